@@ -24,6 +24,7 @@ type Struct struct {
 	comment      string
 	name         string
 	methods      map[string]*Method
+	unlisted     map[string]bool
 	constructors map[string]*Function
 	members      []jen.Code
 }
@@ -38,6 +39,7 @@ func NewStruct(comment string,
 		comment:      comment,
 		name:         name,
 		methods:      make(map[string]*Method, len(methods)),
+		unlisted:     make(map[string]bool),
 		constructors: make(map[string]*Function, len(constructors)),
 		members:      members,
 	}
@@ -93,11 +95,36 @@ func (s *Struct) Constructors(name string) *Function {
 	return s.constructors[name]
 }
 
+// AddMethods adds methods to this struct, replacing any existing methods with
+// the same names. Exported methods are also part of its interface.
+func (s *Struct) AddMethods(methods ...*Method) {
+	for _, m := range methods {
+		s.methods[m.Name()] = m
+		delete(s.unlisted, m.Name())
+	}
+}
+
+// AddUnlistedMethods adds methods to this struct, replacing any existing
+// methods with the same names, that are not part of its interface even if
+// exported. Other code may still call them through a type assertion, without
+// requiring every implementation of the interface to have them.
+func (s *Struct) AddUnlistedMethods(methods ...*Method) {
+	for _, m := range methods {
+		s.methods[m.Name()] = m
+		s.unlisted[m.Name()] = true
+	}
+}
+
+// AddMembers appends members to the definition of this struct.
+func (s *Struct) AddMembers(members ...jen.Code) {
+	s.members = append(s.members, members...)
+}
+
 // ToInterface creates an interface version of this struct.
 func (s *Struct) ToInterface(pkg, name, comment string) *Interface {
 	fns := make([]FunctionSignature, 0, len(s.methods))
 	for _, m := range s.methods {
-		if unicode.IsUpper([]rune(m.Name())[0]) {
+		if unicode.IsUpper([]rune(m.Name())[0]) && !s.unlisted[m.Name()] {
 			fns = append(fns, m.ToFunctionSignature())
 		}
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-fed/activity/astool/rdf"
 	"github.com/go-fed/activity/astool/rdf/xsd"
 	"net/url"
+	"reflect"
 	"sort"
 	"strings"
 )
@@ -21,6 +22,9 @@ const (
 type File struct {
 	// F is the code-generated contents of this file.
 	F *jen.File
+	// Raw is the contents of a file that is not Go code, such as TypeScript
+	// definitions. It is used when F is nil.
+	Raw string
 	// FileName is the name of this file to write.
 	FileName string
 	// Directory specifies the location to write this file.
@@ -140,6 +144,19 @@ func (v vocabulary) nonFuncPropArray() []*gen.NonFunctionalPropertyGenerator {
 	return nfp
 }
 
+// sortedKeys returns the keys of a map with string keys in lexicographical
+// order. Iterating over maps in this order keeps the conversion, and therefore
+// the generated code and any errors, the same upon every run.
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	s := make([]string, len(keys))
+	for i, k := range keys {
+		s[i] = k.String()
+	}
+	sort.Strings(s)
+	return s
+}
+
 // rdfReferences properly accounts for HTTP and HTTPS lookups of specification
 // URIs.
 type mapReferences map[string]*vocabulary
@@ -218,6 +235,26 @@ const (
 type Converter struct {
 	GenRoot       *gen.PackageManager
 	PackagePolicy PackagePolicy
+	// Plugins customize the code generated for types and properties, in
+	// order.
+	Plugins []Plugin
+	// Types restricts the generated types to those named, the types they
+	// extend, and their properties, in order to reduce the size of the
+	// generated code. All types are generated when it is empty.
+	Types []string
+	// TypeScript also generates TypeScript definitions of the types, in the
+	// directory of GenRoot.
+	TypeScript bool
+	// PlainStructs also generates plain structs mirroring the types, with
+	// JSON tags, in the "plain" subpackage of GenRoot.
+	PlainStructs bool
+	// Fuzz also generates go-fuzz targets for the deserialization functions
+	// of the Manager, in the directory of GenRoot. They are only built with
+	// the gen.FuzzBuildTag build tag.
+	Fuzz bool
+	// Benchmarks also generates benchmarks of serializing, deserializing,
+	// and comparing each type, in the directory of GenRoot.
+	Benchmarks bool
 	// Properties stemming from JSONLD
 	idProperty   *gen.FunctionalPropertyGenerator
 	typeProperty *gen.NonFunctionalPropertyGenerator
@@ -225,6 +262,12 @@ type Converter struct {
 
 // Convert turns a ParsedVocabulary into a set of code-generated files.
 func (c *Converter) Convert(p *rdf.ParsedVocabulary) (f []*File, e error) {
+	if len(c.Types) > 0 {
+		p, e = selectTypes(p, c.Types)
+		if e != nil {
+			return
+		}
+	}
 	v := newVocabulary()
 	done := make(map[string]bool)
 	// Step 0: Create the "@id" and "@type" properties
@@ -326,7 +369,7 @@ func (c *Converter) Convert(p *rdf.ParsedVocabulary) (f []*File, e error) {
 // all vocabularies and results in a flattened converted map.
 func (c *Converter) convertReferenceVocabularyRecursively(skip map[string]bool, p *rdf.ParsedVocabulary, refs map[string]*vocabulary) (v map[string]*vocabulary, e error) {
 	v = make(map[string]*vocabulary)
-	for k := range p.References {
+	for _, k := range sortedKeys(p.References) {
 		if skip[k] {
 			continue
 		}
@@ -358,10 +401,11 @@ func (c *Converter) convertReferenceVocabularyRecursively(skip map[string]bool,
 func (c *Converter) convertToFiles(v vocabulary) (f []*File, e error) {
 	pub := c.GenRoot.PublicPackage()
 	// References
-	for _, ref := range v.References {
-		for _, v := range ref.Values {
-			pkg := c.valuePackage(v)
-			f = append(f, convertValue(pkg, v))
+	for _, k := range sortedKeys(v.References) {
+		ref := v.References[k]
+		for _, k := range sortedKeys(ref.Values) {
+			pkg := c.valuePackage(ref.Values[k])
+			f = append(f, convertValue(pkg, ref.Values[k]))
 		}
 		var files []*File
 		files, e = c.toFiles(*ref)
@@ -394,9 +438,9 @@ func (c *Converter) convertToFiles(v vocabulary) (f []*File, e error) {
 	}
 	f = append(f, files...)
 	// This vocabulary
-	for _, v := range v.Values {
-		pkg := c.valuePackage(v)
-		f = append(f, convertValue(pkg, v))
+	for _, k := range sortedKeys(v.Values) {
+		pkg := c.valuePackage(v.Values[k])
+		f = append(f, convertValue(pkg, v.Values[k]))
 	}
 	files, e = c.toFiles(v)
 	if e != nil {
@@ -441,6 +485,22 @@ func (c *Converter) convertToFiles(v vocabulary) (f []*File, e error) {
 		return
 	}
 	f = append(f, typeFiles...)
+	// Non-functional property list
+	listPkg := gen.ListPackage(c.GenRoot)
+	listFile := jen.NewFilePath(listPkg.Path())
+	listFile.Add(gen.ListDefinition())
+	f = append(f, &File{
+		F:         listFile,
+		FileName:  "gen_list.go",
+		Directory: listPkg.WriteDir(),
+	})
+	listDocFile := jen.NewFilePath(listPkg.Path())
+	listDocFile.PackageComment(gen.ListPackageComment(listPkg.Name()))
+	f = append(f, &File{
+		F:         listDocFile,
+		FileName:  "gen_doc.go",
+		Directory: listPkg.WriteDir(),
+	})
 	// Root Package Documentation
 	rootDocFile := jen.NewFilePath(pub.Path())
 	rootDocFile.PackageComment(gen.GenRootPackageComment(pub.Name()))
@@ -461,6 +521,38 @@ func (c *Converter) convertToFiles(v vocabulary) (f []*File, e error) {
 		return
 	}
 	f = append(f, files...)
+	// Columns
+	files, e = c.columnsFiles(c.GenRoot.PublicPackage(), v)
+	if e != nil {
+		return
+	}
+	f = append(f, files...)
+	// GraphQL
+	files, e = c.graphQLFiles(c.GenRoot.Sub("graphql").PublicPackage(), v)
+	if e != nil {
+		return
+	}
+	f = append(f, files...)
+	// TypeScript definitions
+	if c.TypeScript {
+		f = append(f, c.typeScriptFile(c.GenRoot.PublicPackage(), v))
+	}
+	// Plain structs
+	if c.PlainStructs {
+		files, e = c.plainFiles(c.GenRoot.Sub("plain").PublicPackage(), v)
+		if e != nil {
+			return
+		}
+		f = append(f, files...)
+	}
+	// Fuzz targets
+	if c.Fuzz {
+		f = append(f, c.fuzzFile(c.GenRoot.PublicPackage(), v))
+	}
+	// Benchmarks
+	if c.Benchmarks {
+		f = append(f, c.benchmarkFiles(c.GenRoot.PublicPackage(), v)...)
+	}
 	return
 }
 
@@ -478,10 +570,11 @@ func (c *Converter) convertVocabulary(p *rdf.ParsedVocabulary, refs map[string]*
 	v = newVocabulary()
 	v.Name = p.Vocab.Name
 	v.URI = p.Vocab.URI
-	for k, val := range p.Vocab.Values {
-		v.Values[k] = c.convertValue(p.Vocab.Name, val)
+	for _, k := range sortedKeys(p.Vocab.Values) {
+		v.Values[k] = c.convertValue(p.Vocab.Name, p.Vocab.Values[k])
 	}
-	for k, prop := range p.Vocab.Properties {
+	for _, k := range sortedKeys(p.Vocab.Properties) {
+		prop := p.Vocab.Properties[k]
 		if prop.Functional {
 			v.FProps[k], e = c.convertFunctionalProperty(prop, v.Values, p.Vocab, p.References, refs)
 		} else {
@@ -498,8 +591,8 @@ func (c *Converter) convertVocabulary(p *rdf.ParsedVocabulary, refs map[string]*
 	// Instead of building a dependency tree, naively keep iterating through
 	// 'allTypes' until it is empty (good) or we get stuck (return error).
 	allTypes := make([]rdf.VocabularyType, 0, len(p.Vocab.Types))
-	for _, t := range p.Vocab.Types {
-		allTypes = append(allTypes, t)
+	for _, k := range sortedKeys(p.Vocab.Types) {
+		allTypes = append(allTypes, p.Vocab.Types[k])
 	}
 	for {
 		if len(allTypes) == 0 {
@@ -515,9 +608,9 @@ func (c *Converter) convertVocabulary(p *rdf.ParsedVocabulary, refs map[string]*
 				}
 				v.Types[t.Name] = tg
 				stuck = false
-				// Delete the one we just did.
-				allTypes[i] = allTypes[len(allTypes)-1]
-				allTypes = allTypes[:len(allTypes)-1]
+				// Delete the one we just did, keeping the rest in
+				// order.
+				allTypes = append(allTypes[:i], allTypes[i+1:]...)
 				break
 			}
 		}
@@ -683,7 +776,8 @@ func (c *Converter) convertType(t rdf.VocabularyType,
 	// values.
 	name := c.convertTypeToName(t)
 	var rangeProps []gen.Property
-	for _, prop := range existingFProps {
+	for _, k := range sortedKeys(existingFProps) {
+		prop := existingFProps[k]
 		for _, kind := range prop.GetKinds() {
 			// TODO: Rename "LowerName" since the type's name is
 			// actually title case.
@@ -692,7 +786,8 @@ func (c *Converter) convertType(t rdf.VocabularyType,
 			}
 		}
 	}
-	for _, prop := range existingNFProps {
+	for _, k := range sortedKeys(existingNFProps) {
+		prop := existingNFProps[k]
 		for _, kind := range prop.GetKinds() {
 			if kind.Name.LowerName == name {
 				rangeProps = append(rangeProps, prop)
@@ -722,6 +817,11 @@ func (c *Converter) convertType(t rdf.VocabularyType,
 		ext,
 		disjoint,
 		t.IsTypeless())
+	if e == nil && len(c.Plugins) > 0 {
+		tg.SetMemberTags(func(p gen.Property) map[string]string {
+			return c.memberTags(tg, p)
+		})
+	}
 	return
 }
 
@@ -830,7 +930,8 @@ func (c *Converter) convertValue(vocabName string, v rdf.VocabularyValue) *gen.K
 		v.IsURI,
 		s,
 		d,
-		l)
+		l,
+		v.ExtraDefs)
 }
 
 // convertTypeToName makes a Titled version of the VocabularyType's name.
@@ -972,6 +1073,15 @@ func (c *Converter) rootFiles(pkg gen.Package, vocabName string, v vocabulary, m
 	if file := funcsToFile(pkg, propCtors, fmt.Sprintf("gen_pkg_%s_property_constructors.go", lowerVocabName)); file != nil {
 		f = append(f, file)
 	}
+	var workers []*codegen.Function
+	for _, nf := range v.nonFuncPropArray() {
+		if fn := nf.PublicWorkersFn(pkg); fn != nil {
+			workers = append(workers, fn)
+		}
+	}
+	if file := funcsToFile(pkg, workers, fmt.Sprintf("gen_pkg_%s_property_workers.go", lowerVocabName)); file != nil {
+		f = append(f, file)
+	}
 	if file := funcsToFile(pkg, ext, fmt.Sprintf("gen_pkg_%s_extends.go", lowerVocabName)); file != nil {
 		f = append(f, file)
 	}
@@ -1022,7 +1132,8 @@ func (c *Converter) packageFiles(v vocabulary, m *gen.ManagerGenerator) (f []*Fi
 			// Public
 			pub := v.typeArray()[0].PublicPackage()
 			file := jen.NewFilePath(pub.Path())
-			file.Add(pubI.Definition())
+			file.Add(pubI.Definition()).Line()
+			file.Add(gen.DeserializeErrorDefinitions())
 			f = append(f, &File{
 				F:         file,
 				FileName:  "gen_pkg.go",
@@ -1071,7 +1182,7 @@ func (c *Converter) packageFiles(v vocabulary, m *gen.ManagerGenerator) (f []*Fi
 			})
 		}
 	case IndividualUnderRoot:
-		for _, tg := range v.Types {
+		for _, tg := range v.typeArray() {
 			var file []*File
 			file, e = c.typePackageFiles(tg, v.Name, m)
 			if e != nil {
@@ -1079,7 +1190,7 @@ func (c *Converter) packageFiles(v vocabulary, m *gen.ManagerGenerator) (f []*Fi
 			}
 			f = append(f, file...)
 		}
-		for _, pg := range v.FProps {
+		for _, pg := range v.funcPropArray() {
 			var file []*File
 			file, e = c.propertyPackageFiles(&pg.PropertyGenerator, v.Name)
 			if e != nil {
@@ -1087,7 +1198,7 @@ func (c *Converter) packageFiles(v vocabulary, m *gen.ManagerGenerator) (f []*Fi
 			}
 			f = append(f, file...)
 		}
-		for _, pg := range v.NFProps {
+		for _, pg := range v.nonFuncPropArray() {
 			var file []*File
 			file, e = c.propertyPackageFiles(&pg.PropertyGenerator, v.Name)
 			if e != nil {
@@ -1110,7 +1221,8 @@ func (c *Converter) typePackageFiles(tg *gen.TypeGenerator, vocabName string, m
 	// Public
 	pub := tg.PublicPackage()
 	file := jen.NewFilePath(pub.Path())
-	file.Add(pubI.Definition())
+	file.Add(pubI.Definition()).Line()
+	file.Add(gen.DeserializeErrorDefinitions())
 	f = append(f, &File{
 		F:         file,
 		FileName:  "gen_pkg.go",
@@ -1243,6 +1355,114 @@ func (c *Converter) resolverFiles(pkg gen.Package, manGen *gen.ManagerGenerator,
 	return
 }
 
+// columnsFiles creates the files for the column metadata.
+func (c *Converter) columnsFiles(pkg gen.Package, root vocabulary) (files []*File, e error) {
+	cg := gen.NewColumnsGenerator(root.allTypeArray(), pkg)
+	column, columns := cg.Definition()
+	file := jen.NewFilePath(pkg.Path())
+	file.Add(column.Definition()).Line().Add(columns)
+	files = append(files, &File{
+		F:         file,
+		FileName:  "gen_columns.go",
+		Directory: pkg.WriteDir(),
+	})
+	return
+}
+
+// graphQLFiles creates the files for the GraphQL schema and its resolvers.
+func (c *Converter) graphQLFiles(pkg gen.Package, root vocabulary) (files []*File, e error) {
+	gg := gen.NewGraphQLGenerator(root.allTypeArray(), pkg)
+	schema, db, node, resolver, fns, vars := gg.Definition()
+	// Schema
+	file := jen.NewFilePath(pkg.Path())
+	file.Add(schema)
+	files = append(files, &File{
+		F:         file,
+		FileName:  "gen_schema.go",
+		Directory: pkg.WriteDir(),
+	})
+	// Resolver
+	file = jen.NewFilePath(pkg.Path())
+	file.Add(db.Definition()).Line()
+	file.Add(node.Definition()).Line()
+	file.Add(resolver.Definition()).Line()
+	for _, fn := range fns {
+		file.Add(fn.Definition()).Line()
+	}
+	for _, v := range vars {
+		file.Add(v).Line()
+	}
+	files = append(files, &File{
+		F:         file,
+		FileName:  "gen_resolver.go",
+		Directory: pkg.WriteDir(),
+	})
+	return
+}
+
+// typeScriptFile creates the file for the TypeScript definitions of the types.
+func (c *Converter) typeScriptFile(pkg gen.Package, root vocabulary) *File {
+	tg := gen.NewTypeScriptGenerator(root.allTypeArray())
+	return &File{
+		Raw:       tg.Definition(),
+		FileName:  "gen_types.d.ts",
+		Directory: pkg.WriteDir(),
+	}
+}
+
+// fuzzFile creates the file for the fuzz targets of the deserialization
+// functions.
+func (c *Converter) fuzzFile(pkg gen.Package, root vocabulary) *File {
+	fg := gen.NewFuzzGenerator(pkg, root.Manager)
+	file := jen.NewFilePath(pkg.Path())
+	file.HeaderComment(fmt.Sprintf("//go:build %s\n// +build %s", gen.FuzzBuildTag, gen.FuzzBuildTag))
+	for _, fn := range fg.Definition() {
+		file.Add(fn.Definition()).Line()
+	}
+	return &File{
+		F:         file,
+		FileName:  "gen_fuzz.go",
+		Directory: pkg.WriteDir(),
+	}
+}
+
+// benchmarkFiles creates a test file with the benchmarks of each type.
+func (c *Converter) benchmarkFiles(pkg gen.Package, root vocabulary) (files []*File) {
+	for _, t := range root.allTypeArray() {
+		bg := gen.NewBenchmarkGenerator(pkg, t, root.Manager)
+		file := jen.NewFilePath(pkg.Path())
+		for _, fn := range bg.Definition() {
+			file.Add(fn.Definition()).Line()
+		}
+		files = append(files, &File{
+			F:         file,
+			FileName:  bg.FileName(),
+			Directory: pkg.WriteDir(),
+		})
+	}
+	return
+}
+
+// plainFiles creates the files for the plain structs mirroring the types.
+func (c *Converter) plainFiles(pkg gen.Package, root vocabulary) (files []*File, e error) {
+	pg := gen.NewPlainGenerator(root.allTypeArray(), pkg, c.GenRoot.PublicPackage())
+	list, structs, fns := pg.Definition()
+	file := jen.NewFilePath(pkg.Path())
+	file.Add(list.Definition()).Line()
+	for _, fn := range fns {
+		file.Add(fn.Definition()).Line()
+	}
+	for _, s := range structs {
+		file.Add(s.Definition()).Line()
+	}
+	files = append(files, &File{
+		F:         file,
+		FileName:  "gen_plain.go",
+		Directory: pkg.WriteDir(),
+	})
+	return
+}
+
 // constFiles creates the files for constants.
 func (c *Converter) constFiles(pkg gen.Package, types []*gen.TypeGenerator, props []*gen.PropertyGenerator) (files []*File, e error) {
 	consts := gen.GenerateConstants(types, props)
@@ -1288,7 +1508,8 @@ func (c *Converter) jsonLDToFiles() (f []*File, e error) {
 	priv := typePm.PrivatePackage()
 	file := jen.NewFilePath(priv.Path())
 	s, t := c.typeProperty.Definitions()
-	file.Add(s.Definition()).Line().Add(t.Definition())
+	file.Add(s.Definition()).Line().Add(t.Definition()).Line()
+	file.Add(c.typeProperty.PoolDefinition())
 	f = append(f, &File{
 		F:         file,
 		FileName:  fmt.Sprintf("gen_property_%s_%s.go", vName, c.typeProperty.PropertyName()),
@@ -1315,7 +1536,8 @@ func (c *Converter) jsonLDToFiles() (f []*File, e error) {
 	// Implementation
 	priv = idPm.PrivatePackage()
 	file = jen.NewFilePath(priv.Path())
-	file.Add(c.idProperty.Definition().Definition())
+	file.Add(c.idProperty.Definition().Definition()).Line()
+	file.Add(c.idProperty.PoolDefinition())
 	f = append(f, &File{
 		F:         file,
 		FileName:  fmt.Sprintf("gen_property_%s_%s.go", vName, c.idProperty.PropertyName()),
@@ -1336,7 +1558,7 @@ func (c *Converter) jsonLDToFiles() (f []*File, e error) {
 // toFiles converts a vocabulary's types and properties to files.
 func (c *Converter) toFiles(v vocabulary) (f []*File, e error) {
 	vName := strings.ToLower(v.Name)
-	for _, i := range v.FProps {
+	for _, i := range v.funcPropArray() {
 		var pm *gen.PackageManager
 		pm, e = c.propertyPackageManager(i, v.Name)
 		if e != nil {
@@ -1345,7 +1567,13 @@ func (c *Converter) toFiles(v vocabulary) (f []*File, e error) {
 		// Implementation
 		priv := pm.PrivatePackage()
 		file := jen.NewFilePath(priv.Path())
-		file.Add(i.Definition().Definition())
+		for _, plugin := range c.Plugins {
+			if e = plugin.FunctionalProperty(i, i.Definition(), file); e != nil {
+				return
+			}
+		}
+		file.Add(i.Definition().Definition()).Line()
+		file.Add(i.PoolDefinition())
 		f = append(f, &File{
 			F:         file,
 			FileName:  fmt.Sprintf("gen_property_%s_%s.go", vName, i.PropertyName()),
@@ -1362,7 +1590,7 @@ func (c *Converter) toFiles(v vocabulary) (f []*File, e error) {
 		})
 	}
 	// Non-Functional Properties
-	for _, i := range v.NFProps {
+	for _, i := range v.nonFuncPropArray() {
 		var pm *gen.PackageManager
 		pm, e = c.propertyPackageManager(i, v.Name)
 		if e != nil {
@@ -1372,7 +1600,16 @@ func (c *Converter) toFiles(v vocabulary) (f []*File, e error) {
 		priv := pm.PrivatePackage()
 		file := jen.NewFilePath(priv.Path())
 		s, t := i.Definitions()
-		file.Add(s.Definition()).Line().Add(t.Definition())
+		for _, plugin := range c.Plugins {
+			if e = plugin.NonFunctionalProperty(i, t, s, file); e != nil {
+				return
+			}
+		}
+		file.Add(s.Definition()).Line().Add(t.Definition()).Line()
+		file.Add(i.PoolDefinition())
+		if workers := i.WorkersDefinition(); workers != nil {
+			file.Line().Add(workers)
+		}
 		f = append(f, &File{
 			F:         file,
 			FileName:  fmt.Sprintf("gen_property_%s_%s.go", vName, i.PropertyName()),
@@ -1391,7 +1628,7 @@ func (c *Converter) toFiles(v vocabulary) (f []*File, e error) {
 		})
 	}
 	// Types
-	for _, i := range v.Types {
+	for _, i := range v.typeArray() {
 		var pm *gen.PackageManager
 		pm, e = c.typePackageManager(i, v.Name)
 		if e != nil {
@@ -1400,7 +1637,14 @@ func (c *Converter) toFiles(v vocabulary) (f []*File, e error) {
 		// Implementation
 		priv := pm.PrivatePackage()
 		file := jen.NewFilePath(priv.Path())
-		file.Add(i.Definition().Definition())
+		for _, plugin := range c.Plugins {
+			if e = plugin.Type(i, i.Definition(), file); e != nil {
+				return
+			}
+		}
+		file.Add(i.Definition().Definition()).Line()
+		file.Add(i.KnownPropertiesDefinition())
+		file.Line().Add(i.PoolDefinition())
 		f = append(f, &File{
 			F:         file,
 			FileName:  fmt.Sprintf("gen_type_%s_%s.go", vName, strings.ToLower(i.TypeName())),
@@ -1453,6 +1697,9 @@ func toIdentifier(n rdf.NameGetter) gen.Identifier {
 // convertValue converts a Kind value into a code-generated File.
 func convertValue(pkg gen.Package, v *gen.Kind) *File {
 	file := jen.NewFilePath(pkg.Path())
+	for _, def := range v.ExtraDefs {
+		file.Add(def).Line()
+	}
 	file.Add(
 		v.SerializeDef.Definition(),
 	).Line().Add(
@@ -0,0 +1,74 @@
+package convert
+
+import (
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+	"github.com/go-fed/activity/astool/gen"
+)
+
+// Plugin customizes the code generated for the types and properties of a
+// vocabulary, so that tools built upon the Converter can add to it, such as ORM
+// tags or tracing code, without maintaining a fork of the generator.
+//
+// Each hook is given the generator, the struct of its implementation, and the
+// file the implementation is written to. Methods and members may be added to
+// the struct, with exported methods also becoming part of its interface. Other
+// code may be added to the file, and its imports named with ImportName or
+// ImportAlias. Returning an error stops the conversion.
+//
+// Embed NopPlugin to implement only some of the hooks.
+type Plugin interface {
+	// MemberTags returns the struct tags of the member of a type holding
+	// one of its properties.
+	MemberTags(t *gen.TypeGenerator, p gen.Property) map[string]string
+	// Type is called with the implementation of a type.
+	Type(t *gen.TypeGenerator, s *codegen.Struct, f *jen.File) error
+	// FunctionalProperty is called with the implementation of a
+	// functional property.
+	FunctionalProperty(p *gen.FunctionalPropertyGenerator, s *codegen.Struct, f *jen.File) error
+	// NonFunctionalProperty is called with the implementations of a
+	// non-functional property and of the iterator over its values.
+	NonFunctionalProperty(p *gen.NonFunctionalPropertyGenerator, s, iter *codegen.Struct, f *jen.File) error
+}
+
+// Plugin must be implemented by NopPlugin.
+var _ Plugin = NopPlugin{}
+
+// NopPlugin is a Plugin leaving the generated code unchanged.
+type NopPlugin struct{}
+
+// MemberTags returns no tags.
+func (NopPlugin) MemberTags(t *gen.TypeGenerator, p gen.Property) map[string]string {
+	return nil
+}
+
+// Type does nothing.
+func (NopPlugin) Type(t *gen.TypeGenerator, s *codegen.Struct, f *jen.File) error {
+	return nil
+}
+
+// FunctionalProperty does nothing.
+func (NopPlugin) FunctionalProperty(p *gen.FunctionalPropertyGenerator, s *codegen.Struct, f *jen.File) error {
+	return nil
+}
+
+// NonFunctionalProperty does nothing.
+func (NopPlugin) NonFunctionalProperty(p *gen.NonFunctionalPropertyGenerator, s, iter *codegen.Struct, f *jen.File) error {
+	return nil
+}
+
+// memberTags returns the struct tags of all plugins for the member of the type
+// holding the property. Later plugins replace the tags of earlier ones with
+// the same key.
+func (c *Converter) memberTags(t *gen.TypeGenerator, p gen.Property) map[string]string {
+	var tags map[string]string
+	for _, plugin := range c.Plugins {
+		for k, v := range plugin.MemberTags(t, p) {
+			if tags == nil {
+				tags = make(map[string]string)
+			}
+			tags[k] = v
+		}
+	}
+	return tags
+}
@@ -0,0 +1,112 @@
+package convert
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/astool/rdf"
+)
+
+// selectTypes returns a copy of the ParsedVocabulary containing only the named
+// types, the types they extend, and the properties of these types. Types are
+// matched by their name in any of the vocabularies.
+//
+// References to omitted types are removed from the domains and ranges of the
+// properties, and properties left without a range are omitted. Values are all
+// kept.
+func selectTypes(p *rdf.ParsedVocabulary, names []string) (*rdf.ParsedVocabulary, error) {
+	vocabs := make([]*rdf.Vocabulary, 0, len(p.References)+1)
+	vocabs = append(vocabs, &p.Vocab)
+	for _, v := range p.References {
+		vocabs = append(vocabs, v)
+	}
+	allTypes := make(map[string]rdf.VocabularyType)
+	for _, v := range vocabs {
+		for name, t := range v.Types {
+			allTypes[name] = t
+		}
+	}
+	// Keep the named types and, transitively, the types they extend.
+	types := make(map[string]bool, len(names))
+	var keep func(name string)
+	keep = func(name string) {
+		if types[name] {
+			return
+		}
+		types[name] = true
+		for _, ext := range allTypes[name].Extends {
+			keep(ext.Name)
+		}
+	}
+	for _, name := range names {
+		if _, ok := allTypes[name]; !ok {
+			return nil, fmt.Errorf("cannot select unknown type %q", name)
+		}
+		keep(name)
+	}
+	// keepRefs filters out references to types that are not kept, leaving
+	// references to values untouched.
+	keepRefs := func(refs []rdf.VocabularyReference) (kept []rdf.VocabularyReference) {
+		for _, r := range refs {
+			if _, isType := allTypes[r.Name]; !isType || types[r.Name] {
+				kept = append(kept, r)
+			}
+		}
+		return
+	}
+	// Keep the properties of the kept types, whether listed by the type or
+	// added to it by another vocabulary.
+	props := make(map[string]bool)
+	for name := range types {
+		for _, prop := range allTypes[name].Properties {
+			props[prop.Name] = true
+		}
+	}
+	for _, v := range vocabs {
+		for name, prop := range v.Properties {
+			for _, dom := range prop.Domain {
+				if types[dom.Name] {
+					props[name] = true
+				}
+			}
+			if len(keepRefs(prop.Range)) == 0 {
+				delete(props, name)
+			}
+		}
+	}
+	keepProps := func(refs []rdf.VocabularyReference) (kept []rdf.VocabularyReference) {
+		for _, r := range refs {
+			if props[r.Name] {
+				kept = append(kept, r)
+			}
+		}
+		return
+	}
+	selectVocab := func(v *rdf.Vocabulary) *rdf.Vocabulary {
+		s := *v
+		s.Types = make(map[string]rdf.VocabularyType)
+		for name, t := range v.Types {
+			if !types[name] {
+				continue
+			}
+			t.DisjointWith = keepRefs(t.DisjointWith)
+			t.Properties = keepProps(t.Properties)
+			t.WithoutProperties = keepProps(t.WithoutProperties)
+			s.Types[name] = t
+		}
+		s.Properties = make(map[string]rdf.VocabularyProperty)
+		for name, prop := range v.Properties {
+			if !props[name] {
+				continue
+			}
+			prop.Domain = keepRefs(prop.Domain)
+			prop.Range = keepRefs(prop.Range)
+			s.Properties[name] = prop
+		}
+		return &s
+	}
+	selected := p.Clone()
+	selected.Vocab = *selectVocab(&p.Vocab)
+	for k, v := range p.References {
+		selected.References[k] = selectVocab(v)
+	}
+	return selected, nil
+}
@@ -0,0 +1,137 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+	"strings"
+)
+
+const (
+	benchmarkValueFnName   = "benchmark%s"
+	benchmarkName          = "Benchmark%s%s"
+	benchmarkSerialize     = "Serialize"
+	benchmarkDeserialize   = "Deserialize"
+	benchmarkLessThan      = "LessThan"
+	benchmarkIRIHost       = "example.com"
+	benchmarkIRIPathFormat = "/%s/%d"
+)
+
+// BenchmarkGenerator generates benchmarks of serializing, deserializing, and
+// comparing a type, so that changes to the generated code are measured.
+type BenchmarkGenerator struct {
+	pkg Package
+	t   *TypeGenerator
+	m   *ManagerGenerator
+}
+
+// NewBenchmarkGenerator creates a new generator for the benchmarks of a type, in
+// the root package pkg.
+//
+// Must be constructed after the ManagerGenerator.
+func NewBenchmarkGenerator(pkg Package, t *TypeGenerator, m *ManagerGenerator) *BenchmarkGenerator {
+	return &BenchmarkGenerator{
+		pkg: pkg,
+		t:   t,
+		m:   m,
+	}
+}
+
+// FileName returns the name of the test file for the benchmarks of the type.
+func (g *BenchmarkGenerator) FileName() string {
+	return fmt.Sprintf("gen_bench_%s_%s_test.go", strings.ToLower(g.t.VocabName()), strings.ToLower(g.t.TypeName()))
+}
+
+// Definition returns the benchmarks of the type and the function creating the
+// values they use.
+//
+// The values are created by the type's constructor with an "id", so that the
+// benchmarks measure the generated code rather than the size of the value.
+func (g *BenchmarkGenerator) Definition() []*codegen.Function {
+	name := g.t.InterfaceName()
+	valueFn := fmt.Sprintf(benchmarkValueFnName, name)
+	return []*codegen.Function{
+		codegen.NewCommentedFunction(
+			g.pkg.Path(),
+			valueFn,
+			[]jen.Code{jen.Id("n").Int()},
+			[]jen.Code{jen.Qual(g.t.PublicPackage().Path(), name)},
+			[]jen.Code{
+				jen.Id("id").Op(":=").Id(fmt.Sprintf("%s%s", constructorName, idType)).Call(),
+				jen.Id("id").Dot(setMethod).Call(jen.Op("&").Qual("net/url", "URL").Values(jen.Dict{
+					jen.Id("Scheme"): jen.Lit("https"),
+					jen.Id("Host"):   jen.Lit(benchmarkIRIHost),
+					jen.Id("Path"): jen.Qual("fmt", "Sprintf").Call(
+						jen.Lit(benchmarkIRIPathFormat),
+						jen.Lit(strings.ToLower(g.t.TypeName())),
+						jen.Id("n"),
+					),
+				})),
+				jen.Id("v").Op(":=").Id(fmt.Sprintf("%s%s", constructorName, name)).Call(),
+				jen.Id("v").Dot(setIdFunction).Call(jen.Id("id")),
+				jen.Return(jen.Id("v")),
+			},
+			fmt.Sprintf("%s returns the %s with the n-th IRI as its \"id\", for benchmarks.", valueFn, name)),
+		g.benchmark(
+			benchmarkSerialize,
+			fmt.Sprintf("serializing the %s type", name),
+			[]jen.Code{
+				jen.Id("v").Op(":=").Id(valueFn).Call(jen.Lit(0)),
+			},
+			benchmarkFatalIfErr(jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("v").Dot(serializeMethodName).Call())),
+		g.benchmark(
+			benchmarkDeserialize,
+			fmt.Sprintf("deserializing the %s type", name),
+			[]jen.Code{
+				jen.List(jen.Id("m"), jen.Err()).Op(":=").Id(valueFn).Call(jen.Lit(0)).Dot(serializeMethodName).Call(),
+				jen.If(jen.Err().Op("!=").Nil()).Block(
+					jen.Id("b").Dot("Fatal").Call(jen.Err()),
+				),
+				jen.Id("deserialize").Op(":=").Id(managerInitName()).Dot(g.m.getDeserializationMethodForType(g.t).Name()).Call(),
+				jen.Id("aliasMap").Op(":=").Map(jen.String()).String().Values(),
+			},
+			benchmarkFatalIfErr(jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("deserialize").Call(jen.Id("m"), jen.Id("aliasMap")))),
+		g.benchmark(
+			benchmarkLessThan,
+			fmt.Sprintf("comparing two values of the %s type", name),
+			[]jen.Code{
+				jen.List(jen.Id("lhs"), jen.Id("rhs")).Op(":=").List(
+					jen.Id(valueFn).Call(jen.Lit(0)),
+					jen.Id(valueFn).Call(jen.Lit(1)),
+				),
+			},
+			jen.Id("lhs").Dot(compareLessMethod).Call(jen.Id("rhs"))),
+	}
+}
+
+// benchmark returns the benchmark of an operation on the type, which is
+// repeated after the setup.
+func (g *BenchmarkGenerator) benchmark(op, of string, setup []jen.Code, do jen.Code) *codegen.Function {
+	fn := fmt.Sprintf(benchmarkName, g.t.InterfaceName(), op)
+	body := append(setup,
+		jen.Id("b").Dot("ResetTimer").Call(),
+		jen.For(
+			jen.Id("i").Op(":=").Lit(0),
+			jen.Id("i").Op("<").Id("b").Dot("N"),
+			jen.Id("i").Op("++"),
+		).Block(do),
+	)
+	return codegen.NewCommentedFunction(
+		g.pkg.Path(),
+		fn,
+		[]jen.Code{jen.Id("b").Op("*").Qual("testing", "B")},
+		/*ret=*/ nil,
+		body,
+		fmt.Sprintf("%s measures %s.", fn, of))
+}
+
+// benchmarkFatalIfErr fails the benchmark if the statement assigns a non-nil
+// error "err".
+func benchmarkFatalIfErr(stmt jen.Code) jen.Code {
+	return jen.If(
+		stmt,
+		jen.Err().Op("!=").Nil(),
+	).Block(
+		jen.Id("b").Dot("Fatal").Call(jen.Err()),
+	)
+}
@@ -0,0 +1,175 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+	"strings"
+)
+
+const (
+	columnStructName = "Column"
+	columnsVarName   = "Columns"
+	// SQL-friendly column types.
+	sqlText      = "TEXT"
+	sqlBoolean   = "BOOLEAN"
+	sqlDouble    = "DOUBLE PRECISION"
+	sqlBigInt    = "BIGINT"
+	sqlTimestamp = "TIMESTAMP"
+	sqlInterval  = "INTERVAL"
+	sqlJSON      = "JSON"
+	// Go kinds that cannot be determined from a single Kind.
+	goIRIKind   = "*url.URL"
+	goMixedKind = "interface{}"
+	goLangKind  = "map[string]string"
+)
+
+// ColumnsGenerator generates metadata describing how the properties of each
+// type map onto relational columns.
+type ColumnsGenerator struct {
+	pkg   Package
+	types []*TypeGenerator
+}
+
+// NewColumnsGenerator creates a new generator for the column metadata of the
+// types.
+//
+// Must be constructed after all TypeGenerators.
+func NewColumnsGenerator(tgs []*TypeGenerator, pkg Package) *ColumnsGenerator {
+	return &ColumnsGenerator{
+		pkg:   pkg,
+		types: tgs,
+	}
+}
+
+// Definition returns the Column struct and the variable containing the columns
+// of every type.
+func (g *ColumnsGenerator) Definition() (column *codegen.Struct, columns jen.Code) {
+	column = codegen.NewStruct(
+		fmt.Sprintf("%s describes how a property of an ActivityStreams type "+
+			"can be stored in a relational database column. It is "+
+			"intended for ORMs and migration generators, so that "+
+			"applications storing properties in columns stay in sync "+
+			"with the vocabulary.", columnStructName),
+		columnStructName,
+		nil,
+		nil,
+		[]jen.Code{
+			jen.Comment("Property is the name of the property.").Line().Id("Property").String(),
+			jen.Comment("Vocabulary is the name of the vocabulary defining the property.").Line().Id("Vocabulary").String(),
+			jen.Comment("GoKind is the Go type of the property's value. Properties whose").Line().Comment("values are ActivityStreams types are stored as their IRI.").Line().Id("GoKind").String(),
+			jen.Comment("SQLType is a portable SQL column type for the property. Values").Line().Comment("that cannot be stored in a scalar column, such as non-functional").Line().Comment("properties, natural language maps, or properties with values of").Line().Comment("several kinds, are JSON.").Line().Id("SQLType").String(),
+			jen.Comment("Nullable is true if the property may be absent.").Line().Id("Nullable").Bool(),
+		})
+	typeCols := make(jen.Dict, len(g.types))
+	for _, t := range g.types {
+		var cols []jen.Code
+		for _, p := range t.allProperties() {
+			cols = append(cols, jen.Line().Add(g.column(p.PropertyName(), p.VocabName(), g.goKind(p), g.sqlType(p), g.nullable(p))))
+			if p.HasNaturalLanguageMap() {
+				cols = append(cols, jen.Line().Add(g.column(p.PropertyName()+"Map", p.VocabName(), goLangKind, sqlJSON, true)))
+			}
+		}
+		// Places the closing brace on its own line.
+		cols = append(cols, jen.Line())
+		typeCols[jen.Lit(fmt.Sprintf("%s%s", t.VocabName(), t.TypeName()))] = jen.Values(cols...)
+	}
+	columns = jen.Comment(codegen.FormatPackageDocumentation(fmt.Sprintf(
+		"%s are the columns of every ActivityStreams type, keyed by the "+
+			"vocabulary name followed by the type name, such as "+
+			"%q. The columns are in property order.",
+		columnsVarName,
+		fmt.Sprintf("%s%s", g.types[0].VocabName(), g.types[0].TypeName()),
+	))).Line().Var().Id(columnsVarName).Op("=").Map(jen.String()).Index().Qual(g.pkg.Path(), columnStructName).Values(typeCols)
+	return
+}
+
+// column returns a Column literal.
+func (g *ColumnsGenerator) column(property, vocab, goKind, sqlType string, nullable bool) jen.Code {
+	return jen.Values(
+		jen.Lit(property),
+		jen.Lit(vocab),
+		jen.Lit(goKind),
+		jen.Lit(sqlType),
+		jen.Lit(nullable),
+	)
+}
+
+// isList determines whether a property is non-functional.
+func (g *ColumnsGenerator) isList(p Property) bool {
+	_, ok := p.(*NonFunctionalPropertyGenerator)
+	return ok
+}
+
+// nullable determines whether a property may be absent. Only the 'type' is
+// always present.
+func (g *ColumnsGenerator) nullable(p Property) bool {
+	return !(p.VocabName() == JSONLDVocabName && p.PropertyName() == JSONLDTypeName)
+}
+
+// goKind determines the Go type of a property's values.
+func (g *ColumnsGenerator) goKind(p Property) string {
+	s := ""
+	for _, k := range propertyKinds(p) {
+		kind := goIRIKind
+		if k.isValue() {
+			kind = fmt.Sprintf("%#v", k.ConcreteKind)
+		}
+		if len(s) == 0 {
+			s = kind
+		} else if s != kind {
+			s = goMixedKind
+			break
+		}
+	}
+	if len(s) == 0 {
+		s = goMixedKind
+	}
+	if g.isList(p) {
+		return "[]" + s
+	}
+	return s
+}
+
+// sqlType determines the SQL-friendly column type of a property.
+func (g *ColumnsGenerator) sqlType(p Property) string {
+	if g.isList(p) {
+		return sqlJSON
+	}
+	s := ""
+	for _, k := range propertyKinds(p) {
+		t := sqlText
+		if k.isValue() {
+			t = valueSQLType(k)
+		}
+		if len(s) == 0 {
+			s = t
+		} else if s != t {
+			return sqlJSON
+		}
+	}
+	if len(s) == 0 {
+		return sqlJSON
+	}
+	return s
+}
+
+// valueSQLType maps a value Kind to a SQL-friendly column type.
+func valueSQLType(k Kind) string {
+	switch strings.TrimPrefix(fmt.Sprintf("%#v", k.ConcreteKind), "*") {
+	case "bool":
+		return sqlBoolean
+	case "float64":
+		return sqlDouble
+	case "int":
+		return sqlBigInt
+	case "time.Time":
+		return sqlTimestamp
+	case "time.Duration":
+		return sqlInterval
+	case "string", "url.URL":
+		return sqlText
+	default:
+		return sqlJSON
+	}
+}
@@ -49,7 +49,9 @@ func GenRootPackageComment(pkgName string) string {
 		"singleton at init time in this library. It is then injected "+
 		"into each implementation library so they can deserialize "+
 		"their needed types without relying on the underlying "+
-		"concrete type.\n\n"+
+		"concrete type. Each of its deserialization methods has a "+
+		"variant accepting a context, which the JSONResolver uses, "+
+		"that returns the context's error once it is done.\n\n"+
 		"Subdirectories of this package include implementation "+
 		"files and functions that are not intended to be directly "+
 		"linked to applications, but are used by this particular "+
@@ -118,6 +120,8 @@ func VocabPackageComment(pkgName, vocabName string) string {
 		"arbitrary but stable ordering best used as a normalized "+
 		"form. A non-functional property's iterator looks like a "+
 		"functional property with \"Next\" and \"Previous\" methods. "+
+		"The \"Values\" method returns all of the iterators in a "+
+		"slice, to range over them instead. "+
 		"Applications should not use the \"KindIndex\" methods as it "+
 		"is a comparison mechanism only for those looking to write an "+
 		"alternate implementation of this library.\n\n"+
@@ -127,12 +131,19 @@ func VocabPackageComment(pkgName, vocabName string) string {
 		"value. The aliases used by this library when serializing "+
 		"objects is done at code-generation time, unless a different "+
 		"alias was used to deserialize the type or property.\n\n"+
+		"The implementations of types and properties also have a "+
+		"\"Release\" method, which is not part of their interfaces. "+
+		"Applications deserializing many values, such as servers "+
+		"processing the activities delivered to their inboxes, may "+
+		"release a deserialized type once done with it, so that it "+
+		"and its properties are reused by later deserializations "+
+		"instead of being allocated again.\n\n"+
 		"Types, functional properties, and non-functional properties "+
 		"are not designed for concurrent usage by two or more "+
-		"goroutines. Also, certain methods on a non-functional "+
-		"property will invalidate iterators and possibly cause "+
-		"unexpected behaviors. To avoid this, re-obtain an iterator "+
-		"after modifying a non-functional property.",
+		"goroutines. Iterators of a non-functional property remain "+
+		"valid when values are added to or removed from it, so it "+
+		"is not necessary to re-obtain an iterator after modifying "+
+		"a non-functional property.",
 		pkgName, vocabName))
 }
 
@@ -195,3 +206,14 @@ func PrivateIndividualPropertyPackageComment(pkgName, propertyName string) strin
 		"package.",
 		pkgName, propertyName))
 }
+
+func ListPackageComment(pkgName string) string {
+	return codegen.FormatPackageDocumentation(fmt.Sprintf("Package %s "+
+		"contains the list of values shared by the implementations of "+
+		"every non-functional property, so that adding, removing, and "+
+		"linking their iterators is not repeated for each of them. "+
+		"This package is code-generated and subject to the same "+
+		"license as the go-fed tool used to generate it.\n\n"+
+		"Applications should not use this package directly.",
+		pkgName))
+}
@@ -381,6 +381,7 @@ func (p *FunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *co
 	typeDeserializeFns := jen.Empty()
 	foundValue := false
 	foundType := false
+	foundTypeless := false
 	for i, kind := range p.kinds {
 		values := jen.Dict{
 			jen.Id(p.memberName(i)): jen.Id("v"),
@@ -392,8 +393,6 @@ func (p *FunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *co
 		tmp := jen.Empty()
 		if kind.isValue() && foundValue {
 			tmp = tmp.Else()
-		} else if !kind.isValue() && foundType {
-			tmp = tmp.Else()
 		}
 		variable := jen.Id("i")
 		if !kind.isValue() {
@@ -406,7 +405,7 @@ func (p *FunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *co
 			).Op(":=").Add(kind.deserializeFnCode(variable, jen.Id("aliasMap"))),
 			jen.Err().Op("==").Nil(),
 		).Block(
-			jen.Id(codegen.This()).Op(":=").Op("&").Id(p.StructName()).Values(
+			p.newThisCode(
 				values,
 			),
 			jen.Return(
@@ -418,10 +417,67 @@ func (p *FunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *co
 			foundValue = true
 			valueDeserializeFns = valueDeserializeFns.Add(tmp)
 		} else {
-			foundType = true
-			typeDeserializeFns = typeDeserializeFns.Add(tmp)
+			if foundType || foundTypeless {
+				typeDeserializeFns = typeDeserializeFns.Line()
+			}
+			if kind.Typeless {
+				foundTypeless = true
+				typeDeserializeFns = typeDeserializeFns.Add(tmp)
+			} else {
+				foundType = true
+				// Only attempt types the value has, unless it has
+				// none.
+				typeDeserializeFns = typeDeserializeFns.If(
+					jen.Op("!").Id("hasType").Op("||").Id("isType").Call(
+						jen.Lit(kind.TypeVocabURI.String()),
+						jen.Lit(kind.Name.LowerName),
+					),
+				).Block(tmp)
+			}
 		}
 	}
+	if foundType {
+		typeDeserializeFns = jen.Commentf("Begin: Determine the types of the value, to deserialize it as them first").Line().Add(
+			jen.List(
+				jen.Id("typeValue"),
+				jen.Id("hasType"),
+			).Op(":=").Id("m").Index(jen.Lit(JSONLDTypeName)),
+		).Line().Add(
+			jen.Id("isType").Op(":=").Func().Params(
+				jen.Id("vocabURI"),
+				jen.Id("name").String(),
+			).Bool().Block(
+				jen.Id("aliasPrefix").Op(":=").Lit(""),
+				jen.If(
+					jen.List(
+						jen.Id("a"),
+						jen.Id("ok"),
+					).Op(":=").Id("aliasMap").Index(jen.Id("vocabURI")),
+					jen.Id("ok"),
+				).Block(
+					jen.Id("aliasPrefix").Op("=").Id("a").Op("+").Lit(":"),
+				),
+				jen.Switch(jen.Id("t").Op(":=").Id("typeValue").Assert(jen.Type())).Block(
+					jen.Case(jen.String()).Block(
+						jen.Return(jen.Qual("strings", "TrimPrefix").Call(jen.Id("t"), jen.Id("aliasPrefix")).Op("==").Id("name")),
+					),
+					jen.Case(jen.Index().Interface()).Block(
+						jen.For(
+							jen.List(jen.Id("_"), jen.Id("elem")).Op(":=").Range().Id("t"),
+						).Block(
+							jen.If(
+								jen.List(jen.Id("s"), jen.Id("ok")).Op(":=").Id("elem").Assert(jen.String()),
+								jen.Id("ok").Op("&&").Qual("strings", "TrimPrefix").Call(jen.Id("s"), jen.Id("aliasPrefix")).Op("==").Id("name"),
+							).Block(
+								jen.Return(jen.True()),
+							),
+						),
+					),
+				),
+				jen.Return(jen.False()),
+			),
+		).Line().Commentf("End: Determine the types of the value, to deserialize it as them first").Line().Add(typeDeserializeFns)
+	}
 	mapProperty := jen.Empty()
 	if p.hasNaturalLanguageMap {
 		mapProperty = jen.If(
@@ -531,8 +587,7 @@ func (p *FunctionalPropertyGenerator) singleTypeDef() *codegen.Struct {
 	// TODO: Normalize alias of values when setting on this property.
 	kindMembers = append(kindMembers, jen.Id(aliasMember).String())
 	if p.asIterator {
-		kindMembers = append(kindMembers, jen.Id(myIndexMemberName).Int())
-		kindMembers = append(kindMembers, jen.Id(parentMemberName).Qual(p.GetPublicPackage().Path(), p.parentTypeInterfaceName()))
+		kindMembers = append(kindMembers, jen.Qual(ListPackage(p.packageManager).Path(), listLinksName).Index(jen.Op("*").Id(p.StructName())))
 	}
 	var methods []*codegen.Method
 	var funcs []*codegen.Function
@@ -544,11 +599,13 @@ func (p *FunctionalPropertyGenerator) singleTypeDef() *codegen.Struct {
 	methods = append(methods, p.funcs()...)
 	methods = append(methods, p.commonMethods()...)
 	methods = append(methods, p.nameMethod())
-	return codegen.NewStruct(comment,
+	property := codegen.NewStruct(comment,
 		p.StructName(),
 		methods,
 		funcs,
 		kindMembers)
+	property.AddUnlistedMethods(p.addContextMethod(), p.releaseMethod())
+	return property
 }
 
 // singleTypeFuncs generates the special-case simplified methods for a
@@ -702,59 +759,61 @@ func (p *FunctionalPropertyGenerator) singleTypeFuncs() []*codegen.Method {
 		clearComment,
 	))
 	// LessThan Method
-	lessCode := p.kinds[0].lessFnCode(jen.Id(codegen.This()).Dot(p.getFnName(0)).Call(), jen.Id("o").Dot(p.getFnName(0)).Call())
+	methods = append(methods, p.lessMethod())
+	// Compare Method
 	iriCmp := jen.Empty()
 	if !p.hasURIKind() {
 		iriCmp = iriCmp.Add(
-			jen.Commentf("LessThan comparison for if either or both are IRIs.").Line(),
+			jen.Commentf("Compare comparison for if either or both are IRIs.").Line(),
 			jen.If(
 				jen.Id(codegen.This()).Dot(isIRIMethod).Call().Op("&&").Id("o").Dot(isIRIMethod).Call(),
 			).Block(
 				jen.Return(
-					jen.Id(codegen.This()).Dot(iriMember).Dot("String").Call().Op("<").Id("o").Dot(getIRIMethod).Call().Dot("String").Call(),
+					jen.Qual("strings", "Compare").Call(
+						jen.Id(codegen.This()).Dot(iriMember).Dot("String").Call(),
+						jen.Id("o").Dot(getIRIMethod).Call().Dot("String").Call(),
+					),
 				),
 			).Else())
 	}
 	methods = append(methods, codegen.NewCommentedValueMethod(
 		p.GetPrivatePackage().Path(),
-		compareLessMethod,
+		compareMethod,
 		p.StructName(),
 		[]jen.Code{jen.Id("o").Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
-		[]jen.Code{jen.Bool()},
-		[]jen.Code{
+		[]jen.Code{jen.Int()},
+		append([]jen.Code{
 			iriCmp.If(
-				jen.Id(codegen.This()).Dot(isIRIMethod).Call(),
+				jen.Id(codegen.This()).Dot(isIRIMethod).Call().Op("&&").Op("!").Id("o").Dot(isIRIMethod).Call(),
 			).Block(
 				jen.Commentf("IRIs are always less than other values, none, or unknowns"),
-				jen.Return(jen.True()),
+				jen.Return(jen.Lit(-1)),
 			).Else().If(
-				jen.Id("o").Dot(isIRIMethod).Call(),
+				jen.Op("!").Id(codegen.This()).Dot(isIRIMethod).Call().Op("&&").Id("o").Dot(isIRIMethod).Call(),
 			).Block(
 				jen.Commentf("This other, none, or unknown value is always greater than IRIs"),
-				jen.Return(jen.False()),
+				jen.Return(jen.Lit(1)),
 			),
-			jen.Commentf("LessThan comparison for the single value or unknown value."),
+			jen.Commentf("Compare comparison for the single value or unknown value."),
 			jen.If(
 				jen.Op("!").Id(codegen.This()).Dot(p.isMethodName(0)).Call().Op("&&").Op("!").Id("o").Dot(p.isMethodName(0)).Call(),
 			).Block(
 				jen.Commentf("Both are unknowns."),
-				jen.Return(jen.False()),
+				jen.Return(jen.Lit(0)),
 			).Else().If(
 				jen.Id(codegen.This()).Dot(p.isMethodName(0)).Call().Op("&&").Op("!").Id("o").Dot(p.isMethodName(0)).Call(),
 			).Block(
 				jen.Commentf("Values are always greater than unknown values."),
-				jen.Return(jen.False()),
+				jen.Return(jen.Lit(1)),
 			).Else().If(
 				jen.Op("!").Id(codegen.This()).Dot(p.isMethodName(0)).Call().Op("&&").Id("o").Dot(p.isMethodName(0)).Call(),
 			).Block(
 				jen.Commentf("Unknowns are always less than known values."),
-				jen.Return(jen.True()),
-			).Else().Block(
-				jen.Commentf("Actual comparison."),
-				jen.Return(lessCode),
+				jen.Return(jen.Lit(-1)),
 			),
-		},
-		fmt.Sprintf("%s compares two instances of this property with an arbitrary but stable comparison. Applications should not use this because it is only meant to help alternative implementations to go-fed to be able to normalize nonfunctional properties.", compareLessMethod),
+			jen.Commentf("Actual comparison."),
+		}, p.kinds[0].compareFnCode(jen.Id(codegen.This()).Dot(p.getFnName(0)).Call(), jen.Id("o").Dot(p.getFnName(0)).Call())...),
+		p.compareComment(),
 	))
 	return methods
 }
@@ -789,8 +848,7 @@ func (p *FunctionalPropertyGenerator) multiTypeDef() *codegen.Struct {
 			p.StructName(),
 			explanation,
 		)
-		kindMembers = append(kindMembers, jen.Id(myIndexMemberName).Int())
-		kindMembers = append(kindMembers, jen.Id(parentMemberName).Qual(p.GetPublicPackage().Path(), p.parentTypeInterfaceName()))
+		kindMembers = append(kindMembers, jen.Qual(ListPackage(p.packageManager).Path(), listLinksName).Index(jen.Op("*").Id(p.StructName())))
 	}
 	var methods []*codegen.Method
 	var funcs []*codegen.Function
@@ -802,11 +860,13 @@ func (p *FunctionalPropertyGenerator) multiTypeDef() *codegen.Struct {
 	methods = append(methods, p.funcs()...)
 	methods = append(methods, p.commonMethods()...)
 	methods = append(methods, p.nameMethod())
-	return codegen.NewStruct(comment,
+	property := codegen.NewStruct(comment,
 		p.StructName(),
 		methods,
 		funcs,
 		kindMembers)
+	property.AddUnlistedMethods(p.addContextMethod(), p.releaseMethod())
+	return property
 }
 
 // multiTypeFuncs generates the methods for a functional property with more than
@@ -993,42 +1053,47 @@ func (p *FunctionalPropertyGenerator) multiTypeFuncs() []*codegen.Method {
 		fmt.Sprintf("%s returns the IRI of this property. When %s returns false, %s will return an arbitrary value.", getIRIMethod, isIRIMethod, getIRIMethod),
 	))
 	// LessThan Method
-	lessCode := jen.Empty().Add(
+	methods = append(methods, p.lessMethod())
+	// Compare Method
+	cmpCode := jen.Empty().Add(
 		jen.Id("idx1").Op(":=").Id(codegen.This()).Dot(kindIndexMethod).Call().Line(),
 		jen.Id("idx2").Op(":=").Id("o").Dot(kindIndexMethod).Call().Line(),
 		jen.If(jen.Id("idx1").Op("<").Id("idx2")).Block(
-			jen.Return(jen.True()),
+			jen.Return(jen.Lit(-1)),
 		).Else().If(jen.Id("idx1").Op(">").Id("idx2")).Block(
-			jen.Return(jen.False()),
+			jen.Return(jen.Lit(1)),
 		))
 	for i, kind := range p.kinds {
-		lessCode.Add(
+		cmpCode.Add(
 			jen.Else().If(
 				jen.Id(codegen.This()).Dot(p.isMethodName(i)).Call(),
 			).Block(
-				jen.Return(kind.lessFnCode(jen.Id(codegen.This()).Dot(p.getFnName(i)).Call(), jen.Id("o").Dot(p.getFnName(i)).Call()))))
+				kind.compareFnCode(jen.Id(codegen.This()).Dot(p.getFnName(i)).Call(), jen.Id("o").Dot(p.getFnName(i)).Call())...))
 	}
 	if !p.hasURIKind() {
-		lessCode.Add(
+		cmpCode.Add(
 			jen.Else().If(
 				jen.Id(codegen.This()).Dot(isIRIMethod).Call(),
 			).Block(
 				jen.Return(
-					jen.Id(codegen.This()).Dot(iriMember).Dot("String").Call().Op("<").Id("o").Dot(getIRIMethod).Call().Dot("String").Call(),
+					jen.Qual("strings", "Compare").Call(
+						jen.Id(codegen.This()).Dot(iriMember).Dot("String").Call(),
+						jen.Id("o").Dot(getIRIMethod).Call().Dot("String").Call(),
+					),
 				),
 			))
 	}
 	methods = append(methods, codegen.NewCommentedValueMethod(
 		p.GetPrivatePackage().Path(),
-		compareLessMethod,
+		compareMethod,
 		p.StructName(),
 		[]jen.Code{jen.Id("o").Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
-		[]jen.Code{jen.Bool()},
+		[]jen.Code{jen.Int()},
 		[]jen.Code{
-			lessCode,
-			jen.Return(jen.False()),
+			cmpCode,
+			jen.Return(jen.Lit(0)),
 		},
-		fmt.Sprintf("%s compares two instances of this property with an arbitrary but stable comparison. Applications should not use this because it is only meant to help alternative implementations to go-fed to be able to normalize nonfunctional properties.", compareLessMethod),
+		p.compareComment(),
 	))
 	return methods
 }
@@ -1065,7 +1130,7 @@ func (p *FunctionalPropertyGenerator) wrapDeserializeCode(valueExisting, typeExi
 			jen.Commentf("If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst"),
 			jen.Commentf("Also, if no scheme exists, don't treat it as a URL -- net/url is greedy"),
 			jen.If(jen.Err().Op("==").Nil().Op("&&").Len(jen.Id("u").Dot("Scheme")).Op(">").Lit(0)).Block(
-				jen.Id(codegen.This()).Op(":=").Op("&").Id(p.StructName()).Values(
+				p.newThisCode(
 					jen.Dict{
 						jen.Id(iriMember):   jen.Id("u"),
 						jen.Id(aliasMember): jen.Id("alias"),
@@ -1093,7 +1158,7 @@ func (p *FunctionalPropertyGenerator) wrapDeserializeCode(valueExisting, typeExi
 		iriCode = iriCode.Add(valueExisting).Line()
 	}
 	iriCode = iriCode.Add(
-		jen.Id(codegen.This()).Op(":=").Op("&").Id(p.StructName()).Values(
+		p.newThisCode(
 			jen.Dict{
 				jen.Id(unknownMemberName): jen.Id("i"),
 				jen.Id(aliasMember):       jen.Id("alias"),
@@ -1108,56 +1173,108 @@ func (p *FunctionalPropertyGenerator) wrapDeserializeCode(valueExisting, typeExi
 	return iriCode
 }
 
+// lessMethod returns the LessThan method for this functional property, which
+// is based on its Compare method.
+func (p *FunctionalPropertyGenerator) lessMethod() *codegen.Method {
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		compareLessMethod,
+		p.StructName(),
+		[]jen.Code{jen.Id("o").Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
+		[]jen.Code{jen.Bool()},
+		[]jen.Code{
+			jen.Return(jen.Id(codegen.This()).Dot(compareMethod).Call(jen.Id("o")).Op("<").Lit(0)),
+		},
+		fmt.Sprintf("%s compares two instances of this property with an arbitrary but stable comparison. Applications should not use this because it is only meant to help alternative implementations to go-fed to be able to normalize nonfunctional properties.", compareLessMethod))
+}
+
+// compareComment returns the comment of the Compare method for this functional
+// property.
+func (p *FunctionalPropertyGenerator) compareComment() string {
+	return fmt.Sprintf("%s compares two instances of this property with an arbitrary but stable comparison, returning a negative number if this is lesser, a positive number if this is greater, and 0 if neither is. Applications should not use this because it is only meant to help alternative implementations to go-fed to be able to normalize nonfunctional properties.", compareMethod)
+}
+
 // contextMethod returns the Context method for this functional property.
 func (p *FunctionalPropertyGenerator) contextMethod() *codegen.Method {
-	contextKind := jen.Var().Id("child").Map(jen.String()).String().Line()
-	hasIfStarted := false
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		contextMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Map(jen.String()).String()},
+		[]jen.Code{
+			jen.Id("m").Op(":=").Make(jen.Map(jen.String()).String()),
+			jen.Id(codegen.This()).Dot(addContextMethod).Call(jen.Id("m")),
+			jen.Return(jen.Id("m")),
+		},
+		fmt.Sprintf("%s returns the JSONLD URIs required in the context string for this property and the specific values that are set. The value in the map is the alias used to import the property's value or values.", contextMethod))
+}
+
+// addContextMethod returns the AddJSONLDContext method for this functional
+// property, which is not part of its interface.
+func (p *FunctionalPropertyGenerator) addContextMethod() *codegen.Method {
+	var body []jen.Code
+	if p.vocabURI != nil {
+		body = append(body, jen.Id("m").Index(jen.Lit(p.vocabURI.String())).Op("=").Id(codegen.This()).Dot(aliasMember))
+	}
+	var contextKind *jen.Statement
 	for i, kind := range p.kinds {
 		// Skip raw values, as only types and any of their properties
 		// will need to have LD context strings added.
 		if kind.isValue() {
 			continue
 		}
-		if hasIfStarted {
+		if contextKind != nil {
 			contextKind = contextKind.Else()
 		} else {
-			hasIfStarted = true
+			contextKind = jen.Empty()
 		}
 		contextKind.Add(
 			jen.If(
 				jen.Id(codegen.This()).Dot(p.isMethodName(i)).Call(),
 			).Block(
-				jen.Id("child").Op("=").Id(codegen.This()).Dot(p.getFnName(i)).Call().Dot(contextMethod).Call()))
+				addContextCode(jen.Id(codegen.This()).Dot(p.memberName(i)))))
 	}
-	mDef := jen.Var().Id("m").Map(jen.String()).String()
-	if p.vocabURI != nil {
-		mDef = jen.Id("m").Op(":=").Map(jen.String()).String().Values(
-			jen.Dict{
-				jen.Lit(p.vocabURI.String()): jen.Id(codegen.This()).Dot(aliasMember),
-			},
-		)
+	if contextKind != nil {
+		body = append(body, contextKind)
 	}
 	return codegen.NewCommentedValueMethod(
 		p.GetPrivatePackage().Path(),
-		contextMethod,
+		addContextMethod,
+		p.StructName(),
+		[]jen.Code{jen.Id("m").Map(jen.String()).String()},
+		/*ret=*/ nil,
+		body,
+		fmt.Sprintf("%s adds the JSONLD URIs required in the context string for this property and the specific values that are set to the map, along with the aliases used to import them. Unlike %s, it does not create a map for the property and its value.", addContextMethod, contextMethod))
+}
+
+// releaseMethod returns the Release method, returning this property to its
+// pool after releasing the type it holds.
+func (p *FunctionalPropertyGenerator) releaseMethod() *codegen.Method {
+	var body []jen.Code
+	for i, kind := range p.kinds {
+		if kind.isValue() {
+			continue
+		}
+		body = append(body, releaseCode(jen.Id(codegen.This()).Dot(p.memberName(i))))
+	}
+	body = append(body,
+		jen.Op("*").Id(codegen.This()).Op("=").Id(p.StructName()).Values(),
+		jen.Id(poolName(p.StructName())).Dot("Put").Call(jen.Id(codegen.This())),
+	)
+	return codegen.NewCommentedPointerMethod(
+		p.GetPrivatePackage().Path(),
+		releaseMethod,
 		p.StructName(),
 		/*params=*/ nil,
-		[]jen.Code{jen.Map(jen.String()).String()},
-		[]jen.Code{
-			mDef,
-			contextKind,
-			jen.Commentf("Since the literal maps in this function are determined at\ncode-generation time, this loop should not overwrite an existing key with a\nnew value."),
-			jen.For(
-				jen.List(
-					jen.Id("k"),
-					jen.Id("v"),
-				).Op(":=").Range().Id("child"),
-			).Block(
-				jen.Id("m").Index(jen.Id("k")).Op("=").Id("v"),
-			),
-			jen.Return(jen.Id("m")),
-		},
-		fmt.Sprintf("%s returns the JSONLD URIs required in the context string for this property and the specific values that are set. The value in the map is the alias used to import the property's value or values.", contextMethod))
+		/*ret=*/ nil,
+		body,
+		fmt.Sprintf("%s returns this property and any type it holds to the pools from which they are reused when deserializing. Neither may be used afterwards, so it is only called by applications that are done with a deserialized value, to reduce the allocations of deserializing the next one.", releaseMethod))
+}
+
+// PoolDefinition returns the pool of released values of this property.
+func (p *FunctionalPropertyGenerator) PoolDefinition() *jen.Statement {
+	return poolDefinition(p.StructName())
 }
 
 // thisIRI returns the statement to access this IRI -- it may be an xsd:anyURI
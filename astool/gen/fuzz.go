@@ -0,0 +1,119 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+)
+
+const (
+	// FuzzBuildTag is the build tag go-fuzz builds with, which the fuzz
+	// targets require so they are left out of regular builds.
+	FuzzBuildTag    = "gofuzz"
+	fuzzTargetName  = "Fuzz%s"
+	fuzzInputFnName = "fuzzInput"
+)
+
+// FuzzGenerator generates go-fuzz targets for every deserialization function
+// of the Manager, and for resolving a JSON map into a Type, so that malformed
+// federated input causing a panic is found by fuzzing.
+type FuzzGenerator struct {
+	pkg Package
+	m   *ManagerGenerator
+}
+
+// NewFuzzGenerator creates a new generator for the fuzz targets of the
+// Manager's deserialization functions, in the root package pkg.
+//
+// Must be constructed after the ManagerGenerator.
+func NewFuzzGenerator(pkg Package, m *ManagerGenerator) *FuzzGenerator {
+	return &FuzzGenerator{
+		pkg: pkg,
+		m:   m,
+	}
+}
+
+// Definition returns the fuzz targets and the function they share to parse
+// their input.
+//
+// Each target accepts arbitrary data, deserializes it when it is a JSON
+// object, and serializes the result again. It returns 1 if the data was
+// deserialized, so the fuzzer prefers such inputs, and 0 otherwise.
+func (g *FuzzGenerator) Definition() []*codegen.Function {
+	fns := []*codegen.Function{
+		codegen.NewCommentedFunction(
+			g.pkg.Path(),
+			fuzzInputFnName,
+			[]jen.Code{jen.Id("data").Index().Byte()},
+			[]jen.Code{
+				jen.Id("m").Map(jen.String()).Interface(),
+				jen.Id("aliasMap").Map(jen.String()).String(),
+				jen.Id("ok").Bool(),
+			},
+			[]jen.Code{
+				jen.If(
+					jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("m")),
+					jen.Err().Op("!=").Nil(),
+				).Block(jen.Return(jen.Nil(), jen.Nil(), jen.False())),
+				jen.Return(jen.Id("m"), jen.Id(toAliasMapFnName).Call(jen.Id("m").Index(jen.Lit(contextJSONLDName))), jen.True()),
+			},
+			fmt.Sprintf("%s unmarshals the data to fuzz into a JSON object and the aliases of its JSON-LD context. It returns false if the data is not a JSON object.", fuzzInputFnName)),
+		g.target(
+			fmt.Sprintf("To%s", typeInterfaceName),
+			fmt.Sprintf("the To%s function", typeInterfaceName),
+			/*aliases=*/ false,
+			jen.List(jen.Id("v"), jen.Err()).Op(":=").Id(fmt.Sprintf("To%s", typeInterfaceName)).Call(
+				jen.Qual("context", "Background").Call(),
+				jen.Id("m"),
+			)),
+	}
+	var methods []*codegen.Method
+	for _, t := range g.m.tg {
+		methods = append(methods, g.m.getDeserializationMethodForType(t))
+	}
+	for _, p := range g.m.fp {
+		methods = append(methods, g.m.getDeserializationMethodForProperty(p))
+	}
+	for _, p := range g.m.nfp {
+		methods = append(methods, g.m.getDeserializationMethodForProperty(p))
+	}
+	for _, m := range methods {
+		fns = append(fns, g.target(
+			m.Name(),
+			fmt.Sprintf("the %s method of the %s", m.Name(), managerName),
+			/*aliases=*/ true,
+			jen.List(jen.Id("v"), jen.Err()).Op(":=").Id(managerInitName()).Dot(m.Name()).Call().Call(
+				jen.Id("m"),
+				jen.Id("aliasMap"),
+			)))
+	}
+	return fns
+}
+
+// target returns the fuzz target of a deserialization, which must assign the
+// value "v" and the error "err" from the JSON map "m" and, if it uses aliases,
+// the aliases "aliasMap".
+func (g *FuzzGenerator) target(name, of string, aliases bool, deserialize jen.Code) *codegen.Function {
+	fn := fmt.Sprintf(fuzzTargetName, name)
+	aliasMap := jen.Id("_")
+	if aliases {
+		aliasMap = jen.Id("aliasMap")
+	}
+	return codegen.NewCommentedFunction(
+		g.pkg.Path(),
+		fn,
+		[]jen.Code{jen.Id("data").Index().Byte()},
+		[]jen.Code{jen.Int()},
+		[]jen.Code{
+			jen.List(jen.Id("m"), aliasMap, jen.Id("ok")).Op(":=").Id(fuzzInputFnName).Call(jen.Id("data")),
+			jen.If(jen.Op("!").Id("ok")).Block(jen.Return(jen.Lit(0))),
+			deserialize,
+			jen.If(jen.Err().Op("!=").Nil().Op("||").Id("v").Op("==").Nil()).Block(jen.Return(jen.Lit(0))),
+			jen.If(
+				jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("v").Dot(serializeMethodName).Call(),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return(jen.Lit(0))),
+			jen.Return(jen.Lit(1)),
+		},
+		fmt.Sprintf("%s is a go-fuzz target for %s. It returns 1 if the data deserializes, and 0 otherwise.", fn, of))
+}
@@ -0,0 +1,485 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+	"sort"
+	"strings"
+)
+
+const (
+	graphQLSchemaConstName      = "Schema"
+	graphQLDatabaseName         = "Database"
+	graphQLNodeStructName       = "Node"
+	graphQLResolverStructName   = "Resolver"
+	graphQLNodeMethod           = "Node"
+	graphQLFieldMethod          = "Field"
+	graphQLToNodeMethod         = "toNode"
+	graphQLToTypeNameFn         = "toTypeName"
+	graphQLTypeNamesVar         = "typeNames"
+	graphQLObjectFieldsVar      = "objectFields"
+	graphQLListFieldsVar        = "listFields"
+	graphQLDbMember             = "db"
+	graphQLNodeInterface        = "Node"
+	graphQLIDScalar             = "ID"
+	graphQLStringScalar         = "String"
+	graphQLBooleanScalar        = "Boolean"
+	graphQLFloatScalar          = "Float"
+	graphQLIntScalar            = "Int"
+	graphQLDateTimeScalar       = "DateTime"
+	graphQLJSONScalar           = "JSON"
+	graphQLNaturalLanguageMapFn = "%sMap"
+)
+
+// GraphQLGenerator generates a GraphQL schema mirroring the ActivityStreams
+// types, and the resolvers that back it with a database.
+type GraphQLGenerator struct {
+	pkg   Package
+	types []*TypeGenerator
+	// Computed from the types.
+	typeNames    map[string]string
+	objectFields map[string]bool
+	listFields   map[string]bool
+}
+
+// NewGraphQLGenerator creates a new generator for the GraphQL schema and its
+// resolvers.
+//
+// Must be constructed after all TypeGenerators.
+func NewGraphQLGenerator(tgs []*TypeGenerator, pkg Package) *GraphQLGenerator {
+	g := &GraphQLGenerator{
+		pkg:          pkg,
+		types:        tgs,
+		typeNames:    make(map[string]string, len(tgs)),
+		objectFields: make(map[string]bool),
+		listFields:   make(map[string]bool),
+	}
+	for _, t := range tgs {
+		// The first vocabulary to define a type name wins; the types
+		// are already in a stable order.
+		if _, has := g.typeNames[t.TypeName()]; !has {
+			g.typeNames[t.TypeName()] = g.graphQLTypeName(t)
+		}
+		for _, p := range t.allProperties() {
+			_, isList := p.(*NonFunctionalPropertyGenerator)
+			if isList {
+				g.listFields[p.PropertyName()] = true
+			}
+			if g.fieldScalar(p) == graphQLNodeInterface {
+				g.objectFields[p.PropertyName()] = true
+			}
+		}
+	}
+	return g
+}
+
+// graphQLTypeName is the name of the type in the GraphQL schema.
+func (g *GraphQLGenerator) graphQLTypeName(t *TypeGenerator) string {
+	return fmt.Sprintf("%s%s", t.VocabName(), t.TypeName())
+}
+
+// fieldScalar determines the named GraphQL type of a property's values.
+//
+// Properties whose values can only be ActivityStreams types are a Node, as are
+// their IRIs once dereferenced. Properties whose values are all the same kind
+// of value are mapped to the closest GraphQL scalar. Everything else, such as
+// properties that can be either a type or a value, is left as JSON.
+func (g *GraphQLGenerator) fieldScalar(p Property) string {
+	if p.VocabName() == JSONLDVocabName && p.PropertyName() == JSONLDIdName {
+		return graphQLIDScalar
+	} else if p.VocabName() == JSONLDVocabName && p.PropertyName() == JSONLDTypeName {
+		return graphQLStringScalar
+	}
+	scalar := ""
+	for _, k := range propertyKinds(p) {
+		s := graphQLNodeInterface
+		if k.isValue() {
+			s = valueScalar(k)
+		}
+		if len(scalar) == 0 {
+			scalar = s
+		} else if scalar != s {
+			return graphQLJSONScalar
+		}
+	}
+	if len(scalar) == 0 {
+		return graphQLJSONScalar
+	}
+	return scalar
+}
+
+// valueScalar maps a value Kind to a GraphQL scalar.
+func valueScalar(k Kind) string {
+	switch k.Name.LowerName {
+	case "boolean":
+		return graphQLBooleanScalar
+	case "float":
+		return graphQLFloatScalar
+	case "nonNegativeInteger":
+		return graphQLIntScalar
+	case "dateTime":
+		return graphQLDateTimeScalar
+	case langStringName:
+		return graphQLJSONScalar
+	default:
+		return graphQLStringScalar
+	}
+}
+
+// fieldType determines the full GraphQL type of a property, wrapping it in a
+// list if it is non-functional.
+func (g *GraphQLGenerator) fieldType(p Property) string {
+	s := g.fieldScalar(p)
+	if g.listFields[p.PropertyName()] {
+		return fmt.Sprintf("[%s!]", s)
+	}
+	return s
+}
+
+// Schema returns the GraphQL schema definition language document.
+func (g *GraphQLGenerator) Schema() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("scalar %s\n\n", graphQLDateTimeScalar))
+	b.WriteString(fmt.Sprintf("scalar %s\n\n", graphQLJSONScalar))
+	b.WriteString(fmt.Sprintf("interface %s {\n", graphQLNodeInterface))
+	b.WriteString(fmt.Sprintf("  %s: %s\n", JSONLDIdName, graphQLIDScalar))
+	b.WriteString(fmt.Sprintf("  %s: [%s!]\n", JSONLDTypeName, graphQLStringScalar))
+	b.WriteString("}\n\n")
+	b.WriteString("type Query {\n")
+	b.WriteString(fmt.Sprintf("  node(%s: %s!): %s\n", JSONLDIdName, graphQLIDScalar, graphQLNodeInterface))
+	b.WriteString("}\n")
+	for _, t := range g.types {
+		b.WriteString(fmt.Sprintf("\ntype %s implements %s {\n", g.graphQLTypeName(t), graphQLNodeInterface))
+		fields := make(map[string]string)
+		for _, p := range t.allProperties() {
+			fields[p.PropertyName()] = g.fieldType(p)
+			if p.HasNaturalLanguageMap() {
+				fields[fmt.Sprintf(graphQLNaturalLanguageMapFn, p.PropertyName())] = graphQLJSONScalar
+			}
+		}
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			b.WriteString(fmt.Sprintf("  %s: %s\n", name, fields[name]))
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+// Definition returns the Go code for the schema, the resolvers, and the
+// lookup tables they use.
+func (g *GraphQLGenerator) Definition() (schema jen.Code, db *codegen.Interface, node, resolver *codegen.Struct, fns []*codegen.Function, vars []jen.Code) {
+	schema = jen.Comment(codegen.FormatPackageDocumentation(fmt.Sprintf(
+		"%s is the GraphQL schema definition language document describing "+
+			"every ActivityStreams type known to this package. Each type "+
+			"implements the %s interface, and the %s query fetches any "+
+			"value by its id. Custom scalars must be supplied by the "+
+			"GraphQL server: %s is an xsd:dateTime string, and %s is any "+
+			"JSON-LD value.",
+		graphQLSchemaConstName,
+		graphQLNodeInterface,
+		"node",
+		graphQLDateTimeScalar,
+		graphQLJSONScalar,
+	))).Line().Const().Id(graphQLSchemaConstName).Op("=").Op("`" + g.Schema() + "`")
+	db = g.database()
+	node = g.node()
+	resolver = g.resolver()
+	fns = []*codegen.Function{g.toTypeNameFn()}
+	vars = g.vars()
+	return
+}
+
+// vocabPath returns the path to the package containing the vocab.Type
+// interface.
+func (g *GraphQLGenerator) vocabPath() string {
+	return g.types[0].PublicPackage().Path()
+}
+
+// database returns the definition of the Database interface.
+func (g *GraphQLGenerator) database() *codegen.Interface {
+	return codegen.NewInterface(
+		g.pkg.Path(),
+		graphQLDatabaseName,
+		[]codegen.FunctionSignature{
+			{
+				Name: "Get",
+				Params: []jen.Code{
+					jen.Id("c").Qual("context", "Context"),
+					jen.Id("id").Op("*").Qual("net/url", "URL"),
+				},
+				Ret: []jen.Code{
+					jen.Id("value").Qual(g.vocabPath(), typeInterfaceName),
+					jen.Err().Error(),
+				},
+				Comment: "Get returns the ActivityStreams value with the specified id.",
+			},
+		},
+		fmt.Sprintf("%s is the part of an application's database that is "+
+			"needed to resolve ActivityStreams values by their ids. "+
+			"The go-fed pub.Database satisfies this interface.",
+			graphQLDatabaseName))
+}
+
+// node returns the definition of the Node struct.
+func (g *GraphQLGenerator) node() *codegen.Struct {
+	return codegen.NewStruct(
+		fmt.Sprintf("%s is a resolved ActivityStreams value. Its fields are "+
+			"resolved with a %s.", graphQLNodeStructName, graphQLResolverStructName),
+		graphQLNodeStructName,
+		nil,
+		nil,
+		[]jen.Code{
+			jen.Comment("TypeName is the name of the value's type in the Schema. It is empty").Line().Comment("when the value's type is not known to this package.").Line().Id("TypeName").String(),
+			jen.Comment("Value is the JSON-LD serialization of the value.").Line().Id("Value").Map(jen.String()).Interface(),
+		})
+}
+
+// resolver returns the definition of the Resolver struct and its methods.
+func (g *GraphQLGenerator) resolver() *codegen.Struct {
+	return codegen.NewStruct(
+		fmt.Sprintf("%s resolves the query and fields of the Schema, "+
+			"dereferencing ids using a %s. Applications adapt it to "+
+			"the GraphQL server of their choice, instead of writing a "+
+			"resolver for every field of every type.",
+			graphQLResolverStructName,
+			graphQLDatabaseName),
+		graphQLResolverStructName,
+		[]*codegen.Method{
+			g.nodeMethod(),
+			g.fieldMethod(),
+			g.toNodeMethod(),
+		},
+		[]*codegen.Function{
+			codegen.NewCommentedFunction(
+				g.pkg.Path(),
+				fmt.Sprintf("%s%s", constructorName, graphQLResolverStructName),
+				[]jen.Code{jen.Id("db").Qual(g.pkg.Path(), graphQLDatabaseName)},
+				[]jen.Code{jen.Op("*").Qual(g.pkg.Path(), graphQLResolverStructName)},
+				[]jen.Code{
+					jen.Return(
+						jen.Op("&").Qual(g.pkg.Path(), graphQLResolverStructName).Values(
+							jen.Dict{
+								jen.Id(graphQLDbMember): jen.Id("db"),
+							},
+						),
+					),
+				},
+				fmt.Sprintf("%s%s creates a %s that fetches values from the %s.", constructorName, graphQLResolverStructName, graphQLResolverStructName, graphQLDatabaseName)),
+		},
+		[]jen.Code{
+			jen.Id(graphQLDbMember).Qual(g.pkg.Path(), graphQLDatabaseName),
+		})
+}
+
+// nodeMethod returns the method resolving the 'node' query.
+func (g *GraphQLGenerator) nodeMethod() *codegen.Method {
+	return codegen.NewCommentedPointerMethod(
+		g.pkg.Path(),
+		graphQLNodeMethod,
+		graphQLResolverStructName,
+		[]jen.Code{
+			jen.Id("c").Qual("context", "Context"),
+			jen.Id("id").String(),
+		},
+		[]jen.Code{
+			jen.Id("n").Op("*").Qual(g.pkg.Path(), graphQLNodeStructName),
+			jen.Err().Error(),
+		},
+		[]jen.Code{
+			jen.Var().Id("u").Op("*").Qual("net/url", "URL"),
+			jen.If(
+				jen.List(jen.Id("u"), jen.Err()).Op("=").Qual("net/url", "Parse").Call(jen.Id("id")),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return()),
+			jen.Var().Id("t").Qual(g.vocabPath(), typeInterfaceName),
+			jen.If(
+				jen.List(jen.Id("t"), jen.Err()).Op("=").Id(codegen.This()).Dot(graphQLDbMember).Dot("Get").Call(jen.Id("c"), jen.Id("u")),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return()),
+			jen.Var().Id("m").Map(jen.String()).Interface(),
+			jen.If(
+				jen.List(jen.Id("m"), jen.Err()).Op("=").Id("t").Dot(serializeMethod).Call(),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return()),
+			jen.Id("n").Op("=").Op("&").Qual(g.pkg.Path(), graphQLNodeStructName).Values(
+				jen.Dict{
+					jen.Id("TypeName"): jen.Id(graphQLTypeNamesVar).Index(jen.Id("t").Dot(typeNameMethod).Call()),
+					jen.Id("Value"):    jen.Id("m"),
+				},
+			),
+			jen.Return(),
+		},
+		fmt.Sprintf("%s resolves the 'node' query by fetching the value with the given id from the %s.", graphQLNodeMethod, graphQLDatabaseName))
+}
+
+// fieldMethod returns the method resolving a field of a Node.
+func (g *GraphQLGenerator) fieldMethod() *codegen.Method {
+	return codegen.NewCommentedPointerMethod(
+		g.pkg.Path(),
+		graphQLFieldMethod,
+		graphQLResolverStructName,
+		[]jen.Code{
+			jen.Id("c").Qual("context", "Context"),
+			jen.Id("n").Op("*").Qual(g.pkg.Path(), graphQLNodeStructName),
+			jen.Id("field").String(),
+		},
+		[]jen.Code{
+			jen.Id("v").Interface(),
+			jen.Err().Error(),
+		},
+		[]jen.Code{
+			jen.List(jen.Id("raw"), jen.Id("ok")).Op(":=").Id("n").Dot("Value").Index(jen.Id("field")),
+			jen.If(jen.Op("!").Id("ok")).Block(jen.Return()),
+			jen.If(jen.Op("!").Id(graphQLListFieldsVar).Index(jen.Id("field"))).Block(
+				jen.If(jen.Id(graphQLObjectFieldsVar).Index(jen.Id("field"))).Block(
+					jen.Return(jen.Id(codegen.This()).Dot(graphQLToNodeMethod).Call(jen.Id("c"), jen.Id("raw"))),
+				),
+				jen.Return(jen.Id("raw"), jen.Nil()),
+			),
+			jen.List(jen.Id("elems"), jen.Id("ok")).Op(":=").Id("raw").Assert(jen.Index().Interface()),
+			jen.If(jen.Op("!").Id("ok")).Block(
+				jen.Id("elems").Op("=").Index().Interface().Values(jen.Id("raw")),
+			),
+			jen.If(jen.Op("!").Id(graphQLObjectFieldsVar).Index(jen.Id("field"))).Block(
+				jen.Return(jen.Id("elems"), jen.Nil()),
+			),
+			jen.Id("nodes").Op(":=").Make(jen.Index().Op("*").Qual(g.pkg.Path(), graphQLNodeStructName), jen.Lit(0), jen.Len(jen.Id("elems"))),
+			jen.For(jen.List(jen.Id("_"), jen.Id("elem")).Op(":=").Range().Id("elems")).Block(
+				jen.Var().Id("node").Op("*").Qual(g.pkg.Path(), graphQLNodeStructName),
+				jen.If(
+					jen.List(jen.Id("node"), jen.Err()).Op("=").Id(codegen.This()).Dot(graphQLToNodeMethod).Call(jen.Id("c"), jen.Id("elem")),
+					jen.Err().Op("!=").Nil(),
+				).Block(jen.Return()),
+				jen.Id("nodes").Op("=").Append(jen.Id("nodes"), jen.Id("node")),
+			),
+			jen.Return(jen.Id("nodes"), jen.Nil()),
+		},
+		fmt.Sprintf("%s resolves a field of a %s. Fields whose values are ActivityStreams types resolve to a *%s, or a []*%s for non-functional properties, with any ids being dereferenced using the %s. All other fields resolve to their JSON-LD value, which is always a slice for non-functional properties. Absent fields resolve to nil.", graphQLFieldMethod, graphQLNodeStructName, graphQLNodeStructName, graphQLNodeStructName, graphQLDatabaseName))
+}
+
+// toNodeMethod returns the method converting a JSON-LD value into a Node.
+func (g *GraphQLGenerator) toNodeMethod() *codegen.Method {
+	return codegen.NewCommentedPointerMethod(
+		g.pkg.Path(),
+		graphQLToNodeMethod,
+		graphQLResolverStructName,
+		[]jen.Code{
+			jen.Id("c").Qual("context", "Context"),
+			jen.Id("v").Interface(),
+		},
+		[]jen.Code{
+			jen.Op("*").Qual(g.pkg.Path(), graphQLNodeStructName),
+			jen.Error(),
+		},
+		[]jen.Code{
+			jen.Switch(jen.Id("t").Op(":=").Id("v").Assert(jen.Type())).Block(
+				jen.Case(jen.String()).Block(
+					jen.Return(jen.Id(codegen.This()).Dot(graphQLNodeMethod).Call(jen.Id("c"), jen.Id("t"))),
+				),
+				jen.Case(jen.Map(jen.String()).Interface()).Block(
+					jen.Return(
+						jen.Op("&").Qual(g.pkg.Path(), graphQLNodeStructName).Values(
+							jen.Dict{
+								jen.Id("TypeName"): jen.Id(graphQLToTypeNameFn).Call(jen.Id("t").Index(jen.Lit(JSONLDTypeName))),
+								jen.Id("Value"):    jen.Id("t"),
+							},
+						),
+						jen.Nil(),
+					),
+				),
+				jen.Default().Block(
+					jen.Return(
+						jen.Nil(),
+						jen.Qual("fmt", "Errorf").Call(jen.Lit("cannot resolve a node from a value of type %T"), jen.Id("v")),
+					),
+				),
+			),
+		},
+		fmt.Sprintf("%s converts a JSON-LD value into a %s, dereferencing it if it is an id.", graphQLToNodeMethod, graphQLNodeStructName))
+}
+
+// toTypeNameFn returns the function determining the Schema type name from a
+// JSON-LD 'type' value.
+func (g *GraphQLGenerator) toTypeNameFn() *codegen.Function {
+	return codegen.NewCommentedFunction(
+		g.pkg.Path(),
+		graphQLToTypeNameFn,
+		[]jen.Code{
+			jen.Id("v").Interface(),
+		},
+		[]jen.Code{
+			jen.String(),
+		},
+		[]jen.Code{
+			jen.List(jen.Id("names"), jen.Id("ok")).Op(":=").Id("v").Assert(jen.Index().Interface()),
+			jen.If(jen.Op("!").Id("ok")).Block(
+				jen.Id("names").Op("=").Index().Interface().Values(jen.Id("v")),
+			),
+			jen.For(jen.List(jen.Id("_"), jen.Id("name")).Op(":=").Range().Id("names")).Block(
+				jen.List(jen.Id("s"), jen.Id("ok")).Op(":=").Id("name").Assert(jen.String()),
+				jen.If(jen.Op("!").Id("ok")).Block(jen.Continue()),
+				jen.Comment("Remove any alias or IRI prefix."),
+				jen.If(
+					jen.Id("i").Op(":=").Qual("strings", "LastIndexAny").Call(jen.Id("s"), jen.Lit(":/#")),
+					jen.Id("i").Op(">=").Lit(0),
+				).Block(
+					jen.Id("s").Op("=").Id("s").Index(jen.Id("i").Op("+").Lit(1), jen.Empty()),
+				),
+				jen.If(
+					jen.List(jen.Id("n"), jen.Id("ok")).Op(":=").Id(graphQLTypeNamesVar).Index(jen.Id("s")),
+					jen.Id("ok"),
+				).Block(jen.Return(jen.Id("n"))),
+			),
+			jen.Return(jen.Lit("")),
+		},
+		fmt.Sprintf("%s determines the name of the type in the Schema for a JSON-LD 'type' value. Returns an empty string if no type is known.", graphQLToTypeNameFn))
+}
+
+// vars returns the lookup tables used by the resolver.
+func (g *GraphQLGenerator) vars() []jen.Code {
+	return []jen.Code{
+		jen.Commentf(
+			"%s maps the ActivityStreams type names to the names of the types in the Schema.",
+			graphQLTypeNamesVar,
+		).Line().Var().Id(graphQLTypeNamesVar).Op("=").Map(jen.String()).String().Values(
+			stringDict(g.typeNames),
+		),
+		jen.Commentf(
+			"%s are the fields whose values are resolved as a %s.",
+			graphQLObjectFieldsVar,
+			graphQLNodeStructName,
+		).Line().Var().Id(graphQLObjectFieldsVar).Op("=").Map(jen.String()).Bool().Values(
+			boolDict(g.objectFields),
+		),
+		jen.Commentf(
+			"%s are the fields of non-functional properties, whose values are always resolved as a slice.",
+			graphQLListFieldsVar,
+		).Line().Var().Id(graphQLListFieldsVar).Op("=").Map(jen.String()).Bool().Values(
+			boolDict(g.listFields),
+		),
+	}
+}
+
+// stringDict converts a map into a jen.Dict.
+func stringDict(m map[string]string) jen.Dict {
+	d := make(jen.Dict, len(m))
+	for k, v := range m {
+		d[jen.Lit(k)] = jen.Lit(v)
+	}
+	return d
+}
+
+// boolDict converts a set into a jen.Dict.
+func boolDict(m map[string]bool) jen.Dict {
+	d := make(jen.Dict, len(m))
+	for k, v := range m {
+		d[jen.Lit(k)] = jen.Lit(v)
+	}
+	return d
+}
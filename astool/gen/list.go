@@ -0,0 +1,148 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+)
+
+const (
+	listPackageName   = "proplist"
+	listTypeName      = "List"
+	listLinksName     = "Links"
+	listElementName   = "Element"
+	listLinksMethod   = "links"
+	listLinkMethod    = "Link"
+	listLinkOneMethod = "link"
+	listNextFn        = "Next"
+	listPrevFn        = "Prev"
+	listTypeParam     = "T"
+)
+
+// ListPackage returns the package of the List shared by the non-functional
+// properties generated beneath the root of the PackageManager.
+func ListPackage(pm *PackageManager) Package {
+	return NewPackageManager(pm.prefix, pm.root).SubPrivate(listPackageName).PrivatePackage()
+}
+
+// listComment returns a comment of the List package, wrapped like the comments
+// of the generated properties.
+func listComment(format string, a ...interface{}) *jen.Statement {
+	return jen.Comment(codegen.FormatPackageDocumentation(fmt.Sprintf(format, a...)))
+}
+
+// ListDefinition returns the generic List holding the iterators of a
+// non-functional property, and the Links each iterator embeds to find the
+// iterators before and after it.
+//
+// The Element constraint has an unexported method, which iterators only have by
+// embedding Links, so that the List alone changes the links.
+func ListDefinition() jen.Code {
+	t := jen.Id(listTypeParam)
+	constraint := func() *jen.Statement {
+		return jen.Id(listTypeParam).Id(listElementName).Index(jen.Id(listTypeParam))
+	}
+	list := func() *jen.Statement {
+		return jen.Id("l").Id(listTypeName).Index(jen.Id(listTypeParam))
+	}
+	pointerList := func() *jen.Statement {
+		return jen.Id("l").Op("*").Id(listTypeName).Index(jen.Id(listTypeParam))
+	}
+	zero := jen.Var().Id("zero").Add(t)
+	return join([]jen.Code{
+		listComment("%s is an element of a %s, which embeds %s.", listElementName, listTypeName, listLinksName).Line().
+			Type().Id(listElementName).Index(jen.Id(listTypeParam).Id("any")).Interface(
+			jen.Id(listLinksMethod).Params().Op("*").Id(listLinksName).Index(jen.Id(listTypeParam)),
+		),
+		listComment("%s are the elements before and after an element of a %s. They are embedded in the element, so that iterating from it remains correct when values are added to or removed from the %s.", listLinksName, listTypeName, listTypeName).Line().
+			Type().Id(listLinksName).Index(jen.Id(listTypeParam).Id("any")).Struct(
+			jen.Id("prev").Add(t),
+			jen.Id("next").Add(t),
+		),
+		listComment("%s returns the %s of an element.", listLinksMethod, listLinksName).Line().
+			Func().Params(jen.Id("l").Op("*").Id(listLinksName).Index(jen.Id(listTypeParam))).Id(listLinksMethod).Params().Op("*").Id(listLinksName).Index(jen.Id(listTypeParam)).Block(
+			jen.Return(jen.Id("l")),
+		),
+		listComment("%s returns the element after e in its %s, or the zero value if it is the last.", listNextFn, listTypeName).Line().
+			Func().Id(listNextFn).Index(constraint()).Params(jen.Id("e").Add(t)).Add(t).Block(
+			jen.Return(jen.Id("e").Dot(listLinksMethod).Call().Dot("next")),
+		),
+		listComment("%s returns the element before e in its %s, or the zero value if it is the first.", listPrevFn, listTypeName).Line().
+			Func().Id(listPrevFn).Index(constraint()).Params(jen.Id("e").Add(t)).Add(t).Block(
+			jen.Return(jen.Id("e").Dot(listLinksMethod).Call().Dot("prev")),
+		),
+		listComment("%s is the values of a non-functional property, in order. Elements are linked to the elements before and after them by the methods changing the %s.", listTypeName, listTypeName).Line().
+			Type().Id(listTypeName).Index(constraint()).Index().Add(t),
+		listComment("Len returns the number of elements.").Line().
+			Func().Params(list()).Id("Len").Params().Int().Block(
+			jen.Return(jen.Len(jen.Id("l"))),
+		),
+		listComment("Append adds the element to the back.").Line().
+			Func().Params(pointerList()).Id("Append").Params(jen.Id("v").Add(t)).Block(
+			jen.Op("*").Id("l").Op("=").Append(jen.Op("*").Id("l"), jen.Id("v")),
+			jen.Id("l").Dot(listLinkOneMethod).Call(jen.Len(jen.Op("*").Id("l")).Op("-").Lit(1)),
+		),
+		listComment("Prepend adds the element to the front.").Line().
+			Func().Params(pointerList()).Id("Prepend").Params(jen.Id("v").Add(t)).Block(
+			jen.Op("*").Id("l").Op("=").Append(jen.Id(listTypeName).Index(jen.Id(listTypeParam)).Values(jen.Id("v")), jen.Op("*").Id("l").Op("...")),
+			jen.Id("l").Dot(listLinkOneMethod).Call(jen.Lit(0)),
+		),
+		listComment("Insert adds the element at the index. Existing elements at that index and higher are shifted back once.").Line().
+			Func().Params(pointerList()).Id("Insert").Params(jen.Id("idx").Int(), jen.Id("v").Add(t)).Block(
+			zero,
+			jen.Op("*").Id("l").Op("=").Append(jen.Op("*").Id("l"), jen.Id("zero")),
+			jen.Copy(
+				jen.Parens(jen.Op("*").Id("l")).Index(jen.Id("idx").Op("+").Lit(1), jen.Empty()),
+				jen.Parens(jen.Op("*").Id("l")).Index(jen.Id("idx"), jen.Empty()),
+			),
+			jen.Parens(jen.Op("*").Id("l")).Index(jen.Id("idx")).Op("=").Id("v"),
+			jen.Id("l").Dot(listLinkOneMethod).Call(jen.Id("idx")),
+		),
+		listComment("Set replaces the element at the index. Panics if the index is out of bounds.").Line().
+			Func().Params(list()).Id("Set").Params(jen.Id("idx").Int(), jen.Id("v").Add(t)).Block(
+			jen.Id("l").Index(jen.Id("idx")).Op("=").Id("v"),
+			jen.Id("l").Dot(listLinkOneMethod).Call(jen.Id("idx")),
+		),
+		listComment("Remove deletes the element at the index. Panics if the index is out of bounds.").Line().
+			Func().Params(pointerList()).Id("Remove").Params(jen.Id("idx").Int()).Block(
+			zero,
+			jen.Copy(
+				jen.Parens(jen.Op("*").Id("l")).Index(jen.Id("idx"), jen.Empty()),
+				jen.Parens(jen.Op("*").Id("l")).Index(jen.Id("idx").Op("+").Lit(1), jen.Empty()),
+			),
+			jen.Parens(jen.Op("*").Id("l")).Index(jen.Len(jen.Op("*").Id("l")).Op("-").Lit(1)).Op("=").Id("zero"),
+			jen.Op("*").Id("l").Op("=").Parens(jen.Op("*").Id("l")).Index(jen.Empty(), jen.Len(jen.Op("*").Id("l")).Op("-").Lit(1)),
+			jen.If(jen.Id("idx").Op("<").Len(jen.Op("*").Id("l"))).Block(
+				jen.Id("l").Dot(listLinkOneMethod).Call(jen.Id("idx")),
+			).Else().If(jen.Id("idx").Op(">").Lit(0)).Block(
+				jen.Parens(jen.Op("*").Id("l")).Index(jen.Id("idx").Op("-").Lit(1)).Dot(listLinksMethod).Call().Dot("next").Op("=").Id("zero"),
+			),
+		),
+		listComment("Swap swaps the elements at two indices.").Line().
+			Func().Params(list()).Id("Swap").Params(jen.Id("i"), jen.Id("j").Int()).Block(
+			jen.List(jen.Id("l").Index(jen.Id("i")), jen.Id("l").Index(jen.Id("j"))).Op("=").List(jen.Id("l").Index(jen.Id("j")), jen.Id("l").Index(jen.Id("i"))),
+			jen.Id("l").Dot(listLinkOneMethod).Call(jen.Id("i")),
+			jen.Id("l").Dot(listLinkOneMethod).Call(jen.Id("j")),
+		),
+		listComment("%s connects every element with the elements before and after it, such as after they were appended without the methods of the %s.", listLinkMethod, listTypeName).Line().
+			Func().Params(list()).Id(listLinkMethod).Params().Block(
+			jen.For(jen.Id("idx").Op(":=").Range().Id("l")).Block(
+				jen.Id("l").Dot(listLinkOneMethod).Call(jen.Id("idx")),
+			),
+		),
+		listComment("%s connects the element at the index with the elements before and after it, after it was added or moved there. Other elements are left untouched, so that changing a value of a long list does not relink all of them.", listLinkOneMethod).Line().
+			Func().Params(list()).Id(listLinkOneMethod).Params(jen.Id("idx").Int()).Block(
+			zero,
+			jen.Id("it").Op(":=").Id("l").Index(jen.Id("idx")).Dot(listLinksMethod).Call(),
+			jen.List(jen.Id("it").Dot("prev"), jen.Id("it").Dot("next")).Op("=").List(jen.Id("zero"), jen.Id("zero")),
+			jen.If(jen.Id("idx").Op(">").Lit(0)).Block(
+				jen.Id("it").Dot("prev").Op("=").Id("l").Index(jen.Id("idx").Op("-").Lit(1)),
+				jen.Id("it").Dot("prev").Dot(listLinksMethod).Call().Dot("next").Op("=").Id("l").Index(jen.Id("idx")),
+			),
+			jen.If(jen.Id("idx").Op("+").Lit(1).Op("<").Len(jen.Id("l"))).Block(
+				jen.Id("it").Dot("next").Op("=").Id("l").Index(jen.Id("idx").Op("+").Lit(1)),
+				jen.Id("it").Dot("next").Dot(listLinksMethod).Call().Dot("prev").Op("=").Id("l").Index(jen.Id("idx")),
+			),
+		),
+	})
+}
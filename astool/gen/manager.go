@@ -7,8 +7,9 @@ import (
 )
 
 const (
-	managerName        = "Manager"
-	managerInitVarName = "mgr"
+	managerName          = "Manager"
+	managerInitVarName   = "mgr"
+	contextMethodPostfix = "Context"
 )
 
 // managerInitName returns the package variable name for the manager.
@@ -56,7 +57,8 @@ type ManagerGenerator struct {
 // managedMethods caches the specific methods and interfaces mapped to specific
 // properties and types.
 type managedMethods struct {
-	deserializor *codegen.Method
+	deserializor        *codegen.Method
+	contextDeserializor *codegen.Method
 }
 
 // NewManagerGenerator creates a new manager system.
@@ -79,18 +81,24 @@ func NewManagerGenerator(pkg Package,
 	// Pass 1: Get all deserializor-like methods created. Further passes may
 	// rely on already having this data available in the manager.
 	for _, t := range tg {
+		deser := mg.createDeserializationMethodForType(t)
 		mg.tgManagedMethods[t] = &managedMethods{
-			deserializor: mg.createDeserializationMethodForType(t),
+			deserializor:        deser,
+			contextDeserializor: mg.createContextDeserializationMethod(deser, t.PublicPackage(), t.InterfaceName(), t.VocabName()),
 		}
 	}
 	for _, p := range fp {
+		deser := mg.createDeserializationMethodForFuncProperty(p)
 		mg.fpManagedMethods[p] = &managedMethods{
-			deserializor: mg.createDeserializationMethodForFuncProperty(p),
+			deserializor:        deser,
+			contextDeserializor: mg.createContextDeserializationMethod(deser, p.GetPublicPackage(), p.InterfaceName(), p.VocabName()),
 		}
 	}
 	for _, p := range nfp {
+		deser := mg.createDeserializationMethodForNonFuncProperty(p)
 		mg.nfpManagedMethods[p] = &managedMethods{
-			deserializor: mg.createDeserializationMethodForNonFuncProperty(p),
+			deserializor:        deser,
+			contextDeserializor: mg.createContextDeserializationMethod(deser, p.GetPublicPackage(), p.InterfaceName(), p.VocabName()),
 		}
 	}
 	// Pass 2: Inform the type of this ManagerGenerator so that it can keep
@@ -109,6 +117,12 @@ func (m *ManagerGenerator) getDeserializationMethodForType(t *TypeGenerator) *co
 	return m.tgManagedMethods[t].deserializor
 }
 
+// getContextDeserializationMethodForType obtains the deserialization method
+// accepting a context for a type.
+func (m *ManagerGenerator) getContextDeserializationMethodForType(t *TypeGenerator) *codegen.Method {
+	return m.tgManagedMethods[t].contextDeserializor
+}
+
 // getDeserializationMethodForProperty obtains the deserialization method for a
 // property regardless whether it is functional or non-functional.
 func (m *ManagerGenerator) getDeserializationMethodForProperty(p Property) *codegen.Method {
@@ -136,14 +150,14 @@ func (m *ManagerGenerator) getDeserializationMethodForProperty(p Property) *code
 // desired) to minimize binary bloat.
 func (m *ManagerGenerator) Definition() *codegen.Struct {
 	var methods []*codegen.Method
-	for _, tg := range m.tgManagedMethods {
-		methods = append(methods, tg.deserializor)
+	for _, tg := range m.tg {
+		methods = append(methods, m.tgManagedMethods[tg].deserializor, m.tgManagedMethods[tg].contextDeserializor)
 	}
-	for _, fp := range m.fpManagedMethods {
-		methods = append(methods, fp.deserializor)
+	for _, fp := range m.fp {
+		methods = append(methods, m.fpManagedMethods[fp].deserializor, m.fpManagedMethods[fp].contextDeserializor)
 	}
-	for _, nfp := range m.nfpManagedMethods {
-		methods = append(methods, nfp.deserializor)
+	for _, nfp := range m.nfp {
+		methods = append(methods, m.nfpManagedMethods[nfp].deserializor, m.nfpManagedMethods[nfp].contextDeserializor)
 	}
 	s := codegen.NewStruct(
 		fmt.Sprintf("%s manages interface types and deserializations for use by generated code. Application code implicitly uses this manager at run-time to create concrete implementations of the interfaces.", managerName),
@@ -231,3 +245,48 @@ func (m *ManagerGenerator) createDeserializationMethod(deserName string, pubPkg,
 		},
 		fmt.Sprintf("%s returns the deserialization method for the %q non-functional property in the vocabulary %q", name, interfaceName, vocabName))
 }
+
+// createContextDeserializationMethod returns a method like the deserialization
+// method deser, whose function also accepts a context. The function returns the
+// context's error instead of deserializing once the context is done.
+func (m *ManagerGenerator) createContextDeserializationMethod(deser *codegen.Method, pubPkg Package, interfaceName, vocabName string) *codegen.Method {
+	name := deser.Name() + contextMethodPostfix
+	return codegen.NewCommentedValueMethod(
+		m.pkg.Path(),
+		name,
+		managerName,
+		/*param=*/ nil,
+		[]jen.Code{
+			jen.Func().Params(
+				jen.Qual("context", "Context"),
+				jen.Map(jen.String()).Interface(),
+				jen.Map(jen.String()).String(),
+			).Params(
+				jen.Qual(pubPkg.Path(), interfaceName),
+				jen.Error(),
+			),
+		},
+		[]jen.Code{
+			jen.Return(
+				jen.Func().Params(
+					jen.Id("ctx").Qual("context", "Context"),
+					jen.Id("m").Map(jen.String()).Interface(),
+					jen.Id("aliasMap").Map(jen.String()).String(),
+				).Params(
+					jen.Qual(pubPkg.Path(), interfaceName),
+					jen.Error(),
+				).Block(
+					jen.If(
+						jen.Err().Op(":=").Id("ctx").Dot("Err").Call(),
+						jen.Err().Op("!=").Nil(),
+					).Block(
+						jen.Return(jen.Nil(), jen.Err()),
+					),
+					jen.Return(
+						deser.On(codegen.This()).Call().Call(jen.Id("m"), jen.Id("aliasMap")),
+					),
+				),
+			),
+		},
+		fmt.Sprintf("%s returns the deserialization method for the %q type or property in the vocabulary %q, accepting a context. It returns the context's error without deserializing once the context is done.", name, interfaceName, vocabName))
+}
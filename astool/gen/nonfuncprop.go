@@ -10,13 +10,26 @@ import (
 )
 
 const (
-	propertiesName = "properties"
+	propertiesName           = "properties"
+	activityStreamsVocabName = "ActivityStreams"
 )
 
+// parallelProperties are the ActivityStreams properties holding the items of
+// collections, whose values may be numerous enough to deserialize in parallel.
+var parallelProperties = map[string]bool{
+	"items":        true,
+	"orderedItems": true,
+}
+
 // NonFunctionalPropertyGenerator produces Go code for properties that can have
 // more than one value. The resulting property is a type that is a list of
 // iterators; each iterator is a concrete struct type. The property can be
 // sorted and iterated over so individual elements can be inspected.
+//
+// The iterators are kept in the generic List of the ListPackage, which every
+// property shares to add, remove, and link its iterators. Methods such as At,
+// Begin, and the kind-specific Append and Prepend still return or build the
+// vocab interfaces, so they remain generated for each property.
 type NonFunctionalPropertyGenerator struct {
 	PropertyGenerator
 	cacheOnce    sync.Once
@@ -94,15 +107,74 @@ func (p *NonFunctionalPropertyGenerator) Definitions() (*codegen.Struct, *codege
 			methods,
 			funcs,
 			[]jen.Code{
-				jen.Id(propertiesName).Index().Op("*").Id(p.iteratorTypeName().CamelName),
+				jen.Id(propertiesName).Qual(ListPackage(p.packageManager).Path(), listTypeName).Index(jen.Op("*").Id(p.iteratorTypeName().CamelName)),
 				jen.Id(aliasMember).String(),
 			})
+		property.AddUnlistedMethods(p.addContextMethod(), p.releaseMethod())
 		iterator := p.elementTypeGenerator().Definition()
 		p.cachedIter, p.cachedStruct = iterator, property
 	})
 	return p.cachedIter, p.cachedStruct
 }
 
+// addContextMethod returns the AddJSONLDContext method for this non-functional
+// property, which is not part of its interface.
+func (p *NonFunctionalPropertyGenerator) addContextMethod() *codegen.Method {
+	var body []jen.Code
+	if p.vocabURI != nil {
+		body = append(body, jen.Id("m").Index(jen.Lit(p.vocabURI.String())).Op("=").Id(codegen.This()).Dot(aliasMember))
+	}
+	body = append(body, jen.For(
+		jen.List(
+			jen.Id("_"),
+			jen.Id("elem"),
+		).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
+	).Block(
+		jen.Id("elem").Dot(addContextMethod).Call(jen.Id("m")),
+	))
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		addContextMethod,
+		p.StructName(),
+		[]jen.Code{jen.Id("m").Map(jen.String()).String()},
+		/*ret=*/ nil,
+		body,
+		fmt.Sprintf("%s adds the JSONLD URIs required in the context string for this property and the specific values that are set to the map, along with the aliases used to import them. Unlike %s, it does not create a map for the property and each of its values.", addContextMethod, contextMethod))
+}
+
+// releaseMethod returns the Release method for this non-functional property,
+// which is not part of its interface.
+func (p *NonFunctionalPropertyGenerator) releaseMethod() *codegen.Method {
+	return codegen.NewCommentedPointerMethod(
+		p.GetPrivatePackage().Path(),
+		releaseMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		/*ret=*/ nil,
+		[]jen.Code{
+			jen.For(
+				jen.List(
+					jen.Id("i"),
+					jen.Id("elem"),
+				).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
+			).Block(
+				jen.Id("elem").Dot(releaseMethod).Call(),
+				jen.Id(codegen.This()).Dot(propertiesName).Index(jen.Id("i")).Op("=").Nil(),
+			),
+			jen.Op("*").Id(codegen.This()).Op("=").Id(p.StructName()).Values(jen.Dict{
+				jen.Id(propertiesName): jen.Id(codegen.This()).Dot(propertiesName).Index(jen.Empty(), jen.Lit(0)),
+			}),
+			jen.Id(poolName(p.StructName())).Dot("Put").Call(jen.Id(codegen.This())),
+		},
+		fmt.Sprintf("%s returns this property and its values to the pools from which they are reused when deserializing. None of them may be used afterwards, so it is only called by applications that are done with a deserialized value, to reduce the allocations of deserializing the next one.", releaseMethod))
+}
+
+// PoolDefinition returns the pools of released values of this property and of
+// its iterators.
+func (p *NonFunctionalPropertyGenerator) PoolDefinition() *jen.Statement {
+	return poolDefinition(p.StructName()).Line().Line().Add(p.elementTypeGenerator().PoolDefinition())
+}
+
 // iteratorInterfaceName gets the interface name for the iterator.
 func (p *NonFunctionalPropertyGenerator) iteratorInterfaceName() string {
 	return strings.Title(p.iteratorTypeName().CamelName)
@@ -125,15 +197,27 @@ func (p *NonFunctionalPropertyGenerator) elementTypeGenerator() *FunctionalPrope
 	}
 }
 
+// listCall calls the method of the List of iterators of this property.
+func (p *NonFunctionalPropertyGenerator) listCall(method string, args ...jen.Code) *jen.Statement {
+	return jen.Id(codegen.This()).Dot(propertiesName).Dot(method).Call(args...)
+}
+
+// iriDict returns the members of an iterator holding the IRI "v".
+func (p *NonFunctionalPropertyGenerator) iriDict() jen.Dict {
+	return jen.Dict{
+		p.thisIRI():         jen.Id("v"),
+		jen.Id(aliasMember): jen.Id(codegen.This()).Dot(aliasMember),
+	}
+}
+
 // funcs produces the methods needed for the NonFunctional property.
 func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 	var methods []*codegen.Method
 	less := jen.Empty()
 	for i, kind := range p.kinds {
 		dict := jen.Dict{
-			jen.Id(parentMemberName): jen.Id(codegen.This()),
-			jen.Id(p.memberName(i)):  jen.Id("v"),
-			jen.Id(aliasMember):      jen.Id(codegen.This()).Dot(aliasMember),
+			jen.Id(p.memberName(i)): jen.Id("v"),
+			jen.Id(aliasMember):     jen.Id(codegen.This()).Dot(aliasMember),
 		}
 		if !kind.Nilable {
 			dict[jen.Id(p.hasMemberName(i))] = jen.True()
@@ -143,7 +227,6 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 		for k, v := range dict {
 			prependDict[k] = v
 		}
-		prependDict[jen.Id(myIndexMemberName)] = jen.Lit(0)
 		prependMethodName := fmt.Sprintf("%s%s%s", prependMethod, kind.Vocab, p.kindCamelName(i))
 		methods = append(methods,
 			codegen.NewCommentedPointerMethod(
@@ -153,29 +236,14 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 				[]jen.Code{jen.Id("v").Add(kind.ConcreteKind)},
 				/*ret=*/ nil,
 				[]jen.Code{
-					jen.Id(codegen.This()).Dot(propertiesName).Op("=").Append(
-						jen.Index().Op("*").Id(p.iteratorTypeName().CamelName).Values(
-							jen.Values(prependDict),
-						),
-						jen.Id(codegen.This()).Dot(propertiesName).Op("..."),
-					),
-					jen.For(
-						jen.Id("i").Op(":=").Lit(1),
-						jen.Id("i").Op("<").Id(codegen.This()).Dot(lenMethod).Call(),
-						jen.Id("i").Op("++"),
-					).Block(
-						jen.Parens(
-							jen.Id(codegen.This()).Dot(propertiesName),
-						).Index(jen.Id("i")).Dot(myIndexMemberName).Op("=").Id("i"),
-					),
+					p.listCall(prependMethod, jen.Op("&").Id(p.iteratorTypeName().CamelName).Values(prependDict)),
 				},
-				fmt.Sprintf("%s prepends a %s value to the front of a list of the property %q. Invalidates all iterators.", prependMethodName, kind.Name.LowerName, p.PropertyName())))
+				fmt.Sprintf("%s prepends a %s value to the front of a list of the property %q.", prependMethodName, kind.Name.LowerName, p.PropertyName())))
 		// Insert Method
 		insertDict := jen.Dict{}
 		for k, v := range dict {
 			insertDict[k] = v
 		}
-		insertDict[jen.Id(myIndexMemberName)] = jen.Id("idx")
 		insertMethodName := fmt.Sprintf("%s%s%s", insertMethod, kind.Vocab, p.kindCamelName(i))
 		methods = append(methods,
 			codegen.NewCommentedPointerMethod(
@@ -188,42 +256,14 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 				},
 				/*ret=*/ nil,
 				[]jen.Code{
-					jen.Id(codegen.This()).Dot(propertiesName).Op("=").Append(
-						jen.Id(codegen.This()).Dot(propertiesName),
-						jen.Nil(),
-					),
-					jen.Copy(
-						jen.Id(codegen.This()).Dot(propertiesName).Index(
-							jen.Id("idx").Op("+").Lit(1),
-							jen.Empty(),
-						),
-						jen.Id(codegen.This()).Dot(propertiesName).Index(
-							jen.Id("idx"),
-							jen.Empty(),
-						),
-					),
-					jen.Id(codegen.This()).Dot(propertiesName).Index(
-						jen.Id("idx"),
-					).Op("=").Op("&").Id(p.iteratorTypeName().CamelName).Values(
-						insertDict,
-					),
-					jen.For(
-						jen.Id("i").Op(":=").Id("idx"),
-						jen.Id("i").Op("<").Id(codegen.This()).Dot(lenMethod).Call(),
-						jen.Id("i").Op("++"),
-					).Block(
-						jen.Parens(
-							jen.Id(codegen.This()).Dot(propertiesName),
-						).Index(jen.Id("i")).Dot(myIndexMemberName).Op("=").Id("i"),
-					),
+					p.listCall(insertMethod, jen.Id("idx"), jen.Op("&").Id(p.iteratorTypeName().CamelName).Values(insertDict)),
 				},
-				fmt.Sprintf("%s inserts a %s value at the specified index for a property %q. Existing elements at that index and higher are shifted back once. Invalidates all iterators.", insertMethodName, kind.Name.LowerName, p.PropertyName())))
+				fmt.Sprintf("%s inserts a %s value at the specified index for a property %q. Existing elements at that index and higher are shifted back once.", insertMethodName, kind.Name.LowerName, p.PropertyName())))
 		// Append Method
 		appendDict := jen.Dict{}
 		for k, v := range dict {
 			appendDict[k] = v
 		}
-		appendDict[jen.Id(myIndexMemberName)] = jen.Id(codegen.This()).Dot(lenMethod).Call()
 		appendMethodName := fmt.Sprintf("%s%s%s", appendMethod, kind.Vocab, p.kindCamelName(i))
 		methods = append(methods,
 			codegen.NewCommentedPointerMethod(
@@ -233,20 +273,14 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 				[]jen.Code{jen.Id("v").Add(kind.ConcreteKind)},
 				/*ret=*/ nil,
 				[]jen.Code{
-					jen.Id(codegen.This()).Dot(propertiesName).Op("=").Append(
-						jen.Id(codegen.This()).Dot(propertiesName),
-						jen.Op("&").Id(p.iteratorTypeName().CamelName).Values(
-							appendDict,
-						),
-					),
+					p.listCall(appendMethod, jen.Op("&").Id(p.iteratorTypeName().CamelName).Values(appendDict)),
 				},
-				fmt.Sprintf("%s appends a %s value to the back of a list of the property %q. Invalidates iterators that are traversing using %s.", appendMethodName, kind.Name.LowerName, p.PropertyName(), prevMethod)))
+				fmt.Sprintf("%s appends a %s value to the back of a list of the property %q.", appendMethodName, kind.Name.LowerName, p.PropertyName())))
 		// Set Method
 		setDict := jen.Dict{}
 		for k, v := range dict {
 			setDict[k] = v
 		}
-		setDict[jen.Id(myIndexMemberName)] = jen.Id("idx")
 		setMethodName := p.setFnName(i)
 		methods = append(methods,
 			codegen.NewCommentedPointerMethod(
@@ -256,12 +290,9 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 				[]jen.Code{jen.Id("idx").Int(), jen.Id("v").Add(kind.ConcreteKind)},
 				/*ret=*/ nil,
 				[]jen.Code{
-					jen.Parens(jen.Id(codegen.This()).Dot(propertiesName)).Index(jen.Id("idx")).Dot(parentMemberName).Op("=").Nil(),
-					jen.Parens(jen.Id(codegen.This()).Dot(propertiesName)).Index(jen.Id("idx")).Op("=").Op("&").Id(p.iteratorTypeName().CamelName).Values(
-						setDict,
-					),
+					p.listCall(setMethod, jen.Id("idx"), jen.Op("&").Id(p.iteratorTypeName().CamelName).Values(setDict)),
 				},
-				fmt.Sprintf("%s sets a %s value to be at the specified index for the property %q. Panics if the index is out of bounds. Invalidates all iterators.", setMethodName, kind.Name.LowerName, p.PropertyName())))
+				fmt.Sprintf("%s sets a %s value to be at the specified index for the property %q. Panics if the index is out of bounds.", setMethodName, kind.Name.LowerName, p.PropertyName())))
 		// Less logic
 		if i > 0 {
 			less.Else()
@@ -284,26 +315,7 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 			[]jen.Code{jen.Id("v").Op("*").Qual("net/url", "URL")},
 			/*ret=*/ nil,
 			[]jen.Code{
-				jen.Id(codegen.This()).Dot(propertiesName).Op("=").Append(
-					jen.Index().Op("*").Id(p.iteratorTypeName().CamelName).Values(
-						jen.Values(jen.Dict{
-							p.thisIRI():               jen.Id("v"),
-							jen.Id(parentMemberName):  jen.Id(codegen.This()),
-							jen.Id(myIndexMemberName): jen.Lit(0),
-							jen.Id(aliasMember):       jen.Id(codegen.This()).Dot(aliasMember),
-						}),
-					),
-					jen.Id(codegen.This()).Dot(propertiesName).Op("..."),
-				),
-				jen.For(
-					jen.Id("i").Op(":=").Lit(1),
-					jen.Id("i").Op("<").Id(codegen.This()).Dot(lenMethod).Call(),
-					jen.Id("i").Op("++"),
-				).Block(
-					jen.Parens(
-						jen.Id(codegen.This()).Dot(propertiesName),
-					).Index(jen.Id("i")).Dot(myIndexMemberName).Op("=").Id("i"),
-				),
+				p.listCall(prependMethod, jen.Op("&").Id(p.iteratorTypeName().CamelName).Values(p.iriDict())),
 			},
 			fmt.Sprintf("%sIRI prepends an IRI value to the front of a list of the property %q.", prependMethod, p.PropertyName())))
 	methods = append(methods,
@@ -317,41 +329,9 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 			},
 			/*ret=*/ nil,
 			[]jen.Code{
-				jen.Id(codegen.This()).Dot(propertiesName).Op("=").Append(
-					jen.Id(codegen.This()).Dot(propertiesName),
-					jen.Nil(),
-				),
-				jen.Copy(
-					jen.Id(codegen.This()).Dot(propertiesName).Index(
-						jen.Id("idx").Op("+").Lit(1),
-						jen.Empty(),
-					),
-					jen.Id(codegen.This()).Dot(propertiesName).Index(
-						jen.Id("idx"),
-						jen.Empty(),
-					),
-				),
-				jen.Id(codegen.This()).Dot(propertiesName).Index(
-					jen.Id("idx"),
-				).Op("=").Op("&").Id(p.iteratorTypeName().CamelName).Values(
-					jen.Dict{
-						p.thisIRI():               jen.Id("v"),
-						jen.Id(parentMemberName):  jen.Id(codegen.This()),
-						jen.Id(myIndexMemberName): jen.Id("idx"),
-						jen.Id(aliasMember):       jen.Id(codegen.This()).Dot(aliasMember),
-					},
-				),
-				jen.For(
-					jen.Id("i").Op(":=").Id("idx"),
-					jen.Id("i").Op("<").Id(codegen.This()).Dot(lenMethod).Call(),
-					jen.Id("i").Op("++"),
-				).Block(
-					jen.Parens(
-						jen.Id(codegen.This()).Dot(propertiesName),
-					).Index(jen.Id("i")).Dot(myIndexMemberName).Op("=").Id("i"),
-				),
+				p.listCall(insertMethod, jen.Id("idx"), jen.Op("&").Id(p.iteratorTypeName().CamelName).Values(p.iriDict())),
 			},
-			fmt.Sprintf("%s inserts an IRI value at the specified index for a property %q. Existing elements at that index and higher are shifted back once. Invalidates all iterators.", insertMethod, p.PropertyName())))
+			fmt.Sprintf("%s inserts an IRI value at the specified index for a property %q. Existing elements at that index and higher are shifted back once.", insertMethod, p.PropertyName())))
 	methods = append(methods,
 		codegen.NewCommentedPointerMethod(
 			p.GetPrivatePackage().Path(),
@@ -360,17 +340,7 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 			[]jen.Code{jen.Id("v").Op("*").Qual("net/url", "URL")},
 			/*ret=*/ nil,
 			[]jen.Code{
-				jen.Id(codegen.This()).Dot(propertiesName).Op("=").Append(
-					jen.Id(codegen.This()).Dot(propertiesName),
-					jen.Op("&").Id(p.iteratorTypeName().CamelName).Values(
-						jen.Dict{
-							p.thisIRI():               jen.Id("v"),
-							jen.Id(parentMemberName):  jen.Id(codegen.This()),
-							jen.Id(myIndexMemberName): jen.Id(codegen.This()).Dot(lenMethod).Call(),
-							jen.Id(aliasMember):       jen.Id(codegen.This()).Dot(aliasMember),
-						},
-					),
-				),
+				p.listCall(appendMethod, jen.Op("&").Id(p.iteratorTypeName().CamelName).Values(p.iriDict())),
 			},
 			fmt.Sprintf("%sIRI appends an IRI value to the back of a list of the property %q", appendMethod, p.PropertyName())))
 	methods = append(methods,
@@ -381,15 +351,7 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 			[]jen.Code{jen.Id("idx").Int(), jen.Id("v").Op("*").Qual("net/url", "URL")},
 			/*ret=*/ nil,
 			[]jen.Code{
-				jen.Parens(jen.Id(codegen.This()).Dot(propertiesName)).Index(jen.Id("idx")).Dot(parentMemberName).Op("=").Nil(),
-				jen.Parens(jen.Id(codegen.This()).Dot(propertiesName)).Index(jen.Id("idx")).Op("=").Op("&").Id(p.iteratorTypeName().CamelName).Values(
-					jen.Dict{
-						p.thisIRI():               jen.Id("v"),
-						jen.Id(parentMemberName):  jen.Id(codegen.This()),
-						jen.Id(myIndexMemberName): jen.Id("idx"),
-						jen.Id(aliasMember):       jen.Id(codegen.This()).Dot(aliasMember),
-					},
-				),
+				p.listCall(setMethod, jen.Id("idx"), jen.Op("&").Id(p.iteratorTypeName().CamelName).Values(p.iriDict())),
 			},
 			fmt.Sprintf("%sIRI sets an IRI value to be at the specified index for the property %q. Panics if the index is out of bounds.", setMethod, p.PropertyName())))
 	less = less.Else().If(
@@ -410,43 +372,9 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 			[]jen.Code{jen.Id("idx").Int()},
 			/*ret=*/ nil,
 			[]jen.Code{
-				jen.Parens(jen.Id(codegen.This()).Dot(propertiesName)).Index(jen.Id("idx")).Dot(parentMemberName).Op("=").Nil(),
-				jen.Copy(
-					jen.Parens(
-						jen.Id(codegen.This()).Dot(propertiesName),
-					).Index(
-						jen.Id("idx"),
-						jen.Empty(),
-					),
-					jen.Parens(
-						jen.Id(codegen.This()).Dot(propertiesName),
-					).Index(
-						jen.Id("idx").Op("+").Lit(1),
-						jen.Empty(),
-					),
-				),
-				jen.Parens(
-					jen.Id(codegen.This()).Dot(propertiesName),
-				).Index(
-					jen.Len(jen.Id(codegen.This()).Dot(propertiesName)).Op("-").Lit(1),
-				).Op("=").Op("&").Id(p.iteratorTypeName().CamelName).Values(),
-				jen.Id(codegen.This()).Dot(propertiesName).Op("=").Parens(
-					jen.Id(codegen.This()).Dot(propertiesName),
-				).Index(
-					jen.Empty(),
-					jen.Len(jen.Id(codegen.This()).Dot(propertiesName)).Op("-").Lit(1),
-				),
-				jen.For(
-					jen.Id("i").Op(":=").Id("idx"),
-					jen.Id("i").Op("<").Id(codegen.This()).Dot(lenMethod).Call(),
-					jen.Id("i").Op("++"),
-				).Block(
-					jen.Parens(
-						jen.Id(codegen.This()).Dot(propertiesName),
-					).Index(jen.Id("i")).Dot(myIndexMemberName).Op("=").Id("i"),
-				),
+				p.listCall(removeMethod, jen.Id("idx")),
 			},
-			fmt.Sprintf("%s deletes an element at the specified index from a list of the property %q, regardless of its type. Panics if the index is out of bounds. Invalidates all iterators.", removeMethod, p.PropertyName())))
+			fmt.Sprintf("%s deletes an element at the specified index from a list of the property %q, regardless of its type. Panics if the index is out of bounds.", removeMethod, p.PropertyName())))
 	// Len Method
 	methods = append(methods,
 		codegen.NewCommentedValueMethod(
@@ -457,9 +385,7 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 			[]jen.Code{jen.Id("length").Int()},
 			[]jen.Code{
 				jen.Return(
-					jen.Len(
-						jen.Id(codegen.This()).Dot(propertiesName),
-					),
+					p.listCall(lenMethod),
 				),
 			},
 			fmt.Sprintf("%s returns the number of values that exist for the %q property.", lenMethod, p.PropertyName())))
@@ -475,13 +401,7 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 			},
 			/*ret=*/ nil,
 			[]jen.Code{
-				jen.List(
-					jen.Id(codegen.This()).Dot(propertiesName).Index(jen.Id("i")),
-					jen.Id(codegen.This()).Dot(propertiesName).Index(jen.Id("j")),
-				).Op("=").List(
-					jen.Id(codegen.This()).Dot(propertiesName).Index(jen.Id("j")),
-					jen.Id(codegen.This()).Dot(propertiesName).Index(jen.Id("i")),
-				),
+				p.listCall(swapMethod, jen.Id("i"), jen.Id("j")),
 			},
 			fmt.Sprintf("%s swaps the location of values at two indices for the %q property.", swapMethod, p.PropertyName())))
 	// Less Method
@@ -521,7 +441,19 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 			},
 			fmt.Sprintf("%s computes an arbitrary value for indexing this kind of value. This is a leaky API method specifically needed only for alternate implementations for go-fed. Applications should not use this method. Panics if the index is out of bounds.", kindIndexMethod)))
 	// LessThan Method
-	lessCode := jen.Empty().Add(
+	methods = append(methods, codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		compareLessMethod,
+		p.StructName(),
+		[]jen.Code{jen.Id("o").Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
+		[]jen.Code{jen.Bool()},
+		[]jen.Code{
+			jen.Return(jen.Id(codegen.This()).Dot(compareMethod).Call(jen.Id("o")).Op("<").Lit(0)),
+		},
+		fmt.Sprintf("%s compares two instances of this property with an arbitrary but stable comparison. Applications should not use this because it is only meant to help alternative implementations to go-fed to be able to normalize nonfunctional properties.", compareLessMethod),
+	))
+	// Compare Method
+	cmpCode := jen.Empty().Add(
 		jen.Id("l1").Op(":=").Id(codegen.This()).Dot(lenMethod).Call().Line(),
 		jen.Id("l2").Op(":=").Id("o").Dot(lenMethod).Call().Line(),
 		jen.Id("l").Op(":=").Id("l1").Line(),
@@ -532,30 +464,36 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 		))
 	methods = append(methods, codegen.NewCommentedValueMethod(
 		p.GetPrivatePackage().Path(),
-		compareLessMethod,
+		compareMethod,
 		p.StructName(),
 		[]jen.Code{jen.Id("o").Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
-		[]jen.Code{jen.Bool()},
+		[]jen.Code{jen.Int()},
 		[]jen.Code{
-			lessCode,
+			cmpCode,
 			jen.For(
 				jen.Id("i").Op(":=").Lit(0),
 				jen.Id("i").Op("<").Id("l"),
 				jen.Id("i").Op("++"),
 			).Block(
 				jen.If(
-					jen.Id(codegen.This()).Dot(propertiesName).Index(jen.Id("i")).Dot(compareLessMethod).Call(jen.Id("o").Dot(atMethodName).Call(jen.Id("i"))),
-				).Block(
-					jen.Return(jen.True()),
-				).Else().If(
-					jen.Id("o").Dot(atMethodName).Call(jen.Id("i")).Dot(compareLessMethod).Call(jen.Id(codegen.This()).Dot(propertiesName).Index(jen.Id("i"))),
+					jen.Id("c").Op(":=").Id(codegen.This()).Dot(propertiesName).Index(jen.Id("i")).Dot(compareMethod).Call(jen.Id("o").Dot(atMethodName).Call(jen.Id("i"))),
+					jen.Id("c").Op("!=").Lit(0),
 				).Block(
-					jen.Return(jen.False()),
+					jen.Return(jen.Id("c")),
 				),
 			),
-			jen.Return(jen.Id("l1").Op("<").Id("l2")),
+			jen.If(
+				jen.Id("l1").Op("<").Id("l2"),
+			).Block(
+				jen.Return(jen.Lit(-1)),
+			).Else().If(
+				jen.Id("l1").Op(">").Id("l2"),
+			).Block(
+				jen.Return(jen.Lit(1)),
+			),
+			jen.Return(jen.Lit(0)),
 		},
-		fmt.Sprintf("%s compares two instances of this property with an arbitrary but stable comparison. Applications should not use this because it is only meant to help alternative implementations to go-fed to be able to normalize nonfunctional properties.", compareLessMethod),
+		fmt.Sprintf("%s compares two instances of this property with an arbitrary but stable comparison, returning a negative number if this is lesser, a positive number if this is greater, and 0 if neither is. Applications should not use this because it is only meant to help alternative implementations to go-fed to be able to normalize nonfunctional properties.", compareMethod),
 	))
 	// At Method
 	methods = append(methods, codegen.NewCommentedValueMethod(
@@ -613,40 +551,39 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 			jen.Return(jen.Nil()),
 		},
 		fmt.Sprintf("%s returns beyond-the-last iterator, which is nil. Can be used with the iterator's %s method and this property's %s method to iterate from front to back through all values.", endMethod, nextMethod, beginMethod)))
-	// Context Method
-	mDef := jen.Var().Id("m").Map(jen.String()).String()
-	if p.vocabURI != nil {
-		mDef = jen.Id("m").Op(":=").Map(jen.String()).String().Values(
-			jen.Dict{
-				jen.Lit(p.vocabURI.String()): jen.Id(codegen.This()).Dot(aliasMember),
-			},
-		)
-	}
+	// Values Method
 	methods = append(methods, codegen.NewCommentedValueMethod(
 		p.GetPrivatePackage().Path(),
-		contextMethod,
+		valuesMethod,
 		p.StructName(),
 		/*params=*/ nil,
-		[]jen.Code{jen.Map(jen.String()).String()},
+		[]jen.Code{jen.Index().Qual(p.GetPublicPackage().Path(), p.iteratorInterfaceName())},
 		[]jen.Code{
-			mDef,
+			jen.Id("values").Op(":=").Make(
+				jen.Index().Qual(p.GetPublicPackage().Path(), p.iteratorInterfaceName()),
+				jen.Len(jen.Id(codegen.This()).Dot(propertiesName)),
+			),
 			jen.For(
 				jen.List(
-					jen.Id("_"),
+					jen.Id("i"),
 					jen.Id("elem"),
 				).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
 			).Block(
-				jen.Id("child").Op(":=").Id("elem").Dot(contextMethod).Call(),
-				jen.Commentf("Since the literal maps in this function are determined at\ncode-generation time, this loop should not overwrite an existing key with a\nnew value."),
-				jen.For(
-					jen.List(
-						jen.Id("k"),
-						jen.Id("v"),
-					).Op(":=").Range().Id("child"),
-				).Block(
-					jen.Id("m").Index(jen.Id("k")).Op("=").Id("v"),
-				),
+				jen.Id("values").Index(jen.Id("i")).Op("=").Id("elem"),
 			),
+			jen.Return(jen.Id("values")),
+		},
+		fmt.Sprintf("%s returns the iterators of all values from front to back, so they can be ranged over. Adding values to or removing them from this property afterwards does not change the returned slice.", valuesMethod)))
+	// Context Method
+	methods = append(methods, codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		contextMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Map(jen.String()).String()},
+		[]jen.Code{
+			jen.Id("m").Op(":=").Make(jen.Map(jen.String()).String()),
+			jen.Id(codegen.This()).Dot(addContextMethod).Call(jen.Id("m")),
 			jen.Return(jen.Id("m")),
 		},
 		fmt.Sprintf("%s returns the JSONLD URIs required in the context string for this property and the specific values that are set. The value in the map is the alias used to import the property's value or values.", contextMethod)))
@@ -668,9 +605,7 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 						p.iteratorTypeName().CamelName,
 					).Values(
 						jen.Dict{
-							jen.Id(myIndexMemberName): jen.Id("idx"),
-							jen.Id(parentMemberName):  jen.Id(codegen.This()),
-							jen.Id(aliasMember):       jen.Id(codegen.This()).Dot(aliasMember),
+							jen.Id(aliasMember): jen.Id(codegen.This()).Dot(aliasMember),
 						},
 					),
 					jen.If(
@@ -683,10 +618,10 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 					).Block(
 						jen.Return(jen.Err()),
 					),
-					jen.Parens(jen.Id(codegen.This()).Dot(propertiesName)).Index(jen.Id("idx")).Op("=").Id("n"),
+					p.listCall(setMethod, jen.Id("idx"), jen.Id("n")),
 					jen.Return(jen.Nil()),
 				},
-				fmt.Sprintf("%s%s sets an arbitrary type value to the specified index of the property %q. Invalidates all iterators. Returns an error if the type is not a valid one to set for this property. Panics if the index is out of bounds.", setMethod, typeInterfaceName, p.PropertyName())))
+				fmt.Sprintf("%s%s sets an arbitrary type value to the specified index of the property %q. Returns an error if the type is not a valid one to set for this property. Panics if the index is out of bounds.", setMethod, typeInterfaceName, p.PropertyName())))
 		// PrependType Method
 		methods = append(methods,
 			codegen.NewCommentedPointerMethod(
@@ -701,9 +636,7 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 						p.iteratorTypeName().CamelName,
 					).Values(
 						jen.Dict{
-							jen.Id(myIndexMemberName): jen.Lit(0),
-							jen.Id(parentMemberName):  jen.Id(codegen.This()),
-							jen.Id(aliasMember):       jen.Id(codegen.This()).Dot(aliasMember),
+							jen.Id(aliasMember): jen.Id(codegen.This()).Dot(aliasMember),
 						},
 					),
 					jen.If(
@@ -716,26 +649,10 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 					).Block(
 						jen.Return(jen.Err()),
 					),
-					jen.Id(codegen.This()).Dot(propertiesName).Op("=").Append(
-						jen.Index().Op("*").Id(
-							p.iteratorTypeName().CamelName,
-						).Values(
-							jen.Id("n"),
-						),
-						jen.Id(codegen.This()).Dot(propertiesName).Op("..."),
-					),
-					jen.For(
-						jen.Id("i").Op(":=").Lit(1),
-						jen.Id("i").Op("<").Id(codegen.This()).Dot(lenMethod).Call(),
-						jen.Id("i").Op("++"),
-					).Block(
-						jen.Parens(
-							jen.Id(codegen.This()).Dot(propertiesName),
-						).Index(jen.Id("i")).Dot(myIndexMemberName).Op("=").Id("i"),
-					),
+					p.listCall(prependMethod, jen.Id("n")),
 					jen.Return(jen.Nil()),
 				},
-				fmt.Sprintf("%s%s prepends an arbitrary type value to the front of a list of the property %q. Invalidates all iterators. Returns an error if the type is not a valid one to set for this property.", prependMethod, typeInterfaceName, p.PropertyName())))
+				fmt.Sprintf("%s%s prepends an arbitrary type value to the front of a list of the property %q. Returns an error if the type is not a valid one to set for this property.", prependMethod, typeInterfaceName, p.PropertyName())))
 		// InsertType Method
 		methods = append(methods,
 			codegen.NewCommentedPointerMethod(
@@ -753,9 +670,7 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 						p.iteratorTypeName().CamelName,
 					).Values(
 						jen.Dict{
-							jen.Id(myIndexMemberName): jen.Id("idx"),
-							jen.Id(parentMemberName):  jen.Id(codegen.This()),
-							jen.Id(aliasMember):       jen.Id(codegen.This()).Dot(aliasMember),
+							jen.Id(aliasMember): jen.Id(codegen.This()).Dot(aliasMember),
 						},
 					),
 					jen.If(
@@ -768,35 +683,10 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 					).Block(
 						jen.Return(jen.Err()),
 					),
-					jen.Id(codegen.This()).Dot(propertiesName).Op("=").Append(
-						jen.Id(codegen.This()).Dot(propertiesName),
-						jen.Nil(),
-					),
-					jen.Copy(
-						jen.Id(codegen.This()).Dot(propertiesName).Index(
-							jen.Id("idx").Op("+").Lit(1),
-							jen.Empty(),
-						),
-						jen.Id(codegen.This()).Dot(propertiesName).Index(
-							jen.Id("idx"),
-							jen.Empty(),
-						),
-					),
-					jen.Id(codegen.This()).Dot(propertiesName).Index(
-						jen.Id("idx"),
-					).Op("=").Id("n"),
-					jen.For(
-						jen.Id("i").Op(":=").Id("idx"),
-						jen.Id("i").Op("<").Id(codegen.This()).Dot(lenMethod).Call(),
-						jen.Id("i").Op("++"),
-					).Block(
-						jen.Parens(
-							jen.Id(codegen.This()).Dot(propertiesName),
-						).Index(jen.Id("i")).Dot(myIndexMemberName).Op("=").Id("i"),
-					),
+					p.listCall(insertMethod, jen.Id("idx"), jen.Id("n")),
 					jen.Return(jen.Nil()),
 				},
-				fmt.Sprintf("%s%s prepends an arbitrary type value to the front of a list of the property %q. Invalidates all iterators. Returns an error if the type is not a valid one to set for this property.", prependMethod, typeInterfaceName, p.PropertyName())))
+				fmt.Sprintf("%s%s inserts an arbitrary type value at the specified index for the property %q. Existing elements at that index and higher are shifted back once. Returns an error if the type is not a valid one to set for this property.", insertMethod, typeInterfaceName, p.PropertyName())))
 
 		// AppendType
 		methods = append(methods,
@@ -812,9 +702,7 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 						p.iteratorTypeName().CamelName,
 					).Values(
 						jen.Dict{
-							jen.Id(myIndexMemberName): jen.Id(codegen.This()).Dot(lenMethod).Call(),
-							jen.Id(parentMemberName):  jen.Id(codegen.This()),
-							jen.Id(aliasMember):       jen.Id(codegen.This()).Dot(aliasMember),
+							jen.Id(aliasMember): jen.Id(codegen.This()).Dot(aliasMember),
 						},
 					),
 					jen.If(
@@ -827,13 +715,10 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 					).Block(
 						jen.Return(jen.Err()),
 					),
-					jen.Id(codegen.This()).Dot(propertiesName).Op("=").Append(
-						jen.Id(codegen.This()).Dot(propertiesName),
-						jen.Id("n"),
-					),
+					p.listCall(appendMethod, jen.Id("n")),
 					jen.Return(jen.Nil()),
 				},
-				fmt.Sprintf("%s%s prepends an arbitrary type value to the front of a list of the property %q. Invalidates iterators that are traversing using %s. Returns an error if the type is not a valid one to set for this property.", prependMethod, typeInterfaceName, p.PropertyName(), prevMethod)))
+				fmt.Sprintf("%s%s appends an arbitrary type value to the back of a list of the property %q. Returns an error if the type is not a valid one to set for this property.", appendMethod, typeInterfaceName, p.PropertyName())))
 	}
 	methods = append(methods, p.commonMethods()...)
 	methods = append(methods, p.nameMethod())
@@ -919,6 +804,38 @@ func (p *NonFunctionalPropertyGenerator) serializationFuncs() (*codegen.Method,
 			),
 		)
 	}
+	deserializeList := jen.For(
+		jen.List(
+			jen.Id("_"),
+			jen.Id("iterator"),
+		).Op(":=").Range().Id("list"),
+	).Block(
+		deserializeFn("iterator"),
+	)
+	if p.isParallel() {
+		deserializeList = jen.If(
+			jen.Id("workers").Op(":=").Int().Call(jen.Qual("sync/atomic", "LoadInt64").Call(jen.Op("&").Id(p.workersName()))),
+			jen.Id("workers").Op(">").Lit(1).Op("&&").Len(jen.Id("list")).Op(">").Lit(1),
+		).Block(
+			jen.List(
+				jen.Id("properties"),
+				jen.Err(),
+			).Op(":=").Id(p.parallelDeserializeFnName()).Call(
+				jen.Id("list"),
+				jen.Id("aliasMap"),
+				jen.Id("workers"),
+			),
+			jen.If(
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(
+					jen.Id(codegen.This()),
+					jen.Err(),
+				),
+			),
+			jen.Id(codegen.This()).Dot(propertiesName).Op("=").Id("properties"),
+		).Else().Block(deserializeList)
+	}
 	mapProperty := jen.Empty()
 	if p.hasNaturalLanguageMap {
 		mapProperty = jen.If(
@@ -973,12 +890,9 @@ func (p *NonFunctionalPropertyGenerator) serializationFuncs() (*codegen.Method,
 			jen.If(
 				jen.Id("ok"),
 			).Block(
-				jen.Id(codegen.This()).Op(":=").Op("&").Id(p.StructName()).Values(
-					jen.Dict{
-						jen.Id(propertiesName): jen.Index().Op("*").Id(p.iteratorTypeName().CamelName).Values(),
-						jen.Id(aliasMember):    jen.Id("alias"),
-					},
-				),
+				jen.Commentf("A released value keeps its properties for reuse."),
+				poolGetCode(p.StructName()),
+				jen.Id(codegen.This()).Dot(aliasMember).Op("=").Id("alias"),
 				jen.If(
 					jen.List(
 						jen.Id("list"),
@@ -988,24 +902,12 @@ func (p *NonFunctionalPropertyGenerator) serializationFuncs() (*codegen.Method,
 					),
 					jen.Id("ok"),
 				).Block(
-					jen.For(
-						jen.List(
-							jen.Id("_"),
-							jen.Id("iterator"),
-						).Op(":=").Range().Id("list"),
-					).Block(
-						deserializeFn("iterator"),
-					),
+					deserializeList,
 				).Else().Block(
 					deserializeFn("i"),
 				),
 				jen.Commentf("Set up the properties for iteration."),
-				jen.For(
-					jen.List(jen.Id("idx"), jen.Id("ele")).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
-				).Block(
-					jen.Id("ele").Dot(parentMemberName).Op("=").Id(codegen.This()),
-					jen.Id("ele").Dot(myIndexMemberName).Op("=").Id("idx"),
-				),
+				p.listCall(listLinkMethod),
 				jen.Return(
 					jen.Id(codegen.This()),
 					jen.Nil(),
@@ -1020,6 +922,166 @@ func (p *NonFunctionalPropertyGenerator) serializationFuncs() (*codegen.Method,
 	return serialize, deserialize
 }
 
+// isParallel determines whether the values of this property may be deserialized
+// in parallel.
+func (p *NonFunctionalPropertyGenerator) isParallel() bool {
+	return p.VocabName() == activityStreamsVocabName && parallelProperties[p.PropertyName()]
+}
+
+// workersName returns the name of the variable configuring the number of
+// goroutines deserializing the values of this property.
+func (p *NonFunctionalPropertyGenerator) workersName() string {
+	return fmt.Sprintf("deserialize%sWorkers", p.StructName())
+}
+
+// setWorkersFnName returns the name of the function setting the number of
+// goroutines deserializing the values of this property.
+func (p *NonFunctionalPropertyGenerator) setWorkersFnName() string {
+	return fmt.Sprintf("%s%sWorkers", setMethod, p.StructName())
+}
+
+// setWorkersComment returns the documentation of the functions setting the
+// number of goroutines deserializing the values of this property.
+func (p *NonFunctionalPropertyGenerator) setWorkersComment() string {
+	return fmt.Sprintf("%s configures the values of the %q property to be deserialized by n goroutines, keeping their order, so that large pages of collections are deserialized faster on multiple cores. A value of 0 or 1 deserializes them one after the other, which is the default. It is safe to call concurrently with deserializing values, which use the number of goroutines configured when they started.", p.setWorkersFnName(), p.PropertyName())
+}
+
+// PublicWorkersFn returns the function in the package configuring the number of
+// goroutines deserializing the values of this property, or nil if they are
+// always deserialized one after the other.
+func (p *NonFunctionalPropertyGenerator) PublicWorkersFn(pkg Package) *codegen.Function {
+	if !p.isParallel() {
+		return nil
+	}
+	return codegen.NewCommentedFunction(
+		pkg.Path(),
+		p.setWorkersFnName(),
+		[]jen.Code{jen.Id("n").Int()},
+		/*ret=*/ nil,
+		[]jen.Code{
+			jen.Qual(p.GetPrivatePackage().Path(), p.setWorkersFnName()).Call(jen.Id("n")),
+		},
+		p.setWorkersComment())
+}
+
+// parallelDeserializeFnName returns the name of the function deserializing the
+// values of this property in parallel.
+func (p *NonFunctionalPropertyGenerator) parallelDeserializeFnName() string {
+	return fmt.Sprintf("%sParallel", p.elementTypeGenerator().DeserializeFnName())
+}
+
+// WorkersDefinition returns the configuration and functions deserializing the
+// values of this property across a number of goroutines, or nil if they are
+// always deserialized one after the other.
+//
+// Only the properties holding the items of collections are deserialized in
+// parallel, as the pages of collections may have hundreds of values.
+func (p *NonFunctionalPropertyGenerator) WorkersDefinition() jen.Code {
+	if !p.isParallel() {
+		return nil
+	}
+	iter := jen.Op("*").Id(p.iteratorTypeName().CamelName)
+	return jen.Empty().Add(
+		jen.Comment(codegen.FormatPackageDocumentation(fmt.Sprintf(
+			"%s is the number of goroutines deserializing the values of the %q property. It is set with %s, and is only accessed atomically so that it may be changed while values are deserialized.",
+			p.workersName(),
+			p.PropertyName(),
+			p.setWorkersFnName(),
+		))).Line(),
+		jen.Var().Id(p.workersName()).Int64(),
+		jen.Line().Line(),
+		codegen.NewCommentedFunction(
+			p.GetPrivatePackage().Path(),
+			p.setWorkersFnName(),
+			[]jen.Code{jen.Id("n").Int()},
+			/*ret=*/ nil,
+			[]jen.Code{
+				jen.Qual("sync/atomic", "StoreInt64").Call(jen.Op("&").Id(p.workersName()), jen.Int64().Call(jen.Id("n"))),
+			},
+			p.setWorkersComment()).Definition(),
+		jen.Line().Line(),
+		codegen.NewCommentedFunction(
+			p.GetPrivatePackage().Path(),
+			p.parallelDeserializeFnName(),
+			[]jen.Code{
+				jen.Id("list").Index().Interface(),
+				jen.Id("aliasMap").Map(jen.String()).String(),
+				jen.Id("workers").Int(),
+			},
+			[]jen.Code{
+				jen.Index().Add(iter.Clone()),
+				jen.Error(),
+			},
+			[]jen.Code{
+				jen.Id("elems").Op(":=").Make(jen.Index().Add(iter.Clone()), jen.Len(jen.Id("list"))),
+				jen.Id("errs").Op(":=").Make(jen.Index().Error(), jen.Len(jen.Id("list"))),
+				jen.If(
+					jen.Id("workers").Op(">").Len(jen.Id("list")),
+				).Block(
+					jen.Id("workers").Op("=").Len(jen.Id("list")),
+				),
+				jen.Id("indices").Op(":=").Make(jen.Chan().Int()),
+				jen.Var().Id("wg").Qual("sync", "WaitGroup"),
+				jen.Id("wg").Dot("Add").Call(jen.Id("workers")),
+				jen.For(
+					jen.Id("w").Op(":=").Lit(0),
+					jen.Id("w").Op("<").Id("workers"),
+					jen.Id("w").Op("++"),
+				).Block(
+					jen.Go().Func().Params().Block(
+						jen.Defer().Id("wg").Dot("Done").Call(),
+						jen.For(
+							jen.Id("idx").Op(":=").Range().Id("indices"),
+						).Block(
+							jen.List(
+								jen.Id("elems").Index(jen.Id("idx")),
+								jen.Id("errs").Index(jen.Id("idx")),
+							).Op("=").Id(p.elementTypeGenerator().DeserializeFnName()).Call(
+								jen.Id("list").Index(jen.Id("idx")),
+								jen.Id("aliasMap"),
+							),
+						),
+					).Call(),
+				),
+				jen.For(
+					jen.Id("idx").Op(":=").Range().Id("list"),
+				).Block(
+					jen.Id("indices").Op("<-").Id("idx"),
+				),
+				jen.Close(jen.Id("indices")),
+				jen.Id("wg").Dot("Wait").Call(),
+				jen.Id("properties").Op(":=").Make(jen.Index().Add(iter.Clone()), jen.Lit(0), jen.Len(jen.Id("list"))),
+				jen.For(
+					jen.List(
+						jen.Id("idx"),
+						jen.Id("p"),
+					).Op(":=").Range().Id("elems"),
+				).Block(
+					jen.If(
+						jen.Id("errs").Index(jen.Id("idx")).Op("!=").Nil(),
+					).Block(
+						jen.Return(
+							jen.Nil(),
+							jen.Id("errs").Index(jen.Id("idx")),
+						),
+					).Else().If(
+						jen.Id("p").Op("!=").Nil(),
+					).Block(
+						jen.Id("properties").Op("=").Append(
+							jen.Id("properties"),
+							jen.Id("p"),
+						),
+					),
+				),
+				jen.Return(
+					jen.Id("properties"),
+					jen.Nil(),
+				),
+			},
+			fmt.Sprintf("%s deserializes the values of the %q property with the number of goroutines given by workers, keeping their order. It returns the error of the first value failing to deserialize, like deserializing them one after the other does.", p.parallelDeserializeFnName(), p.PropertyName())).Definition(),
+	)
+}
+
 // thisIRI returns the member to access this IRI -- it may be an xsd:anyURI
 // or another equivalent type.
 func (p *NonFunctionalPropertyGenerator) thisIRI() *jen.Statement {
@@ -0,0 +1,216 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+)
+
+const (
+	plainListName           = "List"
+	plainToVocabMethod      = "ToVocab"
+	plainFromMapFn          = "fromMap"
+	plainToMapFn            = "toMap"
+	plainNaturalLanguageMap = "%sMap"
+)
+
+// PlainGenerator generates plain structs mirroring the ActivityStreams types,
+// with exported fields and JSON tags, and the functions converting between
+// them and the generated types.
+type PlainGenerator struct {
+	pkg   Package
+	root  Package
+	types []*TypeGenerator
+}
+
+// NewPlainGenerator creates a new generator for the plain structs of the
+// types, in the package pkg. The root package is the one containing the
+// Manager.
+//
+// Must be constructed after all TypeGenerators.
+func NewPlainGenerator(tgs []*TypeGenerator, pkg, root Package) *PlainGenerator {
+	return &PlainGenerator{
+		pkg:   pkg,
+		root:  root,
+		types: tgs,
+	}
+}
+
+// Definition returns the List of values of non-functional properties, the
+// plain structs of every type, and the functions they share.
+func (g *PlainGenerator) Definition() (list *codegen.Typedef, structs []*codegen.Struct, fns []*codegen.Function) {
+	list = codegen.NewTypedef(
+		fmt.Sprintf("%s holds the values of a non-functional property. Like "+
+			"the generated types, it is marshaled as a single value if it "+
+			"has only one, and as a JSON array otherwise.", plainListName),
+		plainListName,
+		jen.Index().Interface(),
+		[]*codegen.Method{
+			codegen.NewCommentedValueMethod(
+				g.pkg.Path(),
+				"MarshalJSON",
+				plainListName,
+				/*params=*/ nil,
+				[]jen.Code{jen.Index().Byte(), jen.Error()},
+				[]jen.Code{
+					jen.If(jen.Len(jen.Id(codegen.This())).Op("==").Lit(1)).Block(
+						jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id(codegen.This()).Index(jen.Lit(0)))),
+					),
+					jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Index().Interface().Parens(jen.Id(codegen.This())))),
+				},
+				"MarshalJSON returns the value if there is only one, and the JSON array of values otherwise."),
+			codegen.NewCommentedPointerMethod(
+				g.pkg.Path(),
+				"UnmarshalJSON",
+				plainListName,
+				[]jen.Code{jen.Id("b").Index().Byte()},
+				[]jen.Code{jen.Error()},
+				[]jen.Code{
+					jen.Var().Id("v").Interface(),
+					jen.If(
+						jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("b"), jen.Op("&").Id("v")),
+						jen.Err().Op("!=").Nil(),
+					).Block(jen.Return(jen.Err())),
+					jen.If(
+						jen.List(jen.Id("a"), jen.Id("ok")).Op(":=").Id("v").Assert(jen.Index().Interface()),
+						jen.Id("ok"),
+					).Block(
+						jen.Op("*").Id(codegen.This()).Op("=").Id("a"),
+					).Else().Block(
+						jen.Op("*").Id(codegen.This()).Op("=").Qual(g.pkg.Path(), plainListName).Values(jen.Id("v")),
+					),
+					jen.Return(jen.Nil()),
+				},
+				"UnmarshalJSON sets the values from a JSON array, or the value from any other JSON value."),
+		},
+		/*functions=*/ nil)
+	for _, t := range g.types {
+		structs = append(structs, g.plainStruct(t))
+	}
+	fns = []*codegen.Function{
+		codegen.NewCommentedFunction(
+			g.pkg.Path(),
+			plainFromMapFn,
+			[]jen.Code{
+				jen.Id("m").Map(jen.String()).Interface(),
+				jen.Id("v").Interface(),
+			},
+			[]jen.Code{jen.Error()},
+			[]jen.Code{
+				jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("m")),
+				jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Err())),
+				jen.Return(jen.Qual("encoding/json", "Unmarshal").Call(jen.Id("b"), jen.Id("v"))),
+			},
+			fmt.Sprintf("%s sets the fields of the plain struct v from the serialized map of a type.", plainFromMapFn)),
+		codegen.NewCommentedFunction(
+			g.pkg.Path(),
+			plainToMapFn,
+			[]jen.Code{jen.Id("v").Interface()},
+			[]jen.Code{
+				jen.Map(jen.String()).Interface(),
+				jen.Error(),
+			},
+			[]jen.Code{
+				jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("v")),
+				jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+				jen.Var().Id("m").Map(jen.String()).Interface(),
+				jen.Err().Op("=").Qual("encoding/json", "Unmarshal").Call(jen.Id("b"), jen.Op("&").Id("m")),
+				jen.Return(jen.Id("m"), jen.Err()),
+			},
+			fmt.Sprintf("%s returns the serialized map of the plain struct v.", plainToMapFn)),
+	}
+	return
+}
+
+// plainStruct returns the plain struct of a type, its constructor from the
+// type, and its method converting it back.
+func (g *PlainGenerator) plainStruct(t *TypeGenerator) *codegen.Struct {
+	var members []jen.Code
+	for _, p := range t.allProperties() {
+		members = append(members, jen.Id(t.memberName(p)).Add(g.fieldType(p)).Tag(map[string]string{
+			"json": p.PropertyName() + ",omitempty",
+		}))
+		if p.HasNaturalLanguageMap() {
+			name := fmt.Sprintf(plainNaturalLanguageMap, p.PropertyName())
+			members = append(members, jen.Id(fmt.Sprintf(plainNaturalLanguageMap, t.memberName(p))).Map(jen.String()).String().Tag(map[string]string{
+				"json": name + ",omitempty",
+			}))
+		}
+	}
+	vocabType := jen.Qual(t.PublicPackage().Path(), t.InterfaceName())
+	return codegen.NewStruct(
+		fmt.Sprintf("%s is a plain representation of the %q type, for "+
+			"marshaling and template rendering without the property "+
+			"interfaces. Functional properties whose values are of a "+
+			"single kind have the closest Go type, non-functional "+
+			"properties are a %s, and other properties hold their "+
+			"serialized values. Unknown properties are not kept.",
+			t.InterfaceName(), t.TypeName(), plainListName),
+		t.InterfaceName(),
+		[]*codegen.Method{
+			codegen.NewCommentedValueMethod(
+				g.pkg.Path(),
+				plainToVocabMethod,
+				t.InterfaceName(),
+				/*params=*/ nil,
+				[]jen.Code{vocabType, jen.Error()},
+				[]jen.Code{
+					jen.List(jen.Id("m"), jen.Err()).Op(":=").Qual(g.pkg.Path(), plainToMapFn).Call(jen.Id(codegen.This())),
+					jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+					jen.Return(
+						jen.Qual(g.root.Path(), managerName).Values().Dot(
+							fmt.Sprintf("%s%s", t.deserializationFnName(), t.VocabName()),
+						).Call().Call(jen.Id("m"), jen.Map(jen.String()).String().Values()),
+					),
+				},
+				fmt.Sprintf("%s converts the plain representation into a %s.", plainToVocabMethod, t.InterfaceName())),
+		},
+		[]*codegen.Function{
+			codegen.NewCommentedFunction(
+				g.pkg.Path(),
+				fmt.Sprintf("%s%s", constructorName, t.InterfaceName()),
+				[]jen.Code{jen.Id("v").Add(vocabType)},
+				[]jen.Code{
+					jen.Op("*").Qual(g.pkg.Path(), t.InterfaceName()),
+					jen.Error(),
+				},
+				[]jen.Code{
+					jen.List(jen.Id("m"), jen.Err()).Op(":=").Id("v").Dot(serializeMethodName).Call(),
+					jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+					jen.Id("p").Op(":=").Op("&").Qual(g.pkg.Path(), t.InterfaceName()).Values(),
+					jen.Return(jen.Id("p"), jen.Qual(g.pkg.Path(), plainFromMapFn).Call(jen.Id("m"), jen.Id("p"))),
+				},
+				fmt.Sprintf("%s%s returns the plain representation of the %s.", constructorName, t.InterfaceName(), t.InterfaceName())),
+		},
+		members)
+}
+
+// fieldType determines the Go type of the field holding a property.
+//
+// Non-functional properties are a List. Functional properties whose values
+// are all of one value kind have the closest Go type, with a pointer for those
+// whose zero value is meaningful. Everything else, such as properties whose
+// values are ActivityStreams types, is left as the serialized value.
+func (g *PlainGenerator) fieldType(p Property) jen.Code {
+	if _, isList := p.(*NonFunctionalPropertyGenerator); isList {
+		return jen.Qual(g.pkg.Path(), plainListName)
+	}
+	kinds := propertyKinds(p)
+	if len(kinds) != 1 || !kinds[0].isValue() {
+		return jen.Interface()
+	}
+	switch kinds[0].Name.LowerName {
+	case "boolean":
+		return jen.Op("*").Bool()
+	case "float":
+		return jen.Op("*").Float64()
+	case "nonNegativeInteger":
+		return jen.Op("*").Int()
+	case "dateTime":
+		return jen.Op("*").Qual("time", "Time")
+	case langStringName:
+		return jen.Map(jen.String()).String()
+	default:
+		return jen.String()
+	}
+}
@@ -41,20 +41,22 @@ const (
 	beginMethod               = "Begin"
 	endMethod                 = "End"
 	emptyMethod               = "Empty"
+	valuesMethod              = "Values"
 	// Context string management
-	contextMethod = "JSONLDContext"
+	contextMethod    = "JSONLDContext"
+	addContextMethod = "AddJSONLDContext"
+	// Pooling of deserialized values
+	releaseMethod = "Release"
 	// Member names for generated code
 	unknownMemberName = "unknown"
 	// Reference to the rdf:langString member! Kludge: both of these must be
 	// kept in sync with the generated code.
 	langMapMember       = "rdfLangStringMember"
 	isLanguageMapMethod = "IsRDFLangString"
+	langStringName      = "langString"
 	// Kind Index constants
 	iriKindIndex           = -2
 	noneOrUnknownKindIndex = -1
-	// iterator specific
-	myIndexMemberName = "myIdx"
-	parentMemberName  = "parent"
 )
 
 // join appends a bunch of Go Code together, each on their own line.
@@ -96,6 +98,10 @@ type Kind struct {
 	// These <FuncName>Fn types are for qualified names of the functions.
 	// Expected to always be non-nil: a function is needed to deserialize.
 	DeserializeFn *jen.Statement
+	// TypeVocabURI and Typeless are only used for types, to determine from
+	// the "type" of a value whether it can be deserialized as the type.
+	TypeVocabURI *url.URL
+	Typeless     bool
 	// If any of these are nil at generation time, assume to call the method
 	// on the object directly (instead of a qualified function).
 	SerializeFn *jen.Statement
@@ -105,13 +111,15 @@ type Kind struct {
 	SerializeDef   *codegen.Function
 	DeserializeDef *codegen.Function
 	LessDef        *codegen.Function
+	ExtraDefs      []jen.Code
 }
 
 // NewKindForValue creates a Kind for a value type.
 func NewKindForValue(docName, idName, vocab string,
 	defType *jen.Statement,
 	isNilable, isURI bool,
-	serializeFn, deserializeFn, lessFn *codegen.Function) *Kind {
+	serializeFn, deserializeFn, lessFn *codegen.Function,
+	extraDefs []jen.Code) *Kind {
 	return &Kind{
 		Name: Identifier{
 			LowerName: docName,
@@ -127,6 +135,7 @@ func NewKindForValue(docName, idName, vocab string,
 		SerializeDef:   serializeFn,
 		DeserializeDef: deserializeFn,
 		LessDef:        lessFn,
+		ExtraDefs:      extraDefs,
 	}
 }
 
@@ -154,6 +163,76 @@ func NewKindForType(docName, idName, vocab string) *Kind {
 	}
 }
 
+// poolName returns the name of the pool of released values of a struct.
+func poolName(structName string) string {
+	return fmt.Sprintf("pool%s", structName)
+}
+
+// poolDefinition returns the pool of released values of a struct, from which its
+// deserialization function takes new values.
+func poolDefinition(structName string) *jen.Statement {
+	return jen.Commentf(
+		"%s holds the released values of %s, which are reused when deserializing.",
+		poolName(structName),
+		structName,
+	).Line().Var().Id(poolName(structName)).Op("=").Qual("sync", "Pool").Values(jen.Dict{
+		jen.Id("New"): jen.Func().Params().Interface().Block(
+			jen.Return(jen.Op("&").Id(structName).Values()),
+		),
+	})
+}
+
+// poolGetCode returns the code taking a value of a struct from its pool as
+// "this".
+func poolGetCode(structName string) *jen.Statement {
+	return jen.Id(codegen.This()).Op(":=").Id(poolName(structName)).Dot("Get").Call().Assert(jen.Op("*").Id(structName))
+}
+
+// releaseCode returns the code releasing a value held by a type or property.
+// Values not generated by this tool, which cannot be released, are left to the
+// garbage collector.
+func releaseCode(value *jen.Statement) *jen.Statement {
+	return jen.If(
+		jen.List(
+			jen.Id("r"),
+			jen.Id("ok"),
+		).Op(":=").Add(value.Clone()).Assert(jen.Interface(
+			jen.Id(releaseMethod).Params(),
+		)),
+		jen.Id("ok"),
+	).Block(
+		jen.Id("r").Dot(releaseMethod).Call(),
+	)
+}
+
+// addContextCode returns the code adding the JSONLD context of a value to the
+// map "m". Generated values add theirs directly, so that the context of a type
+// and all of its properties is built in a single map. Any other value, such as
+// an application's wrapper of a generated type, has its JSONLDContext merged.
+func addContextCode(value *jen.Statement) *jen.Statement {
+	return jen.If(
+		jen.List(
+			jen.Id("a"),
+			jen.Id("ok"),
+		).Op(":=").Add(value.Clone()).Assert(jen.Interface(
+			jen.Id(addContextMethod).Params(jen.Map(jen.String()).String()),
+		)),
+		jen.Id("ok"),
+	).Block(
+		jen.Id("a").Dot(addContextMethod).Call(jen.Id("m")),
+	).Else().Block(
+		jen.Commentf("Since the literal maps in this function are determined at\ncode-generation time, this loop should not overwrite an existing key with a\nnew value."),
+		jen.For(
+			jen.List(
+				jen.Id("k"),
+				jen.Id("v"),
+			).Op(":=").Range().Add(value.Clone()).Dot(contextMethod).Call(),
+		).Block(
+			jen.Id("m").Index(jen.Id("k")).Op("=").Id("v"),
+		),
+	)
+}
+
 // lessFnCode creates the correct code calling this Kind's less function
 // depending on whether the Kind is a value or a type.
 func (k Kind) lessFnCode(this, other *jen.Statement) *jen.Statement {
@@ -169,6 +248,38 @@ func (k Kind) lessFnCode(this, other *jen.Statement) *jen.Statement {
 	return lessCall
 }
 
+// compareFnCode creates the code returning the three-way comparison of two of
+// this Kind's values, depending on whether the Kind is a value or a type.
+func (k Kind) compareFnCode(this, other *jen.Statement) []jen.Code {
+	if !k.isValue() {
+		// LessFn is nil case -- call the Compare method directly on the
+		// LHS
+		return []jen.Code{
+			jen.Return(this.Clone().Dot(compareMethod).Call(other.Clone())),
+		}
+	}
+	// LessFn is indeed a function -- call this function both ways
+	return []jen.Code{
+		jen.If(
+			jen.List(
+				jen.Id("lhs"),
+				jen.Id("rhs"),
+			).Op(":=").List(
+				this.Clone(),
+				other.Clone(),
+			),
+			k.LessFn.Clone().Call(jen.Id("lhs"), jen.Id("rhs")),
+		).Block(
+			jen.Return(jen.Lit(-1)),
+		).Else().If(
+			k.LessFn.Clone().Call(jen.Id("rhs"), jen.Id("lhs")),
+		).Block(
+			jen.Return(jen.Lit(1)),
+		),
+		jen.Return(jen.Lit(0)),
+	}
+}
+
 // lessFnCode creates the correct code calling this Kind's deserialize function
 // depending on whether the Kind is a value or a type.
 func (k Kind) deserializeFnCode(m, ctx *jen.Statement) *jen.Statement {
@@ -189,6 +300,26 @@ func (k Kind) isValue() bool {
 	return k.LessFn != nil
 }
 
+// propertyKinds returns the kinds of values a property can have. The
+// rdf:langString kind is omitted for properties with a natural language map,
+// as it is only ever serialized as the map.
+func propertyKinds(p Property) (k []Kind) {
+	var kinds []Kind
+	switch v := p.(type) {
+	case *FunctionalPropertyGenerator:
+		kinds = v.GetKinds()
+	case *NonFunctionalPropertyGenerator:
+		kinds = v.GetKinds()
+	}
+	for _, kind := range kinds {
+		if p.HasNaturalLanguageMap() && kind.Name.LowerName == langStringName {
+			continue
+		}
+		k = append(k, kind)
+	}
+	return
+}
+
 // PropertyGenerator is a common base struct used in both Functional and
 // NonFunctional ActivityStreams properties. It provides common naming patterns,
 // logic, and common Go code to be generated.
@@ -240,7 +371,7 @@ func (p *PropertyGenerator) GetPublicPackage() Package {
 // The name parameter must match the LowerName of an Identifier.
 //
 // This feels very hacky.
-func (p *PropertyGenerator) SetKindFns(docName, idName, vocab string, qualKind *jen.Statement, deser *codegen.Method) error {
+func (p *PropertyGenerator) SetKindFns(docName, idName, vocab string, vocabURI *url.URL, typeless bool, qualKind *jen.Statement, deser *codegen.Method) error {
 	for i, kind := range p.kinds {
 		if kind.Name.LowerName == docName && kind.Vocab == vocab {
 			if kind.SerializeFn != nil || kind.DeserializeFn != nil || kind.LessFn != nil {
@@ -248,6 +379,8 @@ func (p *PropertyGenerator) SetKindFns(docName, idName, vocab string, qualKind *
 			}
 			kind.ConcreteKind = qualKind
 			kind.DeserializeFn = deser.On(managerInitName())
+			kind.TypeVocabURI = vocabURI
+			kind.Typeless = typeless
 			p.managerMethods = append(p.managerMethods, deser)
 			p.kinds[i] = kind
 			return nil
@@ -259,6 +392,8 @@ func (p *PropertyGenerator) SetKindFns(docName, idName, vocab string, qualKind *
 	k := NewKindForType(docName, idName, vocab)
 	k.ConcreteKind = qualKind
 	k.DeserializeFn = deser.On(managerInitName())
+	k.TypeVocabURI = vocabURI
+	k.Typeless = typeless
 	p.managerMethods = append(p.managerMethods, deser)
 	p.kinds = append(p.kinds, *k)
 	return nil
@@ -293,12 +428,6 @@ func (p *PropertyGenerator) InterfaceName() string {
 	return p.StructName()
 }
 
-// parentTypeInterfaceName is useful for iterators that need the base property
-// type's interface name.
-func (p *PropertyGenerator) parentTypeInterfaceName() string {
-	return strings.TrimSuffix(p.StructName(), "Iterator")
-}
-
 // PropertyName returns the name of this property, as defined in
 // specifications. It is not suitable for use in generated code function
 // identifiers.
@@ -387,46 +516,39 @@ func (p *PropertyGenerator) clearMethodName() string {
 func (p *PropertyGenerator) commonMethods() (m []*codegen.Method) {
 	if p.asIterator {
 		// Next & Prev methods
-		m = append(m, codegen.NewCommentedValueMethod(
-			p.GetPrivatePackage().Path(),
-			nextMethod,
-			p.StructName(),
-			/*params=*/ nil,
-			[]jen.Code{jen.Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
-			[]jen.Code{
-				jen.If(
-					jen.Id(codegen.This()).Dot(myIndexMemberName).Op("+").Lit(1).Op(">=").Id(codegen.This()).Dot(parentMemberName).Dot(lenMethod).Call(),
-				).Block(
-					jen.Return(jen.Nil()),
-				).Else().Block(
-					jen.Return(
-						jen.Id(codegen.This()).Dot(parentMemberName).Dot(atMethodName).Call(jen.Id(codegen.This()).Dot(myIndexMemberName).Op("+").Lit(1)),
+		for _, link := range []struct {
+			method, fn, comment string
+		}{
+			{nextMethod, listNextFn, "next"},
+			{prevMethod, listPrevFn, "previous"},
+		} {
+			m = append(m, codegen.NewCommentedValueMethod(
+				p.GetPrivatePackage().Path(),
+				link.method,
+				p.StructName(),
+				/*params=*/ nil,
+				[]jen.Code{jen.Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
+				[]jen.Code{
+					jen.If(
+						jen.Id("it").Op(":=").Qual(ListPackage(p.packageManager).Path(), link.fn).Call(jen.Op("&").Id(codegen.This())),
+						jen.Id("it").Op("!=").Nil(),
+					).Block(
+						jen.Return(jen.Id("it")),
 					),
-				),
-			},
-			fmt.Sprintf("%s returns the next iterator, or nil if there is no next iterator.", nextMethod)))
-		m = append(m, codegen.NewCommentedValueMethod(
-			p.GetPrivatePackage().Path(),
-			prevMethod,
-			p.StructName(),
-			/*params=*/ nil,
-			[]jen.Code{jen.Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
-			[]jen.Code{
-				jen.If(
-					jen.Id(codegen.This()).Dot(myIndexMemberName).Op("-").Lit(1).Op("<").Lit(0),
-				).Block(
 					jen.Return(jen.Nil()),
-				).Else().Block(
-					jen.Return(
-						jen.Id(codegen.This()).Dot(parentMemberName).Dot(atMethodName).Call(jen.Id(codegen.This()).Dot(myIndexMemberName).Op("-").Lit(1)),
-					),
-				),
-			},
-			fmt.Sprintf("%s returns the previous iterator, or nil if there is no previous iterator.", prevMethod)))
+				},
+				fmt.Sprintf("%s returns the %s iterator, or nil if there is no %s iterator. It remains correct when values are added to or removed from the property, including this one, without obtaining the iterator again.", link.method, link.comment, link.comment)))
+		}
 	}
 	return m
 }
 
+// newThisCode returns the code taking a value of this property from its pool as
+// "this", and setting its members to the values.
+func (p *PropertyGenerator) newThisCode(values jen.Dict) *jen.Statement {
+	return poolGetCode(p.StructName()).Line().Op("*").Id(codegen.This()).Op("=").Id(p.StructName()).Values(values)
+}
+
 // isMethodName returns the identifier to use for methods that determine if a
 // property holds a specific Kind of value.
 func (p *PropertyGenerator) isMethodName(i int) string {
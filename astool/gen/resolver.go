@@ -314,7 +314,8 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 			jen.List(
 				jen.Id("v"),
 				jen.Err(),
-			).Op(":=").Add(r.manGen.getDeserializationMethodForType(t).On(managerInitVarName).Call().Call(
+			).Op(":=").Add(r.manGen.getContextDeserializationMethodForType(t).On(managerInitVarName).Call().Call(
+				jen.Id("ctx"),
 				jen.Id("m"),
 				jen.Id("aliasMap"),
 			)),
@@ -373,7 +374,9 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 				jen.Op("!").Id("ok"),
 			).Block(
 				jen.Return(
-					jen.Qual("fmt", "Errorf").Call(
+					deserializeErrorCode(
+						r.types[0].PublicPackage(),
+						errMissingTypeName,
 						jen.Lit("cannot determine ActivityStreams type: 'type' property is missing"),
 					),
 				),
@@ -460,7 +463,7 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 				),
 			),
 		},
-		fmt.Sprintf("%s determines the ActivityStreams type of the payload, then applies the first callback function whose signature accepts the ActivityStreams value's type. This strictly assures that the callback function will only be passed ActivityStream objects whose type matches its interface. Returns an error if the ActivityStreams type does not match callbackers or is not a type handled by the generated code. If multiple types are present, it will check each one in order and apply only the first one. It returns an unhandled error for a multi-typed object if none of the types were able to be handled.", resolveMethod)))
+		fmt.Sprintf("%s determines the ActivityStreams type of the payload, then applies the first callback function whose signature accepts the ActivityStreams value's type. This strictly assures that the callback function will only be passed ActivityStream objects whose type matches its interface. Returns an error if the ActivityStreams type does not match callbackers or is not a type handled by the generated code. If multiple types are present, it will check each one in order and apply only the first one. It returns an unhandled error for a multi-typed object if none of the types were able to be handled. It returns the context's error, without deserializing the payload, if the context is done.", resolveMethod)))
 	return
 }
 
@@ -24,11 +24,15 @@ const (
 	serializeMethodName        = "Serialize"
 	deserializeFnName          = "Deserialize"
 	compareLessMethod          = "LessThan"
+	compareMethod              = "Compare"
 	getUnknownMethod           = "GetUnknownProperties"
 	unknownMember              = "unknown"
 	aliasMember                = "alias"
 	getMethodFormat            = "Get%s"
 	constructorName            = "New"
+	deserializeErrorName       = "DeserializeError"
+	errMissingTypeName         = "ErrMissingType"
+	errWrongTypeName           = "ErrWrongType"
 )
 
 const (
@@ -49,6 +53,49 @@ func typePropertyConstructorName() string {
 	return typePropertyConstructor
 }
 
+// DeserializeErrorDefinitions returns the errors of deserializing values as
+// types. They are in the package of the Type interface, so that both the
+// implementations and applications can refer to them.
+func DeserializeErrorDefinitions() jen.Code {
+	return jen.Commentf(
+		"%s indicates that a value cannot be deserialized as a type because it has no \"type\" property.",
+		errMissingTypeName,
+	).Line().Var().Id(errMissingTypeName).Error().Op("=").Qual("errors", "New").Call(
+		jen.Lit("no \"type\" property in map"),
+	).Line().Line().Commentf(
+		"%s indicates that a value cannot be deserialized as a type because its \"type\" property is another type.",
+		errWrongTypeName,
+	).Line().Var().Id(errWrongTypeName).Error().Op("=").Qual("errors", "New").Call(
+		jen.Lit("\"type\" property is of another type"),
+	).Line().Line().Commentf(
+		"%s is the error of deserializing a value as a type. Its Unwrap method returns the category of the error, %s or %s, so that errors.Is matches it as well.",
+		deserializeErrorName,
+		errMissingTypeName,
+		errWrongTypeName,
+	).Line().Type().Id(deserializeErrorName).Struct(
+		jen.Comment("Err is the category of the error."),
+		jen.Id("Err").Error(),
+		jen.Comment("Reason describes the error."),
+		jen.Id("Reason").String(),
+	).Line().Line().Comment(
+		"Error returns the reason of the error.",
+	).Line().Func().Params(jen.Id(codegen.This()).Id(deserializeErrorName)).Id("Error").Params().String().Block(
+		jen.Return(jen.Id(codegen.This()).Dot("Reason")),
+	).Line().Line().Comment(
+		"Unwrap returns the category of the error.",
+	).Line().Func().Params(jen.Id(codegen.This()).Id(deserializeErrorName)).Id("Unwrap").Params().Error().Block(
+		jen.Return(jen.Id(codegen.This()).Dot("Err")),
+	)
+}
+
+// deserializeErrorCode returns a DeserializeError of a category with a reason.
+func deserializeErrorCode(pkg Package, category string, reason jen.Code) *jen.Statement {
+	return jen.Qual(pkg.Path(), deserializeErrorName).Values(jen.Dict{
+		jen.Id("Err"):    jen.Qual(pkg.Path(), category),
+		jen.Id("Reason"): reason,
+	})
+}
+
 // TypeInterface returns the Type Interface that is needed for ActivityStream
 // types to compile for methods dealing with extending, in the inheritance
 // sense.
@@ -125,7 +172,7 @@ type Property interface {
 	PropertyName() string
 	StructName() string
 	InterfaceName() string
-	SetKindFns(docName, idName, vocab string, kind *jen.Statement, deser *codegen.Method) error
+	SetKindFns(docName, idName, vocab string, vocabURI *url.URL, typeless bool, kind *jen.Statement, deser *codegen.Method) error
 	DeserializeFnName() string
 	HasNaturalLanguageMap() bool
 }
@@ -146,6 +193,7 @@ type TypeGenerator struct {
 	typeless          bool
 	extendedBy        []*TypeGenerator
 	m                 *ManagerGenerator
+	memberTags        func(Property) map[string]string
 	cacheOnce         sync.Once
 	cachedStruct      *codegen.Struct
 }
@@ -230,6 +278,13 @@ func (t *TypeGenerator) AddRangeProperty(property Property) {
 	t.rangeProperties = append(t.rangeProperties, property)
 }
 
+// SetMemberTags sets the function returning the struct tags of the member
+// holding each property, such as those used by an ORM. Properties given nil or
+// empty tags have none. It must be called before Definition is called.
+func (t *TypeGenerator) SetMemberTags(fn func(Property) map[string]string) {
+	t.memberTags = fn
+}
+
 // apply propagates the manager's functions referring to this type's
 // implementation as if this type were a Kind.
 //
@@ -254,7 +309,7 @@ func (t *TypeGenerator) apply(m *ManagerGenerator) error {
 				continue
 			}
 			// Kluge: convert.toIdentifier must match this!
-			if e := p.SetKindFns(t.TypeName(), strings.Title(t.TypeName()), t.vocabName, kind, deser); e != nil {
+			if e := p.SetKindFns(t.TypeName(), strings.Title(t.TypeName()), t.vocabName, t.vocabURI, t.typeless, kind, deser); e != nil {
 				return e
 			}
 			propsSet[p] = true
@@ -383,13 +438,14 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 		members := t.members()
 		ser := t.serializationMethod()
 		less := t.lessMethod()
+		cmp := t.compareMethod()
 		get := t.getUnknownMethod()
 		deser := t.deserializationFn()
 		extendsFn, extendsMethod := t.extendsDefinition()
 		getters := t.allGetters()
 		setters := t.allSetters()
 		constructor := t.constructorFn()
-		ctxMethods := t.contextMethods()
+		ctxMethods, addCtxMethod := t.contextMethods()
 		t.cachedStruct = codegen.NewStruct(
 			t.Comments(),
 			t.StructName(),
@@ -400,6 +456,7 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 					extendsMethod,
 					ser,
 					less,
+					cmp,
 					get,
 				},
 				ctxMethods...),
@@ -415,6 +472,7 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 				deser,
 			},
 			members)
+		t.cachedStruct.AddUnlistedMethods(addCtxMethod, t.releaseMethod())
 	})
 	return t.cachedStruct
 }
@@ -484,7 +542,13 @@ func (t *TypeGenerator) members() (members []jen.Code) {
 	// Convert to jen.Code
 	members = make([]jen.Code, 0, len(p))
 	for _, property := range p {
-		members = append(members, jen.Id(t.memberName(property)).Qual(property.GetPublicPackage().Path(), property.InterfaceName()))
+		member := jen.Id(t.memberName(property)).Qual(property.GetPublicPackage().Path(), property.InterfaceName())
+		if t.memberTags != nil {
+			if tags := t.memberTags(property); len(tags) > 0 {
+				member = member.Tag(tags)
+			}
+		}
+		members = append(members, member)
 	}
 	// TODO: Normalize alias of properties when setting properties.
 	members = append(members, jen.Id(aliasMember).String())
@@ -669,6 +733,8 @@ func (t *TypeGenerator) getAllDisjointWith() (s []string) {
 			s = t.getAllChildrenExtendedBy(s, e)
 		}
 	}
+	// Sort to eliminate noise upon regeneration.
+	sort.Strings(s)
 	return s
 }
 
@@ -748,14 +814,34 @@ func (t *TypeGenerator) serializationMethod() (ser *codegen.Method) {
 			jen.Id("m").Index(jen.Id("k")).Op("=").Id("v"),
 		),
 	).Line().Commentf("End: Serialize unknown properties").Line()
-	header := jen.Id("m").Op(":=").Make(
-		jen.Map(jen.String()).Interface(),
+	// Size the map for the properties that are set, so that it is not grown
+	// while serializing them.
+	sizeCode := jen.Commentf("Begin: Count the properties to serialize").Line()
+	if t.typeless {
+		sizeCode.Add(jen.Id("n").Op(":=").Len(jen.Id(codegen.This()).Dot(unknownMember)).Line())
+	} else {
+		// The "type" property.
+		sizeCode.Add(jen.Id("n").Op(":=").Lit(1).Op("+").Len(jen.Id(codegen.This()).Dot(unknownMember)).Line())
+	}
+	for _, prop := range t.allProperties() {
+		sizeCode.Add(
+			jen.If(
+				jen.Id(codegen.This()).Dot(t.memberName(prop)).Op("!=").Nil(),
+			).Block(
+				jen.Id("n").Op("++"),
+			).Line())
+	}
+	sizeCode.Commentf("End: Count the properties to serialize").Line()
+	header := jen.Empty().Add(
+		sizeCode,
+		jen.Id("m").Op(":=").Make(
+			jen.Map(jen.String()).Interface(),
+			jen.Id("n"),
+		),
 	)
 	if !t.typeless {
-		header = jen.Empty().Add(
-			jen.Id("m").Op(":=").Make(
-				jen.Map(jen.String()).Interface(),
-			).Line(),
+		header.Add(
+			jen.Line(),
 			jen.Id("typeName").Op(":=").Lit(t.TypeName()).Line(),
 			jen.If(
 				jen.Len(jen.Id(codegen.This()).Dot(aliasMember)).Op(">").Lit(0),
@@ -783,9 +869,27 @@ func (t *TypeGenerator) serializationMethod() (ser *codegen.Method) {
 
 // lessMethod returns the method needed to compare a type with another type.
 func (t *TypeGenerator) lessMethod() (less *codegen.Method) {
-	lessCode := jen.Commentf("Begin: Compare known properties").Line()
+	less = codegen.NewCommentedValueMethod(
+		t.PrivatePackage().Path(),
+		compareLessMethod,
+		t.StructName(),
+		[]jen.Code{
+			jen.Id("o").Qual(t.PublicPackage().Path(), t.InterfaceName()),
+		},
+		[]jen.Code{jen.Bool()},
+		[]jen.Code{
+			jen.Return(jen.Id(codegen.This()).Dot(compareMethod).Call(jen.Id("o")).Op("<").Lit(0)),
+		},
+		fmt.Sprintf("%s computes if this %s is lesser, with an arbitrary but stable determination.", compareLessMethod, t.TypeName()))
+	return
+}
+
+// compareMethod returns the method needed to compare a type with another type
+// in a single pass, on which the LessThan method is based.
+func (t *TypeGenerator) compareMethod() (cmp *codegen.Method) {
+	cmpCode := jen.Commentf("Begin: Compare known properties").Line()
 	for _, prop := range t.allProperties() {
-		lessCode = lessCode.Add(
+		cmpCode = cmpCode.Add(
 			jen.Commentf("Compare property %q", prop.PropertyName()).Line(),
 			jen.If(
 				jen.List(
@@ -800,33 +904,28 @@ func (t *TypeGenerator) lessMethod() (less *codegen.Method) {
 				jen.Id("lhs").Op("!=").Nil().Op("&&").Id("rhs").Op("!=").Nil(),
 			).Block(
 				jen.If(
-					jen.Id("lhs").Dot(compareLessMethod).Call(
+					jen.Id("c").Op(":=").Id("lhs").Dot(compareMethod).Call(
 						jen.Id("rhs"),
 					),
+					jen.Id("c").Op("!=").Lit(0),
 				).Block(
-					jen.Return(jen.True()),
-				).Else().If(
-					jen.Id("rhs").Dot(compareLessMethod).Call(
-						jen.Id("lhs"),
-					),
-				).Block(
-					jen.Return(jen.False()),
+					jen.Return(jen.Id("c")),
 				),
 			).Else().If(
 				jen.Id("lhs").Op("==").Nil().Op("&&").Id("rhs").Op("!=").Nil(),
 			).Block(
 				jen.Commentf("Nil is less than anything else"),
-				jen.Return(jen.True()),
+				jen.Return(jen.Lit(-1)),
 			).Else().If(
-				jen.Id("rhs").Op("!=").Nil().Op("&&").Id("rhs").Op("==").Nil(),
+				jen.Id("lhs").Op("!=").Nil().Op("&&").Id("rhs").Op("==").Nil(),
 			).Block(
 				jen.Commentf("Anything else is greater than nil"),
-				jen.Return(jen.False()),
+				jen.Return(jen.Lit(1)),
 			),
-			jen.Commentf("Else: Both are nil"),
+			jen.Commentf("Else: Both are nil or equal"),
 			jen.Line())
 	}
-	lessCode = lessCode.Commentf("End: Compare known properties").Line()
+	cmpCode = cmpCode.Commentf("End: Compare known properties").Line()
 	unknownCode := jen.Commentf("Begin: Compare unknown properties (only by number of them)").Line().If(
 		jen.Len(
 			jen.Id(codegen.This()).Dot(unknownMember),
@@ -834,7 +933,7 @@ func (t *TypeGenerator) lessMethod() (less *codegen.Method) {
 			jen.Id("o").Dot(getUnknownMethod).Call(),
 		),
 	).Block(
-		jen.Return(jen.True()),
+		jen.Return(jen.Lit(-1)),
 	).Else().If(
 		jen.Len(
 			jen.Id("o").Dot(getUnknownMethod).Call(),
@@ -842,26 +941,53 @@ func (t *TypeGenerator) lessMethod() (less *codegen.Method) {
 			jen.Id(codegen.This()).Dot(unknownMember),
 		),
 	).Block(
-		jen.Return(jen.False()),
+		jen.Return(jen.Lit(1)),
 	).Commentf("End: Compare unknown properties (only by number of them)").Line()
-	less = codegen.NewCommentedValueMethod(
+	cmp = codegen.NewCommentedValueMethod(
 		t.PrivatePackage().Path(),
-		compareLessMethod,
+		compareMethod,
 		t.StructName(),
 		[]jen.Code{
 			jen.Id("o").Qual(t.PublicPackage().Path(), t.InterfaceName()),
 		},
-		[]jen.Code{jen.Bool()},
+		[]jen.Code{jen.Int()},
 		[]jen.Code{
-			lessCode,
+			cmpCode,
 			unknownCode,
 			jen.Commentf("All properties are the same."),
-			jen.Return(jen.False()),
+			jen.Return(jen.Lit(0)),
 		},
-		fmt.Sprintf("%s computes if this %s is lesser, with an arbitrary but stable determination.", compareLessMethod, t.TypeName()))
+		fmt.Sprintf("%s compares this %s with another, with an arbitrary but stable determination. It returns a negative number if this is lesser, a positive number if this is greater, and 0 if neither is. Each property is compared once, so comparing deeply nested values costs half as much as calling %s both ways.", compareMethod, t.TypeName(), compareLessMethod))
 	return
 }
 
+// knownPropertiesName returns the name of the package variable containing the
+// names of the properties of this type.
+func (t *TypeGenerator) knownPropertiesName() string {
+	return fmt.Sprintf("known%sProperties", t.StructName())
+}
+
+// KnownPropertiesDefinition returns the package variable containing the names
+// of all the properties of this type, including natural language maps. It is
+// used when deserializing to determine whether a property is unknown, which
+// is faster than comparing the name to each of them in turn.
+//
+// It must be in the same package as the Definition.
+func (t *TypeGenerator) KnownPropertiesDefinition() jen.Code {
+	names := make(jen.Dict)
+	for _, prop := range t.allProperties() {
+		names[jen.Lit(prop.PropertyName())] = jen.Values()
+		if prop.HasNaturalLanguageMap() {
+			names[jen.Lit(prop.PropertyName()+"Map")] = jen.Values()
+		}
+	}
+	return jen.Comment(codegen.FormatPackageDocumentation(fmt.Sprintf(
+		"%s are the names of the properties of the %q type, which are not unknown properties.",
+		t.knownPropertiesName(),
+		t.TypeName(),
+	))).Line().Var().Id(t.knownPropertiesName()).Op("=").Map(jen.String()).Struct().Values(names)
+}
+
 // deserializationFn returns free function reference that can be used to
 // treat a TypeGenerator as another property's Kind.
 func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
@@ -884,24 +1010,15 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 			).Line())
 	}
 	deserCode = deserCode.Commentf("End: Known property deserialization").Line()
-	knownProps := jen.Commentf("Begin: Code that ensures a property name is unknown").Line()
-	for i, prop := range t.allProperties() {
-		if i > 0 {
-			knownProps = knownProps.Else()
-		}
-		knownProps = knownProps.If(
-			jen.Id("k").Op("==").Lit(prop.PropertyName()),
-		).Block(
-			jen.Continue(),
-		)
-		if prop.HasNaturalLanguageMap() {
-			knownProps = knownProps.Else().If(
-				jen.Id("k").Op("==").Lit(prop.PropertyName() + "Map"),
-			).Block(
-				jen.Continue(),
-			)
-		}
-	}
+	knownProps := jen.Commentf("Begin: Code that ensures a property name is unknown").Line().If(
+		jen.List(
+			jen.Id("_"),
+			jen.Id("ok"),
+		).Op(":=").Id(t.knownPropertiesName()).Index(jen.Id("k")),
+		jen.Id("ok"),
+	).Block(
+		jen.Continue(),
+	)
 	knownProps = knownProps.Commentf("End: Code that ensures a property name is unknown").Line()
 	unknownCode := jen.Commentf("Begin: Unknown deserialization").Line().For(
 		jen.List(
@@ -924,11 +1041,7 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 			jen.Id("ok"),
 		).Block(
 			jen.Id("alias").Op("=").Id("a"),
-		).Line(),
-		jen.Id(codegen.This()).Op(":=").Op("&").Id(t.StructName()).Values(jen.Dict{
-			jen.Id(aliasMember):   jen.Id("alias"),
-			jen.Id(unknownMember): jen.Make(jen.Map(jen.String()).Interface()),
-		}),
+		),
 	)
 	typed := jen.Empty()
 	if !t.typeless {
@@ -944,11 +1057,7 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 			).Block(
 				jen.Id("alias").Op("=").Id("a"),
 				jen.Id("aliasPrefix").Op("=").Id("a").Op("+").Lit(":"),
-			).Line(),
-			jen.Id(codegen.This()).Op(":=").Op("&").Id(t.StructName()).Values(jen.Dict{
-				jen.Id(aliasMember):   jen.Id("alias"),
-				jen.Id(unknownMember): jen.Make(jen.Map(jen.String()).Interface()),
-			}),
+			),
 		)
 		typed.Add(
 			jen.If(
@@ -960,7 +1069,7 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 			).Block(
 				jen.Return(
 					jen.Nil(),
-					jen.Qual("fmt", "Errorf").Call(jen.Lit("no \"type\" property in map")),
+					deserializeErrorCode(t.PublicPackage(), errMissingTypeName, jen.Lit("no \"type\" property in map")),
 				),
 			).Else().If(
 				jen.List(
@@ -978,7 +1087,7 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 				).Block(
 					jen.Return(
 						jen.Nil(),
-						jen.Qual("fmt", "Errorf").Call(jen.Lit("\"type\" property is not of %q type: %s"), jen.Lit(t.TypeName()), jen.Id("typeName")),
+						deserializeErrorCode(t.PublicPackage(), errWrongTypeName, jen.Qual("fmt", "Sprintf").Call(jen.Lit("\"type\" property is not of %q type: %s"), jen.Lit(t.TypeName()), jen.Id("typeName"))),
 					),
 				),
 				jen.Commentf("Fall through, success in finding a proper Type"),
@@ -1015,14 +1124,14 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 				).Block(
 					jen.Return(
 						jen.Nil(),
-						jen.Qual("fmt", "Errorf").Call(jen.Lit("could not find a \"type\" property of value %q"), jen.Lit(t.TypeName())),
+						deserializeErrorCode(t.PublicPackage(), errWrongTypeName, jen.Qual("fmt", "Sprintf").Call(jen.Lit("could not find a \"type\" property of value %q"), jen.Lit(t.TypeName()))),
 					),
 				),
 				jen.Commentf("Fall through, success in finding a proper Type"),
 			).Else().Block(
 				jen.Return(
 					jen.Nil(),
-					jen.Qual("fmt", "Errorf").Call(jen.Lit("\"type\" property is unrecognized type: %T"), jen.Id("typeValue")),
+					deserializeErrorCode(t.PublicPackage(), errWrongTypeName, jen.Qual("fmt", "Sprintf").Call(jen.Lit("\"type\" property is unrecognized type: %T"), jen.Id("typeValue"))),
 				),
 			),
 		)
@@ -1035,6 +1144,7 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 		[]jen.Code{
 			header,
 			typed,
+			t.newThisCode(),
 			deserCode,
 			unknownCode,
 			jen.Return(jen.Id(codegen.This()), jen.Nil()),
@@ -1043,6 +1153,51 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 	return
 }
 
+// newThisCode returns the code taking a value of this type from its pool as
+// "this", with the "alias" and keeping the map of unknown properties of a
+// released value.
+func (t *TypeGenerator) newThisCode() *jen.Statement {
+	return poolGetCode(t.StructName()).Line().Id(codegen.This()).Dot(aliasMember).Op("=").Id("alias").Line().If(
+		jen.Id(codegen.This()).Dot(unknownMember).Op("==").Nil(),
+	).Block(
+		jen.Id(codegen.This()).Dot(unknownMember).Op("=").Make(jen.Map(jen.String()).Interface()),
+	)
+}
+
+// releaseMethod returns the Release method, returning this type to its pool
+// after releasing its properties.
+func (t *TypeGenerator) releaseMethod() *codegen.Method {
+	var body []jen.Code
+	for _, prop := range t.allProperties() {
+		body = append(body, releaseCode(jen.Id(codegen.This()).Dot(t.memberName(prop))))
+	}
+	body = append(body,
+		jen.Id(unknownMember).Op(":=").Id(codegen.This()).Dot(unknownMember),
+		jen.For(
+			jen.Id("k").Op(":=").Range().Id(unknownMember),
+		).Block(
+			jen.Delete(jen.Id(unknownMember), jen.Id("k")),
+		),
+		jen.Op("*").Id(codegen.This()).Op("=").Id(t.StructName()).Values(jen.Dict{
+			jen.Id(unknownMember): jen.Id(unknownMember),
+		}),
+		jen.Id(poolName(t.StructName())).Dot("Put").Call(jen.Id(codegen.This())),
+	)
+	return codegen.NewCommentedPointerMethod(
+		t.PrivatePackage().Path(),
+		releaseMethod,
+		t.StructName(),
+		/*params=*/ nil,
+		/*ret=*/ nil,
+		body,
+		fmt.Sprintf("%s returns this type and its properties to the pools from which they are reused when deserializing. None of them may be used afterwards, so it is only called by applications that are done with a deserialized value, to reduce the allocations of deserializing the next one.", releaseMethod))
+}
+
+// PoolDefinition returns the pool of released values of this type.
+func (t *TypeGenerator) PoolDefinition() *jen.Statement {
+	return poolDefinition(t.StructName())
+}
+
 // getUnknownMethod returns the GetUnknown helper used to compare which type is
 // LessThan. This method is API-leaky and shouldn't be used by normal app
 // developers.
@@ -1150,44 +1305,43 @@ func (t *TypeGenerator) constructorFn() *codegen.Function {
 		fmt.Sprintf("%s%s creates a new %s type", constructorName, t.StructName(), t.TypeName()))
 }
 
-// contextMethod returns a map of the context's vocabulary
-func (t *TypeGenerator) contextMethods() []*codegen.Method {
-	helperName := fmt.Sprintf("helper%s", contextMethod)
+// contextMethods returns the methods building a map of the context's
+// vocabulary, and the exported AddJSONLDContext method they use, which is not
+// part of the type's interface.
+func (t *TypeGenerator) contextMethods() (methods []*codegen.Method, addCtx *codegen.Method) {
+	helperName := fmt.Sprintf("helper%s", addContextMethod)
 	helper := codegen.NewCommentedValueMethod(
 		t.PrivatePackage().Path(),
 		helperName,
 		t.StructName(),
-		[]jen.Code{jen.Id("i").Id(jsonLDContextInterfaceName), jen.Id("toMerge").Map(jen.String()).String()},
-		[]jen.Code{jen.Map(jen.String()).String()},
+		[]jen.Code{jen.Id("i").Id(jsonLDContextInterfaceName), jen.Id("m").Map(jen.String()).String()},
+		/*ret=*/ nil,
 		[]jen.Code{
 			jen.If(
 				jen.Id("i").Op("==").Nil(),
 			).Block(
-				jen.Return(jen.Id("toMerge")),
+				jen.Return(),
 			),
-			jen.For(
-				jen.List(
-					jen.Id("k"),
-					jen.Id("v"),
-				).Op(":=").Range().Id("i").Dot(contextMethod).Call(),
-			).Block(
-				jen.Commentf("Since the literal maps in this function are determined at\ncode-generation time, this loop should not overwrite an existing key with a\nnew value."),
-				jen.Id("toMerge").Index(jen.Id("k")).Op("=").Id("v"),
-			),
-			jen.Return(jen.Id("toMerge")),
-		},
-		fmt.Sprintf("%s obtains the context uris and their aliases from a property, if it is not nil.", helperName))
-	contextKind := jen.Id("m").Op(":=").Map(jen.String()).String().Values(
-		jen.Dict{
-			jen.Lit(t.vocabURI.String()): jen.Id(codegen.This()).Dot(aliasMember),
+			addContextCode(jen.Id("i")),
 		},
-	).Line()
+		fmt.Sprintf("%s adds the context uris and their aliases from a property to the map, if it is not nil.", helperName))
+	addContext := []jen.Code{
+		jen.Id("m").Index(jen.Lit(t.vocabURI.String())).Op("=").Id(codegen.This()).Dot(aliasMember),
+	}
 	for _, property := range t.allProperties() {
-		contextKind.Add(
-			jen.Id("m").Op("=").Id(codegen.This()).Dot(helperName).Call(
+		addContext = append(addContext,
+			jen.Id(codegen.This()).Dot(helperName).Call(
 				jen.Id(codegen.This()).Dot(t.memberName(property)),
-				jen.Id("m")).Line())
+				jen.Id("m")))
 	}
+	addCtx = codegen.NewCommentedValueMethod(
+		t.PrivatePackage().Path(),
+		addContextMethod,
+		t.StructName(),
+		[]jen.Code{jen.Id("m").Map(jen.String()).String()},
+		/*ret=*/ nil,
+		addContext,
+		fmt.Sprintf("%s adds the JSONLD URIs required in the context string for this type and the specific properties that are set to the map, along with the aliases used to import them. Unlike %s, it does not create a map for the type and each of its properties.", addContextMethod, contextMethod))
 	ctxMethod := codegen.NewCommentedValueMethod(
 		t.PrivatePackage().Path(),
 		contextMethod,
@@ -1195,9 +1349,10 @@ func (t *TypeGenerator) contextMethods() []*codegen.Method {
 		/*params=*/ nil,
 		[]jen.Code{jen.Map(jen.String()).String()},
 		[]jen.Code{
-			contextKind,
+			jen.Id("m").Op(":=").Make(jen.Map(jen.String()).String()),
+			jen.Id(codegen.This()).Dot(addContextMethod).Call(jen.Id("m")),
 			jen.Return(jen.Id("m")),
 		},
 		fmt.Sprintf("%s returns the JSONLD URIs required in the context string for this type and the specific properties that are set. The value in the map is the alias used to import the type and its properties.", contextMethod))
-	return []*codegen.Method{helper, ctxMethod}
+	return []*codegen.Method{helper, ctxMethod}, addCtx
 }
@@ -0,0 +1,161 @@
+package gen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	typeScriptOneOrMany          = "OneOrMany"
+	typeScriptString             = "string"
+	typeScriptBoolean            = "boolean"
+	typeScriptNumber             = "number"
+	typeScriptUnknown            = "unknown"
+	typeScriptNaturalLanguage    = "{ [language: string]: string }"
+	typeScriptNaturalLanguageMap = "%sMap"
+)
+
+// TypeScriptGenerator generates TypeScript type definitions mirroring the JSON
+// serialization of the ActivityStreams types, for clients consuming it.
+type TypeScriptGenerator struct {
+	types []*TypeGenerator
+	// Computed from the types.
+	byName map[string]*TypeGenerator
+}
+
+// NewTypeScriptGenerator creates a new generator for the TypeScript
+// definitions of the types.
+//
+// Must be constructed after all TypeGenerators.
+func NewTypeScriptGenerator(tgs []*TypeGenerator) *TypeScriptGenerator {
+	g := &TypeScriptGenerator{
+		types:  tgs,
+		byName: make(map[string]*TypeGenerator, len(tgs)),
+	}
+	for _, t := range tgs {
+		g.byName[t.InterfaceName()] = t
+	}
+	return g
+}
+
+// Definition returns the contents of a TypeScript declaration file with an
+// interface for every type.
+//
+// Each interface lists every property of its type, including the properties of
+// the types it extends, as optional members. Non-functional properties may be
+// a single value or an array of values. Properties whose values can be
+// ActivityStreams types may also be an IRI. As every member is optional, a type
+// is assignable to the types it extends, so these are the only ones listed
+// among the values of a property.
+func (g *TypeScriptGenerator) Definition() string {
+	var b strings.Builder
+	b.WriteString("// Code generated by astool. DO NOT EDIT.\n\n")
+	b.WriteString("/** A non-functional property, which is either a single value or an array of values. */\n")
+	b.WriteString(fmt.Sprintf("export type %s<T> = T | T[];\n", typeScriptOneOrMany))
+	for _, t := range g.types {
+		b.WriteString("\n")
+		b.WriteString(typeScriptComment(t.Comments()))
+		b.WriteString(fmt.Sprintf("export interface %s {\n", t.InterfaceName()))
+		b.WriteString(fmt.Sprintf("  %q?: %s;\n", "@context", typeScriptUnknown))
+		fields := make(map[string]string)
+		for _, p := range t.allProperties() {
+			fields[p.PropertyName()] = g.fieldType(p)
+			if p.HasNaturalLanguageMap() {
+				fields[fmt.Sprintf(typeScriptNaturalLanguageMap, p.PropertyName())] = typeScriptNaturalLanguage
+			}
+		}
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			b.WriteString(fmt.Sprintf("  %s?: %s;\n", name, fields[name]))
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+// fieldType determines the TypeScript type of a property.
+func (g *TypeScriptGenerator) fieldType(p Property) string {
+	if p.VocabName() == JSONLDVocabName && p.PropertyName() == JSONLDIdName {
+		return typeScriptString
+	}
+	var union []string
+	seen := make(map[string]bool)
+	add := func(s string) {
+		if !seen[s] {
+			seen[s] = true
+			union = append(union, s)
+		}
+	}
+	kinds := propertyKinds(p)
+	for _, k := range kinds {
+		if k.isValue() {
+			add(valueTypeScriptType(k))
+		} else if name := fmt.Sprintf("%s%s", k.Vocab, k.Name.CamelName); !g.extendsAny(g.byName[name], kinds) {
+			add(name)
+		}
+	}
+	if len(union) == 0 {
+		return typeScriptUnknown
+	}
+	for _, k := range kinds {
+		if !k.isValue() {
+			// The IRI of an ActivityStreams type.
+			add(typeScriptString)
+			break
+		}
+	}
+	s := strings.Join(union, " | ")
+	if _, isList := p.(*NonFunctionalPropertyGenerator); isList {
+		return fmt.Sprintf("%s<%s>", typeScriptOneOrMany, s)
+	}
+	return s
+}
+
+// extendsAny determines whether the type extends, directly or indirectly, any
+// of the types among the kinds.
+func (g *TypeScriptGenerator) extendsAny(t *TypeGenerator, kinds []Kind) bool {
+	if t == nil {
+		return false
+	}
+	for _, ext := range t.Extends() {
+		for _, k := range kinds {
+			if !k.isValue() && fmt.Sprintf("%s%s", k.Vocab, k.Name.CamelName) == ext.InterfaceName() {
+				return true
+			}
+		}
+		if g.extendsAny(ext, kinds) {
+			return true
+		}
+	}
+	return false
+}
+
+// valueTypeScriptType maps a value Kind to a TypeScript type.
+func valueTypeScriptType(k Kind) string {
+	switch k.Name.LowerName {
+	case "boolean":
+		return typeScriptBoolean
+	case "float", "nonNegativeInteger":
+		return typeScriptNumber
+	case langStringName:
+		return typeScriptNaturalLanguage
+	default:
+		return typeScriptString
+	}
+}
+
+// typeScriptComment formats the first paragraph of a comment as a TSDoc
+// comment, leaving out the examples.
+func typeScriptComment(comment string) string {
+	comment = strings.TrimSpace(strings.SplitN(comment, "\n\n", 2)[0])
+	if len(comment) == 0 {
+		return ""
+	}
+	comment = strings.Replace(comment, "*/", "*\\/", -1)
+	return fmt.Sprintf("/**\n * %s\n */\n", strings.Replace(comment, "\n", "\n * ", -1))
+}
@@ -19,10 +19,16 @@ import (
 )
 
 const (
-	pathFlag = "path"
-	specFlag = "spec"
-	helpText = `
-Usage: astool [-spec=<file>] [-path=<gopath prefix>] <directory>
+	pathFlag  = "path"
+	specFlag  = "spec"
+	typeFlag  = "type"
+	flatFlag  = "flat"
+	plainFlag = "plain"
+	tsFlag    = "typescript"
+	fuzzFlag  = "fuzz"
+	benchFlag = "bench"
+	helpText  = `
+Usage: astool [-spec=<file>] [-path=<gopath prefix>] [-type=<type>] [-flat] [-plain] [-typescript] [-fuzz] [-bench] <directory>
 
 The ActivityStreams tool (astool) is used to generate ActivityStreams types,
 properties, and values from an OWL2 RDF specification. The tool generates the
@@ -54,12 +60,24 @@ Types specification as the root:
 
     astool -spec activitystreams.jsonld -spec derived_extension.jsonld .
 
+Applications may define their own vocabularies this way, as OWL ontologies in
+JSON-LD like the ones distributed with this tool. Generating them together with
+the vocabularies they build upon produces a single Manager and resolvers
+handling all of their types, so that the application's own types are
+deserialized alongside the ActivityStreams ones:
+
+    astool -spec activitystreams.jsonld -spec security-v1.jsonld \
+        -spec toot.jsonld -spec my_vocabulary.jsonld .
+
 The following directories are generated in the current working directory (cwd)
 given a particular specification for a <vocabulary>:
 
     cwd/
         gen_doc.go
             - Package level documentation.
+	gen_columns.go
+	    - Metadata mapping the properties of types to relational
+	      database columns.
 	gen_init.go
 	    - Init function definitions.
 	gen_manager.go
@@ -94,6 +112,13 @@ given a particular specification for a <vocabulary>:
 	    gen_resolver_utils.go
 	        - Functions aiding in handling resolver errors.
 
+	graphql/
+	    gen_schema.go
+	        - GraphQL schema definition of the types.
+	    gen_resolver.go
+	        - Resolves the GraphQL schema's query and fields using a
+		  database.
+
 	vocab/
 	    gen_doc.go
 	        - Package level documentation.
@@ -143,6 +168,42 @@ the 'path' flag, which will prefix all generated code with the 'path':
 
     astool -spec specification.jsonld -path mymodule ./subdir
 
+Applications needing only a handful of types may generate just those with the
+'type' flag, to keep the size of their binaries down. The types they extend and
+the properties of all of them are generated too, along with a Manager and
+resolvers handling only these types:
+
+    astool -spec activitystreams.jsonld -type Note,Create,Person ./subdir
+
+Each type and property is generated into its own package, unless the 'flat'
+flag is set, in which case those of each vocabulary share a single package:
+
+    astool -flat -spec specification.jsonld ./subdir
+
+Applications wanting simple structs for marshaling or template rendering may
+set the 'plain' flag, which also generates a 'plain' package with a struct for
+each type. Its fields are exported and have JSON tags, and it can be converted
+to and from the type.
+
+Front-end clients consuming the JSON of the types may use the TypeScript
+definitions written to 'gen_types.d.ts' when the 'typescript' flag is set.
+
+The 'fuzz' flag also writes 'gen_fuzz.go', with a go-fuzz target for every
+deserialization function of the Manager. It is only built with the 'gofuzz'
+build tag, so it does not change the package otherwise:
+
+    go-fuzz-build -func FuzzDeserializeNoteActivityStreams
+
+Similarly, the 'bench' flag also writes a test file for each type, with
+benchmarks of serializing, deserializing, and comparing it, to measure changes
+to the generated code:
+
+    go test -run NONE -bench ActivityStreamsNote
+
+The generated code may be customized, such as by adding struct tags or methods
+to types, by a program giving the convert.Converter its own convert.Plugin
+instead of running this tool.
+
 `
 )
 
@@ -227,6 +288,12 @@ type CommandLineFlags struct {
 	// Flags
 	specs list
 	path  settableString
+	types list
+	flat  bool
+	plain bool
+	ts    bool
+	fuzz  bool
+	bench bool
 	// Additional data
 	pathAutoDetected bool
 	// Destination on the file system for the code generation
@@ -243,6 +310,12 @@ func NewCommandLineFlags() (*CommandLineFlags, error) {
 		pathFlag,
 		"Package path to use for all generated package paths. If using GOPATH, this is automatically detected as $GOPATH/<path>/ when generating in a subdirectory. Cannot be explicitly set to be empty.")
 	flag.Var(&(c.specs), specFlag, "Input JSON-LD specification used to generate Go code.")
+	flag.BoolVar(&c.flat, flatFlag, false, "Generate the types and properties of each vocabulary into a single package, instead of one package for each.")
+	flag.BoolVar(&c.plain, plainFlag, false, "Also generate plain structs with JSON tags mirroring the types, and conversions between the two, in the 'plain' subpackage.")
+	flag.BoolVar(&c.ts, tsFlag, false, "Also generate TypeScript definitions of the types, for clients consuming their JSON.")
+	flag.BoolVar(&c.fuzz, fuzzFlag, false, "Also generate go-fuzz targets for the deserialization functions, built with the 'gofuzz' build tag.")
+	flag.BoolVar(&c.bench, benchFlag, false, "Also generate benchmarks of serializing, deserializing, and comparing each type.")
+	flag.Var(&(c.types), typeFlag, "Type to generate, along with the types it extends and their properties. All types are generated if omitted.")
 	flag.Parse()
 	args := flag.Args()
 	if len(args) != 1 {
@@ -308,11 +381,13 @@ func (c *CommandLineFlags) ReadSpecs() (j []rdf.JSONLD, err error) {
 		var b []byte
 		b, err = ioutil.ReadFile(spec)
 		if err != nil {
+			err = fmt.Errorf("cannot read specification %q: %s", spec, err)
 			return
 		}
 		var inputJSON map[string]interface{}
 		err = json.Unmarshal(b, &inputJSON)
 		if err != nil {
+			err = fmt.Errorf("specification %q is not a JSON-LD document: %s", spec, err)
 			return
 		}
 		j = append(j, inputJSON)
@@ -335,6 +410,16 @@ func (c *CommandLineFlags) Path() string {
 	return c.path.String()
 }
 
+// PackagePolicy returns the policy for the packages of the generated types and
+// properties: one package for each vocabulary if the 'flat' flag is set, and
+// one for each type and property otherwise.
+func (c *CommandLineFlags) PackagePolicy() convert.PackagePolicy {
+	if c.flat {
+		return convert.FlatUnderRoot
+	}
+	return convert.IndividualUnderRoot
+}
+
 // NewPackageManager creates the correct package manager for the flag inputs.
 func (c *CommandLineFlags) NewPackageManager() *gen.PackageManager {
 	g := gen.NewPackageManager(c.Path(), "")
@@ -384,18 +469,25 @@ func main() {
 	fmt.Printf("Parsing %d vocabularies...\n", len(inputJSONs))
 	p, err := rdf.ParseVocabularies(registry, inputJSONs)
 	if err != nil {
-		panic(err)
+		fmt.Printf("cannot parse the vocabularies of %s: %s\n", cmd.specs.String(), err)
+		return
 	}
 
 	// Convert to generated code
 	fmt.Printf("Converting %d types, properties, and values...\n", p.Size())
 	c := &convert.Converter{
 		GenRoot:       cmd.NewPackageManager(),
-		PackagePolicy: convert.IndividualUnderRoot,
+		PackagePolicy: cmd.PackagePolicy(),
+		Types:         cmd.types,
+		PlainStructs:  cmd.plain,
+		TypeScript:    cmd.ts,
+		Fuzz:          cmd.fuzz,
+		Benchmarks:    cmd.bench,
 	}
 	f, err := c.Convert(p)
 	if err != nil {
-		panic(err)
+		fmt.Println(err)
+		return
 	}
 
 	// Write generated code
@@ -411,11 +503,19 @@ func main() {
 			panic(e)
 		}
 
+		path := dir + string(os.PathSeparator) + file.FileName
+		if file.F == nil {
+			if e := ioutil.WriteFile(path, []byte(file.Raw), 0666); e != nil {
+				panic(e)
+			}
+			continue
+		}
+
 		// Standard generated Go code header.
 		// https://github.com/golang/go/issues/13560#issuecomment-288457920
 		file.F.HeaderComment("// Code generated by astool. DO NOT EDIT.\n")
 
-		if e := file.F.Save(dir + string(os.PathSeparator) + file.FileName); e != nil {
+		if e := file.F.Save(path); e != nil {
 			panic(e)
 		}
 	}
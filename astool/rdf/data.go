@@ -192,6 +192,9 @@ type VocabularyValue struct {
 	SerializeFn    *codegen.Function
 	DeserializeFn  *codegen.Function
 	LessFn         *codegen.Function
+	// ExtraDefs are optional package-level definitions generated alongside
+	// the functions above, such as configuration used by SerializeFn.
+	ExtraDefs []jen.Code
 }
 
 // String returns a printable version of this value for debugging.
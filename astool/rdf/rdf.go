@@ -295,13 +295,20 @@ func (r *RDFRegistry) getNode(s string) (n RDFNode, e error) {
 			return
 		}
 	} else if len(strs) == 1 {
+		// Use the most specific ontology, since the spec URI of one
+		// ontology may be a prefix of another's, such as "http://schema.org"
+		// and "http://schema.org/".
+		var found Ontology
 		for _, ontology := range r.ontologies {
-			if strings.HasPrefix(s, ontology.SpecURI()) {
-				n, e = ontology.GetByName(s)
-				return
+			if strings.HasPrefix(s, ontology.SpecURI()) && (found == nil || len(ontology.SpecURI()) > len(found.SpecURI())) {
+				found = ontology
 			}
 		}
-		e = fmt.Errorf("getNode could not find ontology for %s", s)
+		if found == nil {
+			e = fmt.Errorf("getNode could not find ontology for %s", s)
+			return
+		}
+		n, e = found.GetByName(s)
 		return
 	} else {
 		e = fmt.Errorf("getNode given unhandled node name: %s", s)
@@ -437,8 +437,37 @@ func (f *float) Apply(key string, value interface{}, ctx *rdf.ParsingContext) (b
 				floatSpec,
 				jen.Id("float64"),
 				[]jen.Code{
+					jen.List(
+						jen.Id("f"),
+						jen.Id("_"),
+					).Op(":=").Id(floatFormat).Dot("Load").Call().Assert(jen.Op("*").Id(floatFormatting)),
+					jen.If(
+						jen.Id("f").Op("==").Nil(),
+					).Block(
+						jen.Return(
+							jen.Id(codegen.This()),
+							jen.Nil(),
+						),
+					).Else().If(
+						jen.Qual("math", "IsNaN").Call(jen.Id(codegen.This())).Op("||").Qual("math", "IsInf").Call(jen.Id(codegen.This()), jen.Lit(0)),
+					).Block(
+						jen.Return(
+							jen.Nil(),
+							jen.Qual("fmt", "Errorf").Call(
+								jen.Lit("%v cannot be serialized as a number for xsd:float"),
+								jen.Id(codegen.This()),
+							),
+						),
+					),
 					jen.Return(
-						jen.Id(codegen.This()),
+						jen.Qual("encoding/json", "Number").Call(
+							jen.Qual("strconv", "FormatFloat").Call(
+								jen.Id(codegen.This()),
+								jen.Id("f").Dot("format"),
+								jen.Id("f").Dot("precision"),
+								jen.Lit(64),
+							),
+						),
 						jen.Nil(),
 					),
 				}),
@@ -458,6 +487,16 @@ func (f *float) Apply(key string, value interface{}, ctx *rdf.ParsingContext) (b
 							jen.Id("f"),
 							jen.Nil(),
 						),
+					).Else().If(
+						jen.List(
+							jen.Id("n"),
+							jen.Id("ok"),
+						).Op(":=").Id(codegen.This()).Assert(jen.Qual("encoding/json", "Number")),
+						jen.Id("ok"),
+					).Block(
+						jen.Return(
+							jen.Id("n").Dot("Float64").Call(),
+						),
 					).Else().Block(
 						jen.Return(
 							jen.Lit(0),
@@ -478,6 +517,7 @@ func (f *float) Apply(key string, value interface{}, ctx *rdf.ParsingContext) (b
 					),
 				}),
 		}
+		val.ExtraDefs = floatFormatDefs(f.pkg)
 		if err = v.SetValue(floatSpec, val); err != nil {
 			return true, err
 		}
@@ -485,6 +525,87 @@ func (f *float) Apply(key string, value interface{}, ctx *rdf.ParsingContext) (b
 	return true, nil
 }
 
+const (
+	// floatFormatting is the generated type holding the format and
+	// precision passed to strconv.FormatFloat when serializing xsd:float
+	// values.
+	floatFormatting = "floatFormatting"
+	// floatFormat is the generated variable holding the floatFormatting
+	// set by an application, or a nil one if floats are left to the
+	// encoding/json package.
+	floatFormat = "floatFormat"
+	// floatFormatVerbs are the formats of strconv.FormatFloat producing
+	// JSON numbers. The others produce binary or hexadecimal numbers.
+	floatFormatVerbs = "eEfgG"
+)
+
+// floatFormatDefs generates the package-level configuration allowing
+// applications to control how xsd:float values are serialized.
+//
+// By default, floats are left to the encoding/json package to format, which
+// may not reproduce the text that was originally received. The configuration
+// is held in an atomic.Value, so that it may be changed while other goroutines
+// serialize values.
+func floatFormatDefs(pkg string) []jen.Code {
+	return []jen.Code{
+		jen.Commentf(
+			codegen.FormatPackageDocumentation(
+				fmt.Sprintf("%s is the format and precision passed to strconv.FormatFloat when serializing float values.", floatFormatting)),
+		).Line().Type().Id(floatFormatting).Struct(
+			jen.Id("format").Byte(),
+			jen.Id("precision").Int(),
+		),
+		jen.Commentf(
+			codegen.FormatPackageDocumentation(
+				fmt.Sprintf("%s holds the *%s set with SetFormat, or a nil one if float values are left to the encoding/json package to format. It is only accessed atomically, so that it may be changed while values are serialized.", floatFormat, floatFormatting)),
+		).Line().Var().Id(floatFormat).Qual("sync/atomic", "Value"),
+		codegen.NewCommentedFunction(
+			pkg,
+			"SetFormat",
+			[]jen.Code{
+				jen.Id("format").Byte(),
+				jen.Id("precision").Int(),
+			},
+			[]jen.Code{jen.Error()},
+			[]jen.Code{
+				jen.If(
+					jen.Op("!").Qual("strings", "ContainsRune").Call(
+						jen.Lit(floatFormatVerbs),
+						jen.Rune().Call(jen.Id("format")),
+					),
+				).Block(
+					jen.Return(
+						jen.Qual("fmt", "Errorf").Call(
+							jen.Lit("%q is not a JSON number format of strconv.FormatFloat"),
+							jen.Id("format"),
+						),
+					),
+				),
+				jen.Id(floatFormat).Dot("Store").Call(
+					jen.Op("&").Id(floatFormatting).Values(jen.Dict{
+						jen.Id("format"):    jen.Id("format"),
+						jen.Id("precision"): jen.Id("precision"),
+					}),
+				),
+				jen.Return(jen.Nil()),
+			},
+			fmt.Sprintf("SetFormat configures float values to be serialized as JSON numbers formatted by strconv.FormatFloat with the given format and precision, such as 'f' and 6 to always serialize coordinates with six decimal places. An error is returned if the format is not one of %q. It is safe to call concurrently with serializing values.", floatFormatVerbs),
+		).Definition(),
+		codegen.NewCommentedFunction(
+			pkg,
+			"ResetFormat",
+			/*params=*/ nil,
+			/*ret=*/ nil,
+			[]jen.Code{
+				jen.Id(floatFormat).Dot("Store").Call(
+					jen.Parens(jen.Op("*").Id(floatFormatting)).Parens(jen.Nil()),
+				),
+			},
+			"ResetFormat restores the default serialization of float values, which leaves their formatting to the encoding/json package. It is safe to call concurrently with serializing values.",
+		).Definition(),
+	}
+}
+
 var _ rdf.RDFNode = &xmlString{}
 
 // xmlString is a string.
@@ -1,4 +1,4 @@
 // +build generate
-//go:generate go run ./astool -spec astool/activitystreams.jsonld -spec astool/security-v1.jsonld -spec astool/toot.jsonld -spec astool/forgefed.jsonld -path github.com/go-fed/activity ./streams
+//go:generate go run ./astool -spec astool/activitystreams.jsonld -spec astool/security-v1.jsonld -spec astool/toot.jsonld -spec astool/forgefed.jsonld -spec astool/schema.jsonld -spec astool/peertube.jsonld -spec astool/litepub.jsonld -path github.com/go-fed/activity ./streams
 
 package activity
@@ -1,6 +1,6 @@
 module github.com/go-fed/activity
 
-go 1.12
+go 1.18
 
 require (
 	github.com/dave/jennifer v1.3.0
@@ -8,3 +8,8 @@ require (
 	github.com/go-test/deep v1.0.1
 	github.com/golang/mock v1.2.0
 )
+
+require (
+	golang.org/x/crypto v0.0.0-20180527072434-ab813273cd59 // indirect
+	golang.org/x/sys v0.0.0-20180525142821-c11f84a56e43 // indirect
+)
@@ -0,0 +1,241 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"time"
+)
+
+const (
+	// ActorFile is the file of the actor in an archive.
+	ActorFile = "actor.json"
+	// OutboxFile is the file of the collection of the actor's activities.
+	OutboxFile = "outbox.json"
+	// LikesFile is the file of the collection of the objects the actor
+	// liked.
+	LikesFile = "likes.json"
+	// BookmarksFile is the file of the collection of the objects the actor
+	// bookmarked.
+	BookmarksFile = "bookmarks.json"
+)
+
+// Archive is the content of a Mastodon account export, except for its media
+// files.
+type Archive struct {
+	// Actor is the actor whose data is exported.
+	Actor vocab.Type
+	// Outbox is the collection of the actor's activities.
+	Outbox vocab.ActivityStreamsOrderedCollection
+	// Likes is the collection of the objects the actor liked, or nil if
+	// the archive has none.
+	Likes vocab.ActivityStreamsOrderedCollection
+	// Bookmarks is the collection of the objects the actor bookmarked, or
+	// nil if the archive has none.
+	Bookmarks vocab.ActivityStreamsOrderedCollection
+}
+
+// New creates the archive of a local actor and its activities, in the order of
+// its outbox.
+//
+// The media files referenced by the actor and the activities are listed by the
+// archive's Media, and are written with the archive by Write.
+func New(actor vocab.Type, activities []vocab.Type) (*Archive, error) {
+	outbox := streams.NewActivityStreamsOrderedCollection()
+	streams.SetId(outbox, &url.URL{Path: OutboxFile})
+	totalItems := streams.NewActivityStreamsTotalItemsProperty()
+	totalItems.Set(len(activities))
+	outbox.SetActivityStreamsTotalItems(totalItems)
+	items := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, activity := range activities {
+		if err := items.AppendType(activity); err != nil {
+			return nil, err
+		}
+	}
+	outbox.SetActivityStreamsOrderedItems(items)
+	return &Archive{
+		Actor:  actor,
+		Outbox: outbox,
+	}, nil
+}
+
+// Activities returns the activities of the outbox. Those only referenced by
+// their IRI are left out.
+func (a *Archive) Activities() []vocab.Type {
+	if a.Outbox == nil || a.Outbox.GetActivityStreamsOrderedItems() == nil {
+		return nil
+	}
+	var activities []vocab.Type
+	for iter := a.Outbox.GetActivityStreamsOrderedItems().Begin(); iter != nil; iter = iter.Next() {
+		if t := iter.GetType(); t != nil {
+			activities = append(activities, t)
+		}
+	}
+	return activities
+}
+
+// MediaReader reads a media file of an archive being read, such as to store it
+// for the migrated actor. The reader is only valid until the function returns.
+type MediaReader func(path string, r io.Reader) error
+
+// MediaOpener opens a media file of an archive being written, returning its
+// content and size.
+type MediaOpener func(f MediaFile) (rc io.ReadCloser, size int64, err error)
+
+// Read reads an archive from the gzipped tar archive of r.
+//
+// The files of the archive other than those of the actor and its collections
+// are passed to media in the order they are stored, which is not necessarily
+// that of the media manifest. If media is nil, they are skipped.
+func Read(c context.Context, r io.Reader, media MediaReader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	a := &Archive{}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		} else if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name, ok := cleanPath(hdr.Name)
+		if !ok {
+			continue
+		}
+		switch name {
+		case ActorFile:
+			a.Actor, err = readType(c, tr)
+		case OutboxFile:
+			a.Outbox, err = readCollection(c, tr)
+		case LikesFile:
+			a.Likes, err = readCollection(c, tr)
+		case BookmarksFile:
+			a.Bookmarks, err = readCollection(c, tr)
+		default:
+			if media != nil {
+				err = media(name, tr)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+	}
+	if a.Actor == nil {
+		return nil, fmt.Errorf("archive has no %s", ActorFile)
+	} else if a.Outbox == nil {
+		return nil, fmt.Errorf("archive has no %s", OutboxFile)
+	}
+	return a, nil
+}
+
+// readType deserializes the JSON of r.
+func readType(c context.Context, r io.Reader) (vocab.Type, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return streams.ToType(c, m)
+}
+
+// readCollection deserializes the JSON of r, which must be an
+// OrderedCollection.
+func readCollection(c context.Context, r io.Reader) (vocab.ActivityStreamsOrderedCollection, error) {
+	t, err := readType(c, r)
+	if err != nil {
+		return nil, err
+	}
+	oc, ok := t.(vocab.ActivityStreamsOrderedCollection)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an OrderedCollection", t.GetTypeName())
+	}
+	return oc, nil
+}
+
+// Write writes the archive as a gzipped tar archive to w.
+//
+// The files of the archive's media manifest are opened by media and written
+// after those of the actor and its collections. If media is nil, they are not
+// written.
+func Write(w io.Writer, a *Archive, media MediaOpener) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	modTime := time.Now()
+	for _, f := range []struct {
+		name string
+		t    vocab.Type
+	}{
+		{ActorFile, a.Actor},
+		{OutboxFile, a.Outbox},
+		{LikesFile, a.Likes},
+		{BookmarksFile, a.Bookmarks},
+	} {
+		if f.t == nil {
+			continue
+		}
+		m, err := streams.Serialize(f.t)
+		if err != nil {
+			return fmt.Errorf("%s: %v", f.name, err)
+		}
+		b, err := json.Marshal(m)
+		if err != nil {
+			return fmt.Errorf("%s: %v", f.name, err)
+		}
+		if err = writeFile(tw, f.name, int64(len(b)), modTime, bytes.NewReader(b)); err != nil {
+			return err
+		}
+	}
+	if media != nil {
+		for _, f := range a.Media() {
+			if err := writeMedia(tw, f, modTime, media); err != nil {
+				return fmt.Errorf("%s: %v", f.Path, err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// writeMedia writes a media file opened by media to the tar archive.
+func writeMedia(tw *tar.Writer, f MediaFile, modTime time.Time, media MediaOpener) error {
+	rc, size, err := media(f)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return writeFile(tw, f.Path, size, modTime, rc)
+}
+
+// writeFile writes a file of the given size to the tar archive.
+func writeFile(tw *tar.Writer, name string, size int64, modTime time.Time, r io.Reader) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0644,
+		Size:     size,
+		ModTime:  modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := io.Copy(tw, r)
+	return err
+}
@@ -0,0 +1,238 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+const (
+	testActor = `{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"toot": "http://joinmastodon.org/ns#"}],
+  "id": "https://example.com/users/alice",
+  "type": "Person",
+  "preferredUsername": "alice",
+  "icon": {"type": "Image", "mediaType": "image/png", "url": "avatar.png"},
+  "image": {"type": "Image", "mediaType": "image/jpeg", "url": "https://cdn.example.com/header.jpg"}
+}`
+	testOutbox = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "outbox.json",
+  "type": "OrderedCollection",
+  "totalItems": 2,
+  "orderedItems": [
+    {
+      "id": "https://example.com/users/alice/statuses/1/activity",
+      "type": "Create",
+      "actor": "https://example.com/users/alice",
+      "object": {
+        "id": "https://example.com/users/alice/statuses/1",
+        "type": "Note",
+        "content": "hello",
+        "attachment": [
+          {"type": "Document", "mediaType": "image/png", "url": "/media_attachments/files/1/original/a.png"},
+          {"type": "Document", "mediaType": "video/mp4", "url": "/media_attachments/files/2/original/b.mp4"}
+        ]
+      }
+    },
+    {
+      "id": "https://example.com/users/alice/statuses/2/activity",
+      "type": "Announce",
+      "actor": "https://example.com/users/alice",
+      "object": "https://other.example/notes/1"
+    }
+  ]
+}`
+	testLikes = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "likes.json",
+  "type": "OrderedCollection",
+  "orderedItems": ["https://other.example/notes/1", "https://other.example/notes/2"]
+}`
+)
+
+// testFile is a file of a test archive.
+type testFile struct {
+	name string
+	body string
+}
+
+// testArchive returns a gzipped tar archive of the files.
+func testArchive(t *testing.T, files []testFile) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		hdr := &tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.body)), Typeflag: tar.TypeReg}
+		if f.body == "" {
+			hdr.Typeflag = tar.TypeDir
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if _, err := io.WriteString(tw, f.body); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if err := gz.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return buf
+}
+
+// mediaMap returns a MediaReader storing the media files read in m.
+func mediaMap(m map[string]string) MediaReader {
+	return func(path string, r io.Reader) error {
+		b, err := ioutil.ReadAll(r)
+		m[path] = string(b)
+		return err
+	}
+}
+
+// serialized returns the JSON of a value, to compare them.
+func serialized(t *testing.T, v vocab.Type) string {
+	m, err := streams.Serialize(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return string(b)
+}
+
+// TestRead tests reading a Mastodon account export.
+func TestRead(t *testing.T) {
+	buf := testArchive(t, []testFile{
+		{"./actor.json", testActor},
+		{"./outbox.json", testOutbox},
+		{"./likes.json", testLikes},
+		{"./avatar.png", "avatar"},
+		{"./media_attachments/", ""},
+		{"./media_attachments/files/1/original/a.png", "a"},
+	})
+	media := make(map[string]string)
+	a, err := Read(context.Background(), buf, mediaMap(media))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if id := streams.GetIdString(a.Actor); id != "https://example.com/users/alice" {
+		t.Errorf("got actor %q", id)
+	}
+	if activities := a.Activities(); len(activities) != 2 {
+		t.Errorf("got %d activities, want 2", len(activities))
+	} else if name := activities[1].GetTypeName(); name != "Announce" {
+		t.Errorf("got second activity %q, want Announce", name)
+	}
+	if a.Likes == nil || a.Likes.GetActivityStreamsOrderedItems().Len() != 2 {
+		t.Errorf("expected two likes")
+	}
+	if a.Bookmarks != nil {
+		t.Errorf("expected no bookmarks")
+	}
+	expectMedia := map[string]string{
+		"avatar.png": "avatar",
+		"media_attachments/files/1/original/a.png": "a",
+	}
+	if !reflect.DeepEqual(media, expectMedia) {
+		t.Errorf("got media %v, want %v", media, expectMedia)
+	}
+}
+
+// TestReadRequiresActorAndOutbox tests that an archive without an actor or an
+// outbox is not read.
+func TestReadRequiresActorAndOutbox(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		files []testFile
+	}{
+		{"NoActor", []testFile{{OutboxFile, testOutbox}}},
+		{"NoOutbox", []testFile{{ActorFile, testActor}}},
+		{"OutboxNotACollection", []testFile{{ActorFile, testActor}, {OutboxFile, testActor}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Read(context.Background(), testArchive(t, test.files), nil); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+// TestMedia tests the media manifest of an archive.
+func TestMedia(t *testing.T) {
+	a, err := Read(context.Background(), testArchive(t, []testFile{
+		{ActorFile, testActor},
+		{OutboxFile, testOutbox},
+	}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expect := []MediaFile{
+		{Path: "avatar.png", MediaType: "image/png"},
+		{Path: "media_attachments/files/1/original/a.png", MediaType: "image/png"},
+		{Path: "media_attachments/files/2/original/b.mp4", MediaType: "video/mp4"},
+	}
+	if got := a.Media(); !reflect.DeepEqual(got, expect) {
+		t.Errorf("got %v, want %v", got, expect)
+	}
+}
+
+// TestWrite tests that a local actor's archive is read back as written.
+func TestWrite(t *testing.T) {
+	actor := streams.NewActivityStreamsPerson()
+	if err := streams.SetIdString(actor, "https://example.com/users/alice"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	icon := streams.NewActivityStreamsImage()
+	iconURL := streams.NewActivityStreamsUrlProperty()
+	iconURL.AppendXMLSchemaAnyURI(&url.URL{Path: "avatar.png"})
+	icon.SetActivityStreamsUrl(iconURL)
+	iconProp := streams.NewActivityStreamsIconProperty()
+	iconProp.AppendActivityStreamsImage(icon)
+	actor.SetActivityStreamsIcon(iconProp)
+	var activities []vocab.Type
+	for i := 0; i < 3; i++ {
+		note := streams.NewNote(fmt.Sprintf("note %d", i), streams.GetId(actor), nil)
+		activities = append(activities, streams.NewCreateFromObject(note, streams.GetId(actor)))
+	}
+	a, err := New(actor, activities)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	buf := &bytes.Buffer{}
+	err = Write(buf, a, func(f MediaFile) (io.ReadCloser, int64, error) {
+		return ioutil.NopCloser(bytes.NewBufferString("avatar")), 6, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	media := make(map[string]string)
+	got, err := Read(context.Background(), buf, mediaMap(media))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if s, expect := serialized(t, got.Actor), serialized(t, actor); s != expect {
+		t.Errorf("got actor %s, want %s", s, expect)
+	}
+	if s, expect := serialized(t, got.Outbox), serialized(t, a.Outbox); s != expect {
+		t.Errorf("got outbox %s, want %s", s, expect)
+	}
+	if got.Likes != nil || got.Bookmarks != nil {
+		t.Errorf("expected no likes nor bookmarks")
+	}
+	if expect := map[string]string{"avatar.png": "avatar"}; !reflect.DeepEqual(media, expect) {
+		t.Errorf("got media %v, want %v", media, expect)
+	}
+}
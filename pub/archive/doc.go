@@ -0,0 +1,10 @@
+// Package archive reads and writes Mastodon account exports, so that tools
+// migrating an actor between servers can be built on top of this library.
+//
+// An export is a gzipped tar archive. It holds the actor in actor.json, its
+// activities in the collection of outbox.json, and the objects it liked and
+// bookmarked in the collections of likes.json and bookmarks.json. The media
+// files referenced by the actor and its activities, such as its avatar and the
+// attachments of its posts, are stored alongside them and listed by the media
+// manifest of the archive.
+package archive
@@ -0,0 +1,128 @@
+package archive
+
+import (
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"path"
+)
+
+// MediaFile is an entry of the media manifest of an archive.
+type MediaFile struct {
+	// Path is the path of the file in the archive.
+	Path string
+	// MediaType is the media type of the file, or empty if it is not
+	// known.
+	MediaType string
+}
+
+// Media returns the media manifest of the archive: the files referenced by the
+// icon and image of the actor and by the attachments of the objects of its
+// activities, in that order and without duplicates.
+//
+// Only files referenced by relative URLs, such as "avatar.png" or
+// "/media_attachments/files/1/original/a.png", are stored in an archive. Those
+// referenced by absolute IRIs are left to be fetched from their server.
+func (a *Archive) Media() []MediaFile {
+	m := &manifest{seen: make(map[string]bool)}
+	if v, ok := a.Actor.(interface {
+		GetActivityStreamsIcon() vocab.ActivityStreamsIconProperty
+	}); ok && v.GetActivityStreamsIcon() != nil {
+		for iter := v.GetActivityStreamsIcon().Begin(); iter != nil; iter = iter.Next() {
+			m.add(iter.GetType())
+		}
+	}
+	if v, ok := a.Actor.(interface {
+		GetActivityStreamsImage() vocab.ActivityStreamsImageProperty
+	}); ok && v.GetActivityStreamsImage() != nil {
+		for iter := v.GetActivityStreamsImage().Begin(); iter != nil; iter = iter.Next() {
+			m.add(iter.GetType())
+		}
+	}
+	for _, activity := range a.Activities() {
+		v, ok := activity.(interface {
+			GetActivityStreamsObject() vocab.ActivityStreamsObjectProperty
+		})
+		if !ok || v.GetActivityStreamsObject() == nil {
+			continue
+		}
+		for iter := v.GetActivityStreamsObject().Begin(); iter != nil; iter = iter.Next() {
+			obj, ok := iter.GetType().(interface {
+				GetActivityStreamsAttachment() vocab.ActivityStreamsAttachmentProperty
+			})
+			if !ok || obj.GetActivityStreamsAttachment() == nil {
+				continue
+			}
+			for att := obj.GetActivityStreamsAttachment().Begin(); att != nil; att = att.Next() {
+				m.add(att.GetType())
+			}
+		}
+	}
+	return m.files
+}
+
+// manifest collects the media files referenced by values, once each.
+type manifest struct {
+	files []MediaFile
+	seen  map[string]bool
+}
+
+// add adds the files referenced by the 'url' property of a value, such as an
+// Image or a Document, to the manifest. Values without one, including nil
+// values, are ignored.
+func (m *manifest) add(t vocab.Type) {
+	v, ok := t.(interface {
+		GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty
+	})
+	if !ok || v.GetActivityStreamsUrl() == nil {
+		return
+	}
+	mediaType := ""
+	if mt, ok := t.(interface {
+		GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty
+	}); ok && mt.GetActivityStreamsMediaType() != nil && mt.GetActivityStreamsMediaType().IsRFCRfc2045() {
+		mediaType = mt.GetActivityStreamsMediaType().Get()
+	}
+	// Relative URLs are not deserialized as IRIs, but are kept as the
+	// property's unknown values, so the serialized property is examined.
+	s, err := v.GetActivityStreamsUrl().Serialize()
+	if err != nil {
+		return
+	}
+	refs, ok := s.([]interface{})
+	if !ok {
+		refs = []interface{}{s}
+	}
+	for _, ref := range refs {
+		str, ok := ref.(string)
+		if !ok {
+			continue
+		}
+		u, err := url.Parse(str)
+		if err != nil {
+			continue
+		}
+		p, ok := mediaPath(u)
+		if !ok || m.seen[p] {
+			continue
+		}
+		m.seen[p] = true
+		m.files = append(m.files, MediaFile{Path: p, MediaType: mediaType})
+	}
+}
+
+// mediaPath returns the path in an archive of a file referenced by a relative
+// URL. It returns false if the URL is absolute or has no path.
+func mediaPath(u *url.URL) (string, bool) {
+	if u.IsAbs() || u.Host != "" || u.Path == "" {
+		return "", false
+	}
+	return cleanPath(u.Path)
+}
+
+// cleanPath returns the path of a file in an archive, relative to its root, so
+// that paths such as "./actor.json" and "/actor.json" are the same file and
+// none are outside of the archive. It returns false if the path is the root.
+func cleanPath(p string) (string, bool) {
+	p = path.Clean("/" + p)[1:]
+	return p, p != ""
+}
@@ -0,0 +1,95 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// RequestVerifier authenticates the actor that signed an incoming request.
+type RequestVerifier interface {
+	// Verify returns the IRI of the actor whose key produced a valid
+	// signature on the request.
+	//
+	// An error is returned if the request is not signed, or if the
+	// signature cannot be verified.
+	Verify(c context.Context, r *http.Request) (actor *url.URL, err error)
+}
+
+// FetchAuthorizer decides whether a verified actor may obtain the
+// ActivityStreams value served by an authorized fetch handler.
+type FetchAuthorizer interface {
+	// Blocked determines whether to deny the actors fetching a value, with
+	// the same semantics as the FederatingProtocol's Blocked method.
+	Blocked(c context.Context, actorIRIs []*url.URL) (blocked bool, err error)
+	// AuthorizeFetch determines whether the verified requester may obtain
+	// the ActivityStreams value with the id. It is called only if the
+	// requester is not blocked.
+	//
+	// Returning an error results in the handler returning the error, and
+	// the caller is responsible for writing a response.
+	AuthorizeFetch(c context.Context, requester, id *url.URL) (authorized bool, err error)
+}
+
+// NewAuthorizedFetchHandler creates a HandlerFunc serving ActivityStreams
+// requests like NewActivityStreamsHandler, but only to peers that prove their
+// identity. This is also known as "authorized fetch" or "secure mode".
+//
+// Each request must carry a signature accepted by the verifier, otherwise the
+// handler responds with http.StatusUnauthorized. The verified actor must not be
+// blocked and must be authorized to fetch the value, otherwise the handler
+// responds with http.StatusForbidden. In both cases 'isASRequest' is true and
+// no error is returned.
+//
+// Peers that require authorized fetch also expect their values to be fetched
+// with signed requests, which the HttpSigTransport does for every GET.
+func NewAuthorizedFetchHandler(db Database, clock Clock, verifier RequestVerifier, authorizer FetchAuthorizer) HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		// Do nothing if it is not an ActivityPub GET request
+		if !isActivityPubGet(r) {
+			return
+		}
+		isASRequest = true
+		requester, verr := verifier.Verify(c, r)
+		if verr != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		blocked, err := authorizer.Blocked(c, []*url.URL{requester})
+		if err != nil {
+			return
+		} else if blocked {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		id := requestId(r)
+		authorized, err := authorizer.AuthorizeFetch(c, requester, id)
+		if err != nil {
+			return
+		} else if !authorized {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		err = serveActivityStreams(c, w, db, clock, id)
+		return
+	}
+}
+
+// NewVerifyingMiddleware returns an http.Handler serving requests verified by
+// the verifier with the next handler, for endpoints outside of the pub
+// handlers that also require signed requests, such as an application's own
+// API.
+//
+// The verified actor is added to the context of the request given to the next
+// handler, from which SignerFromContext returns it. Requests that fail to
+// verify are answered with http.StatusUnauthorized.
+func NewVerifyingMiddleware(verifier RequestVerifier, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor, err := verifier.Verify(r.Context(), r)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithSigner(r.Context(), actor)))
+	})
+}
@@ -0,0 +1,173 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+// TestAuthorizedFetchHandler tests the handler serving ActivityPub requests
+// only to verified and authorized peers.
+func TestAuthorizedFetchHandler(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, clock *MockClock, v *MockRequestVerifier, a *MockFetchAuthorizer, hf HandlerFunc) {
+		setupData()
+		db = NewMockDatabase(ctl)
+		clock = NewMockClock(ctl)
+		v = NewMockRequestVerifier(ctl)
+		a = NewMockFetchAuthorizer(ctl)
+		hf = NewAuthorizedFetchHandler(db, clock, v, a)
+		return
+	}
+	t.Run("IgnoresIfNotActivityPubGetRequest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(resp.Result().Header), 0)
+	})
+	t.Run("UnauthorizedIfVerificationFails", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, v, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testNoteId1, nil))
+		// Mock
+		v.EXPECT().Verify(ctx, req).Return(nil, fmt.Errorf("test error"))
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("ForbiddenIfBlocked", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, v, a, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testNoteId1, nil))
+		// Mock
+		v.EXPECT().Verify(ctx, req).Return(mustParse(testFederatedActorIRI), nil)
+		a.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(true, nil)
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("ForbiddenIfNotAuthorized", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, v, a, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testNoteId1, nil))
+		// Mock
+		v.EXPECT().Verify(ctx, req).Return(mustParse(testFederatedActorIRI), nil)
+		a.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		a.EXPECT().AuthorizeFetch(ctx, mustParse(testFederatedActorIRI), mustParse(testNoteId1)).Return(false, nil)
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("ReturnsErrorWhenAuthorizeFetchReturnsError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, v, a, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testNoteId1, nil))
+		testErr := fmt.Errorf("test error")
+		// Mock
+		v.EXPECT().Verify(ctx, req).Return(mustParse(testFederatedActorIRI), nil)
+		a.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		a.EXPECT().AuthorizeFetch(ctx, mustParse(testFederatedActorIRI), mustParse(testNoteId1)).Return(false, testErr)
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, testErr)
+		assertEqual(t, len(resp.Result().Header), 0)
+	})
+	t.Run("ServesContentToAuthorizedRequester", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDb, mockClock, v, a, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testNoteId1, nil))
+		// Mock
+		v.EXPECT().Verify(ctx, req).Return(mustParse(testFederatedActorIRI), nil)
+		a.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		a.EXPECT().AuthorizeFetch(ctx, mustParse(testFederatedActorIRI), mustParse(testNoteId1)).Return(true, nil)
+		mockDb.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDb.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(testMyNote, nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		mockClock.EXPECT().Now().Return(now())
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+		b, err := ioutil.ReadAll(resp.Result().Body)
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, mustSerializeToBytes(testMyNote))
+	})
+}
+
+// TestVerifyingMiddleware tests the middleware passing verified requests to the
+// next handler.
+func TestVerifyingMiddleware(t *testing.T) {
+	t.Run("PassesSignerToNextHandler", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		v := NewMockRequestVerifier(ctl)
+		var signer *url.URL
+		h := NewVerifyingMiddleware(v, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			signer, _ = SignerFromContext(r.Context())
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		// Mock
+		v.EXPECT().Verify(req.Context(), req).Return(mustParse(testFederatedActorIRI), nil)
+		// Run
+		h.ServeHTTP(resp, req)
+		// Verify
+		assertEqual(t, resp.Code, http.StatusNoContent)
+		assertEqual(t, signer.String(), testFederatedActorIRI)
+	})
+	t.Run("UnauthorizedIfVerificationFails", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		v := NewMockRequestVerifier(ctl)
+		h := NewVerifyingMiddleware(v, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("next handler called for an unverified request")
+		}))
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		// Mock
+		v.EXPECT().Verify(req.Context(), req).Return(nil, fmt.Errorf("test error"))
+		// Run
+		h.ServeHTTP(resp, req)
+		// Verify
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+}
@@ -3,6 +3,7 @@ package pub
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/streams/vocab"
@@ -35,6 +36,10 @@ type baseActor struct {
 	enableFederatedProtocol bool
 	// clock simply tracks the current time.
 	clock Clock
+	// boxResolver identifies the box of requests, if not nil.
+	boxResolver BoxResolver
+	// inboxLimits bounds the requests to inboxes, if not nil.
+	inboxLimits *inboxLimits
 }
 
 // baseActorFederating must satisfy the FederatingActor interface.
@@ -74,6 +79,7 @@ func NewSocialActor(c CommonBehavior,
 		},
 		enableSocialProtocol: true,
 		clock:                clock,
+		boxResolver:          asBoxResolver(c),
 	}
 }
 
@@ -103,6 +109,8 @@ func NewFederatingActor(c CommonBehavior,
 			},
 			enableFederatedProtocol: true,
 			clock:                   clock,
+			boxResolver:             asBoxResolver(c),
+			inboxLimits:             newInboxLimits(s2s, clock),
 		},
 	}
 }
@@ -133,6 +141,8 @@ func NewActor(c CommonBehavior,
 			enableSocialProtocol:    true,
 			enableFederatedProtocol: true,
 			clock:                   clock,
+			boxResolver:             asBoxResolver(c),
+			inboxLimits:             newInboxLimits(s2s, clock),
 		},
 	}
 }
@@ -155,10 +165,44 @@ func NewCustomActor(delegate DelegateActor,
 			enableSocialProtocol:    enableSocialProtocol,
 			enableFederatedProtocol: enableFederatedProtocol,
 			clock:                   clock,
+			boxResolver:             asBoxResolver(delegate),
+			inboxLimits:             newInboxLimits(delegate, clock),
 		},
 	}
 }
 
+// asBoxResolver returns the value if it is a BoxResolver, or nil.
+func asBoxResolver(v interface{}) BoxResolver {
+	r, _ := v.(BoxResolver)
+	return r
+}
+
+// resolveBox adds the box IRI of the request to the context if the Actor has a
+// BoxResolver. If it finds no box, a http.StatusNotFound response is written
+// and found is false.
+func (b *baseActor) resolveBox(c context.Context, w http.ResponseWriter, r *http.Request) (out context.Context, found bool, err error) {
+	if b.boxResolver == nil {
+		return c, true, nil
+	}
+	boxIRI, err := b.boxResolver.BoxIRI(c, r)
+	if err != nil {
+		return c, false, err
+	} else if boxIRI == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return c, false, nil
+	}
+	return context.WithValue(c, boxIRIKey{}, boxIRI), true, nil
+}
+
+// boxIRI returns the box IRI added to the context by resolveBox, or the IRI of
+// the request otherwise.
+func boxIRI(c context.Context, r *http.Request) *url.URL {
+	if boxIRI, ok := BoxIRIFromContext(c); ok {
+		return boxIRI
+	}
+	return requestId(r)
+}
+
 // PostInbox implements the generic algorithm for handling a POST request to an
 // actor's inbox independent on an application. It relies on a delegate to
 // implement application specific functionality.
@@ -173,10 +217,24 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return true, nil
 	}
-	// Check the peer request is authentic.
-	c, authenticated, err := b.delegate.AuthenticatePostInbox(c, w, r)
+	c, found, err := b.resolveBox(c, w, r)
 	if err != nil {
 		return true, err
+	} else if !found {
+		return true, nil
+	}
+	// Bound the requests of remote hosts and the size of their bodies.
+	if b.inboxLimits != nil && !b.inboxLimits.limitRequest(w, r) {
+		return true, nil
+	}
+	// Check the peer request is authentic. Verifying its signature may
+	// read a body longer than the limit.
+	c, authenticated, err := b.delegate.AuthenticatePostInbox(c, w, r)
+	if errors.Is(err, errBodyTooLarge) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return true, nil
+	} else if err != nil {
+		return true, err
 	} else if !authenticated {
 		return true, nil
 	}
@@ -184,7 +242,10 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 	// authorization (ex: blocks). Obtain the activity reject unknown
 	// activities.
 	raw, err := ioutil.ReadAll(r.Body)
-	if err != nil {
+	if err == errBodyTooLarge {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return true, nil
+	} else if err != nil {
 		return true, err
 	}
 	var m map[string]interface{}
@@ -192,9 +253,9 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 		return true, err
 	}
 	asValue, err := streams.ToType(c, m)
-	if err != nil && !streams.IsUnmatchedErr(err) {
+	if err != nil && !isUndeserializable(err) {
 		return true, err
-	} else if streams.IsUnmatchedErr(err) {
+	} else if isUndeserializable(err) {
 		// Respond with bad request -- we do not understand the type.
 		w.WriteHeader(http.StatusBadRequest)
 		return true, nil
@@ -207,6 +268,10 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 		w.WriteHeader(http.StatusBadRequest)
 		return true, nil
 	}
+	if b.inboxLimits != nil && b.inboxLimits.tooManyRecipients(activity) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return true, nil
+	}
 	// Allow server implementations to set context data with a hook.
 	c, err = b.delegate.PostInboxRequestBodyHook(c, r, activity)
 	if err != nil {
@@ -222,16 +287,20 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 	// Post the activity to the actor's inbox and trigger side effects for
 	// that particular Activity type. It is up to the delegate to resolve
 	// the given map.
-	inboxId := requestId(r)
+	inboxId := boxIRI(c, r)
 	err = b.delegate.PostInbox(c, inboxId, activity)
 	if err != nil {
 		// Special case: We know it is a bad request if the object or
-		// target properties needed to be populated, but weren't.
+		// target properties needed to be populated, but weren't, or if
+		// a question's answers are malformed.
 		//
 		// Send the rejection to the peer.
-		if err == ErrObjectRequired || err == ErrTargetRequired {
+		if err == ErrObjectRequired || err == ErrTargetRequired || err == ErrOneOfAndAnyOf {
 			w.WriteHeader(http.StatusBadRequest)
 			return true, nil
+		} else if err == ErrUnauthorizedUpdate {
+			w.WriteHeader(http.StatusForbidden)
+			return true, nil
 		}
 		return true, err
 	}
@@ -255,6 +324,12 @@ func (b *baseActor) GetInbox(c context.Context, w http.ResponseWriter, r *http.R
 	if !isActivityPubGet(r) {
 		return false, nil
 	}
+	c, found, err := b.resolveBox(c, w, r)
+	if err != nil {
+		return true, err
+	} else if !found {
+		return true, nil
+	}
 	// Delegate authenticating and authorizing the request.
 	c, authenticated, err := b.delegate.AuthenticateGetInbox(c, w, r)
 	if err != nil {
@@ -308,6 +383,12 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return true, nil
 	}
+	c, found, err := b.resolveBox(c, w, r)
+	if err != nil {
+		return true, err
+	} else if !found {
+		return true, nil
+	}
 	// Delegate authenticating and authorizing the request.
 	c, authenticated, err := b.delegate.AuthenticatePostOutbox(c, w, r)
 	if err != nil {
@@ -329,9 +410,9 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 	// type unknown to go-fed in a Create below. Instead,
 	// streams.ErrUnhandledType will be returned here.
 	asValue, err := streams.ToType(c, m)
-	if err != nil && !streams.IsUnmatchedErr(err) {
+	if err != nil && !isUndeserializable(err) {
 		return true, err
-	} else if streams.IsUnmatchedErr(err) {
+	} else if isUndeserializable(err) {
 		// Respond with bad request -- we do not understand the type.
 		w.WriteHeader(http.StatusBadRequest)
 		return true, nil
@@ -343,13 +424,14 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 	}
 	// The HTTP request steps are complete, complete the rest of the outbox
 	// and delivery process.
-	outboxId := requestId(r)
+	outboxId := boxIRI(c, r)
 	activity, err := b.deliver(c, outboxId, asValue, m)
 	// Special case: We know it is a bad request if the object or
-	// target properties needed to be populated, but weren't.
+	// target properties needed to be populated, but weren't, or if a
+	// question's answers are malformed.
 	//
 	// Send the rejection to the client.
-	if err == ErrObjectRequired || err == ErrTargetRequired {
+	if err == ErrObjectRequired || err == ErrTargetRequired || err == ErrOneOfAndAnyOf {
 		w.WriteHeader(http.StatusBadRequest)
 		return true, nil
 	} else if err != nil {
@@ -369,6 +451,12 @@ func (b *baseActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.
 	if !isActivityPubGet(r) {
 		return false, nil
 	}
+	c, found, err := b.resolveBox(c, w, r)
+	if err != nil {
+		return true, err
+	} else if !found {
+		return true, nil
+	}
 	// Delegate authenticating and authorizing the request.
 	c, authenticated, err := b.delegate.AuthenticateGetOutbox(c, w, r)
 	if err != nil {
@@ -1,8 +1,13 @@
 package pub
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/streams/vocab"
+	"github.com/go-fed/httpsig"
 	"github.com/golang/mock/gomock"
 	"io/ioutil"
 	"net/http"
@@ -409,6 +414,21 @@ func TestBaseActorFederatingProtocol(t *testing.T) {
 		assertEqual(t, handled, true)
 		assertEqual(t, resp.Code, http.StatusBadRequest)
 	})
+	t.Run("PostInboxBadRequestIfNoType", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxNoTypeRequest())
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
 	t.Run("PostInboxBadRequestIfActivityHasNoId", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
@@ -481,6 +501,24 @@ func TestBaseActorFederatingProtocol(t *testing.T) {
 		assertEqual(t, handled, true)
 		assertEqual(t, resp.Code, http.StatusBadRequest)
 	})
+	t.Run("PostInboxForbiddenForErrUnauthorizedUpdate", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, toDeserializedForm(testCreate)).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, toDeserializedForm(testCreate)).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), toDeserializedForm(testCreate)).Return(ErrUnauthorizedUpdate)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
 	t.Run("PostInboxBadRequestForErrTargetRequired", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
@@ -754,3 +792,249 @@ func TestBaseActor(t *testing.T) {
 		assertEqual(t, respV.Header.Get(locationHeader), testNewActivityIRI)
 	})
 }
+
+// TestBaseActorBoxResolver tests the Actor returned with NewCustomActor when
+// the DelegateActor is also a BoxResolver.
+func TestBaseActorBoxResolver(t *testing.T) {
+	// Set up test case
+	setupData()
+	ctx := context.Background()
+	boxIRI := mustParse("https://example.com/users/addison/inbox")
+	boxCtx := context.WithValue(ctx, boxIRIKey{}, boxIRI)
+	setupFn := func(ctl *gomock.Controller) (delegate *MockDelegateActor, resolver *MockBoxResolver, a Actor) {
+		delegate = NewMockDelegateActor(ctl)
+		resolver = NewMockBoxResolver(ctl)
+		a = NewCustomActor(
+			struct {
+				DelegateActor
+				BoxResolver
+			}{delegate, resolver},
+			/*enableSocialProtocol=*/ true,
+			/*enableFederatedProtocol=*/ true,
+			NewMockClock(ctl))
+		return
+	}
+	// Run tests
+	t.Run("PostInboxUsesResolvedBox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, resolver, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		resolver.EXPECT().BoxIRI(ctx, req).Return(boxIRI, nil)
+		delegate.EXPECT().AuthenticatePostInbox(boxCtx, resp, req).Return(boxCtx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(boxCtx, req, toDeserializedForm(testCreate)).Return(boxCtx, nil)
+		delegate.EXPECT().AuthorizePostInbox(boxCtx, resp, toDeserializedForm(testCreate)).Return(true, nil)
+		delegate.EXPECT().PostInbox(boxCtx, boxIRI, toDeserializedForm(testCreate)).Return(nil)
+		delegate.EXPECT().InboxForwarding(boxCtx, boxIRI, toDeserializedForm(testCreate)).Return(nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+		got, ok := BoxIRIFromContext(boxCtx)
+		assertEqual(t, ok, true)
+		assertEqual(t, got, boxIRI)
+	})
+	t.Run("GetInboxRespondsNotFoundWithoutBox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, resolver, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toGetInboxRequest())
+		resolver.EXPECT().BoxIRI(ctx, req).Return(nil, nil)
+		// Run the test
+		handled, err := a.GetInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusNotFound)
+	})
+	t.Run("GetOutboxReturnsResolverError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, resolver, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toGetOutboxRequest())
+		resolver.EXPECT().BoxIRI(ctx, req).Return(nil, testErr)
+		// Run the test
+		handled, err := a.GetOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, testErr)
+		assertEqual(t, handled, true)
+	})
+	t.Run("PostOutboxUsesResolvedBox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, resolver, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxRequest(testCreateNoId))
+		resolver.EXPECT().BoxIRI(ctx, req).Return(boxIRI, nil)
+		delegate.EXPECT().AuthenticatePostOutbox(boxCtx, resp, req).Return(boxCtx, true, nil)
+		delegate.EXPECT().PostOutboxRequestBodyHook(boxCtx, req, toDeserializedForm(testCreateNoId)).Return(boxCtx, nil)
+		delegate.EXPECT().AddNewIDs(boxCtx, toDeserializedForm(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
+			withNewId(activity)
+			return nil
+		})
+		delegate.EXPECT().PostOutbox(
+			boxCtx,
+			withNewId(toDeserializedForm(testCreateNoId)),
+			boxIRI,
+			mustSerialize(testCreateNoId),
+		).Return(false, nil)
+		// Run the test
+		handled, err := a.PostOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusCreated)
+	})
+}
+
+// TestBaseActorInboxLimits tests the Actor returned with NewCustomActor when
+// the DelegateActor is also an InboxLimiter.
+func TestBaseActorInboxLimits(t *testing.T) {
+	// Set up test case
+	setupData()
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller, limits InboxLimits) (delegate *MockDelegateActor, a Actor) {
+		delegate = NewMockDelegateActor(ctl)
+		limiter := NewMockInboxLimiter(ctl)
+		limiter.EXPECT().InboxLimits().Return(limits)
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(now()).AnyTimes()
+		a = NewCustomActor(
+			struct {
+				DelegateActor
+				InboxLimiter
+			}{delegate, limiter},
+			/*enableSocialProtocol=*/ false,
+			/*enableFederatedProtocol=*/ true,
+			clock)
+		return
+	}
+	// Run tests
+	t.Run("RateLimitsRemoteHost", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, InboxLimits{PerHostPerSecond: 0.5, PerHostBurst: 1})
+		first := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, first, req).Return(ctx, false, nil)
+		// Run the test
+		_, err := a.PostInbox(ctx, first, req)
+		assertEqual(t, err, nil)
+		second := httptest.NewRecorder()
+		handled, err := a.PostInbox(ctx, second, toAPRequest(toPostInboxRequest(testCreate)))
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, second.Code, http.StatusTooManyRequests)
+		assertEqual(t, second.Header().Get(retryAfterHeader), "2")
+	})
+	t.Run("AllowsBurst", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, InboxLimits{PerHostPerSecond: 1, PerHostBurst: 2})
+		delegate.EXPECT().AuthenticatePostInbox(ctx, gomock.Any(), gomock.Any()).Return(ctx, false, nil).Times(2)
+		// Run the test & Verify results
+		for i := 0; i < 2; i++ {
+			resp := httptest.NewRecorder()
+			_, err := a.PostInbox(ctx, resp, toAPRequest(toPostInboxRequest(testCreate)))
+			assertEqual(t, err, nil)
+			assertEqual(t, resp.Code, http.StatusOK)
+		}
+	})
+	t.Run("RejectsLargeContentLength", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, a := setupFn(ctl, InboxLimits{MaxBodyBytes: 16})
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusRequestEntityTooLarge)
+	})
+	t.Run("RejectsLargeBodyWithoutContentLength", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, InboxLimits{MaxBodyBytes: 16})
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		req.ContentLength = -1
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusRequestEntityTooLarge)
+	})
+	t.Run("RejectsLargeBodyReadByHttpSigVerifier", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, InboxLimits{MaxBodyBytes: 16})
+		priv, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().Return(now())
+		v := NewHttpSigVerifier(NewMockTransport(ctl), clock)
+		body := mustSerializeToBytes(testCreate)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("POST", testMyInboxIRI, bytes.NewReader(body)))
+		req.ContentLength = -1
+		req.Header.Set(dateHeader, now().UTC().Format(http.TimeFormat))
+		s := NewHs2019Signer(httpsig.DigestSha256, []string{httpsig.RequestTarget, "date", digestHeader}, httpsig.Signature)
+		if err := s.SignRequest(priv, testFederatedKeyId, req, body); err != nil {
+			t.Fatal(err)
+		}
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).DoAndReturn(func(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+			_, err := v.Verify(c, r)
+			return c, err == nil, err
+		})
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusRequestEntityTooLarge)
+	})
+	t.Run("RejectsTooManyRecipients", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, InboxLimits{MaxRecipients: 1})
+		create := streams.NewActivityStreamsCreate()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		create.SetJSONLDId(id)
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testFederatedActorIRI))
+		to.AppendIRI(mustParse(testFederatedActorIRI2))
+		create.SetActivityStreamsTo(to)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(create))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusRequestEntityTooLarge)
+	})
+}
@@ -87,3 +87,36 @@ type CommonBehavior interface {
 	// garbage collected.
 	NewTransport(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (t Transport, err error)
 }
+
+// BoxResolver is a CommonBehavior able to identify the inbox or outbox of the
+// local actor a request is for, so that a single Actor serves the boxes of any
+// number of local actors on the routes of the application's choosing, such as
+// "/users/{name}/inbox".
+//
+// When the CommonBehavior given to an Actor is also a BoxResolver, the box IRI
+// it returns replaces the request URL as the key of the inbox and outbox
+// lookups and deliveries, including the NewTransport calls to sign them with
+// the actor's keys. The box IRI is also added to the context passed to the rest
+// of the handling of the request, where BoxIRIFromContext returns it.
+//
+// A DelegateActor given to NewCustomActor may also be a BoxResolver.
+type BoxResolver interface {
+	// BoxIRI returns the IRI of the inbox or outbox the request is for, as
+	// known to the Database, for example by looking up the actor named in
+	// the request path.
+	//
+	// If there is no such box, nil is returned without an error, and the
+	// request receives a http.StatusNotFound response. If an error is
+	// returned, it is passed back to the caller of the Actor's method.
+	BoxIRI(c context.Context, r *http.Request) (boxIRI *url.URL, err error)
+}
+
+// boxIRIKey is the context key of the box IRI returned by a BoxResolver.
+type boxIRIKey struct{}
+
+// BoxIRIFromContext returns the inbox or outbox IRI returned by the BoxResolver
+// of the Actor handling the request, if any.
+func BoxIRIFromContext(c context.Context) (boxIRI *url.URL, ok bool) {
+	boxIRI, ok = c.Value(boxIRIKey{}).(*url.URL)
+	return
+}
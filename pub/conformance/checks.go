@@ -0,0 +1,259 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/http"
+	"net/url"
+)
+
+// check is a requirement checked by Run. It returns an error describing why the
+// requirement is not met.
+type check struct {
+	name        string
+	requirement string
+	run         func(c context.Context, h *harness) error
+}
+
+// checks are the requirements checked by Run, in order.
+var checks = []check{
+	{
+		name:        "inbox-media-type",
+		requirement: `Servers MUST accept activities posted to an inbox as application/ld+json; profile="https://www.w3.org/ns/activitystreams", and SHOULD accept them as application/activity+json.`,
+		run:         checkInboxMediaType,
+	},
+	{
+		name:        "inbox-deduplication",
+		requirement: "Servers MUST perform de-duplication of activities returned by the inbox.",
+		run:         checkInboxDeduplication,
+	},
+	{
+		name:        "delivery-bto-bcc",
+		requirement: "Servers MUST remove the 'bto' and 'bcc' properties from objects before delivery, while delivering to the recipients they address.",
+		run:         checkDeliveryBtoBcc,
+	},
+	{
+		name:        "object-media-type",
+		requirement: `Servers MUST present objects in response to application/ld+json; profile="https://www.w3.org/ns/activitystreams", and SHOULD present them in response to application/activity+json.`,
+		run:         checkObjectMediaType,
+	},
+	{
+		name:        "delete-tombstone",
+		requirement: "Objects deleted by their actor are replaced with a Tombstone, which servers SHOULD present with a 410 Gone response.",
+		run:         checkDeleteTombstone,
+	},
+}
+
+// checkInboxMediaType posts an activity to the inbox with each media type.
+func checkInboxMediaType(c context.Context, h *harness) error {
+	for _, mediaType := range []string{ldJSONMediaType, activityJSONMediaType} {
+		w, handled, err := h.postInbox(c, h.remoteCreate(), mediaType)
+		if err != nil {
+			return fmt.Errorf("posting as %s: %v", mediaType, err)
+		} else if !handled {
+			return fmt.Errorf("posting as %s was not handled", mediaType)
+		} else if w.Code < 200 || w.Code >= 300 {
+			return fmt.Errorf("posting as %s responded with %d", mediaType, w.Code)
+		}
+	}
+	return nil
+}
+
+// checkInboxDeduplication posts an activity to the inbox twice, which must
+// then be in the inbox once.
+func checkInboxDeduplication(c context.Context, h *harness) error {
+	create := h.remoteCreate()
+	for _, attempt := range []string{"first", "second"} {
+		if _, handled, err := h.postInbox(c, create, ldJSONMediaType); err != nil {
+			return fmt.Errorf("posting the activity the %s time: %v", attempt, err)
+		} else if !handled {
+			return fmt.Errorf("posting the activity the %s time was not handled", attempt)
+		}
+	}
+	id := streams.GetId(create)
+	if err := h.target.Database.Lock(c, h.inbox); err != nil {
+		return err
+	}
+	defer h.target.Database.Unlock(c, h.inbox)
+	page, err := h.target.Database.GetInbox(c, h.inbox)
+	if err != nil {
+		return err
+	}
+	n := 0
+	if items := page.GetActivityStreamsOrderedItems(); items != nil {
+		for iter := items.Begin(); iter != nil; iter = iter.Next() {
+			if itemId, err := pub.ToId(iter); err == nil && itemId.String() == id.String() {
+				n++
+			}
+		}
+	}
+	if n != 1 {
+		return fmt.Errorf("the inbox has the activity %s %d times after it was posted twice", id, n)
+	}
+	return nil
+}
+
+// checkDeliveryBtoBcc posts a Create to the outbox addressed with 'to', 'bto',
+// and 'bcc', whose deliveries must have neither 'bto' nor 'bcc'.
+func checkDeliveryBtoBcc(c context.Context, h *harness) error {
+	to, bto, bcc := h.remoteActor(), h.remoteActor(), h.remoteActor()
+	note := streams.NewNote("conformance", h.target.Actor, []*url.URL{to})
+	btoProp := streams.NewActivityStreamsBtoProperty()
+	btoProp.AppendIRI(bto)
+	note.SetActivityStreamsBto(btoProp)
+	bccProp := streams.NewActivityStreamsBccProperty()
+	bccProp.AppendIRI(bcc)
+	note.SetActivityStreamsBcc(bccProp)
+	if _, err := h.createLocal(c, note); err != nil {
+		return err
+	}
+	for _, recipient := range []struct {
+		property string
+		iri      *url.URL
+	}{
+		{"to", to},
+		{"bto", bto},
+		{"bcc", bcc},
+	} {
+		payloads := h.tp.delivered(inboxOf(recipient.iri))
+		if len(payloads) == 0 {
+			return fmt.Errorf("the recipient %s addressed by '%s' received no delivery", recipient.iri, recipient.property)
+		}
+		for _, b := range payloads {
+			if p, err := hiddenProperty(b); err != nil {
+				return err
+			} else if p != "" {
+				return fmt.Errorf("the delivery to %s has the '%s' property", recipient.iri, p)
+			}
+		}
+	}
+	return nil
+}
+
+// checkObjectMediaType requests a new object with each media type.
+func checkObjectMediaType(c context.Context, h *harness) error {
+	id, err := h.createLocal(c, streams.NewNote("conformance", h.target.Actor, nil))
+	if err != nil {
+		return err
+	}
+	for _, mediaType := range []string{ldJSONMediaType, activityJSONMediaType} {
+		w, handled, err := h.get(c, id, mediaType)
+		if err != nil {
+			return fmt.Errorf("requesting as %s: %v", mediaType, err)
+		} else if !handled {
+			return fmt.Errorf("requesting as %s was not handled", mediaType)
+		} else if w.Code != http.StatusOK {
+			return fmt.Errorf("requesting as %s responded with %d", mediaType, w.Code)
+		} else if ct := w.Header().Get("Content-Type"); !pub.IsActivityPubMediaType(ct) {
+			return fmt.Errorf("requesting as %s responded with the Content-Type %q", mediaType, ct)
+		}
+		var m map[string]interface{}
+		if err := json.Unmarshal(w.Body.Bytes(), &m); err != nil {
+			return fmt.Errorf("requesting as %s: %v", mediaType, err)
+		} else if m["id"] != id.String() {
+			return fmt.Errorf("requesting %s as %s responded with the object %v", id, mediaType, m["id"])
+		}
+	}
+	return nil
+}
+
+// checkDeleteTombstone posts a Delete of a new object to the outbox, after
+// which the object must be a Tombstone.
+func checkDeleteTombstone(c context.Context, h *harness) error {
+	id, err := h.createLocal(c, streams.NewNote("conformance", h.target.Actor, nil))
+	if err != nil {
+		return err
+	}
+	del := streams.NewActivityStreamsDelete()
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(h.target.Actor)
+	del.SetActivityStreamsActor(actor)
+	obj := streams.NewActivityStreamsObjectProperty()
+	obj.AppendIRI(id)
+	del.SetActivityStreamsObject(obj)
+	if _, err := h.postLocal(c, del); err != nil {
+		return err
+	}
+	t, err := getValue(c, h.target.Database, id)
+	if err != nil {
+		return fmt.Errorf("cannot get the deleted object %s: %v", id, err)
+	} else if !streams.IsOrExtendsActivityStreamsTombstone(t) {
+		return fmt.Errorf("the deleted object %s is a %s instead of a Tombstone", id, t.GetTypeName())
+	}
+	w, handled, err := h.get(c, id, ldJSONMediaType)
+	if err != nil {
+		return fmt.Errorf("requesting the deleted object %s: %v", id, err)
+	} else if !handled {
+		return fmt.Errorf("requesting the deleted object %s was not handled", id)
+	} else if w.Code != http.StatusGone {
+		return fmt.Errorf("requesting the deleted object %s responded with %d", id, w.Code)
+	}
+	return nil
+}
+
+// remoteCreate returns a Create of a Note by an actor of the simulated peers,
+// addressed to the Actor.
+func (h *harness) remoteCreate() vocab.ActivityStreamsCreate {
+	actor := h.remoteActor()
+	note := streams.NewNote("conformance", actor, []*url.URL{h.target.Actor})
+	streams.SetId(note, h.remoteIRI("notes"))
+	create := streams.NewCreateFromObject(note, actor)
+	streams.SetId(create, h.remoteIRI("activities"))
+	return create
+}
+
+// createLocal posts a Create of the object to the outbox, returning the id of
+// the object.
+func (h *harness) createLocal(c context.Context, obj vocab.Type) (*url.URL, error) {
+	activityId, err := h.postLocal(c, streams.NewCreateFromObject(obj, h.target.Actor))
+	if err != nil {
+		return nil, err
+	}
+	t, err := getValue(c, h.target.Database, activityId)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get the posted activity %s: %v", activityId, err)
+	}
+	create, ok := t.(vocab.ActivityStreamsCreate)
+	if !ok || create.GetActivityStreamsObject() == nil || create.GetActivityStreamsObject().Len() != 1 {
+		return nil, fmt.Errorf("the posted activity %s is not a Create of one object", activityId)
+	}
+	return pub.ToId(create.GetActivityStreamsObject().At(0))
+}
+
+// postLocal posts the activity to the outbox, returning the id it was given.
+func (h *harness) postLocal(c context.Context, activity vocab.Type) (*url.URL, error) {
+	w, handled, err := h.postOutbox(c, activity, ldJSONMediaType)
+	if err != nil {
+		return nil, fmt.Errorf("posting a %s to the outbox: %v", activity.GetTypeName(), err)
+	} else if !handled {
+		return nil, fmt.Errorf("posting a %s to the outbox was not handled", activity.GetTypeName())
+	} else if w.Code != http.StatusCreated {
+		return nil, fmt.Errorf("posting a %s to the outbox responded with %d", activity.GetTypeName(), w.Code)
+	}
+	return url.Parse(w.Header().Get("Location"))
+}
+
+// hiddenProperty returns 'bto' or 'bcc' if the serialized activity, or its
+// object, has the property. It returns an empty string if neither has it.
+func hiddenProperty(b []byte) (string, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return "", err
+	}
+	values := []map[string]interface{}{m}
+	if obj, ok := m["object"].(map[string]interface{}); ok {
+		values = append(values, obj)
+	}
+	for _, v := range values {
+		for _, p := range []string{"bto", "bcc"} {
+			if _, ok := v[p]; ok {
+				return p, nil
+			}
+		}
+	}
+	return "", nil
+}
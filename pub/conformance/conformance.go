@@ -0,0 +1,114 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"net/url"
+	"strings"
+)
+
+// Target is the application integration whose conformance is checked.
+type Target struct {
+	// Database is the application's Database. It must already hold the
+	// Actor, with its 'inbox' and 'outbox'.
+	Database pub.Database
+	// Actor is the IRI of a local actor, to whose inbox and outbox the
+	// activities are posted.
+	Actor *url.URL
+	// FederatingCallbacks returns the application's callbacks for the
+	// activities received in the inbox. If nil, only the default behaviors
+	// of package pub are used.
+	FederatingCallbacks func(c context.Context) (wrapped pub.FederatingWrappedCallbacks, other []interface{}, err error)
+	// SocialCallbacks returns the application's callbacks for the
+	// activities posted to the outbox. If nil, only the default behaviors
+	// of package pub are used.
+	SocialCallbacks func(c context.Context) (wrapped pub.SocialWrappedCallbacks, other []interface{}, err error)
+	// Handler serves the ActivityStreams values of the Database. If nil,
+	// one created by pub.NewActivityStreamsHandler is used.
+	Handler pub.HandlerFunc
+}
+
+// Result is whether a requirement is met.
+type Result struct {
+	// Name identifies the check.
+	Name string
+	// Requirement is the requirement checked.
+	Requirement string
+	// Passed is whether the requirement is met.
+	Passed bool
+	// Detail describes why the requirement is not met.
+	Detail string
+}
+
+// Report is the result of each check, in the order they were run.
+type Report struct {
+	Results []Result
+}
+
+// Passed returns true if every requirement is met.
+func (r *Report) Passed() bool {
+	for _, res := range r.Results {
+		if !res.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Err returns an error listing the requirements that are not met, or nil if
+// every requirement is met.
+func (r *Report) Err() error {
+	var failed []string
+	for _, res := range r.Results {
+		if !res.Passed {
+			failed = append(failed, fmt.Sprintf("%s: %s", res.Name, res.Detail))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d conformance checks failed:\n%s", len(failed), len(r.Results), strings.Join(failed, "\n"))
+}
+
+// String lists the result of each check.
+func (r *Report) String() string {
+	var b strings.Builder
+	for _, res := range r.Results {
+		status := "PASS"
+		if !res.Passed {
+			status = "FAIL"
+		}
+		fmt.Fprintf(&b, "%s %s: %s\n", status, res.Name, res.Requirement)
+		if !res.Passed {
+			fmt.Fprintf(&b, "     %s\n", res.Detail)
+		}
+	}
+	return b.String()
+}
+
+// Run checks the conformance of the Target.
+//
+// An error is returned if the checks cannot be run, such as when the Actor is
+// not in the Database. Requirements that are not met are reported in the
+// Report instead.
+func Run(c context.Context, t Target) (*Report, error) {
+	h, err := newHarness(c, t)
+	if err != nil {
+		return nil, err
+	}
+	r := &Report{}
+	for _, check := range checks {
+		res := Result{
+			Name:        check.name,
+			Requirement: check.requirement,
+			Passed:      true,
+		}
+		if err := check.run(c, h); err != nil {
+			res.Passed = false
+			res.Detail = err.Error()
+		}
+		r.Results = append(r.Results, res)
+	}
+	return r, nil
+}
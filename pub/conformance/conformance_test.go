@@ -0,0 +1,118 @@
+package conformance
+
+import (
+	"context"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"testing"
+)
+
+const testActorIRI = "https://example.com/addison"
+
+// newTestDatabase returns a MemoryDatabase with an actor at testActorIRI.
+func newTestDatabase(t *testing.T) *pub.MemoryDatabase {
+	c := context.Background()
+	db := pub.NewMemoryDatabase(mustParse("https://example.com"))
+	p := streams.NewActivityStreamsPerson()
+	streams.SetId(p, mustParse(testActorIRI))
+	inbox := streams.NewActivityStreamsInboxProperty()
+	inbox.SetIRI(mustParse(testActorIRI + "/inbox"))
+	p.SetActivityStreamsInbox(inbox)
+	outbox := streams.NewActivityStreamsOutboxProperty()
+	outbox.SetIRI(mustParse(testActorIRI + "/outbox"))
+	p.SetActivityStreamsOutbox(outbox)
+	if err := db.Create(c, p); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return db
+}
+
+// mustParse parses the IRI, panicking if it is invalid.
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// noDedupDatabase never finds an activity in an inbox.
+type noDedupDatabase struct {
+	*pub.MemoryDatabase
+}
+
+// InboxContains returns false.
+func (noDedupDatabase) InboxContains(c context.Context, inbox, id *url.URL) (bool, error) {
+	return false, nil
+}
+
+// noUpdateDatabase ignores updates.
+type noUpdateDatabase struct {
+	*pub.MemoryDatabase
+}
+
+// Update does nothing.
+func (noUpdateDatabase) Update(c context.Context, asType vocab.Type) error {
+	return nil
+}
+
+// TestRun tests that the requirements met by a Database are reported as such.
+func TestRun(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		db     func(*pub.MemoryDatabase) pub.Database
+		failed map[string]bool
+	}{
+		{
+			name: "MemoryDatabase",
+			db:   func(db *pub.MemoryDatabase) pub.Database { return db },
+		},
+		{
+			name:   "NoDeduplication",
+			db:     func(db *pub.MemoryDatabase) pub.Database { return noDedupDatabase{db} },
+			failed: map[string]bool{"inbox-deduplication": true},
+		},
+		{
+			name:   "NoUpdates",
+			db:     func(db *pub.MemoryDatabase) pub.Database { return noUpdateDatabase{db} },
+			failed: map[string]bool{"delete-tombstone": true},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			report, err := Run(context.Background(), Target{
+				Database: test.db(newTestDatabase(t)),
+				Actor:    mustParse(testActorIRI),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(report.Results) != len(checks) {
+				t.Fatalf("got %d results, want %d", len(report.Results), len(checks))
+			}
+			for _, res := range report.Results {
+				if res.Passed == test.failed[res.Name] {
+					t.Errorf("got %s passed %v, want %v:\n%s", res.Name, res.Passed, !test.failed[res.Name], report)
+				}
+			}
+			if report.Passed() != (len(test.failed) == 0) {
+				t.Errorf("got report passed %v", report.Passed())
+			} else if (report.Err() == nil) != (len(test.failed) == 0) {
+				t.Errorf("got report error %v", report.Err())
+			}
+		})
+	}
+}
+
+// TestRunRequiresActor tests that the checks are not run without the Actor in
+// the Database.
+func TestRunRequiresActor(t *testing.T) {
+	_, err := Run(context.Background(), Target{
+		Database: pub.NewMemoryDatabase(mustParse("https://example.com")),
+		Actor:    mustParse(testActorIRI),
+	})
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
@@ -0,0 +1,27 @@
+// Package conformance checks an application's integration of package pub
+// against requirements of the ActivityPub specification.
+//
+// The checks run an Actor built with the application's Database, and
+// optionally its callbacks, against simulated peers: they post activities to
+// the inbox and outbox of one of the application's actors, record the
+// deliveries made to the peers, and examine what the Database stored. They
+// cover the de-duplication of the inbox, the removal of the 'bto' and 'bcc'
+// properties before delivery, the Tombstones of deleted objects, and the media
+// types of ActivityStreams requests and responses.
+//
+// The resulting Report lists each requirement and whether it is met, so that
+// it can be printed or used to fail a test:
+//
+//	report, err := conformance.Run(c, conformance.Target{
+//		Database: myDatabase,
+//		Actor:    myActorIRI,
+//	})
+//	if err != nil {
+//		t.Fatal(err)
+//	} else if err = report.Err(); err != nil {
+//		t.Error(err)
+//	}
+//
+// The checks store activities and objects in the Database, so it must not be
+// one used in production.
+package conformance
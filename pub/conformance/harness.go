@@ -0,0 +1,316 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/pub/pubtest"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const (
+	// remoteHost is the host of the simulated peers.
+	remoteHost = "remote.example"
+	// remoteActorsPath is the path beneath which the simulated peers serve
+	// their actors.
+	remoteActorsPath = "/actors/"
+	// ldJSONMediaType is the media type that servers must accept and serve.
+	ldJSONMediaType = `application/ld+json; profile="https://www.w3.org/ns/activitystreams"`
+	// activityJSONMediaType is the media type that servers should accept
+	// and serve.
+	activityJSONMediaType = "application/activity+json"
+)
+
+var _ pub.CommonBehavior = &harness{}
+var _ pub.FederatingProtocol = &harness{}
+var _ pub.SocialProtocol = &harness{}
+
+// harness runs an Actor with the Target's Database and callbacks, which trusts
+// every request and delivers to the simulated peers.
+type harness struct {
+	target  Target
+	actor   pub.FederatingActor
+	handler pub.HandlerFunc
+	tp      *transport
+	inbox   *url.URL
+	outbox  *url.URL
+	// run distinguishes the IRIs of this run from those of other runs
+	// against the same Database.
+	run string
+	// mu guards n.
+	mu sync.Mutex
+	n  int
+}
+
+// newHarness creates the harness of the Target, whose Actor must be in its
+// Database.
+func newHarness(c context.Context, t Target) (*harness, error) {
+	if t.Database == nil {
+		return nil, fmt.Errorf("target has no Database")
+	} else if t.Actor == nil {
+		return nil, fmt.Errorf("target has no Actor")
+	}
+	actor, err := getValue(c, t.Database, t.Actor)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get actor %s: %v", t.Actor, err)
+	}
+	h := &harness{
+		target:  t,
+		handler: t.Handler,
+		tp:      &transport{deliveries: make(map[string][][]byte)},
+	}
+	if v, ok := actor.(interface {
+		GetActivityStreamsInbox() vocab.ActivityStreamsInboxProperty
+	}); ok && v.GetActivityStreamsInbox() != nil {
+		h.inbox, err = pub.ToId(v.GetActivityStreamsInbox())
+	}
+	if h.inbox == nil || err != nil {
+		return nil, fmt.Errorf("actor %s has no inbox", t.Actor)
+	}
+	if v, ok := actor.(interface {
+		GetActivityStreamsOutbox() vocab.ActivityStreamsOutboxProperty
+	}); ok && v.GetActivityStreamsOutbox() != nil {
+		h.outbox, err = pub.ToId(v.GetActivityStreamsOutbox())
+	}
+	if h.outbox == nil || err != nil {
+		return nil, fmt.Errorf("actor %s has no outbox", t.Actor)
+	}
+	b := make([]byte, 8)
+	if _, err = rand.Read(b); err != nil {
+		return nil, err
+	}
+	h.run = hex.EncodeToString(b)
+	clock := pubtest.NewClock()
+	h.actor = pub.NewActor(h, h, h, t.Database, clock)
+	if h.handler == nil {
+		h.handler = pub.NewActivityStreamsHandler(t.Database, clock)
+	}
+	return h, nil
+}
+
+// remoteIRI returns a new IRI of the simulated peers, of a kind such as
+// "notes".
+func (h *harness) remoteIRI(kind string) *url.URL {
+	h.mu.Lock()
+	h.n++
+	n := h.n
+	h.mu.Unlock()
+	return &url.URL{
+		Scheme: "https",
+		Host:   remoteHost,
+		Path:   fmt.Sprintf("/%s/%s-%d", kind, h.run, n),
+	}
+}
+
+// remoteActor returns a new IRI of an actor of the simulated peers, whose
+// inbox is the actor's IRI with "/inbox" appended.
+func (h *harness) remoteActor() *url.URL {
+	return h.remoteIRI(strings.Trim(remoteActorsPath, "/"))
+}
+
+// postInbox posts the activity to the Actor's inbox with the media type.
+func (h *harness) postInbox(c context.Context, t vocab.Type, mediaType string) (*httptest.ResponseRecorder, bool, error) {
+	return h.post(c, h.actor.PostInbox, h.inbox, t, mediaType)
+}
+
+// postOutbox posts the value to the Actor's outbox with the media type.
+func (h *harness) postOutbox(c context.Context, t vocab.Type, mediaType string) (*httptest.ResponseRecorder, bool, error) {
+	return h.post(c, h.actor.PostOutbox, h.outbox, t, mediaType)
+}
+
+// post posts the value to the box with the function handling the box.
+func (h *harness) post(c context.Context, fn pub.HandlerFunc, box *url.URL, t vocab.Type, mediaType string) (*httptest.ResponseRecorder, bool, error) {
+	b, err := serialize(t)
+	if err != nil {
+		return nil, false, err
+	}
+	r := httptest.NewRequest("POST", box.String(), bytes.NewReader(b))
+	r.Header.Set("Content-Type", mediaType)
+	w := httptest.NewRecorder()
+	handled, err := fn(c, w, r)
+	return w, handled, err
+}
+
+// get requests the value with the IRI from the Handler, accepting the media
+// type.
+func (h *harness) get(c context.Context, iri *url.URL, mediaType string) (*httptest.ResponseRecorder, bool, error) {
+	r := httptest.NewRequest("GET", iri.String(), nil)
+	r.Header.Set("Accept", mediaType)
+	w := httptest.NewRecorder()
+	handled, err := h.handler(c, w, r)
+	return w, handled, err
+}
+
+// AuthenticateGetInbox trusts every request.
+func (h *harness) AuthenticateGetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return c, true, nil
+}
+
+// AuthenticateGetOutbox trusts every request.
+func (h *harness) AuthenticateGetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return c, true, nil
+}
+
+// GetOutbox returns the first page of the Actor's outbox.
+func (h *harness) GetOutbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	return h.target.Database.GetOutbox(c, h.outbox)
+}
+
+// NewTransport returns the transport to the simulated peers.
+func (h *harness) NewTransport(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (pub.Transport, error) {
+	return h.tp, nil
+}
+
+// PostInboxRequestBodyHook does nothing.
+func (h *harness) PostInboxRequestBodyHook(c context.Context, r *http.Request, activity pub.Activity) (context.Context, error) {
+	return c, nil
+}
+
+// AuthenticatePostInbox trusts every request.
+func (h *harness) AuthenticatePostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return c, true, nil
+}
+
+// Blocked blocks no one.
+func (h *harness) Blocked(c context.Context, actorIRIs []*url.URL) (bool, error) {
+	return false, nil
+}
+
+// FederatingCallbacks returns the Target's callbacks, if any.
+func (h *harness) FederatingCallbacks(c context.Context) (pub.FederatingWrappedCallbacks, []interface{}, error) {
+	if h.target.FederatingCallbacks != nil {
+		return h.target.FederatingCallbacks(c)
+	}
+	return pub.FederatingWrappedCallbacks{}, nil, nil
+}
+
+// DefaultCallback does nothing.
+func (h *harness) DefaultCallback(c context.Context, activity pub.Activity) error {
+	return nil
+}
+
+// MaxInboxForwardingRecursionDepth only examines the received activity.
+func (h *harness) MaxInboxForwardingRecursionDepth(c context.Context) int {
+	return 1
+}
+
+// MaxDeliveryRecursionDepth only examines the addressed collections.
+func (h *harness) MaxDeliveryRecursionDepth(c context.Context) int {
+	return 1
+}
+
+// FilterForwarding forwards to no one.
+func (h *harness) FilterForwarding(c context.Context, potentialRecipients []*url.URL, a pub.Activity) ([]*url.URL, error) {
+	return nil, nil
+}
+
+// GetInbox returns the first page of the Actor's inbox.
+func (h *harness) GetInbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	return h.target.Database.GetInbox(c, h.inbox)
+}
+
+// PostOutboxRequestBodyHook does nothing.
+func (h *harness) PostOutboxRequestBodyHook(c context.Context, r *http.Request, data vocab.Type) (context.Context, error) {
+	return c, nil
+}
+
+// AuthenticatePostOutbox trusts every request.
+func (h *harness) AuthenticatePostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return c, true, nil
+}
+
+// SocialCallbacks returns the Target's callbacks, if any.
+func (h *harness) SocialCallbacks(c context.Context) (pub.SocialWrappedCallbacks, []interface{}, error) {
+	if h.target.SocialCallbacks != nil {
+		return h.target.SocialCallbacks(c)
+	}
+	return pub.SocialWrappedCallbacks{}, nil, nil
+}
+
+// transport serves the actors of the simulated peers and records the
+// deliveries to their inboxes.
+type transport struct {
+	// mu guards deliveries.
+	mu         sync.Mutex
+	deliveries map[string][][]byte
+}
+
+// Dereference serves the actors of the simulated peers. Other IRIs are not
+// found.
+func (t *transport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	name := strings.TrimPrefix(iri.Path, remoteActorsPath)
+	if iri.Host != remoteHost || name == iri.Path || strings.Contains(name, "/") {
+		return nil, &pub.DereferenceError{
+			IRI:        iri,
+			StatusCode: http.StatusNotFound,
+			Status:     "404 Not Found",
+			Err:        pub.ErrObjectNotFound,
+		}
+	}
+	actor := streams.NewActivityStreamsPerson()
+	streams.SetId(actor, iri)
+	inbox := streams.NewActivityStreamsInboxProperty()
+	inbox.SetIRI(inboxOf(iri))
+	actor.SetActivityStreamsInbox(inbox)
+	return serialize(actor)
+}
+
+// Deliver records the delivery to the inbox.
+func (t *transport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.deliveries[to.String()] = append(t.deliveries[to.String()], b)
+	return nil
+}
+
+// BatchDeliver records the delivery to each inbox.
+func (t *transport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	for _, to := range recipients {
+		if err := t.Deliver(c, b, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delivered returns the payloads delivered to the inbox.
+func (t *transport) delivered(inbox *url.URL) [][]byte {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.deliveries[inbox.String()]
+}
+
+// inboxOf returns the inbox of an actor of the simulated peers.
+func inboxOf(actor *url.URL) *url.URL {
+	inbox := *actor
+	inbox.Path += "/inbox"
+	return &inbox
+}
+
+// getValue returns the value with the id from the Database.
+func getValue(c context.Context, db pub.Database, id *url.URL) (vocab.Type, error) {
+	if err := db.Lock(c, id); err != nil {
+		return nil, err
+	}
+	defer db.Unlock(c, id)
+	return db.Get(c, id)
+}
+
+// serialize returns the JSON of the value.
+func serialize(t vocab.Type) ([]byte, error) {
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
@@ -92,8 +92,9 @@ type Database interface {
 	Update(c context.Context, asType vocab.Type) error
 	// Delete removes the entry with the given id.
 	//
-	// Delete is only called for federated objects. Deletes from the Social
-	// Protocol instead call Update to create a Tombstone.
+	// Delete is not called when handling Delete activities. Deletes from
+	// both the Social and Federating Protocols instead call Update to
+	// replace the entry with a Tombstone.
 	//
 	// The library makes this call only after acquiring a lock first.
 	Delete(c context.Context, id *url.URL) error
@@ -137,3 +138,95 @@ type Database interface {
 	// The library makes this call only after acquiring a lock first.
 	Liked(c context.Context, actorIRI *url.URL) (followers vocab.ActivityStreamsCollection, err error)
 }
+
+// TransactionalDatabase is a Database able to apply the changes made while
+// handling an activity atomically, such as with SQL transactions.
+//
+// When the Database given to an Actor is also a TransactionalDatabase, the side
+// effects of an activity received in an inbox or posted to an outbox happen
+// within a transaction. The transaction is carried by the context passed to the
+// Database methods, and to the application's callbacks, so they can take part
+// in it. Locks are still taken within a transaction, and may be no-ops if the
+// transactions provide enough isolation.
+type TransactionalDatabase interface {
+	Database
+	// Begin starts a new transaction, returning a context carrying it that
+	// is derived from the given context.
+	Begin(c context.Context) (tx context.Context, err error)
+	// Commit applies the changes made in the transaction carried by the
+	// context.
+	Commit(tx context.Context) error
+	// Rollback discards the changes made in the transaction carried by the
+	// context.
+	Rollback(tx context.Context) error
+}
+
+// OutboxPager is a Database able to retrieve a range of the items in an outbox,
+// so that a handler created by NewOutboxHandler can serve the outbox in pages
+// without loading all of its items.
+type OutboxPager interface {
+	Database
+	// OutboxItems returns the ids of at most 'limit' items of the outbox,
+	// skipping the first 'offset' items, in the same order as the items of
+	// GetOutbox. It also returns the total number of items in the outbox.
+	// A 'limit' of zero only counts the items.
+	//
+	// The library makes this call only after acquiring a lock first.
+	OutboxItems(c context.Context, outboxIRI *url.URL, offset, limit int) (items []*url.URL, totalItems int, err error)
+}
+
+// FollowersSynchronizer is a Database able to keep the followers collections of
+// this server and its peers consistent, following FEP-8fcf as Mastodon does.
+//
+// When the Database given to an Actor is also a FollowersSynchronizer:
+//
+// 1. Activities delivered to the followers of a local actor carry a
+// Collection-Synchronization header, with a digest of the followers on the
+// receiving server. It is only sent by a Transport created for the delivery,
+// and not for deliveries enqueued in a DeliveryQueue.
+//
+// 2. When an activity received in an inbox carries a Collection-Synchronization
+// header whose digest does not match the local actors following its sender, the
+// sender's partial followers collection is fetched and the differences are
+// given to ReconcileFollowers. Failing to do so is logged, and does not fail
+// the request.
+//
+// The application serves the partial followers collection of its actors at the
+// URL returned by PartialFollowersURL, only listing the followers on the server
+// of the actor whose HTTP Signature authenticates the request. PartialFollowers
+// selects these followers.
+type FollowersSynchronizer interface {
+	Database
+	// PartialFollowersURL returns the URL at which peers fetch the followers
+	// of the local actor that are on their server.
+	PartialFollowersURL(c context.Context, actorIRI *url.URL) (u *url.URL, err error)
+	// LocalFollowers returns the local actors following the peer actor
+	// with the followers collection.
+	LocalFollowers(c context.Context, followersIRI *url.URL) (followers []*url.URL, err error)
+	// ReconcileFollowers is called when the peer's followers collection
+	// disagrees with LocalFollowers.
+	//
+	// The stale actors follow the peer actor according to this server, but
+	// are not in its followers collection, and should stop following it.
+	// The unknown actors are in its followers collection, but do not follow
+	// it according to this server, and should Undo their Follow.
+	ReconcileFollowers(c context.Context, followersIRI *url.URL, stale, unknown []*url.URL) error
+}
+
+// FollowRequestDatabase is a Database keeping the Follow requests of local
+// actors that await a decision, when the FederatingWrappedCallbacks queue them
+// with OnFollowQueueForApproval.
+//
+// The application lists the pending requests with FollowRequests, and decides
+// on them with AcceptFollowRequest or RejectFollowRequest. A pending request
+// undone by its actor is removed.
+type FollowRequestDatabase interface {
+	Database
+	// AddFollowRequest records the Follow of the local actor as pending.
+	AddFollowRequest(c context.Context, actorIRI *url.URL, follow vocab.ActivityStreamsFollow) error
+	// FollowRequests returns the pending Follows of the local actor.
+	FollowRequests(c context.Context, actorIRI *url.URL) (follows []vocab.ActivityStreamsFollow, err error)
+	// RemoveFollowRequest removes the pending Follow with the id. It is not
+	// an error if it is not pending.
+	RemoveFollowRequest(c context.Context, followIRI *url.URL) error
+}
@@ -124,8 +124,8 @@ type DelegateActor interface {
 	// later) must decide whether it has seen this activity before in order
 	// to determine whether to do the forwarding algorithm.
 	//
-	// If the error is ErrObjectRequired or ErrTargetRequired, then a Bad
-	// Request status is sent in the response.
+	// If the error is ErrObjectRequired, ErrTargetRequired, or
+	// ErrOneOfAndAnyOf, then a Bad Request status is sent in the response.
 	PostInbox(c context.Context, inboxIRI *url.URL, activity Activity) error
 	// InboxForwarding delegates inbox forwarding logic when a POST request
 	// is received in the Actor's inbox.
@@ -157,8 +157,8 @@ type DelegateActor interface {
 	// general storage for independent retrieval, and not just within the
 	// actor's outbox.
 	//
-	// If the error is ErrObjectRequired or ErrTargetRequired, then a Bad
-	// Request status is sent in the response.
+	// If the error is ErrObjectRequired, ErrTargetRequired, or
+	// ErrOneOfAndAnyOf, then a Bad Request status is sent in the response.
 	//
 	// Note that 'rawJSON' is an unfortunate consequence where an 'Update'
 	// Activity is the only one that explicitly cares about 'null' values in
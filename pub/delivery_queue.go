@@ -0,0 +1,430 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DeliveryPriority orders the deliveries of a DeliveryQueue: deliveries with a
+// higher priority are leased before those with a lower one, so that a large
+// fan-out does not delay more urgent deliveries.
+type DeliveryPriority int
+
+const (
+	// DeliveryPriorityBackfill is for deliveries that may wait for all
+	// others, such as resending old activities to a new follower.
+	DeliveryPriorityBackfill DeliveryPriority = -1
+	// DeliveryPriorityFanOut is the default priority, for activities
+	// delivered to the followers of their actor.
+	DeliveryPriorityFanOut DeliveryPriority = 0
+	// DeliveryPriorityDirect is for activities delivered to the actors
+	// they mention, such as direct messages.
+	DeliveryPriorityDirect DeliveryPriority = 1
+)
+
+// deliveryPriorityKey is the context key of the priority of deliveries.
+type deliveryPriorityKey struct{}
+
+// WithDeliveryPriority returns a context tagging the deliveries enqueued with it
+// with the priority. Applications use it when calling the Actor's Send, or when
+// handling a request whose activities are delivered.
+func WithDeliveryPriority(c context.Context, p DeliveryPriority) context.Context {
+	return context.WithValue(c, deliveryPriorityKey{}, p)
+}
+
+// DeliveryPriorityFromContext returns the priority added to the context by
+// WithDeliveryPriority, or DeliveryPriorityFanOut.
+func DeliveryPriorityFromContext(c context.Context) DeliveryPriority {
+	p, _ := c.Value(deliveryPriorityKey{}).(DeliveryPriority)
+	return p
+}
+
+// Delivery is the pending delivery of an ActivityStreams payload to a single
+// recipient, held by a DeliveryQueue.
+type Delivery struct {
+	// ID uniquely identifies the delivery within its DeliveryQueue.
+	ID string
+	// BoxIRI is the inbox or outbox of the actor on whose behalf the
+	// payload is sent, as given to the CommonBehavior's NewTransport.
+	BoxIRI *url.URL
+	// Payload is the serialized ActivityStreams value, sent unmodified.
+	Payload []byte
+	// Recipient is the inbox the payload is delivered to.
+	Recipient *url.URL
+	// Attempts is the number of failed attempts so far.
+	Attempts int
+	// Priority is the priority the delivery was enqueued with.
+	Priority DeliveryPriority
+}
+
+// DeliveryQueue persists the deliveries of ActivityStreams payloads, so that
+// they are retried until they succeed and survive restarts of the server.
+//
+// When the Database given to an Actor is also a DeliveryQueue, the activities
+// the actor delivers are enqueued instead of being sent right away, and are
+// sent by a DeliveryWorker. Otherwise, they are sent with the Transport's
+// BatchDeliver while handling the request. A BudgetTransport also enqueues the
+// deliveries that exceed its budget.
+//
+// A delivery is leased for a period of time, during which it is not leased
+// again. A delivery that is neither acknowledged nor negatively acknowledged
+// before its lease expires, for example because the server crashed, becomes
+// available again.
+//
+// Deliveries are enqueued with the priority of the context, as returned by
+// DeliveryPriorityFromContext, and available deliveries with a higher priority
+// are leased first.
+//
+// Implementations must be safe for concurrent use. A MemoryDeliveryQueue is
+// provided for tests and prototypes; deployments that must survive restarts
+// implement it with their own storage, such as SQL or Redis.
+type DeliveryQueue interface {
+	// Enqueue schedules the delivery of the ActivityStreams payload to
+	// each of the recipients on behalf of the actor with the box, with the
+	// priority of the context.
+	Enqueue(c context.Context, boxIRI *url.URL, b []byte, recipients []*url.URL) error
+	// Lease returns at most 'max' deliveries that are available now, those
+	// with the highest priority first, and makes them unavailable for the
+	// duration of the lease.
+	Lease(c context.Context, max int, lease time.Duration) ([]*Delivery, error)
+	// Ack removes the delivery once it succeeded or was abandoned.
+	Ack(c context.Context, d *Delivery) error
+	// Nack records a failed attempt of the delivery, which becomes
+	// available again after the delay.
+	Nack(c context.Context, d *Delivery, delay time.Duration) error
+}
+
+// DeliveryQueue must be implemented by MemoryDeliveryQueue.
+var _ DeliveryQueue = &MemoryDeliveryQueue{}
+
+// MemoryDeliveryQueue is a DeliveryQueue keeping deliveries in memory, for tests
+// and prototypes. Its deliveries are lost when the process exits. It is safe for
+// concurrent use.
+type MemoryDeliveryQueue struct {
+	clock  Clock
+	mu     sync.Mutex
+	nextId int
+	// pending are the deliveries in the order they were enqueued.
+	pending []*memoryDelivery
+}
+
+// memoryDelivery is a delivery held by a MemoryDeliveryQueue.
+type memoryDelivery struct {
+	d Delivery
+	// available is when the delivery may be leased.
+	available time.Time
+}
+
+// NewMemoryDeliveryQueue returns an empty MemoryDeliveryQueue measuring leases
+// and delays with the clock.
+func NewMemoryDeliveryQueue(clock Clock) *MemoryDeliveryQueue {
+	return &MemoryDeliveryQueue{
+		clock: clock,
+	}
+}
+
+// Enqueue adds a delivery for each recipient, available right away.
+func (m *MemoryDeliveryQueue) Enqueue(c context.Context, boxIRI *url.URL, b []byte, recipients []*url.URL) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.clock.Now()
+	priority := DeliveryPriorityFromContext(c)
+	for _, r := range recipients {
+		m.nextId++
+		m.pending = append(m.pending, &memoryDelivery{
+			d: Delivery{
+				ID:        strconv.Itoa(m.nextId),
+				BoxIRI:    boxIRI,
+				Payload:   b,
+				Recipient: r,
+				Priority:  priority,
+			},
+			available: now,
+		})
+	}
+	return nil
+}
+
+// Lease returns the available deliveries with the highest priority, the oldest
+// first.
+func (m *MemoryDeliveryQueue) Lease(c context.Context, max int, lease time.Duration) ([]*Delivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.clock.Now()
+	var available []*memoryDelivery
+	for _, p := range m.pending {
+		if !p.available.After(now) {
+			available = append(available, p)
+		}
+	}
+	sort.SliceStable(available, func(i, j int) bool {
+		return available[i].d.Priority > available[j].d.Priority
+	})
+	if max < 0 {
+		max = 0
+	}
+	if len(available) > max {
+		available = available[:max]
+	}
+	leased := make([]*Delivery, 0, len(available))
+	for _, p := range available {
+		p.available = now.Add(lease)
+		d := p.d
+		leased = append(leased, &d)
+	}
+	return leased, nil
+}
+
+// Ack removes the delivery.
+func (m *MemoryDeliveryQueue) Ack(c context.Context, d *Delivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	i, err := m.find(d)
+	if err != nil {
+		return err
+	}
+	m.pending = append(m.pending[:i], m.pending[i+1:]...)
+	return nil
+}
+
+// Nack counts the failed attempt and delays the delivery.
+func (m *MemoryDeliveryQueue) Nack(c context.Context, d *Delivery, delay time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	i, err := m.find(d)
+	if err != nil {
+		return err
+	}
+	m.pending[i].d.Attempts++
+	m.pending[i].available = m.clock.Now().Add(delay)
+	return nil
+}
+
+// Len returns the number of deliveries in the queue, including leased ones.
+func (m *MemoryDeliveryQueue) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.pending)
+}
+
+// find returns the index of the delivery. The lock must be held.
+func (m *MemoryDeliveryQueue) find(d *Delivery) (int, error) {
+	for i, p := range m.pending {
+		if p.d.ID == d.ID {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("no delivery with id: %s", d.ID)
+}
+
+// maxBackoffDoublings bounds the exponential backoff of a DeliveryWorker, so
+// that the delay between attempts stops growing.
+const maxBackoffDoublings = 16
+
+// DeliveryWorker sends the deliveries of a DeliveryQueue with the Transport of
+// the actor on whose behalf each is sent, retrying failed deliveries with an
+// exponential backoff.
+//
+// Multiple workers, in one or several processes, may share a DeliveryQueue.
+type DeliveryWorker struct {
+	queue       DeliveryQueue
+	common      CommonBehavior
+	lease       time.Duration
+	maxAttempts int
+	backoff     time.Duration
+	// batch groups the deliveries to the same inbox on behalf of the same
+	// actor, which are sent back-to-back with a single Transport.
+	batch bool
+	// window is how long to wait for more deliveries to join a batch.
+	window time.Duration
+}
+
+// NewDeliveryWorker returns a DeliveryWorker sending the deliveries of the
+// queue with the Transports created by the CommonBehavior.
+//
+// Each delivery is leased for the lease duration, which must exceed the time a
+// delivery may take. A failed delivery is retried after the backoff, which
+// doubles with each failed attempt, until it failed maxAttempts times. A zero or
+// negative maxAttempts retries forever.
+func NewDeliveryWorker(q DeliveryQueue, common CommonBehavior, lease time.Duration, maxAttempts int, backoff time.Duration) *DeliveryWorker {
+	return &DeliveryWorker{
+		queue:       q,
+		common:      common,
+		lease:       lease,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+}
+
+// NewBatchingDeliveryWorker returns a DeliveryWorker that batches the
+// deliveries to the same inbox on behalf of the same actor, sending them
+// back-to-back with a single Transport. This reuses the Transport's signer and
+// connection, reducing connection churn during large fan-outs.
+//
+// Once deliveries are leased, the worker waits for the window so that
+// deliveries enqueued meanwhile may join their batches. The lease must exceed
+// the window and the time a batch may take. A zero window does not wait.
+//
+// The other parameters are the same as for NewDeliveryWorker.
+func NewBatchingDeliveryWorker(q DeliveryQueue, common CommonBehavior, lease time.Duration, maxAttempts int, backoff, window time.Duration) *DeliveryWorker {
+	w := NewDeliveryWorker(q, common, lease, maxAttempts, backoff)
+	w.batch = true
+	w.window = window
+	return w
+}
+
+// DeliverDue concurrently sends at most 'max' deliveries that are available
+// now, returning how many were leased. A batching worker sends each batch
+// concurrently, and the deliveries within a batch one after the other.
+//
+// Returns an error if the deliveries could not be leased, or if any of them
+// could not be acknowledged. Failed deliveries are not errors, and are retried.
+func (w *DeliveryWorker) DeliverDue(c context.Context, max int) (n int, err error) {
+	ds, err := w.queue.Lease(c, max, w.lease)
+	if err != nil {
+		return
+	}
+	if w.batch && w.window > 0 && len(ds) > 0 && len(ds) < max {
+		ds = append(ds, w.leaseAfterWindow(c, max-len(ds))...)
+	}
+	batches := w.batches(ds)
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(ds))
+	for _, b := range batches {
+		wg.Add(1)
+		go func(b []*Delivery) {
+			defer wg.Done()
+			for _, err := range w.deliver(c, b) {
+				errCh <- err
+			}
+		}(b)
+	}
+	wg.Wait()
+	close(errCh)
+	errs := make([]string, 0, len(ds))
+	for e := range errCh {
+		errs = append(errs, e.Error())
+	}
+	n = len(ds)
+	if len(errs) > 0 {
+		err = fmt.Errorf("failed to acknowledge at least one delivery: %s", strings.Join(errs, "; "))
+	}
+	return
+}
+
+// leaseAfterWindow waits for the window, then leases at most 'max' more
+// deliveries to join the batches of those already leased. Errors are logged,
+// since the deliveries already leased are sent regardless.
+func (w *DeliveryWorker) leaseAfterWindow(c context.Context, max int) []*Delivery {
+	t := time.NewTimer(w.window)
+	defer t.Stop()
+	select {
+	case <-c.Done():
+		return nil
+	case <-t.C:
+	}
+	ds, err := w.queue.Lease(c, max, w.lease)
+	if err != nil {
+		logEvent(c, LogError, "failed to lease deliveries joining a batch", "error", err)
+		return nil
+	}
+	return ds
+}
+
+// batches groups the deliveries to send with the same Transport, preserving
+// their order. Without batching, each delivery is sent on its own.
+func (w *DeliveryWorker) batches(ds []*Delivery) [][]*Delivery {
+	batches := make([][]*Delivery, 0, len(ds))
+	if !w.batch {
+		for _, d := range ds {
+			batches = append(batches, []*Delivery{d})
+		}
+		return batches
+	}
+	idx := make(map[string]int, len(ds))
+	for _, d := range ds {
+		key := d.BoxIRI.String() + " " + d.Recipient.String()
+		if i, ok := idx[key]; ok {
+			batches[i] = append(batches[i], d)
+		} else {
+			idx[key] = len(batches)
+			batches = append(batches, []*Delivery{d})
+		}
+	}
+	return batches
+}
+
+// Run sends the deliveries of the queue, at most 'max' at a time, until the
+// context is done. When the queue has no more available deliveries, it is
+// polled again after the poll interval. A max less than one is treated as one.
+//
+// Errors are logged with the context's Logger. Run always returns the
+// context's error.
+func (w *DeliveryWorker) Run(c context.Context, max int, poll time.Duration) error {
+	if max < 1 {
+		max = 1
+	}
+	for {
+		n, err := w.DeliverDue(c, max)
+		if err != nil {
+			logEvent(c, LogError, "failed to process deliveries", "error", err)
+		}
+		if err == nil && n >= max {
+			if err = c.Err(); err != nil {
+				return err
+			}
+			continue
+		}
+		t := time.NewTimer(poll)
+		select {
+		case <-c.Done():
+			t.Stop()
+			return c.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// deliver sends the batch of deliveries one after the other with a single
+// Transport, settling each of them. Returns the errors settling the
+// deliveries.
+func (w *DeliveryWorker) deliver(c context.Context, batch []*Delivery) (errs []error) {
+	tp, tpErr := w.common.NewTransport(c, batch[0].BoxIRI, goFedUserAgent())
+	for _, d := range batch {
+		err := tpErr
+		if err == nil {
+			err = tp.Deliver(c, d.Payload, d.Recipient)
+		}
+		if err = w.settle(c, d, err); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return
+}
+
+// settle acknowledges the delivery if it succeeded or ran out of attempts, and
+// negatively acknowledges it otherwise. The error is the outcome of the
+// delivery.
+func (w *DeliveryWorker) settle(c context.Context, d *Delivery, err error) error {
+	if err == nil {
+		return w.queue.Ack(c, d)
+	}
+	attempts := d.Attempts + 1
+	if w.maxAttempts > 0 && attempts >= w.maxAttempts {
+		logEvent(c, LogWarn, "abandoned delivery after its last attempt failed", "recipient", d.Recipient.String(), "attempts", attempts, "error", err)
+		return w.queue.Ack(c, d)
+	}
+	doublings := d.Attempts
+	if doublings > maxBackoffDoublings {
+		doublings = maxBackoffDoublings
+	}
+	delay := w.backoff << uint(doublings)
+	logEvent(c, LogInfo, "scheduled retry of failed delivery", "recipient", d.Recipient.String(), "attempts", attempts, "delay", delay, "error", err)
+	return w.queue.Nack(c, d, delay)
+}
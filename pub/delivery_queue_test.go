@@ -0,0 +1,387 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestMemoryDeliveryQueue(t *testing.T) {
+	ctx := context.Background()
+	recipients := []*url.URL{mustParse(testFederatedInboxIRI), mustParse(testFederatedInboxIRI2)}
+	t.Run("LeasesEnqueuedDeliveries", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		q := NewMemoryDeliveryQueue(cl)
+		// Mock
+		cl.EXPECT().Now().Return(now()).Times(2)
+		// Run & Verify
+		err := q.Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, recipients)
+		assertEqual(t, err, nil)
+		ds, err := q.Lease(ctx, 10, time.Minute)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(ds), 2)
+		for i, d := range ds {
+			assertEqual(t, d.BoxIRI.String(), testMyOutboxIRI)
+			assertByteEqual(t, d.Payload, testRespBody)
+			assertEqual(t, d.Recipient.String(), recipients[i].String())
+			assertEqual(t, d.Attempts, 0)
+		}
+		assertNotEqual(t, ds[0].ID, ds[1].ID)
+	})
+	t.Run("LeasesAtMostMax", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		q := NewMemoryDeliveryQueue(cl)
+		// Mock
+		cl.EXPECT().Now().Return(now()).Times(2)
+		// Run & Verify
+		err := q.Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, recipients)
+		assertEqual(t, err, nil)
+		ds, err := q.Lease(ctx, 1, time.Minute)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(ds), 1)
+		assertEqual(t, ds[0].Recipient.String(), testFederatedInboxIRI)
+	})
+	t.Run("LeasesHigherPriorityFirst", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		q := NewMemoryDeliveryQueue(cl)
+		// Mock
+		cl.EXPECT().Now().Return(now()).Times(4)
+		// Run & Verify
+		err := q.Enqueue(WithDeliveryPriority(ctx, DeliveryPriorityBackfill), mustParse(testMyOutboxIRI), testRespBody, recipients[:1])
+		assertEqual(t, err, nil)
+		err = q.Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, recipients)
+		assertEqual(t, err, nil)
+		err = q.Enqueue(WithDeliveryPriority(ctx, DeliveryPriorityDirect), mustParse(testMyOutboxIRI), testRespBody, recipients[1:])
+		assertEqual(t, err, nil)
+		ds, err := q.Lease(ctx, 3, time.Minute)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(ds), 3)
+		assertEqual(t, ds[0].Priority, DeliveryPriorityDirect)
+		assertEqual(t, ds[0].Recipient.String(), testFederatedInboxIRI2)
+		assertEqual(t, ds[1].Priority, DeliveryPriorityFanOut)
+		assertEqual(t, ds[1].Recipient.String(), testFederatedInboxIRI)
+		assertEqual(t, ds[2].Priority, DeliveryPriorityFanOut)
+		assertEqual(t, ds[2].Recipient.String(), testFederatedInboxIRI2)
+	})
+	t.Run("DoesNotLeaseLeasedUntilLeaseExpires", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		q := NewMemoryDeliveryQueue(cl)
+		// Mock
+		gomock.InOrder(
+			cl.EXPECT().Now().Return(now()),
+			cl.EXPECT().Now().Return(now()),
+			cl.EXPECT().Now().Return(now().Add(time.Second)),
+			cl.EXPECT().Now().Return(now().Add(time.Minute)),
+		)
+		// Run & Verify
+		err := q.Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, recipients[:1])
+		assertEqual(t, err, nil)
+		ds, err := q.Lease(ctx, 10, time.Minute)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(ds), 1)
+		ds, err = q.Lease(ctx, 10, time.Minute)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(ds), 0)
+		ds, err = q.Lease(ctx, 10, time.Minute)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(ds), 1)
+	})
+	t.Run("AckRemovesDelivery", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		q := NewMemoryDeliveryQueue(cl)
+		// Mock
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		// Run & Verify
+		err := q.Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, recipients)
+		assertEqual(t, err, nil)
+		ds, err := q.Lease(ctx, 1, time.Minute)
+		assertEqual(t, err, nil)
+		err = q.Ack(ctx, ds[0])
+		assertEqual(t, err, nil)
+		assertEqual(t, q.Len(), 1)
+		err = q.Ack(ctx, ds[0])
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("NackDelaysAndCountsAttempt", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		q := NewMemoryDeliveryQueue(cl)
+		// Mock
+		gomock.InOrder(
+			cl.EXPECT().Now().Return(now()),
+			cl.EXPECT().Now().Return(now()),
+			cl.EXPECT().Now().Return(now()),
+			cl.EXPECT().Now().Return(now().Add(time.Hour-time.Second)),
+			cl.EXPECT().Now().Return(now().Add(time.Hour)),
+		)
+		// Run & Verify
+		err := q.Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, recipients[:1])
+		assertEqual(t, err, nil)
+		ds, err := q.Lease(ctx, 10, time.Minute)
+		assertEqual(t, err, nil)
+		err = q.Nack(ctx, ds[0], time.Hour)
+		assertEqual(t, err, nil)
+		ds, err = q.Lease(ctx, 10, time.Minute)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(ds), 0)
+		ds, err = q.Lease(ctx, 10, time.Minute)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(ds), 1)
+		assertEqual(t, ds[0].Attempts, 1)
+	})
+}
+
+func TestDeliveryWorker(t *testing.T) {
+	ctx := context.Background()
+	newDeliveryFn := func(attempts int) *Delivery {
+		return &Delivery{
+			ID:        "1",
+			BoxIRI:    mustParse(testMyOutboxIRI),
+			Payload:   testRespBody,
+			Recipient: mustParse(testFederatedInboxIRI),
+			Attempts:  attempts,
+		}
+	}
+	setupFn := func(ctl *gomock.Controller) (q *MockDeliveryQueue, c *MockCommonBehavior, tp *MockTransport, w *DeliveryWorker) {
+		q = NewMockDeliveryQueue(ctl)
+		c = NewMockCommonBehavior(ctl)
+		tp = NewMockTransport(ctl)
+		w = NewDeliveryWorker(q, c, time.Minute, 3, time.Second)
+		return
+	}
+	t.Run("AcksSuccessfulDelivery", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q, c, tp, w := setupFn(ctl)
+		d := newDeliveryFn(0)
+		// Mock
+		q.EXPECT().Lease(ctx, 10, time.Minute).Return([]*Delivery{d}, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)).Return(nil)
+		q.EXPECT().Ack(ctx, d)
+		// Run & Verify
+		n, err := w.DeliverDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 1)
+	})
+	t.Run("NacksFailedDeliveryWithBackoff", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q, c, tp, w := setupFn(ctl)
+		d := newDeliveryFn(1)
+		// Mock
+		q.EXPECT().Lease(ctx, 10, time.Minute).Return([]*Delivery{d}, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)).Return(testErr)
+		q.EXPECT().Nack(ctx, d, 2*time.Second)
+		// Run & Verify
+		n, err := w.DeliverDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 1)
+	})
+	t.Run("NacksIfTransportCannotBeCreated", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q, c, _, w := setupFn(ctl)
+		d := newDeliveryFn(0)
+		// Mock
+		q.EXPECT().Lease(ctx, 10, time.Minute).Return([]*Delivery{d}, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(nil, testErr)
+		q.EXPECT().Nack(ctx, d, time.Second)
+		// Run & Verify
+		_, err := w.DeliverDue(ctx, 10)
+		assertEqual(t, err, nil)
+	})
+	t.Run("AbandonsDeliveryAfterMaxAttempts", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q, c, tp, w := setupFn(ctl)
+		d := newDeliveryFn(2)
+		// Mock
+		q.EXPECT().Lease(ctx, 10, time.Minute).Return([]*Delivery{d}, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)).Return(testErr)
+		q.EXPECT().Ack(ctx, d)
+		// Run & Verify
+		_, err := w.DeliverDue(ctx, 10)
+		assertEqual(t, err, nil)
+	})
+	t.Run("ReturnsErrorIfLeaseFails", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q, _, _, w := setupFn(ctl)
+		// Mock
+		q.EXPECT().Lease(ctx, 10, time.Minute).Return(nil, testErr)
+		// Run & Verify
+		_, err := w.DeliverDue(ctx, 10)
+		assertEqual(t, err, testErr)
+	})
+	t.Run("ReturnsErrorIfAckFails", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q, c, tp, w := setupFn(ctl)
+		d := newDeliveryFn(0)
+		// Mock
+		q.EXPECT().Lease(ctx, 10, time.Minute).Return([]*Delivery{d}, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)).Return(nil)
+		q.EXPECT().Ack(ctx, d).Return(testErr)
+		// Run & Verify
+		_, err := w.DeliverDue(ctx, 10)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("RunStopsWhenContextDone", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q, _, _, w := setupFn(ctl)
+		cancelCtx, cancel := context.WithCancel(ctx)
+		// Mock
+		q.EXPECT().Lease(cancelCtx, 10, time.Minute).DoAndReturn(func(c context.Context, max int, lease time.Duration) ([]*Delivery, error) {
+			cancel()
+			return nil, nil
+		})
+		// Run & Verify
+		err := w.Run(cancelCtx, 10, time.Hour)
+		assertEqual(t, err, context.Canceled)
+	})
+	t.Run("RunPollsWhenMaxNotPositive", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q, _, _, w := setupFn(ctl)
+		cancelCtx, cancel := context.WithCancel(ctx)
+		// Mock
+		q.EXPECT().Lease(cancelCtx, 1, time.Minute).DoAndReturn(func(c context.Context, max int, lease time.Duration) ([]*Delivery, error) {
+			cancel()
+			return nil, nil
+		})
+		// Run & Verify
+		err := w.Run(cancelCtx, 0, time.Hour)
+		assertEqual(t, err, context.Canceled)
+	})
+}
+
+func TestBatchingDeliveryWorker(t *testing.T) {
+	ctx := context.Background()
+	newDeliveryFn := func(id, recipient string) *Delivery {
+		return &Delivery{
+			ID:        id,
+			BoxIRI:    mustParse(testMyOutboxIRI),
+			Payload:   []byte(id),
+			Recipient: mustParse(recipient),
+		}
+	}
+	t.Run("SendsBatchWithOneTransport", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q := NewMockDeliveryQueue(ctl)
+		c := NewMockCommonBehavior(ctl)
+		tp := NewMockTransport(ctl)
+		w := NewBatchingDeliveryWorker(q, c, time.Minute, 3, time.Second, 0)
+		d1 := newDeliveryFn("1", testFederatedInboxIRI)
+		d2 := newDeliveryFn("2", testFederatedInboxIRI)
+		// Mock
+		q.EXPECT().Lease(ctx, 10, time.Minute).Return([]*Delivery{d1, d2}, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil)
+		gomock.InOrder(
+			tp.EXPECT().Deliver(ctx, []byte("1"), mustParse(testFederatedInboxIRI)).Return(nil),
+			q.EXPECT().Ack(ctx, d1),
+			tp.EXPECT().Deliver(ctx, []byte("2"), mustParse(testFederatedInboxIRI)).Return(testErr),
+			q.EXPECT().Nack(ctx, d2, time.Second),
+		)
+		// Run & Verify
+		n, err := w.DeliverDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 2)
+	})
+	t.Run("SendsOtherInboxesInOtherBatches", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q := NewMockDeliveryQueue(ctl)
+		c := NewMockCommonBehavior(ctl)
+		tp1 := NewMockTransport(ctl)
+		tp2 := NewMockTransport(ctl)
+		w := NewBatchingDeliveryWorker(q, c, time.Minute, 3, time.Second, 0)
+		d1 := newDeliveryFn("1", testFederatedInboxIRI)
+		d2 := newDeliveryFn("2", testFederatedInboxIRI2)
+		// Mock
+		q.EXPECT().Lease(ctx, 10, time.Minute).Return([]*Delivery{d1, d2}, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp1, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp2, nil)
+		tp1.EXPECT().Deliver(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		tp2.EXPECT().Deliver(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		q.EXPECT().Ack(ctx, d1)
+		q.EXPECT().Ack(ctx, d2)
+		// Run & Verify
+		n, err := w.DeliverDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 2)
+	})
+	t.Run("JoinsDeliveriesLeasedAfterWindow", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q := NewMockDeliveryQueue(ctl)
+		c := NewMockCommonBehavior(ctl)
+		tp := NewMockTransport(ctl)
+		w := NewBatchingDeliveryWorker(q, c, time.Minute, 3, time.Second, time.Millisecond)
+		d1 := newDeliveryFn("1", testFederatedInboxIRI)
+		d2 := newDeliveryFn("2", testFederatedInboxIRI)
+		// Mock
+		gomock.InOrder(
+			q.EXPECT().Lease(ctx, 10, time.Minute).Return([]*Delivery{d1}, nil),
+			q.EXPECT().Lease(ctx, 9, time.Minute).Return([]*Delivery{d2}, nil),
+			c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(tp, nil),
+			tp.EXPECT().Deliver(ctx, []byte("1"), mustParse(testFederatedInboxIRI)).Return(nil),
+			q.EXPECT().Ack(ctx, d1),
+			tp.EXPECT().Deliver(ctx, []byte("2"), mustParse(testFederatedInboxIRI)).Return(nil),
+			q.EXPECT().Ack(ctx, d2),
+		)
+		// Run & Verify
+		n, err := w.DeliverDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 2)
+	})
+	t.Run("DoesNotWaitIfNothingLeased", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q := NewMockDeliveryQueue(ctl)
+		w := NewBatchingDeliveryWorker(q, NewMockCommonBehavior(ctl), time.Minute, 3, time.Second, time.Hour)
+		// Mock
+		q.EXPECT().Lease(ctx, 10, time.Minute).Return(nil, nil)
+		// Run & Verify
+		n, err := w.DeliverDue(ctx, 10)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 0)
+	})
+}
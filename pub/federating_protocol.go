@@ -5,6 +5,7 @@ import (
 	"github.com/go-fed/activity/streams/vocab"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // FederatingProtocol contains behaviors an application needs to satisfy for the
@@ -64,6 +65,9 @@ type FederatingProtocol interface {
 	// Finally, if the authentication and authorization succeeds, then
 	// blocked must be false and error nil. The request will continue
 	// to be processed.
+	//
+	// Blocked is also called with each actor an activity is delivered to.
+	// Activities are not delivered to blocked actors.
 	Blocked(c context.Context, actorIRIs []*url.URL) (blocked bool, err error)
 	// FederatingCallbacks returns the application logic that handles
 	// ActivityStreams received from federating peers.
@@ -96,6 +100,12 @@ type FederatingProtocol interface {
 	// MaxInboxForwardingRecursionDepth determines how deep to search within
 	// an activity to determine if inbox forwarding needs to occur.
 	//
+	// This bounds the length of a chain of replies, such as a reply to a
+	// reply to this server's note, that is followed through the
+	// 'inReplyTo', 'object', 'target', and 'tag' properties. Each step
+	// that is not embedded in the activity requires dereferencing the
+	// value from a peer.
+	//
 	// Zero or negative numbers indicate infinite recursion.
 	MaxInboxForwardingRecursionDepth(c context.Context) int
 	// MaxDeliveryRecursionDepth determines how deep to search within
@@ -122,3 +132,151 @@ type FederatingProtocol interface {
 	// API is enabled.
 	GetInbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error)
 }
+
+// InboxFilter is optionally implemented by a FederatingProtocol to filter
+// activities received in an inbox, such as to drop spam or abuse.
+//
+// When the FederatingProtocol given to an Actor is also an InboxFilter, every
+// activity received in an inbox is filtered after the request is authenticated
+// and the peer is authorized, but before the activity is added to the inbox
+// and its side effects take place.
+type InboxFilter interface {
+	// FilterInbox determines whether to handle the activity sent by the
+	// actors.
+	//
+	// If handle is true, the activity is handled as usual and the code is
+	// ignored. Otherwise, the activity is neither added to the inbox nor
+	// are its side effects triggered, and the code is written in the
+	// response. A successful code, such as http.StatusAccepted, silently
+	// drops the activity, while an error code, such as
+	// http.StatusForbidden or http.StatusUnprocessableEntity, rejects it.
+	// A code that is not a valid HTTP status code, such as zero, is
+	// written as http.StatusForbidden.
+	//
+	// If an error is returned, it is passed back to the caller of
+	// PostInbox.
+	FilterInbox(c context.Context, actorIRIs []*url.URL, activity Activity) (handle bool, code int, err error)
+}
+
+// PeerPolicy is optionally implemented by a FederatingProtocol to determine how
+// to federate with each remote peer. A DomainPolicy is a PeerPolicy providing
+// allowlists, silencing, and blocking by domain.
+//
+// When the FederatingProtocol given to an Actor is not a PeerPolicy, every peer
+// has the PolicyAllow policy.
+type PeerPolicy interface {
+	// FederationPolicy determines how to federate with the remote peer
+	// identified by the IRI of an actor or inbox.
+	//
+	// It is called for every actor of an activity received in an inbox,
+	// where the most restrictive Policy of the actors applies, and for
+	// every inbox an activity is delivered to, where blocked inboxes are
+	// skipped.
+	//
+	// If an error is returned, it is passed back to the caller of
+	// PostInbox, or returned by the delivery.
+	FederationPolicy(c context.Context, remote *url.URL) (policy Policy, err error)
+}
+
+// DereferenceLimiter is optionally implemented by a FederatingProtocol to bound
+// the values dereferenced from peers while recursively resolving the recipients
+// of a delivery, or while determining whether and to whom inbox forwarding
+// needs to occur.
+//
+// When the FederatingProtocol given to an Actor is not a DereferenceLimiter,
+// only the recursion depths of the FederatingProtocol limit the resolution.
+// Regardless, an IRI is dereferenced at most once, so that cycles in hostile
+// collections and reply chains are not followed.
+type DereferenceLimiter interface {
+	// RecursiveDereferenceLimits returns the limits of each resolution.
+	//
+	// The maxRequests is the total number of dereferences permitted for
+	// each such resolution, regardless of depth, and the timeout is the
+	// total time permitted for it. When either is exhausted, resolution
+	// stops and uses the values already dereferenced. Zero or negative
+	// values indicate no limit.
+	RecursiveDereferenceLimits(c context.Context) (maxRequests int, timeout time.Duration)
+}
+
+// SharedInboxPolicy is optionally implemented by a FederatingProtocol to deliver
+// activities to the shared inboxes of their recipients. A SharedInboxStrategy
+// is a SharedInboxPolicy for the common cases.
+//
+// When the FederatingProtocol given to an Actor is not a SharedInboxPolicy,
+// activities are delivered to the individual inbox of each recipient.
+type SharedInboxPolicy interface {
+	// DeliverToSharedInboxes determines whether an activity delivered on
+	// behalf of the outbox may be sent to its recipients' shared inboxes
+	// instead of their individual inboxes.
+	//
+	// When true, recipients sharing the same shared inbox, such as the
+	// followers on one peer server, receive a single delivery. This is
+	// only appropriate when the peer is trusted to redistribute the
+	// activity, such as when it is addressed to the actor's followers or
+	// to the Public collection, so the activity is provided as a reference
+	// for this decision. The implementation must not modify it.
+	DeliverToSharedInboxes(c context.Context, outboxIRI *url.URL, activity Activity) bool
+	// SharedInbox returns the shared inbox of a dereferenced recipient
+	// actor, or nil to deliver to the actor's individual inbox instead.
+	//
+	// Only called when DeliverToSharedInboxes returns true. The
+	// implementation may use streams.GetSharedInbox, or its own mapping of
+	// known actors or servers to shared inboxes.
+	SharedInbox(c context.Context, actor vocab.Type) (sharedInbox *url.URL, err error)
+}
+
+// RelaySubscriber is optionally implemented by a FederatingProtocol whose
+// server subscribes to fediverse relays, usually with its instance actor and a
+// Follow created by NewRelayFollow.
+//
+// When the FederatingProtocol given to an Actor is also a RelaySubscriber, an
+// Announce received from a relay it is subscribed to is unwrapped instead of
+// being handled as a share. Each announced object is fetched again from its
+// origin, rather than trusting the relay's copy, and must have the id it was
+// fetched at. Objects that this server owns, whose origin has the PolicyBlock
+// federation policy, or whose authors are on another host or are Blocked are
+// dropped. The others are stored in the Database, if absent, and given to
+// Relayed.
+type RelaySubscriber interface {
+	// IsRelay returns true if the actor is a relay this server is
+	// subscribed to.
+	IsRelay(c context.Context, actorIRI *url.URL) (isRelay bool, err error)
+	// Relayed receives an object announced by the relay, after it passed
+	// the checks above, such as to add it to a federated timeline.
+	//
+	// If an error is returned, it is passed back to the caller of
+	// PostInbox.
+	Relayed(c context.Context, relayIRI *url.URL, object vocab.Type) error
+}
+
+// SignerMatch is how closely the actor whose key signed a request to an inbox
+// must match the actor of the activity it carries.
+type SignerMatch int
+
+const (
+	// SignerMatchActor requires the signer to be an actor of the activity.
+	SignerMatchActor SignerMatch = iota
+	// SignerMatchOrigin requires the signer to be on the same host as an
+	// actor of the activity, such as an instance actor forwarding the
+	// activities of its users.
+	SignerMatchOrigin
+	// SignerMatchNone does not check the signer, such as for activities
+	// forwarded by other servers whose authenticity is established in
+	// another way.
+	SignerMatchNone
+)
+
+// SignerMatchPolicy is optionally implemented by a FederatingProtocol to relax
+// the check of the actor that signed a request to an inbox.
+//
+// When the context returned by AuthenticatePostInbox carries the actor owning
+// the key that signed the request, added by WithSigner, an activity whose actor
+// does not match the signer is rejected with a http.StatusUnauthorized
+// response, as that actor may be spoofed. The signer must be an actor of the
+// activity unless the FederatingProtocol is a SignerMatchPolicy returning
+// another SignerMatch.
+type SignerMatchPolicy interface {
+	// SignerMatch returns how closely the signer must match the actor of
+	// the activity.
+	SignerMatch(c context.Context, signer *url.URL, activity Activity) (match SignerMatch, err error)
+}
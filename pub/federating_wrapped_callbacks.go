@@ -23,6 +23,24 @@ const (
 	// OnFollowAutomaticallyAccept triggers the side effect of sending a
 	// Reject of this Follow request in response.
 	OnFollowAutomaticallyReject
+	// OnFollowQueueForApproval keeps the Follow request pending in the
+	// Database, which must be a FollowRequestDatabase, until the
+	// application calls AcceptFollowRequest or RejectFollowRequest.
+	OnFollowQueueForApproval
+)
+
+// OnMoveBehavior enumerates the different default actions that the go-fed
+// library can provide when receiving a Move Activity of an actor from a peer.
+type OnMoveBehavior int
+
+const (
+	// OnMoveDoNothing does not take any action when a Move Activity is
+	// received.
+	OnMoveDoNothing OnMoveBehavior = iota
+	// OnMoveFollowTarget verifies that the Move's 'target' lists the moved
+	// actor in its 'alsoKnownAs' property and, if the actor owning the
+	// inbox follows the moved actor, sends a Follow to the 'target'.
+	OnMoveFollowTarget
 )
 
 // FederatingWrappedCallbacks lists the callback functions that already have
@@ -37,7 +55,26 @@ type FederatingWrappedCallbacks struct {
 	// 'object' property is created in the database.
 	//
 	// Create calls Create for each object in the federated Activity.
+	//
+	// Notes that are votes in a Question owned by this server are also
+	// tallied, see Vote.
 	Create func(context.Context, vocab.ActivityStreamsCreate) error
+	// Vote handles additional side effects for a vote in a Question owned
+	// by this server. A vote is a Note in a Create whose 'inReplyTo' is
+	// the Question and whose 'name' is the name of one of the Question's
+	// 'oneOf' or 'anyOf' options.
+	//
+	// The wrapping function ignores votes in a Question that is closed,
+	// or whose 'endTime' has passed, and votes for options the Question
+	// does not have. Otherwise, it adds one to the 'totalItems' of the
+	// option's 'replies' before calling Vote with the Question locked.
+	// Vote returns whether the vote is the first of its author in the
+	// Question, in which case one is added to the Question's
+	// 'votersCount'. The Question is then updated in the database.
+	//
+	// If Vote is nil, every vote is counted as a new voter, which is
+	// correct for Questions with 'oneOf' options.
+	Vote func(c context.Context, question vocab.ActivityStreamsQuestion, vote vocab.ActivityStreamsNote) (isNewVoter bool, err error)
 	// Update handles additional side effects for the Update ActivityStreams
 	// type, specific to the application using go-fed.
 	//
@@ -46,11 +83,26 @@ type FederatingWrappedCallbacks struct {
 	//
 	// Update calls Update on the federated entry from the database, with a
 	// new value.
+	//
+	// Before doing so, the wrapping function ensures each object is owned
+	// by an actor of the Update: the object is the actor itself, or an
+	// actor of the Update is among the 'actor' or 'attributedTo' of both
+	// the stored object, if any, and the new one. If the context carries
+	// the actor that signed the request, added by WithSigner, it must be
+	// an actor of the Update. Otherwise ErrUnauthorizedUpdate is returned.
+	// Objects without an 'actor' or 'attributedTo' are only required to be
+	// on the same host as the Update.
 	Update func(context.Context, vocab.ActivityStreamsUpdate) error
+	// AuthorizeUpdate, if set, replaces the check that the objects of an
+	// Update are owned by its actor, for special cases such as Updates of
+	// group content by its moderators. Returning false rejects the Update
+	// with ErrUnauthorizedUpdate.
+	AuthorizeUpdate func(c context.Context, update vocab.ActivityStreamsUpdate, object vocab.Type) (authorized bool, err error)
 	// Delete handles additional side effects for the Delete ActivityStreams
 	// type, specific to the application using go-fed.
 	//
-	// Delete removes the federated entry from the database.
+	// The wrapping function replaces the federated entry in the database
+	// with a Tombstone, so that it is served with a 410 Gone status.
 	Delete func(context.Context, vocab.ActivityStreamsDelete) error
 	// Follow handles additional side effects for the Follow ActivityStreams
 	// type, specific to the application using go-fed.
@@ -61,6 +113,11 @@ type FederatingWrappedCallbacks struct {
 	// OnFollow determines what action to take for this particular callback
 	// if a Follow Activity is handled.
 	OnFollow OnFollowBehavior
+	// FollowPolicy, if set, determines the action to take for each Follow
+	// of a local actor instead of OnFollow, such as to queue the Follows
+	// of actors whose account is locked for approval and to accept the
+	// others.
+	FollowPolicy func(c context.Context, actorIRI *url.URL, follow vocab.ActivityStreamsFollow) (OnFollowBehavior, error)
 	// Accept handles additional side effects for the Accept ActivityStreams
 	// type, specific to the application using go-fed.
 	//
@@ -104,16 +161,22 @@ type FederatingWrappedCallbacks struct {
 	// The wrapping function will add the activity to the "shares"
 	// collection on all 'object' targets owned by this server.
 	Announce func(context.Context, vocab.ActivityStreamsAnnounce) error
+	// ManualLikesAndShares disables the default behavior of Like, Announce,
+	// and Undo that maintains the "likes" and "shares" collections, for
+	// applications that maintain them on their own.
+	ManualLikesAndShares bool
 	// Undo handles additional side effects for the Undo ActivityStreams
 	// type, specific to the application using go-fed.
 	//
 	// The wrapping function ensures the 'actor' on the 'Undo'
 	// is be the same as the 'actor' on all Activities being undone.
 	// It enforces that the actors on the Undo must correspond to all of the
-	// 'object' actors in some manner.
+	// 'object' actors in some manner. Embedded Like and Announce activities
+	// being undone are removed from the "likes" and "shares" collections on
+	// all of their 'object' targets owned by this server.
 	//
 	// It is expected that the application will implement the proper
-	// reversal of activities that are being undone.
+	// reversal of other activities that are being undone.
 	Undo func(context.Context, vocab.ActivityStreamsUndo) error
 	// Block handles additional side effects for the Block ActivityStreams
 	// type, specific to the application using go-fed.
@@ -123,6 +186,82 @@ type FederatingWrappedCallbacks struct {
 	// received from a federated peer, as delivering Blocks explicitly
 	// deviates from the original ActivityPub specification.
 	Block func(context.Context, vocab.ActivityStreamsBlock) error
+	// Move handles additional side effects for the Move ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function ensures the 'Move' has at least one 'object'
+	// entry, and takes the action determined by OnMove.
+	Move func(context.Context, vocab.ActivityStreamsMove) error
+	// OnMove determines what action to take for this particular callback
+	// if a Move Activity is handled.
+	OnMove OnMoveBehavior
+	// Flag handles additional side effects for the Flag ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function only ensures the 'Flag' has at least one
+	// 'object' entry, but otherwise has no default side effect. It is up
+	// to the wrapped application function to handle the report.
+	Flag func(context.Context, vocab.ActivityStreamsFlag) error
+	// View handles additional side effects for the View ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function only ensures the 'View' has at least one
+	// 'object' entry, but otherwise has no default side effect.
+	View func(context.Context, vocab.ActivityStreamsView) error
+	// Listen handles additional side effects for the Listen ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function only ensures the 'Listen' has at least one
+	// 'object' entry, but otherwise has no default side effect.
+	Listen func(context.Context, vocab.ActivityStreamsListen) error
+	// Read handles additional side effects for the Read ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function only ensures the 'Read' has at least one
+	// 'object' entry, but otherwise has no default side effect.
+	Read func(context.Context, vocab.ActivityStreamsRead) error
+	// TentativeAccept handles additional side effects for the TentativeAccept
+	// ActivityStreams type, specific to the application using go-fed.
+	//
+	// The wrapping function only ensures the 'TentativeAccept' has at least
+	// one 'object' entry, but otherwise has no default side effect.
+	TentativeAccept func(context.Context, vocab.ActivityStreamsTentativeAccept) error
+	// TentativeReject handles additional side effects for the TentativeReject
+	// ActivityStreams type, specific to the application using go-fed.
+	//
+	// The wrapping function only ensures the 'TentativeReject' has at least
+	// one 'object' entry, but otherwise has no default side effect.
+	TentativeReject func(context.Context, vocab.ActivityStreamsTentativeReject) error
+	// Offer handles additional side effects for the Offer ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function only ensures the 'Offer' has at least one
+	// 'object' entry, but otherwise has no default side effect.
+	Offer func(context.Context, vocab.ActivityStreamsOffer) error
+	// Invite handles additional side effects for the Invite ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function only ensures the 'Invite' has at least one
+	// 'object' entry, but otherwise has no default side effect.
+	Invite func(context.Context, vocab.ActivityStreamsInvite) error
+	// Question handles additional side effects for the Question
+	// ActivityStreams type, specific to the application using go-fed.
+	//
+	// The wrapping function ensures the 'Question' does not have both
+	// 'oneOf' and 'anyOf' entries, but otherwise has no default side
+	// effect.
+	Question func(context.Context, vocab.ActivityStreamsQuestion) error
+	// Travel handles additional side effects for the Travel ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function provides no default side effects. It simply
+	// calls the wrapped function.
+	Travel func(context.Context, vocab.ActivityStreamsTravel) error
+	// Cache, if set, has the objects of Update and Delete activities
+	// invalidated when they are received, so that their stale payloads are
+	// not served to later dereferences. It should be the DereferenceCache
+	// of the CachingTransport returned by FederatingProtocol.NewTransport.
+	Cache DereferenceCache
 
 	// Sidechannel data -- this is set at request handling time. These must
 	// be set before the callbacks are used.
@@ -131,6 +270,8 @@ type FederatingWrappedCallbacks struct {
 	db Database
 	// inboxIRI is the inboxIRI that is handling this callback.
 	inboxIRI *url.URL
+	// clock is the server's clock.
+	clock Clock
 	// addNewIds creates new 'id' entries on an activity and its objects if
 	// it is a Create activity.
 	addNewIds func(c context.Context, activity Activity) error
@@ -158,6 +299,17 @@ func (w FederatingWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 	enableAnnounce := true
 	enableUndo := true
 	enableBlock := true
+	enableMove := true
+	enableFlag := true
+	enableView := true
+	enableListen := true
+	enableRead := true
+	enableTentativeAccept := true
+	enableTentativeReject := true
+	enableOffer := true
+	enableInvite := true
+	enableQuestion := true
+	enableTravel := true
 	for _, fn := range fns {
 		switch fn.(type) {
 		default:
@@ -186,6 +338,28 @@ func (w FederatingWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 			enableUndo = false
 		case func(context.Context, vocab.ActivityStreamsBlock) error:
 			enableBlock = false
+		case func(context.Context, vocab.ActivityStreamsMove) error:
+			enableMove = false
+		case func(context.Context, vocab.ActivityStreamsFlag) error:
+			enableFlag = false
+		case func(context.Context, vocab.ActivityStreamsView) error:
+			enableView = false
+		case func(context.Context, vocab.ActivityStreamsListen) error:
+			enableListen = false
+		case func(context.Context, vocab.ActivityStreamsRead) error:
+			enableRead = false
+		case func(context.Context, vocab.ActivityStreamsTentativeAccept) error:
+			enableTentativeAccept = false
+		case func(context.Context, vocab.ActivityStreamsTentativeReject) error:
+			enableTentativeReject = false
+		case func(context.Context, vocab.ActivityStreamsOffer) error:
+			enableOffer = false
+		case func(context.Context, vocab.ActivityStreamsInvite) error:
+			enableInvite = false
+		case func(context.Context, vocab.ActivityStreamsQuestion) error:
+			enableQuestion = false
+		case func(context.Context, vocab.ActivityStreamsTravel) error:
+			enableTravel = false
 		}
 	}
 	if enableCreate {
@@ -224,6 +398,39 @@ func (w FederatingWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 	if enableBlock {
 		fns = append(fns, w.block)
 	}
+	if enableMove {
+		fns = append(fns, w.move)
+	}
+	if enableFlag {
+		fns = append(fns, w.flag)
+	}
+	if enableView {
+		fns = append(fns, w.view)
+	}
+	if enableListen {
+		fns = append(fns, w.listen)
+	}
+	if enableRead {
+		fns = append(fns, w.read)
+	}
+	if enableTentativeAccept {
+		fns = append(fns, w.tentativeAccept)
+	}
+	if enableTentativeReject {
+		fns = append(fns, w.tentativeReject)
+	}
+	if enableOffer {
+		fns = append(fns, w.offer)
+	}
+	if enableInvite {
+		fns = append(fns, w.invite)
+	}
+	if enableQuestion {
+		fns = append(fns, w.question)
+	}
+	if enableTravel {
+		fns = append(fns, w.travel)
+	}
 	return fns
 }
 
@@ -233,6 +440,8 @@ func (w FederatingWrappedCallbacks) create(c context.Context, a vocab.ActivitySt
 	if op == nil || op.Len() == 0 {
 		return ErrObjectRequired
 	}
+	// Notes are tallied as votes after all objects are created.
+	var votes []vocab.ActivityStreamsNote
 	// Create anonymous loop function to be able to properly scope the defer
 	// for the database lock at each iteration.
 	loopFn := func(iter vocab.ActivityStreamsObjectPropertyIterator) error {
@@ -270,6 +479,9 @@ func (w FederatingWrappedCallbacks) create(c context.Context, a vocab.ActivitySt
 		if err := w.db.Create(c, t); err != nil {
 			return err
 		}
+		if note, ok := t.(vocab.ActivityStreamsNote); ok {
+			votes = append(votes, note)
+		}
 		return nil
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
@@ -277,12 +489,41 @@ func (w FederatingWrappedCallbacks) create(c context.Context, a vocab.ActivitySt
 			return err
 		}
 	}
+	for _, note := range votes {
+		if err := w.vote(c, note); err != nil {
+			return err
+		}
+	}
 	if w.Create != nil {
 		return w.Create(c, a)
 	}
 	return nil
 }
 
+// vote tallies the Note if it is a vote in a Question owned by this server.
+func (w FederatingWrappedCallbacks) vote(c context.Context, note vocab.ActivityStreamsNote) error {
+	questionId, option, ok := toVote(note)
+	if !ok {
+		return nil
+	}
+	return w.updateOwnedObject(c, questionId, func(t vocab.Type) (changed bool, err error) {
+		q, ok := t.(vocab.ActivityStreamsQuestion)
+		if !ok || isQuestionClosed(q, w.clock.Now()) || !tallyVote(q, option) {
+			return false, nil
+		}
+		isNewVoter := true
+		if w.Vote != nil {
+			if isNewVoter, err = w.Vote(c, q, note); err != nil {
+				return false, err
+			}
+		}
+		if isNewVoter {
+			addVoter(q)
+		}
+		return true, nil
+	})
+}
+
 // update implements the federating Update activity side effects.
 func (w FederatingWrappedCallbacks) update(c context.Context, a vocab.ActivityStreamsUpdate) error {
 	op := a.GetActivityStreamsObject()
@@ -308,9 +549,15 @@ func (w FederatingWrappedCallbacks) update(c context.Context, a vocab.ActivitySt
 			return err
 		}
 		defer w.db.Unlock(c, id)
+		if err := w.authorizeUpdate(c, a, id, t); err != nil {
+			return err
+		}
 		if err := w.db.Update(c, t); err != nil {
 			return err
 		}
+		if w.Cache != nil {
+			w.Cache.Invalidate(c, id)
+		}
 		return nil
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
@@ -324,6 +571,68 @@ func (w FederatingWrappedCallbacks) update(c context.Context, a vocab.ActivitySt
 	return nil
 }
 
+// authorizeUpdate returns ErrUnauthorizedUpdate unless the object of the
+// Update, whose lock is held, is owned by an actor of the Update that signed
+// the request.
+func (w FederatingWrappedCallbacks) authorizeUpdate(c context.Context, a vocab.ActivityStreamsUpdate, id *url.URL, t vocab.Type) error {
+	if w.AuthorizeUpdate != nil {
+		if authorized, err := w.AuthorizeUpdate(c, a, t); err != nil {
+			return err
+		} else if !authorized {
+			return ErrUnauthorizedUpdate
+		}
+		return nil
+	}
+	actors, err := authorIRIs(a)
+	if err != nil {
+		return err
+	}
+	if signer, ok := SignerFromContext(c); ok && !containsIRI(actors, signer) {
+		return ErrUnauthorizedUpdate
+	}
+	if containsIRI(actors, id) {
+		// An actor updating itself.
+		return nil
+	}
+	versions := []vocab.Type{t}
+	if exists, err := w.db.Exists(c, id); err != nil {
+		return err
+	} else if exists {
+		stored, err := w.db.Get(c, id)
+		if err != nil {
+			return err
+		}
+		versions = append(versions, stored)
+	}
+	for _, v := range versions {
+		owners, err := authorIRIs(v)
+		if err != nil {
+			return err
+		}
+		if len(owners) == 0 {
+			continue
+		}
+		owned := false
+		for _, owner := range owners {
+			owned = owned || containsIRI(actors, owner)
+		}
+		if !owned {
+			return ErrUnauthorizedUpdate
+		}
+	}
+	return nil
+}
+
+// containsIRI returns true if the IRIs contain the IRI.
+func containsIRI(iris []*url.URL, iri *url.URL) bool {
+	for _, u := range iris {
+		if u.String() == iri.String() {
+			return true
+		}
+	}
+	return false
+}
+
 // deleteFn implements the federating Delete activity side effects.
 func (w FederatingWrappedCallbacks) deleteFn(c context.Context, a vocab.ActivityStreamsDelete) error {
 	op := a.GetActivityStreamsObject()
@@ -345,9 +654,23 @@ func (w FederatingWrappedCallbacks) deleteFn(c context.Context, a vocab.Activity
 			return err
 		}
 		defer w.db.Unlock(c, id)
-		if err := w.db.Delete(c, id); err != nil {
+		if exists, err := w.db.Exists(c, id); err != nil {
+			return err
+		} else if !exists {
+			return nil
+		}
+		t, err := w.db.Get(c, id)
+		if err != nil {
 			return err
 		}
+		if !streams.IsOrExtendsActivityStreamsTombstone(t) {
+			if err := w.db.Update(c, toTombstone(t, id, w.clock.Now())); err != nil {
+				return err
+			}
+		}
+		if w.Cache != nil {
+			w.Cache.Invalidate(c, id)
+		}
 		return nil
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
@@ -384,7 +707,7 @@ func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivitySt
 	w.db.Unlock(c, w.inboxIRI)
 	// Unlock must be called by now and every branch above.
 	isMe := false
-	if w.OnFollow != OnFollowDoNothing {
+	if w.OnFollow != OnFollowDoNothing || w.FollowPolicy != nil {
 		for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
 			id, err := ToId(iter)
 			if err != nil {
@@ -397,79 +720,54 @@ func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivitySt
 		}
 	}
 	if isMe {
-		// Prepare the response.
-		var response Activity
-		if w.OnFollow == OnFollowAutomaticallyAccept {
-			response = streams.NewActivityStreamsAccept()
-		} else if w.OnFollow == OnFollowAutomaticallyReject {
-			response = streams.NewActivityStreamsReject()
-		} else {
-			return fmt.Errorf("unknown OnFollowBehavior: %d", w.OnFollow)
-		}
-		// Set us as the 'actor'.
-		me := streams.NewActivityStreamsActorProperty()
-		response.SetActivityStreamsActor(me)
-		me.AppendIRI(actorIRI)
-		// Set the Follow as the 'object' property.
-		op := streams.NewActivityStreamsObjectProperty()
-		response.SetActivityStreamsObject(op)
-		op.AppendActivityStreamsFollow(a)
-		// Add all actors on the original Follow to the 'to' property.
-		recipients := make([]*url.URL, 0)
-		to := streams.NewActivityStreamsToProperty()
-		response.SetActivityStreamsTo(to)
-		followActors := a.GetActivityStreamsActor()
-		for iter := followActors.Begin(); iter != followActors.End(); iter = iter.Next() {
-			id, err := ToId(iter)
-			if err != nil {
+		onFollow := w.OnFollow
+		if w.FollowPolicy != nil {
+			if onFollow, err = w.FollowPolicy(c, actorIRI, a); err != nil {
 				return err
 			}
-			to.AppendIRI(id)
-			recipients = append(recipients, id)
 		}
-		if w.OnFollow == OnFollowAutomaticallyAccept {
-			// If automatically accepting, then also update our
-			// followers collection with the new actors.
-			//
-			// If automatically rejecting, do not update the
-			// followers collection.
-			if err := w.db.Lock(c, actorIRI); err != nil {
+		switch onFollow {
+		case OnFollowDoNothing:
+		case OnFollowQueueForApproval:
+			fr, ok := w.db.(FollowRequestDatabase)
+			if !ok {
+				return fmt.Errorf("OnFollowQueueForApproval requires a FollowRequestDatabase")
+			}
+			if err := fr.AddFollowRequest(c, actorIRI, a); err != nil {
 				return err
 			}
-			// WARNING: Unlock not deferred.
-			followers, err := w.db.Followers(c, actorIRI)
+		case OnFollowAutomaticallyAccept, OnFollowAutomaticallyReject:
+			accept := onFollow == OnFollowAutomaticallyAccept
+			response, recipients, err := followResponse(actorIRI, a, accept)
 			if err != nil {
-				w.db.Unlock(c, actorIRI)
 				return err
 			}
-			items := followers.GetActivityStreamsItems()
-			if items == nil {
-				items = streams.NewActivityStreamsItemsProperty()
-				followers.SetActivityStreamsItems(items)
-			}
-			for _, elem := range recipients {
-				items.PrependIRI(elem)
+			if accept {
+				// If automatically accepting, then also update
+				// our followers collection with the new actors.
+				//
+				// If automatically rejecting, do not update the
+				// followers collection.
+				if err := addFollowers(c, w.db, actorIRI, recipients); err != nil {
+					return err
+				}
 			}
-			if err = w.db.Update(c, followers); err != nil {
-				w.db.Unlock(c, actorIRI)
+			// Lock without defer!
+			w.db.Lock(c, w.inboxIRI)
+			outboxIRI, err := w.db.OutboxForInbox(c, w.inboxIRI)
+			if err != nil {
+				w.db.Unlock(c, w.inboxIRI)
 				return err
 			}
-			w.db.Unlock(c, actorIRI)
-			// Unlock must be called by now and every branch above.
-		}
-		// Lock without defer!
-		w.db.Lock(c, w.inboxIRI)
-		outboxIRI, err := w.db.OutboxForInbox(c, w.inboxIRI)
-		if err != nil {
 			w.db.Unlock(c, w.inboxIRI)
-			return err
-		}
-		w.db.Unlock(c, w.inboxIRI)
-		// Everything must be unlocked by now.
-		if err := w.addNewIds(c, response); err != nil {
-			return err
-		} else if err := w.deliver(c, outboxIRI, response); err != nil {
-			return err
+			// Everything must be unlocked by now.
+			if err := w.addNewIds(c, response); err != nil {
+				return err
+			} else if err := w.deliver(c, outboxIRI, response); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown OnFollowBehavior: %d", onFollow)
 		}
 	}
 	if w.Follow != nil {
@@ -478,6 +776,62 @@ func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivitySt
 	return nil
 }
 
+// followResponse returns the Accept, or the Reject, of the Follow by the local
+// actor, addressed to the actors of the Follow, which are also returned.
+func followResponse(actorIRI *url.URL, follow vocab.ActivityStreamsFollow, accept bool) (response Activity, recipients []*url.URL, err error) {
+	if accept {
+		response = streams.NewActivityStreamsAccept()
+	} else {
+		response = streams.NewActivityStreamsReject()
+	}
+	// Set us as the 'actor'.
+	me := streams.NewActivityStreamsActorProperty()
+	response.SetActivityStreamsActor(me)
+	me.AppendIRI(actorIRI)
+	// Set the Follow as the 'object' property.
+	op := streams.NewActivityStreamsObjectProperty()
+	response.SetActivityStreamsObject(op)
+	op.AppendActivityStreamsFollow(follow)
+	// Add all actors on the original Follow to the 'to' property.
+	to := streams.NewActivityStreamsToProperty()
+	response.SetActivityStreamsTo(to)
+	followActors := follow.GetActivityStreamsActor()
+	if followActors == nil {
+		return nil, nil, fmt.Errorf("cannot respond to Follow without an actor")
+	}
+	for iter := followActors.Begin(); iter != followActors.End(); iter = iter.Next() {
+		var id *url.URL
+		if id, err = ToId(iter); err != nil {
+			return nil, nil, err
+		}
+		to.AppendIRI(id)
+		recipients = append(recipients, id)
+	}
+	return
+}
+
+// addFollowers prepends the new followers to the followers collection of the
+// local actor.
+func addFollowers(c context.Context, db Database, actorIRI *url.URL, newFollowers []*url.URL) error {
+	if err := db.Lock(c, actorIRI); err != nil {
+		return err
+	}
+	defer db.Unlock(c, actorIRI)
+	followers, err := db.Followers(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	items := followers.GetActivityStreamsItems()
+	if items == nil {
+		items = streams.NewActivityStreamsItemsProperty()
+		followers.SetActivityStreamsItems(items)
+	}
+	for _, elem := range newFollowers {
+		items.PrependIRI(elem)
+	}
+	return db.Update(c, followers)
+}
+
 // accept implements the federating Accept activity side effects.
 func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivityStreamsAccept) error {
 	op := a.GetActivityStreamsObject()
@@ -721,29 +1075,138 @@ func (w FederatingWrappedCallbacks) like(c context.Context, a vocab.ActivityStre
 	if err != nil {
 		return err
 	}
-	// Create anonymous loop function to be able to properly scope the defer
-	// for the database lock at each iteration.
-	loopFn := func(iter vocab.ActivityStreamsObjectPropertyIterator) error {
-		objId, err := ToId(iter)
-		if err != nil {
+	if !w.ManualLikesAndShares {
+		for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+			objId, err := ToId(iter)
+			if err != nil {
+				return err
+			}
+			if err := w.updateLikes(c, objId, id, true); err != nil {
+				return err
+			}
+		}
+	}
+	if w.Like != nil {
+		return w.Like(c, a)
+	}
+	return nil
+}
+
+// announce implements the federating Announce activity side effects.
+func (w FederatingWrappedCallbacks) announce(c context.Context, a vocab.ActivityStreamsAnnounce) error {
+	id, err := GetId(a)
+	if err != nil {
+		return err
+	}
+	op := a.GetActivityStreamsObject()
+	if op != nil && !w.ManualLikesAndShares {
+		for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+			objId, err := ToId(iter)
+			if err != nil {
+				return err
+			}
+			if err := w.updateShares(c, objId, id, true); err != nil {
+				return err
+			}
+		}
+	}
+	if w.Announce != nil {
+		return w.Announce(c, a)
+	}
+	return nil
+}
+
+// undo implements the federating Undo activity side effects.
+func (w FederatingWrappedCallbacks) undo(c context.Context, a vocab.ActivityStreamsUndo) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	actors := a.GetActivityStreamsActor()
+	if err := mustHaveActivityActorsMatchObjectActors(c, actors, op, w.newTransport, w.inboxIRI); err != nil {
+		return err
+	}
+	if !w.ManualLikesAndShares {
+		if err := w.undoLikesAndShares(c, op); err != nil {
 			return err
 		}
-		if err := w.db.Lock(c, objId); err != nil {
+	}
+	if fr, ok := w.db.(FollowRequestDatabase); ok {
+		if err := undoFollowRequests(c, fr, op); err != nil {
 			return err
 		}
-		defer w.db.Unlock(c, objId)
-		if owns, err := w.db.Owns(c, objId); err != nil {
+	}
+	if w.Undo != nil {
+		return w.Undo(c, a)
+	}
+	return nil
+}
+
+// undoFollowRequests removes the Follows being undone from the pending Follow
+// requests. Follows referred to by IRI are removed too, which is a no-op if they
+// are not pending.
+func undoFollowRequests(c context.Context, fr FollowRequestDatabase, op vocab.ActivityStreamsObjectProperty) error {
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		if !iter.IsActivityStreamsFollow() && !iter.IsIRI() {
+			continue
+		}
+		id, err := ToId(iter)
+		if err != nil {
 			return err
-		} else if !owns {
-			return nil
 		}
-		t, err := w.db.Get(c, objId)
+		if err := fr.RemoveFollowRequest(c, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// undoLikesAndShares removes the Like and Announce activities being undone
+// from the 'likes' and 'shares' collections of the objects owned by this
+// server. Only activities embedded in the Undo are removed, as the type of an
+// activity referred to by IRI is not known.
+func (w FederatingWrappedCallbacks) undoLikesAndShares(c context.Context, op vocab.ActivityStreamsObjectProperty) error {
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		var activity Activity
+		var update func(c context.Context, objId, id *url.URL, add bool) error
+		if iter.IsActivityStreamsLike() {
+			activity, update = iter.GetActivityStreamsLike(), w.updateLikes
+		} else if iter.IsActivityStreamsAnnounce() {
+			activity, update = iter.GetActivityStreamsAnnounce(), w.updateShares
+		} else {
+			continue
+		}
+		id, err := GetId(activity)
 		if err != nil {
 			return err
 		}
+		objects := activity.GetActivityStreamsObject()
+		if objects == nil {
+			continue
+		}
+		for objIter := objects.Begin(); objIter != objects.End(); objIter = objIter.Next() {
+			objId, err := ToId(objIter)
+			if err != nil {
+				return err
+			}
+			if err := update(c, objId, id, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// updateLikes adds the Like activity's id to, or removes it from, the 'likes'
+// collection of the object if it is owned by this server.
+func (w FederatingWrappedCallbacks) updateLikes(c context.Context, objId, id *url.URL, add bool) error {
+	return w.updateOwnedObject(c, objId, func(t vocab.Type) (bool, error) {
 		l, ok := t.(likeser)
 		if !ok {
-			return fmt.Errorf("cannot add Like to likes collection for type %T", t)
+			if !add {
+				return false, nil
+			}
+			return false, fmt.Errorf("cannot add Like to likes collection for type %T", t)
 		}
 		// Get 'likes' property on the object, creating default if
 		// necessary.
@@ -759,72 +1222,24 @@ func (w FederatingWrappedCallbacks) like(c context.Context, a vocab.ActivityStre
 			likesT = col
 			likes.SetActivityStreamsCollection(col)
 		}
-		// Prepend the activity's 'id' on the 'likes' Collection or
-		// OrderedCollection.
-		if col, ok := likesT.(itemser); ok {
-			items := col.GetActivityStreamsItems()
-			if items == nil {
-				items = streams.NewActivityStreamsItemsProperty()
-				col.SetActivityStreamsItems(items)
-			}
-			items.PrependIRI(id)
-		} else if oCol, ok := likesT.(orderedItemser); ok {
-			oItems := oCol.GetActivityStreamsOrderedItems()
-			if oItems == nil {
-				oItems = streams.NewActivityStreamsOrderedItemsProperty()
-				oCol.SetActivityStreamsOrderedItems(oItems)
-			}
-			oItems.PrependIRI(id)
-		} else {
-			return fmt.Errorf("likes type is neither a Collection nor an OrderedCollection: %T", likesT)
-		}
-		err = w.db.Update(c, t)
+		changed, err := updateCollectionItem(likesT, id, add)
 		if err != nil {
-			return err
-		}
-		return nil
-	}
-	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
-		if err := loopFn(iter); err != nil {
-			return err
+			return false, fmt.Errorf("likes %s", err)
 		}
-	}
-	if w.Like != nil {
-		return w.Like(c, a)
-	}
-	return nil
+		return changed, nil
+	})
 }
 
-// announce implements the federating Announce activity side effects.
-func (w FederatingWrappedCallbacks) announce(c context.Context, a vocab.ActivityStreamsAnnounce) error {
-	id, err := GetId(a)
-	if err != nil {
-		return err
-	}
-	op := a.GetActivityStreamsObject()
-	// Create anonymous loop function to be able to properly scope the defer
-	// for the database lock at each iteration.
-	loopFn := func(iter vocab.ActivityStreamsObjectPropertyIterator) error {
-		objId, err := ToId(iter)
-		if err != nil {
-			return err
-		}
-		if err := w.db.Lock(c, objId); err != nil {
-			return err
-		}
-		defer w.db.Unlock(c, objId)
-		if owns, err := w.db.Owns(c, objId); err != nil {
-			return err
-		} else if !owns {
-			return nil
-		}
-		t, err := w.db.Get(c, objId)
-		if err != nil {
-			return err
-		}
+// updateShares adds the Announce activity's id to, or removes it from, the
+// 'shares' collection of the object if it is owned by this server.
+func (w FederatingWrappedCallbacks) updateShares(c context.Context, objId, id *url.URL, add bool) error {
+	return w.updateOwnedObject(c, objId, func(t vocab.Type) (bool, error) {
 		s, ok := t.(shareser)
 		if !ok {
-			return fmt.Errorf("cannot add Announce to Shares collection for type %T", t)
+			if !add {
+				return false, nil
+			}
+			return false, fmt.Errorf("cannot add Announce to Shares collection for type %T", t)
 		}
 		// Get 'shares' property on the object, creating default if
 		// necessary.
@@ -840,68 +1255,248 @@ func (w FederatingWrappedCallbacks) announce(c context.Context, a vocab.Activity
 			sharesT = col
 			shares.SetActivityStreamsCollection(col)
 		}
-		// Prepend the activity's 'id' on the 'shares' Collection or
-		// OrderedCollection.
-		if col, ok := sharesT.(itemser); ok {
-			items := col.GetActivityStreamsItems()
-			if items == nil {
-				items = streams.NewActivityStreamsItemsProperty()
-				col.SetActivityStreamsItems(items)
-			}
-			items.PrependIRI(id)
-		} else if oCol, ok := sharesT.(orderedItemser); ok {
-			oItems := oCol.GetActivityStreamsOrderedItems()
-			if oItems == nil {
-				oItems = streams.NewActivityStreamsOrderedItemsProperty()
-				oCol.SetActivityStreamsOrderedItems(oItems)
-			}
-			oItems.PrependIRI(id)
-		} else {
-			return fmt.Errorf("shares type is neither a Collection nor an OrderedCollection: %T", sharesT)
-		}
-		err = w.db.Update(c, t)
+		changed, err := updateCollectionItem(sharesT, id, add)
 		if err != nil {
-			return err
+			return false, fmt.Errorf("shares %s", err)
 		}
+		return changed, nil
+	})
+}
+
+// updateOwnedObject locks and fetches the object if it is owned by this
+// server, and saves it if the function changed it.
+func (w FederatingWrappedCallbacks) updateOwnedObject(c context.Context, objId *url.URL, fn func(t vocab.Type) (changed bool, err error)) error {
+	if err := w.db.Lock(c, objId); err != nil {
+		return err
+	}
+	defer w.db.Unlock(c, objId)
+	if owns, err := w.db.Owns(c, objId); err != nil {
+		return err
+	} else if !owns {
 		return nil
 	}
-	if op != nil {
-		for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
-			if err := loopFn(iter); err != nil {
-				return err
+	t, err := w.db.Get(c, objId)
+	if err != nil {
+		return err
+	}
+	if changed, err := fn(t); err != nil {
+		return err
+	} else if !changed {
+		return nil
+	}
+	return w.db.Update(c, t)
+}
+
+// block implements the federating Block activity side effects.
+func (w FederatingWrappedCallbacks) block(c context.Context, a vocab.ActivityStreamsBlock) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Block != nil {
+		return w.Block(c, a)
+	}
+	return nil
+}
+
+// move implements the federating Move activity side effects.
+func (w FederatingWrappedCallbacks) move(c context.Context, a vocab.ActivityStreamsMove) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.OnMove == OnMoveFollowTarget {
+		if err := w.followMoveTarget(c, a); err != nil {
+			return err
+		}
+	} else if w.OnMove != OnMoveDoNothing {
+		return fmt.Errorf("unknown OnMoveBehavior: %d", w.OnMove)
+	}
+	if w.Move != nil {
+		return w.Move(c, a)
+	}
+	return nil
+}
+
+// followMoveTarget verifies the Move and, if the actor owning this inbox
+// follows the moved actor, delivers a Follow of the Move's target.
+func (w FederatingWrappedCallbacks) followMoveTarget(c context.Context, a vocab.ActivityStreamsMove) error {
+	tport, err := w.newTransport(c, w.inboxIRI, goFedUserAgent())
+	if err != nil {
+		return err
+	}
+	origin, target, err := verifyMove(c, a, func(c context.Context, iri *url.URL) (vocab.Type, error) {
+		return dereferenceType(c, tport, iri)
+	})
+	if err != nil {
+		return err
+	}
+	if err := w.db.Lock(c, w.inboxIRI); err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	actorIRI, err := w.db.ActorForInbox(c, w.inboxIRI)
+	if err != nil {
+		w.db.Unlock(c, w.inboxIRI)
+		return err
+	}
+	outboxIRI, err := w.db.OutboxForInbox(c, w.inboxIRI)
+	if err != nil {
+		w.db.Unlock(c, w.inboxIRI)
+		return err
+	}
+	w.db.Unlock(c, w.inboxIRI)
+	// Unlock must be called by now and every branch above.
+	if err := w.db.Lock(c, actorIRI); err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	following, err := w.db.Following(c, actorIRI)
+	if err != nil {
+		w.db.Unlock(c, actorIRI)
+		return err
+	}
+	w.db.Unlock(c, actorIRI)
+	// Unlock must be called by now and every branch above.
+	isFollowing := false
+	if items := following.GetActivityStreamsItems(); items != nil {
+		for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && id.String() == origin.String() {
+				isFollowing = true
+				break
 			}
 		}
 	}
-	if w.Announce != nil {
-		return w.Announce(c, a)
+	if !isFollowing {
+		return nil
+	}
+	follow := streams.NewActivityStreamsFollow()
+	me := streams.NewActivityStreamsActorProperty()
+	me.AppendIRI(actorIRI)
+	follow.SetActivityStreamsActor(me)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(target)
+	follow.SetActivityStreamsObject(op)
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(target)
+	follow.SetActivityStreamsTo(to)
+	if err := w.addNewIds(c, follow); err != nil {
+		return err
+	}
+	return w.deliver(c, outboxIRI, follow)
+}
+
+// flag implements the federating Flag activity side effects.
+func (w FederatingWrappedCallbacks) flag(c context.Context, a vocab.ActivityStreamsFlag) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Flag != nil {
+		return w.Flag(c, a)
 	}
 	return nil
 }
 
-// undo implements the federating Undo activity side effects.
-func (w FederatingWrappedCallbacks) undo(c context.Context, a vocab.ActivityStreamsUndo) error {
+// view implements the federating View activity side effects.
+func (w FederatingWrappedCallbacks) view(c context.Context, a vocab.ActivityStreamsView) error {
 	op := a.GetActivityStreamsObject()
 	if op == nil || op.Len() == 0 {
 		return ErrObjectRequired
 	}
-	actors := a.GetActivityStreamsActor()
-	if err := mustHaveActivityActorsMatchObjectActors(c, actors, op, w.newTransport, w.inboxIRI); err != nil {
-		return err
+	if w.View != nil {
+		return w.View(c, a)
 	}
-	if w.Undo != nil {
-		return w.Undo(c, a)
+	return nil
+}
+
+// listen implements the federating Listen activity side effects.
+func (w FederatingWrappedCallbacks) listen(c context.Context, a vocab.ActivityStreamsListen) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Listen != nil {
+		return w.Listen(c, a)
 	}
 	return nil
 }
 
-// block implements the federating Block activity side effects.
-func (w FederatingWrappedCallbacks) block(c context.Context, a vocab.ActivityStreamsBlock) error {
+// read implements the federating Read activity side effects.
+func (w FederatingWrappedCallbacks) read(c context.Context, a vocab.ActivityStreamsRead) error {
 	op := a.GetActivityStreamsObject()
 	if op == nil || op.Len() == 0 {
 		return ErrObjectRequired
 	}
-	if w.Block != nil {
-		return w.Block(c, a)
+	if w.Read != nil {
+		return w.Read(c, a)
+	}
+	return nil
+}
+
+// tentativeAccept implements the federating TentativeAccept activity side effects.
+func (w FederatingWrappedCallbacks) tentativeAccept(c context.Context, a vocab.ActivityStreamsTentativeAccept) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.TentativeAccept != nil {
+		return w.TentativeAccept(c, a)
+	}
+	return nil
+}
+
+// tentativeReject implements the federating TentativeReject activity side effects.
+func (w FederatingWrappedCallbacks) tentativeReject(c context.Context, a vocab.ActivityStreamsTentativeReject) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.TentativeReject != nil {
+		return w.TentativeReject(c, a)
+	}
+	return nil
+}
+
+// offer implements the federating Offer activity side effects.
+func (w FederatingWrappedCallbacks) offer(c context.Context, a vocab.ActivityStreamsOffer) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Offer != nil {
+		return w.Offer(c, a)
+	}
+	return nil
+}
+
+// invite implements the federating Invite activity side effects.
+func (w FederatingWrappedCallbacks) invite(c context.Context, a vocab.ActivityStreamsInvite) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Invite != nil {
+		return w.Invite(c, a)
+	}
+	return nil
+}
+
+// question implements the federating Question activity side effects.
+func (w FederatingWrappedCallbacks) question(c context.Context, a vocab.ActivityStreamsQuestion) error {
+	if oneOf, anyOf := a.GetActivityStreamsOneOf(), a.GetActivityStreamsAnyOf(); oneOf != nil && oneOf.Len() > 0 && anyOf != nil && anyOf.Len() > 0 {
+		return ErrOneOfAndAnyOf
+	}
+	if w.Question != nil {
+		return w.Question(c, a)
+	}
+	return nil
+}
+
+// travel implements the federating Travel activity side effects.
+func (w FederatingWrappedCallbacks) travel(c context.Context, a vocab.ActivityStreamsTravel) error {
+	if w.Travel != nil {
+		return w.Travel(c, a)
 	}
 	return nil
 }
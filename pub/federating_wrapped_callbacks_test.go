@@ -2,8 +2,10 @@ package pub
 
 import (
 	"context"
+	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/streams/vocab"
@@ -221,6 +223,182 @@ func TestFederatedCallbacks(t *testing.T) {
 			t.Fatalf("could not find overridden function")
 		}
 	})
+	t.Run("OverridesMove", func(t *testing.T) {
+		ok := false
+		o := func(context.Context, vocab.ActivityStreamsMove) error {
+			ok = true
+			return nil
+		}
+		var w FederatingWrappedCallbacks
+		for _, f := range w.callbacks([]interface{}{o}) {
+			if fn, ok := f.(func(context.Context, vocab.ActivityStreamsMove) error); ok {
+				fn(nil, nil)
+			}
+		}
+		if !ok {
+			t.Fatalf("could not find overridden function")
+		}
+	})
+	t.Run("OverridesFlag", func(t *testing.T) {
+		ok := false
+		o := func(context.Context, vocab.ActivityStreamsFlag) error {
+			ok = true
+			return nil
+		}
+		var w FederatingWrappedCallbacks
+		for _, f := range w.callbacks([]interface{}{o}) {
+			if fn, ok := f.(func(context.Context, vocab.ActivityStreamsFlag) error); ok {
+				fn(nil, nil)
+			}
+		}
+		if !ok {
+			t.Fatalf("could not find overridden function")
+		}
+	})
+	t.Run("OverridesView", func(t *testing.T) {
+		ok := false
+		o := func(context.Context, vocab.ActivityStreamsView) error {
+			ok = true
+			return nil
+		}
+		var w FederatingWrappedCallbacks
+		for _, f := range w.callbacks([]interface{}{o}) {
+			if fn, ok := f.(func(context.Context, vocab.ActivityStreamsView) error); ok {
+				fn(nil, nil)
+			}
+		}
+		if !ok {
+			t.Fatalf("could not find overridden function")
+		}
+	})
+	t.Run("OverridesListen", func(t *testing.T) {
+		ok := false
+		o := func(context.Context, vocab.ActivityStreamsListen) error {
+			ok = true
+			return nil
+		}
+		var w FederatingWrappedCallbacks
+		for _, f := range w.callbacks([]interface{}{o}) {
+			if fn, ok := f.(func(context.Context, vocab.ActivityStreamsListen) error); ok {
+				fn(nil, nil)
+			}
+		}
+		if !ok {
+			t.Fatalf("could not find overridden function")
+		}
+	})
+	t.Run("OverridesRead", func(t *testing.T) {
+		ok := false
+		o := func(context.Context, vocab.ActivityStreamsRead) error {
+			ok = true
+			return nil
+		}
+		var w FederatingWrappedCallbacks
+		for _, f := range w.callbacks([]interface{}{o}) {
+			if fn, ok := f.(func(context.Context, vocab.ActivityStreamsRead) error); ok {
+				fn(nil, nil)
+			}
+		}
+		if !ok {
+			t.Fatalf("could not find overridden function")
+		}
+	})
+	t.Run("OverridesTentativeAccept", func(t *testing.T) {
+		ok := false
+		o := func(context.Context, vocab.ActivityStreamsTentativeAccept) error {
+			ok = true
+			return nil
+		}
+		var w FederatingWrappedCallbacks
+		for _, f := range w.callbacks([]interface{}{o}) {
+			if fn, ok := f.(func(context.Context, vocab.ActivityStreamsTentativeAccept) error); ok {
+				fn(nil, nil)
+			}
+		}
+		if !ok {
+			t.Fatalf("could not find overridden function")
+		}
+	})
+	t.Run("OverridesTentativeReject", func(t *testing.T) {
+		ok := false
+		o := func(context.Context, vocab.ActivityStreamsTentativeReject) error {
+			ok = true
+			return nil
+		}
+		var w FederatingWrappedCallbacks
+		for _, f := range w.callbacks([]interface{}{o}) {
+			if fn, ok := f.(func(context.Context, vocab.ActivityStreamsTentativeReject) error); ok {
+				fn(nil, nil)
+			}
+		}
+		if !ok {
+			t.Fatalf("could not find overridden function")
+		}
+	})
+	t.Run("OverridesOffer", func(t *testing.T) {
+		ok := false
+		o := func(context.Context, vocab.ActivityStreamsOffer) error {
+			ok = true
+			return nil
+		}
+		var w FederatingWrappedCallbacks
+		for _, f := range w.callbacks([]interface{}{o}) {
+			if fn, ok := f.(func(context.Context, vocab.ActivityStreamsOffer) error); ok {
+				fn(nil, nil)
+			}
+		}
+		if !ok {
+			t.Fatalf("could not find overridden function")
+		}
+	})
+	t.Run("OverridesInvite", func(t *testing.T) {
+		ok := false
+		o := func(context.Context, vocab.ActivityStreamsInvite) error {
+			ok = true
+			return nil
+		}
+		var w FederatingWrappedCallbacks
+		for _, f := range w.callbacks([]interface{}{o}) {
+			if fn, ok := f.(func(context.Context, vocab.ActivityStreamsInvite) error); ok {
+				fn(nil, nil)
+			}
+		}
+		if !ok {
+			t.Fatalf("could not find overridden function")
+		}
+	})
+	t.Run("OverridesQuestion", func(t *testing.T) {
+		ok := false
+		o := func(context.Context, vocab.ActivityStreamsQuestion) error {
+			ok = true
+			return nil
+		}
+		var w FederatingWrappedCallbacks
+		for _, f := range w.callbacks([]interface{}{o}) {
+			if fn, ok := f.(func(context.Context, vocab.ActivityStreamsQuestion) error); ok {
+				fn(nil, nil)
+			}
+		}
+		if !ok {
+			t.Fatalf("could not find overridden function")
+		}
+	})
+	t.Run("OverridesTravel", func(t *testing.T) {
+		ok := false
+		o := func(context.Context, vocab.ActivityStreamsTravel) error {
+			ok = true
+			return nil
+		}
+		var w FederatingWrappedCallbacks
+		for _, f := range w.callbacks([]interface{}{o}) {
+			if fn, ok := f.(func(context.Context, vocab.ActivityStreamsTravel) error); ok {
+				fn(nil, nil)
+			}
+		}
+		if !ok {
+			t.Fatalf("could not find overridden function")
+		}
+	})
 }
 
 func TestFederatedCreate(t *testing.T) {
@@ -335,6 +513,191 @@ func TestFederatedCreate(t *testing.T) {
 		assertEqual(t, ctx, gotc)
 		assertEqual(t, c, got)
 	})
+	newQuestionFn := func(cats, voters int) vocab.ActivityStreamsQuestion {
+		q := streams.NewActivityStreamsQuestion()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testNoteId2))
+		q.SetJSONLDId(id)
+		oneOf := streams.NewActivityStreamsOneOfProperty()
+		for _, name := range []string{"cats", "dogs"} {
+			option := streams.NewActivityStreamsNote()
+			nameProp := streams.NewActivityStreamsNameProperty()
+			nameProp.AppendXMLSchemaString(name)
+			option.SetActivityStreamsName(nameProp)
+			if name == "cats" && cats > 0 {
+				replies := streams.NewActivityStreamsCollection()
+				total := streams.NewActivityStreamsTotalItemsProperty()
+				total.Set(cats)
+				replies.SetActivityStreamsTotalItems(total)
+				repliesProp := streams.NewActivityStreamsRepliesProperty()
+				repliesProp.SetActivityStreamsCollection(replies)
+				option.SetActivityStreamsReplies(repliesProp)
+			}
+			oneOf.AppendActivityStreamsNote(option)
+		}
+		q.SetActivityStreamsOneOf(oneOf)
+		if voters > 0 {
+			count := streams.NewTootVotersCountProperty()
+			count.Set(voters)
+			q.SetTootVotersCount(count)
+		}
+		return q
+	}
+	newVoteFn := func(option string) (vocab.ActivityStreamsCreate, vocab.ActivityStreamsNote) {
+		note := streams.NewActivityStreamsNote()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testNoteId1))
+		note.SetJSONLDId(id)
+		name := streams.NewActivityStreamsNameProperty()
+		name.AppendXMLSchemaString(option)
+		note.SetActivityStreamsName(name)
+		inReplyTo := streams.NewActivityStreamsInReplyToProperty()
+		inReplyTo.AppendIRI(mustParse(testNoteId2))
+		note.SetActivityStreamsInReplyTo(inReplyTo)
+		c := newCreateFn()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(note)
+		c.SetActivityStreamsObject(op)
+		return c, note
+	}
+	t.Run("TalliesVoteInOwnedQuestion", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB, _ := setupFn(ctl)
+		mockClock := NewMockClock(ctl)
+		w.clock = mockClock
+		c, note := newVoteFn("cats")
+		expectQuestion := newQuestionFn(3, 5)
+		gomock.InOrder(
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Create(ctx, note),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId2)),
+			mockDB.EXPECT().Owns(ctx, mustParse(testNoteId2)).Return(true, nil),
+			mockDB.EXPECT().Get(ctx, mustParse(testNoteId2)).Return(newQuestionFn(2, 4), nil),
+			mockClock.EXPECT().Now().Return(now()),
+			mockDB.EXPECT().Update(ctx, expectQuestion),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId2)),
+		)
+		err := w.create(ctx, c)
+		assertEqual(t, err, nil)
+	})
+	t.Run("TalliesFirstVoteForOption", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB, _ := setupFn(ctl)
+		mockClock := NewMockClock(ctl)
+		w.clock = mockClock
+		c, note := newVoteFn("cats")
+		expectQuestion := newQuestionFn(1, 1)
+		gomock.InOrder(
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Create(ctx, note),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId2)),
+			mockDB.EXPECT().Owns(ctx, mustParse(testNoteId2)).Return(true, nil),
+			mockDB.EXPECT().Get(ctx, mustParse(testNoteId2)).Return(newQuestionFn(0, 0), nil),
+			mockClock.EXPECT().Now().Return(now()),
+			mockDB.EXPECT().Update(ctx, expectQuestion),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId2)),
+		)
+		err := w.create(ctx, c)
+		assertEqual(t, err, nil)
+	})
+	t.Run("VoteCallbackDeterminesNewVoter", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB, _ := setupFn(ctl)
+		mockClock := NewMockClock(ctl)
+		w.clock = mockClock
+		c, note := newVoteFn("cats")
+		expectQuestion := newQuestionFn(3, 4)
+		var gotc context.Context
+		var gotq vocab.ActivityStreamsQuestion
+		var gotv vocab.ActivityStreamsNote
+		w.Vote = func(ctx context.Context, q vocab.ActivityStreamsQuestion, v vocab.ActivityStreamsNote) (bool, error) {
+			gotc = ctx
+			gotq = q
+			gotv = v
+			return false, nil
+		}
+		gomock.InOrder(
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Create(ctx, note),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId2)),
+			mockDB.EXPECT().Owns(ctx, mustParse(testNoteId2)).Return(true, nil),
+			mockDB.EXPECT().Get(ctx, mustParse(testNoteId2)).Return(newQuestionFn(2, 4), nil),
+			mockClock.EXPECT().Now().Return(now()),
+			mockDB.EXPECT().Update(ctx, expectQuestion),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId2)),
+		)
+		err := w.create(ctx, c)
+		assertEqual(t, err, nil)
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, gomock.Eq(expectQuestion).Matches(gotq), true)
+		assertEqual(t, note, gotv)
+	})
+	t.Run("IgnoresVoteInClosedQuestion", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB, _ := setupFn(ctl)
+		mockClock := NewMockClock(ctl)
+		w.clock = mockClock
+		c, note := newVoteFn("cats")
+		q := newQuestionFn(2, 4)
+		endTime := streams.NewActivityStreamsEndTimeProperty()
+		endTime.Set(now().Add(-time.Hour))
+		q.SetActivityStreamsEndTime(endTime)
+		gomock.InOrder(
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Create(ctx, note),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId2)),
+			mockDB.EXPECT().Owns(ctx, mustParse(testNoteId2)).Return(true, nil),
+			mockDB.EXPECT().Get(ctx, mustParse(testNoteId2)).Return(q, nil),
+			mockClock.EXPECT().Now().Return(now()),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId2)),
+		)
+		err := w.create(ctx, c)
+		assertEqual(t, err, nil)
+	})
+	t.Run("IgnoresVoteForUnknownOption", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB, _ := setupFn(ctl)
+		mockClock := NewMockClock(ctl)
+		w.clock = mockClock
+		c, note := newVoteFn("birds")
+		gomock.InOrder(
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Create(ctx, note),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId2)),
+			mockDB.EXPECT().Owns(ctx, mustParse(testNoteId2)).Return(true, nil),
+			mockDB.EXPECT().Get(ctx, mustParse(testNoteId2)).Return(newQuestionFn(2, 4), nil),
+			mockClock.EXPECT().Now().Return(now()),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId2)),
+		)
+		err := w.create(ctx, c)
+		assertEqual(t, err, nil)
+	})
+	t.Run("IgnoresVoteInQuestionNotOwned", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB, _ := setupFn(ctl)
+		c, note := newVoteFn("cats")
+		gomock.InOrder(
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Create(ctx, note),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
+			mockDB.EXPECT().Lock(ctx, mustParse(testNoteId2)),
+			mockDB.EXPECT().Owns(ctx, mustParse(testNoteId2)).Return(false, nil),
+			mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId2)),
+		)
+		err := w.create(ctx, c)
+		assertEqual(t, err, nil)
+	})
 }
 
 func TestFederatedUpdate(t *testing.T) {
@@ -391,6 +754,7 @@ func TestFederatedUpdate(t *testing.T) {
 		defer ctl.Finish()
 		w, mockDB := setupFn(ctl)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(false, nil)
 		mockDB.EXPECT().Update(ctx, testFederatedNote)
 		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
 		u := newUpdateFn()
@@ -404,9 +768,11 @@ func TestFederatedUpdate(t *testing.T) {
 		defer ctl.Finish()
 		w, mockDB := setupFn(ctl)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(false, nil)
 		mockDB.EXPECT().Update(ctx, testFederatedNote)
 		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId2))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId2)).Return(false, nil)
 		mockDB.EXPECT().Update(ctx, testFederatedNote2)
 		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId2))
 		u := newUpdateFn()
@@ -416,6 +782,82 @@ func TestFederatedUpdate(t *testing.T) {
 			t.Fatalf("got error %s", err)
 		}
 	})
+	t.Run("ErrorIfStoredObjectOwnedByOtherActor", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB := setupFn(ctl)
+		stored := streams.NewActivityStreamsNote()
+		at := streams.NewActivityStreamsAttributedToProperty()
+		at.AppendIRI(mustParse(testFederatedActorIRI2))
+		stored.SetActivityStreamsAttributedTo(at)
+		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		mockDB.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(stored, nil)
+		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		err := w.update(ctx, newUpdateFn())
+		assertEqual(t, err, ErrUnauthorizedUpdate)
+	})
+	t.Run("UpdatesStoredObjectOwnedByActor", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB := setupFn(ctl)
+		stored := streams.NewActivityStreamsNote()
+		at := streams.NewActivityStreamsAttributedToProperty()
+		at.AppendIRI(mustParse(testFederatedActorIRI))
+		stored.SetActivityStreamsAttributedTo(at)
+		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		mockDB.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(stored, nil)
+		mockDB.EXPECT().Update(ctx, testFederatedNote)
+		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		err := w.update(ctx, newUpdateFn())
+		assertEqual(t, err, nil)
+	})
+	t.Run("ErrorIfNewObjectAttributedToOtherActor", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB := setupFn(ctl)
+		note := streams.NewActivityStreamsNote()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testNoteId1))
+		note.SetJSONLDId(id)
+		at := streams.NewActivityStreamsAttributedToProperty()
+		at.AppendIRI(mustParse(testFederatedActorIRI2))
+		note.SetActivityStreamsAttributedTo(at)
+		u := newUpdateFn()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(note)
+		u.SetActivityStreamsObject(op)
+		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(false, nil)
+		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		err := w.update(ctx, u)
+		assertEqual(t, err, ErrUnauthorizedUpdate)
+	})
+	t.Run("ErrorIfSignerIsNotActor", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB := setupFn(ctl)
+		signedCtx := WithSigner(ctx, mustParse(testFederatedActorIRI2))
+		mockDB.EXPECT().Lock(signedCtx, mustParse(testNoteId1))
+		mockDB.EXPECT().Unlock(signedCtx, mustParse(testNoteId1))
+		err := w.update(signedCtx, newUpdateFn())
+		assertEqual(t, err, ErrUnauthorizedUpdate)
+	})
+	t.Run("AuthorizeUpdateOverridesCheck", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB := setupFn(ctl)
+		w.AuthorizeUpdate = func(c context.Context, update vocab.ActivityStreamsUpdate, object vocab.Type) (bool, error) {
+			return true, nil
+		}
+		signedCtx := WithSigner(ctx, mustParse(testFederatedActorIRI2))
+		mockDB.EXPECT().Lock(signedCtx, mustParse(testNoteId1))
+		mockDB.EXPECT().Update(signedCtx, testFederatedNote)
+		mockDB.EXPECT().Unlock(signedCtx, mustParse(testNoteId1))
+		err := w.update(signedCtx, newUpdateFn())
+		assertEqual(t, err, nil)
+	})
 	t.Run("ErrorIfObjectIsIRI", func(t *testing.T) {
 		u := newUpdateFn()
 		op := streams.NewActivityStreamsObjectProperty()
@@ -427,11 +869,28 @@ func TestFederatedUpdate(t *testing.T) {
 			t.Fatalf("expected error, got none")
 		}
 	})
+	t.Run("InvalidatesCache", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB := setupFn(ctl)
+		cache := NewMockDereferenceCache(ctl)
+		w.Cache = cache
+		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(false, nil)
+		mockDB.EXPECT().Update(ctx, testFederatedNote)
+		cache.EXPECT().Invalidate(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		err := w.update(ctx, newUpdateFn())
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+	})
 	t.Run("CallsCustomCallback", func(t *testing.T) {
 		ctl := gomock.NewController(t)
 		defer ctl.Finish()
 		w, mockDB := setupFn(ctl)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(false, nil)
 		mockDB.EXPECT().Update(ctx, testFederatedNote)
 		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
 		u := newUpdateFn()
@@ -466,9 +925,11 @@ func TestFederatedDelete(t *testing.T) {
 		return d
 	}
 	ctx := context.Background()
-	setupFn := func(ctl *gomock.Controller) (w FederatingWrappedCallbacks, mockDB *MockDatabase) {
+	setupFn := func(ctl *gomock.Controller) (w FederatingWrappedCallbacks, mockDB *MockDatabase, mockClock *MockClock) {
 		mockDB = NewMockDatabase(ctl)
+		mockClock = NewMockClock(ctl)
 		w.db = mockDB
+		w.clock = mockClock
 		return
 	}
 	t.Run("ErrorIfNoObject", func(t *testing.T) {
@@ -500,12 +961,15 @@ func TestFederatedDelete(t *testing.T) {
 			t.Fatalf("expected error, got none")
 		}
 	})
-	t.Run("DeletesFederatedObject", func(t *testing.T) {
+	t.Run("ReplacesFederatedObjectWithTombstone", func(t *testing.T) {
 		ctl := gomock.NewController(t)
 		defer ctl.Finish()
-		w, mockDB := setupFn(ctl)
+		w, mockDB, mockClock := setupFn(ctl)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
-		mockDB.EXPECT().Delete(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		mockDB.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(streams.NewActivityStreamsNote(), nil)
+		mockClock.EXPECT().Now().Return(now())
+		mockDB.EXPECT().Update(ctx, toTombstone(streams.NewActivityStreamsNote(), mustParse(testNoteId1), now()))
 		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
 		d := newDeleteFn()
 		err := w.deleteFn(ctx, d)
@@ -513,15 +977,21 @@ func TestFederatedDelete(t *testing.T) {
 			t.Fatalf("got error %s", err)
 		}
 	})
-	t.Run("DeletesAllFederatedObjects", func(t *testing.T) {
+	t.Run("ReplacesAllFederatedObjectsWithTombstones", func(t *testing.T) {
 		ctl := gomock.NewController(t)
 		defer ctl.Finish()
-		w, mockDB := setupFn(ctl)
+		w, mockDB, mockClock := setupFn(ctl)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
-		mockDB.EXPECT().Delete(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		mockDB.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(streams.NewActivityStreamsNote(), nil)
+		mockClock.EXPECT().Now().Return(now())
+		mockDB.EXPECT().Update(ctx, toTombstone(streams.NewActivityStreamsNote(), mustParse(testNoteId1), now()))
 		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId2))
-		mockDB.EXPECT().Delete(ctx, mustParse(testNoteId2))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId2)).Return(true, nil)
+		mockDB.EXPECT().Get(ctx, mustParse(testNoteId2)).Return(streams.NewActivityStreamsNote(), nil)
+		mockClock.EXPECT().Now().Return(now())
+		mockDB.EXPECT().Update(ctx, toTombstone(streams.NewActivityStreamsNote(), mustParse(testNoteId2), now()))
 		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId2))
 		d := newDeleteFn()
 		d.GetActivityStreamsObject().AppendIRI(mustParse(testNoteId2))
@@ -530,34 +1000,80 @@ func TestFederatedDelete(t *testing.T) {
 			t.Fatalf("got error %s", err)
 		}
 	})
-	t.Run("CallsCustomCallback", func(t *testing.T) {
+	t.Run("SkipsObjectsNotStored", func(t *testing.T) {
 		ctl := gomock.NewController(t)
 		defer ctl.Finish()
-		w, mockDB := setupFn(ctl)
+		w, mockDB, _ := setupFn(ctl)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
-		mockDB.EXPECT().Delete(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(false, nil)
 		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
-		d := newDeleteFn()
-		var gotc context.Context
-		var got vocab.ActivityStreamsDelete
-		w.Delete = func(ctx context.Context, v vocab.ActivityStreamsDelete) error {
-			gotc = ctx
-			got = v
-			return nil
-		}
-		err := w.deleteFn(ctx, d)
+		err := w.deleteFn(ctx, newDeleteFn())
 		if err != nil {
 			t.Fatalf("got error %s", err)
 		}
-		assertEqual(t, ctx, gotc)
-		assertEqual(t, d, got)
 	})
-}
-
-func TestFederatedFollow(t *testing.T) {
-	newFollowFn := func() vocab.ActivityStreamsFollow {
-		f := streams.NewActivityStreamsFollow()
-		id := streams.NewJSONLDIdProperty()
+	t.Run("KeepsExistingTombstone", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB, _ := setupFn(ctl)
+		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		mockDB.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(streams.NewActivityStreamsTombstone(), nil)
+		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		err := w.deleteFn(ctx, newDeleteFn())
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+	})
+	t.Run("InvalidatesCache", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB, mockClock := setupFn(ctl)
+		cache := NewMockDereferenceCache(ctl)
+		w.Cache = cache
+		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		mockDB.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(streams.NewActivityStreamsNote(), nil)
+		mockClock.EXPECT().Now().Return(now())
+		mockDB.EXPECT().Update(ctx, toTombstone(streams.NewActivityStreamsNote(), mustParse(testNoteId1), now()))
+		cache.EXPECT().Invalidate(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		err := w.deleteFn(ctx, newDeleteFn())
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB, mockClock := setupFn(ctl)
+		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		mockDB.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(streams.NewActivityStreamsNote(), nil)
+		mockClock.EXPECT().Now().Return(now())
+		mockDB.EXPECT().Update(ctx, toTombstone(streams.NewActivityStreamsNote(), mustParse(testNoteId1), now()))
+		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		d := newDeleteFn()
+		var gotc context.Context
+		var got vocab.ActivityStreamsDelete
+		w.Delete = func(ctx context.Context, v vocab.ActivityStreamsDelete) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		err := w.deleteFn(ctx, d)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, d, got)
+	})
+}
+
+func TestFederatedFollow(t *testing.T) {
+	newFollowFn := func() vocab.ActivityStreamsFollow {
+		f := streams.NewActivityStreamsFollow()
+		id := streams.NewJSONLDIdProperty()
 		id.Set(mustParse(testNewActivityIRI))
 		f.SetJSONLDId(id)
 		actor := streams.NewActivityStreamsActorProperty()
@@ -705,6 +1221,72 @@ func TestFederatedFollow(t *testing.T) {
 			t.Fatalf("got error %s", err)
 		}
 	})
+	t.Run("OnFollowQueueForApprovalAddsFollowRequest", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDB := NewMockFollowRequestDatabase(ctl)
+		var w FederatingWrappedCallbacks
+		w.db = mockDB
+		w.inboxIRI = mustParse(testMyInboxIRI)
+		w.OnFollow = OnFollowQueueForApproval
+		f := newFollowFn()
+		mockDB.EXPECT().Lock(ctx, mustParse(testMyInboxIRI))
+		mockDB.EXPECT().ActorForInbox(ctx, mustParse(testMyInboxIRI)).Return(
+			mustParse(testFederatedActorIRI2), nil)
+		mockDB.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI))
+		mockDB.EXPECT().AddFollowRequest(ctx, mustParse(testFederatedActorIRI2), f)
+		err := w.follow(ctx, f)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+	})
+	t.Run("OnFollowQueueForApprovalErrorsWithoutFollowRequestDatabase", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB := setupFn(ctl)
+		w.OnFollow = OnFollowQueueForApproval
+		mockDB.EXPECT().Lock(ctx, mustParse(testMyInboxIRI))
+		mockDB.EXPECT().ActorForInbox(ctx, mustParse(testMyInboxIRI)).Return(
+			mustParse(testFederatedActorIRI2), nil)
+		mockDB.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI))
+		err := w.follow(ctx, newFollowFn())
+		if err == nil {
+			t.Fatalf("expected error, got none")
+		}
+	})
+	t.Run("FollowPolicyOverridesOnFollow", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockDB := setupFn(ctl)
+		w.OnFollow = OnFollowAutomaticallyAccept
+		f := newFollowFn()
+		w.FollowPolicy = func(c context.Context, actorIRI *url.URL, follow vocab.ActivityStreamsFollow) (OnFollowBehavior, error) {
+			assertEqual(t, actorIRI.String(), testFederatedActorIRI2)
+			assertEqual(t, follow, f)
+			return OnFollowAutomaticallyReject, nil
+		}
+		w.addNewIds = func(c context.Context, activity Activity) error {
+			return nil
+		}
+		w.deliver = func(c context.Context, outboxIRI *url.URL, activity Activity) error {
+			if !streams.IsOrExtendsActivityStreamsReject(activity) {
+				t.Fatalf("expected Reject, got %T", activity)
+			}
+			return nil
+		}
+		mockDB.EXPECT().Lock(ctx, mustParse(testMyInboxIRI))
+		mockDB.EXPECT().ActorForInbox(ctx, mustParse(testMyInboxIRI)).Return(
+			mustParse(testFederatedActorIRI2), nil)
+		mockDB.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI))
+		mockDB.EXPECT().Lock(ctx, mustParse(testMyInboxIRI))
+		mockDB.EXPECT().OutboxForInbox(ctx, mustParse(testMyInboxIRI)).Return(
+			mustParse(testMyOutboxIRI), nil)
+		mockDB.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI))
+		err := w.follow(ctx, f)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+	})
 	t.Run("CallsCustomCallback", func(t *testing.T) {
 		ctl := gomock.NewController(t)
 		defer ctl.Finish()
@@ -1378,6 +1960,9 @@ func TestFederatedLike(t *testing.T) {
 		expectItems := streams.NewActivityStreamsItemsProperty()
 		expectItems.AppendIRI(mustParse(testFederatedActivityIRI))
 		expectCol.SetActivityStreamsItems(expectItems)
+		expectTotal := streams.NewActivityStreamsTotalItemsProperty()
+		expectTotal.Set(1)
+		expectCol.SetActivityStreamsTotalItems(expectTotal)
 		expectLikes.SetActivityStreamsCollection(expectCol)
 		expectNote.SetActivityStreamsLikes(expectLikes)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
@@ -1411,6 +1996,9 @@ func TestFederatedLike(t *testing.T) {
 		expectItems.AppendIRI(mustParse(testFederatedActivityIRI))
 		expectItems.AppendIRI(mustParse(testFederatedActivityIRI2))
 		expectCol.SetActivityStreamsItems(expectItems)
+		expectTotal := streams.NewActivityStreamsTotalItemsProperty()
+		expectTotal.Set(2)
+		expectCol.SetActivityStreamsTotalItems(expectTotal)
 		expectLikes.SetActivityStreamsCollection(expectCol)
 		expectNote.SetActivityStreamsLikes(expectLikes)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
@@ -1444,6 +2032,9 @@ func TestFederatedLike(t *testing.T) {
 		expectItems.AppendIRI(mustParse(testFederatedActivityIRI))
 		expectItems.AppendIRI(mustParse(testFederatedActivityIRI2))
 		expectCol.SetActivityStreamsOrderedItems(expectItems)
+		expectTotal := streams.NewActivityStreamsTotalItemsProperty()
+		expectTotal.Set(2)
+		expectCol.SetActivityStreamsTotalItems(expectTotal)
 		expectLikes.SetActivityStreamsOrderedCollection(expectCol)
 		expectNote.SetActivityStreamsLikes(expectLikes)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
@@ -1458,6 +2049,17 @@ func TestFederatedLike(t *testing.T) {
 			t.Fatalf("got error %s", err)
 		}
 	})
+	t.Run("SkipsLikesCollectionWhenManual", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, _ := setupFn(ctl)
+		w.ManualLikesAndShares = true
+		l := newLikeFn()
+		err := w.like(ctx, l)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+	})
 	t.Run("CallsCustomCallback", func(t *testing.T) {
 		ctl := gomock.NewController(t)
 		defer ctl.Finish()
@@ -1469,6 +2071,9 @@ func TestFederatedLike(t *testing.T) {
 		expectItems := streams.NewActivityStreamsItemsProperty()
 		expectItems.AppendIRI(mustParse(testFederatedActivityIRI))
 		expectCol.SetActivityStreamsItems(expectItems)
+		expectTotal := streams.NewActivityStreamsTotalItemsProperty()
+		expectTotal.Set(1)
+		expectCol.SetActivityStreamsTotalItems(expectTotal)
 		expectLikes.SetActivityStreamsCollection(expectCol)
 		expectNote.SetActivityStreamsLikes(expectLikes)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
@@ -1548,6 +2153,9 @@ func TestFederatedAnnounce(t *testing.T) {
 		expectItems := streams.NewActivityStreamsItemsProperty()
 		expectItems.AppendIRI(mustParse(testFederatedActivityIRI))
 		expectCol.SetActivityStreamsItems(expectItems)
+		expectTotal := streams.NewActivityStreamsTotalItemsProperty()
+		expectTotal.Set(1)
+		expectCol.SetActivityStreamsTotalItems(expectTotal)
 		expectShares.SetActivityStreamsCollection(expectCol)
 		expectNote.SetActivityStreamsShares(expectShares)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
@@ -1582,6 +2190,9 @@ func TestFederatedAnnounce(t *testing.T) {
 		expectItems.AppendIRI(mustParse(testFederatedActivityIRI))
 		expectItems.AppendIRI(mustParse(testFederatedActivityIRI2))
 		expectCol.SetActivityStreamsItems(expectItems)
+		expectTotal := streams.NewActivityStreamsTotalItemsProperty()
+		expectTotal.Set(2)
+		expectCol.SetActivityStreamsTotalItems(expectTotal)
 		expectShares.SetActivityStreamsCollection(expectCol)
 		expectNote.SetActivityStreamsShares(expectShares)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
@@ -1616,6 +2227,9 @@ func TestFederatedAnnounce(t *testing.T) {
 		expectItems.AppendIRI(mustParse(testFederatedActivityIRI))
 		expectItems.AppendIRI(mustParse(testFederatedActivityIRI2))
 		expectCol.SetActivityStreamsOrderedItems(expectItems)
+		expectTotal := streams.NewActivityStreamsTotalItemsProperty()
+		expectTotal.Set(2)
+		expectCol.SetActivityStreamsTotalItems(expectTotal)
 		expectShares.SetActivityStreamsOrderedCollection(expectCol)
 		expectNote.SetActivityStreamsShares(expectShares)
 		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
@@ -1758,6 +2372,76 @@ func TestFederatedUndo(t *testing.T) {
 			t.Fatalf("got error %s", err)
 		}
 	})
+	t.Run("RemovesPendingFollowRequest", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockTp := setupFn(ctl)
+		mockDB := NewMockFollowRequestDatabase(ctl)
+		w.db = mockDB
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActivityIRI)).Return(
+			mustSerializeToBytes(testFollow), nil)
+		mockDB.EXPECT().RemoveFollowRequest(ctx, mustParse(testFederatedActivityIRI))
+		u := newUndoFn()
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI2))
+		u.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsFollow(testFollow)
+		u.SetActivityStreamsObject(op)
+		err := w.undo(ctx, u)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+	})
+	t.Run("RemovesFromLikesCollection", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, mockTp := setupFn(ctl)
+		mockDB := NewMockDatabase(ctl)
+		w.db = mockDB
+		like := streams.NewActivityStreamsLike()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		like.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		like.SetActivityStreamsActor(actor)
+		likeOp := streams.NewActivityStreamsObjectProperty()
+		likeOp.AppendIRI(mustParse(testNoteId1))
+		like.SetActivityStreamsObject(likeOp)
+		newNoteFn := func(likeIRIs ...string) vocab.ActivityStreamsNote {
+			note := streams.NewActivityStreamsNote()
+			likes := streams.NewActivityStreamsLikesProperty()
+			col := streams.NewActivityStreamsCollection()
+			items := streams.NewActivityStreamsItemsProperty()
+			for _, iri := range likeIRIs {
+				items.AppendIRI(mustParse(iri))
+			}
+			col.SetActivityStreamsItems(items)
+			total := streams.NewActivityStreamsTotalItemsProperty()
+			total.Set(len(likeIRIs))
+			col.SetActivityStreamsTotalItems(total)
+			likes.SetActivityStreamsCollection(col)
+			note.SetActivityStreamsLikes(likes)
+			return note
+		}
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActivityIRI)).Return(
+			mustSerializeToBytes(like), nil)
+		mockDB.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		mockDB.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(true, nil)
+		mockDB.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(
+			newNoteFn(testFederatedActivityIRI, testFederatedActivityIRI2), nil)
+		mockDB.EXPECT().Update(ctx, newNoteFn(testFederatedActivityIRI2)).Return(nil)
+		mockDB.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		u := newUndoFn()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsLike(like)
+		u.SetActivityStreamsObject(op)
+		err := w.undo(ctx, u)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+	})
 	t.Run("CallsCustomCallback", func(t *testing.T) {
 		ctl := gomock.NewController(t)
 		defer ctl.Finish()
@@ -1832,3 +2516,592 @@ func TestFederatedBlock(t *testing.T) {
 		assertEqual(t, b, got)
 	})
 }
+func TestFederatedMove(t *testing.T) {
+	newMoveFn := func() vocab.ActivityStreamsMove {
+		a := streams.NewActivityStreamsMove()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		a.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		a.SetActivityStreamsObject(op)
+		return a
+	}
+	ctx := context.Background()
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		a := newMoveFn()
+		a.SetActivityStreamsObject(nil)
+		var w FederatingWrappedCallbacks
+		err := w.move(ctx, a)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		var w FederatingWrappedCallbacks
+		var gotc context.Context
+		var got vocab.ActivityStreamsMove
+		w.Move = func(ctx context.Context, v vocab.ActivityStreamsMove) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		a := newMoveFn()
+		err := w.move(ctx, a)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, a, got)
+	})
+	newActorMoveFn := func() vocab.ActivityStreamsMove {
+		a := newMoveFn()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testFederatedActorIRI))
+		a.SetActivityStreamsObject(op)
+		target := streams.NewActivityStreamsTargetProperty()
+		target.AppendIRI(mustParse(testFederatedActorIRI2))
+		a.SetActivityStreamsTarget(target)
+		return a
+	}
+	newTargetFn := func(alsoKnownAs ...string) vocab.ActivityStreamsPerson {
+		p := streams.NewActivityStreamsPerson()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActorIRI2))
+		p.SetJSONLDId(id)
+		if len(alsoKnownAs) > 0 {
+			aka := streams.NewActivityStreamsAlsoKnownAsProperty()
+			for _, iri := range alsoKnownAs {
+				aka.AppendIRI(mustParse(iri))
+			}
+			p.SetActivityStreamsAlsoKnownAs(aka)
+		}
+		return p
+	}
+	newFollowingFn := func(iris ...string) vocab.ActivityStreamsCollection {
+		col := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		for _, iri := range iris {
+			items.AppendIRI(mustParse(iri))
+		}
+		col.SetActivityStreamsItems(items)
+		return col
+	}
+	setupFn := func(ctl *gomock.Controller) (w FederatingWrappedCallbacks, db *MockDatabase, tp *MockTransport, delivered *[]Activity) {
+		setupData()
+		db = NewMockDatabase(ctl)
+		tp = NewMockTransport(ctl)
+		delivered = &[]Activity{}
+		w = FederatingWrappedCallbacks{
+			OnMove:   OnMoveFollowTarget,
+			db:       db,
+			inboxIRI: mustParse(testMyInboxIRI),
+			newTransport: func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error) {
+				return tp, nil
+			},
+			addNewIds: func(c context.Context, activity Activity) error {
+				return nil
+			},
+			deliver: func(c context.Context, outboxIRI *url.URL, activity Activity) error {
+				if outboxIRI.String() != testMyOutboxIRI {
+					return fmt.Errorf("delivered from %s", outboxIRI)
+				}
+				*delivered = append(*delivered, activity)
+				return nil
+			},
+		}
+		return
+	}
+	t.Run("FollowsTargetOfFollowedActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, db, tp, delivered := setupFn(ctl)
+		a := newActorMoveFn()
+		expectFollow := streams.NewActivityStreamsFollow()
+		me := streams.NewActivityStreamsActorProperty()
+		me.AppendIRI(mustParse(testPersonIRI))
+		expectFollow.SetActivityStreamsActor(me)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testFederatedActorIRI2))
+		expectFollow.SetActivityStreamsObject(op)
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testFederatedActorIRI2))
+		expectFollow.SetActivityStreamsTo(to)
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
+			mustSerializeToBytes(newTargetFn(testFederatedActorIRI)), nil)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testMyInboxIRI)),
+			db.EXPECT().ActorForInbox(ctx, mustParse(testMyInboxIRI)).Return(
+				mustParse(testPersonIRI), nil),
+			db.EXPECT().OutboxForInbox(ctx, mustParse(testMyInboxIRI)).Return(
+				mustParse(testMyOutboxIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Following(ctx, mustParse(testPersonIRI)).Return(
+				newFollowingFn(testFederatedActorIRI3, testFederatedActorIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testPersonIRI)),
+		)
+		// Run & Verify
+		err := w.move(ctx, a)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*delivered), 1)
+		assertByteEqual(t, mustSerializeToBytes((*delivered)[0]), mustSerializeToBytes(expectFollow))
+	})
+	t.Run("DoesNotFollowIfNotFollowingActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, db, tp, delivered := setupFn(ctl)
+		a := newActorMoveFn()
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
+			mustSerializeToBytes(newTargetFn(testFederatedActorIRI)), nil)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testMyInboxIRI)),
+			db.EXPECT().ActorForInbox(ctx, mustParse(testMyInboxIRI)).Return(
+				mustParse(testPersonIRI), nil),
+			db.EXPECT().OutboxForInbox(ctx, mustParse(testMyInboxIRI)).Return(
+				mustParse(testMyOutboxIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Following(ctx, mustParse(testPersonIRI)).Return(
+				newFollowingFn(testFederatedActorIRI3), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testPersonIRI)),
+		)
+		// Run & Verify
+		err := w.move(ctx, a)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*delivered), 0)
+	})
+	t.Run("ErrorIfTargetDoesNotReferenceActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, _, tp, delivered := setupFn(ctl)
+		a := newActorMoveFn()
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
+			mustSerializeToBytes(newTargetFn(testFederatedActorIRI3)), nil)
+		// Run & Verify
+		err := w.move(ctx, a)
+		assertNotEqual(t, err, nil)
+		assertEqual(t, len(*delivered), 0)
+	})
+	t.Run("ErrorIfNoTarget", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, _, _, _ := setupFn(ctl)
+		a := newActorMoveFn()
+		a.SetActivityStreamsTarget(nil)
+		// Run & Verify
+		err := w.move(ctx, a)
+		assertEqual(t, err, ErrTargetRequired)
+	})
+	t.Run("ErrorIfObjectIsNotActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, _, _, _ := setupFn(ctl)
+		a := newActorMoveFn()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testFederatedActorIRI3))
+		a.SetActivityStreamsObject(op)
+		// Run & Verify
+		err := w.move(ctx, a)
+		assertNotEqual(t, err, nil)
+	})
+}
+
+func TestFederatedFlag(t *testing.T) {
+	newFlagFn := func() vocab.ActivityStreamsFlag {
+		a := streams.NewActivityStreamsFlag()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		a.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		a.SetActivityStreamsObject(op)
+		return a
+	}
+	ctx := context.Background()
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		a := newFlagFn()
+		a.SetActivityStreamsObject(nil)
+		var w FederatingWrappedCallbacks
+		err := w.flag(ctx, a)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		var w FederatingWrappedCallbacks
+		var gotc context.Context
+		var got vocab.ActivityStreamsFlag
+		w.Flag = func(ctx context.Context, v vocab.ActivityStreamsFlag) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		a := newFlagFn()
+		err := w.flag(ctx, a)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, a, got)
+	})
+}
+
+func TestFederatedView(t *testing.T) {
+	newViewFn := func() vocab.ActivityStreamsView {
+		a := streams.NewActivityStreamsView()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		a.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		a.SetActivityStreamsObject(op)
+		return a
+	}
+	ctx := context.Background()
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		a := newViewFn()
+		a.SetActivityStreamsObject(nil)
+		var w FederatingWrappedCallbacks
+		err := w.view(ctx, a)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		var w FederatingWrappedCallbacks
+		var gotc context.Context
+		var got vocab.ActivityStreamsView
+		w.View = func(ctx context.Context, v vocab.ActivityStreamsView) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		a := newViewFn()
+		err := w.view(ctx, a)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, a, got)
+	})
+}
+
+func TestFederatedListen(t *testing.T) {
+	newListenFn := func() vocab.ActivityStreamsListen {
+		a := streams.NewActivityStreamsListen()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		a.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		a.SetActivityStreamsObject(op)
+		return a
+	}
+	ctx := context.Background()
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		a := newListenFn()
+		a.SetActivityStreamsObject(nil)
+		var w FederatingWrappedCallbacks
+		err := w.listen(ctx, a)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		var w FederatingWrappedCallbacks
+		var gotc context.Context
+		var got vocab.ActivityStreamsListen
+		w.Listen = func(ctx context.Context, v vocab.ActivityStreamsListen) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		a := newListenFn()
+		err := w.listen(ctx, a)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, a, got)
+	})
+}
+
+func TestFederatedRead(t *testing.T) {
+	newReadFn := func() vocab.ActivityStreamsRead {
+		a := streams.NewActivityStreamsRead()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		a.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		a.SetActivityStreamsObject(op)
+		return a
+	}
+	ctx := context.Background()
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		a := newReadFn()
+		a.SetActivityStreamsObject(nil)
+		var w FederatingWrappedCallbacks
+		err := w.read(ctx, a)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		var w FederatingWrappedCallbacks
+		var gotc context.Context
+		var got vocab.ActivityStreamsRead
+		w.Read = func(ctx context.Context, v vocab.ActivityStreamsRead) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		a := newReadFn()
+		err := w.read(ctx, a)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, a, got)
+	})
+}
+
+func TestFederatedTentativeAccept(t *testing.T) {
+	newTentativeAcceptFn := func() vocab.ActivityStreamsTentativeAccept {
+		a := streams.NewActivityStreamsTentativeAccept()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		a.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		a.SetActivityStreamsObject(op)
+		return a
+	}
+	ctx := context.Background()
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		a := newTentativeAcceptFn()
+		a.SetActivityStreamsObject(nil)
+		var w FederatingWrappedCallbacks
+		err := w.tentativeAccept(ctx, a)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		var w FederatingWrappedCallbacks
+		var gotc context.Context
+		var got vocab.ActivityStreamsTentativeAccept
+		w.TentativeAccept = func(ctx context.Context, v vocab.ActivityStreamsTentativeAccept) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		a := newTentativeAcceptFn()
+		err := w.tentativeAccept(ctx, a)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, a, got)
+	})
+}
+
+func TestFederatedTentativeReject(t *testing.T) {
+	newTentativeRejectFn := func() vocab.ActivityStreamsTentativeReject {
+		a := streams.NewActivityStreamsTentativeReject()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		a.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		a.SetActivityStreamsObject(op)
+		return a
+	}
+	ctx := context.Background()
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		a := newTentativeRejectFn()
+		a.SetActivityStreamsObject(nil)
+		var w FederatingWrappedCallbacks
+		err := w.tentativeReject(ctx, a)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		var w FederatingWrappedCallbacks
+		var gotc context.Context
+		var got vocab.ActivityStreamsTentativeReject
+		w.TentativeReject = func(ctx context.Context, v vocab.ActivityStreamsTentativeReject) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		a := newTentativeRejectFn()
+		err := w.tentativeReject(ctx, a)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, a, got)
+	})
+}
+
+func TestFederatedOffer(t *testing.T) {
+	newOfferFn := func() vocab.ActivityStreamsOffer {
+		a := streams.NewActivityStreamsOffer()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		a.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		a.SetActivityStreamsObject(op)
+		return a
+	}
+	ctx := context.Background()
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		a := newOfferFn()
+		a.SetActivityStreamsObject(nil)
+		var w FederatingWrappedCallbacks
+		err := w.offer(ctx, a)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		var w FederatingWrappedCallbacks
+		var gotc context.Context
+		var got vocab.ActivityStreamsOffer
+		w.Offer = func(ctx context.Context, v vocab.ActivityStreamsOffer) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		a := newOfferFn()
+		err := w.offer(ctx, a)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, a, got)
+	})
+}
+
+func TestFederatedInvite(t *testing.T) {
+	newInviteFn := func() vocab.ActivityStreamsInvite {
+		a := streams.NewActivityStreamsInvite()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		a.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		a.SetActivityStreamsObject(op)
+		return a
+	}
+	ctx := context.Background()
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		a := newInviteFn()
+		a.SetActivityStreamsObject(nil)
+		var w FederatingWrappedCallbacks
+		err := w.invite(ctx, a)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		var w FederatingWrappedCallbacks
+		var gotc context.Context
+		var got vocab.ActivityStreamsInvite
+		w.Invite = func(ctx context.Context, v vocab.ActivityStreamsInvite) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		a := newInviteFn()
+		err := w.invite(ctx, a)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, a, got)
+	})
+}
+
+func TestFederatedQuestion(t *testing.T) {
+	newQuestionFn := func() vocab.ActivityStreamsQuestion {
+		q := streams.NewActivityStreamsQuestion()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		q.SetJSONLDId(id)
+		oneOf := streams.NewActivityStreamsOneOfProperty()
+		oneOf.AppendIRI(mustParse(testNoteId1))
+		oneOf.AppendIRI(mustParse(testNoteId2))
+		q.SetActivityStreamsOneOf(oneOf)
+		return q
+	}
+	ctx := context.Background()
+	t.Run("ErrorIfOneOfAndAnyOf", func(t *testing.T) {
+		q := newQuestionFn()
+		anyOf := streams.NewActivityStreamsAnyOfProperty()
+		anyOf.AppendIRI(mustParse(testNoteId1))
+		q.SetActivityStreamsAnyOf(anyOf)
+		var w FederatingWrappedCallbacks
+		err := w.question(ctx, q)
+		assertEqual(t, err, ErrOneOfAndAnyOf)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		var w FederatingWrappedCallbacks
+		var gotc context.Context
+		var got vocab.ActivityStreamsQuestion
+		w.Question = func(ctx context.Context, v vocab.ActivityStreamsQuestion) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		q := newQuestionFn()
+		err := w.question(ctx, q)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, q, got)
+	})
+}
+
+func TestFederatedTravel(t *testing.T) {
+	ctx := context.Background()
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		tr := streams.NewActivityStreamsTravel()
+		var w FederatingWrappedCallbacks
+		var gotc context.Context
+		var got vocab.ActivityStreamsTravel
+		w.Travel = func(ctx context.Context, v vocab.ActivityStreamsTravel) error {
+			gotc = ctx
+			got = v
+			return nil
+		}
+		err := w.travel(ctx, tr)
+		if err != nil {
+			t.Fatalf("got error %s", err)
+		}
+		assertEqual(t, ctx, gotc)
+		assertEqual(t, tr, got)
+	})
+}
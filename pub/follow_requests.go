@@ -0,0 +1,71 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// ErrFollowRequestNotFound indicates the Follow is not pending for the actor.
+var ErrFollowRequestNotFound = errors.New("follow request is not pending")
+
+// AcceptFollowRequest accepts the Follow pending for the actor of the outbox:
+// the Follow's actors are added to its followers, and it sends them an Accept.
+//
+// The actor must have been constructed with the FederatingProtocol enabled.
+func AcceptFollowRequest(c context.Context, actor FederatingActor, db FollowRequestDatabase, outboxIRI, followIRI *url.URL) error {
+	return decideFollowRequest(c, actor, db, outboxIRI, followIRI, true)
+}
+
+// RejectFollowRequest rejects the Follow pending for the actor of the outbox,
+// sending a Reject to the Follow's actors.
+//
+// The actor must have been constructed with the FederatingProtocol enabled.
+func RejectFollowRequest(c context.Context, actor FederatingActor, db FollowRequestDatabase, outboxIRI, followIRI *url.URL) error {
+	return decideFollowRequest(c, actor, db, outboxIRI, followIRI, false)
+}
+
+// decideFollowRequest removes the pending Follow, and sends its Accept or
+// Reject.
+func decideFollowRequest(c context.Context, actor FederatingActor, db FollowRequestDatabase, outboxIRI, followIRI *url.URL, accept bool) error {
+	if err := db.Lock(c, outboxIRI); err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	actorIRI, err := db.ActorForOutbox(c, outboxIRI)
+	if err != nil {
+		db.Unlock(c, outboxIRI)
+		return err
+	}
+	db.Unlock(c, outboxIRI)
+	// Unlock must be called by now and every branch above.
+	follows, err := db.FollowRequests(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	var follow vocab.ActivityStreamsFollow
+	for _, f := range follows {
+		if id, err := GetId(f); err == nil && id.String() == followIRI.String() {
+			follow = f
+			break
+		}
+	}
+	if follow == nil {
+		return ErrFollowRequestNotFound
+	}
+	response, recipients, err := followResponse(actorIRI, follow, accept)
+	if err != nil {
+		return err
+	}
+	if accept {
+		if err = addFollowers(c, db, actorIRI, recipients); err != nil {
+			return err
+		}
+	}
+	if err = db.RemoveFollowRequest(c, followIRI); err != nil {
+		return err
+	}
+	_, err = actor.Send(c, outboxIRI, response)
+	return err
+}
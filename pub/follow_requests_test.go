@@ -0,0 +1,81 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+// TestFollowRequests ensures pending Follows are accepted or rejected.
+func TestFollowRequests(t *testing.T) {
+	ctx := context.Background()
+	actorIRI := mustParse(testFederatedActorIRI)
+	outboxIRI := mustParse(testMyOutboxIRI)
+	followIRI := mustParse(testFederatedActivityIRI)
+	setupFn := func(ctl *gomock.Controller) (db *MockFollowRequestDatabase, a *MockFederatingActor) {
+		setupData()
+		db = NewMockFollowRequestDatabase(ctl)
+		a = NewMockFederatingActor(ctl)
+		db.EXPECT().Lock(ctx, outboxIRI)
+		db.EXPECT().ActorForOutbox(ctx, outboxIRI).Return(actorIRI, nil)
+		db.EXPECT().Unlock(ctx, outboxIRI)
+		return
+	}
+	t.Run("AcceptAddsFollowerAndSendsAccept", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, a := setupFn(ctl)
+		expectFollowers := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testFederatedActorIRI2))
+		expectFollowers.SetActivityStreamsItems(items)
+		// Mock
+		db.EXPECT().FollowRequests(ctx, actorIRI).Return([]vocab.ActivityStreamsFollow{testFollow}, nil)
+		db.EXPECT().Lock(ctx, actorIRI)
+		db.EXPECT().Followers(ctx, actorIRI).Return(streams.NewActivityStreamsCollection(), nil)
+		db.EXPECT().Update(ctx, expectFollowers)
+		db.EXPECT().Unlock(ctx, actorIRI)
+		db.EXPECT().RemoveFollowRequest(ctx, followIRI)
+		a.EXPECT().Send(ctx, outboxIRI, gomock.Any()).Do(func(c context.Context, outbox *url.URL, v vocab.Type) {
+			if !streams.IsOrExtendsActivityStreamsAccept(v) {
+				t.Fatalf("expected Accept, got %T", v)
+			}
+		})
+		// Run & Verify
+		err := AcceptFollowRequest(ctx, a, db, outboxIRI, followIRI)
+		assertEqual(t, err, nil)
+	})
+	t.Run("RejectSendsReject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, a := setupFn(ctl)
+		// Mock
+		db.EXPECT().FollowRequests(ctx, actorIRI).Return([]vocab.ActivityStreamsFollow{testFollow}, nil)
+		db.EXPECT().RemoveFollowRequest(ctx, followIRI)
+		a.EXPECT().Send(ctx, outboxIRI, gomock.Any()).Do(func(c context.Context, outbox *url.URL, v vocab.Type) {
+			if !streams.IsOrExtendsActivityStreamsReject(v) {
+				t.Fatalf("expected Reject, got %T", v)
+			}
+		})
+		// Run & Verify
+		err := RejectFollowRequest(ctx, a, db, outboxIRI, followIRI)
+		assertEqual(t, err, nil)
+	})
+	t.Run("ErrorIfNotPending", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, a := setupFn(ctl)
+		// Mock
+		db.EXPECT().FollowRequests(ctx, actorIRI).Return(nil, nil)
+		// Run & Verify
+		err := AcceptFollowRequest(ctx, a, db, outboxIRI, followIRI)
+		assertEqual(t, err, ErrFollowRequestNotFound)
+	})
+}
@@ -0,0 +1,152 @@
+package pub
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	// collectionSynchronizationHeader is the header carrying the digest of
+	// a followers collection, as described by FEP-8fcf.
+	collectionSynchronizationHeader = "Collection-Synchronization"
+)
+
+// CollectionSynchronization is the value of the Collection-Synchronization
+// header.
+type CollectionSynchronization struct {
+	// CollectionId is the followers collection of the sending actor.
+	CollectionId *url.URL
+	// URL is where the followers on the receiving server are fetched.
+	URL *url.URL
+	// Digest is the PartialFollowersDigest of the followers on the
+	// receiving server.
+	Digest string
+}
+
+// String formats the header value.
+func (s CollectionSynchronization) String() string {
+	return fmt.Sprintf("collectionId=%q, url=%q, digest=%q", s.CollectionId.String(), s.URL.String(), s.Digest)
+}
+
+// collectionSynchronizationParam matches a parameter of the
+// Collection-Synchronization header.
+var collectionSynchronizationParam = regexp.MustCompile(`([a-zA-Z]+)="([^"]*)"`)
+
+// ParseCollectionSynchronization parses the value of a
+// Collection-Synchronization header.
+func ParseCollectionSynchronization(v string) (s CollectionSynchronization, err error) {
+	params := make(map[string]string, 3)
+	for _, m := range collectionSynchronizationParam.FindAllStringSubmatch(v, -1) {
+		params[m[1]] = m[2]
+	}
+	for _, k := range []string{"collectionId", "url", "digest"} {
+		if len(params[k]) == 0 {
+			err = fmt.Errorf("Collection-Synchronization header is missing %s: %q", k, v)
+			return
+		}
+	}
+	if s.CollectionId, err = url.Parse(params["collectionId"]); err != nil {
+		return
+	}
+	if s.URL, err = url.Parse(params["url"]); err != nil {
+		return
+	}
+	s.Digest = strings.ToLower(params["digest"])
+	return
+}
+
+// PartialFollowers returns the followers whose IRI is on the host.
+func PartialFollowers(followers []*url.URL, host string) []*url.URL {
+	var partial []*url.URL
+	for _, f := range followers {
+		if f.Host == host {
+			partial = append(partial, f)
+		}
+	}
+	return partial
+}
+
+// PartialFollowersDigest returns the hex-encoded digest of the followers on the
+// host: the XOR of the SHA-256 hash of each follower's IRI.
+func PartialFollowersDigest(followers []*url.URL, host string) string {
+	var digest [sha256.Size]byte
+	for _, f := range PartialFollowers(followers, host) {
+		h := sha256.Sum256([]byte(f.String()))
+		for i := range digest {
+			digest[i] ^= h[i]
+		}
+	}
+	return hex.EncodeToString(digest[:])
+}
+
+// followersSynchronization is an actor's followers collection, from which the
+// Collection-Synchronization header is computed for each recipient.
+type followersSynchronization struct {
+	collectionId *url.URL
+	url          *url.URL
+	followers    []*url.URL
+}
+
+// header returns the Collection-Synchronization header value for a delivery to
+// the host.
+func (f *followersSynchronization) header(host string) string {
+	return CollectionSynchronization{
+		CollectionId: f.collectionId,
+		URL:          f.url,
+		Digest:       PartialFollowersDigest(f.followers, host),
+	}.String()
+}
+
+// followersSynchronizationKey is the context key of the
+// followersSynchronization of a delivery.
+type followersSynchronizationKey struct{}
+
+// withFollowersSynchronization returns a context whose deliveries carry the
+// Collection-Synchronization header.
+func withFollowersSynchronization(c context.Context, f *followersSynchronization) context.Context {
+	return context.WithValue(c, followersSynchronizationKey{}, f)
+}
+
+// followersSynchronizationFromContext returns the followersSynchronization
+// added by withFollowersSynchronization, or nil.
+func followersSynchronizationFromContext(c context.Context) *followersSynchronization {
+	f, _ := c.Value(followersSynchronizationKey{}).(*followersSynchronization)
+	return f
+}
+
+// collectionSynchronizationKey is the context key of the
+// Collection-Synchronization header received with an activity.
+type collectionSynchronizationKey struct{}
+
+// receivedCollectionSynchronization returns the Collection-Synchronization
+// header added to the context by PostInboxRequestBodyHook, if any.
+func receivedCollectionSynchronization(c context.Context) (s CollectionSynchronization, ok bool) {
+	s, ok = c.Value(collectionSynchronizationKey{}).(CollectionSynchronization)
+	return
+}
+
+// diffIRIs returns the IRIs only in a, and the IRIs only in b.
+func diffIRIs(a, b []*url.URL) (onlyA, onlyB []*url.URL) {
+	inA := make(map[string]bool, len(a))
+	for _, u := range a {
+		inA[u.String()] = true
+	}
+	inB := make(map[string]bool, len(b))
+	for _, u := range b {
+		inB[u.String()] = true
+		if !inA[u.String()] {
+			onlyB = append(onlyB, u)
+		}
+	}
+	for _, u := range a {
+		if !inB[u.String()] {
+			onlyA = append(onlyA, u)
+		}
+	}
+	return
+}
@@ -0,0 +1,56 @@
+package pub
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestPartialFollowersDigest(t *testing.T) {
+	followers := []*url.URL{
+		mustParse(testFederatedActorIRI),
+		mustParse(testFederatedActorIRI2),
+		mustParse(testPersonIRI),
+	}
+	t.Run("IsZeroWithoutFollowersOnHost", func(t *testing.T) {
+		d := PartialFollowersDigest(followers, "example.com")
+		assertEqual(t, d, strings.Repeat("0", 2*sha256.Size))
+	})
+	t.Run("IsHashOfOnlyFollowerOnHost", func(t *testing.T) {
+		h := sha256.Sum256([]byte(testPersonIRI))
+		d := PartialFollowersDigest(followers, "maybe.example.com")
+		assertEqual(t, d, hex.EncodeToString(h[:]))
+	})
+	t.Run("DoesNotDependOnOrder", func(t *testing.T) {
+		reversed := []*url.URL{followers[2], followers[1], followers[0]}
+		assertEqual(t, PartialFollowersDigest(reversed, "other.example.com"), PartialFollowersDigest(followers, "other.example.com"))
+		assertNotEqual(t, PartialFollowersDigest(followers, "other.example.com"), PartialFollowersDigest(followers[:1], "other.example.com"))
+	})
+}
+
+func TestParseCollectionSynchronization(t *testing.T) {
+	t.Run("ParsesHeader", func(t *testing.T) {
+		v := `collectionId="https://other.example.com/dakota/followers", url="https://other.example.com/dakota/followers_synchronization", digest="B08AB6"`
+		s, err := ParseCollectionSynchronization(v)
+		assertEqual(t, err, nil)
+		assertEqual(t, s.CollectionId.String(), "https://other.example.com/dakota/followers")
+		assertEqual(t, s.URL.String(), "https://other.example.com/dakota/followers_synchronization")
+		assertEqual(t, s.Digest, "b08ab6")
+	})
+	t.Run("ParsesString", func(t *testing.T) {
+		s := CollectionSynchronization{
+			CollectionId: mustParse("https://example.com/addison/followers"),
+			URL:          mustParse("https://example.com/addison/followers_synchronization"),
+			Digest:       "00ff",
+		}
+		got, err := ParseCollectionSynchronization(s.String())
+		assertEqual(t, err, nil)
+		assertEqual(t, got.String(), s.String())
+	})
+	t.Run("ReturnsErrorIfMissingDigest", func(t *testing.T) {
+		_, err := ParseCollectionSynchronization(`collectionId="https://example.com/addison/followers", url="https://example.com/sync"`)
+		assertNotEqual(t, err, nil)
+	})
+}
@@ -5,7 +5,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"math"
 	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// pageQuery is the query parameter selecting a page of a collection
+	// served by a handler.
+	pageQuery = "page"
 )
 
 // HandlerFunc determines whether an incoming HTTP request is an ActivityStreams
@@ -39,48 +49,267 @@ func NewActivityStreamsHandler(db Database, clock Clock) HandlerFunc {
 			return
 		}
 		isASRequest = true
-		id := requestId(r)
-		// Lock and obtain a copy of the requested ActivityStreams value
-		err = db.Lock(c, id)
-		if err != nil {
+		err = serveActivityStreams(c, w, db, clock, requestId(r))
+		return
+	}
+}
+
+// serveActivityStreams writes the ActivityStreams value with the id to the
+// ResponseWriter, without its sensitive fields.
+func serveActivityStreams(c context.Context, w http.ResponseWriter, db Database, clock Clock, id *url.URL) error {
+	// Lock and obtain a copy of the requested ActivityStreams value
+	err := db.Lock(c, id)
+	if err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred
+	t, err := db.Get(c, id)
+	if err != nil {
+		db.Unlock(c, id)
+		return err
+	}
+	db.Unlock(c, id)
+	// Unlock must have been called by this point and in every
+	// branch above
+	//
+	// Remove sensitive fields.
+	clearSensitiveFields(t)
+	code := http.StatusOK
+	if streams.IsOrExtendsActivityStreamsTombstone(t) {
+		code = http.StatusGone
+	}
+	return writeActivityStreams(w, clock, t, code)
+}
+
+// writeActivityStreams serializes the ActivityStreams value and writes it to
+// the ResponseWriter with the status code.
+func writeActivityStreams(w http.ResponseWriter, clock Clock, t vocab.Type, code int) error {
+	return writeActivityStreamsAs(w, clock, t, code, contentTypeHeaderValue)
+}
+
+// writeActivityStreamsAs serializes the ActivityStreams value and writes it to
+// the ResponseWriter with the status code and the Content-Type.
+func writeActivityStreamsAs(w http.ResponseWriter, clock Clock, t vocab.Type, code int, contentType string) error {
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	// Construct the response.
+	addResponseHeaders(w.Header(), clock, raw)
+	w.Header().Set(contentTypeHeader, contentType)
+	// Write the response.
+	w.WriteHeader(code)
+	n, err := w.Write(raw)
+	if err != nil {
+		return err
+	} else if n != len(raw) {
+		return fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+	}
+	return nil
+}
+
+// HTMLRenderer renders ActivityStreams values as webpages, for the requests of
+// browsers to a handler created by NewContentNegotiatingHandler.
+type HTMLRenderer interface {
+	// RenderHTML writes the webpage of the value to the ResponseWriter,
+	// including its status code. The value has already had its sensitive
+	// fields ('bto' and 'bcc') removed.
+	//
+	// If an error is returned, nothing must have been written to the
+	// ResponseWriter.
+	RenderHTML(c context.Context, w http.ResponseWriter, r *http.Request, t vocab.Type) error
+}
+
+// NewContentNegotiatingHandler creates a HandlerFunc serving the value with the
+// request IRI in the representation preferred by the request's Accept header,
+// which may be weighted.
+//
+// ActivityStreams requests are served with the Content-Type
+// 'application/activity+json' or 'application/ld+json' with the ActivityStreams
+// profile, matching the Accept header. Browsers preferring 'text/html' have the
+// value rendered by the HTMLRenderer, if it is not nil. Otherwise, the request
+// is not handled, so that the caller may serve its own webpage. Requests
+// accepting none of these receive a http.StatusNotAcceptable response.
+//
+// Values absent from the Database receive a http.StatusNotFound response, and
+// Tombstones a http.StatusGone response. Sensitive fields ('bto' and 'bcc')
+// are removed before responding.
+//
+// Callers are responsible for authorized access to this resource.
+func NewContentNegotiatingHandler(db Database, clock Clock, html HTMLRenderer) HandlerFunc {
+	offers := []string{contentTypeHeaderValue, activityJSONMediaType, htmlMediaType}
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		if r.Method != "GET" && r.Method != "HEAD" {
 			return
 		}
-		// WARNING: Unlock not deferred
-		t, err := db.Get(c, id)
-		if err != nil {
-			db.Unlock(c, id)
+		mediaType := negotiateMediaType(r.Header.Get(acceptHeader), offers)
+		if mediaType == htmlMediaType && html == nil {
 			return
 		}
-		db.Unlock(c, id)
-		// Unlock must have been called by this point and in every
-		// branch above
-		//
-		// Remove sensitive fields.
-		clearSensitiveFields(t)
-		// Serialize the fetched value.
-		m, err := streams.Serialize(t)
-		if err != nil {
+		isASRequest = true
+		w.Header().Add("Vary", acceptHeader)
+		if len(mediaType) == 0 {
+			w.WriteHeader(http.StatusNotAcceptable)
 			return
 		}
-		raw, err := json.Marshal(m)
+		t, err := getServable(c, db, requestId(r))
 		if err != nil {
 			return
+		} else if t == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
 		}
-		// Construct the response.
-		addResponseHeaders(w.Header(), clock, raw)
-		// Write the response.
+		if mediaType == htmlMediaType {
+			err = html.RenderHTML(c, w, r, t)
+			return
+		}
+		code := http.StatusOK
 		if streams.IsOrExtendsActivityStreamsTombstone(t) {
-			w.WriteHeader(http.StatusGone)
-		} else {
-			w.WriteHeader(http.StatusOK)
+			code = http.StatusGone
 		}
-		n, err := w.Write(raw)
-		if err != nil {
+		err = writeActivityStreamsAs(w, clock, t, code, mediaType)
+		return
+	}
+}
+
+// getServable returns the value with the id without its sensitive fields, or
+// nil if the Database does not have it.
+func getServable(c context.Context, db Database, id *url.URL) (vocab.Type, error) {
+	if err := db.Lock(c, id); err != nil {
+		return nil, err
+	}
+	defer db.Unlock(c, id)
+	if exists, err := db.Exists(c, id); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	t, err := db.Get(c, id)
+	if err != nil {
+		return nil, err
+	}
+	clearSensitiveFields(t)
+	return t, nil
+}
+
+// NewOutboxHandler creates a HandlerFunc to serve an outbox in pages of at
+// most pageSize items, retrieving only the items of the requested page from
+// the OutboxPager.
+//
+// Without a 'page' query parameter, the outbox is served as an
+// OrderedCollection with its 'totalItems' and links to its 'first' and 'last'
+// pages. With 'page=N', the Nth page counting from 1 is served as an
+// OrderedCollectionPage linking to its 'prev' and 'next' pages. Pages past the
+// last one are empty. An invalid 'page', or one so large that its items
+// cannot be counted, receives a http.StatusBadRequest response. A pageSize
+// less than one is treated as one.
+//
+// The outbox is identified by the request IRI without its query.
+//
+// Callers are responsible for authorized access to this resource.
+func NewOutboxHandler(db OutboxPager, clock Clock, pageSize int) HandlerFunc {
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		// Do nothing if it is not an ActivityPub GET request
+		if !isActivityPubGet(r) {
 			return
-		} else if n != len(raw) {
-			err = fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+		}
+		isASRequest = true
+		page := 0
+		if p := r.URL.Query().Get(pageQuery); len(p) > 0 {
+			if page, err = strconv.Atoi(p); err != nil || page < 1 || page-1 > math.MaxInt/pageSize {
+				err = nil
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		outboxIRI := *requestId(r)
+		outboxIRI.RawQuery = ""
+		t, err := outboxPage(c, db, &outboxIRI, page, pageSize)
+		if err != nil {
 			return
 		}
+		err = writeActivityStreams(w, clock, t, http.StatusOK)
 		return
 	}
 }
+
+// outboxPage returns the page of the outbox, or the outbox linking to its
+// pages when page is zero. Only the number of items is retrieved for the
+// outbox itself.
+func outboxPage(c context.Context, db OutboxPager, outboxIRI *url.URL, page, pageSize int) (vocab.Type, error) {
+	if err := db.Lock(c, outboxIRI); err != nil {
+		return nil, err
+	}
+	defer db.Unlock(c, outboxIRI)
+	if page == 0 {
+		_, total, err := db.OutboxItems(c, outboxIRI, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		return newPagedCollection(outboxIRI, total, pageSize), nil
+	}
+	items, total, err := db.OutboxItems(c, outboxIRI, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return newCollectionPage(outboxIRI, page, items, total, pageSize), nil
+}
+
+// pageIRI returns the IRI of the page of the collection.
+func pageIRI(collectionIRI *url.URL, page int) *url.URL {
+	u := *collectionIRI
+	u.RawQuery = url.Values{pageQuery: []string{strconv.Itoa(page)}}.Encode()
+	return &u
+}
+
+// newPagedCollection returns an OrderedCollection linking to its first and
+// last pages instead of containing its items.
+func newPagedCollection(collectionIRI *url.URL, total, pageSize int) vocab.ActivityStreamsOrderedCollection {
+	col := streams.NewActivityStreamsOrderedCollection()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(collectionIRI)
+	col.SetJSONLDId(id)
+	totalItems := streams.NewActivityStreamsTotalItemsProperty()
+	totalItems.Set(total)
+	col.SetActivityStreamsTotalItems(totalItems)
+	first := streams.NewActivityStreamsFirstProperty()
+	first.SetIRI(pageIRI(collectionIRI, 1))
+	col.SetActivityStreamsFirst(first)
+	lastPage := (total + pageSize - 1) / pageSize
+	if lastPage < 1 {
+		lastPage = 1
+	}
+	last := streams.NewActivityStreamsLastProperty()
+	last.SetIRI(pageIRI(collectionIRI, lastPage))
+	col.SetActivityStreamsLast(last)
+	return col
+}
+
+// newCollectionPage returns the page of the collection with its items.
+func newCollectionPage(collectionIRI *url.URL, page int, items []*url.URL, total, pageSize int) vocab.ActivityStreamsOrderedCollectionPage {
+	p := newBoxPage(pageIRI(collectionIRI, page), items)
+	partOf := streams.NewActivityStreamsPartOfProperty()
+	partOf.SetIRI(collectionIRI)
+	p.SetActivityStreamsPartOf(partOf)
+	startIndex := streams.NewActivityStreamsStartIndexProperty()
+	startIndex.Set((page - 1) * pageSize)
+	p.SetActivityStreamsStartIndex(startIndex)
+	if page > 1 {
+		prev := streams.NewActivityStreamsPrevProperty()
+		prev.SetIRI(pageIRI(collectionIRI, page-1))
+		p.SetActivityStreamsPrev(prev)
+	}
+	if total-(page-1)*pageSize > pageSize {
+		next := streams.NewActivityStreamsNextProperty()
+		next.SetIRI(pageIRI(collectionIRI, page+1))
+		p.SetActivityStreamsNext(next)
+	}
+	return p
+}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -103,3 +104,267 @@ func TestActivityStreamsHandler(t *testing.T) {
 		assertByteEqual(t, b, mustSerializeToBytes(testMyNote))
 	})
 }
+
+// TestContentNegotiatingHandler tests the handler serving values in the
+// representation the request accepts.
+func TestContentNegotiatingHandler(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, clock *MockClock, html *MockHTMLRenderer, hf HandlerFunc) {
+		setupData()
+		db = NewMockDatabase(ctl)
+		clock = NewMockClock(ctl)
+		html = NewMockHTMLRenderer(ctl)
+		hf = NewContentNegotiatingHandler(db, clock, html)
+		return
+	}
+	mockGetFn := func(db *MockDatabase) {
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		db.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil)
+		db.EXPECT().Get(ctx, mustParse(testNoteId1)).Return(testMyNote, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+	}
+	t.Run("ServesActivityJSON", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, clock, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(acceptHeader, "application/activity+json")
+		// Mock
+		mockGetFn(db)
+		clock.EXPECT().Now().Return(now())
+		// Run & Verify
+		handled, err := hf(ctx, resp, req)
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, resp.Header().Get(contentTypeHeader), "application/activity+json")
+		assertEqual(t, resp.Header().Get("Vary"), acceptHeader)
+		assertByteEqual(t, resp.Body.Bytes(), mustSerializeToBytes(testMyNote))
+	})
+	t.Run("ServesLDJSONWithProfile", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, clock, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(acceptHeader, `text/html;q=0.5, application/ld+json ; profile="https://www.w3.org/ns/activitystreams"`)
+		// Mock
+		mockGetFn(db)
+		clock.EXPECT().Now().Return(now())
+		// Run & Verify
+		handled, err := hf(ctx, resp, req)
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Header().Get(contentTypeHeader), contentTypeHeaderValue)
+	})
+	t.Run("RendersHTMLForBrowsers", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, html, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(acceptHeader, "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+		// Mock
+		mockGetFn(db)
+		html.EXPECT().RenderHTML(ctx, resp, req, testMyNote)
+		// Run & Verify
+		handled, err := hf(ctx, resp, req)
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotHandleBrowsersWithoutRenderer", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		hf := NewContentNegotiatingHandler(NewMockDatabase(ctl), NewMockClock(ctl), nil)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(acceptHeader, "text/html")
+		// Run & Verify
+		handled, err := hf(ctx, resp, req)
+		assertEqual(t, handled, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(resp.Result().Header), 0)
+	})
+	t.Run("RespondsNotAcceptable", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(acceptHeader, "image/png")
+		// Run & Verify
+		handled, err := hf(ctx, resp, req)
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusNotAcceptable)
+	})
+	t.Run("RespondsNotFound", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testNoteId1, nil))
+		// Mock
+		db.EXPECT().Lock(ctx, mustParse(testNoteId1))
+		db.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(false, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testNoteId1))
+		// Run & Verify
+		handled, err := hf(ctx, resp, req)
+		assertEqual(t, handled, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusNotFound)
+	})
+	t.Run("IgnoresPost", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("POST", testNoteId1, nil))
+		// Run & Verify
+		handled, err := hf(ctx, resp, req)
+		assertEqual(t, handled, false)
+		assertEqual(t, err, nil)
+	})
+}
+
+// TestOutboxHandler tests the handler serving an outbox in pages.
+func TestOutboxHandler(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (db *MockOutboxPager, clock *MockClock, hf HandlerFunc) {
+		setupData()
+		db = NewMockOutboxPager(ctl)
+		clock = NewMockClock(ctl)
+		hf = NewOutboxHandler(db, clock, 2)
+		return
+	}
+	t.Run("IgnoresIfNotActivityPubGetRequest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", testMyOutboxIRI, nil)
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(resp.Result().Header), 0)
+	})
+	t.Run("ServesCollectionWithFirstAndLastPages", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDb, mockClock, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testMyOutboxIRI, nil))
+		// Mock
+		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().OutboxItems(ctx, mustParse(testMyOutboxIRI), 0, 0).Return(nil, 3, nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		mockClock.EXPECT().Now().Return(now())
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+		b, err := ioutil.ReadAll(resp.Result().Body)
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, []byte(`{"@context":"https://www.w3.org/ns/activitystreams","first":"https://example.com/addison/outbox?page=1","id":"https://example.com/addison/outbox","last":"https://example.com/addison/outbox?page=2","totalItems":3,"type":"OrderedCollection"}`))
+	})
+	t.Run("ServesPage", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDb, mockClock, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testMyOutboxIRI+"?page=2", nil))
+		// Mock
+		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().OutboxItems(ctx, mustParse(testMyOutboxIRI), 2, 2).Return(
+			[]*url.URL{mustParse(testNewActivityIRI)}, 3, nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		mockClock.EXPECT().Now().Return(now())
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+		b, err := ioutil.ReadAll(resp.Result().Body)
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, []byte(`{"@context":"https://www.w3.org/ns/activitystreams","id":"https://example.com/addison/outbox?page=2","orderedItems":"https://example.com/new/1","partOf":"https://example.com/addison/outbox","prev":"https://example.com/addison/outbox?page=1","startIndex":2,"type":"OrderedCollectionPage"}`))
+	})
+	t.Run("BadRequestIfInvalidPage", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testMyOutboxIRI+"?page=0", nil))
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("BadRequestIfPageOffsetOverflows", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testMyOutboxIRI+"?page=4611686018427387905", nil))
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("ServesPagesOfOneIfPageSizeNotPositive", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDb, mockClock, _ := setupFn(ctl)
+		hf := NewOutboxHandler(mockDb, mockClock, 0)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testMyOutboxIRI, nil))
+		// Mock
+		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().OutboxItems(ctx, mustParse(testMyOutboxIRI), 0, 0).Return(nil, 3, nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		mockClock.EXPECT().Now().Return(now())
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+		b, err := ioutil.ReadAll(resp.Result().Body)
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, []byte(`{"@context":"https://www.w3.org/ns/activitystreams","first":"https://example.com/addison/outbox?page=1","id":"https://example.com/addison/outbox","last":"https://example.com/addison/outbox?page=3","totalItems":3,"type":"OrderedCollection"}`))
+	})
+	t.Run("ReturnsErrorWhenDatabaseReturnsError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDb, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(httptest.NewRequest("GET", testMyOutboxIRI+"?page=1", nil))
+		testErr := fmt.Errorf("test error")
+		// Mock
+		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().OutboxItems(ctx, mustParse(testMyOutboxIRI), 0, 2).Return(nil, 0, testErr)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, testErr)
+		assertEqual(t, len(resp.Result().Header), 0)
+	})
+}
@@ -0,0 +1,72 @@
+package pub
+
+import (
+	"sync"
+	"time"
+)
+
+// hostLimiter limits the rate of requests to or from each host, handing out
+// slots spaced by an interval, with bursts of up to burst requests.
+type hostLimiter struct {
+	clock    Clock
+	interval time.Duration
+	burst    int
+	mu       *sync.Mutex
+	// next is the next slot of a host, as measured by the clock, if there
+	// were no burst allowance.
+	next map[string]time.Time
+}
+
+// newHostLimiter returns a hostLimiter allowing perSecond requests per second
+// for each host, as measured by the clock, with bursts of up to burst
+// requests. A zero or negative perSecond does not limit, and a burst less than
+// one is treated as one.
+func newHostLimiter(clock Clock, perSecond float64, burst int) *hostLimiter {
+	var interval time.Duration
+	if perSecond > 0 {
+		interval = time.Duration(float64(time.Second) / perSecond)
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &hostLimiter{
+		clock:    clock,
+		interval: interval,
+		burst:    burst,
+		mu:       &sync.Mutex{},
+		next:     make(map[string]time.Time),
+	}
+}
+
+// reserve returns how long until the next available slot of the host, or zero
+// if it is available now. The slot is taken if it is available now, or if
+// queue is true, in which case the caller must wait until the slot.
+func (l *hostLimiter) reserve(host string, queue bool) time.Duration {
+	if l.interval <= 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clock.Now()
+	next, ok := l.next[host]
+	if !ok {
+		// Hosts whose slots are all in the past are the same as hosts
+		// never seen before, so forget them.
+		for h, t := range l.next {
+			if t.Before(now) {
+				delete(l.next, h)
+			}
+		}
+	}
+	if next.Before(now) {
+		next = now
+	}
+	wait := next.Sub(now) - time.Duration(l.burst-1)*l.interval
+	if wait < 0 {
+		wait = 0
+	}
+	if wait == 0 || queue {
+		l.next[host] = next.Add(l.interval)
+	}
+	return wait
+}
@@ -0,0 +1,152 @@
+package pub
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-fed/httpsig"
+)
+
+const (
+	// Hs2019 is the HTTP Signature algorithm identifier that does not name
+	// an algorithm, which is instead derived from the key identified by the
+	// keyId. Newer Mastodon and Pleroma versions send it, and may reject
+	// the legacy identifiers, such as "rsa-sha256", in strict modes.
+	Hs2019 = "hs2019"
+	// algorithmParameter is the HTTP Signature parameter naming the
+	// algorithm.
+	algorithmParameter = "algorithm"
+)
+
+// algorithmForKey returns the algorithm that signatures identified as "hs2019"
+// are created with by the key, as Mastodon does.
+func algorithmForKey(key interface{}) (httpsig.Algorithm, error) {
+	switch key.(type) {
+	case *rsa.PrivateKey, *rsa.PublicKey:
+		return httpsig.RSA_SHA256, nil
+	case ed25519.PrivateKey, ed25519.PublicKey:
+		return ed25519Algorithm, nil
+	default:
+		return "", fmt.Errorf("no hs2019 algorithm for key type %T", key)
+	}
+}
+
+// verifyingAlgorithm returns the algorithm verifying the signature of the
+// header with the public key. Signatures identified as "hs2019", or without an
+// algorithm, use the one derived from the key. Signatures identified with a
+// legacy algorithm must use one for the type of the key.
+func verifyingAlgorithm(h http.Header, pubKey crypto.PublicKey) (httpsig.Algorithm, error) {
+	derived, err := algorithmForKey(pubKey)
+	if err != nil {
+		return "", err
+	}
+	declared := signatureAlgorithm(h)
+	if len(declared) == 0 || declared == Hs2019 {
+		return derived, nil
+	}
+	if _, ok := pubKey.(*rsa.PublicKey); ok && strings.HasPrefix(declared, "rsa-") {
+		return httpsig.Algorithm(declared), nil
+	} else if declared == string(derived) {
+		return derived, nil
+	}
+	return "", fmt.Errorf("signature algorithm %q does not match key type %T", declared, pubKey)
+}
+
+// httpsig.Signer must be implemented by hs2019Signer.
+var _ httpsig.Signer = &hs2019Signer{}
+
+// hs2019Signer signs with the algorithm derived from the private key, and
+// identifies its signatures as "hs2019". It does not use the httpsig package,
+// which supports neither "hs2019" nor Ed25519 keys.
+type hs2019Signer struct {
+	dAlgo     httpsig.DigestAlgorithm
+	headers   []string
+	scheme    httpsig.SignatureScheme
+	clock     Clock
+	expiresIn time.Duration
+}
+
+// NewHs2019Signer returns a httpsig.Signer whose signatures are identified by
+// the "hs2019" algorithm. They are created with the algorithm derived from the
+// private key given to SignRequest and SignResponse: RSASSA-PKCS1-v1_5 with
+// SHA-256 for *rsa.PrivateKey, and Ed25519 for ed25519.PrivateKey.
+//
+// The other parameters are the same as for httpsig.NewSigner. Unlike the signers
+// it returns, the signer is safe for concurrent use.
+//
+// Its signatures cannot cover the (created) and (expires) pseudo-headers, which
+// require a signer created by NewHs2019SignerWithExpiry.
+func NewHs2019Signer(dAlgo httpsig.DigestAlgorithm, headers []string, scheme httpsig.SignatureScheme) httpsig.Signer {
+	return NewHs2019SignerWithExpiry(dAlgo, headers, scheme, nil, 0)
+}
+
+// NewHs2019SignerWithExpiry returns a httpsig.Signer like NewHs2019Signer,
+// whose signatures also have a created parameter set from the clock and, if
+// expiresIn is positive, an expires parameter that much later. The headers may
+// then include CreatedPseudoHeader and ExpiresPseudoHeader, so that the
+// signatures cannot be replayed once they expire or are older than the maximum
+// age accepted by verifiers.
+func NewHs2019SignerWithExpiry(dAlgo httpsig.DigestAlgorithm, headers []string, scheme httpsig.SignatureScheme, clock Clock, expiresIn time.Duration) httpsig.Signer {
+	return &hs2019Signer{
+		dAlgo:     dAlgo,
+		headers:   headers,
+		scheme:    scheme,
+		clock:     clock,
+		expiresIn: expiresIn,
+	}
+}
+
+// SignRequest signs the request with the algorithm derived from the private key.
+func (s *hs2019Signer) SignRequest(pKey crypto.PrivateKey, pubKeyId string, r *http.Request, body []byte) error {
+	return s.sign(pKey, pubKeyId, r.Header, r, body)
+}
+
+// SignResponse signs the response with the algorithm derived from the private
+// key.
+func (s *hs2019Signer) SignResponse(pKey crypto.PrivateKey, pubKeyId string, w http.ResponseWriter, body []byte) error {
+	return s.sign(pKey, pubKeyId, w.Header(), nil, body)
+}
+
+// sign sets the HTTP Signature of the header, adding the Digest of the body if
+// there is one. The request is nil when signing a response.
+func (s *hs2019Signer) sign(pKey crypto.PrivateKey, pubKeyId string, h http.Header, r *http.Request, body []byte) error {
+	if body != nil {
+		if err := addDigest(h, s.dAlgo, body); err != nil {
+			return err
+		}
+	}
+	headers := s.headers
+	if len(headers) == 0 {
+		headers = []string{"date"}
+	}
+	params := map[string]string{
+		keyIdParameter:     pubKeyId,
+		algorithmParameter: Hs2019,
+		headersParameter:   strings.ToLower(strings.Join(headers, " ")),
+	}
+	if s.clock != nil {
+		created := s.clock.Now()
+		params[createdParameter] = strconv.FormatInt(created.Unix(), 10)
+		if s.expiresIn > 0 {
+			params[expiresParameter] = strconv.FormatInt(created.Add(s.expiresIn).Unix(), 10)
+		}
+	}
+	str, err := signingString(h, headers, r, params)
+	if err != nil {
+		return err
+	}
+	sig, err := sign(pKey, str)
+	if err != nil {
+		return err
+	}
+	params[signatureParameter] = base64.StdEncoding.EncodeToString(sig)
+	setSignatureHeader(h, s.scheme, params)
+	return nil
+}
@@ -0,0 +1,121 @@
+package pub
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+)
+
+func TestHs2019Signer(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRequestFn := func() *http.Request {
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(dateHeader, now().UTC().Format(http.TimeFormat))
+		return req
+	}
+	t.Run("IdentifiesSignatureAsHs2019", func(t *testing.T) {
+		// Setup
+		req := newRequestFn()
+		s := NewHs2019Signer(httpsig.DigestSha256, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		// Run
+		err := s.SignRequest(priv, testFederatedKeyId, req, nil)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, signatureAlgorithm(req.Header), Hs2019)
+		v, err := httpsig.NewVerifier(req)
+		assertEqual(t, err, nil)
+		assertEqual(t, v.Verify(&priv.PublicKey, httpsig.RSA_SHA256), nil)
+	})
+	t.Run("IdentifiesAuthorizationAsHs2019", func(t *testing.T) {
+		// Setup
+		req := newRequestFn()
+		s := NewHs2019Signer(httpsig.DigestSha256, []string{httpsig.RequestTarget, "date"}, httpsig.Authorization)
+		// Run
+		err := s.SignRequest(priv, testFederatedKeyId, req, nil)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, signatureAlgorithm(req.Header), Hs2019)
+	})
+	t.Run("SignsResponse", func(t *testing.T) {
+		// Setup
+		resp := httptest.NewRecorder()
+		resp.Header().Set(dateHeader, now().UTC().Format(http.TimeFormat))
+		s := NewHs2019Signer(httpsig.DigestSha256, []string{"date"}, httpsig.Signature)
+		// Run
+		err := s.SignResponse(priv, testFederatedKeyId, resp, nil)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, signatureAlgorithm(resp.Header()), Hs2019)
+	})
+	t.Run("SetsCreatedAndExpires", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c := NewMockClock(ctl)
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		s := NewHs2019SignerWithExpiry(httpsig.DigestSha256, []string{httpsig.RequestTarget, CreatedPseudoHeader, ExpiresPseudoHeader}, httpsig.Signature, c, time.Minute)
+		// Mock
+		c.EXPECT().Now().Return(now())
+		// Run
+		err := s.SignRequest(priv, testFederatedKeyId, req, nil)
+		// Verify
+		assertEqual(t, err, nil)
+		_, params := signatureHeader(req.Header)
+		assertEqual(t, params[createdParameter], strconv.FormatInt(now().Unix(), 10))
+		assertEqual(t, params[expiresParameter], strconv.FormatInt(now().Add(time.Minute).Unix(), 10))
+		assertEqual(t, verifySignature(req.Header, req, &priv.PublicKey, httpsig.RSA_SHA256), nil)
+	})
+	t.Run("ErrorIfCreatedWithoutClock", func(t *testing.T) {
+		// Setup
+		s := NewHs2019Signer(httpsig.DigestSha256, []string{httpsig.RequestTarget, CreatedPseudoHeader}, httpsig.Signature)
+		// Run & Verify
+		err := s.SignRequest(priv, testFederatedKeyId, newRequestFn(), nil)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfKeyTypeUnsupported", func(t *testing.T) {
+		// Setup
+		s := NewHs2019Signer(httpsig.DigestSha256, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		// Run & Verify
+		err := s.SignRequest([]byte("secret"), testFederatedKeyId, newRequestFn(), nil)
+		assertNotEqual(t, err, nil)
+	})
+}
+
+func TestVerifyingAlgorithm(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		declared  string
+		expect    httpsig.Algorithm
+		expectErr bool
+	}{
+		{"Hs2019", Hs2019, httpsig.RSA_SHA256, false},
+		{"Absent", "", httpsig.RSA_SHA256, false},
+		{"Legacy", string(httpsig.RSA_SHA512), httpsig.RSA_SHA512, false},
+		{"OtherKeyType", "ed25519", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := make(http.Header)
+			if len(test.declared) > 0 {
+				h.Set(string(httpsig.Signature), `keyId="`+testFederatedKeyId+`",algorithm="`+test.declared+`",signature="abc"`)
+			}
+			algo, err := verifyingAlgorithm(h, &priv.PublicKey)
+			assertEqual(t, algo, test.expect)
+			assertEqual(t, err != nil, test.expectErr)
+		})
+	}
+}
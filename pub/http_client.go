@@ -0,0 +1,112 @@
+package pub
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// HttpClientConfig configures the HTTP client created by NewHttpClient, for use
+// by a HttpSigTransport or other HTTP requests to peers.
+//
+// Start from DefaultHttpClientConfig and change the fields that need to differ,
+// as the zero value disables redirects and sets no timeouts.
+type HttpClientConfig struct {
+	// Timeout bounds a whole request, including reading the response
+	// body. Zero means no timeout.
+	Timeout time.Duration
+	// DialTimeout bounds establishing a TCP connection. Zero means no
+	// timeout.
+	DialTimeout time.Duration
+	// TLSHandshakeTimeout bounds the TLS handshake. Zero means no timeout.
+	TLSHandshakeTimeout time.Duration
+	// ResponseHeaderTimeout bounds waiting for the response headers after
+	// the request is sent, for peers that accept connections but are slow
+	// to process activities. Zero means no timeout.
+	ResponseHeaderTimeout time.Duration
+	// IdleConnTimeout is how long an idle connection is kept for reuse.
+	// Zero means no limit.
+	IdleConnTimeout time.Duration
+	// MaxIdleConns limits the idle connections kept across all hosts.
+	// Zero means no limit.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost limits the idle connections kept for each host.
+	// Deliveries fan out to many actors on the same large instances, so it
+	// is higher than the standard library's default of 2. Zero means the
+	// standard library's default.
+	MaxIdleConnsPerHost int
+	// TLSConfig configures TLS, such as the trusted roots or the minimum
+	// version. Nil means the standard library's defaults.
+	TLSConfig *tls.Config
+	// MaxRedirects is the number of redirects followed for a request. Zero
+	// means redirects are not followed, and the redirect response is
+	// returned.
+	MaxRedirects int
+	// AllowInsecureRedirects follows redirects from https to http URLs,
+	// which are refused otherwise.
+	AllowInsecureRedirects bool
+}
+
+// DefaultHttpClientConfig returns the configuration suitable for federating
+// with peers: bounded timeouts so that unresponsive servers do not hold
+// connections, more idle connections per host to reuse during fan-outs, TLS 1.2
+// or later, and a few redirects followed only to https URLs.
+func DefaultHttpClientConfig() HttpClientConfig {
+	return HttpClientConfig{
+		Timeout:               30 * time.Second,
+		DialTimeout:           10 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 20 * time.Second,
+		IdleConnTimeout:       90 * time.Second,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   8,
+		TLSConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		},
+		MaxRedirects: 5,
+	}
+}
+
+// NewHttpClient returns a standard library HTTP client configured by the
+// config, which can be given to NewHttpSigTransport.
+//
+// Its requests use the proxy of the environment, like http.DefaultTransport.
+func NewHttpClient(cfg HttpClientConfig) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   cfg.DialTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			DialContext:           dialer.DialContext,
+			TLSClientConfig:       cfg.TLSConfig,
+			TLSHandshakeTimeout:   cfg.TLSHandshakeTimeout,
+			ResponseHeaderTimeout: cfg.ResponseHeaderTimeout,
+			IdleConnTimeout:       cfg.IdleConnTimeout,
+			MaxIdleConns:          cfg.MaxIdleConns,
+			MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+		},
+		CheckRedirect: redirectPolicy(cfg.MaxRedirects, cfg.AllowInsecureRedirects),
+		Timeout:       cfg.Timeout,
+	}
+}
+
+// redirectPolicy returns an http.Client CheckRedirect function following at
+// most max redirects, and only to https URLs from https URLs unless insecure.
+func redirectPolicy(max int, insecure bool) func(req *http.Request, via []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if len(via) > max {
+			if max == 0 {
+				return http.ErrUseLastResponse
+			}
+			return fmt.Errorf("stopped after %d redirects", max)
+		}
+		if !insecure && via[len(via)-1].URL.Scheme == "https" && req.URL.Scheme != "https" {
+			return fmt.Errorf("refusing insecure redirect from %s to %s", via[len(via)-1].URL, req.URL)
+		}
+		return nil
+	}
+}
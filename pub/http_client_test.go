@@ -0,0 +1,94 @@
+package pub
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewHttpClient(t *testing.T) {
+	newServerFn := func() *httptest.Server {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/loop", http.StatusFound)
+		})
+		mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/actor", http.StatusMovedPermanently)
+		})
+		mux.HandleFunc("/actor", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(100 * time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+		})
+		return httptest.NewServer(mux)
+	}
+	t.Run("FollowsRedirects", func(t *testing.T) {
+		// Setup
+		s := newServerFn()
+		defer s.Close()
+		client := NewHttpClient(DefaultHttpClientConfig())
+		// Run & Verify
+		resp, err := client.Get(s.URL + "/moved")
+		assertEqual(t, err, nil)
+		defer resp.Body.Close()
+		assertEqual(t, resp.StatusCode, http.StatusOK)
+	})
+	t.Run("StopsAfterMaxRedirects", func(t *testing.T) {
+		// Setup
+		s := newServerFn()
+		defer s.Close()
+		cfg := DefaultHttpClientConfig()
+		cfg.MaxRedirects = 2
+		client := NewHttpClient(cfg)
+		// Run & Verify
+		_, err := client.Get(s.URL + "/loop")
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ReturnsRedirectIfNotFollowing", func(t *testing.T) {
+		// Setup
+		s := newServerFn()
+		defer s.Close()
+		cfg := DefaultHttpClientConfig()
+		cfg.MaxRedirects = 0
+		client := NewHttpClient(cfg)
+		// Run & Verify
+		resp, err := client.Get(s.URL + "/moved")
+		assertEqual(t, err, nil)
+		defer resp.Body.Close()
+		assertEqual(t, resp.StatusCode, http.StatusMovedPermanently)
+	})
+	t.Run("TimesOut", func(t *testing.T) {
+		// Setup
+		s := newServerFn()
+		defer s.Close()
+		cfg := DefaultHttpClientConfig()
+		cfg.Timeout = 10 * time.Millisecond
+		client := NewHttpClient(cfg)
+		// Run & Verify
+		_, err := client.Get(s.URL + "/slow")
+		assertNotEqual(t, err, nil)
+	})
+}
+
+func TestRedirectPolicy(t *testing.T) {
+	newRequestFn := func(url string) *http.Request {
+		r, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	t.Run("RefusesInsecureRedirect", func(t *testing.T) {
+		check := redirectPolicy(5, false)
+		err := check(newRequestFn("http://other.example.com/dakota"), []*http.Request{newRequestFn(testFederatedActorIRI)})
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("AllowsInsecureRedirectIfConfigured", func(t *testing.T) {
+		check := redirectPolicy(5, true)
+		err := check(newRequestFn("http://other.example.com/dakota"), []*http.Request{newRequestFn(testFederatedActorIRI)})
+		assertEqual(t, err, nil)
+	})
+}
@@ -0,0 +1,274 @@
+package pub
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-fed/httpsig"
+)
+
+const (
+	// HTTP Signature parameters.
+	keyIdParameter     = "keyId"
+	headersParameter   = "headers"
+	signatureParameter = "signature"
+	createdParameter   = "created"
+	expiresParameter   = "expires"
+	// signatureAuthScheme is the auth-scheme of HTTP Signatures in the
+	// Authorization header.
+	signatureAuthScheme = "Signature"
+	// ed25519Algorithm is the legacy algorithm identifier of HTTP
+	// Signatures made with Ed25519 keys, which the httpsig package does not
+	// support.
+	ed25519Algorithm httpsig.Algorithm = "ed25519"
+	// CreatedPseudoHeader is the pseudo-header covering the time an HTTP
+	// Signature was created, given by its created parameter.
+	CreatedPseudoHeader = "(created)"
+	// ExpiresPseudoHeader is the pseudo-header covering the time an HTTP
+	// Signature expires, given by its expires parameter.
+	ExpiresPseudoHeader = "(expires)"
+)
+
+// signatureHeader returns the name of the header holding the HTTP Signature,
+// and its parameters.
+func signatureHeader(h http.Header) (name string, params map[string]string) {
+	name = string(httpsig.Signature)
+	s := h.Get(name)
+	if len(s) == 0 {
+		name = string(httpsig.Authorization)
+		s = strings.TrimPrefix(h.Get(name), signatureAuthScheme+" ")
+	}
+	params = make(map[string]string)
+	for _, p := range strings.Split(s, ",") {
+		kv := strings.SplitN(strings.TrimSpace(p), "=", 2)
+		if len(kv) == 2 {
+			params[kv[0]] = strings.Trim(kv[1], "\"")
+		}
+	}
+	return
+}
+
+// signatureAlgorithm returns the algorithm parameter of the HTTP Signature in
+// the Signature or Authorization header, or an empty string if it has none.
+func signatureAlgorithm(h http.Header) string {
+	_, params := signatureHeader(h)
+	return params[algorithmParameter]
+}
+
+// setSignatureHeader sets the HTTP Signature with the parameters in the header
+// of the scheme.
+func setSignatureHeader(h http.Header, scheme httpsig.SignatureScheme, params map[string]string) {
+	var b bytes.Buffer
+	if scheme == httpsig.Authorization {
+		b.WriteString(signatureAuthScheme + " ")
+	}
+	for i, name := range []string{keyIdParameter, algorithmParameter, createdParameter, expiresParameter, headersParameter, signatureParameter} {
+		v, ok := params[name]
+		if !ok {
+			continue
+		} else if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, "%s=%q", name, v)
+	}
+	h.Set(string(scheme), b.String())
+}
+
+// signingString returns the string signed by an HTTP Signature covering the
+// headers. The request is nil when signing a response, which cannot cover the
+// (request-target). The (created) and (expires) pseudo-headers are given by the
+// parameters of the signature.
+func signingString(h http.Header, headers []string, r *http.Request, params map[string]string) (string, error) {
+	if len(headers) == 0 {
+		headers = []string{"date"}
+	}
+	lines := make([]string, 0, len(headers))
+	for _, name := range headers {
+		name = strings.ToLower(name)
+		if name == httpsig.RequestTarget {
+			if r == nil {
+				return "", fmt.Errorf("cannot sign %q of a response", httpsig.RequestTarget)
+			}
+			target := r.URL.Path
+			if len(r.URL.RawQuery) > 0 {
+				target += "?" + r.URL.RawQuery
+			}
+			lines = append(lines, name+": "+strings.ToLower(r.Method)+" "+target)
+			continue
+		} else if name == CreatedPseudoHeader || name == ExpiresPseudoHeader {
+			v := params[strings.Trim(name, "()")]
+			if len(v) == 0 {
+				return "", fmt.Errorf("missing %s parameter for %q", strings.Trim(name, "()"), name)
+			}
+			lines = append(lines, name+": "+v)
+			continue
+		}
+		values, ok := h[http.CanonicalHeaderKey(name)]
+		if !ok {
+			return "", fmt.Errorf("missing header %q", name)
+		}
+		trimmed := make([]string, len(values))
+		for i, v := range values {
+			trimmed[i] = strings.TrimSpace(v)
+		}
+		lines = append(lines, name+": "+strings.Join(trimmed, ", "))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// sign signs the string with the private key, using RSASSA-PKCS1-v1_5 with
+// SHA-256 for RSA keys.
+func sign(privKey crypto.PrivateKey, s string) ([]byte, error) {
+	switch k := privKey.(type) {
+	case *rsa.PrivateKey:
+		h := sha256.Sum256([]byte(s))
+		return rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, h[:])
+	case ed25519.PrivateKey:
+		return ed25519.Sign(k, []byte(s)), nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", privKey)
+	}
+}
+
+// signatureHeaders returns the headers covered by the HTTP Signature, given its
+// parameters. Signatures without a headers parameter cover the Date header.
+func signatureHeaders(params map[string]string) []string {
+	if p := params[headersParameter]; len(p) > 0 {
+		return strings.Split(p, " ")
+	}
+	return []string{"date"}
+}
+
+// coversHeader returns true if the HTTP Signature, given its parameters, covers
+// the header.
+func coversHeader(params map[string]string, header string) bool {
+	for _, name := range signatureHeaders(params) {
+		if strings.EqualFold(name, header) {
+			return true
+		}
+	}
+	return false
+}
+
+// coversPseudoHeaders returns true if the HTTP Signature, given its parameters,
+// covers the (created) or (expires) pseudo-headers, which the httpsig package
+// does not support.
+func coversPseudoHeaders(params map[string]string) bool {
+	return coversHeader(params, CreatedPseudoHeader) || coversHeader(params, ExpiresPseudoHeader)
+}
+
+// parseSignatureTime parses the created or expires parameter of an HTTP
+// Signature, a Unix timestamp with optional decimal fractions of a second.
+func parseSignatureTime(s string) (time.Time, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid signature timestamp %q: %s", s, err)
+	}
+	sec := math.Floor(f)
+	return time.Unix(int64(sec), int64((f-sec)*float64(time.Second))), nil
+}
+
+// verifySignature verifies the HTTP Signature of the header with the public
+// key, for the Ed25519 and the RSASSA-PKCS1-v1_5 with SHA-256 or SHA-512
+// algorithms. The request is nil when verifying a response.
+func verifySignature(h http.Header, r *http.Request, pubKey crypto.PublicKey, algo httpsig.Algorithm) error {
+	_, params := signatureHeader(h)
+	s, err := signingString(h, signatureHeaders(params), r, params)
+	if err != nil {
+		return err
+	}
+	sig, err := base64.StdEncoding.DecodeString(params[signatureParameter])
+	if err != nil {
+		return err
+	}
+	switch k := pubKey.(type) {
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, []byte(s), sig) {
+			return fmt.Errorf("invalid Ed25519 http signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		var hash crypto.Hash
+		switch algo {
+		case httpsig.RSA_SHA256:
+			hash = crypto.SHA256
+		case httpsig.RSA_SHA512:
+			hash = crypto.SHA512
+		default:
+			return fmt.Errorf("unsupported algorithm %q", algo)
+		}
+		d := hash.New()
+		d.Write([]byte(s))
+		return rsa.VerifyPKCS1v15(k, hash, d.Sum(nil), sig)
+	default:
+		return fmt.Errorf("unsupported public key type %T", pubKey)
+	}
+}
+
+// digestHash returns the hash of the Digest algorithm, which is SHA-256 or
+// SHA-512.
+func digestHash(algo httpsig.DigestAlgorithm) (crypto.Hash, error) {
+	switch httpsig.DigestAlgorithm(strings.ToUpper(string(algo))) {
+	case httpsig.DigestSha256:
+		return crypto.SHA256, nil
+	case httpsig.DigestSha512:
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("unsupported Digest algorithm %q", algo)
+	}
+}
+
+// addDigest sets the Digest header of the body, computed with the algorithm.
+func addDigest(h http.Header, algo httpsig.DigestAlgorithm, body []byte) error {
+	hash, err := digestHash(algo)
+	if err != nil {
+		return err
+	}
+	d := hash.New()
+	d.Write(body)
+	h.Set(digestHeader, strings.ToUpper(string(algo))+digestDelimiter+base64.StdEncoding.EncodeToString(d.Sum(nil)))
+	return nil
+}
+
+// verifyDigest verifies the body against the Digest header, which may list
+// several digests. Those with unsupported algorithms are ignored, but at least
+// one must be supported, and all supported ones must match.
+func verifyDigest(h http.Header, body []byte) error {
+	values := h[digestHeader]
+	if len(values) == 0 {
+		return fmt.Errorf("missing Digest header")
+	}
+	var verified bool
+	for _, v := range strings.Split(strings.Join(values, ","), ",") {
+		kv := strings.SplitN(strings.TrimSpace(v), digestDelimiter, 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("malformed Digest %q", v)
+		}
+		hash, err := digestHash(httpsig.DigestAlgorithm(kv[0]))
+		if err != nil {
+			continue
+		}
+		d := hash.New()
+		d.Write(body)
+		if base64.StdEncoding.EncodeToString(d.Sum(nil)) != kv[1] {
+			return fmt.Errorf("%s Digest does not match the body", kv[0])
+		}
+		verified = true
+	}
+	if !verified {
+		return fmt.Errorf("no supported algorithm in Digest %q", strings.Join(values, ","))
+	}
+	return nil
+}
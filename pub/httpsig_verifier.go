@@ -0,0 +1,395 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/go-fed/httpsig"
+)
+
+// DefaultMaxDateSkew is the amount of time the Date header of a request may
+// differ from the server's clock for a HttpSigVerifier to accept it, unless
+// configured otherwise.
+const DefaultMaxDateSkew = 5 * time.Minute
+
+// DefaultMaxSignatureAge is the amount of time after the created parameter of
+// an HTTP Signature that a HttpSigVerifier accepts it, unless configured
+// otherwise.
+const DefaultMaxSignatureAge = time.Hour
+
+// HttpSigVerifierConfig configures the times of requests a HttpSigVerifier
+// accepts.
+//
+// Start from DefaultHttpSigVerifierConfig and change the fields that need to
+// differ, as the zero value rejects most requests.
+type HttpSigVerifierConfig struct {
+	// MaxAge is the amount of time after the created parameter of a
+	// signature that it is accepted.
+	MaxAge time.Duration
+	// MaxSkew is the amount of time the server's clock may differ from
+	// those of peers. The Date header of a request may be this far from
+	// the clock, its signature created this far in the future, and
+	// accepted this long after it expires. Clock drift between servers is
+	// the most common cause of rejected signatures, so a larger skew
+	// tolerates peers with inaccurate clocks, at the cost of a longer
+	// window for replaying requests.
+	MaxSkew time.Duration
+}
+
+// DefaultHttpSigVerifierConfig returns the configuration accepting signatures
+// created up to DefaultMaxSignatureAge ago, from peers whose clocks are within
+// DefaultMaxDateSkew of the server's.
+func DefaultHttpSigVerifierConfig() HttpSigVerifierConfig {
+	return HttpSigVerifierConfig{
+		MaxAge:  DefaultMaxSignatureAge,
+		MaxSkew: DefaultMaxDateSkew,
+	}
+}
+
+// RequestVerifier must be implemented by HttpSigVerifier.
+var _ RequestVerifier = &HttpSigVerifier{}
+
+// HttpSigVerifier verifies the HTTP Signature of requests with the public key
+// identified by the signature's keyId, resolved by a KeyResolver.
+//
+// A signature that fails to verify is checked again with the key fetched anew,
+// in case its actor rotated the key since it was cached.
+type HttpSigVerifier struct {
+	resolver KeyResolver
+	clock    Clock
+	cfg      HttpSigVerifierConfig
+}
+
+// NewHttpSigVerifier returns a HttpSigVerifier fetching public keys with the
+// Transport and checking the times of requests against the clock, as
+// configured by DefaultHttpSigVerifierConfig.
+//
+// The keyId is dereferenced on every request, so the Transport is usually
+// wrapped by a CachingTransport, unless a CachingKeyResolver is given to
+// NewHttpSigVerifierWithKeyResolver instead.
+//
+// Peers that serve their keys only to authorized fetches require the
+// Transport to sign its requests, usually as the server's own actor.
+func NewHttpSigVerifier(t Transport, clock Clock) *HttpSigVerifier {
+	return NewHttpSigVerifierWithConfig(t, clock, DefaultHttpSigVerifierConfig())
+}
+
+// NewHttpSigVerifierWithConfig returns a HttpSigVerifier like
+// NewHttpSigVerifier, accepting the times of requests allowed by the config.
+func NewHttpSigVerifierWithConfig(t Transport, clock Clock, cfg HttpSigVerifierConfig) *HttpSigVerifier {
+	return NewHttpSigVerifierWithKeyResolver(NewCachingKeyResolver(t, nil), clock, cfg)
+}
+
+// NewHttpSigVerifierWithKeyResolver returns a HttpSigVerifier like
+// NewHttpSigVerifierWithConfig, resolving public keys with the KeyResolver.
+func NewHttpSigVerifierWithKeyResolver(r KeyResolver, clock Clock, cfg HttpSigVerifierConfig) *HttpSigVerifier {
+	return &HttpSigVerifier{
+		resolver: r,
+		clock:    clock,
+		cfg:      cfg,
+	}
+}
+
+// Verify returns the owner of the public key that produced the request's HTTP
+// Signature.
+//
+// The request's Date header must be within the maximum skew of the clock. It
+// may be omitted if the signature has a created parameter, which must be no
+// older than the maximum age. A signature with an expires parameter is
+// rejected once it expires, give or take the maximum skew. Both parameters
+// may be covered by the (created) and (expires) pseudo-headers.
+//
+// Signatures identified as "hs2019" are verified with the algorithm derived
+// from the key. RSA and Ed25519 keys are supported.
+//
+// A request with a body must have a Digest header covered by the signature,
+// with a SHA-256 or SHA-512 digest matching the body. The body is read, and
+// replaced so that it can be read again. A rejected signature is logged with
+// the context's Logger.
+//
+// A body longer than the MaxBodyBytes of the InboxLimits is not logged. Its
+// error is returned unchanged, so that PostInbox responds with
+// http.StatusRequestEntityTooLarge when AuthenticatePostInbox returns it.
+func (h *HttpSigVerifier) Verify(c context.Context, r *http.Request) (actor *url.URL, err error) {
+	defer func() {
+		if err != nil && !errors.Is(err, errBodyTooLarge) {
+			logEvent(c, LogWarn, "rejected HTTP Signature", "url", r.URL.String(), "error", err)
+		}
+	}()
+	v, err := httpsig.NewVerifier(r)
+	if err != nil {
+		return
+	}
+	_, params := signatureHeader(r.Header)
+	if err = h.checkTimes(r.Header, params); err != nil {
+		return
+	} else if err = verifyRequestDigest(r, params); err != nil {
+		return
+	}
+	keyId, err := url.Parse(v.KeyId())
+	if err != nil {
+		return
+	}
+	owner, pubKey, err := h.resolver.Resolve(c, keyId)
+	if err != nil {
+		return
+	}
+	if err = verifyWithKey(r, v, params, pubKey); err != nil && !isForceRefresh(c) {
+		logEvent(c, LogDebug, "fetching the public key again after a failed HTTP Signature", "keyId", keyId.String(), "error", err)
+		if owner, pubKey, err = h.resolver.Resolve(WithForceRefresh(c), keyId); err != nil {
+			return
+		}
+		err = verifyWithKey(r, v, params, pubKey)
+	}
+	if err != nil {
+		return
+	}
+	actor = owner
+	return
+}
+
+// verifyWithKey verifies the HTTP Signature of the request, given its
+// parameters, with the public key.
+func verifyWithKey(r *http.Request, v httpsig.Verifier, params map[string]string, pubKey crypto.PublicKey) error {
+	algo, err := verifyingAlgorithm(r.Header, pubKey)
+	if err != nil {
+		return err
+	}
+	if _, ok := pubKey.(ed25519.PublicKey); ok || coversPseudoHeaders(params) {
+		return verifySignature(r.Header, r, pubKey, algo)
+	}
+	return v.Verify(pubKey, algo)
+}
+
+// checkTimes checks the Date header, and the created and expires parameters of
+// the signature, against the clock.
+func (h *HttpSigVerifier) checkTimes(hdr http.Header, params map[string]string) error {
+	now := h.clock.Now()
+	created, hasCreated := params[createdParameter]
+	if d := hdr.Get(dateHeader); len(d) > 0 || !hasCreated {
+		date, err := http.ParseTime(d)
+		if err != nil {
+			return fmt.Errorf("invalid Date header: %s", err)
+		}
+		if skew := now.Sub(date); skew > h.cfg.MaxSkew || skew < -h.cfg.MaxSkew {
+			return fmt.Errorf("Date header is %s away from now", skew)
+		}
+	}
+	if hasCreated {
+		t, err := parseSignatureTime(created)
+		if err != nil {
+			return err
+		}
+		if age := now.Sub(t); age > h.cfg.MaxAge {
+			return fmt.Errorf("signature was created %s ago", age)
+		} else if age < -h.cfg.MaxSkew {
+			return fmt.Errorf("signature is created %s in the future", -age)
+		}
+	}
+	if expires, ok := params[expiresParameter]; ok {
+		t, err := parseSignatureTime(expires)
+		if err != nil {
+			return err
+		}
+		if now.Sub(t) > h.cfg.MaxSkew {
+			return fmt.Errorf("signature expired at %s", t.UTC().Format(http.TimeFormat))
+		}
+	}
+	return nil
+}
+
+// verifyRequestDigest verifies the Digest header against the body of the
+// request, which it replaces with a reader of the same content. A request with
+// a body must have a Digest header covered by the signature, given its
+// parameters.
+func verifyRequestDigest(r *http.Request, params map[string]string) error {
+	if r.Body == nil {
+		return nil
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return err
+	} else if len(body) == 0 && len(r.Header[digestHeader]) == 0 {
+		return nil
+	} else if !coversHeader(params, digestHeader) {
+		return fmt.Errorf("Digest header is not covered by the signature")
+	}
+	return verifyDigest(r.Header, body)
+}
+
+// signerKey is the context key of the actor whose key signed a request.
+type signerKey struct{}
+
+// WithSigner returns a context carrying the actor whose key signed the request,
+// such as the actor returned by a RequestVerifier. AuthenticatePostInbox
+// implementations return it so that side effects, such as those of an Update,
+// can check the activity was sent by its actor.
+func WithSigner(c context.Context, actorIRI *url.URL) context.Context {
+	return context.WithValue(c, signerKey{}, actorIRI)
+}
+
+// SignerFromContext returns the actor added to the context by WithSigner.
+func SignerFromContext(c context.Context) (actorIRI *url.URL, ok bool) {
+	actorIRI, ok = c.Value(signerKey{}).(*url.URL)
+	return
+}
+
+// fetchPublicKey dereferences the keyId and returns the public key and its
+// owner.
+//
+// The keyId may identify a standalone key, a key embedded in its owner's
+// 'publicKey' property, or a Multikey, standalone or in its owner's
+// 'assertionMethod' property.
+//
+// The owner claimed by the key must be on the keyId's host, or else list the
+// keyId itself, so that a key cannot claim to be owned by an actor of another
+// server.
+func fetchPublicKey(c context.Context, t Transport, keyId *url.URL) (owner *url.URL, pubKey crypto.PublicKey, err error) {
+	if owner, pubKey, err = findPublicKey(c, t, keyId); err != nil {
+		return
+	}
+	if err = confirmKeyOwner(c, t, keyId, owner); err != nil {
+		return nil, nil, err
+	}
+	return
+}
+
+// confirmKeyOwner checks that the owner claimed by the public key with the
+// keyId lists the key in its 'publicKey' or 'assertionMethod' properties.
+//
+// An owner on the same host as the keyId is not dereferenced, since the server
+// serving the key is also the one serving its owner.
+func confirmKeyOwner(c context.Context, t Transport, keyId, owner *url.URL) error {
+	if owner.Scheme == keyId.Scheme && owner.Host == keyId.Host {
+		return nil
+	}
+	b, err := t.Dereference(c, owner)
+	if err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	v, err := streams.ToType(c, m)
+	if err != nil {
+		return err
+	}
+	if id := streams.GetId(v); id == nil || id.String() != owner.String() {
+		return fmt.Errorf("owner %s of public key %s is not at its id", owner, keyId)
+	}
+	if u, ok := v.(unknownPropertieser); ok {
+		// Malformed Multikeys cannot list the keyId, so they are ignored.
+		keys, _ := GetAssertionMethods(u)
+		for _, k := range keys {
+			if k.ID.String() == keyId.String() {
+				return nil
+			}
+		}
+	}
+	if pk, ok := v.(publicKeyer); ok && pk.GetW3IDSecurityV1PublicKey() != nil {
+		for iter := pk.GetW3IDSecurityV1PublicKey().Begin(); iter != pk.GetW3IDSecurityV1PublicKey().End(); iter = iter.Next() {
+			var id *url.URL
+			if iter.IsIRI() {
+				id = iter.GetIRI()
+			} else if iter.IsW3IDSecurityV1PublicKey() && iter.Get().GetJSONLDId() != nil {
+				id = iter.Get().GetJSONLDId().Get()
+			}
+			if id != nil && id.String() == keyId.String() {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("owner %s of public key %s does not list it", owner, keyId)
+}
+
+// findPublicKey dereferences the keyId and returns the public key and the
+// owner it claims, as fetchPublicKey does without confirming the owner.
+func findPublicKey(c context.Context, t Transport, keyId *url.URL) (owner *url.URL, pubKey crypto.PublicKey, err error) {
+	b, err := t.Dereference(c, keyId)
+	if err != nil {
+		return
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return
+	}
+	if t, _ := m["type"].(string); t == multikeyType {
+		var k Multikey
+		if k, err = parseMultikey(m); err != nil {
+			return
+		}
+		return k.Controller, k.PublicKey, nil
+	}
+	v, err := streams.ToType(c, m)
+	if err != nil {
+		return
+	}
+	if u, ok := v.(unknownPropertieser); ok {
+		var keys []Multikey
+		if keys, err = GetAssertionMethods(u); err != nil {
+			return
+		}
+		for _, k := range keys {
+			if k.ID.String() == keyId.String() {
+				return k.Controller, k.PublicKey, nil
+			}
+		}
+	}
+	var key vocab.W3IDSecurityV1PublicKey
+	if k, ok := v.(vocab.W3IDSecurityV1PublicKey); ok {
+		key = k
+	} else if pk, ok := v.(publicKeyer); ok && pk.GetW3IDSecurityV1PublicKey() != nil {
+		for iter := pk.GetW3IDSecurityV1PublicKey().Begin(); iter != pk.GetW3IDSecurityV1PublicKey().End(); iter = iter.Next() {
+			if !iter.IsW3IDSecurityV1PublicKey() {
+				continue
+			}
+			id := iter.Get().GetJSONLDId()
+			if id != nil && id.Get() != nil && id.Get().String() == keyId.String() {
+				key = iter.Get()
+				break
+			}
+		}
+	}
+	if key == nil {
+		err = fmt.Errorf("no public key %s in the dereferenced value", keyId)
+		return
+	}
+	if key.GetW3IDSecurityV1Owner() == nil || key.GetW3IDSecurityV1Owner().Get() == nil {
+		err = fmt.Errorf("public key %s has no owner", keyId)
+		return
+	}
+	owner = key.GetW3IDSecurityV1Owner().Get()
+	if key.GetW3IDSecurityV1PublicKeyPem() == nil {
+		err = fmt.Errorf("public key %s has no publicKeyPem", keyId)
+		return
+	}
+	pubKey, err = parsePublicKeyPEM(key.GetW3IDSecurityV1PublicKeyPem().Get())
+	return
+}
+
+// parsePublicKeyPEM parses a PKIX or PKCS #1 encoded public key.
+func parsePublicKeyPEM(s string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, fmt.Errorf("publicKeyPem is not PEM encoded")
+	}
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
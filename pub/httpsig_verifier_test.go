@@ -0,0 +1,511 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+)
+
+const testFederatedKeyId = testFederatedActorIRI + "#main-key"
+
+// mustNewTestKeyPerson returns a serialized Person with the public key of the
+// private key.
+func mustNewTestKeyPerson(priv *rsa.PrivateKey) []byte {
+	return mustNewTestKeyPersonWithOwner(priv, testFederatedActorIRI, testFederatedKeyId, testFederatedActorIRI)
+}
+
+// mustNewTestKeyPersonWithOwner returns a serialized Person with the id, and
+// with the public key of the private key, which has the keyId and claims to be
+// owned by the owner.
+func mustNewTestKeyPersonWithOwner(priv *rsa.PrivateKey, actorIRI, keyId, ownerIRI string) []byte {
+	b, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	if err != nil {
+		panic(err)
+	}
+	person := streams.NewActivityStreamsPerson()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse(actorIRI))
+	person.SetJSONLDId(id)
+	pk := streams.NewW3IDSecurityV1PublicKey()
+	pkId := streams.NewJSONLDIdProperty()
+	pkId.Set(mustParse(keyId))
+	pk.SetJSONLDId(pkId)
+	owner := streams.NewW3IDSecurityV1OwnerProperty()
+	owner.Set(mustParse(ownerIRI))
+	pk.SetW3IDSecurityV1Owner(owner)
+	pemProp := streams.NewW3IDSecurityV1PublicKeyPemProperty()
+	pemProp.Set(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b})))
+	pk.SetW3IDSecurityV1PublicKeyPem(pemProp)
+	pkProp := streams.NewW3IDSecurityV1PublicKeyProperty()
+	pkProp.AppendW3IDSecurityV1PublicKey(pk)
+	person.SetW3IDSecurityV1PublicKey(pkProp)
+	return mustSerializeToBytes(person)
+}
+
+// mustNewTestMultikeyPerson returns a serialized Person with the public key in
+// its 'assertionMethod'.
+func mustNewTestMultikeyPerson(pubKey crypto.PublicKey) []byte {
+	person := streams.NewActivityStreamsPerson()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse(testFederatedActorIRI))
+	person.SetJSONLDId(id)
+	err := SetAssertionMethods(person, []Multikey{{
+		ID:         mustParse(testFederatedKeyId),
+		Controller: mustParse(testFederatedActorIRI),
+		PublicKey:  pubKey,
+	}})
+	if err != nil {
+		panic(err)
+	}
+	return mustSerializeToBytes(person)
+}
+
+// mustNewTestSignedRequest returns a GET request signed by the private key.
+func mustNewTestSignedRequest(priv *rsa.PrivateKey, date time.Time) *http.Request {
+	return mustNewTestSignedRequestWithKeyId(priv, testFederatedKeyId, date)
+}
+
+// mustNewTestSignedRequestWithKeyId returns a GET request signed by the private
+// key, identified by the keyId.
+func mustNewTestSignedRequestWithKeyId(priv *rsa.PrivateKey, keyId string, date time.Time) *http.Request {
+	req := httptest.NewRequest("GET", testNoteId1, nil)
+	req.Header.Set(dateHeader, date.UTC().Format(http.TimeFormat))
+	s, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, httpsig.DigestSha256, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+	if err != nil {
+		panic(err)
+	}
+	if err = s.SignRequest(priv, keyId, req, nil); err != nil {
+		panic(err)
+	}
+	return req
+}
+
+func TestHttpSigVerifier(t *testing.T) {
+	ctx := context.Background()
+	priv, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPub, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setupFn := func(ctl *gomock.Controller) (tp *MockTransport, c *MockClock, v *HttpSigVerifier) {
+		tp = NewMockTransport(ctl)
+		c = NewMockClock(ctl)
+		v = NewHttpSigVerifier(tp, c)
+		return
+	}
+	newDigestRequestFn := func(algo httpsig.DigestAlgorithm, headers []string, body []byte) *http.Request {
+		req := httptest.NewRequest("POST", testMyInboxIRI, bytes.NewReader(body))
+		req.Header.Set(dateHeader, now().UTC().Format(http.TimeFormat))
+		s := NewHs2019Signer(algo, headers, httpsig.Signature)
+		if err := s.SignRequest(priv, testFederatedKeyId, req, body); err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+	newExpiringRequestFn := func(ctl *gomock.Controller, created time.Time) *http.Request {
+		c := NewMockClock(ctl)
+		c.EXPECT().Now().Return(created)
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		s := NewHs2019SignerWithExpiry(httpsig.DigestSha256, []string{httpsig.RequestTarget, CreatedPseudoHeader, ExpiresPseudoHeader}, httpsig.Signature, c, 2*DefaultMaxDateSkew)
+		if err := s.SignRequest(priv, testFederatedKeyId, req, nil); err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+	t.Run("ReturnsKeyOwner", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		req := mustNewTestSignedRequest(priv, now())
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+		// Run & Verify
+		actor, err := v.Verify(ctx, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testFederatedActorIRI)
+	})
+	t.Run("VerifiesHs2019Signature", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(dateHeader, now().UTC().Format(http.TimeFormat))
+		s := NewHs2019Signer(httpsig.DigestSha256, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		if err := s.SignRequest(priv, testFederatedKeyId, req, nil); err != nil {
+			t.Fatal(err)
+		}
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+		// Run & Verify
+		actor, err := v.Verify(ctx, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testFederatedActorIRI)
+	})
+	t.Run("VerifiesEd25519Multikey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(dateHeader, now().UTC().Format(http.TimeFormat))
+		s := NewHs2019Signer(httpsig.DigestSha256, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		if err := s.SignRequest(edPriv, testFederatedKeyId, req, nil); err != nil {
+			t.Fatal(err)
+		}
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestMultikeyPerson(edPub), nil)
+		// Run & Verify
+		actor, err := v.Verify(ctx, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testFederatedActorIRI)
+	})
+	t.Run("ErrorIfEd25519SignedByOtherKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		_, otherEdPriv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set(dateHeader, now().UTC().Format(http.TimeFormat))
+		s := NewHs2019Signer(httpsig.DigestSha256, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		if err := s.SignRequest(otherEdPriv, testFederatedKeyId, req, nil); err != nil {
+			t.Fatal(err)
+		}
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(gomock.Any(), mustParse(testFederatedKeyId)).Return(mustNewTestMultikeyPerson(edPub), nil).Times(2)
+		// Run & Verify
+		actor, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+		assertEqual(t, actor == nil, true)
+	})
+	t.Run("ErrorIfAlgorithmDoesNotMatchKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		req := mustNewTestSignedRequest(priv, now())
+		sig := req.Header.Get(string(httpsig.Signature))
+		req.Header.Set(string(httpsig.Signature), strings.Replace(sig, `algorithm="rsa-sha256"`, `algorithm="ed25519"`, 1))
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(gomock.Any(), mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil).Times(2)
+		// Run & Verify
+		actor, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+		assertEqual(t, actor == nil, true)
+	})
+	t.Run("ErrorIfSignedByOtherKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		req := mustNewTestSignedRequest(other, now())
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(gomock.Any(), mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil).Times(2)
+		// Run & Verify
+		actor, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+		assertEqual(t, actor == nil, true)
+	})
+	t.Run("FetchesRotatedKeyAgain", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		req := mustNewTestSignedRequest(priv, now())
+		// Mock
+		c.EXPECT().Now().Return(now())
+		first := tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(other), nil)
+		tp.EXPECT().Dereference(WithForceRefresh(ctx), mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil).After(first)
+		// Run & Verify
+		actor, err := v.Verify(ctx, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testFederatedActorIRI)
+	})
+	t.Run("ErrorIfKeyOwnerOnOtherHostDoesNotListKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		const spoofedKeyId = "https://evil.example/mallory#main-key"
+		req := mustNewTestSignedRequestWithKeyId(priv, spoofedKeyId, now())
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(ctx, mustParse(spoofedKeyId)).Return(mustNewTestKeyPersonWithOwner(priv, "https://evil.example/mallory", spoofedKeyId, testFederatedActorIRI), nil)
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(mustNewTestKeyPerson(other), nil)
+		// Run & Verify
+		actor, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+		assertEqual(t, actor == nil, true)
+	})
+	t.Run("ReturnsKeyOwnerOnOtherHostListingKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		const keyId = "https://keys.example/dakota#main-key"
+		req := mustNewTestSignedRequestWithKeyId(priv, keyId, now())
+		person := streams.NewActivityStreamsPerson()
+		streams.SetId(person, mustParse(testFederatedActorIRI))
+		pkProp := streams.NewW3IDSecurityV1PublicKeyProperty()
+		pkProp.AppendIRI(mustParse(keyId))
+		person.SetW3IDSecurityV1PublicKey(pkProp)
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(ctx, mustParse(keyId)).Return(mustNewTestKeyPersonWithOwner(priv, "https://keys.example/dakota", keyId, testFederatedActorIRI), nil)
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(mustSerializeToBytes(person), nil)
+		// Run & Verify
+		actor, err := v.Verify(ctx, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testFederatedActorIRI)
+	})
+	t.Run("ErrorIfDateTooOld", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, c, v := setupFn(ctl)
+		req := mustNewTestSignedRequest(priv, now().Add(-2*DefaultMaxDateSkew))
+		// Mock
+		c.EXPECT().Now().Return(now())
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("AcceptsDateWithinConfiguredSkew", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		c := NewMockClock(ctl)
+		cfg := DefaultHttpSigVerifierConfig()
+		cfg.MaxSkew = 3 * DefaultMaxDateSkew
+		v := NewHttpSigVerifierWithConfig(tp, c, cfg)
+		req := mustNewTestSignedRequest(priv, now().Add(-2*DefaultMaxDateSkew))
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertEqual(t, err, nil)
+	})
+	t.Run("ErrorIfCreatedBeyondConfiguredSkew", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		c := NewMockClock(ctl)
+		cfg := DefaultHttpSigVerifierConfig()
+		cfg.MaxSkew = time.Minute
+		v := NewHttpSigVerifierWithConfig(tp, c, cfg)
+		req := newExpiringRequestFn(ctl, now().Add(2*time.Minute))
+		// Mock
+		c.EXPECT().Now().Return(now())
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("VerifiesCreatedAndExpiresWithoutDate", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		req := newExpiringRequestFn(ctl, now())
+		// Mock
+		c.EXPECT().Now().Return(now().Add(DefaultMaxDateSkew))
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+		// Run & Verify
+		actor, err := v.Verify(ctx, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testFederatedActorIRI)
+	})
+	t.Run("ErrorIfCreatedChanged", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		req := newExpiringRequestFn(ctl, now())
+		created := fmt.Sprintf("created=\"%d\"", now().Unix())
+		earlier := fmt.Sprintf("created=\"%d\"", now().Unix()-1)
+		req.Header.Set("Signature", strings.Replace(req.Header.Get("Signature"), created, earlier, 1))
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(gomock.Any(), mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil).Times(2)
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfExpired", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, c, v := setupFn(ctl)
+		req := newExpiringRequestFn(ctl, now())
+		// Mock
+		c.EXPECT().Now().Return(now().Add(4 * DefaultMaxDateSkew))
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfOlderThanMaxAge", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		c := NewMockClock(ctl)
+		cfg := DefaultHttpSigVerifierConfig()
+		cfg.MaxAge = time.Minute
+		v := NewHttpSigVerifierWithConfig(tp, c, cfg)
+		req := newExpiringRequestFn(ctl, now())
+		// Mock
+		c.EXPECT().Now().Return(now().Add(2 * time.Minute))
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfCreatedInFuture", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, c, v := setupFn(ctl)
+		req := newExpiringRequestFn(ctl, now().Add(2*DefaultMaxDateSkew))
+		// Mock
+		c.EXPECT().Now().Return(now())
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("VerifiesDigest", func(t *testing.T) {
+		for _, algo := range []httpsig.DigestAlgorithm{httpsig.DigestSha256, httpsig.DigestSha512} {
+			t.Run(string(algo), func(t *testing.T) {
+				// Setup
+				ctl := gomock.NewController(t)
+				defer ctl.Finish()
+				tp, c, v := setupFn(ctl)
+				req := newDigestRequestFn(algo, []string{httpsig.RequestTarget, "date", "digest"}, testRespBody)
+				// Mock
+				c.EXPECT().Now().Return(now())
+				tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+				// Run
+				actor, err := v.Verify(ctx, req)
+				// Verify
+				assertEqual(t, err, nil)
+				assertEqual(t, actor.String(), testFederatedActorIRI)
+				b, err := ioutil.ReadAll(req.Body)
+				assertEqual(t, err, nil)
+				assertByteEqual(t, b, testRespBody)
+			})
+		}
+	})
+	t.Run("ErrorIfDigestDoesNotMatchBody", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, c, v := setupFn(ctl)
+		req := newDigestRequestFn(httpsig.DigestSha256, []string{httpsig.RequestTarget, "date", "digest"}, testRespBody)
+		req.Body = ioutil.NopCloser(bytes.NewReader([]byte("tampered body")))
+		// Mock
+		c.EXPECT().Now().Return(now())
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfDigestIsNotSigned", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, c, v := setupFn(ctl)
+		req := newDigestRequestFn(httpsig.DigestSha256, []string{httpsig.RequestTarget, "date"}, testRespBody)
+		// Mock
+		c.EXPECT().Now().Return(now())
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfBodyHasNoDigest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, c, v := setupFn(ctl)
+		req := newDigestRequestFn(httpsig.DigestSha256, []string{httpsig.RequestTarget, "date"}, nil)
+		req.Body = ioutil.NopCloser(bytes.NewReader(testRespBody))
+		// Mock
+		c.EXPECT().Now().Return(now())
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfKeyCannotBeFetched", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		req := mustNewTestSignedRequest(priv, now())
+		testErr := fmt.Errorf("test error")
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(nil, testErr)
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertEqual(t, err, testErr)
+	})
+	t.Run("ErrorIfUnsigned", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, v := setupFn(ctl)
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("LogsRejectedSignature", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, v := setupFn(ctl)
+		l := NewMockLogger(ctl)
+		logCtx := WithLogger(ctx, l)
+		req := mustNewTestSignedRequest(priv, now())
+		testErr := fmt.Errorf("test error")
+		// Mock
+		c.EXPECT().Now().Return(now())
+		tp.EXPECT().Dereference(logCtx, mustParse(testFederatedKeyId)).Return(nil, testErr)
+		l.EXPECT().Log(logCtx, LogWarn, "rejected HTTP Signature", "url", testNoteId1, "error", testErr)
+		// Run & Verify
+		_, err := v.Verify(logCtx, req)
+		assertEqual(t, err, testErr)
+	})
+}
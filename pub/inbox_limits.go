@@ -0,0 +1,152 @@
+package pub
+
+import (
+	"errors"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// retryAfterHeader is the header telling a rate limited peer when to
+	// try again.
+	retryAfterHeader = "Retry-After"
+)
+
+// errBodyTooLarge is returned when reading a request body longer than the
+// MaxBodyBytes of the InboxLimits.
+var errBodyTooLarge = errors.New("request body too large")
+
+// InboxLimits bounds the requests that an Actor accepts in its inboxes, so that
+// a hostile peer cannot exhaust the server's memory or CPU. Zero values do not
+// limit.
+type InboxLimits struct {
+	// MaxBodyBytes is the size of the largest request body accepted. Larger
+	// bodies receive a http.StatusRequestEntityTooLarge response, including
+	// when AuthenticatePostInbox returns the error of a RequestVerifier such
+	// as HttpSigVerifier reading the body.
+	MaxBodyBytes int64
+	// MaxRecipients is the largest number of 'to', 'bto', 'cc', 'bcc', and
+	// 'audience' recipients of an activity. Activities with more receive a
+	// http.StatusRequestEntityTooLarge response.
+	MaxRecipients int
+	// PerHostPerSecond is the number of requests per second accepted from
+	// each remote host, identified by the IP address of the request's
+	// RemoteAddr, with bursts of up to PerHostBurst requests. Requests
+	// beyond the limit receive a http.StatusTooManyRequests response with
+	// a Retry-After header.
+	//
+	// Behind a reverse proxy, the RemoteAddr of requests must be set to
+	// the address of the client before they are handled.
+	PerHostPerSecond float64
+	// PerHostBurst is the burst of requests accepted from each remote
+	// host. Less than one is treated as one.
+	PerHostBurst int
+}
+
+// InboxLimiter is optionally implemented by a FederatingProtocol to bound the
+// requests accepted in inboxes. The limits are read once, when the Actor is
+// created.
+//
+// With NewCustomActor, it is the DelegateActor that may implement it.
+type InboxLimiter interface {
+	// InboxLimits returns the limits of requests to inboxes.
+	InboxLimits() InboxLimits
+}
+
+// inboxLimits enforces the InboxLimits of an Actor.
+type inboxLimits struct {
+	InboxLimits
+	limiter *hostLimiter
+}
+
+// newInboxLimits returns the limits of the value if it is an InboxLimiter, or
+// nil.
+func newInboxLimits(v interface{}, clock Clock) *inboxLimits {
+	l, ok := v.(InboxLimiter)
+	if !ok {
+		return nil
+	}
+	limits := l.InboxLimits()
+	return &inboxLimits{
+		InboxLimits: limits,
+		limiter:     newHostLimiter(clock, limits.PerHostPerSecond, limits.PerHostBurst),
+	}
+}
+
+// limitRequest writes a http.StatusTooManyRequests response if the remote host
+// exceeded its rate, or a http.StatusRequestEntityTooLarge response if the
+// request's Content-Length is too large, returning false. Otherwise, the body of
+// the request is limited to MaxBodyBytes.
+func (l *inboxLimits) limitRequest(w http.ResponseWriter, r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if wait := l.limiter.reserve(host, false); wait > 0 {
+		w.Header().Set(retryAfterHeader, strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		w.WriteHeader(http.StatusTooManyRequests)
+		return false
+	}
+	if l.MaxBodyBytes > 0 {
+		if r.ContentLength > l.MaxBodyBytes {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return false
+		}
+		r.Body = &limitedBody{ReadCloser: r.Body, remaining: l.MaxBodyBytes}
+	}
+	return true
+}
+
+// tooManyRecipients returns true if the activity has more than MaxRecipients
+// recipients.
+func (l *inboxLimits) tooManyRecipients(activity Activity) bool {
+	if l.MaxRecipients <= 0 {
+		return false
+	}
+	n := 0
+	if p := activity.GetActivityStreamsTo(); p != nil {
+		n += p.Len()
+	}
+	if p := activity.GetActivityStreamsBto(); p != nil {
+		n += p.Len()
+	}
+	if p := activity.GetActivityStreamsCc(); p != nil {
+		n += p.Len()
+	}
+	if p := activity.GetActivityStreamsBcc(); p != nil {
+		n += p.Len()
+	}
+	if p := activity.GetActivityStreamsAudience(); p != nil {
+		n += p.Len()
+	}
+	return n > l.MaxRecipients
+}
+
+// limitedBody is a request body returning errBodyTooLarge once more than the
+// remaining bytes are read.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+// Read reads from the body, failing with errBodyTooLarge once it is longer than
+// the limit.
+func (b *limitedBody) Read(p []byte) (n int, err error) {
+	if b.remaining < 0 {
+		return 0, errBodyTooLarge
+	}
+	// Read one byte more than remaining to detect a body that is too long.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err = b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		n += int(b.remaining)
+		err = errBodyTooLarge
+	}
+	return
+}
@@ -0,0 +1,83 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+// KeyResolver resolves the keyId of HTTP Signatures into public keys, for a
+// HttpSigVerifier.
+//
+// It is called concurrently, so it must be safe for concurrent use.
+type KeyResolver interface {
+	// Resolve returns the public key identified by the keyId, and the actor
+	// owning it.
+	//
+	// A context given to WithForceRefresh must fetch the key again instead
+	// of using a cached copy, such as when a signature failed to verify
+	// because its actor rotated the key. A revoked key must return an
+	// error.
+	Resolve(c context.Context, keyId *url.URL) (owner *url.URL, pubKey crypto.PublicKey, err error)
+	// Revoke removes the key from the cache, and returns an error from
+	// later attempts to resolve it, such as when its actor is deleted or
+	// reports it compromised.
+	Revoke(c context.Context, keyId *url.URL)
+}
+
+// KeyResolver must be implemented by CachingKeyResolver.
+var _ KeyResolver = &CachingKeyResolver{}
+
+// CachingKeyResolver is a KeyResolver fetching the documents identified by a
+// keyId with a Transport, and caching them in a DereferenceCache.
+//
+// The keyId may identify a standalone key, a key embedded in its owner's
+// 'publicKey' property, or a Multikey, standalone or in its owner's
+// 'assertionMethod' property. An owner on another host than the keyId is
+// dereferenced to confirm that it lists the key.
+type CachingKeyResolver struct {
+	transport Transport
+	cache     DereferenceCache
+	mu        *sync.RWMutex
+	revoked   map[string]bool
+}
+
+// NewCachingKeyResolver returns a CachingKeyResolver fetching keys with the
+// Transport. A nil cache fetches a key every time it is resolved.
+func NewCachingKeyResolver(t Transport, cache DereferenceCache) *CachingKeyResolver {
+	if cache != nil {
+		t = NewCachingTransport(t, cache)
+	}
+	return &CachingKeyResolver{
+		transport: t,
+		cache:     cache,
+		mu:        &sync.RWMutex{},
+		revoked:   make(map[string]bool),
+	}
+}
+
+// Resolve returns the public key identified by the keyId, and its owner, from
+// the cache unless the context was given to WithForceRefresh.
+func (k *CachingKeyResolver) Resolve(c context.Context, keyId *url.URL) (owner *url.URL, pubKey crypto.PublicKey, err error) {
+	k.mu.RLock()
+	revoked := k.revoked[keyId.String()]
+	k.mu.RUnlock()
+	if revoked {
+		err = fmt.Errorf("public key %s is revoked", keyId)
+		return
+	}
+	return fetchPublicKey(c, k.transport, keyId)
+}
+
+// Revoke removes the key from the cache, and fails later attempts to resolve
+// it.
+func (k *CachingKeyResolver) Revoke(c context.Context, keyId *url.URL) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.revoked[keyId.String()] = true
+	if k.cache != nil {
+		k.cache.Invalidate(c, keyId)
+	}
+}
@@ -0,0 +1,95 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestCachingKeyResolver(t *testing.T) {
+	ctx := context.Background()
+	priv, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setupFn := func(ctl *gomock.Controller) (tp *MockTransport, r *CachingKeyResolver) {
+		tp = NewMockTransport(ctl)
+		r = NewCachingKeyResolver(tp, NewMemoryDereferenceCache(0, 0, nil))
+		return
+	}
+	t.Run("ResolvesKeyOwner", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, r := setupFn(ctl)
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+		// Run
+		owner, pubKey, err := r.Resolve(ctx, mustParse(testFederatedKeyId))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, owner.String(), testFederatedActorIRI)
+		assertEqual(t, pubKey.(*rsa.PublicKey).Equal(&priv.PublicKey), true)
+	})
+	t.Run("CachesKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, r := setupFn(ctl)
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+		// Run & Verify
+		_, _, err := r.Resolve(ctx, mustParse(testFederatedKeyId))
+		assertEqual(t, err, nil)
+		_, _, err = r.Resolve(ctx, mustParse(testFederatedKeyId))
+		assertEqual(t, err, nil)
+	})
+	t.Run("FetchesKeyWhenForced", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, r := setupFn(ctl)
+		refreshCtx := WithForceRefresh(ctx)
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+		tp.EXPECT().Dereference(refreshCtx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+		// Run & Verify
+		_, _, err := r.Resolve(ctx, mustParse(testFederatedKeyId))
+		assertEqual(t, err, nil)
+		_, _, err = r.Resolve(refreshCtx, mustParse(testFederatedKeyId))
+		assertEqual(t, err, nil)
+	})
+	t.Run("ErrorIfRevoked", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, r := setupFn(ctl)
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+		// Run & Verify
+		_, _, err := r.Resolve(ctx, mustParse(testFederatedKeyId))
+		assertEqual(t, err, nil)
+		r.Revoke(ctx, mustParse(testFederatedKeyId))
+		_, _, err = r.Resolve(ctx, mustParse(testFederatedKeyId))
+		assertNotEqual(t, err, nil)
+		_, _, err = r.Resolve(WithForceRefresh(ctx), mustParse(testFederatedKeyId))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("FetchesEveryTimeWithoutCache", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		r := NewCachingKeyResolver(tp, nil)
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil).Times(2)
+		// Run & Verify
+		_, _, err := r.Resolve(ctx, mustParse(testFederatedKeyId))
+		assertEqual(t, err, nil)
+		_, _, err = r.Resolve(ctx, mustParse(testFederatedKeyId))
+		assertEqual(t, err, nil)
+	})
+}
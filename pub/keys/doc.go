@@ -0,0 +1,12 @@
+// Package keys manages the key pairs that actors use to sign their requests
+// with HTTP Signatures.
+//
+// A Manager generates an RSA or Ed25519 key pair for each actor, persists it
+// through a Store, and adds the public keys to the actor's ActivityStreams
+// document so that peers can verify the actor's signatures.
+//
+// Keys may be rotated. A rotated key is retired instead of deleted, and
+// continues to be served and accepted for verification for a grace period, so
+// that requests signed just before the rotation, and peers that cached the old
+// key, are not rejected.
+package keys
@@ -0,0 +1,117 @@
+package keys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Algorithm is the kind of key pair generated for an actor.
+type Algorithm string
+
+const (
+	// RSA key pairs are understood by nearly every ActivityPub peer.
+	RSA Algorithm = "rsa"
+	// Ed25519 key pairs are smaller and faster, but are not yet understood
+	// by every ActivityPub peer.
+	Ed25519 Algorithm = "ed25519"
+)
+
+// DefaultRSABits is the size of the RSA keys generated by a Manager.
+const DefaultRSABits = 2048
+
+const (
+	pemPrivateKeyType = "PRIVATE KEY"
+	pemPublicKeyType  = "PUBLIC KEY"
+)
+
+// Key is a key pair of an actor.
+type Key struct {
+	// ID is the IRI of the public key, which is the keyId of signatures
+	// made with the private key.
+	ID *url.URL
+	// Owner is the IRI of the actor owning the key.
+	Owner *url.URL
+	// Algorithm is the kind of key pair.
+	Algorithm Algorithm
+	// PrivateKey is an *rsa.PrivateKey or ed25519.PrivateKey.
+	PrivateKey crypto.PrivateKey
+	// PublicKey is an *rsa.PublicKey or ed25519.PublicKey.
+	PublicKey crypto.PublicKey
+	// Created is when the key was generated.
+	Created time.Time
+	// Retired is when the key was replaced by a newer key, or the zero
+	// value if it is the actor's active key.
+	Retired time.Time
+}
+
+// IsRetired returns true if the key was replaced by a newer key.
+func (k *Key) IsRetired() bool {
+	return !k.Retired.IsZero()
+}
+
+// PublicKeyPEM returns the PEM encoding of the public key, suitable as the
+// value of the 'publicKeyPem' property.
+func (k *Key) PublicKeyPEM() (string, error) {
+	b, err := x509.MarshalPKIXPublicKey(k.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemPublicKeyType, Bytes: b})), nil
+}
+
+// PrivateKeyPEM returns the PKCS #8 PEM encoding of the private key, so that a
+// Store can persist it.
+func (k *Key) PrivateKeyPEM() (string, error) {
+	b, err := x509.MarshalPKCS8PrivateKey(k.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemPrivateKeyType, Bytes: b})), nil
+}
+
+// ParsePrivateKeyPEM parses a private key encoded by PrivateKeyPEM, returning
+// its Algorithm, private key, and public key.
+func ParsePrivateKeyPEM(s string) (alg Algorithm, priv crypto.PrivateKey, pubKey crypto.PublicKey, err error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil || block.Type != pemPrivateKeyType {
+		err = fmt.Errorf("no %s PEM block", pemPrivateKeyType)
+		return
+	}
+	priv, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return
+	}
+	switch k := priv.(type) {
+	case *rsa.PrivateKey:
+		alg, pubKey = RSA, &k.PublicKey
+	case ed25519.PrivateKey:
+		alg, pubKey = Ed25519, k.Public()
+	default:
+		err = fmt.Errorf("unsupported private key type %T", priv)
+	}
+	return
+}
+
+// generate creates a new key pair of the Algorithm.
+func generate(alg Algorithm, rsaBits int) (priv crypto.PrivateKey, pubKey crypto.PublicKey, err error) {
+	switch alg {
+	case RSA:
+		var k *rsa.PrivateKey
+		if k, err = rsa.GenerateKey(rand.Reader, rsaBits); err != nil {
+			return
+		}
+		priv, pubKey = k, &k.PublicKey
+	case Ed25519:
+		pubKey, priv, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		err = fmt.Errorf("unsupported key algorithm %q", alg)
+	}
+	return
+}
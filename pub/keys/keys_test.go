@@ -0,0 +1,62 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"testing"
+)
+
+// TestPrivateKeyPEM tests that generated private keys survive being persisted
+// as PEM.
+func TestPrivateKeyPEM(t *testing.T) {
+	for _, alg := range []Algorithm{RSA, Ed25519} {
+		t.Run(string(alg), func(t *testing.T) {
+			priv, pubKey, err := generate(alg, 1024)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			k := &Key{Algorithm: alg, PrivateKey: priv, PublicKey: pubKey}
+			s, err := k.PrivateKeyPEM()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			gotAlg, gotPriv, gotPub, err := ParsePrivateKeyPEM(s)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			} else if gotAlg != alg {
+				t.Fatalf("got algorithm %q, want %q", gotAlg, alg)
+			}
+			switch p := gotPriv.(type) {
+			case *rsa.PrivateKey:
+				if !p.Equal(priv) || !p.PublicKey.Equal(gotPub) {
+					t.Fatalf("parsed RSA key does not match")
+				}
+			case ed25519.PrivateKey:
+				if !p.Equal(priv) || !p.Public().(ed25519.PublicKey).Equal(gotPub) {
+					t.Fatalf("parsed Ed25519 key does not match")
+				}
+			default:
+				t.Fatalf("unexpected private key type %T", gotPriv)
+			}
+		})
+	}
+	t.Run("ErrorIfNotPEM", func(t *testing.T) {
+		if _, _, _, err := ParsePrivateKeyPEM("not a key"); err == nil {
+			t.Fatalf("expected error, got none")
+		}
+	})
+}
+
+// TestPublicKeyPEM tests that public keys are PEM encoded.
+func TestPublicKeyPEM(t *testing.T) {
+	priv, pubKey, err := generate(Ed25519, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	s, err := (&Key{PrivateKey: priv, PublicKey: pubKey}).PublicKeyPEM()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if len(s) == 0 || s[:26] != "-----BEGIN PUBLIC KEY-----" {
+		t.Fatalf("unexpected public key PEM: %s", s)
+	}
+}
@@ -0,0 +1,234 @@
+package keys
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// keyIdRandomByteSize is the number of random bytes in the fragment of a
+// generated key's id.
+const keyIdRandomByteSize = 8
+
+// publicKeyer is an actor with a 'publicKey' property, keeping its unknown
+// properties such as 'assertionMethod'.
+type publicKeyer interface {
+	GetJSONLDId() vocab.JSONLDIdProperty
+	SetW3IDSecurityV1PublicKey(i vocab.W3IDSecurityV1PublicKeyProperty)
+	GetUnknownProperties() map[string]interface{}
+}
+
+// Manager generates, rotates, and serves the keys of actors.
+//
+// It is safe for concurrent use, as long as the Store is.
+type Manager struct {
+	store     Store
+	clock     pub.Clock
+	algorithm Algorithm
+	grace     time.Duration
+	// mu serializes generating and rotating keys, so that an actor never
+	// has more than one active key.
+	mu sync.Mutex
+}
+
+// NewManager returns a Manager generating keys of the Algorithm and
+// persisting them in the Store.
+//
+// Rotated keys remain valid for the grace period, as measured by the clock.
+// RSA keys have DefaultRSABits bits.
+func NewManager(store Store, clock pub.Clock, algorithm Algorithm, grace time.Duration) *Manager {
+	return &Manager{
+		store:     store,
+		clock:     clock,
+		algorithm: algorithm,
+		grace:     grace,
+	}
+}
+
+// ActiveKey returns the key the actor signs requests with, generating one if
+// the actor has none.
+func (m *Manager) ActiveKey(c context.Context, owner *url.URL) (*Key, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys, err := m.store.Keys(c, owner)
+	if err != nil {
+		return nil, err
+	}
+	if k := activeKey(keys); k != nil {
+		return k, nil
+	}
+	return m.generate(c, owner)
+}
+
+// Rotate retires the actor's active key, if any, and returns a newly generated
+// active key. Keys whose grace period has elapsed are deleted.
+func (m *Manager) Rotate(c context.Context, owner *url.URL) (*Key, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys, err := m.store.Keys(c, owner)
+	if err != nil {
+		return nil, err
+	}
+	now := m.clock.Now()
+	for _, k := range keys {
+		if !k.IsRetired() {
+			k.Retired = now
+			if err := m.store.Put(c, k); err != nil {
+				return nil, err
+			}
+		} else if !m.inGrace(k, now) {
+			if err := m.store.Delete(c, k.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return m.generate(c, owner)
+}
+
+// VerificationKey returns the key with the id if signatures made with it are
+// still accepted: it is either active, or retired within the grace period.
+func (m *Manager) VerificationKey(c context.Context, id *url.URL) (*Key, error) {
+	k, err := m.store.Get(c, id)
+	if err != nil {
+		return nil, err
+	}
+	if !m.inGrace(k, m.clock.Now()) {
+		return nil, fmt.Errorf("key %s was retired at %s", id, k.Retired)
+	}
+	return k, nil
+}
+
+// PublicKeys returns the keys of the actor whose signatures are accepted,
+// with the active key first and the most recently retired keys following.
+func (m *Manager) PublicKeys(c context.Context, owner *url.URL) ([]*Key, error) {
+	keys, err := m.store.Keys(c, owner)
+	if err != nil {
+		return nil, err
+	}
+	now := m.clock.Now()
+	valid := make([]*Key, 0, len(keys))
+	for _, k := range keys {
+		if m.inGrace(k, now) {
+			valid = append(valid, k)
+		}
+	}
+	sort.Slice(valid, func(i, j int) bool {
+		if valid[i].IsRetired() != valid[j].IsRetired() {
+			return !valid[i].IsRetired()
+		}
+		return valid[i].Retired.After(valid[j].Retired)
+	})
+	return valid, nil
+}
+
+// SetPublicKeys sets the actor's 'publicKey' property to its PublicKeys, so
+// that peers dereferencing the actor can verify its signatures. The actor
+// must have an id.
+//
+// The keys are also set as Multikeys in the actor's 'assertionMethod'
+// property, where newer peers look for Ed25519 keys. The actor's '@context'
+// must then include pub.MultikeyContext.
+//
+// An active key is generated if the actor has none.
+func (m *Manager) SetPublicKeys(c context.Context, actor publicKeyer) error {
+	idp := actor.GetJSONLDId()
+	if idp == nil || idp.Get() == nil {
+		return fmt.Errorf("actor has no id")
+	}
+	owner := idp.Get()
+	if _, err := m.ActiveKey(c, owner); err != nil {
+		return err
+	}
+	keys, err := m.PublicKeys(c, owner)
+	if err != nil {
+		return err
+	}
+	prop := streams.NewW3IDSecurityV1PublicKeyProperty()
+	multikeys := make([]pub.Multikey, 0, len(keys))
+	for _, k := range keys {
+		pk, err := toPublicKey(k)
+		if err != nil {
+			return err
+		}
+		prop.AppendW3IDSecurityV1PublicKey(pk)
+		multikeys = append(multikeys, pub.Multikey{
+			ID:         k.ID,
+			Controller: k.Owner,
+			PublicKey:  k.PublicKey,
+		})
+	}
+	actor.SetW3IDSecurityV1PublicKey(prop)
+	return pub.SetAssertionMethods(actor, multikeys)
+}
+
+// inGrace returns true if the key is active or was retired less than the
+// grace period before now.
+func (m *Manager) inGrace(k *Key, now time.Time) bool {
+	return !k.IsRetired() || now.Before(k.Retired.Add(m.grace))
+}
+
+// generate creates and stores a new active key for the actor. The lock must be
+// held.
+func (m *Manager) generate(c context.Context, owner *url.URL) (*Key, error) {
+	priv, pubKey, err := generate(m.algorithm, DefaultRSABits)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, keyIdRandomByteSize)
+	if _, err = rand.Read(b); err != nil {
+		return nil, err
+	}
+	id := *owner
+	id.Fragment = "key-" + hex.EncodeToString(b)
+	k := &Key{
+		ID:         &id,
+		Owner:      owner,
+		Algorithm:  m.algorithm,
+		PrivateKey: priv,
+		PublicKey:  pubKey,
+		Created:    m.clock.Now(),
+	}
+	if err = m.store.Put(c, k); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// activeKey returns the most recently created key that is not retired, or nil
+// if there is none.
+func activeKey(keys []*Key) (active *Key) {
+	for _, k := range keys {
+		if !k.IsRetired() && (active == nil || k.Created.After(active.Created)) {
+			active = k
+		}
+	}
+	return
+}
+
+// toPublicKey returns the ActivityStreams representation of the public key.
+func toPublicKey(k *Key) (vocab.W3IDSecurityV1PublicKey, error) {
+	s, err := k.PublicKeyPEM()
+	if err != nil {
+		return nil, err
+	}
+	pk := streams.NewW3IDSecurityV1PublicKey()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(k.ID)
+	pk.SetJSONLDId(id)
+	owner := streams.NewW3IDSecurityV1OwnerProperty()
+	owner.Set(k.Owner)
+	pk.SetW3IDSecurityV1Owner(owner)
+	pem := streams.NewW3IDSecurityV1PublicKeyPemProperty()
+	pem.Set(s)
+	pk.SetW3IDSecurityV1PublicKeyPem(pem)
+	return pk, nil
+}
@@ -0,0 +1,164 @@
+package keys
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/pub/pubtest"
+	"github.com/go-fed/activity/streams"
+)
+
+const testActorIRI = "https://example.com/addison"
+
+// testClock is a clock whose time is advanced by tests.
+type testClock struct {
+	t time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.t
+}
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func TestManager(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func() (*Manager, *testClock) {
+		clock := &testClock{t: pubtest.Time}
+		return NewManager(NewMemoryStore(), clock, Ed25519, time.Hour), clock
+	}
+	t.Run("GeneratesActiveKeyOnce", func(t *testing.T) {
+		m, _ := setupFn()
+		k1, err := m.ActiveKey(ctx, mustParse(testActorIRI))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		k2, err := m.ActiveKey(ctx, mustParse(testActorIRI))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if k1.ID.String() != k2.ID.String() {
+			t.Fatalf("generated a second key %s, want %s", k2.ID, k1.ID)
+		} else if k1.Owner.String() != testActorIRI || k1.ID.Fragment == "" {
+			t.Fatalf("unexpected key id %s for owner %s", k1.ID, k1.Owner)
+		}
+	})
+	t.Run("RotatesKey", func(t *testing.T) {
+		m, clock := setupFn()
+		old, err := m.ActiveKey(ctx, mustParse(testActorIRI))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		clock.t = clock.t.Add(time.Minute)
+		k, err := m.Rotate(ctx, mustParse(testActorIRI))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if k.ID.String() == old.ID.String() {
+			t.Fatalf("rotated key has the old id %s", k.ID)
+		}
+		active, err := m.ActiveKey(ctx, mustParse(testActorIRI))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if active.ID.String() != k.ID.String() {
+			t.Fatalf("active key is %s, want %s", active.ID, k.ID)
+		}
+	})
+	t.Run("AcceptsRetiredKeyWithinGrace", func(t *testing.T) {
+		m, clock := setupFn()
+		old, err := m.ActiveKey(ctx, mustParse(testActorIRI))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, err = m.Rotate(ctx, mustParse(testActorIRI)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		clock.t = clock.t.Add(59 * time.Minute)
+		if _, err = m.VerificationKey(ctx, old.ID); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		clock.t = clock.t.Add(time.Minute)
+		if _, err = m.VerificationKey(ctx, old.ID); err == nil {
+			t.Fatalf("expected error, got none")
+		}
+	})
+	t.Run("DeletesKeysPastGraceOnRotate", func(t *testing.T) {
+		m, clock := setupFn()
+		old, err := m.ActiveKey(ctx, mustParse(testActorIRI))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, err = m.Rotate(ctx, mustParse(testActorIRI)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		clock.t = clock.t.Add(2 * time.Hour)
+		if _, err = m.Rotate(ctx, mustParse(testActorIRI)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, err = m.store.Get(ctx, old.ID); err == nil {
+			t.Fatalf("expected key %s to be deleted", old.ID)
+		}
+	})
+	t.Run("SetsPublicKeysOnActor", func(t *testing.T) {
+		m, _ := setupFn()
+		old, err := m.ActiveKey(ctx, mustParse(testActorIRI))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		k, err := m.Rotate(ctx, mustParse(testActorIRI))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		person := streams.NewActivityStreamsPerson()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testActorIRI))
+		person.SetJSONLDId(id)
+		if err = m.SetPublicKeys(ctx, person); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		pk := person.GetW3IDSecurityV1PublicKey()
+		if pk.Len() != 2 {
+			t.Fatalf("got %d public keys, want 2", pk.Len())
+		}
+		for i, want := range []*Key{k, old} {
+			got := pk.At(i).Get()
+			wantPEM, err := want.PublicKeyPEM()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got.GetJSONLDId().Get().String() != want.ID.String() {
+				t.Fatalf("public key %d has id %s, want %s", i, got.GetJSONLDId().Get(), want.ID)
+			} else if got.GetW3IDSecurityV1Owner().Get().String() != testActorIRI {
+				t.Fatalf("public key %d has owner %s", i, got.GetW3IDSecurityV1Owner().Get())
+			} else if got.GetW3IDSecurityV1PublicKeyPem().Get() != wantPEM {
+				t.Fatalf("public key %d has the wrong PEM", i)
+			}
+		}
+		multikeys, err := pub.GetAssertionMethods(person)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if len(multikeys) != 2 {
+			t.Fatalf("got %d Multikeys, want 2", len(multikeys))
+		}
+		for i, want := range []*Key{k, old} {
+			if multikeys[i].ID.String() != want.ID.String() {
+				t.Fatalf("Multikey %d has id %s, want %s", i, multikeys[i].ID, want.ID)
+			} else if multikeys[i].Controller.String() != testActorIRI {
+				t.Fatalf("Multikey %d has controller %s", i, multikeys[i].Controller)
+			}
+		}
+	})
+	t.Run("ErrorIfActorHasNoId", func(t *testing.T) {
+		m, _ := setupFn()
+		if err := m.SetPublicKeys(ctx, streams.NewActivityStreamsPerson()); err == nil {
+			t.Fatalf("expected error, got none")
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+// Store persists the keys of actors.
+//
+// Implementations may persist the private key with Key.PrivateKeyPEM and
+// restore it with ParsePrivateKeyPEM.
+type Store interface {
+	// Get returns the key with the id, or an error if there is none.
+	Get(c context.Context, id *url.URL) (*Key, error)
+	// Keys returns all keys owned by the actor, including retired ones,
+	// in any order.
+	Keys(c context.Context, owner *url.URL) ([]*Key, error)
+	// Put creates the key, or replaces the key with the same id.
+	Put(c context.Context, k *Key) error
+	// Delete removes the key with the id.
+	Delete(c context.Context, id *url.URL) error
+}
+
+// Store must be implemented by MemoryStore.
+var _ Store = &MemoryStore{}
+
+// MemoryStore is a Store keeping keys in memory, for tests and prototypes. It
+// is safe for concurrent use.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	keys map[string]*Key
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		keys: make(map[string]*Key),
+	}
+}
+
+// Get returns the key with the id.
+func (m *MemoryStore) Get(c context.Context, id *url.URL) (*Key, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	k, ok := m.keys[id.String()]
+	if !ok {
+		return nil, fmt.Errorf("no key with id: %s", id)
+	}
+	cp := *k
+	return &cp, nil
+}
+
+// Keys returns all keys owned by the actor.
+func (m *MemoryStore) Keys(c context.Context, owner *url.URL) ([]*Key, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var keys []*Key
+	for _, k := range m.keys {
+		if k.Owner.String() == owner.String() {
+			cp := *k
+			keys = append(keys, &cp)
+		}
+	}
+	return keys, nil
+}
+
+// Put creates or replaces the key.
+func (m *MemoryStore) Put(c context.Context, k *Key) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp := *k
+	m.keys[k.ID.String()] = &cp
+	return nil
+}
+
+// Delete removes the key with the id.
+func (m *MemoryStore) Delete(c context.Context, id *url.URL) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.keys, id.String())
+	return nil
+}
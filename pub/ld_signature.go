@@ -0,0 +1,183 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+)
+
+const (
+	// rsaSignature2017 is the type of the Linked Data Signatures that
+	// Mastodon attaches to the activities it sends.
+	rsaSignature2017 = "RsaSignature2017"
+	// identityV1Context is the JSON-LD context of the signature options.
+	identityV1Context = "https://w3id.org/identity/v1"
+	// ldSignatureProperty is the property of a document holding its Linked
+	// Data Signature.
+	ldSignatureProperty = "signature"
+)
+
+// Canonicalizer converts JSON-LD documents into the canonical N-Quads produced
+// by the URDNA2015 algorithm, on which Linked Data Signatures are computed.
+//
+// This library does not include a JSON-LD processor. Applications implement it
+// with one, such as github.com/piprate/json-gold, which usually needs a
+// document loader caching the JSON-LD contexts.
+type Canonicalizer interface {
+	// Canonicalize returns the canonical N-Quads of the document.
+	Canonicalize(c context.Context, doc map[string]interface{}) (nquads string, err error)
+}
+
+// SignLD adds a RsaSignature2017 Linked Data Signature, created with the key at
+// the time, to the JSON-LD document, such as a serialized activity.
+//
+// Mastodon attaches these signatures to public activities so that servers
+// receiving them forwarded by another server can trust them. The signature must
+// be added after the document's last change, as any change invalidates it.
+func SignLD(c context.Context, canon Canonicalizer, doc map[string]interface{}, keyId *url.URL, privKey *rsa.PrivateKey, created time.Time) error {
+	options := map[string]interface{}{
+		"@context": identityV1Context,
+		"creator":  keyId.String(),
+		"created":  created.UTC().Format(time.RFC3339),
+	}
+	hash, err := ldSignatureHash(c, canon, options, doc)
+	if err != nil {
+		return err
+	}
+	sig, err := rsa.SignPKCS1v15(rand.Reader, privKey, crypto.SHA256, hash)
+	if err != nil {
+		return err
+	}
+	options["type"] = rsaSignature2017
+	options["signatureValue"] = base64.StdEncoding.EncodeToString(sig)
+	doc[ldSignatureProperty] = options
+	return nil
+}
+
+// ldSignatureHash returns the SHA-256 hash that is signed: the one of the
+// concatenated hex-encoded hashes of the canonical signature options and of the
+// canonical document without its signature.
+func ldSignatureHash(c context.Context, canon Canonicalizer, options, doc map[string]interface{}) ([]byte, error) {
+	o := make(map[string]interface{}, len(options))
+	for k, v := range options {
+		if k != "type" && k != "id" && k != "signatureValue" {
+			o[k] = v
+		}
+	}
+	o["@context"] = identityV1Context
+	d := make(map[string]interface{}, len(doc))
+	for k, v := range doc {
+		if k != ldSignatureProperty {
+			d[k] = v
+		}
+	}
+	var toBeSigned string
+	for _, m := range []map[string]interface{}{o, d} {
+		nquads, err := canon.Canonicalize(c, m)
+		if err != nil {
+			return nil, err
+		}
+		h := sha256.Sum256([]byte(nquads))
+		toBeSigned += hex.EncodeToString(h[:])
+	}
+	h := sha256.Sum256([]byte(toBeSigned))
+	return h[:], nil
+}
+
+// LDSignatureVerifier verifies the RsaSignature2017 Linked Data Signatures of
+// documents with the public key identified by the signature's creator, fetched
+// with a Transport.
+type LDSignatureVerifier struct {
+	transport Transport
+	canon     Canonicalizer
+}
+
+// NewLDSignatureVerifier returns a LDSignatureVerifier fetching public keys with
+// the Transport, which is usually wrapped by a CachingTransport.
+func NewLDSignatureVerifier(t Transport, canon Canonicalizer) *LDSignatureVerifier {
+	return &LDSignatureVerifier{
+		transport: t,
+		canon:     canon,
+	}
+}
+
+// Verify returns the owner of the public key that created the document's Linked
+// Data Signature. A rejected signature is logged with the context's Logger.
+func (v *LDSignatureVerifier) Verify(c context.Context, doc map[string]interface{}) (owner *url.URL, err error) {
+	defer func() {
+		if err != nil {
+			logEvent(c, LogWarn, "rejected Linked Data Signature", "id", doc["id"], "error", err)
+		}
+	}()
+	options, ok := doc[ldSignatureProperty].(map[string]interface{})
+	if !ok {
+		err = fmt.Errorf("document has no Linked Data Signature")
+		return
+	}
+	if t, _ := options["type"].(string); t != rsaSignature2017 {
+		err = fmt.Errorf("unsupported Linked Data Signature type %q", t)
+		return
+	}
+	creator, _ := options["creator"].(string)
+	keyId, err := url.Parse(creator)
+	if err != nil {
+		return
+	}
+	sigValue, _ := options["signatureValue"].(string)
+	sig, err := base64.StdEncoding.DecodeString(sigValue)
+	if err != nil {
+		return
+	}
+	owner, pubKey, err := fetchPublicKey(c, v.transport, keyId)
+	if err != nil {
+		return
+	}
+	rsaKey, ok := pubKey.(*rsa.PublicKey)
+	if !ok {
+		err = fmt.Errorf("unsupported public key type %T for %s", pubKey, keyId)
+		return
+	}
+	hash, err := ldSignatureHash(c, v.canon, options, doc)
+	if err != nil {
+		return
+	}
+	if err = rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, hash, sig); err != nil {
+		owner = nil
+	}
+	return
+}
+
+// VerifyActivity verifies the Linked Data Signature of the activity, as
+// received, and returns its actor if the signature was created by the actor's
+// key.
+//
+// It allows trusting an activity forwarded by a server other than its origin,
+// such as when implementing a SignerMatchPolicy.
+func (v *LDSignatureVerifier) VerifyActivity(c context.Context, activity Activity) (actor *url.URL, err error) {
+	doc, err := streams.Serialize(activity)
+	if err != nil {
+		return
+	}
+	owner, err := v.Verify(c, doc)
+	if err != nil {
+		return
+	}
+	if actors := activity.GetActivityStreamsActor(); actors != nil {
+		for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && id.String() == owner.String() {
+				return owner, nil
+			}
+		}
+	}
+	err = fmt.Errorf("Linked Data Signature created by %s, who is not an actor of the activity", owner)
+	return
+}
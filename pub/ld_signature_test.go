@@ -0,0 +1,100 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+)
+
+// jsonCanonicalizer canonicalizes documents as JSON with sorted keys, standing
+// in for URDNA2015 in tests.
+type jsonCanonicalizer struct{}
+
+func (jsonCanonicalizer) Canonicalize(c context.Context, doc map[string]interface{}) (string, error) {
+	b, err := json.Marshal(doc)
+	return string(b), err
+}
+
+func TestLDSignature(t *testing.T) {
+	ctx := context.Background()
+	priv, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newSignedFn := func() map[string]interface{} {
+		setupData()
+		doc, err := streams.Serialize(testCreate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = SignLD(ctx, jsonCanonicalizer{}, doc, mustParse(testFederatedKeyId), priv, now()); err != nil {
+			t.Fatal(err)
+		}
+		return doc
+	}
+	setupFn := func(ctl *gomock.Controller) (tp *MockTransport, v *LDSignatureVerifier) {
+		tp = NewMockTransport(ctl)
+		v = NewLDSignatureVerifier(tp, jsonCanonicalizer{})
+		return
+	}
+	t.Run("VerifiesSignedActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, v := setupFn(ctl)
+		activity, err := streams.ToType(ctx, newSignedFn())
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+		// Run & Verify
+		actor, err := v.VerifyActivity(ctx, activity.(Activity))
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testFederatedActorIRI)
+	})
+	t.Run("RejectsChangedDocument", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, v := setupFn(ctl)
+		doc := newSignedFn()
+		doc["id"] = testFederatedActivityIRI2
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(priv), nil)
+		// Run & Verify
+		_, err := v.Verify(ctx, doc)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("RejectsOtherKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, v := setupFn(ctl)
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedKeyId)).Return(mustNewTestKeyPerson(other), nil)
+		// Run & Verify
+		_, err := v.Verify(ctx, newSignedFn())
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("RejectsUnsignedDocument", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, v := setupFn(ctl)
+		doc := newSignedFn()
+		delete(doc, ldSignatureProperty)
+		// Run & Verify
+		_, err := v.Verify(ctx, doc)
+		assertNotEqual(t, err, nil)
+	})
+}
@@ -0,0 +1,84 @@
+package pub
+
+import (
+	"context"
+)
+
+// LogLevel is the severity of a logged event.
+type LogLevel int
+
+const (
+	// LogDebug events help diagnose interoperability issues, such as
+	// retrying a request with another HTTP Signature dialect.
+	LogDebug LogLevel = iota
+	// LogInfo events are expected in normal operation, such as dropping an
+	// activity from a blocked actor.
+	LogInfo
+	// LogWarn events may indicate a misbehaving peer, such as a request
+	// whose signature is rejected.
+	LogWarn
+	// LogError events indicate this server failed to do its part, such as
+	// failing to schedule a retry.
+	LogError
+)
+
+// String returns the lowercase name of the level.
+func (l LogLevel) String() string {
+	switch l {
+	case LogDebug:
+		return "debug"
+	case LogInfo:
+		return "info"
+	case LogWarn:
+		return "warn"
+	case LogError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Logger receives the noteworthy events of this library, such as rejected
+// signatures, dropped activities, and scheduled retries.
+//
+// Logging is silent by default. An application logs these events by adding
+// its Logger to the contexts it passes to this library with WithLogger,
+// usually once in the middleware serving its ActivityPub requests:
+//
+//	c = pub.WithLogger(c, myLogger)
+//
+// Implementations must be safe for concurrent use.
+type Logger interface {
+	// Log records the event described by the message. The keyvals are
+	// alternating keys and values adding structured detail, where each
+	// key is a string, suitable for structured logging libraries.
+	Log(c context.Context, level LogLevel, msg string, keyvals ...interface{})
+}
+
+// loggerKey is the context key of the Logger.
+type loggerKey struct{}
+
+// WithLogger returns a context whose events are logged by the Logger.
+func WithLogger(c context.Context, l Logger) context.Context {
+	return context.WithValue(c, loggerKey{}, l)
+}
+
+// LoggerFromContext returns the Logger added to the context by WithLogger, or
+// a Logger discarding every event if there is none.
+func LoggerFromContext(c context.Context) Logger {
+	if l, ok := c.Value(loggerKey{}).(Logger); ok {
+		return l
+	}
+	return nopLogger{}
+}
+
+// nopLogger discards every event.
+type nopLogger struct{}
+
+// Log does nothing.
+func (nopLogger) Log(c context.Context, level LogLevel, msg string, keyvals ...interface{}) {}
+
+// logEvent logs the event with the Logger of the context.
+func logEvent(c context.Context, level LogLevel, msg string, keyvals ...interface{}) {
+	LoggerFromContext(c).Log(c, level, msg, keyvals...)
+}
@@ -0,0 +1,45 @@
+package pub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestLoggerFromContext(t *testing.T) {
+	ctx := context.Background()
+	t.Run("SilentByDefault", func(t *testing.T) {
+		// Run & Verify
+		l := LoggerFromContext(ctx)
+		assertEqual(t, l, Logger(nopLogger{}))
+		l.Log(ctx, LogError, "discarded")
+	})
+	t.Run("ReturnsLoggerOfContext", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		l := NewMockLogger(ctl)
+		logCtx := WithLogger(ctx, l)
+		// Mock
+		l.EXPECT().Log(logCtx, LogInfo, "test event", "key", "value")
+		// Run & Verify
+		logEvent(logCtx, LogInfo, "test event", "key", "value")
+	})
+}
+
+func TestLogLevelString(t *testing.T) {
+	tests := []struct {
+		level LogLevel
+		want  string
+	}{
+		{LogDebug, "debug"},
+		{LogInfo, "info"},
+		{LogWarn, "warn"},
+		{LogError, "error"},
+		{LogLevel(42), "unknown"},
+	}
+	for _, test := range tests {
+		assertEqual(t, test.level.String(), test.want)
+	}
+}
@@ -0,0 +1,223 @@
+package pub
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// activityJSONMediaType is the ActivityPub media type.
+	activityJSONMediaType = "application/activity+json"
+	// htmlMediaType is the media type of webpages.
+	htmlMediaType = "text/html"
+	// activityStreamsProfile is the profile of the JSON-LD media type
+	// identifying ActivityStreams.
+	activityStreamsProfile = "https://www.w3.org/ns/activitystreams"
+	// profileParam is the media type parameter listing profiles.
+	profileParam = "profile"
+)
+
+// IsActivityPubMediaType returns true if the Content-Type header value is
+// 'application/activity+json', or 'application/ld+json' with the ActivityStreams
+// profile among its profiles.
+//
+// The value is parsed leniently: whitespace around its parts is ignored, the
+// media type is not case sensitive, the profile may or may not be quoted, and
+// other parameters may appear in any order.
+func IsActivityPubMediaType(contentType string) bool {
+	m, ok := parseMediaType(contentType)
+	return ok && m.isActivityPub()
+}
+
+// AcceptsActivityPub returns true if the Accept header value explicitly lists
+// an ActivityPub media type, as recognized by IsActivityPubMediaType, with a
+// weight at least as high as the weight of 'text/html'.
+//
+// Wildcards such as '*/*' do not count as accepting ActivityPub, so that
+// browsers are not served ActivityStreams data. When several header values are
+// given, they are joined by commas before calling this function.
+func AcceptsActivityPub(accept string) bool {
+	html := mediaType{typ: "text", subtype: "html"}
+	apQ, htmlQ, htmlSpecificity := 0.0, 0.0, -1
+	for _, r := range parseAccept(accept) {
+		if r.isActivityPub() && r.q > apQ {
+			apQ = r.q
+		}
+		if s := r.match(html); s > htmlSpecificity {
+			htmlQ, htmlSpecificity = r.q, s
+		}
+	}
+	return apQ > 0 && apQ >= htmlQ
+}
+
+// isActivityPub returns true if the media type is an ActivityPub one.
+func (m mediaType) isActivityPub() bool {
+	if m.typ != "application" {
+		return false
+	}
+	switch m.subtype {
+	case "activity+json":
+		return true
+	case "ld+json":
+		return hasProfile(m.params[profileParam], activityStreamsProfile)
+	default:
+		return false
+	}
+}
+
+// hasProfile returns true if the space separated list of profiles contains the
+// profile.
+func hasProfile(profiles, profile string) bool {
+	for _, p := range strings.Fields(profiles) {
+		if p == profile {
+			return true
+		}
+	}
+	return false
+}
+
+// mediaType is a media type, or a media range of an Accept header, with its
+// parameters other than the weight.
+type mediaType struct {
+	typ     string
+	subtype string
+	params  map[string]string
+	// q is the weight of a media range, which is 1 unless specified.
+	q float64
+}
+
+// splitUnquoted splits s on each sep that is not within a quoted string.
+func splitUnquoted(s string, sep byte) []string {
+	var parts []string
+	quoted, escaped := false, false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && s[i] == '\\':
+			escaped = true
+		case s[i] == '"':
+			quoted = !quoted
+		case !quoted && s[i] == sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// parseMediaType parses a media type leniently: whitespace around its parts is
+// ignored, the type, subtype, and parameter names are not case sensitive, and
+// parameter values may or may not be quoted. The ok is false if it has no
+// type and subtype.
+func parseMediaType(s string) (m mediaType, ok bool) {
+	parts := splitUnquoted(s, ';')
+	full := strings.ToLower(strings.TrimSpace(parts[0]))
+	slash := strings.Index(full, "/")
+	if slash <= 0 || slash == len(full)-1 {
+		return
+	}
+	m.typ = strings.TrimSpace(full[:slash])
+	m.subtype = strings.TrimSpace(full[slash+1:])
+	m.q = 1
+	for _, p := range parts[1:] {
+		eq := strings.Index(p, "=")
+		if eq < 0 {
+			continue
+		}
+		k := strings.ToLower(strings.TrimSpace(p[:eq]))
+		v := strings.TrimSpace(p[eq+1:])
+		if len(v) >= 2 && v[0] == '"' && v[len(v)-1] == '"' {
+			v = strings.Replace(v[1:len(v)-1], `\"`, `"`, -1)
+		}
+		if k == "q" {
+			if q, err := strconv.ParseFloat(v, 64); err == nil && q >= 0 && q <= 1 {
+				m.q = q
+			}
+			continue
+		}
+		if m.params == nil {
+			m.params = make(map[string]string)
+		}
+		m.params[k] = v
+	}
+	return m, true
+}
+
+// parseAccept parses the media ranges of an Accept header, skipping the ones
+// that are malformed. An empty header accepts any media type.
+func parseAccept(accept string) []mediaType {
+	if len(strings.TrimSpace(accept)) == 0 {
+		return []mediaType{{typ: "*", subtype: "*", q: 1}}
+	}
+	var ranges []mediaType
+	for _, r := range splitUnquoted(accept, ',') {
+		if m, ok := parseMediaType(r); ok {
+			ranges = append(ranges, m)
+		}
+	}
+	return ranges
+}
+
+// match returns how specifically the media range matches the media type, or
+// -1 if it does not match. Parameters of the range must equal those of the
+// media type; parameters only of the media type do not prevent a match.
+func (r mediaType) match(m mediaType) int {
+	switch {
+	case r.typ == "*" && r.subtype == "*":
+		return 0
+	case r.typ != m.typ:
+		return -1
+	case r.subtype == "*":
+		return 1
+	case r.subtype != m.subtype:
+		return -1
+	}
+	for k, v := range r.params {
+		if k == profileParam {
+			if !anyProfile(m.params[k], v) {
+				return -1
+			}
+		} else if m.params[k] != v {
+			return -1
+		}
+	}
+	return 2 + len(r.params)
+}
+
+// anyProfile returns true if any of the space separated profiles of the media
+// range is among those of the media type.
+func anyProfile(profiles, rangeProfiles string) bool {
+	for _, p := range strings.Fields(rangeProfiles) {
+		if hasProfile(profiles, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// negotiateMediaType returns the offered media type most preferred by the
+// Accept header, or the empty string if none is acceptable. The weight of an
+// offer is the one of the most specific media range matching it. Offers of
+// equal weight are preferred in the order given.
+func negotiateMediaType(accept string, offers []string) string {
+	ranges := parseAccept(accept)
+	best, bestQ := "", 0.0
+	for _, o := range offers {
+		m, ok := parseMediaType(o)
+		if !ok {
+			continue
+		}
+		q, specificity := 0.0, -1
+		for _, r := range ranges {
+			if s := r.match(m); s > specificity {
+				q, specificity = r.q, s
+			}
+		}
+		if q > bestQ {
+			best, bestQ = o, q
+		}
+	}
+	return best
+}
@@ -0,0 +1,30 @@
+package pub
+
+import (
+	"testing"
+)
+
+func TestNegotiateMediaType(t *testing.T) {
+	offers := []string{contentTypeHeaderValue, activityJSONMediaType, htmlMediaType}
+	tests := []struct {
+		name   string
+		accept string
+		expect string
+	}{
+		{"EmptyAcceptsFirstOffer", "", contentTypeHeaderValue},
+		{"WildcardAcceptsFirstOffer", "*/*", contentTypeHeaderValue},
+		{"ExactMatch", "application/activity+json", activityJSONMediaType},
+		{"UnquotedProfile", "application/ld+json;profile=https://www.w3.org/ns/activitystreams", contentTypeHeaderValue},
+		{"OtherProfileDoesNotMatch", `application/ld+json; profile="https://example.com/other"`, ""},
+		{"HighestWeightWins", "application/activity+json;q=0.5, text/html", htmlMediaType},
+		{"SpecificRangeOverridesWildcard", "*/*;q=0.9, application/ld+json;q=0.1", activityJSONMediaType},
+		{"ZeroWeightIsNotAcceptable", "text/html, */*;q=0", htmlMediaType},
+		{"CaseInsensitive", "Application/Activity+JSON", activityJSONMediaType},
+		{"NoneAcceptable", "image/png", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assertEqual(t, negotiateMediaType(test.accept, offers), test.expect)
+		})
+	}
+}
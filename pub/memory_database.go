@@ -0,0 +1,297 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+var _ Database = &MemoryDatabase{}
+
+// OutboxPager must be implemented by MemoryDatabase.
+var _ OutboxPager = &MemoryDatabase{}
+
+// MemoryDatabase is a Database that keeps all of its data in memory. It is
+// safe for concurrent use.
+//
+// It is intended for tests and prototypes: nothing is persisted, and the
+// entire inbox and outbox are returned as a single page.
+//
+// Values are stored in their serialized form, so modifying a value after
+// passing it to, or receiving it from, the MemoryDatabase does not modify the
+// stored value.
+//
+// Actors stored with an 'inbox' and 'outbox' are mapped to them, so that
+// ActorForInbox, ActorForOutbox, and OutboxForInbox work without further
+// configuration. When an actor has no 'followers', 'following', or 'liked'
+// IRI, the collection is stored at the actor's IRI with "/followers",
+// "/following", or "/liked" appended.
+type MemoryDatabase struct {
+	base  *url.URL
+	locks iriLocks
+	// mu guards the fields below.
+	mu           sync.RWMutex
+	objects      map[string][]byte
+	boxes        map[string][]*url.URL
+	inboxActors  map[string]*url.URL
+	outboxActors map[string]*url.URL
+	inboxOutbox  map[string]*url.URL
+	nextID       int
+}
+
+// NewMemoryDatabase creates an empty MemoryDatabase owning the IRIs with the
+// same scheme and host as the base IRI. New ids are created beneath the base
+// IRI.
+func NewMemoryDatabase(base *url.URL) *MemoryDatabase {
+	return &MemoryDatabase{
+		base:         base,
+		objects:      make(map[string][]byte),
+		boxes:        make(map[string][]*url.URL),
+		inboxActors:  make(map[string]*url.URL),
+		outboxActors: make(map[string]*url.URL),
+		inboxOutbox:  make(map[string]*url.URL),
+	}
+}
+
+// Lock takes the lock for the IRI, blocking until it is available.
+func (m *MemoryDatabase) Lock(c context.Context, id *url.URL) error {
+	return m.locks.Lock(id)
+}
+
+// Unlock releases the lock for the IRI.
+func (m *MemoryDatabase) Unlock(c context.Context, id *url.URL) error {
+	return m.locks.Unlock(id)
+}
+
+// InboxContains returns true if the inbox contains the id.
+func (m *MemoryDatabase) InboxContains(c context.Context, inbox, id *url.URL) (contains bool, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, item := range m.boxes[inbox.String()] {
+		if item.String() == id.String() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetInbox returns the entire inbox as a single page.
+func (m *MemoryDatabase) GetInbox(c context.Context, inboxIRI *url.URL) (inbox vocab.ActivityStreamsOrderedCollectionPage, err error) {
+	return m.getBoxPage(inboxIRI), nil
+}
+
+// SetInbox replaces the inbox with the items of the page. The page must have
+// the id of the inbox.
+func (m *MemoryDatabase) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	return m.setBoxPage(inbox)
+}
+
+// Owns returns true if the IRI has the same scheme and host as the base IRI.
+func (m *MemoryDatabase) Owns(c context.Context, id *url.URL) (owns bool, err error) {
+	return strings.EqualFold(id.Scheme, m.base.Scheme) && strings.EqualFold(id.Host, m.base.Host), nil
+}
+
+// ActorForOutbox returns the actor stored with the outbox.
+func (m *MemoryDatabase) ActorForOutbox(c context.Context, outboxIRI *url.URL) (actorIRI *url.URL, err error) {
+	return m.lookup(m.outboxActors, outboxIRI, "actor for outbox")
+}
+
+// ActorForInbox returns the actor stored with the inbox.
+func (m *MemoryDatabase) ActorForInbox(c context.Context, inboxIRI *url.URL) (actorIRI *url.URL, err error) {
+	return m.lookup(m.inboxActors, inboxIRI, "actor for inbox")
+}
+
+// OutboxForInbox returns the outbox of the actor stored with the inbox.
+func (m *MemoryDatabase) OutboxForInbox(c context.Context, inboxIRI *url.URL) (outboxIRI *url.URL, err error) {
+	return m.lookup(m.inboxOutbox, inboxIRI, "outbox for inbox")
+}
+
+// Exists returns true if a value, inbox, or outbox is stored with the id.
+func (m *MemoryDatabase) Exists(c context.Context, id *url.URL) (exists bool, err error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, exists = m.objects[id.String()]
+	if !exists {
+		_, exists = m.boxes[id.String()]
+	}
+	return
+}
+
+// Get returns the value stored with the id. An inbox or outbox is returned as
+// an OrderedCollection of all of its items.
+func (m *MemoryDatabase) Get(c context.Context, id *url.URL) (value vocab.Type, err error) {
+	m.mu.RLock()
+	b, ok := m.objects[id.String()]
+	_, isBox := m.boxes[id.String()]
+	m.mu.RUnlock()
+	if ok {
+		return deserializeValue(c, b)
+	} else if isBox {
+		return m.getBox(id), nil
+	}
+	return nil, fmt.Errorf("no value with id: %s", id)
+}
+
+// Create stores a new value. It is an error if a value with the same id is
+// already stored.
+func (m *MemoryDatabase) Create(c context.Context, asType vocab.Type) error {
+	return m.store(asType, true)
+}
+
+// Update replaces the stored value with the same id.
+func (m *MemoryDatabase) Update(c context.Context, asType vocab.Type) error {
+	return m.store(asType, false)
+}
+
+// Delete removes the value stored with the id.
+func (m *MemoryDatabase) Delete(c context.Context, id *url.URL) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.objects[id.String()]; !ok {
+		return fmt.Errorf("no value with id: %s", id)
+	}
+	delete(m.objects, id.String())
+	return nil
+}
+
+// GetOutbox returns the entire outbox as a single page.
+func (m *MemoryDatabase) GetOutbox(c context.Context, outboxIRI *url.URL) (inbox vocab.ActivityStreamsOrderedCollectionPage, err error) {
+	return m.getBoxPage(outboxIRI), nil
+}
+
+// SetOutbox replaces the outbox with the items of the page. The page must
+// have the id of the outbox.
+func (m *MemoryDatabase) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	return m.setBoxPage(outbox)
+}
+
+// OutboxItems returns the items of the outbox in the range, and the number of
+// items in the outbox. A negative offset or limit is an error.
+func (m *MemoryDatabase) OutboxItems(c context.Context, outboxIRI *url.URL, offset, limit int) (items []*url.URL, totalItems int, err error) {
+	if offset < 0 || limit < 0 {
+		err = fmt.Errorf("invalid range of outbox items: offset %d, limit %d", offset, limit)
+		return
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	box := m.boxes[outboxIRI.String()]
+	totalItems = len(box)
+	if offset >= len(box) {
+		return
+	}
+	end := len(box)
+	if limit < end-offset {
+		end = offset + limit
+	}
+	items = append(items, box[offset:end]...)
+	return
+}
+
+// NewID returns a new id beneath the base IRI, such as
+// "https://example.com/note/1" for a Note.
+func (m *MemoryDatabase) NewID(c context.Context, t vocab.Type) (id *url.URL, err error) {
+	m.mu.Lock()
+	m.nextID++
+	n := m.nextID
+	m.mu.Unlock()
+	return url.Parse(fmt.Sprintf("%s/%s/%d", strings.TrimSuffix(m.base.String(), "/"), strings.ToLower(t.GetTypeName()), n))
+}
+
+// Followers returns the 'followers' collection of the actor, creating it if
+// it does not yet exist.
+func (m *MemoryDatabase) Followers(c context.Context, actorIRI *url.URL) (followers vocab.ActivityStreamsCollection, err error) {
+	return actorCollection(c, m, actorIRI, "followers", followersIRI)
+}
+
+// Following returns the 'following' collection of the actor, creating it if
+// it does not yet exist.
+func (m *MemoryDatabase) Following(c context.Context, actorIRI *url.URL) (following vocab.ActivityStreamsCollection, err error) {
+	return actorCollection(c, m, actorIRI, "following", followingIRI)
+}
+
+// Liked returns the 'liked' collection of the actor, creating it if it does
+// not yet exist.
+func (m *MemoryDatabase) Liked(c context.Context, actorIRI *url.URL) (liked vocab.ActivityStreamsCollection, err error) {
+	return actorCollection(c, m, actorIRI, "liked", likedIRI)
+}
+
+// lookup returns the IRI mapped to by the key IRI.
+func (m *MemoryDatabase) lookup(mapping map[string]*url.URL, key *url.URL, what string) (*url.URL, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	v, ok := mapping[key.String()]
+	if !ok {
+		return nil, fmt.Errorf("no %s: %s", what, key)
+	}
+	return v, nil
+}
+
+// store serializes and stores the value, and maps the inbox and outbox of an
+// actor to it.
+func (m *MemoryDatabase) store(asType vocab.Type, create bool) error {
+	id, err := GetId(asType)
+	if err != nil {
+		return err
+	}
+	b, err := serializeValue(asType)
+	if err != nil {
+		return err
+	}
+	inbox, outbox := actorBoxes(asType)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, exists := m.objects[id.String()]
+	if create && exists {
+		return fmt.Errorf("value already exists with id: %s", id)
+	} else if !create && !exists {
+		return fmt.Errorf("no value with id: %s", id)
+	}
+	m.objects[id.String()] = b
+	if inbox != nil {
+		m.inboxActors[inbox.String()] = id
+		if _, ok := m.boxes[inbox.String()]; !ok {
+			m.boxes[inbox.String()] = nil
+		}
+	}
+	if outbox != nil {
+		m.outboxActors[outbox.String()] = id
+		if _, ok := m.boxes[outbox.String()]; !ok {
+			m.boxes[outbox.String()] = nil
+		}
+	}
+	if inbox != nil && outbox != nil {
+		m.inboxOutbox[inbox.String()] = outbox
+	}
+	return nil
+}
+
+// getBoxPage returns the entire box as a single OrderedCollectionPage with the
+// id of the box.
+func (m *MemoryDatabase) getBoxPage(boxIRI *url.URL) vocab.ActivityStreamsOrderedCollectionPage {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return newBoxPage(boxIRI, m.boxes[boxIRI.String()])
+}
+
+// getBox returns the entire box as an OrderedCollection.
+func (m *MemoryDatabase) getBox(boxIRI *url.URL) vocab.ActivityStreamsOrderedCollection {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return newBoxCollection(boxIRI, m.boxes[boxIRI.String()])
+}
+
+// setBoxPage replaces the items of the box with the id of the page.
+func (m *MemoryDatabase) setBoxPage(page vocab.ActivityStreamsOrderedCollectionPage) error {
+	boxIRI, items, err := boxPageItems(page)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.boxes[boxIRI.String()] = items
+	return nil
+}
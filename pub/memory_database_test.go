@@ -0,0 +1,235 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+func TestMemoryDatabase(t *testing.T) {
+	ctx := context.Background()
+	const testMyActorIRI = "https://example.com/addison"
+	newActorFn := func() vocab.ActivityStreamsPerson {
+		p := streams.NewActivityStreamsPerson()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testMyActorIRI))
+		p.SetJSONLDId(id)
+		inbox := streams.NewActivityStreamsInboxProperty()
+		inbox.SetIRI(mustParse(testMyInboxIRI))
+		p.SetActivityStreamsInbox(inbox)
+		outbox := streams.NewActivityStreamsOutboxProperty()
+		outbox.SetIRI(mustParse(testMyOutboxIRI))
+		p.SetActivityStreamsOutbox(outbox)
+		return p
+	}
+	setupFn := func() *MemoryDatabase {
+		setupData()
+		return NewMemoryDatabase(mustParse("https://example.com"))
+	}
+	t.Run("CreatesAndGetsValue", func(t *testing.T) {
+		db := setupFn()
+		err := db.Create(ctx, testMyNote)
+		assertEqual(t, err, nil)
+		exists, err := db.Exists(ctx, mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, exists, true)
+		got, err := db.Get(ctx, mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, mustSerializeToBytes(got), mustSerializeToBytes(testMyNote))
+	})
+	t.Run("ErrorIfCreatedTwice", func(t *testing.T) {
+		db := setupFn()
+		err := db.Create(ctx, testMyNote)
+		assertEqual(t, err, nil)
+		err = db.Create(ctx, testMyNote)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("UpdatesValue", func(t *testing.T) {
+		db := setupFn()
+		err := db.Create(ctx, testMyNote)
+		assertEqual(t, err, nil)
+		name := streams.NewActivityStreamsNameProperty()
+		name.AppendXMLSchemaString("Updated")
+		testMyNote.SetActivityStreamsName(name)
+		err = db.Update(ctx, testMyNote)
+		assertEqual(t, err, nil)
+		got, err := db.Get(ctx, mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, mustSerializeToBytes(got), mustSerializeToBytes(testMyNote))
+	})
+	t.Run("ErrorIfUpdatingMissingValue", func(t *testing.T) {
+		db := setupFn()
+		err := db.Update(ctx, testMyNote)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("DeletesValue", func(t *testing.T) {
+		db := setupFn()
+		err := db.Create(ctx, testMyNote)
+		assertEqual(t, err, nil)
+		err = db.Delete(ctx, mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		exists, err := db.Exists(ctx, mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, exists, false)
+	})
+	t.Run("StoresCopyOfValue", func(t *testing.T) {
+		db := setupFn()
+		err := db.Create(ctx, testMyNote)
+		assertEqual(t, err, nil)
+		want := mustSerializeToBytes(testMyNote)
+		testMyNote.SetActivityStreamsName(nil)
+		got, err := db.Get(ctx, mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, mustSerializeToBytes(got), want)
+	})
+	t.Run("OwnsIRIsOfBaseHost", func(t *testing.T) {
+		db := setupFn()
+		owns, err := db.Owns(ctx, mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, owns, true)
+		owns, err = db.Owns(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, owns, false)
+	})
+	t.Run("CreatesNewIDsBeneathBase", func(t *testing.T) {
+		db := setupFn()
+		id1, err := db.NewID(ctx, testMyNote)
+		assertEqual(t, err, nil)
+		id2, err := db.NewID(ctx, testMyNote)
+		assertEqual(t, err, nil)
+		assertEqual(t, id1.String(), "https://example.com/note/1")
+		assertEqual(t, id2.String(), "https://example.com/note/2")
+	})
+	t.Run("MapsActorBoxes", func(t *testing.T) {
+		db := setupFn()
+		err := db.Create(ctx, newActorFn())
+		assertEqual(t, err, nil)
+		actor, err := db.ActorForInbox(ctx, mustParse(testMyInboxIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testMyActorIRI)
+		actor, err = db.ActorForOutbox(ctx, mustParse(testMyOutboxIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testMyActorIRI)
+		outbox, err := db.OutboxForInbox(ctx, mustParse(testMyInboxIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, outbox.String(), testMyOutboxIRI)
+		_, err = db.ActorForInbox(ctx, mustParse(testFederatedActorIRI))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("SetsAndGetsInbox", func(t *testing.T) {
+		db := setupFn()
+		err := db.Create(ctx, newActorFn())
+		assertEqual(t, err, nil)
+		inbox, err := db.GetInbox(ctx, mustParse(testMyInboxIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, inbox.GetActivityStreamsOrderedItems().Len(), 0)
+		inbox.GetActivityStreamsOrderedItems().PrependIRI(mustParse(testNewActivityIRI))
+		err = db.SetInbox(ctx, inbox)
+		assertEqual(t, err, nil)
+		contains, err := db.InboxContains(ctx, mustParse(testMyInboxIRI), mustParse(testNewActivityIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, contains, true)
+		got, err := db.Get(ctx, mustParse(testMyInboxIRI))
+		assertEqual(t, err, nil)
+		col, ok := got.(vocab.ActivityStreamsOrderedCollection)
+		assertEqual(t, ok, true)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 1)
+		assertEqual(t, col.GetActivityStreamsOrderedItems().At(0).GetIRI().String(), testNewActivityIRI)
+	})
+	t.Run("GetsRangeOfOutboxItems", func(t *testing.T) {
+		db := setupFn()
+		err := db.Create(ctx, newActorFn())
+		assertEqual(t, err, nil)
+		err = db.SetOutbox(ctx, newBoxPage(mustParse(testMyOutboxIRI), []*url.URL{
+			mustParse(testNewActivityIRI3),
+			mustParse(testNewActivityIRI2),
+			mustParse(testNewActivityIRI),
+		}))
+		assertEqual(t, err, nil)
+		items, total, err := db.OutboxItems(ctx, mustParse(testMyOutboxIRI), 1, 5)
+		assertEqual(t, err, nil)
+		assertEqual(t, total, 3)
+		assertEqual(t, len(items), 2)
+		assertEqual(t, items[0].String(), testNewActivityIRI2)
+		assertEqual(t, items[1].String(), testNewActivityIRI)
+		items, total, err = db.OutboxItems(ctx, mustParse(testMyOutboxIRI), 3, 5)
+		assertEqual(t, err, nil)
+		assertEqual(t, total, 3)
+		assertEqual(t, len(items), 0)
+		items, total, err = db.OutboxItems(ctx, mustParse(testMyOutboxIRI), 0, 0)
+		assertEqual(t, err, nil)
+		assertEqual(t, total, 3)
+		assertEqual(t, len(items), 0)
+	})
+	t.Run("RejectsNegativeRangeOfOutboxItems", func(t *testing.T) {
+		db := setupFn()
+		err := db.Create(ctx, newActorFn())
+		assertEqual(t, err, nil)
+		err = db.SetOutbox(ctx, newBoxPage(mustParse(testMyOutboxIRI), []*url.URL{
+			mustParse(testNewActivityIRI),
+		}))
+		assertEqual(t, err, nil)
+		_, _, err = db.OutboxItems(ctx, mustParse(testMyOutboxIRI), -2, 1)
+		assertNotEqual(t, err, nil)
+		_, _, err = db.OutboxItems(ctx, mustParse(testMyOutboxIRI), 0, -1)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("CreatesDefaultFollowersCollection", func(t *testing.T) {
+		db := setupFn()
+		err := db.Create(ctx, newActorFn())
+		assertEqual(t, err, nil)
+		followers, err := db.Followers(ctx, mustParse(testMyActorIRI))
+		assertEqual(t, err, nil)
+		id, err := GetId(followers)
+		assertEqual(t, err, nil)
+		assertEqual(t, id.String(), testMyActorIRI+"/followers")
+		followers.GetActivityStreamsItems().AppendIRI(mustParse(testFederatedActorIRI))
+		err = db.Update(ctx, followers)
+		assertEqual(t, err, nil)
+		followers, err = db.Followers(ctx, mustParse(testMyActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, followers.GetActivityStreamsItems().Len(), 1)
+	})
+	t.Run("UsesActorFollowingCollection", func(t *testing.T) {
+		db := setupFn()
+		actor := newActorFn()
+		following := streams.NewActivityStreamsFollowingProperty()
+		following.SetIRI(mustParse("https://example.com/addison/follows"))
+		actor.SetActivityStreamsFollowing(following)
+		err := db.Create(ctx, actor)
+		assertEqual(t, err, nil)
+		col, err := db.Following(ctx, mustParse(testMyActorIRI))
+		assertEqual(t, err, nil)
+		id, err := GetId(col)
+		assertEqual(t, err, nil)
+		assertEqual(t, id.String(), "https://example.com/addison/follows")
+	})
+	t.Run("LocksAreExclusive", func(t *testing.T) {
+		db := setupFn()
+		id := mustParse(testNoteId1)
+		err := db.Lock(ctx, id)
+		assertEqual(t, err, nil)
+		locked := make(chan struct{})
+		go func() {
+			db.Lock(ctx, id)
+			close(locked)
+			db.Unlock(ctx, id)
+		}()
+		select {
+		case <-locked:
+			t.Fatalf("expected lock to be held")
+		default:
+		}
+		err = db.Unlock(ctx, id)
+		assertEqual(t, err, nil)
+		<-locked
+	})
+	t.Run("ErrorIfUnlockingUnknownId", func(t *testing.T) {
+		db := setupFn()
+		err := db.Unlock(ctx, &url.URL{Scheme: "https", Host: "example.com"})
+		assertNotEqual(t, err, nil)
+	})
+}
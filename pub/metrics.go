@@ -0,0 +1,134 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Metrics receives counters and timings of federation internals, so that an
+// application can export them to its monitoring system, such as Prometheus.
+//
+// When the FederatingProtocol given to an Actor is also a Metrics, every
+// activity handled in an inbox is counted. Deliveries, signature verification,
+// and enqueued deliveries are measured by wrapping the Transport, the
+// RequestVerifier, and the DeliveryQueue with NewMetricsTransport,
+// NewMetricsVerifier, and NewMetricsDeliveryQueue.
+//
+// Implementations must be safe for concurrent use, and should return quickly,
+// as they are called while handling requests.
+type Metrics interface {
+	// InboxActivity counts an activity of the type, such as "Create",
+	// received in an inbox.
+	InboxActivity(c context.Context, typeName string)
+	// SignatureFailure counts a request whose signature could not be
+	// verified, and why.
+	SignatureFailure(c context.Context, err error)
+	// Delivery records an attempt to deliver to the host, how long it
+	// took, and its outcome. The error is nil if the delivery succeeded.
+	Delivery(c context.Context, host string, latency time.Duration, err error)
+	// Enqueued counts a delivery to the host handed to a DeliveryQueue to
+	// be sent later.
+	Enqueued(c context.Context, host string)
+}
+
+// Transport must be implemented by MetricsTransport.
+var _ Transport = &MetricsTransport{}
+
+// MetricsTransport wraps another Transport, recording every delivery attempt in
+// the Metrics.
+//
+// Wrapping the HttpSigTransport directly records each attempt to each host,
+// while wrapping a BudgetTransport or RateLimitedTransport also measures the
+// time spent waiting on them.
+type MetricsTransport struct {
+	Transport
+	clock   Clock
+	metrics Metrics
+}
+
+// NewMetricsTransport returns a Transport delivering with the wrapped Transport
+// and recording its latency, as measured by the clock, in the Metrics.
+func NewMetricsTransport(t Transport, clock Clock, m Metrics) *MetricsTransport {
+	return &MetricsTransport{
+		Transport: t,
+		clock:     clock,
+		metrics:   m,
+	}
+}
+
+// Deliver sends an ActivityStreams object with the wrapped Transport, recording
+// the attempt.
+func (m *MetricsTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	start := m.clock.Now()
+	err := m.Transport.Deliver(c, b, to)
+	m.metrics.Delivery(c, to.Host, m.clock.Now().Sub(start), err)
+	return err
+}
+
+// BatchDeliver sends concurrent deliveries with the wrapped Transport,
+// recording each attempt.
+func (m *MetricsTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	return batchDeliver(c, b, recipients, m.Deliver)
+}
+
+// RequestVerifier must be implemented by MetricsVerifier.
+var _ RequestVerifier = &MetricsVerifier{}
+
+// MetricsVerifier wraps another RequestVerifier, counting the requests it fails
+// to verify in the Metrics.
+type MetricsVerifier struct {
+	verifier RequestVerifier
+	metrics  Metrics
+}
+
+// NewMetricsVerifier returns a RequestVerifier verifying requests with the
+// wrapped RequestVerifier.
+func NewMetricsVerifier(v RequestVerifier, m Metrics) *MetricsVerifier {
+	return &MetricsVerifier{
+		verifier: v,
+		metrics:  m,
+	}
+}
+
+// Verify verifies the request with the wrapped RequestVerifier, counting a
+// failure.
+func (m *MetricsVerifier) Verify(c context.Context, r *http.Request) (actor *url.URL, err error) {
+	actor, err = m.verifier.Verify(c, r)
+	if err != nil {
+		m.metrics.SignatureFailure(c, err)
+	}
+	return
+}
+
+// DeliveryQueue must be implemented by MetricsDeliveryQueue.
+var _ DeliveryQueue = &MetricsDeliveryQueue{}
+
+// MetricsDeliveryQueue wraps another DeliveryQueue, counting the deliveries
+// enqueued in it in the Metrics.
+type MetricsDeliveryQueue struct {
+	DeliveryQueue
+	metrics Metrics
+}
+
+// NewMetricsDeliveryQueue returns a DeliveryQueue scheduling deliveries with the
+// wrapped DeliveryQueue.
+func NewMetricsDeliveryQueue(q DeliveryQueue, m Metrics) *MetricsDeliveryQueue {
+	return &MetricsDeliveryQueue{
+		DeliveryQueue: q,
+		metrics:       m,
+	}
+}
+
+// Enqueue schedules the deliveries with the wrapped DeliveryQueue, counting
+// each recipient if it succeeds.
+func (m *MetricsDeliveryQueue) Enqueue(c context.Context, boxIRI *url.URL, b []byte, recipients []*url.URL) error {
+	if err := m.DeliveryQueue.Enqueue(c, boxIRI, b, recipients); err != nil {
+		return err
+	}
+	for _, r := range recipients {
+		m.metrics.Enqueued(c, r.Host)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package pub
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestMetricsTransport(t *testing.T) {
+	ctx := context.Background()
+	t.Run("RecordsDelivery", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		cl := NewMockClock(ctl)
+		m := NewMockMetrics(ctl)
+		tp := NewMetricsTransport(wrapped, cl, m)
+		// Mock
+		gomock.InOrder(
+			cl.EXPECT().Now().Return(now()),
+			wrapped.EXPECT().Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)).Return(nil),
+			cl.EXPECT().Now().Return(now().Add(time.Second)),
+			m.EXPECT().Delivery(ctx, mustParse(testFederatedInboxIRI).Host, time.Second, nil),
+		)
+		// Run & Verify
+		err := tp.Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI))
+		assertEqual(t, err, nil)
+	})
+	t.Run("RecordsFailedDelivery", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		cl := NewMockClock(ctl)
+		m := NewMockMetrics(ctl)
+		tp := NewMetricsTransport(wrapped, cl, m)
+		// Mock
+		cl.EXPECT().Now().Return(now()).Times(2)
+		wrapped.EXPECT().Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)).Return(testErr)
+		m.EXPECT().Delivery(ctx, mustParse(testFederatedInboxIRI).Host, time.Duration(0), testErr)
+		// Run & Verify
+		err := tp.Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI))
+		assertEqual(t, err, testErr)
+	})
+	t.Run("RecordsEachBatchDelivery", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		cl := NewMockClock(ctl)
+		m := NewMockMetrics(ctl)
+		tp := NewMetricsTransport(wrapped, cl, m)
+		// Mock
+		cl.EXPECT().Now().Return(now()).Times(4)
+		wrapped.EXPECT().Deliver(ctx, testRespBody, gomock.Any()).Return(nil).Times(2)
+		m.EXPECT().Delivery(ctx, gomock.Any(), time.Duration(0), nil).Times(2)
+		// Run & Verify
+		err := tp.BatchDeliver(ctx, testRespBody, []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)})
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotRecordDereference", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		tp := NewMetricsTransport(wrapped, NewMockClock(ctl), NewMockMetrics(ctl))
+		// Mock
+		wrapped.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(testRespBody, nil)
+		// Run & Verify
+		b, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, testRespBody)
+	})
+}
+
+func TestMetricsVerifier(t *testing.T) {
+	ctx := context.Background()
+	t.Run("CountsFailure", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockRequestVerifier(ctl)
+		m := NewMockMetrics(ctl)
+		v := NewMetricsVerifier(wrapped, m)
+		r := httptest.NewRequest("POST", testMyInboxIRI, nil)
+		// Mock
+		wrapped.EXPECT().Verify(ctx, r).Return(nil, testErr)
+		m.EXPECT().SignatureFailure(ctx, testErr)
+		// Run & Verify
+		_, err := v.Verify(ctx, r)
+		assertEqual(t, err, testErr)
+	})
+	t.Run("DoesNotCountSuccess", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockRequestVerifier(ctl)
+		v := NewMetricsVerifier(wrapped, NewMockMetrics(ctl))
+		r := httptest.NewRequest("POST", testMyInboxIRI, nil)
+		// Mock
+		wrapped.EXPECT().Verify(ctx, r).Return(mustParse(testFederatedActorIRI), nil)
+		// Run & Verify
+		actor, err := v.Verify(ctx, r)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testFederatedActorIRI)
+	})
+}
+
+func TestMetricsDeliveryQueue(t *testing.T) {
+	ctx := context.Background()
+	t.Run("CountsEachRecipient", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockDeliveryQueue(ctl)
+		m := NewMockMetrics(ctl)
+		q := NewMetricsDeliveryQueue(wrapped, m)
+		recipients := []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedInboxIRI)}
+		// Mock
+		wrapped.EXPECT().Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, recipients)
+		m.EXPECT().Enqueued(ctx, recipients[0].Host)
+		m.EXPECT().Enqueued(ctx, recipients[1].Host)
+		// Run & Verify
+		err := q.Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, recipients)
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotCountIfEnqueueFails", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockDeliveryQueue(ctl)
+		q := NewMetricsDeliveryQueue(wrapped, NewMockMetrics(ctl))
+		recipients := []*url.URL{mustParse(testFederatedActorIRI)}
+		// Mock
+		wrapped.EXPECT().Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, recipients).Return(testErr)
+		// Run & Verify
+		err := q.Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, recipients)
+		assertEqual(t, err, testErr)
+	})
+}
@@ -0,0 +1,195 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: actor.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	context "context"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	gomock "github.com/golang/mock/gomock"
+	http "net/http"
+	url "net/url"
+	reflect "reflect"
+)
+
+// MockActor is a mock of Actor interface
+type MockActor struct {
+	ctrl     *gomock.Controller
+	recorder *MockActorMockRecorder
+}
+
+// MockActorMockRecorder is the mock recorder for MockActor
+type MockActorMockRecorder struct {
+	mock *MockActor
+}
+
+// NewMockActor creates a new mock instance
+func NewMockActor(ctrl *gomock.Controller) *MockActor {
+	mock := &MockActor{ctrl: ctrl}
+	mock.recorder = &MockActorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockActor) EXPECT() *MockActorMockRecorder {
+	return m.recorder
+}
+
+// PostInbox mocks base method
+func (m *MockActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostInbox", c, w, r)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostInbox indicates an expected call of PostInbox
+func (mr *MockActorMockRecorder) PostInbox(c, w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostInbox", reflect.TypeOf((*MockActor)(nil).PostInbox), c, w, r)
+}
+
+// GetInbox mocks base method
+func (m *MockActor) GetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInbox", c, w, r)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInbox indicates an expected call of GetInbox
+func (mr *MockActorMockRecorder) GetInbox(c, w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInbox", reflect.TypeOf((*MockActor)(nil).GetInbox), c, w, r)
+}
+
+// PostOutbox mocks base method
+func (m *MockActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostOutbox", c, w, r)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostOutbox indicates an expected call of PostOutbox
+func (mr *MockActorMockRecorder) PostOutbox(c, w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostOutbox", reflect.TypeOf((*MockActor)(nil).PostOutbox), c, w, r)
+}
+
+// GetOutbox mocks base method
+func (m *MockActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutbox", c, w, r)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutbox indicates an expected call of GetOutbox
+func (mr *MockActorMockRecorder) GetOutbox(c, w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutbox", reflect.TypeOf((*MockActor)(nil).GetOutbox), c, w, r)
+}
+
+// MockFederatingActor is a mock of FederatingActor interface
+type MockFederatingActor struct {
+	ctrl     *gomock.Controller
+	recorder *MockFederatingActorMockRecorder
+}
+
+// MockFederatingActorMockRecorder is the mock recorder for MockFederatingActor
+type MockFederatingActorMockRecorder struct {
+	mock *MockFederatingActor
+}
+
+// NewMockFederatingActor creates a new mock instance
+func NewMockFederatingActor(ctrl *gomock.Controller) *MockFederatingActor {
+	mock := &MockFederatingActor{ctrl: ctrl}
+	mock.recorder = &MockFederatingActorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFederatingActor) EXPECT() *MockFederatingActorMockRecorder {
+	return m.recorder
+}
+
+// PostInbox mocks base method
+func (m *MockFederatingActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostInbox", c, w, r)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostInbox indicates an expected call of PostInbox
+func (mr *MockFederatingActorMockRecorder) PostInbox(c, w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostInbox", reflect.TypeOf((*MockFederatingActor)(nil).PostInbox), c, w, r)
+}
+
+// GetInbox mocks base method
+func (m *MockFederatingActor) GetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInbox", c, w, r)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInbox indicates an expected call of GetInbox
+func (mr *MockFederatingActorMockRecorder) GetInbox(c, w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInbox", reflect.TypeOf((*MockFederatingActor)(nil).GetInbox), c, w, r)
+}
+
+// PostOutbox mocks base method
+func (m *MockFederatingActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostOutbox", c, w, r)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostOutbox indicates an expected call of PostOutbox
+func (mr *MockFederatingActorMockRecorder) PostOutbox(c, w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostOutbox", reflect.TypeOf((*MockFederatingActor)(nil).PostOutbox), c, w, r)
+}
+
+// GetOutbox mocks base method
+func (m *MockFederatingActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutbox", c, w, r)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutbox indicates an expected call of GetOutbox
+func (mr *MockFederatingActorMockRecorder) GetOutbox(c, w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutbox", reflect.TypeOf((*MockFederatingActor)(nil).GetOutbox), c, w, r)
+}
+
+// Send mocks base method
+func (m *MockFederatingActor) Send(c context.Context, outbox *url.URL, t vocab.Type) (Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", c, outbox, t)
+	ret0, _ := ret[0].(Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send
+func (mr *MockFederatingActorMockRecorder) Send(c, outbox, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockFederatingActor)(nil).Send), c, outbox, t)
+}
@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: authorized_fetch.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	http "net/http"
+	url "net/url"
+	reflect "reflect"
+)
+
+// MockRequestVerifier is a mock of RequestVerifier interface
+type MockRequestVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestVerifierMockRecorder
+}
+
+// MockRequestVerifierMockRecorder is the mock recorder for MockRequestVerifier
+type MockRequestVerifierMockRecorder struct {
+	mock *MockRequestVerifier
+}
+
+// NewMockRequestVerifier creates a new mock instance
+func NewMockRequestVerifier(ctrl *gomock.Controller) *MockRequestVerifier {
+	mock := &MockRequestVerifier{ctrl: ctrl}
+	mock.recorder = &MockRequestVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRequestVerifier) EXPECT() *MockRequestVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method
+func (m *MockRequestVerifier) Verify(c context.Context, r *http.Request) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", c, r)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify
+func (mr *MockRequestVerifierMockRecorder) Verify(c, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockRequestVerifier)(nil).Verify), c, r)
+}
+
+// MockFetchAuthorizer is a mock of FetchAuthorizer interface
+type MockFetchAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockFetchAuthorizerMockRecorder
+}
+
+// MockFetchAuthorizerMockRecorder is the mock recorder for MockFetchAuthorizer
+type MockFetchAuthorizerMockRecorder struct {
+	mock *MockFetchAuthorizer
+}
+
+// NewMockFetchAuthorizer creates a new mock instance
+func NewMockFetchAuthorizer(ctrl *gomock.Controller) *MockFetchAuthorizer {
+	mock := &MockFetchAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockFetchAuthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFetchAuthorizer) EXPECT() *MockFetchAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// Blocked mocks base method
+func (m *MockFetchAuthorizer) Blocked(c context.Context, actorIRIs []*url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Blocked", c, actorIRIs)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Blocked indicates an expected call of Blocked
+func (mr *MockFetchAuthorizerMockRecorder) Blocked(c, actorIRIs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Blocked", reflect.TypeOf((*MockFetchAuthorizer)(nil).Blocked), c, actorIRIs)
+}
+
+// AuthorizeFetch mocks base method
+func (m *MockFetchAuthorizer) AuthorizeFetch(c context.Context, requester, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeFetch", c, requester, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeFetch indicates an expected call of AuthorizeFetch
+func (mr *MockFetchAuthorizerMockRecorder) AuthorizeFetch(c, requester, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeFetch", reflect.TypeOf((*MockFetchAuthorizer)(nil).AuthorizeFetch), c, requester, id)
+}
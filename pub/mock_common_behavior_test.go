@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: pub/common_behavior.go
+// Source: common_behavior.go
 
 // Package pub is a generated GoMock package.
 package pub
@@ -97,3 +97,41 @@ func (mr *MockCommonBehaviorMockRecorder) NewTransport(c, actorBoxIRI, gofedAgen
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTransport", reflect.TypeOf((*MockCommonBehavior)(nil).NewTransport), c, actorBoxIRI, gofedAgent)
 }
+
+// MockBoxResolver is a mock of BoxResolver interface
+type MockBoxResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoxResolverMockRecorder
+}
+
+// MockBoxResolverMockRecorder is the mock recorder for MockBoxResolver
+type MockBoxResolverMockRecorder struct {
+	mock *MockBoxResolver
+}
+
+// NewMockBoxResolver creates a new mock instance
+func NewMockBoxResolver(ctrl *gomock.Controller) *MockBoxResolver {
+	mock := &MockBoxResolver{ctrl: ctrl}
+	mock.recorder = &MockBoxResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBoxResolver) EXPECT() *MockBoxResolverMockRecorder {
+	return m.recorder
+}
+
+// BoxIRI mocks base method
+func (m *MockBoxResolver) BoxIRI(c context.Context, r *http.Request) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BoxIRI", c, r)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BoxIRI indicates an expected call of BoxIRI
+func (mr *MockBoxResolverMockRecorder) BoxIRI(c, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BoxIRI", reflect.TypeOf((*MockBoxResolver)(nil).BoxIRI), c, r)
+}
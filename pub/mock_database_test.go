@@ -327,3 +327,1413 @@ func (mr *MockDatabaseMockRecorder) Liked(c, actorIRI interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Liked", reflect.TypeOf((*MockDatabase)(nil).Liked), c, actorIRI)
 }
+
+// MockTransactionalDatabase is a mock of TransactionalDatabase interface
+type MockTransactionalDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionalDatabaseMockRecorder
+}
+
+// MockTransactionalDatabaseMockRecorder is the mock recorder for MockTransactionalDatabase
+type MockTransactionalDatabaseMockRecorder struct {
+	mock *MockTransactionalDatabase
+}
+
+// NewMockTransactionalDatabase creates a new mock instance
+func NewMockTransactionalDatabase(ctrl *gomock.Controller) *MockTransactionalDatabase {
+	mock := &MockTransactionalDatabase{ctrl: ctrl}
+	mock.recorder = &MockTransactionalDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransactionalDatabase) EXPECT() *MockTransactionalDatabaseMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method
+func (m *MockTransactionalDatabase) Lock(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock
+func (mr *MockTransactionalDatabaseMockRecorder) Lock(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockTransactionalDatabase)(nil).Lock), c, id)
+}
+
+// Unlock mocks base method
+func (m *MockTransactionalDatabase) Unlock(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock
+func (mr *MockTransactionalDatabaseMockRecorder) Unlock(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockTransactionalDatabase)(nil).Unlock), c, id)
+}
+
+// InboxContains mocks base method
+func (m *MockTransactionalDatabase) InboxContains(c context.Context, inbox, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InboxContains", c, inbox, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InboxContains indicates an expected call of InboxContains
+func (mr *MockTransactionalDatabaseMockRecorder) InboxContains(c, inbox, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InboxContains", reflect.TypeOf((*MockTransactionalDatabase)(nil).InboxContains), c, inbox, id)
+}
+
+// GetInbox mocks base method
+func (m *MockTransactionalDatabase) GetInbox(c context.Context, inboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInbox", c, inboxIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsOrderedCollectionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInbox indicates an expected call of GetInbox
+func (mr *MockTransactionalDatabaseMockRecorder) GetInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInbox", reflect.TypeOf((*MockTransactionalDatabase)(nil).GetInbox), c, inboxIRI)
+}
+
+// SetInbox mocks base method
+func (m *MockTransactionalDatabase) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInbox", c, inbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInbox indicates an expected call of SetInbox
+func (mr *MockTransactionalDatabaseMockRecorder) SetInbox(c, inbox interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInbox", reflect.TypeOf((*MockTransactionalDatabase)(nil).SetInbox), c, inbox)
+}
+
+// Owns mocks base method
+func (m *MockTransactionalDatabase) Owns(c context.Context, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Owns", c, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Owns indicates an expected call of Owns
+func (mr *MockTransactionalDatabaseMockRecorder) Owns(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Owns", reflect.TypeOf((*MockTransactionalDatabase)(nil).Owns), c, id)
+}
+
+// ActorForOutbox mocks base method
+func (m *MockTransactionalDatabase) ActorForOutbox(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActorForOutbox", c, outboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActorForOutbox indicates an expected call of ActorForOutbox
+func (mr *MockTransactionalDatabaseMockRecorder) ActorForOutbox(c, outboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActorForOutbox", reflect.TypeOf((*MockTransactionalDatabase)(nil).ActorForOutbox), c, outboxIRI)
+}
+
+// ActorForInbox mocks base method
+func (m *MockTransactionalDatabase) ActorForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActorForInbox", c, inboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActorForInbox indicates an expected call of ActorForInbox
+func (mr *MockTransactionalDatabaseMockRecorder) ActorForInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActorForInbox", reflect.TypeOf((*MockTransactionalDatabase)(nil).ActorForInbox), c, inboxIRI)
+}
+
+// OutboxForInbox mocks base method
+func (m *MockTransactionalDatabase) OutboxForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxForInbox", c, inboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutboxForInbox indicates an expected call of OutboxForInbox
+func (mr *MockTransactionalDatabaseMockRecorder) OutboxForInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxForInbox", reflect.TypeOf((*MockTransactionalDatabase)(nil).OutboxForInbox), c, inboxIRI)
+}
+
+// Exists mocks base method
+func (m *MockTransactionalDatabase) Exists(c context.Context, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", c, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists
+func (mr *MockTransactionalDatabaseMockRecorder) Exists(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockTransactionalDatabase)(nil).Exists), c, id)
+}
+
+// Get mocks base method
+func (m *MockTransactionalDatabase) Get(c context.Context, id *url.URL) (vocab.Type, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, id)
+	ret0, _ := ret[0].(vocab.Type)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockTransactionalDatabaseMockRecorder) Get(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTransactionalDatabase)(nil).Get), c, id)
+}
+
+// Create mocks base method
+func (m *MockTransactionalDatabase) Create(c context.Context, asType vocab.Type) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, asType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockTransactionalDatabaseMockRecorder) Create(c, asType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactionalDatabase)(nil).Create), c, asType)
+}
+
+// Update mocks base method
+func (m *MockTransactionalDatabase) Update(c context.Context, asType vocab.Type) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, asType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockTransactionalDatabaseMockRecorder) Update(c, asType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransactionalDatabase)(nil).Update), c, asType)
+}
+
+// Delete mocks base method
+func (m *MockTransactionalDatabase) Delete(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockTransactionalDatabaseMockRecorder) Delete(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTransactionalDatabase)(nil).Delete), c, id)
+}
+
+// GetOutbox mocks base method
+func (m *MockTransactionalDatabase) GetOutbox(c context.Context, outboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutbox", c, outboxIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsOrderedCollectionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutbox indicates an expected call of GetOutbox
+func (mr *MockTransactionalDatabaseMockRecorder) GetOutbox(c, outboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutbox", reflect.TypeOf((*MockTransactionalDatabase)(nil).GetOutbox), c, outboxIRI)
+}
+
+// SetOutbox mocks base method
+func (m *MockTransactionalDatabase) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOutbox", c, outbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOutbox indicates an expected call of SetOutbox
+func (mr *MockTransactionalDatabaseMockRecorder) SetOutbox(c, outbox interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOutbox", reflect.TypeOf((*MockTransactionalDatabase)(nil).SetOutbox), c, outbox)
+}
+
+// NewID mocks base method
+func (m *MockTransactionalDatabase) NewID(c context.Context, t vocab.Type) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewID", c, t)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewID indicates an expected call of NewID
+func (mr *MockTransactionalDatabaseMockRecorder) NewID(c, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewID", reflect.TypeOf((*MockTransactionalDatabase)(nil).NewID), c, t)
+}
+
+// Followers mocks base method
+func (m *MockTransactionalDatabase) Followers(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Followers", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Followers indicates an expected call of Followers
+func (mr *MockTransactionalDatabaseMockRecorder) Followers(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Followers", reflect.TypeOf((*MockTransactionalDatabase)(nil).Followers), c, actorIRI)
+}
+
+// Following mocks base method
+func (m *MockTransactionalDatabase) Following(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Following", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Following indicates an expected call of Following
+func (mr *MockTransactionalDatabaseMockRecorder) Following(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Following", reflect.TypeOf((*MockTransactionalDatabase)(nil).Following), c, actorIRI)
+}
+
+// Liked mocks base method
+func (m *MockTransactionalDatabase) Liked(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Liked", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Liked indicates an expected call of Liked
+func (mr *MockTransactionalDatabaseMockRecorder) Liked(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Liked", reflect.TypeOf((*MockTransactionalDatabase)(nil).Liked), c, actorIRI)
+}
+
+// Begin mocks base method
+func (m *MockTransactionalDatabase) Begin(c context.Context) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", c)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin
+func (mr *MockTransactionalDatabaseMockRecorder) Begin(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockTransactionalDatabase)(nil).Begin), c)
+}
+
+// Commit mocks base method
+func (m *MockTransactionalDatabase) Commit(tx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit
+func (mr *MockTransactionalDatabaseMockRecorder) Commit(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockTransactionalDatabase)(nil).Commit), tx)
+}
+
+// Rollback mocks base method
+func (m *MockTransactionalDatabase) Rollback(tx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback
+func (mr *MockTransactionalDatabaseMockRecorder) Rollback(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockTransactionalDatabase)(nil).Rollback), tx)
+}
+
+// MockOutboxPager is a mock of OutboxPager interface
+type MockOutboxPager struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxPagerMockRecorder
+}
+
+// MockOutboxPagerMockRecorder is the mock recorder for MockOutboxPager
+type MockOutboxPagerMockRecorder struct {
+	mock *MockOutboxPager
+}
+
+// NewMockOutboxPager creates a new mock instance
+func NewMockOutboxPager(ctrl *gomock.Controller) *MockOutboxPager {
+	mock := &MockOutboxPager{ctrl: ctrl}
+	mock.recorder = &MockOutboxPagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOutboxPager) EXPECT() *MockOutboxPagerMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method
+func (m *MockOutboxPager) Lock(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock
+func (mr *MockOutboxPagerMockRecorder) Lock(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockOutboxPager)(nil).Lock), c, id)
+}
+
+// Unlock mocks base method
+func (m *MockOutboxPager) Unlock(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock
+func (mr *MockOutboxPagerMockRecorder) Unlock(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockOutboxPager)(nil).Unlock), c, id)
+}
+
+// InboxContains mocks base method
+func (m *MockOutboxPager) InboxContains(c context.Context, inbox, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InboxContains", c, inbox, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InboxContains indicates an expected call of InboxContains
+func (mr *MockOutboxPagerMockRecorder) InboxContains(c, inbox, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InboxContains", reflect.TypeOf((*MockOutboxPager)(nil).InboxContains), c, inbox, id)
+}
+
+// GetInbox mocks base method
+func (m *MockOutboxPager) GetInbox(c context.Context, inboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInbox", c, inboxIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsOrderedCollectionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInbox indicates an expected call of GetInbox
+func (mr *MockOutboxPagerMockRecorder) GetInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInbox", reflect.TypeOf((*MockOutboxPager)(nil).GetInbox), c, inboxIRI)
+}
+
+// SetInbox mocks base method
+func (m *MockOutboxPager) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInbox", c, inbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInbox indicates an expected call of SetInbox
+func (mr *MockOutboxPagerMockRecorder) SetInbox(c, inbox interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInbox", reflect.TypeOf((*MockOutboxPager)(nil).SetInbox), c, inbox)
+}
+
+// Owns mocks base method
+func (m *MockOutboxPager) Owns(c context.Context, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Owns", c, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Owns indicates an expected call of Owns
+func (mr *MockOutboxPagerMockRecorder) Owns(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Owns", reflect.TypeOf((*MockOutboxPager)(nil).Owns), c, id)
+}
+
+// ActorForOutbox mocks base method
+func (m *MockOutboxPager) ActorForOutbox(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActorForOutbox", c, outboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActorForOutbox indicates an expected call of ActorForOutbox
+func (mr *MockOutboxPagerMockRecorder) ActorForOutbox(c, outboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActorForOutbox", reflect.TypeOf((*MockOutboxPager)(nil).ActorForOutbox), c, outboxIRI)
+}
+
+// ActorForInbox mocks base method
+func (m *MockOutboxPager) ActorForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActorForInbox", c, inboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActorForInbox indicates an expected call of ActorForInbox
+func (mr *MockOutboxPagerMockRecorder) ActorForInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActorForInbox", reflect.TypeOf((*MockOutboxPager)(nil).ActorForInbox), c, inboxIRI)
+}
+
+// OutboxForInbox mocks base method
+func (m *MockOutboxPager) OutboxForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxForInbox", c, inboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutboxForInbox indicates an expected call of OutboxForInbox
+func (mr *MockOutboxPagerMockRecorder) OutboxForInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxForInbox", reflect.TypeOf((*MockOutboxPager)(nil).OutboxForInbox), c, inboxIRI)
+}
+
+// Exists mocks base method
+func (m *MockOutboxPager) Exists(c context.Context, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", c, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists
+func (mr *MockOutboxPagerMockRecorder) Exists(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockOutboxPager)(nil).Exists), c, id)
+}
+
+// Get mocks base method
+func (m *MockOutboxPager) Get(c context.Context, id *url.URL) (vocab.Type, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, id)
+	ret0, _ := ret[0].(vocab.Type)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockOutboxPagerMockRecorder) Get(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOutboxPager)(nil).Get), c, id)
+}
+
+// Create mocks base method
+func (m *MockOutboxPager) Create(c context.Context, asType vocab.Type) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, asType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockOutboxPagerMockRecorder) Create(c, asType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOutboxPager)(nil).Create), c, asType)
+}
+
+// Update mocks base method
+func (m *MockOutboxPager) Update(c context.Context, asType vocab.Type) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, asType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockOutboxPagerMockRecorder) Update(c, asType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOutboxPager)(nil).Update), c, asType)
+}
+
+// Delete mocks base method
+func (m *MockOutboxPager) Delete(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockOutboxPagerMockRecorder) Delete(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOutboxPager)(nil).Delete), c, id)
+}
+
+// GetOutbox mocks base method
+func (m *MockOutboxPager) GetOutbox(c context.Context, outboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutbox", c, outboxIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsOrderedCollectionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutbox indicates an expected call of GetOutbox
+func (mr *MockOutboxPagerMockRecorder) GetOutbox(c, outboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutbox", reflect.TypeOf((*MockOutboxPager)(nil).GetOutbox), c, outboxIRI)
+}
+
+// SetOutbox mocks base method
+func (m *MockOutboxPager) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOutbox", c, outbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOutbox indicates an expected call of SetOutbox
+func (mr *MockOutboxPagerMockRecorder) SetOutbox(c, outbox interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOutbox", reflect.TypeOf((*MockOutboxPager)(nil).SetOutbox), c, outbox)
+}
+
+// NewID mocks base method
+func (m *MockOutboxPager) NewID(c context.Context, t vocab.Type) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewID", c, t)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewID indicates an expected call of NewID
+func (mr *MockOutboxPagerMockRecorder) NewID(c, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewID", reflect.TypeOf((*MockOutboxPager)(nil).NewID), c, t)
+}
+
+// Followers mocks base method
+func (m *MockOutboxPager) Followers(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Followers", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Followers indicates an expected call of Followers
+func (mr *MockOutboxPagerMockRecorder) Followers(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Followers", reflect.TypeOf((*MockOutboxPager)(nil).Followers), c, actorIRI)
+}
+
+// Following mocks base method
+func (m *MockOutboxPager) Following(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Following", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Following indicates an expected call of Following
+func (mr *MockOutboxPagerMockRecorder) Following(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Following", reflect.TypeOf((*MockOutboxPager)(nil).Following), c, actorIRI)
+}
+
+// Liked mocks base method
+func (m *MockOutboxPager) Liked(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Liked", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Liked indicates an expected call of Liked
+func (mr *MockOutboxPagerMockRecorder) Liked(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Liked", reflect.TypeOf((*MockOutboxPager)(nil).Liked), c, actorIRI)
+}
+
+// OutboxItems mocks base method
+func (m *MockOutboxPager) OutboxItems(c context.Context, outboxIRI *url.URL, offset, limit int) ([]*url.URL, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxItems", c, outboxIRI, offset, limit)
+	ret0, _ := ret[0].([]*url.URL)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OutboxItems indicates an expected call of OutboxItems
+func (mr *MockOutboxPagerMockRecorder) OutboxItems(c, outboxIRI, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxItems", reflect.TypeOf((*MockOutboxPager)(nil).OutboxItems), c, outboxIRI, offset, limit)
+}
+
+// MockFollowersSynchronizer is a mock of FollowersSynchronizer interface
+type MockFollowersSynchronizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowersSynchronizerMockRecorder
+}
+
+// MockFollowersSynchronizerMockRecorder is the mock recorder for MockFollowersSynchronizer
+type MockFollowersSynchronizerMockRecorder struct {
+	mock *MockFollowersSynchronizer
+}
+
+// NewMockFollowersSynchronizer creates a new mock instance
+func NewMockFollowersSynchronizer(ctrl *gomock.Controller) *MockFollowersSynchronizer {
+	mock := &MockFollowersSynchronizer{ctrl: ctrl}
+	mock.recorder = &MockFollowersSynchronizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFollowersSynchronizer) EXPECT() *MockFollowersSynchronizerMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method
+func (m *MockFollowersSynchronizer) Lock(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock
+func (mr *MockFollowersSynchronizerMockRecorder) Lock(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockFollowersSynchronizer)(nil).Lock), c, id)
+}
+
+// Unlock mocks base method
+func (m *MockFollowersSynchronizer) Unlock(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock
+func (mr *MockFollowersSynchronizerMockRecorder) Unlock(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockFollowersSynchronizer)(nil).Unlock), c, id)
+}
+
+// InboxContains mocks base method
+func (m *MockFollowersSynchronizer) InboxContains(c context.Context, inbox, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InboxContains", c, inbox, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InboxContains indicates an expected call of InboxContains
+func (mr *MockFollowersSynchronizerMockRecorder) InboxContains(c, inbox, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InboxContains", reflect.TypeOf((*MockFollowersSynchronizer)(nil).InboxContains), c, inbox, id)
+}
+
+// GetInbox mocks base method
+func (m *MockFollowersSynchronizer) GetInbox(c context.Context, inboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInbox", c, inboxIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsOrderedCollectionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInbox indicates an expected call of GetInbox
+func (mr *MockFollowersSynchronizerMockRecorder) GetInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInbox", reflect.TypeOf((*MockFollowersSynchronizer)(nil).GetInbox), c, inboxIRI)
+}
+
+// SetInbox mocks base method
+func (m *MockFollowersSynchronizer) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInbox", c, inbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInbox indicates an expected call of SetInbox
+func (mr *MockFollowersSynchronizerMockRecorder) SetInbox(c, inbox interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInbox", reflect.TypeOf((*MockFollowersSynchronizer)(nil).SetInbox), c, inbox)
+}
+
+// Owns mocks base method
+func (m *MockFollowersSynchronizer) Owns(c context.Context, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Owns", c, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Owns indicates an expected call of Owns
+func (mr *MockFollowersSynchronizerMockRecorder) Owns(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Owns", reflect.TypeOf((*MockFollowersSynchronizer)(nil).Owns), c, id)
+}
+
+// ActorForOutbox mocks base method
+func (m *MockFollowersSynchronizer) ActorForOutbox(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActorForOutbox", c, outboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActorForOutbox indicates an expected call of ActorForOutbox
+func (mr *MockFollowersSynchronizerMockRecorder) ActorForOutbox(c, outboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActorForOutbox", reflect.TypeOf((*MockFollowersSynchronizer)(nil).ActorForOutbox), c, outboxIRI)
+}
+
+// ActorForInbox mocks base method
+func (m *MockFollowersSynchronizer) ActorForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActorForInbox", c, inboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActorForInbox indicates an expected call of ActorForInbox
+func (mr *MockFollowersSynchronizerMockRecorder) ActorForInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActorForInbox", reflect.TypeOf((*MockFollowersSynchronizer)(nil).ActorForInbox), c, inboxIRI)
+}
+
+// OutboxForInbox mocks base method
+func (m *MockFollowersSynchronizer) OutboxForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxForInbox", c, inboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutboxForInbox indicates an expected call of OutboxForInbox
+func (mr *MockFollowersSynchronizerMockRecorder) OutboxForInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxForInbox", reflect.TypeOf((*MockFollowersSynchronizer)(nil).OutboxForInbox), c, inboxIRI)
+}
+
+// Exists mocks base method
+func (m *MockFollowersSynchronizer) Exists(c context.Context, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", c, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists
+func (mr *MockFollowersSynchronizerMockRecorder) Exists(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockFollowersSynchronizer)(nil).Exists), c, id)
+}
+
+// Get mocks base method
+func (m *MockFollowersSynchronizer) Get(c context.Context, id *url.URL) (vocab.Type, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, id)
+	ret0, _ := ret[0].(vocab.Type)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockFollowersSynchronizerMockRecorder) Get(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFollowersSynchronizer)(nil).Get), c, id)
+}
+
+// Create mocks base method
+func (m *MockFollowersSynchronizer) Create(c context.Context, asType vocab.Type) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, asType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockFollowersSynchronizerMockRecorder) Create(c, asType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFollowersSynchronizer)(nil).Create), c, asType)
+}
+
+// Update mocks base method
+func (m *MockFollowersSynchronizer) Update(c context.Context, asType vocab.Type) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, asType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockFollowersSynchronizerMockRecorder) Update(c, asType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFollowersSynchronizer)(nil).Update), c, asType)
+}
+
+// Delete mocks base method
+func (m *MockFollowersSynchronizer) Delete(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockFollowersSynchronizerMockRecorder) Delete(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFollowersSynchronizer)(nil).Delete), c, id)
+}
+
+// GetOutbox mocks base method
+func (m *MockFollowersSynchronizer) GetOutbox(c context.Context, outboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutbox", c, outboxIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsOrderedCollectionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutbox indicates an expected call of GetOutbox
+func (mr *MockFollowersSynchronizerMockRecorder) GetOutbox(c, outboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutbox", reflect.TypeOf((*MockFollowersSynchronizer)(nil).GetOutbox), c, outboxIRI)
+}
+
+// SetOutbox mocks base method
+func (m *MockFollowersSynchronizer) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOutbox", c, outbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOutbox indicates an expected call of SetOutbox
+func (mr *MockFollowersSynchronizerMockRecorder) SetOutbox(c, outbox interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOutbox", reflect.TypeOf((*MockFollowersSynchronizer)(nil).SetOutbox), c, outbox)
+}
+
+// NewID mocks base method
+func (m *MockFollowersSynchronizer) NewID(c context.Context, t vocab.Type) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewID", c, t)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewID indicates an expected call of NewID
+func (mr *MockFollowersSynchronizerMockRecorder) NewID(c, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewID", reflect.TypeOf((*MockFollowersSynchronizer)(nil).NewID), c, t)
+}
+
+// Followers mocks base method
+func (m *MockFollowersSynchronizer) Followers(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Followers", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Followers indicates an expected call of Followers
+func (mr *MockFollowersSynchronizerMockRecorder) Followers(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Followers", reflect.TypeOf((*MockFollowersSynchronizer)(nil).Followers), c, actorIRI)
+}
+
+// Following mocks base method
+func (m *MockFollowersSynchronizer) Following(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Following", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Following indicates an expected call of Following
+func (mr *MockFollowersSynchronizerMockRecorder) Following(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Following", reflect.TypeOf((*MockFollowersSynchronizer)(nil).Following), c, actorIRI)
+}
+
+// Liked mocks base method
+func (m *MockFollowersSynchronizer) Liked(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Liked", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Liked indicates an expected call of Liked
+func (mr *MockFollowersSynchronizerMockRecorder) Liked(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Liked", reflect.TypeOf((*MockFollowersSynchronizer)(nil).Liked), c, actorIRI)
+}
+
+// PartialFollowersURL mocks base method
+func (m *MockFollowersSynchronizer) PartialFollowersURL(c context.Context, actorIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PartialFollowersURL", c, actorIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PartialFollowersURL indicates an expected call of PartialFollowersURL
+func (mr *MockFollowersSynchronizerMockRecorder) PartialFollowersURL(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartialFollowersURL", reflect.TypeOf((*MockFollowersSynchronizer)(nil).PartialFollowersURL), c, actorIRI)
+}
+
+// LocalFollowers mocks base method
+func (m *MockFollowersSynchronizer) LocalFollowers(c context.Context, followersIRI *url.URL) ([]*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocalFollowers", c, followersIRI)
+	ret0, _ := ret[0].([]*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LocalFollowers indicates an expected call of LocalFollowers
+func (mr *MockFollowersSynchronizerMockRecorder) LocalFollowers(c, followersIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocalFollowers", reflect.TypeOf((*MockFollowersSynchronizer)(nil).LocalFollowers), c, followersIRI)
+}
+
+// ReconcileFollowers mocks base method
+func (m *MockFollowersSynchronizer) ReconcileFollowers(c context.Context, followersIRI *url.URL, stale, unknown []*url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileFollowers", c, followersIRI, stale, unknown)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileFollowers indicates an expected call of ReconcileFollowers
+func (mr *MockFollowersSynchronizerMockRecorder) ReconcileFollowers(c, followersIRI, stale, unknown interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileFollowers", reflect.TypeOf((*MockFollowersSynchronizer)(nil).ReconcileFollowers), c, followersIRI, stale, unknown)
+}
+
+// MockFollowRequestDatabase is a mock of FollowRequestDatabase interface
+type MockFollowRequestDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowRequestDatabaseMockRecorder
+}
+
+// MockFollowRequestDatabaseMockRecorder is the mock recorder for MockFollowRequestDatabase
+type MockFollowRequestDatabaseMockRecorder struct {
+	mock *MockFollowRequestDatabase
+}
+
+// NewMockFollowRequestDatabase creates a new mock instance
+func NewMockFollowRequestDatabase(ctrl *gomock.Controller) *MockFollowRequestDatabase {
+	mock := &MockFollowRequestDatabase{ctrl: ctrl}
+	mock.recorder = &MockFollowRequestDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFollowRequestDatabase) EXPECT() *MockFollowRequestDatabaseMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method
+func (m *MockFollowRequestDatabase) Lock(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock
+func (mr *MockFollowRequestDatabaseMockRecorder) Lock(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockFollowRequestDatabase)(nil).Lock), c, id)
+}
+
+// Unlock mocks base method
+func (m *MockFollowRequestDatabase) Unlock(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock
+func (mr *MockFollowRequestDatabaseMockRecorder) Unlock(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockFollowRequestDatabase)(nil).Unlock), c, id)
+}
+
+// InboxContains mocks base method
+func (m *MockFollowRequestDatabase) InboxContains(c context.Context, inbox, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InboxContains", c, inbox, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InboxContains indicates an expected call of InboxContains
+func (mr *MockFollowRequestDatabaseMockRecorder) InboxContains(c, inbox, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InboxContains", reflect.TypeOf((*MockFollowRequestDatabase)(nil).InboxContains), c, inbox, id)
+}
+
+// GetInbox mocks base method
+func (m *MockFollowRequestDatabase) GetInbox(c context.Context, inboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInbox", c, inboxIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsOrderedCollectionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInbox indicates an expected call of GetInbox
+func (mr *MockFollowRequestDatabaseMockRecorder) GetInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInbox", reflect.TypeOf((*MockFollowRequestDatabase)(nil).GetInbox), c, inboxIRI)
+}
+
+// SetInbox mocks base method
+func (m *MockFollowRequestDatabase) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInbox", c, inbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInbox indicates an expected call of SetInbox
+func (mr *MockFollowRequestDatabaseMockRecorder) SetInbox(c, inbox interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInbox", reflect.TypeOf((*MockFollowRequestDatabase)(nil).SetInbox), c, inbox)
+}
+
+// Owns mocks base method
+func (m *MockFollowRequestDatabase) Owns(c context.Context, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Owns", c, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Owns indicates an expected call of Owns
+func (mr *MockFollowRequestDatabaseMockRecorder) Owns(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Owns", reflect.TypeOf((*MockFollowRequestDatabase)(nil).Owns), c, id)
+}
+
+// ActorForOutbox mocks base method
+func (m *MockFollowRequestDatabase) ActorForOutbox(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActorForOutbox", c, outboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActorForOutbox indicates an expected call of ActorForOutbox
+func (mr *MockFollowRequestDatabaseMockRecorder) ActorForOutbox(c, outboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActorForOutbox", reflect.TypeOf((*MockFollowRequestDatabase)(nil).ActorForOutbox), c, outboxIRI)
+}
+
+// ActorForInbox mocks base method
+func (m *MockFollowRequestDatabase) ActorForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActorForInbox", c, inboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActorForInbox indicates an expected call of ActorForInbox
+func (mr *MockFollowRequestDatabaseMockRecorder) ActorForInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActorForInbox", reflect.TypeOf((*MockFollowRequestDatabase)(nil).ActorForInbox), c, inboxIRI)
+}
+
+// OutboxForInbox mocks base method
+func (m *MockFollowRequestDatabase) OutboxForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboxForInbox", c, inboxIRI)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OutboxForInbox indicates an expected call of OutboxForInbox
+func (mr *MockFollowRequestDatabaseMockRecorder) OutboxForInbox(c, inboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboxForInbox", reflect.TypeOf((*MockFollowRequestDatabase)(nil).OutboxForInbox), c, inboxIRI)
+}
+
+// Exists mocks base method
+func (m *MockFollowRequestDatabase) Exists(c context.Context, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", c, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists
+func (mr *MockFollowRequestDatabaseMockRecorder) Exists(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockFollowRequestDatabase)(nil).Exists), c, id)
+}
+
+// Get mocks base method
+func (m *MockFollowRequestDatabase) Get(c context.Context, id *url.URL) (vocab.Type, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, id)
+	ret0, _ := ret[0].(vocab.Type)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockFollowRequestDatabaseMockRecorder) Get(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFollowRequestDatabase)(nil).Get), c, id)
+}
+
+// Create mocks base method
+func (m *MockFollowRequestDatabase) Create(c context.Context, asType vocab.Type) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, asType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockFollowRequestDatabaseMockRecorder) Create(c, asType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFollowRequestDatabase)(nil).Create), c, asType)
+}
+
+// Update mocks base method
+func (m *MockFollowRequestDatabase) Update(c context.Context, asType vocab.Type) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, asType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockFollowRequestDatabaseMockRecorder) Update(c, asType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFollowRequestDatabase)(nil).Update), c, asType)
+}
+
+// Delete mocks base method
+func (m *MockFollowRequestDatabase) Delete(c context.Context, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockFollowRequestDatabaseMockRecorder) Delete(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFollowRequestDatabase)(nil).Delete), c, id)
+}
+
+// GetOutbox mocks base method
+func (m *MockFollowRequestDatabase) GetOutbox(c context.Context, outboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutbox", c, outboxIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsOrderedCollectionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutbox indicates an expected call of GetOutbox
+func (mr *MockFollowRequestDatabaseMockRecorder) GetOutbox(c, outboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutbox", reflect.TypeOf((*MockFollowRequestDatabase)(nil).GetOutbox), c, outboxIRI)
+}
+
+// SetOutbox mocks base method
+func (m *MockFollowRequestDatabase) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOutbox", c, outbox)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOutbox indicates an expected call of SetOutbox
+func (mr *MockFollowRequestDatabaseMockRecorder) SetOutbox(c, outbox interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOutbox", reflect.TypeOf((*MockFollowRequestDatabase)(nil).SetOutbox), c, outbox)
+}
+
+// NewID mocks base method
+func (m *MockFollowRequestDatabase) NewID(c context.Context, t vocab.Type) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewID", c, t)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewID indicates an expected call of NewID
+func (mr *MockFollowRequestDatabaseMockRecorder) NewID(c, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewID", reflect.TypeOf((*MockFollowRequestDatabase)(nil).NewID), c, t)
+}
+
+// Followers mocks base method
+func (m *MockFollowRequestDatabase) Followers(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Followers", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Followers indicates an expected call of Followers
+func (mr *MockFollowRequestDatabaseMockRecorder) Followers(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Followers", reflect.TypeOf((*MockFollowRequestDatabase)(nil).Followers), c, actorIRI)
+}
+
+// Following mocks base method
+func (m *MockFollowRequestDatabase) Following(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Following", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Following indicates an expected call of Following
+func (mr *MockFollowRequestDatabaseMockRecorder) Following(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Following", reflect.TypeOf((*MockFollowRequestDatabase)(nil).Following), c, actorIRI)
+}
+
+// Liked mocks base method
+func (m *MockFollowRequestDatabase) Liked(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Liked", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Liked indicates an expected call of Liked
+func (mr *MockFollowRequestDatabaseMockRecorder) Liked(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Liked", reflect.TypeOf((*MockFollowRequestDatabase)(nil).Liked), c, actorIRI)
+}
+
+// AddFollowRequest mocks base method
+func (m *MockFollowRequestDatabase) AddFollowRequest(c context.Context, actorIRI *url.URL, follow vocab.ActivityStreamsFollow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFollowRequest", c, actorIRI, follow)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFollowRequest indicates an expected call of AddFollowRequest
+func (mr *MockFollowRequestDatabaseMockRecorder) AddFollowRequest(c, actorIRI, follow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFollowRequest", reflect.TypeOf((*MockFollowRequestDatabase)(nil).AddFollowRequest), c, actorIRI, follow)
+}
+
+// FollowRequests mocks base method
+func (m *MockFollowRequestDatabase) FollowRequests(c context.Context, actorIRI *url.URL) ([]vocab.ActivityStreamsFollow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowRequests", c, actorIRI)
+	ret0, _ := ret[0].([]vocab.ActivityStreamsFollow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowRequests indicates an expected call of FollowRequests
+func (mr *MockFollowRequestDatabaseMockRecorder) FollowRequests(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowRequests", reflect.TypeOf((*MockFollowRequestDatabase)(nil).FollowRequests), c, actorIRI)
+}
+
+// RemoveFollowRequest mocks base method
+func (m *MockFollowRequestDatabase) RemoveFollowRequest(c context.Context, followIRI *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFollowRequest", c, followIRI)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFollowRequest indicates an expected call of RemoveFollowRequest
+func (mr *MockFollowRequestDatabaseMockRecorder) RemoveFollowRequest(c, followIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFollowRequest", reflect.TypeOf((*MockFollowRequestDatabase)(nil).RemoveFollowRequest), c, followIRI)
+}
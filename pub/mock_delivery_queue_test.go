@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: delivery_queue.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	url "net/url"
+	reflect "reflect"
+	time "time"
+)
+
+// MockDeliveryQueue is a mock of DeliveryQueue interface
+type MockDeliveryQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliveryQueueMockRecorder
+}
+
+// MockDeliveryQueueMockRecorder is the mock recorder for MockDeliveryQueue
+type MockDeliveryQueueMockRecorder struct {
+	mock *MockDeliveryQueue
+}
+
+// NewMockDeliveryQueue creates a new mock instance
+func NewMockDeliveryQueue(ctrl *gomock.Controller) *MockDeliveryQueue {
+	mock := &MockDeliveryQueue{ctrl: ctrl}
+	mock.recorder = &MockDeliveryQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeliveryQueue) EXPECT() *MockDeliveryQueueMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method
+func (m *MockDeliveryQueue) Enqueue(c context.Context, boxIRI *url.URL, b []byte, recipients []*url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", c, boxIRI, b, recipients)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue
+func (mr *MockDeliveryQueueMockRecorder) Enqueue(c, boxIRI, b, recipients interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockDeliveryQueue)(nil).Enqueue), c, boxIRI, b, recipients)
+}
+
+// Lease mocks base method
+func (m *MockDeliveryQueue) Lease(c context.Context, max int, lease time.Duration) ([]*Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lease", c, max, lease)
+	ret0, _ := ret[0].([]*Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lease indicates an expected call of Lease
+func (mr *MockDeliveryQueueMockRecorder) Lease(c, max, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lease", reflect.TypeOf((*MockDeliveryQueue)(nil).Lease), c, max, lease)
+}
+
+// Ack mocks base method
+func (m *MockDeliveryQueue) Ack(c context.Context, d *Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ack", c, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ack indicates an expected call of Ack
+func (mr *MockDeliveryQueueMockRecorder) Ack(c, d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ack", reflect.TypeOf((*MockDeliveryQueue)(nil).Ack), c, d)
+}
+
+// Nack mocks base method
+func (m *MockDeliveryQueue) Nack(c context.Context, d *Delivery, delay time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nack", c, d, delay)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Nack indicates an expected call of Nack
+func (mr *MockDeliveryQueueMockRecorder) Nack(c, d, delay interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nack", reflect.TypeOf((*MockDeliveryQueue)(nil).Nack), c, d, delay)
+}
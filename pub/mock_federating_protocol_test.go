@@ -11,6 +11,7 @@ import (
 	http "net/http"
 	url "net/url"
 	reflect "reflect"
+	time "time"
 )
 
 // MockFederatingProtocol is a mock of FederatingProtocol interface
@@ -169,3 +170,260 @@ func (mr *MockFederatingProtocolMockRecorder) GetInbox(c, r interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInbox", reflect.TypeOf((*MockFederatingProtocol)(nil).GetInbox), c, r)
 }
+
+// MockInboxFilter is a mock of InboxFilter interface
+type MockInboxFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboxFilterMockRecorder
+}
+
+// MockInboxFilterMockRecorder is the mock recorder for MockInboxFilter
+type MockInboxFilterMockRecorder struct {
+	mock *MockInboxFilter
+}
+
+// NewMockInboxFilter creates a new mock instance
+func NewMockInboxFilter(ctrl *gomock.Controller) *MockInboxFilter {
+	mock := &MockInboxFilter{ctrl: ctrl}
+	mock.recorder = &MockInboxFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInboxFilter) EXPECT() *MockInboxFilterMockRecorder {
+	return m.recorder
+}
+
+// FilterInbox mocks base method
+func (m *MockInboxFilter) FilterInbox(c context.Context, actorIRIs []*url.URL, activity Activity) (bool, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterInbox", c, actorIRIs, activity)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FilterInbox indicates an expected call of FilterInbox
+func (mr *MockInboxFilterMockRecorder) FilterInbox(c, actorIRIs, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterInbox", reflect.TypeOf((*MockInboxFilter)(nil).FilterInbox), c, actorIRIs, activity)
+}
+
+// MockPeerPolicy is a mock of PeerPolicy interface
+type MockPeerPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPeerPolicyMockRecorder
+}
+
+// MockPeerPolicyMockRecorder is the mock recorder for MockPeerPolicy
+type MockPeerPolicyMockRecorder struct {
+	mock *MockPeerPolicy
+}
+
+// NewMockPeerPolicy creates a new mock instance
+func NewMockPeerPolicy(ctrl *gomock.Controller) *MockPeerPolicy {
+	mock := &MockPeerPolicy{ctrl: ctrl}
+	mock.recorder = &MockPeerPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPeerPolicy) EXPECT() *MockPeerPolicyMockRecorder {
+	return m.recorder
+}
+
+// FederationPolicy mocks base method
+func (m *MockPeerPolicy) FederationPolicy(c context.Context, remote *url.URL) (Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FederationPolicy", c, remote)
+	ret0, _ := ret[0].(Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FederationPolicy indicates an expected call of FederationPolicy
+func (mr *MockPeerPolicyMockRecorder) FederationPolicy(c, remote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FederationPolicy", reflect.TypeOf((*MockPeerPolicy)(nil).FederationPolicy), c, remote)
+}
+
+// MockDereferenceLimiter is a mock of DereferenceLimiter interface
+type MockDereferenceLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDereferenceLimiterMockRecorder
+}
+
+// MockDereferenceLimiterMockRecorder is the mock recorder for MockDereferenceLimiter
+type MockDereferenceLimiterMockRecorder struct {
+	mock *MockDereferenceLimiter
+}
+
+// NewMockDereferenceLimiter creates a new mock instance
+func NewMockDereferenceLimiter(ctrl *gomock.Controller) *MockDereferenceLimiter {
+	mock := &MockDereferenceLimiter{ctrl: ctrl}
+	mock.recorder = &MockDereferenceLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDereferenceLimiter) EXPECT() *MockDereferenceLimiterMockRecorder {
+	return m.recorder
+}
+
+// RecursiveDereferenceLimits mocks base method
+func (m *MockDereferenceLimiter) RecursiveDereferenceLimits(c context.Context) (int, time.Duration) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecursiveDereferenceLimits", c)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(time.Duration)
+	return ret0, ret1
+}
+
+// RecursiveDereferenceLimits indicates an expected call of RecursiveDereferenceLimits
+func (mr *MockDereferenceLimiterMockRecorder) RecursiveDereferenceLimits(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecursiveDereferenceLimits", reflect.TypeOf((*MockDereferenceLimiter)(nil).RecursiveDereferenceLimits), c)
+}
+
+// MockSharedInboxPolicy is a mock of SharedInboxPolicy interface
+type MockSharedInboxPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockSharedInboxPolicyMockRecorder
+}
+
+// MockSharedInboxPolicyMockRecorder is the mock recorder for MockSharedInboxPolicy
+type MockSharedInboxPolicyMockRecorder struct {
+	mock *MockSharedInboxPolicy
+}
+
+// NewMockSharedInboxPolicy creates a new mock instance
+func NewMockSharedInboxPolicy(ctrl *gomock.Controller) *MockSharedInboxPolicy {
+	mock := &MockSharedInboxPolicy{ctrl: ctrl}
+	mock.recorder = &MockSharedInboxPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSharedInboxPolicy) EXPECT() *MockSharedInboxPolicyMockRecorder {
+	return m.recorder
+}
+
+// DeliverToSharedInboxes mocks base method
+func (m *MockSharedInboxPolicy) DeliverToSharedInboxes(c context.Context, outboxIRI *url.URL, activity Activity) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverToSharedInboxes", c, outboxIRI, activity)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// DeliverToSharedInboxes indicates an expected call of DeliverToSharedInboxes
+func (mr *MockSharedInboxPolicyMockRecorder) DeliverToSharedInboxes(c, outboxIRI, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverToSharedInboxes", reflect.TypeOf((*MockSharedInboxPolicy)(nil).DeliverToSharedInboxes), c, outboxIRI, activity)
+}
+
+// SharedInbox mocks base method
+func (m *MockSharedInboxPolicy) SharedInbox(c context.Context, actor vocab.Type) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SharedInbox", c, actor)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SharedInbox indicates an expected call of SharedInbox
+func (mr *MockSharedInboxPolicyMockRecorder) SharedInbox(c, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SharedInbox", reflect.TypeOf((*MockSharedInboxPolicy)(nil).SharedInbox), c, actor)
+}
+
+// MockRelaySubscriber is a mock of RelaySubscriber interface
+type MockRelaySubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelaySubscriberMockRecorder
+}
+
+// MockRelaySubscriberMockRecorder is the mock recorder for MockRelaySubscriber
+type MockRelaySubscriberMockRecorder struct {
+	mock *MockRelaySubscriber
+}
+
+// NewMockRelaySubscriber creates a new mock instance
+func NewMockRelaySubscriber(ctrl *gomock.Controller) *MockRelaySubscriber {
+	mock := &MockRelaySubscriber{ctrl: ctrl}
+	mock.recorder = &MockRelaySubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRelaySubscriber) EXPECT() *MockRelaySubscriberMockRecorder {
+	return m.recorder
+}
+
+// IsRelay mocks base method
+func (m *MockRelaySubscriber) IsRelay(c context.Context, actorIRI *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRelay", c, actorIRI)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRelay indicates an expected call of IsRelay
+func (mr *MockRelaySubscriberMockRecorder) IsRelay(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRelay", reflect.TypeOf((*MockRelaySubscriber)(nil).IsRelay), c, actorIRI)
+}
+
+// Relayed mocks base method
+func (m *MockRelaySubscriber) Relayed(c context.Context, relayIRI *url.URL, object vocab.Type) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relayed", c, relayIRI, object)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Relayed indicates an expected call of Relayed
+func (mr *MockRelaySubscriberMockRecorder) Relayed(c, relayIRI, object interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relayed", reflect.TypeOf((*MockRelaySubscriber)(nil).Relayed), c, relayIRI, object)
+}
+
+// MockSignerMatchPolicy is a mock of SignerMatchPolicy interface
+type MockSignerMatchPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignerMatchPolicyMockRecorder
+}
+
+// MockSignerMatchPolicyMockRecorder is the mock recorder for MockSignerMatchPolicy
+type MockSignerMatchPolicyMockRecorder struct {
+	mock *MockSignerMatchPolicy
+}
+
+// NewMockSignerMatchPolicy creates a new mock instance
+func NewMockSignerMatchPolicy(ctrl *gomock.Controller) *MockSignerMatchPolicy {
+	mock := &MockSignerMatchPolicy{ctrl: ctrl}
+	mock.recorder = &MockSignerMatchPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSignerMatchPolicy) EXPECT() *MockSignerMatchPolicyMockRecorder {
+	return m.recorder
+}
+
+// SignerMatch mocks base method
+func (m *MockSignerMatchPolicy) SignerMatch(c context.Context, signer *url.URL, activity Activity) (SignerMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignerMatch", c, signer, activity)
+	ret0, _ := ret[0].(SignerMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignerMatch indicates an expected call of SignerMatch
+func (mr *MockSignerMatchPolicyMockRecorder) SignerMatch(c, signer, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignerMatch", reflect.TypeOf((*MockSignerMatchPolicy)(nil).SignerMatch), c, signer, activity)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handlers.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	context "context"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	gomock "github.com/golang/mock/gomock"
+	http "net/http"
+	reflect "reflect"
+)
+
+// MockHTMLRenderer is a mock of HTMLRenderer interface
+type MockHTMLRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockHTMLRendererMockRecorder
+}
+
+// MockHTMLRendererMockRecorder is the mock recorder for MockHTMLRenderer
+type MockHTMLRendererMockRecorder struct {
+	mock *MockHTMLRenderer
+}
+
+// NewMockHTMLRenderer creates a new mock instance
+func NewMockHTMLRenderer(ctrl *gomock.Controller) *MockHTMLRenderer {
+	mock := &MockHTMLRenderer{ctrl: ctrl}
+	mock.recorder = &MockHTMLRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockHTMLRenderer) EXPECT() *MockHTMLRendererMockRecorder {
+	return m.recorder
+}
+
+// RenderHTML mocks base method
+func (m *MockHTMLRenderer) RenderHTML(c context.Context, w http.ResponseWriter, r *http.Request, t vocab.Type) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderHTML", c, w, r, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenderHTML indicates an expected call of RenderHTML
+func (mr *MockHTMLRendererMockRecorder) RenderHTML(c, w, r, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderHTML", reflect.TypeOf((*MockHTMLRenderer)(nil).RenderHTML), c, w, r, t)
+}
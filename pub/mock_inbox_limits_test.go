@@ -0,0 +1,47 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: inbox_limits.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockInboxLimiter is a mock of InboxLimiter interface
+type MockInboxLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboxLimiterMockRecorder
+}
+
+// MockInboxLimiterMockRecorder is the mock recorder for MockInboxLimiter
+type MockInboxLimiterMockRecorder struct {
+	mock *MockInboxLimiter
+}
+
+// NewMockInboxLimiter creates a new mock instance
+func NewMockInboxLimiter(ctrl *gomock.Controller) *MockInboxLimiter {
+	mock := &MockInboxLimiter{ctrl: ctrl}
+	mock.recorder = &MockInboxLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockInboxLimiter) EXPECT() *MockInboxLimiterMockRecorder {
+	return m.recorder
+}
+
+// InboxLimits mocks base method
+func (m *MockInboxLimiter) InboxLimits() InboxLimits {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InboxLimits")
+	ret0, _ := ret[0].(InboxLimits)
+	return ret0
+}
+
+// InboxLimits indicates an expected call of InboxLimits
+func (mr *MockInboxLimiterMockRecorder) InboxLimits() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InboxLimits", reflect.TypeOf((*MockInboxLimiter)(nil).InboxLimits))
+}
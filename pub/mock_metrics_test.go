@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: metrics.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	time "time"
+)
+
+// MockMetrics is a mock of Metrics interface
+type MockMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricsMockRecorder
+}
+
+// MockMetricsMockRecorder is the mock recorder for MockMetrics
+type MockMetricsMockRecorder struct {
+	mock *MockMetrics
+}
+
+// NewMockMetrics creates a new mock instance
+func NewMockMetrics(ctrl *gomock.Controller) *MockMetrics {
+	mock := &MockMetrics{ctrl: ctrl}
+	mock.recorder = &MockMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockMetrics) EXPECT() *MockMetricsMockRecorder {
+	return m.recorder
+}
+
+// InboxActivity mocks base method
+func (m *MockMetrics) InboxActivity(c context.Context, typeName string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InboxActivity", c, typeName)
+}
+
+// InboxActivity indicates an expected call of InboxActivity
+func (mr *MockMetricsMockRecorder) InboxActivity(c, typeName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InboxActivity", reflect.TypeOf((*MockMetrics)(nil).InboxActivity), c, typeName)
+}
+
+// SignatureFailure mocks base method
+func (m *MockMetrics) SignatureFailure(c context.Context, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SignatureFailure", c, err)
+}
+
+// SignatureFailure indicates an expected call of SignatureFailure
+func (mr *MockMetricsMockRecorder) SignatureFailure(c, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignatureFailure", reflect.TypeOf((*MockMetrics)(nil).SignatureFailure), c, err)
+}
+
+// Delivery mocks base method
+func (m *MockMetrics) Delivery(c context.Context, host string, latency time.Duration, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delivery", c, host, latency, err)
+}
+
+// Delivery indicates an expected call of Delivery
+func (mr *MockMetricsMockRecorder) Delivery(c, host, latency, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delivery", reflect.TypeOf((*MockMetrics)(nil).Delivery), c, host, latency, err)
+}
+
+// Enqueued mocks base method
+func (m *MockMetrics) Enqueued(c context.Context, host string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Enqueued", c, host)
+}
+
+// Enqueued indicates an expected call of Enqueued
+func (mr *MockMetricsMockRecorder) Enqueued(c, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueued", reflect.TypeOf((*MockMetrics)(nil).Enqueued), c, host)
+}
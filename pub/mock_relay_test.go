@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: relay.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	http "net/http"
+	url "net/url"
+	reflect "reflect"
+)
+
+// MockRelayProtocol is a mock of RelayProtocol interface
+type MockRelayProtocol struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayProtocolMockRecorder
+}
+
+// MockRelayProtocolMockRecorder is the mock recorder for MockRelayProtocol
+type MockRelayProtocolMockRecorder struct {
+	mock *MockRelayProtocol
+}
+
+// NewMockRelayProtocol creates a new mock instance
+func NewMockRelayProtocol(ctrl *gomock.Controller) *MockRelayProtocol {
+	mock := &MockRelayProtocol{ctrl: ctrl}
+	mock.recorder = &MockRelayProtocolMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRelayProtocol) EXPECT() *MockRelayProtocolMockRecorder {
+	return m.recorder
+}
+
+// AuthenticatePostInbox mocks base method
+func (m *MockRelayProtocol) AuthenticatePostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticatePostInbox", c, w, r)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthenticatePostInbox indicates an expected call of AuthenticatePostInbox
+func (mr *MockRelayProtocolMockRecorder) AuthenticatePostInbox(c, w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticatePostInbox", reflect.TypeOf((*MockRelayProtocol)(nil).AuthenticatePostInbox), c, w, r)
+}
+
+// Blocked mocks base method
+func (m *MockRelayProtocol) Blocked(c context.Context, actorIRIs []*url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Blocked", c, actorIRIs)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Blocked indicates an expected call of Blocked
+func (mr *MockRelayProtocolMockRecorder) Blocked(c, actorIRIs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Blocked", reflect.TypeOf((*MockRelayProtocol)(nil).Blocked), c, actorIRIs)
+}
+
+// Route mocks base method
+func (m *MockRelayProtocol) Route(c context.Context, inboxIRI *url.URL, activity *RelayedActivity) ([]*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Route", c, inboxIRI, activity)
+	ret0, _ := ret[0].([]*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Route indicates an expected call of Route
+func (mr *MockRelayProtocolMockRecorder) Route(c, inboxIRI, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Route", reflect.TypeOf((*MockRelayProtocol)(nil).Route), c, inboxIRI, activity)
+}
+
+// NewTransport mocks base method
+func (m *MockRelayProtocol) NewTransport(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTransport", c, actorBoxIRI, gofedAgent)
+	ret0, _ := ret[0].(Transport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewTransport indicates an expected call of NewTransport
+func (mr *MockRelayProtocolMockRecorder) NewTransport(c, actorBoxIRI, gofedAgent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTransport", reflect.TypeOf((*MockRelayProtocol)(nil).NewTransport), c, actorBoxIRI, gofedAgent)
+}
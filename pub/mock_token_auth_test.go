@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: token_auth.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	url "net/url"
+	reflect "reflect"
+)
+
+// MockTokenIntrospector is a mock of TokenIntrospector interface
+type MockTokenIntrospector struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenIntrospectorMockRecorder
+}
+
+// MockTokenIntrospectorMockRecorder is the mock recorder for MockTokenIntrospector
+type MockTokenIntrospectorMockRecorder struct {
+	mock *MockTokenIntrospector
+}
+
+// NewMockTokenIntrospector creates a new mock instance
+func NewMockTokenIntrospector(ctrl *gomock.Controller) *MockTokenIntrospector {
+	mock := &MockTokenIntrospector{ctrl: ctrl}
+	mock.recorder = &MockTokenIntrospectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTokenIntrospector) EXPECT() *MockTokenIntrospectorMockRecorder {
+	return m.recorder
+}
+
+// Introspect mocks base method
+func (m *MockTokenIntrospector) Introspect(c context.Context, token string) (bool, *url.URL, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Introspect", c, token)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*url.URL)
+	ret2, _ := ret[2].([]string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// Introspect indicates an expected call of Introspect
+func (mr *MockTokenIntrospectorMockRecorder) Introspect(c, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Introspect", reflect.TypeOf((*MockTokenIntrospector)(nil).Introspect), c, token)
+}
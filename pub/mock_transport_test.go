@@ -78,6 +78,68 @@ func (mr *MockTransportMockRecorder) BatchDeliver(c, b, recipients interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeliver", reflect.TypeOf((*MockTransport)(nil).BatchDeliver), c, b, recipients)
 }
 
+// MockDereferenceCache is a mock of DereferenceCache interface
+type MockDereferenceCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockDereferenceCacheMockRecorder
+}
+
+// MockDereferenceCacheMockRecorder is the mock recorder for MockDereferenceCache
+type MockDereferenceCacheMockRecorder struct {
+	mock *MockDereferenceCache
+}
+
+// NewMockDereferenceCache creates a new mock instance
+func NewMockDereferenceCache(ctrl *gomock.Controller) *MockDereferenceCache {
+	mock := &MockDereferenceCache{ctrl: ctrl}
+	mock.recorder = &MockDereferenceCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDereferenceCache) EXPECT() *MockDereferenceCacheMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockDereferenceCache) Get(c context.Context, iri *url.URL) ([]byte, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", c, iri)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockDereferenceCacheMockRecorder) Get(c, iri interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDereferenceCache)(nil).Get), c, iri)
+}
+
+// Set mocks base method
+func (m *MockDereferenceCache) Set(c context.Context, iri *url.URL, b []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", c, iri, b)
+}
+
+// Set indicates an expected call of Set
+func (mr *MockDereferenceCacheMockRecorder) Set(c, iri, b interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockDereferenceCache)(nil).Set), c, iri, b)
+}
+
+// Invalidate mocks base method
+func (m *MockDereferenceCache) Invalidate(c context.Context, iri *url.URL) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Invalidate", c, iri)
+}
+
+// Invalidate indicates an expected call of Invalidate
+func (mr *MockDereferenceCacheMockRecorder) Invalidate(c, iri interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockDereferenceCache)(nil).Invalidate), c, iri)
+}
+
 // MockHttpClient is a mock of HttpClient interface
 type MockHttpClient struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,202 @@
+package pub
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+)
+
+const (
+	// multikeyType is the type of public keys in the Multikey format.
+	multikeyType = "Multikey"
+	// MultikeyContext is the JSON-LD context defining the Multikey type and
+	// its properties, which documents with Multikeys must include.
+	MultikeyContext = "https://w3id.org/security/multikey/v1"
+	// assertionMethodProperty is the property of an actor listing its
+	// Multikeys.
+	assertionMethodProperty = "assertionMethod"
+	// base58btcPrefix is the multibase prefix of base58btc encodings.
+	base58btcPrefix = "z"
+	// base58Alphabet is the Bitcoin base58 alphabet.
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+var (
+	// ed25519Multicodec is the multicodec prefix of Ed25519 public keys.
+	ed25519Multicodec = []byte{0xed, 0x01}
+	// rsaMulticodec is the multicodec prefix of PKCS #1 RSA public keys.
+	rsaMulticodec = []byte{0x85, 0x24}
+)
+
+// Multikey is a public key in the Multikey format of FEP-521a, which actors
+// list in their 'assertionMethod' property alongside, or instead of, their
+// 'publicKey'.
+type Multikey struct {
+	// ID is the IRI of the key, which is the keyId of signatures made with
+	// it.
+	ID *url.URL
+	// Controller is the IRI of the actor owning the key.
+	Controller *url.URL
+	// PublicKey is an ed25519.PublicKey or *rsa.PublicKey.
+	PublicKey crypto.PublicKey
+}
+
+// EncodeMultikey returns the 'publicKeyMultibase' of an Ed25519 or RSA public
+// key: its multicodec encoding in base58btc.
+func EncodeMultikey(pubKey crypto.PublicKey) (string, error) {
+	var b []byte
+	switch k := pubKey.(type) {
+	case ed25519.PublicKey:
+		b = append(append(b, ed25519Multicodec...), k...)
+	case *rsa.PublicKey:
+		b = append(append(b, rsaMulticodec...), x509.MarshalPKCS1PublicKey(k)...)
+	default:
+		return "", fmt.Errorf("unsupported public key type %T", pubKey)
+	}
+	return base58btcPrefix + base58Encode(b), nil
+}
+
+// ParseMultikey parses the 'publicKeyMultibase' of an Ed25519 or RSA public
+// key.
+func ParseMultikey(publicKeyMultibase string) (crypto.PublicKey, error) {
+	if !strings.HasPrefix(publicKeyMultibase, base58btcPrefix) {
+		return nil, fmt.Errorf("publicKeyMultibase is not base58btc encoded")
+	}
+	b, err := base58Decode(publicKeyMultibase[len(base58btcPrefix):])
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(b) == len(ed25519Multicodec)+ed25519.PublicKeySize && string(b[:len(ed25519Multicodec)]) == string(ed25519Multicodec):
+		return ed25519.PublicKey(b[len(ed25519Multicodec):]), nil
+	case len(b) > len(rsaMulticodec) && string(b[:len(rsaMulticodec)]) == string(rsaMulticodec):
+		return x509.ParsePKCS1PublicKey(b[len(rsaMulticodec):])
+	default:
+		return nil, fmt.Errorf("unsupported publicKeyMultibase key type")
+	}
+}
+
+// serialize returns the JSON-LD representation of the key.
+func (m Multikey) serialize() (map[string]interface{}, error) {
+	s, err := EncodeMultikey(m.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"id":                 m.ID.String(),
+		"type":               multikeyType,
+		"controller":         m.Controller.String(),
+		"publicKeyMultibase": s,
+	}, nil
+}
+
+// parseMultikey parses the JSON-LD representation of a Multikey.
+func parseMultikey(m map[string]interface{}) (k Multikey, err error) {
+	if t, _ := m["type"].(string); t != multikeyType {
+		err = fmt.Errorf("type %q is not %s", t, multikeyType)
+		return
+	}
+	id, _ := m["id"].(string)
+	if k.ID, err = url.Parse(id); err != nil {
+		return
+	}
+	controller, _ := m["controller"].(string)
+	if len(controller) == 0 {
+		err = fmt.Errorf("Multikey %s has no controller", id)
+		return
+	}
+	if k.Controller, err = url.Parse(controller); err != nil {
+		return
+	}
+	s, _ := m["publicKeyMultibase"].(string)
+	k.PublicKey, err = ParseMultikey(s)
+	return
+}
+
+// SetAssertionMethods sets the 'assertionMethod' property of the actor to the
+// Multikeys. The actor's '@context' must also include MultikeyContext, which
+// applications add when serializing it.
+func SetAssertionMethods(actor unknownPropertieser, keys []Multikey) error {
+	methods := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		m, err := k.serialize()
+		if err != nil {
+			return err
+		}
+		methods = append(methods, m)
+	}
+	actor.GetUnknownProperties()[assertionMethodProperty] = methods
+	return nil
+}
+
+// GetAssertionMethods returns the Multikeys in the 'assertionMethod' property
+// of the actor. Other verification methods are ignored.
+func GetAssertionMethods(actor unknownPropertieser) (keys []Multikey, err error) {
+	var methods []interface{}
+	switch v := actor.GetUnknownProperties()[assertionMethodProperty].(type) {
+	case []interface{}:
+		methods = v
+	case map[string]interface{}:
+		methods = []interface{}{v}
+	}
+	for _, method := range methods {
+		m, ok := method.(map[string]interface{})
+		if !ok {
+			continue
+		} else if t, _ := m["type"].(string); t != multikeyType {
+			continue
+		}
+		var k Multikey
+		if k, err = parseMultikey(m); err != nil {
+			return
+		}
+		keys = append(keys, k)
+	}
+	return
+}
+
+// base58Encode encodes the bytes with the Bitcoin base58 alphabet.
+func base58Encode(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	radix := big.NewInt(int64(len(base58Alphabet)))
+	mod := new(big.Int)
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, c := range b {
+		if c != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+// base58Decode decodes a string encoded with the Bitcoin base58 alphabet.
+func base58Decode(s string) ([]byte, error) {
+	n := new(big.Int)
+	radix := big.NewInt(int64(len(base58Alphabet)))
+	for _, c := range s {
+		i := strings.IndexRune(base58Alphabet, c)
+		if i < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", c)
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(i)))
+	}
+	var zeros int
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
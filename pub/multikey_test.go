@@ -0,0 +1,69 @@
+package pub
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"strings"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+)
+
+func TestMultikey(t *testing.T) {
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPriv, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("RoundTripsEd25519", func(t *testing.T) {
+		s, err := EncodeMultikey(edPub)
+		assertEqual(t, err, nil)
+		assertEqual(t, strings.HasPrefix(s, "z6Mk"), true)
+		got, err := ParseMultikey(s)
+		assertEqual(t, err, nil)
+		assertByteEqual(t, got.(ed25519.PublicKey), edPub)
+	})
+	t.Run("RoundTripsRSA", func(t *testing.T) {
+		s, err := EncodeMultikey(&rsaPriv.PublicKey)
+		assertEqual(t, err, nil)
+		got, err := ParseMultikey(s)
+		assertEqual(t, err, nil)
+		assertEqual(t, got.(*rsa.PublicKey).N.Cmp(rsaPriv.N), 0)
+	})
+	t.Run("RoundTripsLeadingZeros", func(t *testing.T) {
+		b := []byte{0, 0, 1, 2, 255}
+		got, err := base58Decode(base58Encode(b))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, got, b)
+	})
+	t.Run("ErrorIfNotBase58btc", func(t *testing.T) {
+		_, err := ParseMultikey("u" + strings.Repeat("A", 44))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("SetsAndGetsAssertionMethods", func(t *testing.T) {
+		person := streams.NewActivityStreamsPerson()
+		k := Multikey{
+			ID:         mustParse(testFederatedKeyId),
+			Controller: mustParse(testFederatedActorIRI),
+			PublicKey:  edPub,
+		}
+		err := SetAssertionMethods(person, []Multikey{k})
+		assertEqual(t, err, nil)
+		// Survive a serialization round trip.
+		m, err := streams.Serialize(person)
+		assertEqual(t, err, nil)
+		v, err := streams.ToType(context.Background(), m)
+		assertEqual(t, err, nil)
+		keys, err := GetAssertionMethods(v.(unknownPropertieser))
+		assertEqual(t, err, nil)
+		assertEqual(t, len(keys), 1)
+		assertEqual(t, keys[0].ID.String(), testFederatedKeyId)
+		assertEqual(t, keys[0].Controller.String(), testFederatedActorIRI)
+		assertByteEqual(t, keys[0].PublicKey.(ed25519.PublicKey), edPub)
+	})
+}
@@ -0,0 +1,80 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// Policy is how an Actor federates with a remote peer.
+type Policy int
+
+const (
+	// PolicyAllow federates normally with the peer.
+	PolicyAllow Policy = iota
+	// PolicySilence accepts activities sent by the peer with a
+	// http.StatusOK response, but discards them without adding them to an
+	// inbox or triggering side effects. Deliveries to the peer still occur.
+	PolicySilence
+	// PolicyBlock rejects activities sent by the peer with a
+	// http.StatusForbidden response, and does not deliver to the peer.
+	PolicyBlock
+)
+
+// String returns a human-readable name of the Policy.
+func (p Policy) String() string {
+	switch p {
+	case PolicyAllow:
+		return "allow"
+	case PolicySilence:
+		return "silence"
+	case PolicyBlock:
+		return "block"
+	default:
+		return "unknown"
+	}
+}
+
+// DomainPolicy determines the Policy of remote peers by their domain.
+//
+// A domain also applies to its subdomains, so blocking "example.com" blocks
+// "social.example.com". When a domain matches more than one list, the most
+// restrictive Policy applies.
+//
+// A FederatingProtocol may embed a DomainPolicy, or call it, to be a
+// PeerPolicy.
+type DomainPolicy struct {
+	// AllowlistOnly blocks every domain not in Allowed.
+	AllowlistOnly bool
+	// Allowed are the domains federated with when AllowlistOnly is set.
+	Allowed []string
+	// Silenced are the domains whose activities are discarded.
+	Silenced []string
+	// Blocked are the domains that are not federated with.
+	Blocked []string
+}
+
+// FederationPolicy returns the Policy of the domain of the remote IRI.
+func (d DomainPolicy) FederationPolicy(c context.Context, remote *url.URL) (policy Policy, err error) {
+	host := strings.ToLower(remote.Hostname())
+	if d.AllowlistOnly && !matchesDomain(host, d.Allowed) {
+		return PolicyBlock, nil
+	} else if matchesDomain(host, d.Blocked) {
+		return PolicyBlock, nil
+	} else if matchesDomain(host, d.Silenced) {
+		return PolicySilence, nil
+	}
+	return PolicyAllow, nil
+}
+
+// matchesDomain determines whether the lower-case host is one of the domains
+// or one of their subdomains.
+func matchesDomain(host string, domains []string) bool {
+	for _, domain := range domains {
+		domain = strings.ToLower(domain)
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
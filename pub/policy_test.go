@@ -0,0 +1,72 @@
+package pub
+
+import (
+	"context"
+	"testing"
+)
+
+// TestDomainPolicy ensures domains are matched to the most restrictive policy.
+func TestDomainPolicy(t *testing.T) {
+	ctx := context.Background()
+	t.Run("AllowsUnlistedDomains", func(t *testing.T) {
+		d := DomainPolicy{}
+		p, err := d.FederationPolicy(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, p, PolicyAllow)
+	})
+	t.Run("BlocksDomain", func(t *testing.T) {
+		d := DomainPolicy{Blocked: []string{"other.example.com"}}
+		p, err := d.FederationPolicy(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, p, PolicyBlock)
+	})
+	t.Run("BlocksSubdomains", func(t *testing.T) {
+		d := DomainPolicy{Blocked: []string{"Example.com"}}
+		p, err := d.FederationPolicy(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, p, PolicyBlock)
+	})
+	t.Run("DoesNotMatchSuffixOfDomain", func(t *testing.T) {
+		d := DomainPolicy{Blocked: []string{"example.com"}}
+		p, err := d.FederationPolicy(ctx, mustParse("https://notexample.com/dakota"))
+		assertEqual(t, err, nil)
+		assertEqual(t, p, PolicyAllow)
+	})
+	t.Run("SilencesDomain", func(t *testing.T) {
+		d := DomainPolicy{Silenced: []string{"other.example.com"}}
+		p, err := d.FederationPolicy(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, p, PolicySilence)
+	})
+	t.Run("BlockOverridesSilence", func(t *testing.T) {
+		d := DomainPolicy{
+			Silenced: []string{"other.example.com"},
+			Blocked:  []string{"example.com"},
+		}
+		p, err := d.FederationPolicy(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, p, PolicyBlock)
+	})
+	t.Run("AllowlistOnlyBlocksUnlistedDomains", func(t *testing.T) {
+		d := DomainPolicy{
+			AllowlistOnly: true,
+			Allowed:       []string{"example.com"},
+		}
+		p, err := d.FederationPolicy(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, p, PolicyAllow)
+		p, err = d.FederationPolicy(ctx, mustParse("https://example.org/dakota"))
+		assertEqual(t, err, nil)
+		assertEqual(t, p, PolicyBlock)
+	})
+	t.Run("AllowlistOnlyStillSilences", func(t *testing.T) {
+		d := DomainPolicy{
+			AllowlistOnly: true,
+			Allowed:       []string{"example.com"},
+			Silenced:      []string{"other.example.com"},
+		}
+		p, err := d.FederationPolicy(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, p, PolicySilence)
+	})
+}
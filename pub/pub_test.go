@@ -15,6 +15,9 @@ import (
 	"time"
 )
 
+// testTxKey is the context key of a test transaction.
+type testTxKey struct{}
+
 const (
 	testMyInboxIRI            = "https://example.com/addison/inbox"
 	testMyOutboxIRI           = "https://example.com/addison/outbox"
@@ -26,6 +29,9 @@ const (
 	testFederatedActorIRI4    = "https://other.example.com/jessie"
 	testFederatedInboxIRI     = "https://other.example.com/dakota/inbox"
 	testFederatedInboxIRI2    = "https://other.example.com/addison/inbox"
+	testFederatedInboxIRI3    = "https://other.example.com/sam/inbox"
+	testFederatedInboxIRI4    = "https://other.example.com/jessie/inbox"
+	testFederatedSharedInbox  = "https://other.example.com/inbox"
 	testNoteId1               = "https://example.com/note/1"
 	testNoteId2               = "https://example.com/note/2"
 	testNewActivityIRI        = "https://example.com/new/1"
@@ -134,6 +140,10 @@ var (
 	testFederatedPerson1 vocab.ActivityStreamsPerson
 	// testFederatedPerson2 is a federated Person.
 	testFederatedPerson2 vocab.ActivityStreamsPerson
+	// testFederatedPerson3 is a federated Person.
+	testFederatedPerson3 vocab.ActivityStreamsPerson
+	// testFederatedPerson4 is a federated Person.
+	testFederatedPerson4 vocab.ActivityStreamsPerson
 	// testService is a Service.
 	testService vocab.ActivityStreamsService
 	// testCollectionOfActors is a collection of actors.
@@ -386,6 +396,26 @@ func setupData() {
 		inbox.SetIRI(mustParse(testFederatedInboxIRI2))
 		testFederatedPerson2.SetActivityStreamsInbox(inbox)
 	}()
+	// testFederatedPerson3
+	func() {
+		testFederatedPerson3 = streams.NewActivityStreamsPerson()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActorIRI3))
+		testFederatedPerson3.SetJSONLDId(id)
+		inbox := streams.NewActivityStreamsInboxProperty()
+		inbox.SetIRI(mustParse(testFederatedInboxIRI3))
+		testFederatedPerson3.SetActivityStreamsInbox(inbox)
+	}()
+	// testFederatedPerson4
+	func() {
+		testFederatedPerson4 = streams.NewActivityStreamsPerson()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActorIRI4))
+		testFederatedPerson4.SetJSONLDId(id)
+		inbox := streams.NewActivityStreamsInboxProperty()
+		inbox.SetIRI(mustParse(testFederatedInboxIRI4))
+		testFederatedPerson4.SetActivityStreamsInbox(inbox)
+	}()
 	// testService
 	func() {
 		testService = streams.NewActivityStreamsService()
@@ -533,16 +563,16 @@ func toAPRequest(r *http.Request) *http.Request {
 	if r.Method == "POST" {
 		existing, ok := r.Header[contentTypeHeader]
 		if ok {
-			r.Header[contentTypeHeader] = append(existing, activityStreamsMediaTypes[0])
+			r.Header[contentTypeHeader] = append(existing, activityJSONMediaType)
 		} else {
-			r.Header[contentTypeHeader] = []string{activityStreamsMediaTypes[0]}
+			r.Header[contentTypeHeader] = []string{activityJSONMediaType}
 		}
 	} else if r.Method == "GET" {
 		existing, ok := r.Header[acceptHeader]
 		if ok {
-			r.Header[acceptHeader] = append(existing, activityStreamsMediaTypes[0])
+			r.Header[acceptHeader] = append(existing, activityJSONMediaType)
 		} else {
-			r.Header[acceptHeader] = []string{activityStreamsMediaTypes[0]}
+			r.Header[acceptHeader] = []string{activityJSONMediaType}
 		}
 	} else {
 		panic("cannot toAPRequest with method " + r.Method)
@@ -607,6 +637,18 @@ func toPostInboxUnknownRequest() *http.Request {
 	return httptest.NewRequest("POST", testMyInboxIRI, buf)
 }
 
+// toPostInboxNoTypeRequest creates a new POST HTTP request with a body without
+// a type.
+func toPostInboxNoTypeRequest() *http.Request {
+	s := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://www.example.com/spam"
+}`
+	b := []byte(s)
+	buf := bytes.NewBuffer(b)
+	return httptest.NewRequest("POST", testMyInboxIRI, buf)
+}
+
 // toGetInboxRequest creates a new GET HTTP request.
 func toGetInboxRequest() *http.Request {
 	return httptest.NewRequest("GET", testMyInboxIRI, nil)
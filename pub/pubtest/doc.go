@@ -0,0 +1,15 @@
+// Package pubtest provides test doubles for applications built with package
+// pub.
+//
+// The doubles are deterministic: every Signer uses the same fixed RSA key pair
+// and every Clock reports the same fixed time. This lets an application's test
+// suite exercise its authentication paths, such as a FederatingProtocol's
+// AuthenticatePostInbox, with correctly signed requests.
+//
+// A Peer is a remote ActivityPub instance served on the local machine. It
+// serves actors and their public keys, accepts signed deliveries, and records
+// them for assertions, so that delivery and signature code can be tested end to
+// end without real peers.
+//
+// Nothing in this package is secure. It must only be used in tests.
+package pubtest
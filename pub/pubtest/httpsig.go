@@ -0,0 +1,254 @@
+package pubtest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/httpsig"
+)
+
+const (
+	// Algorithm is the HTTP Signature algorithm used by Signers and
+	// expected by Verifiers.
+	Algorithm = httpsig.RSA_SHA256
+	// DefaultMaxSkew is the default amount of time a request's Date may
+	// differ from a Verifier's Clock.
+	DefaultMaxSkew = 5 * time.Minute
+	// activityStreamsMediaType is both the Accept and Content-Type of the
+	// requests created by a Signer.
+	activityStreamsMediaType = "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\""
+	dateHeader               = "Date"
+	digestHeader             = "Digest"
+	digestSha256Prefix       = "SHA-256="
+)
+
+var (
+	// Time is the fixed time reported by a Clock created with NewClock.
+	Time = time.Date(2019, time.September, 14, 12, 0, 0, 0, time.UTC)
+	// privateKey is the parsed testPrivateKeyPEM.
+	privateKey *rsa.PrivateKey
+)
+
+func init() {
+	block, _ := pem.Decode([]byte(testPrivateKeyPEM))
+	if block == nil {
+		panic("pubtest: cannot decode the test private key")
+	}
+	var err error
+	privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		panic(fmt.Sprintf("pubtest: cannot parse the test private key: %s", err))
+	}
+}
+
+// PrivateKey returns the fixed private key used by every Signer.
+func PrivateKey() *rsa.PrivateKey {
+	return privateKey
+}
+
+// PublicKey returns the fixed public key used by every Verifier.
+func PublicKey() *rsa.PublicKey {
+	return &privateKey.PublicKey
+}
+
+// PublicKeyPEM returns the PEM encoding of PublicKey, suitable as the value of
+// an actor's 'publicKeyPem' property.
+func PublicKeyPEM() string {
+	return testPublicKeyPEM
+}
+
+var _ pub.Clock = Clock{}
+
+// Clock is a pub.Clock that always reports the same time.
+type Clock struct {
+	T time.Time
+}
+
+// NewClock returns a Clock reporting Time.
+func NewClock() Clock {
+	return Clock{T: Time}
+}
+
+// Now returns the fixed time.
+func (c Clock) Now() time.Time {
+	return c.T
+}
+
+// Signer adds HTTP Signatures to requests using the fixed private key.
+//
+// GET requests sign the "(request-target)" and "Date" headers. All other
+// requests also sign the "Digest" header, which is computed from the body.
+type Signer struct {
+	// KeyId is the id of the public key placed in the signature.
+	KeyId string
+	// Clock determines the value of the Date header.
+	Clock pub.Clock
+}
+
+// NewSigner creates a Signer for the public key id using a Clock created with
+// NewClock.
+func NewSigner(keyId string) *Signer {
+	return &Signer{
+		KeyId: keyId,
+		Clock: NewClock(),
+	}
+}
+
+// Sign adds a Date header, if it is not already set, and an HTTP Signature to
+// the request. The body must match the body of the request.
+func (s *Signer) Sign(r *http.Request, body []byte) error {
+	if len(r.Header.Get(dateHeader)) == 0 {
+		r.Header.Set(dateHeader, s.Clock.Now().UTC().Format(http.TimeFormat))
+	}
+	headers := []string{httpsig.RequestTarget, dateHeader}
+	if r.Method != http.MethodGet {
+		// The Digest is computed here, instead of by httpsig, so that it
+		// is the RFC 3230 digest of exactly the body.
+		sum := sha256.Sum256(body)
+		r.Header.Set(digestHeader, digestSha256Prefix+base64.StdEncoding.EncodeToString(sum[:]))
+		headers = append(headers, digestHeader)
+	}
+	// httpsig Signers are not safe for concurrent use, so one is created
+	// for every request.
+	signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{Algorithm}, httpsig.DigestSha256, headers, httpsig.Signature)
+	if err != nil {
+		return err
+	}
+	return signer.SignRequest(privateKey, s.KeyId, r, nil)
+}
+
+// NewRequest returns a new incoming ActivityPub request signed by the Signer,
+// suitable for passing to an http.Handler.
+//
+// GET requests have an ActivityStreams Accept header. All other requests have
+// an ActivityStreams Content-Type header.
+//
+// Like httptest.NewRequest, it panics on error.
+func (s *Signer) NewRequest(method, target string, body []byte) *http.Request {
+	r := httptest.NewRequest(method, target, bytes.NewReader(body))
+	if method == http.MethodGet {
+		r.Header.Set("Accept", activityStreamsMediaType)
+	} else {
+		r.Header.Set("Content-Type", activityStreamsMediaType)
+	}
+	if err := s.Sign(r, body); err != nil {
+		panic(fmt.Sprintf("pubtest: cannot sign request: %s", err))
+	}
+	return r
+}
+
+// Verifier verifies the HTTP Signatures created by a Signer.
+type Verifier struct {
+	// KeyId is the only public key id accepted. If empty, any key id is
+	// accepted.
+	KeyId string
+	// Clock is compared against the Date header of requests.
+	Clock pub.Clock
+	// MaxSkew is the amount of time a request's Date may differ from the
+	// Clock.
+	MaxSkew time.Duration
+}
+
+// NewVerifier creates a Verifier for the public key id using a Clock created
+// with NewClock and DefaultMaxSkew.
+func NewVerifier(keyId string) *Verifier {
+	return &Verifier{
+		KeyId:   keyId,
+		Clock:   NewClock(),
+		MaxSkew: DefaultMaxSkew,
+	}
+}
+
+// Verify checks the HTTP Signature, Date, and Digest of the request. It
+// returns the public key id of the signature.
+//
+// The request body, if any, is read and replaced so that it can be read again.
+func (v *Verifier) Verify(r *http.Request) (keyId string, err error) {
+	verifier, err := httpsig.NewVerifier(r)
+	if err != nil {
+		return
+	}
+	keyId = verifier.KeyId()
+	if len(v.KeyId) > 0 && keyId != v.KeyId {
+		err = fmt.Errorf("unexpected public key id %q", keyId)
+		return
+	}
+	if err = v.verifyDate(r); err != nil {
+		return
+	}
+	if err = verifyDigest(r); err != nil {
+		return
+	}
+	err = verifier.Verify(PublicKey(), Algorithm)
+	return
+}
+
+// AuthenticatePostInbox verifies the request, and has the same signature as the
+// FederatingProtocol method so that it can be used to implement it in tests.
+//
+// A request that fails verification receives a http.StatusUnauthorized
+// response.
+func (v *Verifier) AuthenticatePostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (out context.Context, authenticated bool, err error) {
+	out = c
+	if _, verr := v.Verify(r); verr != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	authenticated = true
+	return
+}
+
+// verifyDate checks the request's Date header is within MaxSkew of the Clock.
+func (v *Verifier) verifyDate(r *http.Request) error {
+	date, err := http.ParseTime(r.Header.Get(dateHeader))
+	if err != nil {
+		return fmt.Errorf("invalid Date header: %s", err)
+	}
+	skew := v.Clock.Now().Sub(date)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > v.MaxSkew {
+		return fmt.Errorf("Date header is %s away from now", skew)
+	}
+	return nil
+}
+
+// verifyDigest checks the request's SHA-256 Digest header matches its body. A
+// request without a body does not need a Digest.
+func verifyDigest(r *http.Request) error {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(r.Body); err != nil {
+			return err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	digest := r.Header.Get(digestHeader)
+	if len(digest) == 0 {
+		if len(body) > 0 {
+			return fmt.Errorf("missing Digest header")
+		}
+		return nil
+	} else if !strings.HasPrefix(digest, digestSha256Prefix) {
+		return fmt.Errorf("unsupported Digest header %q", digest)
+	}
+	sum := sha256.Sum256(body)
+	if strings.TrimPrefix(digest, digestSha256Prefix) != base64.StdEncoding.EncodeToString(sum[:]) {
+		return fmt.Errorf("Digest header does not match the body")
+	}
+	return nil
+}
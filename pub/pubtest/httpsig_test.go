@@ -0,0 +1,113 @@
+package pubtest
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testKeyId    = "https://example.com/addison#main-key"
+	testInboxIRI = "https://example.com/addison/inbox"
+	testBody     = `{"type":"Create"}`
+)
+
+// TestSignerVerifier tests that requests created by a Signer pass and fail
+// verification as expected.
+func TestSignerVerifier(t *testing.T) {
+	t.Run("VerifiesPost", func(t *testing.T) {
+		r := NewSigner(testKeyId).NewRequest("POST", testInboxIRI, []byte(testBody))
+		keyId, err := NewVerifier(testKeyId).Verify(r)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if keyId != testKeyId {
+			t.Fatalf("got key id %q, want %q", keyId, testKeyId)
+		}
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if string(b) != testBody {
+			t.Fatalf("body was not preserved: %s", b)
+		}
+	})
+	t.Run("VerifiesGet", func(t *testing.T) {
+		r := NewSigner(testKeyId).NewRequest("GET", testInboxIRI, nil)
+		if _, err := NewVerifier(testKeyId).Verify(r); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+	t.Run("IsDeterministic", func(t *testing.T) {
+		r1 := NewSigner(testKeyId).NewRequest("POST", testInboxIRI, []byte(testBody))
+		r2 := NewSigner(testKeyId).NewRequest("POST", testInboxIRI, []byte(testBody))
+		if s1, s2 := r1.Header.Get("Signature"), r2.Header.Get("Signature"); s1 != s2 {
+			t.Fatalf("signatures differ: %q != %q", s1, s2)
+		}
+	})
+	t.Run("FailsIfUnsigned", func(t *testing.T) {
+		r := httptest.NewRequest("GET", testInboxIRI, nil)
+		if _, err := NewVerifier(testKeyId).Verify(r); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("FailsIfWrongKeyId", func(t *testing.T) {
+		r := NewSigner("https://example.com/dakota#main-key").NewRequest("GET", testInboxIRI, nil)
+		if _, err := NewVerifier(testKeyId).Verify(r); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("FailsIfBodyTamperedWith", func(t *testing.T) {
+		r := NewSigner(testKeyId).NewRequest("POST", testInboxIRI, []byte(testBody))
+		tampered := httptest.NewRequest("POST", testInboxIRI, nil)
+		tampered.Header = r.Header
+		tampered.Body = ioutil.NopCloser(strings.NewReader(`{"type":"Delete"}`))
+		if _, err := NewVerifier(testKeyId).Verify(tampered); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("FailsIfHeaderTamperedWith", func(t *testing.T) {
+		r := NewSigner(testKeyId).NewRequest("GET", testInboxIRI, nil)
+		r.Header.Set("Date", Time.Add(time.Minute).Format(http.TimeFormat))
+		if _, err := NewVerifier(testKeyId).Verify(r); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+	t.Run("FailsIfDateTooSkewed", func(t *testing.T) {
+		s := NewSigner(testKeyId)
+		s.Clock = Clock{T: Time.Add(-time.Hour)}
+		r := s.NewRequest("GET", testInboxIRI, nil)
+		if _, err := NewVerifier(testKeyId).Verify(r); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+}
+
+// TestAuthenticatePostInbox tests the FederatingProtocol-compatible helper.
+func TestAuthenticatePostInbox(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Authenticates", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		r := NewSigner(testKeyId).NewRequest("POST", testInboxIRI, []byte(testBody))
+		_, authenticated, err := NewVerifier(testKeyId).AuthenticatePostInbox(ctx, resp, r)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if !authenticated {
+			t.Fatalf("expected to be authenticated")
+		}
+	})
+	t.Run("RespondsUnauthorized", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", testInboxIRI, strings.NewReader(testBody))
+		_, authenticated, err := NewVerifier(testKeyId).AuthenticatePostInbox(ctx, resp, r)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if authenticated {
+			t.Fatalf("expected to not be authenticated")
+		} else if resp.Code != http.StatusUnauthorized {
+			t.Fatalf("got status %d, want %d", resp.Code, http.StatusUnauthorized)
+		}
+	})
+}
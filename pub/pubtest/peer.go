@@ -0,0 +1,300 @@
+package pubtest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+const (
+	// peerActorsPath is the path beneath which a Peer serves its actors.
+	peerActorsPath = "/users/"
+	// peerSharedInboxPath is the path of a Peer's shared inbox.
+	peerSharedInboxPath = "/inbox"
+	// peerKeyFragment is the fragment of an actor's IRI identifying its
+	// public key.
+	peerKeyFragment = "main-key"
+	// peerMediaType is the Content-Type of the values served by a Peer.
+	peerMediaType = "application/activity+json"
+)
+
+// Delivery is an activity a Peer received in an inbox.
+type Delivery struct {
+	// Inbox is the IRI of the inbox the activity was posted to.
+	Inbox *url.URL
+	// KeyId is the public key id of the request's HTTP Signature, or empty
+	// if the Peer does not verify signatures.
+	KeyId string
+	// Body is the request body.
+	Body []byte
+	// Activity is the deserialized request body.
+	Activity vocab.Type
+}
+
+// Response is a canned response a Peer replies with instead of its own.
+type Response struct {
+	// StatusCode is the status of the response. If zero, http.StatusOK is
+	// used.
+	StatusCode int
+	// Header is added to the headers of the response.
+	Header http.Header
+	// Body is the body of the response.
+	Body []byte
+}
+
+// Peer is a remote ActivityPub instance for end-to-end tests of delivery and
+// dereferencing. It is an HTTPS server on the local machine, which serves its
+// actors with their public keys, accepts signed deliveries to their inboxes,
+// and records them.
+//
+// The actors' public key is PublicKeyPEM, so requests a Peer's actors are
+// expected to have sent can be created by a Signer with the actor's KeyId.
+// Deliveries to a Peer must be signed with PrivateKey, such as by a
+// pub.HttpSigTransport given PrivateKey and a Clock created with NewClock.
+//
+// Requests to a Peer must be sent with its Client, which trusts its
+// certificate.
+type Peer struct {
+	// Verifier verifies the HTTP Signatures of deliveries, which are
+	// rejected with a http.StatusUnauthorized response if it fails. If nil,
+	// deliveries do not need to be signed.
+	Verifier *Verifier
+	server   *httptest.Server
+	// mu guards the fields below.
+	mu         sync.Mutex
+	values     map[string][]byte
+	inboxes    map[string]bool
+	responses  map[string][]Response
+	deliveries []Delivery
+}
+
+// NewPeer starts a Peer whose Verifier accepts any public key id. It must be
+// closed after use.
+func NewPeer() *Peer {
+	p := &Peer{
+		Verifier:  NewVerifier(""),
+		values:    make(map[string][]byte),
+		inboxes:   map[string]bool{peerSharedInboxPath: true},
+		responses: make(map[string][]Response),
+	}
+	p.server = httptest.NewTLSServer(http.HandlerFunc(p.serveHTTP))
+	return p
+}
+
+// Close shuts down the Peer.
+func (p *Peer) Close() {
+	p.server.Close()
+}
+
+// Client returns an HTTP client that trusts the Peer's certificate, suitable
+// for a pub.HttpSigTransport.
+func (p *Peer) Client() *http.Client {
+	return p.server.Client()
+}
+
+// IRI returns the IRI of the path on the Peer.
+func (p *Peer) IRI(path string) *url.URL {
+	u, err := url.Parse(p.server.URL)
+	if err != nil {
+		panic(err)
+	}
+	u.Path = path
+	return u
+}
+
+// SharedInbox returns the IRI of the Peer's shared inbox, which is the
+// 'sharedInbox' endpoint of each of its actors.
+func (p *Peer) SharedInbox() *url.URL {
+	return p.IRI(peerSharedInboxPath)
+}
+
+// AddActor creates and serves a Person with the name. Its inbox, outbox, and
+// public key are the actor's IRI followed by "/inbox", "/outbox", and
+// "#main-key" respectively.
+func (p *Peer) AddActor(name string) vocab.ActivityStreamsPerson {
+	actor := p.IRI(peerActorsPath + name)
+	person := streams.NewActivityStreamsPerson()
+	streams.SetId(person, actor)
+	username := streams.NewActivityStreamsPreferredUsernameProperty()
+	username.SetXMLSchemaString(name)
+	person.SetActivityStreamsPreferredUsername(username)
+	inbox := streams.NewActivityStreamsInboxProperty()
+	inbox.SetIRI(p.IRI(actor.Path + "/inbox"))
+	person.SetActivityStreamsInbox(inbox)
+	outbox := streams.NewActivityStreamsOutboxProperty()
+	outbox.SetIRI(p.IRI(actor.Path + "/outbox"))
+	person.SetActivityStreamsOutbox(outbox)
+	streams.SetEndpoint(person, streams.EndpointSharedInbox, p.SharedInbox())
+	pk := streams.NewW3IDSecurityV1PublicKey()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(keyIRI(actor))
+	pk.SetJSONLDId(id)
+	owner := streams.NewW3IDSecurityV1OwnerProperty()
+	owner.Set(actor)
+	pk.SetW3IDSecurityV1Owner(owner)
+	pem := streams.NewW3IDSecurityV1PublicKeyPemProperty()
+	pem.Set(PublicKeyPEM())
+	pk.SetW3IDSecurityV1PublicKeyPem(pem)
+	pkProp := streams.NewW3IDSecurityV1PublicKeyProperty()
+	pkProp.AppendW3IDSecurityV1PublicKey(pk)
+	person.SetW3IDSecurityV1PublicKey(pkProp)
+	if err := p.Serve(person); err != nil {
+		panic(err)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inboxes[inbox.GetIRI().Path] = true
+	return person
+}
+
+// Serve serves the value at the path of its id, replacing any value already
+// served there.
+func (p *Peer) Serve(t vocab.Type) error {
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.values[streams.GetId(t).Path] = b
+	return nil
+}
+
+// Respond replies to the requests for the path with the canned responses
+// instead, in order. The last response is replied to all later requests.
+//
+// Deliveries to an inbox are still verified and recorded before the canned
+// response is replied, so that tests can assert that the delivery was
+// attempted.
+func (p *Peer) Respond(path string, responses ...Response) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(responses) == 0 {
+		delete(p.responses, path)
+		return
+	}
+	p.responses[path] = responses
+}
+
+// Deliveries returns the activities received in every inbox, in the order they
+// were received.
+func (p *Peer) Deliveries() []Delivery {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Delivery(nil), p.deliveries...)
+}
+
+// DeliveriesTo returns the activities received in the inbox, in the order they
+// were received.
+func (p *Peer) DeliveriesTo(inbox *url.URL) (d []Delivery) {
+	for _, delivery := range p.Deliveries() {
+		if delivery.Inbox.String() == inbox.String() {
+			d = append(d, delivery)
+		}
+	}
+	return
+}
+
+// KeyId returns the public key id of an actor of a Peer.
+func KeyId(actor *url.URL) string {
+	return keyIRI(actor).String()
+}
+
+// keyIRI returns the IRI of the public key of an actor of a Peer.
+func keyIRI(actor *url.URL) *url.URL {
+	key := *actor
+	key.Fragment = peerKeyFragment
+	return &key
+}
+
+// serveHTTP serves the values and canned responses of the Peer, and accepts
+// deliveries to its inboxes.
+func (p *Peer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	isInbox := p.inboxes[r.URL.Path]
+	p.mu.Unlock()
+	if r.Method == http.MethodPost && isInbox {
+		if code := p.deliver(r); code != http.StatusAccepted {
+			w.WriteHeader(code)
+			return
+		}
+	}
+	if resp, ok := p.nextResponse(r.URL.Path); ok {
+		for k, v := range resp.Header {
+			w.Header()[k] = v
+		}
+		if resp.StatusCode == 0 {
+			resp.StatusCode = http.StatusOK
+		}
+		w.WriteHeader(resp.StatusCode)
+		w.Write(resp.Body)
+		return
+	}
+	switch {
+	case r.Method == http.MethodPost && isInbox:
+		w.WriteHeader(http.StatusAccepted)
+	case r.Method == http.MethodGet:
+		p.mu.Lock()
+		b, ok := p.values[r.URL.Path]
+		p.mu.Unlock()
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", peerMediaType)
+		w.Write(b)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// deliver verifies and records the delivery, returning http.StatusAccepted if
+// it is accepted or the status of its rejection otherwise.
+func (p *Peer) deliver(r *http.Request) int {
+	d := Delivery{Inbox: p.IRI(r.URL.Path)}
+	if p.Verifier != nil {
+		var err error
+		if d.KeyId, err = p.Verifier.Verify(r); err != nil {
+			return http.StatusUnauthorized
+		}
+	}
+	var err error
+	if d.Body, err = ioutil.ReadAll(r.Body); err != nil {
+		return http.StatusBadRequest
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(d.Body, &m); err != nil {
+		return http.StatusBadRequest
+	}
+	if d.Activity, err = streams.ToType(r.Context(), m); err != nil {
+		return http.StatusBadRequest
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.deliveries = append(p.deliveries, d)
+	return http.StatusAccepted
+}
+
+// nextResponse returns the next canned response for the path, if any.
+func (p *Peer) nextResponse(path string) (Response, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	responses := p.responses[path]
+	if len(responses) == 0 {
+		return Response{}, false
+	}
+	if len(responses) > 1 {
+		p.responses[path] = responses[1:]
+	}
+	return responses[0], true
+}
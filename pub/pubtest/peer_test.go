@@ -0,0 +1,212 @@
+package pubtest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/go-fed/httpsig"
+)
+
+const testLocalActorIRI = "https://example.com/addison"
+
+// newPeerTransport returns a pub.HttpSigTransport to the Peer, signing with
+// the fixed private key as testLocalActorIRI.
+func newPeerTransport(t *testing.T, p *Peer) *pub.HttpSigTransport {
+	getSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{Algorithm}, httpsig.DigestSha256, []string{httpsig.RequestTarget, "Date"}, httpsig.Signature)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	postSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{Algorithm}, httpsig.DigestSha256, []string{httpsig.RequestTarget, "Date", "Digest"}, httpsig.Signature)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return pub.NewHttpSigTransport(p.Client(), "pubtest", NewClock(), getSigner, postSigner, KeyId(mustParse(testLocalActorIRI)), PrivateKey())
+}
+
+// newTestCreate returns a serialized Create of a Note addressed to the actor.
+func newTestCreate(t *testing.T, actor *url.URL) []byte {
+	create := streams.NewCreateFromObject(streams.NewNote("hello", mustParse(testLocalActorIRI), []*url.URL{actor}), mustParse(testLocalActorIRI))
+	m, err := streams.Serialize(create)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return b
+}
+
+// mustParse parses the IRI, panicking if it is invalid.
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// TestPeerServesActors tests that the actors of a Peer can be dereferenced with
+// their public keys.
+func TestPeerServesActors(t *testing.T) {
+	p := NewPeer()
+	defer p.Close()
+	actor := streams.GetId(p.AddActor("sally"))
+	b, err := newPeerTransport(t, p).Dereference(context.Background(), actor)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	v, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	person, ok := v.(vocab.ActivityStreamsPerson)
+	if !ok {
+		t.Fatalf("got a %s, want a Person", v.GetTypeName())
+	}
+	if inbox := person.GetActivityStreamsInbox(); inbox == nil || inbox.GetIRI().String() != actor.String()+"/inbox" {
+		t.Errorf("got inbox %v", inbox)
+	}
+	if shared := streams.GetEndpoint(person, streams.EndpointSharedInbox); shared == nil || shared.String() != p.SharedInbox().String() {
+		t.Errorf("got shared inbox %v, want %s", shared, p.SharedInbox())
+	}
+	keys := person.GetW3IDSecurityV1PublicKey()
+	if keys == nil || keys.Len() != 1 || !keys.At(0).IsW3IDSecurityV1PublicKey() {
+		t.Fatalf("got public key %v", keys)
+	}
+	pk := keys.At(0).Get()
+	if id := pk.GetJSONLDId(); id == nil || id.Get().String() != KeyId(actor) {
+		t.Errorf("got public key id %v, want %s", id, KeyId(actor))
+	}
+	if pem := pk.GetW3IDSecurityV1PublicKeyPem(); pem == nil || pem.Get() != PublicKeyPEM() {
+		t.Errorf("got public key PEM %v", pem)
+	}
+	if _, err = newPeerTransport(t, p).Dereference(context.Background(), p.IRI("/users/unknown")); err == nil {
+		t.Errorf("expected an error dereferencing an unknown actor")
+	}
+}
+
+// TestPeerRecordsDeliveries tests that signed deliveries are recorded, and
+// unsigned ones rejected.
+func TestPeerRecordsDeliveries(t *testing.T) {
+	t.Run("RecordsSignedDelivery", func(t *testing.T) {
+		p := NewPeer()
+		defer p.Close()
+		actor := streams.GetId(p.AddActor("sally"))
+		inbox := mustParse(actor.String() + "/inbox")
+		if err := newPeerTransport(t, p).Deliver(context.Background(), newTestCreate(t, actor), inbox); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		d := p.DeliveriesTo(inbox)
+		if len(d) != 1 {
+			t.Fatalf("got %d deliveries, want 1", len(d))
+		}
+		if d[0].KeyId != KeyId(mustParse(testLocalActorIRI)) {
+			t.Errorf("got key id %q", d[0].KeyId)
+		}
+		if _, ok := d[0].Activity.(vocab.ActivityStreamsCreate); !ok {
+			t.Errorf("got a %s, want a Create", d[0].Activity.GetTypeName())
+		}
+		if len(p.DeliveriesTo(p.SharedInbox())) != 0 {
+			t.Errorf("got deliveries to the shared inbox")
+		}
+	})
+	t.Run("RecordsSharedInboxDelivery", func(t *testing.T) {
+		p := NewPeer()
+		defer p.Close()
+		actor := streams.GetId(p.AddActor("sally"))
+		if err := newPeerTransport(t, p).BatchDeliver(context.Background(), newTestCreate(t, actor), []*url.URL{p.SharedInbox()}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if d := p.DeliveriesTo(p.SharedInbox()); len(d) != 1 {
+			t.Fatalf("got %d deliveries, want 1", len(d))
+		}
+	})
+	t.Run("RejectsUnsignedDelivery", func(t *testing.T) {
+		p := NewPeer()
+		defer p.Close()
+		actor := streams.GetId(p.AddActor("sally"))
+		body := string(newTestCreate(t, actor))
+		resp, err := p.Client().Post(actor.String()+"/inbox", activityStreamsMediaType, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+		}
+		if d := p.Deliveries(); len(d) != 0 {
+			t.Errorf("got %d deliveries, want 0", len(d))
+		}
+	})
+	t.Run("AcceptsUnsignedDeliveryWithoutVerifier", func(t *testing.T) {
+		p := NewPeer()
+		defer p.Close()
+		p.Verifier = nil
+		actor := streams.GetId(p.AddActor("sally"))
+		body := string(newTestCreate(t, actor))
+		resp, err := p.Client().Post(actor.String()+"/inbox", activityStreamsMediaType, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusAccepted {
+			t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusAccepted)
+		}
+		if d := p.Deliveries(); len(d) != 1 {
+			t.Errorf("got %d deliveries, want 1", len(d))
+		}
+	})
+}
+
+// TestPeerReplaysResponses tests that canned responses are replied in order,
+// while deliveries are still recorded.
+func TestPeerReplaysResponses(t *testing.T) {
+	t.Run("ReplaysDeliveryResponses", func(t *testing.T) {
+		p := NewPeer()
+		defer p.Close()
+		actor := streams.GetId(p.AddActor("sally"))
+		inbox := mustParse(actor.String() + "/inbox")
+		p.Respond(inbox.Path, Response{StatusCode: http.StatusServiceUnavailable}, Response{StatusCode: http.StatusAccepted})
+		tp := newPeerTransport(t, p)
+		b := newTestCreate(t, actor)
+		if err := tp.Deliver(context.Background(), b, inbox); err == nil {
+			t.Fatalf("expected an error")
+		}
+		for i := 0; i < 2; i++ {
+			if err := tp.Deliver(context.Background(), b, inbox); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+		if d := p.DeliveriesTo(inbox); len(d) != 3 {
+			t.Errorf("got %d deliveries, want 3", len(d))
+		}
+	})
+	t.Run("ReplaysDereferenceResponses", func(t *testing.T) {
+		p := NewPeer()
+		defer p.Close()
+		actor := streams.GetId(p.AddActor("sally"))
+		p.Respond(actor.Path, Response{StatusCode: http.StatusGone})
+		_, err := newPeerTransport(t, p).Dereference(context.Background(), actor)
+		if derr, ok := err.(*pub.DereferenceError); !ok {
+			t.Fatalf("got error %v, want a DereferenceError", err)
+		} else if derr.StatusCode != http.StatusGone {
+			t.Errorf("got status %d, want %d", derr.StatusCode, http.StatusGone)
+		}
+		p.Respond(actor.Path)
+		if _, err = newPeerTransport(t, p).Dereference(context.Background(), actor); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	})
+}
@@ -0,0 +1,293 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// RelayProtocol contains behaviors an application needs to satisfy in order to
+// relay federated activities to other peers without modifying them.
+//
+// It is passed to the library as a dependency injection from the client
+// application.
+type RelayProtocol interface {
+	// AuthenticatePostInbox delegates the authentication of a POST to the
+	// relay's inbox.
+	//
+	// If an error is returned, it is passed back to the caller of the
+	// HandlerFunc. In this case, the implementation must not write a
+	// response to the ResponseWriter as is expected that the client will
+	// do so when handling the error. The 'authenticated' is ignored.
+	//
+	// If no error is returned, but authentication or authorization fails,
+	// then authenticated must be false and error nil. It is expected that
+	// the implementation handles writing to the ResponseWriter in this
+	// case.
+	//
+	// Finally, if the authentication and authorization succeeds, then
+	// authenticated must be true and error nil. The request will continue
+	// to be processed.
+	AuthenticatePostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (out context.Context, authenticated bool, err error)
+	// Blocked should determine whether to permit a set of actors given by
+	// their ids are able to have their activities relayed.
+	//
+	// If an error is returned, it is passed back to the caller of the
+	// HandlerFunc.
+	//
+	// If no error is returned, but the actors are not permitted, then
+	// blocked must be true and error nil. An http.StatusForbidden will be
+	// written in the response.
+	//
+	// Finally, if the actors are permitted, then blocked must be false and
+	// error nil. The request will continue to be processed.
+	Blocked(c context.Context, actorIRIs []*url.URL) (blocked bool, err error)
+	// Route determines the inboxes that the relayed activity is forwarded
+	// to. The provided url is the inbox of the relay that received the
+	// activity.
+	//
+	// The RelayedActivity is only a shallow parse of the received
+	// document, and must not be relied upon to be a valid ActivityStreams
+	// value beyond its 'id', 'type', and 'actor'.
+	//
+	// Returning no recipients results in the activity being accepted but
+	// not forwarded.
+	Route(c context.Context, inboxIRI *url.URL, activity *RelayedActivity) (recipients []*url.URL, err error)
+	// NewTransport returns a new Transport on behalf of the relay.
+	//
+	// The actorBoxIRI will be the inbox of the relay. Any authentication
+	// scheme applied on the request must be based on the relay's actor.
+	//
+	// Note that the Transport will be given the original bytes of the
+	// received document, which it must deliver unmodified for any Linked
+	// Data Signatures to remain valid.
+	NewTransport(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (t Transport, err error)
+}
+
+// RelayedActivity is the result of a shallow parse of an activity received by
+// a relay.
+//
+// Only the values needed to validate and route the activity are parsed. The
+// original document is preserved byte-for-byte in Raw, so that it is
+// forwarded exactly as it was received.
+type RelayedActivity struct {
+	// Id is the 'id' of the activity.
+	Id *url.URL
+	// Types are the values of the 'type' property.
+	Types []string
+	// Actors are the ids of the values of the 'actor' property.
+	Actors []*url.URL
+	// Raw is the unmodified request body.
+	Raw []byte
+}
+
+// shallowActivity is the subset of an activity decoded by a relay.
+type shallowActivity struct {
+	Id    string          `json:"id"`
+	Type  json.RawMessage `json:"type"`
+	Actor json.RawMessage `json:"actor"`
+}
+
+// shallowId is an embedded value that only has its 'id' decoded.
+type shallowId struct {
+	Id string `json:"id"`
+}
+
+// parseRelayedActivity shallowly parses the raw document, validating that it
+// is a JSON object with an 'id', a 'type', and at least one 'actor'.
+func parseRelayedActivity(raw []byte) (*RelayedActivity, error) {
+	var s shallowActivity
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+	id, err := parseAbsoluteIRI(s.Id)
+	if err != nil {
+		return nil, fmt.Errorf("relayed activity has an invalid id: %s", err)
+	}
+	a := &RelayedActivity{
+		Id:  id,
+		Raw: raw,
+	}
+	if a.Types, err = parseShallowTypes(s.Type); err != nil {
+		return nil, err
+	}
+	if a.Actors, err = parseShallowIds(s.Actor); err != nil {
+		return nil, err
+	}
+	if len(a.Types) == 0 {
+		return nil, fmt.Errorf("relayed activity has no type")
+	} else if len(a.Actors) == 0 {
+		return nil, fmt.Errorf("relayed activity has no actor")
+	}
+	return a, nil
+}
+
+// parseShallowTypes decodes a 'type' value that is either a single string or
+// an array of strings.
+func parseShallowTypes(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}, nil
+	}
+	var multi []string
+	if err := json.Unmarshal(raw, &multi); err != nil {
+		return nil, fmt.Errorf("relayed activity has an invalid type: %s", err)
+	}
+	return multi, nil
+}
+
+// parseShallowIds decodes the ids of a property whose value is either an IRI,
+// an embedded value with an 'id', or an array of either.
+func parseShallowIds(raw json.RawMessage) ([]*url.URL, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var elems []json.RawMessage
+	if err := json.Unmarshal(raw, &elems); err != nil {
+		elems = []json.RawMessage{raw}
+	}
+	ids := make([]*url.URL, 0, len(elems))
+	for _, elem := range elems {
+		var s string
+		if err := json.Unmarshal(elem, &s); err != nil {
+			var v shallowId
+			if err = json.Unmarshal(elem, &v); err != nil {
+				return nil, fmt.Errorf("relayed activity has an invalid actor: %s", err)
+			}
+			s = v.Id
+		}
+		id, err := parseAbsoluteIRI(s)
+		if err != nil {
+			return nil, fmt.Errorf("relayed activity has an invalid actor: %s", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// parseAbsoluteIRI parses a string into an IRI, which must be absolute.
+func parseAbsoluteIRI(s string) (*url.URL, error) {
+	if len(s) == 0 {
+		return nil, fmt.Errorf("missing IRI")
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	} else if !u.IsAbs() {
+		return nil, fmt.Errorf("IRI %q is not absolute", s)
+	}
+	return u, nil
+}
+
+// NewRelayHandler creates a HandlerFunc to act as the inbox of a relay.
+//
+// Unlike an Actor's PostInbox, the received document is never deserialized into
+// an ActivityStreams type nor serialized again. It is only shallowly parsed in
+// order to validate it and to determine who to forward it to. The original
+// bytes of the request body are then delivered as-is, so that Linked Data
+// Signatures and any properties unknown to go-fed survive being relayed.
+//
+// The HandlerFunc only handles ActivityPub POST requests. Requests whose body
+// cannot be shallowly parsed receive a http.StatusBadRequest response.
+//
+// No side effects occur beyond delivering to the routed recipients: nothing is
+// added to an inbox nor stored in a database.
+func NewRelayHandler(relay RelayProtocol) HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		// Do nothing if it is not an ActivityPub POST request.
+		if !isActivityPubPost(r) {
+			return
+		}
+		isASRequest = true
+		// Check the peer request is authentic.
+		c, authenticated, err := relay.AuthenticatePostInbox(c, w, r)
+		if err != nil {
+			return
+		} else if !authenticated {
+			return
+		}
+		raw, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return
+		}
+		activity, perr := parseRelayedActivity(raw)
+		if perr != nil {
+			// Respond with bad request -- we cannot relay it.
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// Determine if the actor(s) sending this request are blocked.
+		blocked, err := relay.Blocked(c, activity.Actors)
+		if err != nil {
+			return
+		} else if blocked {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		inboxId := requestId(r)
+		recipients, err := relay.Route(c, inboxId, activity)
+		if err != nil {
+			return
+		}
+		// Never relay the activity back to ourselves.
+		recipients = dedupeIRIs(recipients, []*url.URL{inboxId})
+		if len(recipients) > 0 {
+			var tp Transport
+			tp, err = relay.NewTransport(c, inboxId, goFedUserAgent())
+			if err != nil {
+				return
+			}
+			if err = tp.BatchDeliver(c, activity.Raw, recipients); err != nil {
+				return
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+}
+
+// RelayDialect is the kind of Follow a relay expects from its subscribers.
+type RelayDialect int
+
+const (
+	// RelayMastodon relays, such as those compatible with Mastodon, are
+	// followed with the Public collection as the object.
+	RelayMastodon RelayDialect = iota
+	// RelayLitePub relays, such as those of Pleroma, are followed with the
+	// relay's actor as the object.
+	RelayLitePub
+)
+
+// NewRelayFollow returns the Follow subscribing the actor, usually the instance
+// actor, to the relay's actor.
+//
+// Send it with the FederatingActor's Send. Once the relay Accepts it, the
+// relay is in the actor's 'following' collection, and the activities it
+// Announces are ingested if the FederatingProtocol is a RelaySubscriber.
+func NewRelayFollow(actorIRI, relayIRI *url.URL, dialect RelayDialect) vocab.ActivityStreamsFollow {
+	follow := streams.NewActivityStreamsFollow()
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(actorIRI)
+	follow.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	if dialect == RelayLitePub {
+		op.AppendIRI(relayIRI)
+	} else {
+		// The constant always parses.
+		public, _ := url.Parse(PublicActivityPubIRI)
+		op.AppendIRI(public)
+	}
+	follow.SetActivityStreamsObject(op)
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(relayIRI)
+	follow.SetActivityStreamsTo(to)
+	return follow
+}
@@ -0,0 +1,237 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	// testRelayedActivity is a federated activity with an unknown property
+	// and a Linked Data Signature, with unusual formatting.
+	testRelayedActivity = `{"@context":["https://www.w3.org/ns/activitystreams","https://w3id.org/security/v1"],
+  "id": "https://other.example.com/activity/1",
+  "type": "Announce",
+  "actor": {"id": "https://other.example.com/dakota", "type": "Person"},
+  "object": "https://other.example.com/note/1",
+  "quirk":   1.50,
+  "signature": {"type": "RsaSignature2017", "signatureValue": "abc="}
+}`
+)
+
+// toPostRelayRequest creates a new POST HTTP request with the given body.
+func toPostRelayRequest(s string) *http.Request {
+	return toAPRequest(httptest.NewRequest("POST", testMyInboxIRI, bytes.NewBufferString(s)))
+}
+
+// TestParseRelayedActivity tests the shallow parsing of relayed documents.
+func TestParseRelayedActivity(t *testing.T) {
+	t.Run("ParsesEmbeddedActor", func(t *testing.T) {
+		a, err := parseRelayedActivity([]byte(testRelayedActivity))
+		assertEqual(t, err, nil)
+		assertEqual(t, a.Id.String(), testFederatedActivityIRI)
+		assertEqual(t, len(a.Types), 1)
+		assertEqual(t, a.Types[0], "Announce")
+		assertEqual(t, len(a.Actors), 1)
+		assertEqual(t, a.Actors[0].String(), testFederatedActorIRI)
+		assertByteEqual(t, a.Raw, []byte(testRelayedActivity))
+	})
+	t.Run("ParsesMultipleTypesAndActors", func(t *testing.T) {
+		a, err := parseRelayedActivity([]byte(`{
+  "id": "https://other.example.com/activity/1",
+  "type": ["Create", "https://example.com/ns#Extension"],
+  "actor": ["https://other.example.com/dakota", {"id": "https://other.example.com/addison"}]
+}`))
+		assertEqual(t, err, nil)
+		assertEqual(t, len(a.Types), 2)
+		assertEqual(t, a.Types[1], "https://example.com/ns#Extension")
+		assertEqual(t, len(a.Actors), 2)
+		assertEqual(t, a.Actors[0].String(), testFederatedActorIRI)
+		assertEqual(t, a.Actors[1].String(), testFederatedActorIRI2)
+	})
+	t.Run("ErrorsWithoutId", func(t *testing.T) {
+		_, err := parseRelayedActivity([]byte(`{"type": "Create", "actor": "https://other.example.com/dakota"}`))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorsWithRelativeId", func(t *testing.T) {
+		_, err := parseRelayedActivity([]byte(`{"id": "/activity/1", "type": "Create", "actor": "https://other.example.com/dakota"}`))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorsWithoutType", func(t *testing.T) {
+		_, err := parseRelayedActivity([]byte(`{"id": "https://other.example.com/activity/1", "actor": "https://other.example.com/dakota"}`))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorsWithoutActor", func(t *testing.T) {
+		_, err := parseRelayedActivity([]byte(`{"id": "https://other.example.com/activity/1", "type": "Create"}`))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorsWithoutObject", func(t *testing.T) {
+		_, err := parseRelayedActivity([]byte(`["https://other.example.com/activity/1"]`))
+		assertNotEqual(t, err, nil)
+	})
+}
+
+// TestRelayHandler tests the relay's inbox handler.
+func TestRelayHandler(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (relay *MockRelayProtocol, tp *MockTransport, hf HandlerFunc) {
+		relay = NewMockRelayProtocol(ctl)
+		tp = NewMockTransport(ctl)
+		hf = NewRelayHandler(relay)
+		return
+	}
+	t.Run("IgnoresIfNotActivityPubPostRequest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", testMyInboxIRI, bytes.NewBufferString(testRelayedActivity))
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(resp.Result().Header), 0)
+	})
+	t.Run("DoesNotRelayIfNotAuthenticated", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		relay, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toPostRelayRequest(testRelayedActivity)
+		// Mock
+		relay.EXPECT().AuthenticatePostInbox(ctx, resp, req).DoAndReturn(func(ctx context.Context, resp http.ResponseWriter, req *http.Request) (context.Context, bool, error) {
+			resp.WriteHeader(http.StatusForbidden)
+			return ctx, false, nil
+		})
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("RespondsBadRequestIfShallowParseFails", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		relay, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toPostRelayRequest(`{"type": "Create"}`)
+		// Mock
+		relay.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("RespondsForbiddenIfBlocked", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		relay, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toPostRelayRequest(testRelayedActivity)
+		// Mock
+		relay.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		relay.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(true, nil)
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("ReturnsErrorIfRouteFails", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		relay, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toPostRelayRequest(testRelayedActivity)
+		// Mock
+		relay.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		relay.EXPECT().Blocked(ctx, gomock.Any()).Return(false, nil)
+		relay.EXPECT().Route(ctx, mustParse(testMyInboxIRI), gomock.Any()).Return(nil, testErr)
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, testErr)
+	})
+	t.Run("AcceptsWithoutDeliveringIfNoRecipients", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		relay, _, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toPostRelayRequest(testRelayedActivity)
+		// Mock
+		relay.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		relay.EXPECT().Blocked(ctx, gomock.Any()).Return(false, nil)
+		relay.EXPECT().Route(ctx, mustParse(testMyInboxIRI), gomock.Any()).Return([]*url.URL{mustParse(testMyInboxIRI)}, nil)
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+	t.Run("DeliversOriginalBytes", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		relay, tp, hf := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toPostRelayRequest(testRelayedActivity)
+		recipients := []*url.URL{
+			mustParse(testFederatedInboxIRI),
+			mustParse(testFederatedInboxIRI2),
+			mustParse(testFederatedInboxIRI),
+		}
+		// Mock
+		relay.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		relay.EXPECT().Blocked(ctx, gomock.Any()).Return(false, nil)
+		relay.EXPECT().Route(ctx, mustParse(testMyInboxIRI), gomock.Any()).DoAndReturn(func(c context.Context, inbox *url.URL, a *RelayedActivity) ([]*url.URL, error) {
+			assertEqual(t, a.Id.String(), testFederatedActivityIRI)
+			return recipients, nil
+		})
+		relay.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().BatchDeliver(ctx, []byte(testRelayedActivity), []*url.URL{
+			mustParse(testFederatedInboxIRI),
+			mustParse(testFederatedInboxIRI2),
+		})
+		// Run & Verify
+		isAPReq, err := hf(ctx, resp, req)
+		assertEqual(t, isAPReq, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+}
+
+// TestNewRelayFollow ensures the Follow of a relay has the object its dialect
+// expects.
+func TestNewRelayFollow(t *testing.T) {
+	actorIRI := mustParse("https://example.com/actor")
+	relayIRI := mustParse("https://relay.example.com/actor")
+	tests := []struct {
+		name    string
+		dialect RelayDialect
+		object  string
+	}{
+		{"Mastodon", RelayMastodon, PublicActivityPubIRI},
+		{"LitePub", RelayLitePub, relayIRI.String()},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := NewRelayFollow(actorIRI, relayIRI, test.dialect)
+			assertEqual(t, f.GetActivityStreamsActor().At(0).GetIRI().String(), actorIRI.String())
+			assertEqual(t, f.GetActivityStreamsObject().Len(), 1)
+			assertEqual(t, f.GetActivityStreamsObject().At(0).GetIRI().String(), test.object)
+			assertEqual(t, f.GetActivityStreamsTo().At(0).GetIRI().String(), relayIRI.String())
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+var _ SharedInboxPolicy = &SharedInboxStrategy{}
+
+// SharedInboxStrategy is a SharedInboxPolicy that a FederatingProtocol may
+// embed, so that deliveries to the followers of an actor are collapsed into one
+// delivery per sharedInbox.
+//
+// Activities addressed to the Public collection or to the followers of the
+// actor with the outbox are delivered to the sharedInbox of each recipient
+// that has one, and to the personal inbox of the others. Activities only
+// addressed to the actors they mention, or having 'bto' or 'bcc' recipients
+// that a sharedInbox could not distinguish, are delivered to personal inboxes.
+//
+// The sharedInbox of each actor is cached, so that it is not looked up for
+// every delivery. It is safe for concurrent use.
+type SharedInboxStrategy struct {
+	db Database
+	// cache maps the ids of actors to their sharedInbox, or to an empty
+	// value for actors without one.
+	cache *MemoryDereferenceCache
+}
+
+// NewSharedInboxStrategy returns a SharedInboxStrategy looking up the followers
+// collections of local actors in the Database.
+//
+// The sharedInbox of an actor is cached for the ttl, as measured by the clock,
+// and at most maxEntries actors are cached. Zero or negative values do not
+// limit them.
+func NewSharedInboxStrategy(db Database, ttl time.Duration, maxEntries int, clock Clock) *SharedInboxStrategy {
+	return &SharedInboxStrategy{
+		db:    db,
+		cache: NewMemoryDereferenceCache(ttl, maxEntries, clock),
+	}
+}
+
+// DeliverToSharedInboxes returns true if the activity is addressed to the
+// Public collection or to the followers of the actor with the outbox, and has
+// no hidden recipients.
+//
+// Errors looking up the followers are logged, and the activity is delivered to
+// personal inboxes.
+func (s *SharedInboxStrategy) DeliverToSharedInboxes(c context.Context, outboxIRI *url.URL, activity Activity) bool {
+	if bto := activity.GetActivityStreamsBto(); bto != nil && bto.Len() > 0 {
+		return false
+	} else if bcc := activity.GetActivityStreamsBcc(); bcc != nil && bcc.Len() > 0 {
+		return false
+	}
+	followers, err := s.followers(c, outboxIRI)
+	if err != nil {
+		logEvent(c, LogWarn, "failed to look up followers, delivering to personal inboxes", "outbox", outboxIRI.String(), "error", err)
+		return false
+	}
+	v, err := GetVisibility(activity, followers)
+	if err != nil {
+		logEvent(c, LogWarn, "failed to classify visibility, delivering to personal inboxes", "outbox", outboxIRI.String(), "error", err)
+		return false
+	}
+	return v != VisibilityDirect
+}
+
+// followers returns the followers collection of the actor with the outbox, or
+// nil if it has none.
+func (s *SharedInboxStrategy) followers(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	if err := s.db.Lock(c, outboxIRI); err != nil {
+		return nil, err
+	}
+	// WARNING: Unlock not deferred.
+	actorIRI, err := s.db.ActorForOutbox(c, outboxIRI)
+	s.db.Unlock(c, outboxIRI)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.Lock(c, actorIRI); err != nil {
+		return nil, err
+	}
+	// WARNING: Unlock not deferred.
+	actor, err := s.db.Get(c, actorIRI)
+	s.db.Unlock(c, actorIRI)
+	if err != nil {
+		return nil, err
+	}
+	return followersIRI(actor), nil
+}
+
+// SharedInbox returns the sharedInbox endpoint of the actor, or nil if it has
+// none, caching the result.
+func (s *SharedInboxStrategy) SharedInbox(c context.Context, actor vocab.Type) (sharedInbox *url.URL, err error) {
+	id, err := GetId(actor)
+	if err != nil {
+		return
+	}
+	if b, ok := s.cache.Get(c, id); ok {
+		if len(b) == 0 {
+			return nil, nil
+		}
+		return url.Parse(string(b))
+	}
+	if u, ok := actor.(unknownPropertieser); ok {
+		sharedInbox = streams.GetSharedInbox(u)
+	}
+	var b []byte
+	if sharedInbox != nil {
+		b = []byte(sharedInbox.String())
+	}
+	s.cache.Set(c, id, b)
+	return
+}
+
+// Invalidate forgets the cached sharedInbox of the actor, such as when the
+// application receives an Update of the actor.
+func (s *SharedInboxStrategy) Invalidate(c context.Context, actorIRI *url.URL) {
+	s.cache.Invalidate(c, actorIRI)
+}
@@ -0,0 +1,124 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+)
+
+func TestSharedInboxStrategy(t *testing.T) {
+	ctx := context.Background()
+	followers := mustParse(testPersonIRI + "/followers")
+	newActorFn := func() *url.URL {
+		setupData()
+		f := streams.NewActivityStreamsFollowersProperty()
+		f.SetIRI(followers)
+		testMyPerson.SetActivityStreamsFollowers(f)
+		return mustParse(testPersonIRI)
+	}
+	newNoteFn := func(to *url.URL) Activity {
+		setupData()
+		create := streams.NewActivityStreamsCreate()
+		p := streams.NewActivityStreamsToProperty()
+		p.AppendIRI(to)
+		create.SetActivityStreamsTo(p)
+		return create
+	}
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, cl *MockClock, s *SharedInboxStrategy) {
+		db = NewMockDatabase(ctl)
+		cl = NewMockClock(ctl)
+		s = NewSharedInboxStrategy(db, time.Hour, 10, cl)
+		return
+	}
+	expectActorFn := func(db *MockDatabase, actorIRI *url.URL) {
+		db.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(actorIRI, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		db.EXPECT().Lock(ctx, actorIRI)
+		db.EXPECT().Get(ctx, actorIRI).Return(testMyPerson, nil)
+		db.EXPECT().Unlock(ctx, actorIRI)
+	}
+	t.Run("SharesFollowersDeliveries", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, s := setupFn(ctl)
+		actorIRI := newActorFn()
+		// Mock
+		expectActorFn(db, actorIRI)
+		// Run & Verify
+		assertEqual(t, s.DeliverToSharedInboxes(ctx, mustParse(testMyOutboxIRI), newNoteFn(followers)), true)
+	})
+	t.Run("SharesPublicDeliveries", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, s := setupFn(ctl)
+		actorIRI := newActorFn()
+		// Mock
+		expectActorFn(db, actorIRI)
+		// Run & Verify
+		assertEqual(t, s.DeliverToSharedInboxes(ctx, mustParse(testMyOutboxIRI), newNoteFn(mustParse(PublicActivityPubIRI))), true)
+	})
+	t.Run("DoesNotShareDirectDeliveries", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, s := setupFn(ctl)
+		actorIRI := newActorFn()
+		// Mock
+		expectActorFn(db, actorIRI)
+		// Run & Verify
+		assertEqual(t, s.DeliverToSharedInboxes(ctx, mustParse(testMyOutboxIRI), newNoteFn(mustParse(testFederatedActorIRI))), false)
+	})
+	t.Run("DoesNotShareHiddenRecipients", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, s := setupFn(ctl)
+		activity := newNoteFn(followers)
+		bcc := streams.NewActivityStreamsBccProperty()
+		bcc.AppendIRI(mustParse(testFederatedActorIRI))
+		activity.SetActivityStreamsBcc(bcc)
+		// Run & Verify
+		assertEqual(t, s.DeliverToSharedInboxes(ctx, mustParse(testMyOutboxIRI), activity), false)
+	})
+	t.Run("DoesNotShareIfDatabaseFails", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, s := setupFn(ctl)
+		// Mock
+		db.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(nil, testErr)
+		db.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		// Run & Verify
+		assertEqual(t, s.DeliverToSharedInboxes(ctx, mustParse(testMyOutboxIRI), newNoteFn(followers)), false)
+	})
+	t.Run("CachesSharedInbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, cl, s := setupFn(ctl)
+		setupData()
+		streams.SetSharedInbox(testFederatedPerson1, mustParse(testFederatedSharedInbox))
+		// Mock
+		cl.EXPECT().Now().Return(now()).Times(3)
+		// Run & Verify
+		u, err := s.SharedInbox(ctx, testFederatedPerson1)
+		assertEqual(t, err, nil)
+		assertEqual(t, u.String(), testFederatedSharedInbox)
+		streams.SetSharedInbox(testFederatedPerson1, nil)
+		u, err = s.SharedInbox(ctx, testFederatedPerson1)
+		assertEqual(t, err, nil)
+		assertEqual(t, u.String(), testFederatedSharedInbox)
+		s.Invalidate(ctx, mustParse(testFederatedActorIRI))
+		u, err = s.SharedInbox(ctx, testFederatedPerson1)
+		assertEqual(t, err, nil)
+		assertEqual(t, u == nil, true)
+	})
+}
@@ -3,11 +3,13 @@ package pub
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/streams/vocab"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // sideEffectActor must satisfy the DelegateActor interface.
@@ -29,7 +31,20 @@ type sideEffectActor struct {
 }
 
 // PostInboxRequestBodyHook defers to the delegate.
+//
+// If the Database is a FollowersSynchronizer, the Collection-Synchronization
+// header of the request is first added to the context for PostInbox.
 func (a *sideEffectActor) PostInboxRequestBodyHook(c context.Context, r *http.Request, activity Activity) (context.Context, error) {
+	if _, ok := a.db.(FollowersSynchronizer); ok {
+		if v := r.Header.Get(collectionSynchronizationHeader); len(v) > 0 {
+			s, err := ParseCollectionSynchronization(v)
+			if err != nil {
+				logEvent(c, LogWarn, "ignored Collection-Synchronization header", "error", err)
+			} else {
+				c = context.WithValue(c, collectionSynchronizationKey{}, s)
+			}
+		}
+	}
 	return a.s2s.PostInboxRequestBodyHook(c, r, activity)
 }
 
@@ -69,7 +84,9 @@ func (a *sideEffectActor) GetInbox(c context.Context, r *http.Request) (vocab.Ac
 }
 
 // AuthorizePostInbox defers to the federating protocol whether the peer request
-// is authorized based on the actors' ids.
+// is authorized based on the actors' ids, and whether the activity is handled
+// if the federating protocol is an InboxFilter. If the context carries the
+// actor that signed the request, it must match the activity's actor.
 func (a *sideEffectActor) AuthorizePostInbox(c context.Context, w http.ResponseWriter, activity Activity) (authorized bool, err error) {
 	authorized = false
 	actor := activity.GetActivityStreamsActor()
@@ -83,33 +100,208 @@ func (a *sideEffectActor) AuthorizePostInbox(c context.Context, w http.ResponseW
 		if iter.IsIRI() {
 			iris = append(iris, iter.GetIRI())
 		} else if t := iter.GetType(); t != nil {
-			iris = append(iris, activity.GetJSONLDId().Get())
+			var id *url.URL
+			if id, err = GetId(t); err != nil {
+				err = fmt.Errorf("actor at index %d is missing an id", i)
+				return
+			}
+			iris = append(iris, id)
 		} else {
 			err = fmt.Errorf("actor at index %d is missing an id", i)
 			return
 		}
 	}
+	// Ensure the actor whose key signed the request may send the activity.
+	if signer, ok := SignerFromContext(c); ok {
+		var matches bool
+		if matches, err = a.signerMatches(c, signer, activity); err != nil {
+			return
+		} else if !matches {
+			logEvent(c, LogWarn, "rejected activity signed by another actor", "type", activity.GetTypeName(), "actors", iris, "signer", signer.String())
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
 	// Determine if the actor(s) sending this request are blocked.
 	var blocked bool
 	if blocked, err = a.s2s.Blocked(c, iris); err != nil {
 		return
 	} else if blocked {
+		logEvent(c, LogInfo, "dropped activity from blocked actors", "type", activity.GetTypeName(), "actors", iris)
 		w.WriteHeader(http.StatusForbidden)
 		return
 	}
+	// Apply the federation policy of the peer(s) sending this request.
+	policy := PolicyAllow
+	for _, iri := range iris {
+		var p Policy
+		if p, err = a.federationPolicy(c, iri); err != nil {
+			return
+		} else if p > policy {
+			policy = p
+		}
+	}
+	switch policy {
+	case PolicyBlock:
+		logEvent(c, LogInfo, "dropped activity from blocked peer", "type", activity.GetTypeName(), "actors", iris)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	case PolicySilence:
+		logEvent(c, LogInfo, "dropped activity from silenced peer", "type", activity.GetTypeName(), "actors", iris)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	// Let the application filter the activity before its side effects.
+	if f, ok := a.s2s.(InboxFilter); ok {
+		var handle bool
+		var code int
+		if handle, code, err = f.FilterInbox(c, iris, activity); err != nil {
+			return
+		} else if !handle {
+			if code < 100 || code > 599 {
+				code = http.StatusForbidden
+			}
+			logEvent(c, LogInfo, "dropped activity rejected by the inbox filter", "type", activity.GetTypeName(), "actors", iris, "code", code)
+			w.WriteHeader(code)
+			return
+		}
+	}
 	authorized = true
 	return
 }
 
+// federationPolicy returns the Policy of the remote peer, which is PolicyAllow
+// unless the FederatingProtocol is a PeerPolicy.
+func (a *sideEffectActor) federationPolicy(c context.Context, remote *url.URL) (Policy, error) {
+	if p, ok := a.s2s.(PeerPolicy); ok {
+		return p.FederationPolicy(c, remote)
+	}
+	return PolicyAllow, nil
+}
+
+// signerMatches returns true if the actor whose key signed the request matches
+// an actor of the activity as closely as the SignerMatchPolicy requires.
+func (a *sideEffectActor) signerMatches(c context.Context, signer *url.URL, activity Activity) (matches bool, err error) {
+	match := SignerMatchActor
+	if p, ok := a.s2s.(SignerMatchPolicy); ok {
+		if match, err = p.SignerMatch(c, signer, activity); err != nil {
+			return
+		}
+	}
+	if match == SignerMatchNone {
+		return true, nil
+	}
+	actors := activity.GetActivityStreamsActor()
+	for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+		var id *url.URL
+		if id, err = ToId(iter); err != nil {
+			return
+		}
+		switch match {
+		case SignerMatchActor:
+			matches = id.String() == signer.String()
+		case SignerMatchOrigin:
+			matches = id.Host == signer.Host
+		default:
+			err = fmt.Errorf("unknown SignerMatch: %d", match)
+			return
+		}
+		if matches {
+			return
+		}
+	}
+	return
+}
+
 // PostInbox handles the side effects of determining whether to block the peer's
 // request, adding the activity to the actor's inbox, and triggering side
 // effects based on the activity's type.
+//
+// If the Database is a TransactionalDatabase, this happens within a
+// transaction. If the federating protocol is a Metrics, the activity is
+// counted.
 func (a *sideEffectActor) PostInbox(c context.Context, inboxIRI *url.URL, activity Activity) error {
+	if m, ok := a.s2s.(Metrics); ok {
+		m.InboxActivity(c, activity.GetTypeName())
+	}
+	err := a.transaction(c, func(c context.Context) error {
+		return a.postInbox(c, inboxIRI, activity)
+	})
+	if err != nil {
+		return err
+	}
+	if s, ok := receivedCollectionSynchronization(c); ok {
+		if err := a.synchronizeFollowers(c, inboxIRI, activity, s); err != nil {
+			logEvent(c, LogWarn, "could not synchronize followers", "collectionId", s.CollectionId.String(), "error", err)
+		}
+	}
+	return nil
+}
+
+// synchronizeFollowers reconciles the local actors following the sender of the
+// activity with its followers collection, if the digest of the received
+// Collection-Synchronization header does not match them.
+func (a *sideEffectActor) synchronizeFollowers(c context.Context, inboxIRI *url.URL, activity Activity, s CollectionSynchronization) error {
+	fs := a.db.(FollowersSynchronizer)
+	// The collection and its URL must be on the server of the sender.
+	if s.URL.Host != s.CollectionId.Host {
+		return fmt.Errorf("Collection-Synchronization url %s is not on the host of %s", s.URL, s.CollectionId)
+	}
+	sent := false
+	if actors := activity.GetActivityStreamsActor(); actors != nil {
+		for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && id.Host == s.CollectionId.Host {
+				sent = true
+			}
+		}
+	}
+	if !sent {
+		return fmt.Errorf("Collection-Synchronization collectionId %s is not on the host of the activity's actor", s.CollectionId)
+	}
+	local, err := fs.LocalFollowers(c, s.CollectionId)
+	if err != nil {
+		return err
+	}
+	if PartialFollowersDigest(local, inboxIRI.Host) == s.Digest {
+		return nil
+	}
+	t, err := a.common.NewTransport(c, inboxIRI, goFedUserAgent())
+	if err != nil {
+		return err
+	}
+	col, err := dereferenceType(c, t, s.URL)
+	if err != nil {
+		return err
+	}
+	remote, err := collectionItemIRIs(col)
+	if err != nil {
+		return err
+	}
+	stale, unknown := diffIRIs(PartialFollowers(local, inboxIRI.Host), PartialFollowers(remote, inboxIRI.Host))
+	if len(stale) == 0 && len(unknown) == 0 {
+		return nil
+	}
+	logEvent(c, LogInfo, "reconciling followers", "collectionId", s.CollectionId.String(), "stale", stale, "unknown", unknown)
+	return fs.ReconcileFollowers(c, s.CollectionId, stale, unknown)
+}
+
+// postInbox adds the activity to the actor's inbox and triggers its side
+// effects, within the transaction of PostInbox.
+func (a *sideEffectActor) postInbox(c context.Context, inboxIRI *url.URL, activity Activity) error {
 	isNew, err := a.addToInboxIfNew(c, inboxIRI, activity)
 	if err != nil {
 		return err
 	}
 	if isNew {
+		if rs, ok := a.s2s.(RelaySubscriber); ok {
+			if announce, ok := activity.(vocab.ActivityStreamsAnnounce); ok {
+				if relayed, err := a.ingestRelayed(c, rs, inboxIRI, announce); err != nil {
+					return err
+				} else if relayed {
+					return nil
+				}
+			}
+		}
 		wrapped, other, err := a.s2s.FederatingCallbacks(c)
 		if err != nil {
 			return err
@@ -117,6 +309,7 @@ func (a *sideEffectActor) PostInbox(c context.Context, inboxIRI *url.URL, activi
 		// Populate side channels.
 		wrapped.db = a.db
 		wrapped.inboxIRI = inboxIRI
+		wrapped.clock = a.clock
 		wrapped.newTransport = a.common.NewTransport
 		wrapped.deliver = a.Deliver
 		wrapped.addNewIds = a.AddNewIDs
@@ -136,6 +329,109 @@ func (a *sideEffectActor) PostInbox(c context.Context, inboxIRI *url.URL, activi
 	return nil
 }
 
+// ingestRelayed unwraps the Announce if its actor is a relay the server is
+// subscribed to, fetching each announced object from its origin and giving the
+// ones that are trusted to the RelaySubscriber. Returns false if the Announce
+// is not from a relay, to be handled as a share instead.
+func (a *sideEffectActor) ingestRelayed(c context.Context, rs RelaySubscriber, inboxIRI *url.URL, announce vocab.ActivityStreamsAnnounce) (relayed bool, err error) {
+	actors := announce.GetActivityStreamsActor()
+	if actors == nil || actors.Len() != 1 {
+		return
+	}
+	var relayIRI *url.URL
+	if relayIRI, err = ToId(actors.At(0)); err != nil {
+		return
+	}
+	if relayed, err = rs.IsRelay(c, relayIRI); err != nil || !relayed {
+		return
+	}
+	op := announce.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		err = ErrObjectRequired
+		return
+	}
+	t, err := a.common.NewTransport(c, inboxIRI, goFedUserAgent())
+	if err != nil {
+		return
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		var id *url.URL
+		if id, err = ToId(iter); err != nil {
+			return
+		}
+		var obj vocab.Type
+		if obj, err = a.fetchRelayed(c, t, id); err != nil {
+			logEvent(c, LogInfo, "dropped relayed object", "relay", relayIRI.String(), "id", id.String(), "error", err)
+			err = nil
+			continue
+		} else if obj == nil {
+			continue
+		}
+		if err = rs.Relayed(c, relayIRI, obj); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// fetchRelayed fetches an object announced by a relay from its origin, and
+// stores it if it is not already in the database. Returns an error if the
+// object is not trusted, or a nil object if it is owned by this server.
+func (a *sideEffectActor) fetchRelayed(c context.Context, t Transport, id *url.URL) (obj vocab.Type, err error) {
+	if err = a.db.Lock(c, id); err != nil {
+		return
+	}
+	owns, err := a.db.Owns(c, id)
+	a.db.Unlock(c, id)
+	if err != nil || owns {
+		return
+	}
+	if p, err := a.federationPolicy(c, id); err != nil {
+		return nil, err
+	} else if p == PolicyBlock {
+		return nil, fmt.Errorf("origin of %s is blocked", id)
+	}
+	if obj, err = dereferenceType(c, t, id); err != nil {
+		return
+	}
+	fetchedId, err := GetId(obj)
+	if err != nil {
+		return nil, err
+	} else if fetchedId.String() != id.String() {
+		return nil, fmt.Errorf("fetched %s has a different id %s", id, fetchedId)
+	}
+	authors, err := authorIRIs(obj)
+	if err != nil {
+		return nil, err
+	}
+	if len(authors) == 0 {
+		return nil, fmt.Errorf("fetched %s has no actor or attributedTo", id)
+	}
+	for _, author := range authors {
+		if author.Host != id.Host {
+			return nil, fmt.Errorf("author %s of %s is on another host", author, id)
+		}
+	}
+	if blocked, err := a.s2s.Blocked(c, authors); err != nil {
+		return nil, err
+	} else if blocked {
+		return nil, fmt.Errorf("authors of %s are blocked", id)
+	}
+	if err = a.db.Lock(c, id); err != nil {
+		return nil, err
+	}
+	defer a.db.Unlock(c, id)
+	exists, err := a.db.Exists(c, id)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		if err = a.db.Create(c, obj); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
 // InboxForwarding implements the 3-part inbox forwarding algorithm specified in
 // the ActivityPub specification. Does not modify the Activity, but may send
 // outbound requests as a side effect.
@@ -273,7 +569,8 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 	//    by this server. This is only a boolean trigger: As soon as we get
 	//    a hit that we own something, then we should do inbox forwarding.
 	maxDepth := a.s2s.MaxInboxForwardingRecursionDepth(c)
-	ownsValue, err := a.hasInboxForwardingValues(c, inboxIRI, activity, maxDepth, 0)
+	maxRequests, timeout := a.recursiveDereferenceLimits(c)
+	ownsValue, err := a.hasInboxForwardingValues(c, inboxIRI, activity, maxDepth, 0, newDereferenceLimit(a.clock, maxRequests, timeout))
 	if err != nil {
 		return err
 	}
@@ -313,7 +610,37 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 			}
 		}
 	}
-	return a.deliverToRecipients(c, inboxIRI, activity, recipients)
+	// Do not forward the activity back to the actors that sent it.
+	var senders []*url.URL
+	if actors := activity.GetActivityStreamsActor(); actors != nil {
+		for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+			id, err := ToId(iter)
+			if err != nil {
+				return err
+			}
+			senders = append(senders, id)
+		}
+	}
+	recipients = dedupeIRIs(recipients, senders)
+	// Resolve the members of the collections to their inboxes, in the same
+	// manner as a delivery from an outbox.
+	tp, err := a.common.NewTransport(c, inboxIRI, goFedUserAgent())
+	if err != nil {
+		return err
+	}
+	receiverActors, err := a.resolveInboxes(c, tp, recipients, 0, a.s2s.MaxDeliveryRecursionDepth(c), newDereferenceLimit(a.clock, maxRequests, timeout))
+	if err != nil {
+		return err
+	}
+	receiverActors, err = a.filterBlockedActors(c, receiverActors)
+	if err != nil {
+		return err
+	}
+	targets, err := getInboxes(receiverActors)
+	if err != nil {
+		return err
+	}
+	return a.deliverToRecipients(c, inboxIRI, activity, dedupeIRIs(targets, nil))
 }
 
 // PostOutbox handles the side effects of adding the activity to the actor's
@@ -321,7 +648,20 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 //
 // This implementation assumes all types are meant to be delivered except for
 // the ActivityStreams Block type.
+//
+// If the Database is a TransactionalDatabase, this happens within a
+// transaction.
 func (a *sideEffectActor) PostOutbox(c context.Context, activity Activity, outboxIRI *url.URL, rawJSON map[string]interface{}) (deliverable bool, err error) {
+	err = a.transaction(c, func(c context.Context) (err error) {
+		deliverable, err = a.postOutbox(c, activity, outboxIRI, rawJSON)
+		return
+	})
+	return
+}
+
+// postOutbox triggers the side effects of the activity and adds it to the
+// actor's outbox, within the transaction of PostOutbox.
+func (a *sideEffectActor) postOutbox(c context.Context, activity Activity, outboxIRI *url.URL, rawJSON map[string]interface{}) (deliverable bool, err error) {
 	// TODO: Determine this if c2s is nil
 	deliverable = true
 	if a.c2s != nil {
@@ -398,14 +738,85 @@ func (a *sideEffectActor) AddNewIDs(c context.Context, activity Activity) error
 // another server.
 //
 // Must be called if at least the federated protocol is supported.
+//
+// If the Database is a FollowersSynchronizer and the activity is addressed to
+// the actor's followers, the deliveries carry the Collection-Synchronization
+// header.
 func (a *sideEffectActor) Deliver(c context.Context, outboxIRI *url.URL, activity Activity) error {
 	recipients, err := a.prepare(c, outboxIRI, activity)
 	if err != nil {
 		return err
 	}
+	if fs, ok := a.db.(FollowersSynchronizer); ok {
+		var f *followersSynchronization
+		f, err = a.followersSynchronization(c, fs, outboxIRI, activity)
+		if err != nil {
+			return err
+		} else if f != nil {
+			c = withFollowersSynchronization(c, f)
+		}
+	}
 	return a.deliverToRecipients(c, outboxIRI, activity, recipients)
 }
 
+// followersSynchronization returns the followers of the actor with the outbox
+// if the activity is addressed to them, or nil otherwise.
+func (a *sideEffectActor) followersSynchronization(c context.Context, fs FollowersSynchronizer, outboxIRI *url.URL, activity Activity) (*followersSynchronization, error) {
+	if err := a.db.Lock(c, outboxIRI); err != nil {
+		return nil, err
+	}
+	// WARNING: Unlock not deferred.
+	actorIRI, err := a.db.ActorForOutbox(c, outboxIRI)
+	a.db.Unlock(c, outboxIRI)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.db.Lock(c, actorIRI); err != nil {
+		return nil, err
+	}
+	// WARNING: Unlock not deferred.
+	followers, err := a.db.Followers(c, actorIRI)
+	a.db.Unlock(c, actorIRI)
+	if err != nil {
+		return nil, err
+	}
+	collectionId, err := GetId(followers)
+	if err != nil {
+		return nil, err
+	}
+	addressed := false
+	if to := activity.GetActivityStreamsTo(); to != nil {
+		for iter := to.Begin(); iter != to.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && id.String() == collectionId.String() {
+				addressed = true
+			}
+		}
+	}
+	if cc := activity.GetActivityStreamsCc(); cc != nil {
+		for iter := cc.Begin(); iter != cc.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && id.String() == collectionId.String() {
+				addressed = true
+			}
+		}
+	}
+	if !addressed {
+		return nil, nil
+	}
+	items, err := collectionItemIRIs(followers)
+	if err != nil {
+		return nil, err
+	}
+	u, err := fs.PartialFollowersURL(c, actorIRI)
+	if err != nil {
+		return nil, err
+	}
+	return &followersSynchronization{
+		collectionId: collectionId,
+		url:          u,
+		followers:    items,
+	}, nil
+}
+
 // WrapInCreate wraps an object with a Create activity.
 func (a *sideEffectActor) WrapInCreate(c context.Context, obj vocab.Type, outboxIRI *url.URL) (create vocab.ActivityStreamsCreate, err error) {
 	err = a.db.Lock(c, outboxIRI)
@@ -423,9 +834,37 @@ func (a *sideEffectActor) WrapInCreate(c context.Context, obj vocab.Type, outbox
 	return wrapInCreate(c, obj, actorIRI)
 }
 
+// transaction calls fn within a new transaction if the Database is a
+// TransactionalDatabase, committing it if fn succeeds and rolling it back
+// otherwise. Other Databases have fn called with the given context.
+//
+// The error returned by fn is returned unchanged, even if the rollback fails,
+// so that errors such as ErrObjectRequired are still recognized.
+func (a *sideEffectActor) transaction(c context.Context, fn func(c context.Context) error) error {
+	tdb, ok := a.db.(TransactionalDatabase)
+	if !ok {
+		return fn(c)
+	}
+	tx, err := tdb.Begin(c)
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		tdb.Rollback(tx)
+		return err
+	}
+	return tdb.Commit(tx)
+}
+
 // deliverToRecipients will take a prepared Activity and send it to specific
 // recipients on behalf of an actor.
+//
+// If the Database is a DeliveryQueue, the deliveries are enqueued instead.
 func (a *sideEffectActor) deliverToRecipients(c context.Context, boxIRI *url.URL, activity Activity, recipients []*url.URL) error {
+	recipients, err := a.filterBlocked(c, recipients)
+	if err != nil {
+		return err
+	}
 	m, err := streams.Serialize(activity)
 	if err != nil {
 		return err
@@ -434,6 +873,9 @@ func (a *sideEffectActor) deliverToRecipients(c context.Context, boxIRI *url.URL
 	if err != nil {
 		return err
 	}
+	if q, ok := a.db.(DeliveryQueue); ok {
+		return q.Enqueue(c, boxIRI, b, recipients)
+	}
 	tp, err := a.common.NewTransport(c, boxIRI, goFedUserAgent())
 	if err != nil {
 		return err
@@ -441,6 +883,20 @@ func (a *sideEffectActor) deliverToRecipients(c context.Context, boxIRI *url.URL
 	return tp.BatchDeliver(c, b, recipients)
 }
 
+// filterBlocked removes the recipients whose federation policy blocks
+// delivering to them.
+func (a *sideEffectActor) filterBlocked(c context.Context, recipients []*url.URL) (allowed []*url.URL, err error) {
+	for _, r := range recipients {
+		var p Policy
+		if p, err = a.federationPolicy(c, r); err != nil {
+			return
+		} else if p != PolicyBlock {
+			allowed = append(allowed, r)
+		}
+	}
+	return
+}
+
 // addToOutbox adds the activity to the outbox and creates the activity in the
 // internal database as its own entry.
 func (a *sideEffectActor) addToOutbox(c context.Context, outboxIRI *url.URL, activity Activity) error {
@@ -526,8 +982,9 @@ func (a *sideEffectActor) addToInboxIfNew(c context.Context, inboxIRI *url.URL,
 // href and the ones on properties applicable to inbox forwarding.
 //
 // Recursion may be limited by providing a 'maxDepth' greater than zero. A
-// value of zero or a negative number will result in infinite recursion.
-func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *url.URL, val vocab.Type, maxDepth, currDepth int) (bool, error) {
+// value of zero or a negative number will result in infinite recursion. The
+// dereferences made while recurring are bounded by the limit.
+func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *url.URL, val vocab.Type, maxDepth, currDepth int, limit *dereferenceLimit) (bool, error) {
 	// Stop recurring if we are exceeding the maximum depth and the maximum
 	// is a positive number.
 	if maxDepth > 0 && currDepth >= maxDepth {
@@ -581,10 +1038,10 @@ func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *
 		if err != nil {
 			return false, err
 		}
-		b, err := tport.Dereference(c, iri)
+		b, err := limit.dereference(c, tport, iri)
 		if err != nil {
 			// Do not fail the entire process if the data is
-			// missing.
+			// missing or the limit is reached.
 			continue
 		}
 		var m map[string]interface{}
@@ -601,7 +1058,7 @@ func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *
 	}
 	// Recur.
 	for _, nextVal := range types {
-		if has, err := a.hasInboxForwardingValues(c, inboxIRI, nextVal, maxDepth, currDepth+1); err != nil {
+		if has, err := a.hasInboxForwardingValues(c, inboxIRI, nextVal, maxDepth, currDepth+1, limit); err != nil {
 			return false, err
 		} else if has {
 			return true, nil
@@ -680,11 +1137,21 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 	if err != nil {
 		return nil, err
 	}
-	receiverActors, err := a.resolveInboxes(c, t, r, 0, a.s2s.MaxDeliveryRecursionDepth(c))
+	maxRequests, timeout := a.recursiveDereferenceLimits(c)
+	receiverActors, err := a.resolveInboxes(c, t, r, 0, a.s2s.MaxDeliveryRecursionDepth(c), newDereferenceLimit(a.clock, maxRequests, timeout))
 	if err != nil {
 		return nil, err
 	}
-	targets, err := getInboxes(receiverActors)
+	receiverActors, err = a.filterBlockedActors(c, receiverActors)
+	if err != nil {
+		return nil, err
+	}
+	var targets []*url.URL
+	if p, ok := a.s2s.(SharedInboxPolicy); ok && p.DeliverToSharedInboxes(c, outboxIRI, activity) {
+		targets, err = getSharedInboxes(c, p, receiverActors)
+	} else {
+		targets, err = getInboxes(receiverActors)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -723,17 +1190,60 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 	return r, nil
 }
 
+// filterBlockedActors removes the actors that are blocked, so that activities
+// are not delivered to them.
+func (a *sideEffectActor) filterBlockedActors(c context.Context, actors []vocab.Type) (unblocked []vocab.Type, err error) {
+	for _, actor := range actors {
+		var id *url.URL
+		id, err = GetId(actor)
+		if err != nil {
+			return
+		}
+		var blocked bool
+		if blocked, err = a.s2s.Blocked(c, []*url.URL{id}); err != nil {
+			return
+		} else if !blocked {
+			unblocked = append(unblocked, actor)
+		}
+	}
+	return
+}
+
+// getSharedInboxes returns the shared inbox of each actor, falling back to the
+// actor's individual inbox when it has none. Actors sharing the same shared
+// inbox result in duplicate IRIs, which are removed when deduplicating the
+// final recipients.
+func getSharedInboxes(c context.Context, p SharedInboxPolicy, actors []vocab.Type) (u []*url.URL, err error) {
+	for _, actor := range actors {
+		var iri *url.URL
+		iri, err = p.SharedInbox(c, actor)
+		if err != nil {
+			return
+		}
+		if iri == nil {
+			iri, err = getInbox(actor)
+			if err != nil {
+				return
+			}
+		}
+		u = append(u, iri)
+	}
+	return
+}
+
 // resolveInboxes takes a list of Actor id URIs and returns them as concrete
 // instances of actorObject. It attempts to apply recursively when it encounters
 // a target that is a Collection or OrderedCollection.
 //
-// If maxDepth is zero or negative, then recursion is infinitely applied.
+// If maxDepth is zero or negative, then recursion is infinitely applied. The
+// dereferences made are bounded by the limit, and recipients that cannot be
+// dereferenced within it are skipped.
 //
 // If a recipient is a Collection or OrderedCollection, then the server MUST
 // dereference the collection, WITH the user's credentials.
 //
 // Note that this also applies to CollectionPage and OrderedCollectionPage.
-func (a *sideEffectActor) resolveInboxes(c context.Context, t Transport, r []*url.URL, depth, maxDepth int) (actors []vocab.Type, err error) {
+func (a *sideEffectActor) resolveInboxes(c context.Context, t Transport, r []*url.URL, depth, maxDepth int, limit *dereferenceLimit) (actors []vocab.Type, err error) {
 	if maxDepth > 0 && depth >= maxDepth {
 		return
 	}
@@ -742,13 +1252,14 @@ func (a *sideEffectActor) resolveInboxes(c context.Context, t Transport, r []*ur
 		var more []*url.URL
 		// TODO: Determine if more logic is needed here for inaccessible
 		// collections owned by peer servers.
-		act, more, err = a.dereferenceForResolvingInboxes(c, t, u)
+		act, more, err = a.dereferenceForResolvingInboxes(c, t, u, limit)
 		if err != nil {
-			// Missing recipient -- skip.
+			// Missing recipient, or the limit is reached -- skip.
+			err = nil
 			continue
 		}
 		var recurActors []vocab.Type
-		recurActors, err = a.resolveInboxes(c, t, more, depth+1, maxDepth)
+		recurActors, err = a.resolveInboxes(c, t, more, depth+1, maxDepth, limit)
 		if err != nil {
 			return
 		}
@@ -765,9 +1276,9 @@ func (a *sideEffectActor) resolveInboxes(c context.Context, t Transport, r []*ur
 //
 // The returned actor could be nil, if it wasn't an actor (ex: a Collection or
 // OrderedCollection).
-func (a *sideEffectActor) dereferenceForResolvingInboxes(c context.Context, t Transport, actorIRI *url.URL) (actor vocab.Type, moreActorIRIs []*url.URL, err error) {
+func (a *sideEffectActor) dereferenceForResolvingInboxes(c context.Context, t Transport, actorIRI *url.URL, limit *dereferenceLimit) (actor vocab.Type, moreActorIRIs []*url.URL, err error) {
 	var resp []byte
-	resp, err = t.Dereference(c, actorIRI)
+	resp, err = limit.dereference(c, t, actorIRI)
 	if err != nil {
 		return
 	}
@@ -808,3 +1319,72 @@ func (a *sideEffectActor) dereferenceForResolvingInboxes(c context.Context, t Tr
 	}
 	return
 }
+
+// recursiveDereferenceLimits returns the limits of a recursive resolution,
+// which are zero, indicating no limit, unless the FederatingProtocol is a
+// DereferenceLimiter.
+func (a *sideEffectActor) recursiveDereferenceLimits(c context.Context) (maxRequests int, timeout time.Duration) {
+	if l, ok := a.s2s.(DereferenceLimiter); ok {
+		maxRequests, timeout = l.RecursiveDereferenceLimits(c)
+	}
+	return
+}
+
+// errDereferenceLimit indicates an IRI was not dereferenced because the
+// dereferenceLimit was reached, or the IRI was already dereferenced.
+var errDereferenceLimit = errors.New("recursive dereference limit reached")
+
+// dereferenceLimit bounds the dereferences made while recursively resolving
+// values from peers. It is not safe for concurrent use.
+type dereferenceLimit struct {
+	// remaining is the number of dereferences left. Negative values
+	// indicate no limit.
+	remaining int
+	// deadline is when dereferencing stops, as measured by the clock. The
+	// zero value indicates no deadline.
+	deadline time.Time
+	clock    Clock
+	// visited are the IRIs already dereferenced.
+	visited map[string]bool
+}
+
+// newDereferenceLimit returns a dereferenceLimit permitting maxRequests
+// dereferences within the timeout, as measured by the clock. Zero or negative
+// values indicate no limit.
+func newDereferenceLimit(clock Clock, maxRequests int, timeout time.Duration) *dereferenceLimit {
+	d := &dereferenceLimit{
+		remaining: -1,
+		visited:   make(map[string]bool),
+		clock:     clock,
+	}
+	if maxRequests > 0 {
+		d.remaining = maxRequests
+	}
+	if timeout > 0 {
+		d.deadline = clock.Now().Add(timeout)
+	}
+	return d
+}
+
+// dereference fetches the IRI with the Transport if it is within the limit and
+// has not already been dereferenced. Otherwise, errDereferenceLimit is
+// returned.
+func (d *dereferenceLimit) dereference(c context.Context, t Transport, iri *url.URL) ([]byte, error) {
+	if d.visited[iri.String()] || d.remaining == 0 {
+		return nil, errDereferenceLimit
+	}
+	if !d.deadline.IsZero() {
+		left := d.deadline.Sub(d.clock.Now())
+		if left <= 0 {
+			return nil, errDereferenceLimit
+		}
+		var cancel context.CancelFunc
+		c, cancel = context.WithTimeout(c, left)
+		defer cancel()
+	}
+	if d.remaining > 0 {
+		d.remaining--
+	}
+	d.visited[iri.String()] = true
+	return t.Dereference(c, iri)
+}
@@ -3,6 +3,7 @@ package pub
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
@@ -136,6 +137,18 @@ func TestAuthorizePostInbox(t *testing.T) {
 		}
 		return
 	}
+	policySetupFn := func(ctl *gomock.Controller) (fp *MockFederatingProtocol, pp *MockPeerPolicy, a DelegateActor) {
+		setupData()
+		fp = NewMockFederatingProtocol(ctl)
+		pp = NewMockPeerPolicy(ctl)
+		a = &sideEffectActor{
+			s2s: struct {
+				FederatingProtocol
+				PeerPolicy
+			}{fp, pp},
+		}
+		return
+	}
 	// Run tests
 	t.Run("ActorAuthorized", func(t *testing.T) {
 		// Setup
@@ -173,6 +186,25 @@ func TestAuthorizePostInbox(t *testing.T) {
 		assertEqual(t, b, true)
 		assertEqual(t, err, nil)
 	})
+	t.Run("EmbeddedActorAuthorized", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, _, _, a := setupFn(ctl)
+		create := streams.NewActivityStreamsCreate()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		create.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendActivityStreamsPerson(testFederatedPerson1)
+		create.SetActivityStreamsActor(actor)
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(ctx, resp, create)
+		// Verify
+		assertEqual(t, b, true)
+		assertEqual(t, err, nil)
+	})
 	t.Run("OneActorNotAuthorized", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
@@ -185,6 +217,227 @@ func TestAuthorizePostInbox(t *testing.T) {
 		assertEqual(t, b, false)
 		assertEqual(t, err, nil)
 	})
+	t.Run("OneActorSilenced", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, pp, a := policySetupFn(ctl)
+		resp := httptest.NewRecorder()
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)}).Return(false, nil)
+		pp.EXPECT().FederationPolicy(ctx, mustParse(testFederatedActorIRI)).Return(PolicyAllow, nil)
+		pp.EXPECT().FederationPolicy(ctx, mustParse(testFederatedActorIRI2)).Return(PolicySilence, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate2)
+		// Verify
+		assertEqual(t, b, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+	t.Run("OneActorBlockedByPolicy", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, pp, a := policySetupFn(ctl)
+		resp := httptest.NewRecorder()
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)}).Return(false, nil)
+		pp.EXPECT().FederationPolicy(ctx, mustParse(testFederatedActorIRI)).Return(PolicyAllow, nil)
+		pp.EXPECT().FederationPolicy(ctx, mustParse(testFederatedActorIRI2)).Return(PolicyBlock, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate2)
+		// Verify
+		assertEqual(t, b, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("LogsDroppedActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, pp, a := policySetupFn(ctl)
+		l := NewMockLogger(ctl)
+		logCtx := WithLogger(ctx, l)
+		resp := httptest.NewRecorder()
+		actors := []*url.URL{mustParse(testFederatedActorIRI)}
+		fp.EXPECT().Blocked(logCtx, actors).Return(false, nil)
+		pp.EXPECT().FederationPolicy(logCtx, mustParse(testFederatedActorIRI)).Return(PolicySilence, nil)
+		l.EXPECT().Log(logCtx, LogInfo, "dropped activity from silenced peer", "type", "Create", "actors", actors)
+		// Run
+		b, err := a.AuthorizePostInbox(logCtx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+	t.Run("AllowsActivitySignedByItsActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, _, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		signedCtx := WithSigner(ctx, mustParse(testFederatedActorIRI))
+		fp.EXPECT().Blocked(signedCtx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(signedCtx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, true)
+		assertEqual(t, err, nil)
+	})
+	t.Run("RejectsActivitySignedByOtherActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, _, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		signedCtx := WithSigner(ctx, mustParse(testFederatedActorIRI2))
+		// Run
+		b, err := a.AuthorizePostInbox(signedCtx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	signerSetupFn := func(ctl *gomock.Controller) (fp *MockFederatingProtocol, p *MockSignerMatchPolicy, a DelegateActor) {
+		setupData()
+		fp = NewMockFederatingProtocol(ctl)
+		p = NewMockSignerMatchPolicy(ctl)
+		a = &sideEffectActor{
+			s2s: struct {
+				FederatingProtocol
+				SignerMatchPolicy
+			}{fp, p},
+		}
+		return
+	}
+	t.Run("SignerMatchOriginAllowsSignerOnSameHost", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, p, a := signerSetupFn(ctl)
+		resp := httptest.NewRecorder()
+		signedCtx := WithSigner(ctx, mustParse(testFederatedActorIRI2))
+		p.EXPECT().SignerMatch(signedCtx, mustParse(testFederatedActorIRI2), testCreate).Return(SignerMatchOrigin, nil)
+		fp.EXPECT().Blocked(signedCtx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(signedCtx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, true)
+		assertEqual(t, err, nil)
+	})
+	t.Run("SignerMatchOriginRejectsSignerOnOtherHost", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, p, a := signerSetupFn(ctl)
+		resp := httptest.NewRecorder()
+		signedCtx := WithSigner(ctx, mustParse(testPersonIRI))
+		p.EXPECT().SignerMatch(signedCtx, mustParse(testPersonIRI), testCreate).Return(SignerMatchOrigin, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(signedCtx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("SignerMatchNoneAllowsAnySigner", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, p, a := signerSetupFn(ctl)
+		resp := httptest.NewRecorder()
+		signedCtx := WithSigner(ctx, mustParse(testPersonIRI))
+		p.EXPECT().SignerMatch(signedCtx, mustParse(testPersonIRI), testCreate).Return(SignerMatchNone, nil)
+		fp.EXPECT().Blocked(signedCtx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(signedCtx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, true)
+		assertEqual(t, err, nil)
+	})
+	filterSetupFn := func(ctl *gomock.Controller) (fp *MockFederatingProtocol, f *MockInboxFilter, a DelegateActor) {
+		setupData()
+		fp = NewMockFederatingProtocol(ctl)
+		f = NewMockInboxFilter(ctl)
+		a = &sideEffectActor{
+			s2s: struct {
+				FederatingProtocol
+				InboxFilter
+			}{fp, f},
+		}
+		return
+	}
+	t.Run("FilterHandlesActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, f, a := filterSetupFn(ctl)
+		resp := httptest.NewRecorder()
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		f.EXPECT().FilterInbox(ctx, []*url.URL{mustParse(testFederatedActorIRI)}, testCreate).Return(true, 0, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, true)
+		assertEqual(t, err, nil)
+	})
+	t.Run("FilterDropsActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, f, a := filterSetupFn(ctl)
+		resp := httptest.NewRecorder()
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		f.EXPECT().FilterInbox(ctx, []*url.URL{mustParse(testFederatedActorIRI)}, testCreate).Return(false, http.StatusAccepted, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusAccepted)
+	})
+	t.Run("FilterRejectsActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, f, a := filterSetupFn(ctl)
+		resp := httptest.NewRecorder()
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		f.EXPECT().FilterInbox(ctx, []*url.URL{mustParse(testFederatedActorIRI)}, testCreate).Return(false, http.StatusUnprocessableEntity, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusUnprocessableEntity)
+	})
+	t.Run("FilterRejectsActivityWithInvalidCode", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, f, a := filterSetupFn(ctl)
+		resp := httptest.NewRecorder()
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		f.EXPECT().FilterInbox(ctx, []*url.URL{mustParse(testFederatedActorIRI)}, testCreate).Return(false, 0, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("FilterNotCalledIfBlocked", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, _, a := filterSetupFn(ctl)
+		resp := httptest.NewRecorder()
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(true, nil)
+		// Run
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
 }
 
 // TestPostInbox ensures that the main application side effects of receiving a
@@ -222,12 +475,98 @@ func TestPostInbox(t *testing.T) {
 			db.EXPECT().Unlock(ctx, inboxIRI),
 		)
 		fp.EXPECT().FederatingCallbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil)
-		fp.EXPECT().DefaultCallback(ctx, testListen).Return(nil)
 		// Run
 		err := a.PostInbox(ctx, inboxIRI, testListen)
 		// Verify
 		assertEqual(t, err, nil)
 	})
+	t.Run("AddsToInboxInTransaction", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		fp := NewMockFederatingProtocol(ctl)
+		db := NewMockTransactionalDatabase(ctl)
+		a := &sideEffectActor{
+			common: NewMockCommonBehavior(ctl),
+			s2s:    fp,
+			db:     db,
+			clock:  NewMockClock(ctl),
+		}
+		tx := context.WithValue(ctx, testTxKey{}, true)
+		inboxIRI := mustParse(testMyInboxIRI)
+		gomock.InOrder(
+			db.EXPECT().Begin(ctx).Return(tx, nil),
+			db.EXPECT().Lock(tx, inboxIRI),
+			db.EXPECT().InboxContains(tx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(tx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(tx, testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(tx, inboxIRI),
+			db.EXPECT().Commit(tx),
+		)
+		fp.EXPECT().FederatingCallbacks(tx).Return(FederatingWrappedCallbacks{}, nil, nil)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("CountsActivityIfMetrics", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		fp := NewMockFederatingProtocol(ctl)
+		m := NewMockMetrics(ctl)
+		db := NewMockDatabase(ctl)
+		a := &sideEffectActor{
+			common: NewMockCommonBehavior(ctl),
+			s2s: struct {
+				FederatingProtocol
+				Metrics
+			}{fp, m},
+			db:    db,
+			clock: NewMockClock(ctl),
+		}
+		inboxIRI := mustParse(testMyInboxIRI)
+		m.EXPECT().InboxActivity(ctx, "Listen")
+		db.EXPECT().Lock(ctx, inboxIRI)
+		db.EXPECT().InboxContains(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(true, nil)
+		db.EXPECT().Unlock(ctx, inboxIRI)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("RollsBackTransactionOnError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		fp := NewMockFederatingProtocol(ctl)
+		db := NewMockTransactionalDatabase(ctl)
+		a := &sideEffectActor{
+			common: NewMockCommonBehavior(ctl),
+			s2s:    fp,
+			db:     db,
+			clock:  NewMockClock(ctl),
+		}
+		tx := context.WithValue(ctx, testTxKey{}, true)
+		inboxIRI := mustParse(testMyInboxIRI)
+		gomock.InOrder(
+			db.EXPECT().Begin(ctx).Return(tx, nil),
+			db.EXPECT().Lock(tx, inboxIRI),
+			db.EXPECT().InboxContains(tx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(tx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(tx, testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(tx, inboxIRI),
+			db.EXPECT().Rollback(tx),
+		)
+		fp.EXPECT().FederatingCallbacks(tx).Return(FederatingWrappedCallbacks{}, nil, testErr)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, testErr)
+	})
 	t.Run("DoesNotAddToInboxNorDoSideEffectsIfDuplicate", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
@@ -258,12 +597,35 @@ func TestPostInbox(t *testing.T) {
 			db.EXPECT().Unlock(ctx, inboxIRI),
 		)
 		fp.EXPECT().FederatingCallbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil)
-		fp.EXPECT().DefaultCallback(ctx, testListen).Return(nil)
 		// Run
 		err := a.PostInbox(ctx, inboxIRI, testListen)
 		// Verify
 		assertEqual(t, err, nil)
 	})
+	t.Run("CallsDefaultCallbackForUnhandledType", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, db, _, a := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		join := streams.NewActivityStreamsJoin()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		join.SetJSONLDId(id)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().InboxContains(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(ctx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(ctx, testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		fp.EXPECT().FederatingCallbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil)
+		fp.EXPECT().DefaultCallback(ctx, join).Return(nil)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, join)
+		// Verify
+		assertEqual(t, err, nil)
+	})
 	t.Run("ResolvesToCustomFunction", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
@@ -627,14 +989,24 @@ func TestInboxForwarding(t *testing.T) {
 				},
 				nil,
 			),
+			// resolveInboxes
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
+			fp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1),
+			tPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI3)).Return(
+				mustSerializeToBytes(testFederatedPerson3), nil),
+			tPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI4)).Return(
+				mustSerializeToBytes(testFederatedPerson4), nil),
+			// filterBlockedActors
+			fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI3)}).Return(false, nil),
+			fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI4)}).Return(false, nil),
 			// deliverToRecipients
 			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
 			tPort.EXPECT().BatchDeliver(
 				ctx,
 				mustSerializeToBytes(input),
 				[]*url.URL{
-					mustParse(testFederatedActorIRI3),
-					mustParse(testFederatedActorIRI4),
+					mustParse(testFederatedInboxIRI3),
+					mustParse(testFederatedInboxIRI4),
 				},
 			),
 			// Deferred
@@ -646,12 +1018,13 @@ func TestInboxForwarding(t *testing.T) {
 		// Verify
 		assertEqual(t, err, nil)
 	})
-	t.Run("ForwardsToRecipientsIfChainIsNested", func(t *testing.T) {
+	t.Run("DoesNotForwardToBlockedActors", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
 		defer ctl.Finish()
 		cm, fp, _, db, _, a := setupFn(ctl)
-		input := mustAddAudienceIds(testNestedInReplyTo)
+		input := mustAddTagIds(
+			mustAddAudienceIds(testListen))
 		tPort := NewMockTransport(ctl)
 		gomock.InOrder(
 			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
@@ -670,12 +1043,9 @@ func TestInboxForwarding(t *testing.T) {
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
 			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(0),
 			// hasInboxForwardingValues
-			db.EXPECT().Lock(ctx, mustParse(testNoteId1)),
-			db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(false, nil),
-			db.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
-			db.EXPECT().Lock(ctx, mustParse(inReplyToIRI)),
-			db.EXPECT().Owns(ctx, mustParse(inReplyToIRI)).Return(true, nil),
-			db.EXPECT().Unlock(ctx, mustParse(inReplyToIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testTagIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testTagIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testTagIRI)),
 			// after hasInboxForwardingValues
 			fp.EXPECT().FilterForwarding(
 				ctx,
@@ -690,14 +1060,23 @@ func TestInboxForwarding(t *testing.T) {
 				},
 				nil,
 			),
+			// resolveInboxes
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
+			fp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1),
+			tPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI3)).Return(
+				mustSerializeToBytes(testFederatedPerson3), nil),
+			tPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI4)).Return(
+				mustSerializeToBytes(testFederatedPerson4), nil),
+			// filterBlockedActors
+			fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI3)}).Return(true, nil),
+			fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI4)}).Return(false, nil),
 			// deliverToRecipients
 			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
 			tPort.EXPECT().BatchDeliver(
 				ctx,
 				mustSerializeToBytes(input),
 				[]*url.URL{
-					mustParse(testFederatedActorIRI3),
-					mustParse(testFederatedActorIRI4),
+					mustParse(testFederatedInboxIRI4),
 				},
 			),
 			// Deferred
@@ -709,16 +1088,19 @@ func TestInboxForwarding(t *testing.T) {
 		// Verify
 		assertEqual(t, err, nil)
 	})
-	t.Run("ForwardsToRecipientsAfterDereferencing", func(t *testing.T) {
+	t.Run("DoesNotForwardToSender", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
 		defer ctl.Finish()
 		cm, fp, _, db, _, a := setupFn(ctl)
 		input := mustAddTagIds(
 			mustAddAudienceIds(testListen))
-		tagTPort := NewMockTransport(ctl)
-		tagTPort2 := NewMockTransport(ctl)
 		tPort := NewMockTransport(ctl)
+		followers := streams.NewActivityStreamsOrderedCollectionPage()
+		oi := streams.NewActivityStreamsOrderedItemsProperty()
+		oi.AppendIRI(mustParse(testFederatedActorIRI))
+		oi.AppendIRI(mustParse(testFederatedActorIRI3))
+		followers.SetActivityStreamsOrderedItems(oi)
 		gomock.InOrder(
 			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
 			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil),
@@ -728,36 +1110,20 @@ func TestInboxForwarding(t *testing.T) {
 			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI)).Return(true, nil),
 			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
 			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
-			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI2)).Return(true, nil),
+			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI2)).Return(false, nil),
 			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
 			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI)),
-			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
-			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
-			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
+			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(followers, nil),
 			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(0),
 			// hasInboxForwardingValues
 			db.EXPECT().Lock(ctx, mustParse(testTagIRI)),
-			db.EXPECT().Owns(ctx, mustParse(testTagIRI)).Return(false, nil),
+			db.EXPECT().Owns(ctx, mustParse(testTagIRI)).Return(true, nil),
 			db.EXPECT().Unlock(ctx, mustParse(testTagIRI)),
-			db.EXPECT().Lock(ctx, mustParse(testTagIRI2)),
-			db.EXPECT().Owns(ctx, mustParse(testTagIRI2)).Return(false, nil),
-			db.EXPECT().Unlock(ctx, mustParse(testTagIRI2)),
-			db.EXPECT().Lock(ctx, mustParse(testNoteId1)),
-			db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(false, nil),
-			db.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
-			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tagTPort, nil),
-			tagTPort.EXPECT().Dereference(ctx, mustParse(testTagIRI)).Return(mustSerializeToBytes(mustAddInReplyToIds(newActivityWithId(testTagIRI))), nil),
-			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tagTPort2, nil),
-			tagTPort2.EXPECT().Dereference(ctx, mustParse(testTagIRI2)).Return(mustSerializeToBytes(newActivityWithId(testTagIRI2)), nil),
-			db.EXPECT().Lock(ctx, mustParse(inReplyToIRI)),
-			db.EXPECT().Owns(ctx, mustParse(inReplyToIRI)).Return(true, nil),
-			db.EXPECT().Unlock(ctx, mustParse(inReplyToIRI)),
 			// after hasInboxForwardingValues
 			fp.EXPECT().FilterForwarding(
 				ctx,
 				[]*url.URL{
 					mustParse(testAudienceIRI),
-					mustParse(testAudienceIRI2),
 				},
 				input,
 			).Return(
@@ -766,18 +1132,23 @@ func TestInboxForwarding(t *testing.T) {
 				},
 				nil,
 			),
+			// resolveInboxes
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
+			fp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1),
+			tPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI3)).Return(
+				mustSerializeToBytes(testFederatedPerson3), nil),
+			// filterBlockedActors
+			fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI3)}).Return(false, nil),
 			// deliverToRecipients
 			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
 			tPort.EXPECT().BatchDeliver(
 				ctx,
 				mustSerializeToBytes(input),
 				[]*url.URL{
-					mustParse(testFederatedActorIRI3),
-					mustParse(testFederatedActorIRI4),
+					mustParse(testFederatedInboxIRI3),
 				},
 			),
 			// Deferred
-			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
 			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
 		)
 		// Run
@@ -785,12 +1156,13 @@ func TestInboxForwarding(t *testing.T) {
 		// Verify
 		assertEqual(t, err, nil)
 	})
-	t.Run("DoesNotForwardIfChainIsNestedTooDeep", func(t *testing.T) {
+	t.Run("ForwardsToRecipientsIfChainIsNested", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
 		defer ctl.Finish()
-		_, fp, _, db, _, a := setupFn(ctl)
+		cm, fp, _, db, _, a := setupFn(ctl)
 		input := mustAddAudienceIds(testNestedInReplyTo)
+		tPort := NewMockTransport(ctl)
 		gomock.InOrder(
 			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
 			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil),
@@ -806,12 +1178,170 @@ func TestInboxForwarding(t *testing.T) {
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
 			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
-			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(1),
+			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(0),
 			// hasInboxForwardingValues
 			db.EXPECT().Lock(ctx, mustParse(testNoteId1)),
 			db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(false, nil),
 			db.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
-			// Deferred
+			db.EXPECT().Lock(ctx, mustParse(inReplyToIRI)),
+			db.EXPECT().Owns(ctx, mustParse(inReplyToIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(inReplyToIRI)),
+			// after hasInboxForwardingValues
+			fp.EXPECT().FilterForwarding(
+				ctx,
+				[]*url.URL{
+					mustParse(testAudienceIRI),
+					mustParse(testAudienceIRI2),
+				},
+				input,
+			).Return(
+				[]*url.URL{
+					mustParse(testAudienceIRI),
+				},
+				nil,
+			),
+			// resolveInboxes
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
+			fp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1),
+			tPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI3)).Return(
+				mustSerializeToBytes(testFederatedPerson3), nil),
+			tPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI4)).Return(
+				mustSerializeToBytes(testFederatedPerson4), nil),
+			// filterBlockedActors
+			fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI3)}).Return(false, nil),
+			fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI4)}).Return(false, nil),
+			// deliverToRecipients
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
+			tPort.EXPECT().BatchDeliver(
+				ctx,
+				mustSerializeToBytes(input),
+				[]*url.URL{
+					mustParse(testFederatedInboxIRI3),
+					mustParse(testFederatedInboxIRI4),
+				},
+			),
+			// Deferred
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
+		)
+		// Run
+		err := a.InboxForwarding(ctx, mustParse(testMyInboxIRI), input)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("ForwardsToRecipientsAfterDereferencing", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cm, fp, _, db, _, a := setupFn(ctl)
+		input := mustAddTagIds(
+			mustAddAudienceIds(testListen))
+		tagTPort := NewMockTransport(ctl)
+		tagTPort2 := NewMockTransport(ctl)
+		tPort := NewMockTransport(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().Create(ctx, input).Return(nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI2)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
+			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(0),
+			// hasInboxForwardingValues
+			db.EXPECT().Lock(ctx, mustParse(testTagIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testTagIRI)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testTagIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testTagIRI2)),
+			db.EXPECT().Owns(ctx, mustParse(testTagIRI2)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testTagIRI2)),
+			db.EXPECT().Lock(ctx, mustParse(testNoteId1)),
+			db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tagTPort, nil),
+			tagTPort.EXPECT().Dereference(ctx, mustParse(testTagIRI)).Return(mustSerializeToBytes(mustAddInReplyToIds(newActivityWithId(testTagIRI))), nil),
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tagTPort2, nil),
+			tagTPort2.EXPECT().Dereference(ctx, mustParse(testTagIRI2)).Return(mustSerializeToBytes(newActivityWithId(testTagIRI2)), nil),
+			db.EXPECT().Lock(ctx, mustParse(inReplyToIRI)),
+			db.EXPECT().Owns(ctx, mustParse(inReplyToIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(inReplyToIRI)),
+			// after hasInboxForwardingValues
+			fp.EXPECT().FilterForwarding(
+				ctx,
+				[]*url.URL{
+					mustParse(testAudienceIRI),
+					mustParse(testAudienceIRI2),
+				},
+				input,
+			).Return(
+				[]*url.URL{
+					mustParse(testAudienceIRI),
+				},
+				nil,
+			),
+			// resolveInboxes
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
+			fp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1),
+			tPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI3)).Return(
+				mustSerializeToBytes(testFederatedPerson3), nil),
+			tPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI4)).Return(
+				mustSerializeToBytes(testFederatedPerson4), nil),
+			// filterBlockedActors
+			fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI3)}).Return(false, nil),
+			fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI4)}).Return(false, nil),
+			// deliverToRecipients
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
+			tPort.EXPECT().BatchDeliver(
+				ctx,
+				mustSerializeToBytes(input),
+				[]*url.URL{
+					mustParse(testFederatedInboxIRI3),
+					mustParse(testFederatedInboxIRI4),
+				},
+			),
+			// Deferred
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
+		)
+		// Run
+		err := a.InboxForwarding(ctx, mustParse(testMyInboxIRI), input)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotForwardIfChainIsNestedTooDeep", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, db, _, a := setupFn(ctl)
+		input := mustAddAudienceIds(testNestedInReplyTo)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().Create(ctx, input).Return(nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI2)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
+			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(1),
+			// hasInboxForwardingValues
+			db.EXPECT().Lock(ctx, mustParse(testNoteId1)),
+			db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
+			// Deferred
 			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
 			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
 		)
@@ -858,7 +1388,39 @@ func TestPostOutbox(t *testing.T) {
 			db.EXPECT().Unlock(ctx, outboxIRI),
 		)
 		sp.EXPECT().SocialCallbacks(ctx).Return(SocialWrappedCallbacks{}, nil, nil)
-		sp.EXPECT().DefaultCallback(ctx, testMyListen).Return(nil)
+		// Run
+		deliverable, err := a.PostOutbox(ctx, testMyListen, outboxIRI, mustSerialize(testMyListen))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, deliverable, true)
+	})
+	t.Run("AddsToOutboxInTransaction", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		sp := NewMockSocialProtocol(ctl)
+		db := NewMockTransactionalDatabase(ctl)
+		a := &sideEffectActor{
+			common: NewMockCommonBehavior(ctl),
+			c2s:    sp,
+			db:     db,
+			clock:  NewMockClock(ctl),
+		}
+		tx := context.WithValue(ctx, testTxKey{}, true)
+		outboxIRI := mustParse(testMyOutboxIRI)
+		gomock.InOrder(
+			db.EXPECT().Begin(ctx).Return(tx, nil),
+			db.EXPECT().Lock(tx, mustParse(testNewActivityIRI)),
+			db.EXPECT().Create(tx, testMyListen),
+			db.EXPECT().Unlock(tx, mustParse(testNewActivityIRI)),
+			db.EXPECT().Lock(tx, outboxIRI),
+			db.EXPECT().GetOutbox(tx, outboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetOutbox(tx, testOrderedCollectionWithNewId).Return(nil),
+			db.EXPECT().Unlock(tx, outboxIRI),
+			db.EXPECT().Commit(tx),
+		)
+		sp.EXPECT().SocialCallbacks(tx).Return(SocialWrappedCallbacks{}, nil, nil)
 		// Run
 		deliverable, err := a.PostOutbox(ctx, testMyListen, outboxIRI, mustSerialize(testMyListen))
 		// Verify
@@ -881,13 +1443,39 @@ func TestPostOutbox(t *testing.T) {
 			db.EXPECT().Unlock(ctx, outboxIRI),
 		)
 		sp.EXPECT().SocialCallbacks(ctx).Return(SocialWrappedCallbacks{}, nil, nil)
-		sp.EXPECT().DefaultCallback(ctx, testMyListen).Return(nil)
 		// Run
 		deliverable, err := a.PostOutbox(ctx, testMyListen, outboxIRI, mustSerialize(testMyListen))
 		// Verify
 		assertEqual(t, err, nil)
 		assertEqual(t, deliverable, true)
 	})
+	t.Run("CallsDefaultCallbackForUnhandledType", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, sp, db, _, a := setupFn(ctl)
+		outboxIRI := mustParse(testMyOutboxIRI)
+		join := streams.NewActivityStreamsJoin()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testNewActivityIRI))
+		join.SetJSONLDId(id)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testNewActivityIRI)),
+			db.EXPECT().Create(ctx, join),
+			db.EXPECT().Unlock(ctx, mustParse(testNewActivityIRI)),
+			db.EXPECT().Lock(ctx, outboxIRI),
+			db.EXPECT().GetOutbox(ctx, outboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetOutbox(ctx, testOrderedCollectionWithNewId).Return(nil),
+			db.EXPECT().Unlock(ctx, outboxIRI),
+		)
+		sp.EXPECT().SocialCallbacks(ctx).Return(SocialWrappedCallbacks{}, nil, nil)
+		sp.EXPECT().DefaultCallback(ctx, join).Return(nil)
+		// Run
+		deliverable, err := a.PostOutbox(ctx, join, outboxIRI, mustSerialize(join))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, deliverable, true)
+	})
 	t.Run("ResolvesToCustomFunction", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
@@ -1121,8 +1709,10 @@ func TestDeliver(t *testing.T) {
 		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
 			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1160,8 +1750,10 @@ func TestDeliver(t *testing.T) {
 		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
 			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1198,8 +1790,10 @@ func TestDeliver(t *testing.T) {
 		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
 			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1237,8 +1831,10 @@ func TestDeliver(t *testing.T) {
 		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
 			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1275,8 +1871,10 @@ func TestDeliver(t *testing.T) {
 		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
 			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1314,8 +1912,10 @@ func TestDeliver(t *testing.T) {
 		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
 			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1353,8 +1953,98 @@ func TestDeliver(t *testing.T) {
 			mustSerializeToBytes(testCollectionOfActors), nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
 			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
+		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
+			mustParse(testPersonIRI), nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		mockDb.EXPECT().Get(ctx, mustParse(testPersonIRI)).Return(
+			testMyPerson, nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockTp.EXPECT().BatchDeliver(ctx, mustSerializeToBytes(act), expectRecip)
+		// Run & Verify
+		err := a.Deliver(ctx, mustParse(testMyOutboxIRI), act)
+		assertEqual(t, err, nil)
+	})
+	t.Run("StopsResolvingAtMaxDereferences", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, mockFp, _, mockDb, _, a := setupFn(ctl)
+		dl := NewMockDereferenceLimiter(ctl)
+		a.(*sideEffectActor).s2s = struct {
+			FederatingProtocol
+			DereferenceLimiter
+		}{mockFp, dl}
+		mockTp := NewMockTransport(ctl)
+		act := baseActivityFn()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testAudienceIRI))
+		act.SetActivityStreamsTo(to)
+		expectRecip := []*url.URL{
+			mustParse(testFederatedInboxIRI),
+		}
+		// Mock
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		dl.EXPECT().RecursiveDereferenceLimits(ctx).Return(2, time.Duration(0))
+		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(2)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testAudienceIRI)).Return(
+			mustSerializeToBytes(testCollectionOfActors), nil)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
+			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
+			mustParse(testPersonIRI), nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		mockDb.EXPECT().Get(ctx, mustParse(testPersonIRI)).Return(
+			testMyPerson, nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockTp.EXPECT().BatchDeliver(ctx, mustSerializeToBytes(act), expectRecip)
+		// Run & Verify
+		err := a.Deliver(ctx, mustParse(testMyOutboxIRI), act)
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotFollowCollectionCycles", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, mockFp, _, mockDb, _, a := setupFn(ctl)
+		mockTp := NewMockTransport(ctl)
+		act := baseActivityFn()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testAudienceIRI))
+		act.SetActivityStreamsTo(to)
+		cycle := streams.NewActivityStreamsCollection()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testAudienceIRI))
+		cycle.SetJSONLDId(id)
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testAudienceIRI))
+		items.AppendIRI(mustParse(testFederatedActorIRI))
+		cycle.SetActivityStreamsItems(items)
+		expectRecip := []*url.URL{
+			mustParse(testFederatedInboxIRI),
+		}
+		// Mock
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(0)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testAudienceIRI)).Return(
+			mustSerializeToBytes(cycle), nil)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
+			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1392,8 +2082,10 @@ func TestDeliver(t *testing.T) {
 			mustSerializeToBytes(testOrderedCollectionOfActors), nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI3)).Return(
 			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI4)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1475,9 +2167,11 @@ func TestDeliver(t *testing.T) {
 			mockTp, nil)
 		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
-			mustSerializeToBytes(testFederatedPerson1), nil).Times(4)
+			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
-			mustSerializeToBytes(testFederatedPerson2), nil).Times(4)
+			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1516,8 +2210,10 @@ func TestDeliver(t *testing.T) {
 		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
 			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1556,8 +2252,10 @@ func TestDeliver(t *testing.T) {
 		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
 			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1595,6 +2293,7 @@ func TestDeliver(t *testing.T) {
 			[]byte{}, fmt.Errorf("test error"))
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1632,8 +2331,10 @@ func TestDeliver(t *testing.T) {
 		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
 			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
 		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
 			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
 		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
 		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
 			mustParse(testPersonIRI), nil)
@@ -1650,17 +2351,269 @@ func TestDeliver(t *testing.T) {
 		err := a.Deliver(ctx, mustParse(testMyOutboxIRI), act)
 		assertEqual(t, err, expectErr)
 	})
-}
-
-// TestWrapInCreate ensures an object received by the Social Protocol is
-// properly wrapped in a Create Activity.
-func TestWrapInCreate(t *testing.T) {
-	baseNoteFn := func() (vocab.ActivityStreamsNote, vocab.ActivityStreamsCreate) {
-		n := streams.NewActivityStreamsNote()
-		id := streams.NewJSONLDIdProperty()
-		id.Set(mustParse(testNoteId1))
-		n.SetJSONLDId(id)
-		cr := streams.NewActivityStreamsCreate()
+	t.Run("SendOnceToCommonSharedInbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, mockFp, _, mockDb, _, a := setupFn(ctl)
+		sp := NewMockSharedInboxPolicy(ctl)
+		a.(*sideEffectActor).s2s = struct {
+			FederatingProtocol
+			SharedInboxPolicy
+		}{mockFp, sp}
+		mockTp := NewMockTransport(ctl)
+		act := baseActivityFn()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testFederatedActorIRI))
+		to.AppendIRI(mustParse(testFederatedActorIRI2))
+		act.SetActivityStreamsTo(to)
+		expectRecip := []*url.URL{
+			mustParse(testFederatedSharedInbox),
+		}
+		// Mock
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
+		sp.EXPECT().DeliverToSharedInboxes(ctx, mustParse(testMyOutboxIRI), gomock.Any()).Return(true)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
+			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
+			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
+		sp.EXPECT().SharedInbox(ctx, gomock.Any()).Return(
+			mustParse(testFederatedSharedInbox), nil)
+		sp.EXPECT().SharedInbox(ctx, gomock.Any()).Return(
+			mustParse(testFederatedSharedInbox), nil)
+		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
+			mustParse(testPersonIRI), nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		mockDb.EXPECT().Get(ctx, mustParse(testPersonIRI)).Return(
+			testMyPerson, nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockTp.EXPECT().BatchDeliver(ctx, mustSerializeToBytes(act), gomock.Any()).DoAndReturn(
+			func(c context.Context, b []byte, recipients []*url.URL) error {
+				assertEqual(t, len(recipients), len(expectRecip))
+				for _, r := range expectRecip {
+					found := false
+					for _, got := range recipients {
+						found = found || got.String() == r.String()
+					}
+					assertEqual(t, found, true)
+				}
+				return nil
+			})
+		// Run & Verify
+		err := a.Deliver(ctx, mustParse(testMyOutboxIRI), act)
+		assertEqual(t, err, nil)
+	})
+	t.Run("SendToInboxIfNoSharedInbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, mockFp, _, mockDb, _, a := setupFn(ctl)
+		sp := NewMockSharedInboxPolicy(ctl)
+		a.(*sideEffectActor).s2s = struct {
+			FederatingProtocol
+			SharedInboxPolicy
+		}{mockFp, sp}
+		mockTp := NewMockTransport(ctl)
+		act := baseActivityFn()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testFederatedActorIRI))
+		to.AppendIRI(mustParse(testFederatedActorIRI2))
+		act.SetActivityStreamsTo(to)
+		expectRecip := []*url.URL{
+			mustParse(testFederatedInboxIRI),
+			mustParse(testFederatedSharedInbox),
+		}
+		// Mock
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
+		sp.EXPECT().DeliverToSharedInboxes(ctx, mustParse(testMyOutboxIRI), gomock.Any()).Return(true)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
+			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
+			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
+		sp.EXPECT().SharedInbox(ctx, gomock.Any()).Return(
+			nil, nil)
+		sp.EXPECT().SharedInbox(ctx, gomock.Any()).Return(
+			mustParse(testFederatedSharedInbox), nil)
+		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
+			mustParse(testPersonIRI), nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		mockDb.EXPECT().Get(ctx, mustParse(testPersonIRI)).Return(
+			testMyPerson, nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockTp.EXPECT().BatchDeliver(ctx, mustSerializeToBytes(act), gomock.Any()).DoAndReturn(
+			func(c context.Context, b []byte, recipients []*url.URL) error {
+				assertEqual(t, len(recipients), len(expectRecip))
+				for _, r := range expectRecip {
+					found := false
+					for _, got := range recipients {
+						found = found || got.String() == r.String()
+					}
+					assertEqual(t, found, true)
+				}
+				return nil
+			})
+		// Run & Verify
+		err := a.Deliver(ctx, mustParse(testMyOutboxIRI), act)
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotSendToBlockedRecipients", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, mockFp, _, mockDb, _, a := setupFn(ctl)
+		pp := NewMockPeerPolicy(ctl)
+		a.(*sideEffectActor).s2s = struct {
+			FederatingProtocol
+			PeerPolicy
+		}{mockFp, pp}
+		mockTp := NewMockTransport(ctl)
+		act := baseActivityFn()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testFederatedActorIRI))
+		to.AppendIRI(mustParse(testFederatedActorIRI2))
+		act.SetActivityStreamsTo(to)
+		expectRecip := []*url.URL{
+			mustParse(testFederatedInboxIRI2),
+		}
+		// Mock
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
+			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
+			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
+		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
+			mustParse(testPersonIRI), nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		mockDb.EXPECT().Get(ctx, mustParse(testPersonIRI)).Return(
+			testMyPerson, nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+		pp.EXPECT().FederationPolicy(ctx, mustParse(testFederatedInboxIRI)).Return(PolicyBlock, nil)
+		pp.EXPECT().FederationPolicy(ctx, mustParse(testFederatedInboxIRI2)).Return(PolicySilence, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockTp.EXPECT().BatchDeliver(ctx, mustSerializeToBytes(act), expectRecip)
+		// Run & Verify
+		err := a.Deliver(ctx, mustParse(testMyOutboxIRI), act)
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotSendToBlockedActors", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, mockFp, _, mockDb, _, a := setupFn(ctl)
+		mockTp := NewMockTransport(ctl)
+		act := baseActivityFn()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testFederatedActorIRI))
+		to.AppendIRI(mustParse(testFederatedActorIRI2))
+		act.SetActivityStreamsTo(to)
+		expectRecip := []*url.URL{
+			mustParse(testFederatedInboxIRI2),
+		}
+		// Mock
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
+			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(true, nil)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(
+			mustSerializeToBytes(testFederatedPerson2), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI2)}).Return(false, nil)
+		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
+			mustParse(testPersonIRI), nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		mockDb.EXPECT().Get(ctx, mustParse(testPersonIRI)).Return(
+			testMyPerson, nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockTp.EXPECT().BatchDeliver(ctx, mustSerializeToBytes(act), expectRecip)
+		// Run & Verify
+		err := a.Deliver(ctx, mustParse(testMyOutboxIRI), act)
+		assertEqual(t, err, nil)
+	})
+	t.Run("EnqueuesIfDatabaseIsDeliveryQueue", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		c := NewMockCommonBehavior(ctl)
+		mockFp := NewMockFederatingProtocol(ctl)
+		mockDb := NewMockDatabase(ctl)
+		mockQ := NewMockDeliveryQueue(ctl)
+		a := &sideEffectActor{
+			common: c,
+			s2s:    mockFp,
+			db: struct {
+				Database
+				DeliveryQueue
+			}{mockDb, mockQ},
+			clock: NewMockClock(ctl),
+		}
+		mockTp := NewMockTransport(ctl)
+		act := baseActivityFn()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testFederatedActorIRI))
+		act.SetActivityStreamsTo(to)
+		expectRecip := []*url.URL{
+			mustParse(testFederatedInboxIRI),
+		}
+		// Mock
+		c.EXPECT().NewTransport(ctx, mustParse(testMyOutboxIRI), goFedUserAgent()).Return(
+			mockTp, nil)
+		mockFp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(1)
+		mockTp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
+			mustSerializeToBytes(testFederatedPerson1), nil)
+		mockFp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		mockDb.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
+			mustParse(testPersonIRI), nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		mockDb.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		mockDb.EXPECT().Get(ctx, mustParse(testPersonIRI)).Return(
+			testMyPerson, nil)
+		mockDb.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+		mockQ.EXPECT().Enqueue(ctx, mustParse(testMyOutboxIRI), mustSerializeToBytes(act), expectRecip)
+		// Run & Verify
+		err := a.Deliver(ctx, mustParse(testMyOutboxIRI), act)
+		assertEqual(t, err, nil)
+	})
+}
+
+// TestWrapInCreate ensures an object received by the Social Protocol is
+// properly wrapped in a Create Activity.
+func TestWrapInCreate(t *testing.T) {
+	baseNoteFn := func() (vocab.ActivityStreamsNote, vocab.ActivityStreamsCreate) {
+		n := streams.NewActivityStreamsNote()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testNoteId1))
+		n.SetJSONLDId(id)
+		cr := streams.NewActivityStreamsCreate()
 		op := streams.NewActivityStreamsObjectProperty()
 		op.AppendActivityStreamsNote(n)
 		cr.SetActivityStreamsObject(op)
@@ -1828,3 +2781,344 @@ func TestWrapInCreate(t *testing.T) {
 		assertByteEqual(t, mustSerializeToBytes(got), mustSerializeToBytes(expect))
 	})
 }
+
+func TestDereferenceLimit(t *testing.T) {
+	ctx := context.Background()
+	t.Run("DereferencesWithinDeadline", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockClock := NewMockClock(ctl)
+		mockTp := NewMockTransport(ctl)
+		// Mock
+		gomock.InOrder(
+			mockClock.EXPECT().Now().Return(now()),
+			mockClock.EXPECT().Now().Return(now().Add(time.Second)),
+		)
+		mockTp.EXPECT().Dereference(gomock.Any(), mustParse(testFederatedActorIRI)).Return(testRespBody, nil)
+		// Run & Verify
+		d := newDereferenceLimit(mockClock, 0, time.Minute)
+		b, err := d.dereference(ctx, mockTp, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, testRespBody)
+	})
+	t.Run("StopsAtDeadlineOfClock", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockClock := NewMockClock(ctl)
+		mockTp := NewMockTransport(ctl)
+		// Mock
+		gomock.InOrder(
+			mockClock.EXPECT().Now().Return(now()),
+			mockClock.EXPECT().Now().Return(now().Add(time.Minute)),
+		)
+		// Run & Verify
+		d := newDereferenceLimit(mockClock, 0, time.Minute)
+		_, err := d.dereference(ctx, mockTp, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, errDereferenceLimit)
+	})
+}
+
+func TestFollowersSynchronization(t *testing.T) {
+	ctx := context.Background()
+	followersIRI := mustParse(testPersonIRI + "/followers")
+	syncIRI := mustParse(testPersonIRI + "/followers_synchronization")
+	setupFn := func(ctl *gomock.Controller) (db *MockFollowersSynchronizer, a *sideEffectActor) {
+		setupData()
+		db = NewMockFollowersSynchronizer(ctl)
+		a = &sideEffectActor{db: db}
+		return
+	}
+	newFollowersFn := func() vocab.ActivityStreamsCollection {
+		col := streams.NewActivityStreamsCollection()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(followersIRI)
+		col.SetJSONLDId(id)
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testFederatedActorIRI))
+		items.AppendIRI(mustParse(testFederatedActorIRI2))
+		col.SetActivityStreamsItems(items)
+		return col
+	}
+	mockFollowersFn := func(db *MockFollowersSynchronizer) {
+		db.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI))
+		db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(mustParse(testPersonIRI), nil)
+		db.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI))
+		db.EXPECT().Lock(ctx, mustParse(testPersonIRI))
+		db.EXPECT().Followers(ctx, mustParse(testPersonIRI)).Return(newFollowersFn(), nil)
+		db.EXPECT().Unlock(ctx, mustParse(testPersonIRI))
+	}
+	t.Run("ReturnsFollowersIfAddressed", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, a := setupFn(ctl)
+		act := streams.NewActivityStreamsCreate()
+		cc := streams.NewActivityStreamsCcProperty()
+		cc.AppendIRI(followersIRI)
+		act.SetActivityStreamsCc(cc)
+		// Mock
+		mockFollowersFn(db)
+		db.EXPECT().PartialFollowersURL(ctx, mustParse(testPersonIRI)).Return(syncIRI, nil)
+		// Run & Verify
+		f, err := a.followersSynchronization(ctx, db, mustParse(testMyOutboxIRI), act)
+		assertEqual(t, err, nil)
+		assertEqual(t, f.collectionId.String(), followersIRI.String())
+		assertEqual(t, f.url.String(), syncIRI.String())
+		assertEqual(t, len(f.followers), 2)
+		s, err := ParseCollectionSynchronization(f.header("other.example.com"))
+		assertEqual(t, err, nil)
+		assertEqual(t, s.Digest, PartialFollowersDigest(f.followers, "other.example.com"))
+	})
+	t.Run("ReturnsNilIfNotAddressed", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, a := setupFn(ctl)
+		act := streams.NewActivityStreamsCreate()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(testFederatedActorIRI))
+		act.SetActivityStreamsTo(to)
+		// Mock
+		mockFollowersFn(db)
+		// Run & Verify
+		f, err := a.followersSynchronization(ctx, db, mustParse(testMyOutboxIRI), act)
+		assertEqual(t, err, nil)
+		assertEqual(t, f == nil, true)
+	})
+}
+
+func TestSynchronizeFollowers(t *testing.T) {
+	ctx := context.Background()
+	local := []*url.URL{mustParse("https://example.com/addison"), mustParse("https://example.com/sam")}
+	s := CollectionSynchronization{
+		CollectionId: mustParse(testFederatedActorIRI + "/followers"),
+		URL:          mustParse(testFederatedActorIRI + "/followers_synchronization"),
+		Digest:       PartialFollowersDigest(local, "example.com"),
+	}
+	newActivityFn := func(actorIRI string) vocab.ActivityStreamsCreate {
+		act := streams.NewActivityStreamsCreate()
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(actorIRI))
+		act.SetActivityStreamsActor(actor)
+		return act
+	}
+	newRemoteFn := func(items ...*url.URL) []byte {
+		col := streams.NewActivityStreamsOrderedCollection()
+		oi := streams.NewActivityStreamsOrderedItemsProperty()
+		for _, i := range items {
+			oi.AppendIRI(i)
+		}
+		col.SetActivityStreamsOrderedItems(oi)
+		return mustSerializeToBytes(col)
+	}
+	setupFn := func(ctl *gomock.Controller) (c *MockCommonBehavior, db *MockFollowersSynchronizer, tp *MockTransport, a *sideEffectActor) {
+		setupData()
+		c = NewMockCommonBehavior(ctl)
+		db = NewMockFollowersSynchronizer(ctl)
+		tp = NewMockTransport(ctl)
+		a = &sideEffectActor{common: c, db: db}
+		return
+	}
+	t.Run("DoesNothingIfDigestMatches", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, db, _, a := setupFn(ctl)
+		// Mock
+		db.EXPECT().LocalFollowers(ctx, s.CollectionId).Return(local, nil)
+		// Run & Verify
+		err := a.synchronizeFollowers(ctx, mustParse(testMyInboxIRI), newActivityFn(testFederatedActorIRI), s)
+		assertEqual(t, err, nil)
+	})
+	t.Run("ReconcilesIfDigestMismatches", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, db, tp, a := setupFn(ctl)
+		remote := newRemoteFn(local[1], mustParse("https://example.com/jessie"), mustParse(testPersonIRI))
+		// Mock
+		db.EXPECT().LocalFollowers(ctx, s.CollectionId).Return(local[:1], nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Dereference(ctx, s.URL).Return(remote, nil)
+		db.EXPECT().ReconcileFollowers(ctx, s.CollectionId,
+			[]*url.URL{local[0]},
+			[]*url.URL{local[1], mustParse("https://example.com/jessie")})
+		// Run & Verify
+		err := a.synchronizeFollowers(ctx, mustParse(testMyInboxIRI), newActivityFn(testFederatedActorIRI), s)
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotReconcileIfFetchedFollowersMatch", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, db, tp, a := setupFn(ctl)
+		// Mock
+		db.EXPECT().LocalFollowers(ctx, s.CollectionId).Return(local[:1], nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil)
+		tp.EXPECT().Dereference(ctx, s.URL).Return(newRemoteFn(local[0]), nil)
+		// Run & Verify
+		err := a.synchronizeFollowers(ctx, mustParse(testMyInboxIRI), newActivityFn(testFederatedActorIRI), s)
+		assertEqual(t, err, nil)
+	})
+	t.Run("ReturnsErrorIfNotFromCollectionHost", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, a := setupFn(ctl)
+		// Run & Verify
+		err := a.synchronizeFollowers(ctx, mustParse(testMyInboxIRI), newActivityFn(testPersonIRI), s)
+		assertNotEqual(t, err, nil)
+	})
+}
+
+// TestIngestRelayed ensures that objects announced by relays are fetched from
+// their origin and only ingested if trusted.
+func TestIngestRelayed(t *testing.T) {
+	ctx := context.Background()
+	relayIRI := mustParse("https://relay.example.com/actor")
+	noteIRI := mustParse("https://other.example.com/note/1")
+	newAnnounceFn := func(actorIRI *url.URL, objects ...*url.URL) vocab.ActivityStreamsAnnounce {
+		announce := streams.NewActivityStreamsAnnounce()
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(actorIRI)
+		announce.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		for _, o := range objects {
+			op.AppendIRI(o)
+		}
+		announce.SetActivityStreamsObject(op)
+		return announce
+	}
+	newNoteFn := func(id *url.URL, author string) vocab.ActivityStreamsNote {
+		note := streams.NewActivityStreamsNote()
+		note.SetJSONLDId(streams.NewJSONLDIdProperty())
+		note.GetJSONLDId().Set(id)
+		at := streams.NewActivityStreamsAttributedToProperty()
+		at.AppendIRI(mustParse(author))
+		note.SetActivityStreamsAttributedTo(at)
+		return note
+	}
+	setupFn := func(ctl *gomock.Controller) (c *MockCommonBehavior, fp *MockFederatingProtocol, rs *MockRelaySubscriber, db *MockDatabase, tp *MockTransport, a *sideEffectActor) {
+		setupData()
+		c = NewMockCommonBehavior(ctl)
+		fp = NewMockFederatingProtocol(ctl)
+		rs = NewMockRelaySubscriber(ctl)
+		db = NewMockDatabase(ctl)
+		tp = NewMockTransport(ctl)
+		a = &sideEffectActor{
+			common: c,
+			s2s: struct {
+				FederatingProtocol
+				RelaySubscriber
+			}{fp, rs},
+			db: db,
+		}
+		return
+	}
+	t.Run("IngestsObjectFromOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, fp, rs, db, tp, a := setupFn(ctl)
+		note := newNoteFn(noteIRI, testFederatedActorIRI)
+		// Mock
+		rs.EXPECT().IsRelay(ctx, relayIRI).Return(true, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil)
+		db.EXPECT().Lock(ctx, noteIRI).Times(2)
+		db.EXPECT().Owns(ctx, noteIRI).Return(false, nil)
+		db.EXPECT().Unlock(ctx, noteIRI).Times(2)
+		tp.EXPECT().Dereference(ctx, noteIRI).Return(mustSerializeToBytes(note), nil)
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		db.EXPECT().Exists(ctx, noteIRI).Return(false, nil)
+		db.EXPECT().Create(ctx, gomock.Any())
+		rs.EXPECT().Relayed(ctx, relayIRI, gomock.Any())
+		// Run & Verify
+		relayed, err := a.ingestRelayed(ctx, rs, mustParse(testMyInboxIRI), newAnnounceFn(relayIRI, noteIRI))
+		assertEqual(t, relayed, true)
+		assertEqual(t, err, nil)
+	})
+	t.Run("IgnoresAnnounceNotFromRelay", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, rs, _, _, a := setupFn(ctl)
+		// Mock
+		rs.EXPECT().IsRelay(ctx, mustParse(testFederatedActorIRI)).Return(false, nil)
+		// Run & Verify
+		relayed, err := a.ingestRelayed(ctx, rs, mustParse(testMyInboxIRI), newAnnounceFn(mustParse(testFederatedActorIRI), noteIRI))
+		assertEqual(t, relayed, false)
+		assertEqual(t, err, nil)
+	})
+	t.Run("DropsObjectWithOtherId", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, _, rs, db, tp, a := setupFn(ctl)
+		note := newNoteFn(mustParse("https://other.example.com/note/2"), testFederatedActorIRI)
+		// Mock
+		rs.EXPECT().IsRelay(ctx, relayIRI).Return(true, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil)
+		db.EXPECT().Lock(ctx, noteIRI)
+		db.EXPECT().Owns(ctx, noteIRI).Return(false, nil)
+		db.EXPECT().Unlock(ctx, noteIRI)
+		tp.EXPECT().Dereference(ctx, noteIRI).Return(mustSerializeToBytes(note), nil)
+		// Run & Verify
+		relayed, err := a.ingestRelayed(ctx, rs, mustParse(testMyInboxIRI), newAnnounceFn(relayIRI, noteIRI))
+		assertEqual(t, relayed, true)
+		assertEqual(t, err, nil)
+	})
+	t.Run("DropsObjectByAuthorOnOtherHost", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, _, rs, db, tp, a := setupFn(ctl)
+		note := newNoteFn(noteIRI, testPersonIRI)
+		// Mock
+		rs.EXPECT().IsRelay(ctx, relayIRI).Return(true, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil)
+		db.EXPECT().Lock(ctx, noteIRI)
+		db.EXPECT().Owns(ctx, noteIRI).Return(false, nil)
+		db.EXPECT().Unlock(ctx, noteIRI)
+		tp.EXPECT().Dereference(ctx, noteIRI).Return(mustSerializeToBytes(note), nil)
+		// Run & Verify
+		relayed, err := a.ingestRelayed(ctx, rs, mustParse(testMyInboxIRI), newAnnounceFn(relayIRI, noteIRI))
+		assertEqual(t, relayed, true)
+		assertEqual(t, err, nil)
+	})
+	t.Run("DropsObjectFromBlockedOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, fp, rs, db, tp, a := setupFn(ctl)
+		pp := NewMockPeerPolicy(ctl)
+		a.s2s = struct {
+			FederatingProtocol
+			RelaySubscriber
+			PeerPolicy
+		}{fp, rs, pp}
+		// Mock
+		rs.EXPECT().IsRelay(ctx, relayIRI).Return(true, nil)
+		c.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tp, nil)
+		db.EXPECT().Lock(ctx, noteIRI)
+		db.EXPECT().Owns(ctx, noteIRI).Return(false, nil)
+		db.EXPECT().Unlock(ctx, noteIRI)
+		pp.EXPECT().FederationPolicy(ctx, noteIRI).Return(PolicyBlock, nil)
+		// Run & Verify
+		relayed, err := a.ingestRelayed(ctx, rs, mustParse(testMyInboxIRI), newAnnounceFn(relayIRI, noteIRI))
+		assertEqual(t, relayed, true)
+		assertEqual(t, err, nil)
+	})
+	t.Run("ReturnsErrorIfNoObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, rs, _, _, a := setupFn(ctl)
+		// Mock
+		rs.EXPECT().IsRelay(ctx, relayIRI).Return(true, nil)
+		// Run & Verify
+		_, err := a.ingestRelayed(ctx, rs, mustParse(testMyInboxIRI), newAnnounceFn(relayIRI))
+		assertEqual(t, err, ErrObjectRequired)
+	})
+}
@@ -0,0 +1,88 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// SignaturePolicy lists the headers that the HTTP Signatures of inbound
+// requests must cover, such as httpsig.RequestTarget, "host", "date", and
+// "digest". Pseudo-headers, such as CreatedPseudoHeader, may also be listed.
+type SignaturePolicy struct {
+	// GetHeaders must be covered by the signatures of GET and HEAD
+	// requests.
+	GetHeaders []string
+	// PostHeaders must be covered by the signatures of POST requests, and
+	// of any other request with a body.
+	PostHeaders []string
+}
+
+// required returns the headers the signature of a request with the method must
+// cover.
+func (p SignaturePolicy) required(method string) []string {
+	if method == http.MethodGet || method == http.MethodHead {
+		return p.GetHeaders
+	}
+	return p.PostHeaders
+}
+
+// SignaturePolicyError is returned by a PolicyVerifier for a request whose
+// HTTP Signature does not cover all of the headers required by its
+// SignaturePolicy.
+type SignaturePolicyError struct {
+	// Method is the method of the rejected request.
+	Method string
+	// Missing are the required headers the signature does not cover.
+	Missing []string
+}
+
+// Error lists the headers missing from the signature.
+func (e SignaturePolicyError) Error() string {
+	return fmt.Sprintf("HTTP Signature of %s request does not cover required headers: %s", e.Method, strings.Join(e.Missing, ", "))
+}
+
+// RequestVerifier must be implemented by PolicyVerifier.
+var _ RequestVerifier = &PolicyVerifier{}
+
+// PolicyVerifier wraps another RequestVerifier, rejecting requests whose HTTP
+// Signature does not cover the headers required by a SignaturePolicy before
+// verifying them.
+type PolicyVerifier struct {
+	verifier RequestVerifier
+	policy   SignaturePolicy
+}
+
+// NewPolicyVerifier returns a RequestVerifier verifying the requests that
+// satisfy the policy with the wrapped RequestVerifier.
+func NewPolicyVerifier(v RequestVerifier, policy SignaturePolicy) *PolicyVerifier {
+	return &PolicyVerifier{
+		verifier: v,
+		policy:   policy,
+	}
+}
+
+// Verify returns a SignaturePolicyError if the request's HTTP Signature does not
+// cover the required headers, and otherwise verifies it with the wrapped
+// RequestVerifier. A violation of the policy is logged with the context's
+// Logger.
+func (p *PolicyVerifier) Verify(c context.Context, r *http.Request) (actor *url.URL, err error) {
+	_, params := signatureHeader(r.Header)
+	var missing []string
+	for _, header := range p.policy.required(r.Method) {
+		if len(params) == 0 || !coversHeader(params, header) {
+			missing = append(missing, strings.ToLower(header))
+		}
+	}
+	if len(missing) > 0 {
+		err = SignaturePolicyError{
+			Method:  r.Method,
+			Missing: missing,
+		}
+		logEvent(c, LogWarn, "rejected HTTP Signature", "url", r.URL.String(), "error", err)
+		return
+	}
+	return p.verifier.Verify(c, r)
+}
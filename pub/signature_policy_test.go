@@ -0,0 +1,86 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+)
+
+func TestPolicyVerifier(t *testing.T) {
+	ctx := context.Background()
+	policy := SignaturePolicy{
+		GetHeaders:  []string{httpsig.RequestTarget, "host", "date"},
+		PostHeaders: []string{httpsig.RequestTarget, "host", "date", "digest"},
+	}
+	newRequestFn := func(method string, headers string) *http.Request {
+		req := httptest.NewRequest(method, testMyInboxIRI, nil)
+		if len(headers) > 0 {
+			req.Header.Set("Signature", `keyId="`+testFederatedKeyId+`",algorithm="hs2019",headers="`+headers+`",signature="c2ln"`)
+		}
+		return req
+	}
+	setupFn := func(ctl *gomock.Controller) (rv *MockRequestVerifier, v *PolicyVerifier) {
+		rv = NewMockRequestVerifier(ctl)
+		v = NewPolicyVerifier(rv, policy)
+		return
+	}
+	t.Run("VerifiesRequestSatisfyingPolicy", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		rv, v := setupFn(ctl)
+		req := newRequestFn("POST", "(request-target) host date digest")
+		// Mock
+		rv.EXPECT().Verify(ctx, req).Return(mustParse(testFederatedActorIRI), nil)
+		// Run & Verify
+		actor, err := v.Verify(ctx, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), testFederatedActorIRI)
+	})
+	t.Run("AppliesGetPolicyToGetRequests", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		rv, v := setupFn(ctl)
+		req := newRequestFn("GET", "(request-target) host date")
+		// Mock
+		rv.EXPECT().Verify(ctx, req).Return(mustParse(testFederatedActorIRI), nil)
+		// Run & Verify
+		_, err := v.Verify(ctx, req)
+		assertEqual(t, err, nil)
+	})
+	t.Run("ErrorIfRequiredHeadersAreNotSigned", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, v := setupFn(ctl)
+		req := newRequestFn("POST", "(request-target) date")
+		// Run
+		actor, err := v.Verify(ctx, req)
+		// Verify
+		assertEqual(t, actor == nil, true)
+		perr, ok := err.(SignaturePolicyError)
+		assertEqual(t, ok, true)
+		assertEqual(t, perr.Method, "POST")
+		assertEqual(t, len(perr.Missing), 2)
+		assertEqual(t, perr.Missing[0], "host")
+		assertEqual(t, perr.Missing[1], "digest")
+	})
+	t.Run("ErrorIfUnsigned", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, v := setupFn(ctl)
+		req := newRequestFn("GET", "")
+		// Run
+		_, err := v.Verify(ctx, req)
+		// Verify
+		perr, ok := err.(SignaturePolicyError)
+		assertEqual(t, ok, true)
+		assertEqual(t, len(perr.Missing), 3)
+	})
+}
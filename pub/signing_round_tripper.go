@@ -0,0 +1,94 @@
+package pub
+
+import (
+	"bytes"
+	"crypto"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/go-fed/httpsig"
+)
+
+// http.RoundTripper must be implemented by SigningRoundTripper.
+var _ http.RoundTripper = &SigningRoundTripper{}
+
+// SigningRoundTripper wraps another http.RoundTripper, adding an HTTP Signature
+// to every request it sends. It lets code outside of a HttpSigTransport, such
+// as fetching media or calling a peer's custom API, use an http.Client whose
+// requests are signed as an actor.
+//
+// Requests without a Date header are given one from the clock, and a Host
+// header is added so that the signature may cover it. Requests with a body are
+// given its Digest, computed with SHA-256 unless the request's context was given
+// another algorithm by WithDigestAlgorithm. The headers covered by the
+// signature are those the signer is configured with, which should include
+// "digest" for requests with a body.
+type SigningRoundTripper struct {
+	next     http.RoundTripper
+	clock    Clock
+	signer   lockedSigner
+	pubKeyId string
+	privKey  crypto.PrivateKey
+}
+
+// NewSigningRoundTripper returns a SigningRoundTripper signing requests with
+// the signer, the private key, and the unique identifier of its public key,
+// before sending them with the wrapped http.RoundTripper. A nil rt sends them
+// with http.DefaultTransport.
+func NewSigningRoundTripper(rt http.RoundTripper, clock Clock, signer httpsig.Signer, pubKeyId string, privKey crypto.PrivateKey) *SigningRoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &SigningRoundTripper{
+		next:     rt,
+		clock:    clock,
+		signer:   lockSigners([]httpsig.Signer{signer})[0],
+		pubKeyId: pubKeyId,
+		privKey:  privKey,
+	}
+}
+
+// RoundTrip signs a copy of the request and sends it with the wrapped
+// http.RoundTripper. The request itself is not modified, except that its body
+// is read and closed.
+func (s *SigningRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	signed := new(http.Request)
+	*signed = *req
+	signed.Header = make(http.Header, len(req.Header)+3)
+	for k, v := range req.Header {
+		signed.Header[k] = append([]string(nil), v...)
+	}
+	if len(signed.Header.Get("Host")) == 0 {
+		host := req.Host
+		if len(host) == 0 {
+			host = req.URL.Host
+		}
+		signed.Header.Set("Host", host)
+	}
+	if len(signed.Header.Get(dateHeader)) == 0 {
+		signed.Header.Set(dateHeader, s.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		signed.Body = ioutil.NopCloser(bytes.NewReader(body))
+		signed.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+		signed.ContentLength = int64(len(body))
+		if err := addDigest(signed.Header, digestAlgorithmFromContext(req.Context()), body); err != nil {
+			return nil, err
+		}
+	}
+	s.signer.mu.Lock()
+	err := s.signer.signer.SignRequest(s.privKey, s.pubKeyId, signed, nil)
+	s.signer.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return s.next.RoundTrip(signed)
+}
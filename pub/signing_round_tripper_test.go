@@ -0,0 +1,83 @@
+package pub
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+)
+
+// roundTripperFunc is an http.RoundTripper calling the function.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls the function.
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestSigningRoundTripper(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setupFn := func(ctl *gomock.Controller, headers []string, sent **http.Request) (c *MockClock, rt *SigningRoundTripper) {
+		c = NewMockClock(ctl)
+		s := NewHs2019Signer(httpsig.DigestSha256, headers, httpsig.Signature)
+		rt = NewSigningRoundTripper(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			*sent = r
+			return newTestResponse(http.StatusOK, nil), nil
+		}), c, s, testFederatedKeyId, priv)
+		return
+	}
+	t.Run("SignsRequestWithDigest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		var sent *http.Request
+		c, rt := setupFn(ctl, []string{httpsig.RequestTarget, "host", "date", "digest"}, &sent)
+		req, err := http.NewRequest("POST", testFederatedInboxIRI, bytes.NewReader(testRespBody))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Mock
+		c.EXPECT().Now().Return(now())
+		// Run
+		_, err = rt.RoundTrip(req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(req.Header.Get("Signature")), 0)
+		assertEqual(t, verifyDigest(sent.Header, testRespBody), nil)
+		assertEqual(t, verifySignature(sent.Header, sent, &priv.PublicKey, httpsig.RSA_SHA256), nil)
+		b, err := sent.GetBody()
+		assertEqual(t, err, nil)
+		var buf bytes.Buffer
+		buf.ReadFrom(b)
+		assertByteEqual(t, buf.Bytes(), testRespBody)
+	})
+	t.Run("KeepsDateHeader", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		var sent *http.Request
+		_, rt := setupFn(ctl, []string{httpsig.RequestTarget, "host", "date"}, &sent)
+		req, err := http.NewRequest("GET", testNoteId1, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		date := now().Add(-time.Minute).UTC().Format(http.TimeFormat)
+		req.Header.Set(dateHeader, date)
+		// Run
+		_, err = rt.RoundTrip(req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, sent.Header.Get(dateHeader), date)
+		assertEqual(t, sent.Header.Get("Host"), mustParse(testNoteId1).Host)
+		assertEqual(t, len(sent.Header.Get(digestHeader)), 0)
+		assertEqual(t, verifySignature(sent.Header, sent, &priv.PublicKey, httpsig.RSA_SHA256), nil)
+	})
+}
@@ -69,20 +69,107 @@ type SocialWrappedCallbacks struct {
 	// It enforces that the actors on the Undo must correspond to all of the
 	// 'object' actors in some manner.
 	//
+	// When a 'Like' is undone, its objects are removed from the actor's
+	// 'liked' collection. When a 'Follow' is undone, its objects are
+	// removed from the actor's 'following' collection.
+	//
+	// An 'Announce' has no side effect here to reverse: the 'shares' of
+	// its object are kept by the server owning the object, which receives
+	// the Undo. When a 'Block' is undone, the application must stop
+	// reporting its objects in the FederatingProtocol's Blocked method. The
+	// followers and following removed by the Block are not restored; the
+	// actors must follow each other again.
+	//
 	// It is expected that the application will implement the proper
-	// reversal of activities that are being undone.
+	// reversal of other activities that are being undone.
 	Undo func(context.Context, vocab.ActivityStreamsUndo) error
 	// Block handles additional side effects for the Block ActivityStreams
 	// type.
 	//
-	// The wrapping callback only ensures the 'Block' has at least one
-	// 'object' entry, but otherwise has no default side effect. It is up
-	// to the wrapped application function to properly enforce the new
-	// blocking behavior.
+	// The wrapping callback ensures the 'Block' has at least one 'object'
+	// entry, and removes its objects from the actor's 'followers' and
+	// 'following' collections. It is up to the wrapped application
+	// function to properly enforce the new blocking behavior.
 	//
 	// Note that go-fed does not federate 'Block' activities received in the
 	// Social Protocol.
+	//
+	// The application should record the block so that the
+	// FederatingProtocol's Blocked method reports the blocked actors. Then
+	// their activities are rejected from the inbox, and activities are no
+	// longer delivered to them.
 	Block func(context.Context, vocab.ActivityStreamsBlock) error
+	// Move handles additional side effects for the Move ActivityStreams
+	// type.
+	//
+	// The wrapping function ensures the 'Move' has at least one 'object'
+	// entry. If the 'object' is an actor on this server, the wrapping
+	// function also verifies that the 'target' lists the actor in its
+	// 'alsoKnownAs' property, sets the actor's 'movedTo' property to the
+	// 'target', and addresses the Move to the actor's followers.
+	Move func(context.Context, vocab.ActivityStreamsMove) error
+	// Flag handles additional side effects for the Flag ActivityStreams
+	// type.
+	//
+	// The wrapping function only ensures the 'Flag' has at least one
+	// 'object' entry, but otherwise has no default side effect. It is up
+	// to the wrapped application function to handle the report.
+	Flag func(context.Context, vocab.ActivityStreamsFlag) error
+	// View handles additional side effects for the View ActivityStreams
+	// type.
+	//
+	// The wrapping function only ensures the 'View' has at least one
+	// 'object' entry, but otherwise has no default side effect.
+	View func(context.Context, vocab.ActivityStreamsView) error
+	// Listen handles additional side effects for the Listen ActivityStreams
+	// type.
+	//
+	// The wrapping function only ensures the 'Listen' has at least one
+	// 'object' entry, but otherwise has no default side effect.
+	Listen func(context.Context, vocab.ActivityStreamsListen) error
+	// Read handles additional side effects for the Read ActivityStreams
+	// type.
+	//
+	// The wrapping function only ensures the 'Read' has at least one
+	// 'object' entry, but otherwise has no default side effect.
+	Read func(context.Context, vocab.ActivityStreamsRead) error
+	// TentativeAccept handles additional side effects for the TentativeAccept
+	// ActivityStreams type.
+	//
+	// The wrapping function only ensures the 'TentativeAccept' has at least
+	// one 'object' entry, but otherwise has no default side effect.
+	TentativeAccept func(context.Context, vocab.ActivityStreamsTentativeAccept) error
+	// TentativeReject handles additional side effects for the TentativeReject
+	// ActivityStreams type.
+	//
+	// The wrapping function only ensures the 'TentativeReject' has at least
+	// one 'object' entry, but otherwise has no default side effect.
+	TentativeReject func(context.Context, vocab.ActivityStreamsTentativeReject) error
+	// Offer handles additional side effects for the Offer ActivityStreams
+	// type.
+	//
+	// The wrapping function only ensures the 'Offer' has at least one
+	// 'object' entry, but otherwise has no default side effect.
+	Offer func(context.Context, vocab.ActivityStreamsOffer) error
+	// Invite handles additional side effects for the Invite ActivityStreams
+	// type.
+	//
+	// The wrapping function only ensures the 'Invite' has at least one
+	// 'object' entry, but otherwise has no default side effect.
+	Invite func(context.Context, vocab.ActivityStreamsInvite) error
+	// Question handles additional side effects for the Question
+	// ActivityStreams type.
+	//
+	// The wrapping function ensures the 'Question' does not have both
+	// 'oneOf' and 'anyOf' entries, but otherwise has no default side
+	// effect.
+	Question func(context.Context, vocab.ActivityStreamsQuestion) error
+	// Travel handles additional side effects for the Travel ActivityStreams
+	// type.
+	//
+	// The wrapping function provides no default side effects. It simply
+	// calls the wrapped function.
+	Travel func(context.Context, vocab.ActivityStreamsTravel) error
 
 	// Sidechannel data -- this is set at request handling time. These must
 	// be set before the callbacks are used.
@@ -122,6 +209,17 @@ func (w SocialWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 	enableLike := true
 	enableUndo := true
 	enableBlock := true
+	enableMove := true
+	enableFlag := true
+	enableView := true
+	enableListen := true
+	enableRead := true
+	enableTentativeAccept := true
+	enableTentativeReject := true
+	enableOffer := true
+	enableInvite := true
+	enableQuestion := true
+	enableTravel := true
 	for _, fn := range fns {
 		switch fn.(type) {
 		default:
@@ -144,6 +242,28 @@ func (w SocialWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 			enableUndo = false
 		case func(context.Context, vocab.ActivityStreamsBlock) error:
 			enableBlock = false
+		case func(context.Context, vocab.ActivityStreamsMove) error:
+			enableMove = false
+		case func(context.Context, vocab.ActivityStreamsFlag) error:
+			enableFlag = false
+		case func(context.Context, vocab.ActivityStreamsView) error:
+			enableView = false
+		case func(context.Context, vocab.ActivityStreamsListen) error:
+			enableListen = false
+		case func(context.Context, vocab.ActivityStreamsRead) error:
+			enableRead = false
+		case func(context.Context, vocab.ActivityStreamsTentativeAccept) error:
+			enableTentativeAccept = false
+		case func(context.Context, vocab.ActivityStreamsTentativeReject) error:
+			enableTentativeReject = false
+		case func(context.Context, vocab.ActivityStreamsOffer) error:
+			enableOffer = false
+		case func(context.Context, vocab.ActivityStreamsInvite) error:
+			enableInvite = false
+		case func(context.Context, vocab.ActivityStreamsQuestion) error:
+			enableQuestion = false
+		case func(context.Context, vocab.ActivityStreamsTravel) error:
+			enableTravel = false
 		}
 	}
 	if enableCreate {
@@ -173,6 +293,39 @@ func (w SocialWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 	if enableBlock {
 		fns = append(fns, w.block)
 	}
+	if enableMove {
+		fns = append(fns, w.move)
+	}
+	if enableFlag {
+		fns = append(fns, w.flag)
+	}
+	if enableView {
+		fns = append(fns, w.view)
+	}
+	if enableListen {
+		fns = append(fns, w.listen)
+	}
+	if enableRead {
+		fns = append(fns, w.read)
+	}
+	if enableTentativeAccept {
+		fns = append(fns, w.tentativeAccept)
+	}
+	if enableTentativeReject {
+		fns = append(fns, w.tentativeReject)
+	}
+	if enableOffer {
+		fns = append(fns, w.offer)
+	}
+	if enableInvite {
+		fns = append(fns, w.invite)
+	}
+	if enableQuestion {
+		fns = append(fns, w.question)
+	}
+	if enableTravel {
+		fns = append(fns, w.travel)
+	}
 	return fns
 }
 
@@ -511,12 +664,69 @@ func (w SocialWrappedCallbacks) undo(c context.Context, a vocab.ActivityStreamsU
 	if err := mustHaveActivityActorsMatchObjectActors(c, actors, op, w.newTransport, w.outboxIRI); err != nil {
 		return err
 	}
+	if err := w.undoSideEffects(c, op); err != nil {
+		return err
+	}
 	if w.Undo != nil {
 		return w.Undo(c, a)
 	}
 	return nil
 }
 
+// undoSideEffects reverses the side effects of the Like and Follow activities
+// being undone: their objects are removed from this actor's 'liked' and
+// 'following' collections, respectively. Other activities, such as an Announce
+// or Block, have no side effects kept by this actor to reverse.
+//
+// Activities given only by IRI are fetched from the database.
+func (w SocialWrappedCallbacks) undoSideEffects(c context.Context, op vocab.ActivityStreamsObjectProperty) error {
+	// Get this actor's IRI.
+	if err := w.db.Lock(c, w.outboxIRI); err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	actorIRI, err := w.db.ActorForOutbox(c, w.outboxIRI)
+	if err != nil {
+		w.db.Unlock(c, w.outboxIRI)
+		return err
+	}
+	w.db.Unlock(c, w.outboxIRI)
+	// Unlock must be called by now and every branch above.
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		t := iter.GetType()
+		if t == nil {
+			id, err := ToId(iter)
+			if err != nil {
+				return err
+			}
+			if err = w.db.Lock(c, id); err != nil {
+				return err
+			}
+			t, err = w.db.Get(c, id)
+			w.db.Unlock(c, id)
+			if err != nil {
+				return err
+			}
+		}
+		var collection func(context.Context, *url.URL) (vocab.ActivityStreamsCollection, error)
+		if streams.IsOrExtendsActivityStreamsLike(t) {
+			collection = w.db.Liked
+		} else if streams.IsOrExtendsActivityStreamsFollow(t) {
+			collection = w.db.Following
+		} else {
+			continue
+		}
+		o, ok := t.(objecter)
+		if !ok {
+			return fmt.Errorf("cannot undo %T: it has no 'object' property", t)
+		}
+		if err := removeFromActorCollection(c, w.db, actorIRI, collection, o.GetActivityStreamsObject()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // block implements the social Block activity side effects.
 func (w SocialWrappedCallbacks) block(c context.Context, a vocab.ActivityStreamsBlock) error {
 	*w.undeliverable = true
@@ -524,8 +734,259 @@ func (w SocialWrappedCallbacks) block(c context.Context, a vocab.ActivityStreams
 	if op == nil || op.Len() == 0 {
 		return ErrObjectRequired
 	}
+	// Get this actor's IRI.
+	if err := w.db.Lock(c, w.outboxIRI); err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	actorIRI, err := w.db.ActorForOutbox(c, w.outboxIRI)
+	if err != nil {
+		w.db.Unlock(c, w.outboxIRI)
+		return err
+	}
+	w.db.Unlock(c, w.outboxIRI)
+	// Unlock must be called by now and every branch above.
+	//
+	// The blocked actors no longer follow, nor are followed by, this actor.
+	if err := removeFromActorCollection(c, w.db, actorIRI, w.db.Followers, op); err != nil {
+		return err
+	}
+	if err := removeFromActorCollection(c, w.db, actorIRI, w.db.Following, op); err != nil {
+		return err
+	}
 	if w.Block != nil {
 		return w.Block(c, a)
 	}
 	return nil
 }
+
+// move implements the social Move activity side effects.
+func (w SocialWrappedCallbacks) move(c context.Context, a vocab.ActivityStreamsMove) error {
+	*w.undeliverable = false
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if op.Len() == 1 {
+		if err := w.moveOwnedActor(c, a); err != nil {
+			return err
+		}
+	}
+	if w.Move != nil {
+		return w.Move(c, a)
+	}
+	return nil
+}
+
+// moveOwnedActor migrates the actor that is the 'object' of the Move to the
+// Move's 'target', if the actor is owned by this server.
+func (w SocialWrappedCallbacks) moveOwnedActor(c context.Context, a vocab.ActivityStreamsMove) error {
+	origin, err := ToId(a.GetActivityStreamsObject().At(0))
+	if err != nil {
+		return err
+	}
+	if err := w.db.Lock(c, origin); err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	owns, err := w.db.Owns(c, origin)
+	if err != nil || !owns {
+		w.db.Unlock(c, origin)
+		return err
+	}
+	t, err := w.db.Get(c, origin)
+	if err != nil {
+		w.db.Unlock(c, origin)
+		return err
+	}
+	w.db.Unlock(c, origin)
+	// Unlock must be called by now and every branch above.
+	actor, ok := t.(movedToer)
+	if !ok {
+		// Only actors are moved.
+		return nil
+	}
+	_, target, err := verifyMove(c, a, w.fetchMoveTarget)
+	if err != nil {
+		return err
+	}
+	movedTo := streams.NewActivityStreamsMovedToProperty()
+	movedTo.SetIRI(target)
+	actor.SetActivityStreamsMovedTo(movedTo)
+	if err := w.db.Lock(c, origin); err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	if err := w.db.Update(c, t); err != nil {
+		w.db.Unlock(c, origin)
+		return err
+	}
+	w.db.Unlock(c, origin)
+	// Unlock must be called by now and every branch above.
+	followers := followersIRI(t)
+	if followers == nil {
+		return nil
+	}
+	to := a.GetActivityStreamsTo()
+	if to == nil {
+		to = streams.NewActivityStreamsToProperty()
+		a.SetActivityStreamsTo(to)
+	}
+	for iter := to.Begin(); iter != to.End(); iter = iter.Next() {
+		if id, err := ToId(iter); err == nil && id.String() == followers.String() {
+			return nil
+		}
+	}
+	to.AppendIRI(followers)
+	return nil
+}
+
+// fetchMoveTarget obtains the target of a Move from the database if it is
+// owned by this server, or from its peer otherwise.
+func (w SocialWrappedCallbacks) fetchMoveTarget(c context.Context, iri *url.URL) (vocab.Type, error) {
+	if err := w.db.Lock(c, iri); err != nil {
+		return nil, err
+	}
+	// WARNING: Unlock not deferred.
+	owns, err := w.db.Owns(c, iri)
+	if err != nil {
+		w.db.Unlock(c, iri)
+		return nil, err
+	} else if owns {
+		t, err := w.db.Get(c, iri)
+		w.db.Unlock(c, iri)
+		return t, err
+	}
+	w.db.Unlock(c, iri)
+	// Unlock must be called by now and every branch above.
+	tport, err := w.newTransport(c, w.outboxIRI, goFedUserAgent())
+	if err != nil {
+		return nil, err
+	}
+	return dereferenceType(c, tport, iri)
+}
+
+// flag implements the social Flag activity side effects.
+func (w SocialWrappedCallbacks) flag(c context.Context, a vocab.ActivityStreamsFlag) error {
+	*w.undeliverable = false
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Flag != nil {
+		return w.Flag(c, a)
+	}
+	return nil
+}
+
+// view implements the social View activity side effects.
+func (w SocialWrappedCallbacks) view(c context.Context, a vocab.ActivityStreamsView) error {
+	*w.undeliverable = false
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.View != nil {
+		return w.View(c, a)
+	}
+	return nil
+}
+
+// listen implements the social Listen activity side effects.
+func (w SocialWrappedCallbacks) listen(c context.Context, a vocab.ActivityStreamsListen) error {
+	*w.undeliverable = false
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Listen != nil {
+		return w.Listen(c, a)
+	}
+	return nil
+}
+
+// read implements the social Read activity side effects.
+func (w SocialWrappedCallbacks) read(c context.Context, a vocab.ActivityStreamsRead) error {
+	*w.undeliverable = false
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Read != nil {
+		return w.Read(c, a)
+	}
+	return nil
+}
+
+// tentativeAccept implements the social TentativeAccept activity side effects.
+func (w SocialWrappedCallbacks) tentativeAccept(c context.Context, a vocab.ActivityStreamsTentativeAccept) error {
+	*w.undeliverable = false
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.TentativeAccept != nil {
+		return w.TentativeAccept(c, a)
+	}
+	return nil
+}
+
+// tentativeReject implements the social TentativeReject activity side effects.
+func (w SocialWrappedCallbacks) tentativeReject(c context.Context, a vocab.ActivityStreamsTentativeReject) error {
+	*w.undeliverable = false
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.TentativeReject != nil {
+		return w.TentativeReject(c, a)
+	}
+	return nil
+}
+
+// offer implements the social Offer activity side effects.
+func (w SocialWrappedCallbacks) offer(c context.Context, a vocab.ActivityStreamsOffer) error {
+	*w.undeliverable = false
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Offer != nil {
+		return w.Offer(c, a)
+	}
+	return nil
+}
+
+// invite implements the social Invite activity side effects.
+func (w SocialWrappedCallbacks) invite(c context.Context, a vocab.ActivityStreamsInvite) error {
+	*w.undeliverable = false
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Invite != nil {
+		return w.Invite(c, a)
+	}
+	return nil
+}
+
+// question implements the social Question activity side effects.
+func (w SocialWrappedCallbacks) question(c context.Context, a vocab.ActivityStreamsQuestion) error {
+	*w.undeliverable = false
+	if oneOf, anyOf := a.GetActivityStreamsOneOf(), a.GetActivityStreamsAnyOf(); oneOf != nil && oneOf.Len() > 0 && anyOf != nil && anyOf.Len() > 0 {
+		return ErrOneOfAndAnyOf
+	}
+	if w.Question != nil {
+		return w.Question(c, a)
+	}
+	return nil
+}
+
+// travel implements the social Travel activity side effects.
+func (w SocialWrappedCallbacks) travel(c context.Context, a vocab.ActivityStreamsTravel) error {
+	*w.undeliverable = false
+	if w.Travel != nil {
+		return w.Travel(c, a)
+	}
+	return nil
+}
@@ -0,0 +1,390 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+)
+
+func TestSocialUndo(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (w SocialWrappedCallbacks, db *MockDatabase, tp *MockTransport) {
+		setupData()
+		db = NewMockDatabase(ctl)
+		tp = NewMockTransport(ctl)
+		undeliverable := true
+		w = SocialWrappedCallbacks{
+			db:        db,
+			outboxIRI: mustParse(testMyOutboxIRI),
+			newTransport: func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error) {
+				return tp, nil
+			},
+			undeliverable: &undeliverable,
+		}
+		return
+	}
+	newActivityFn := func(a interface {
+		vocab.Type
+		SetActivityStreamsActor(vocab.ActivityStreamsActorProperty)
+		SetActivityStreamsObject(vocab.ActivityStreamsObjectProperty)
+	}, object *url.URL) {
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse(testNewActivityIRI))
+		a.SetJSONLDId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testPersonIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(object)
+		a.SetActivityStreamsObject(op)
+	}
+	newUndoFn := func(set func(vocab.ActivityStreamsObjectProperty)) vocab.ActivityStreamsUndo {
+		u := streams.NewActivityStreamsUndo()
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testPersonIRI))
+		u.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		set(op)
+		u.SetActivityStreamsObject(op)
+		return u
+	}
+	newCollectionFn := func(iris ...string) vocab.ActivityStreamsCollection {
+		col := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		for _, iri := range iris {
+			items.AppendIRI(mustParse(iri))
+		}
+		col.SetActivityStreamsItems(items)
+		return col
+	}
+	t.Run("RemovesLikedObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, db, tp := setupFn(ctl)
+		like := streams.NewActivityStreamsLike()
+		newActivityFn(like, mustParse(testNoteId1))
+		undo := newUndoFn(func(op vocab.ActivityStreamsObjectProperty) {
+			op.AppendActivityStreamsLike(like)
+		})
+		liked := newCollectionFn(testNoteId1, testNoteId2)
+		expectLiked := newCollectionFn(testNoteId2)
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testNewActivityIRI)).Return(
+			mustSerializeToBytes(like), nil)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI)),
+			db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
+				mustParse(testPersonIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Liked(ctx, mustParse(testPersonIRI)).Return(liked, nil),
+			db.EXPECT().Update(ctx, expectLiked),
+			db.EXPECT().Unlock(ctx, mustParse(testPersonIRI)),
+		)
+		// Run & Verify
+		err := w.undo(ctx, undo)
+		assertEqual(t, err, nil)
+		assertEqual(t, *w.undeliverable, false)
+	})
+	t.Run("RemovesFollowedActorFromActivityIRI", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, db, tp := setupFn(ctl)
+		follow := streams.NewActivityStreamsFollow()
+		newActivityFn(follow, mustParse(testFederatedActorIRI))
+		undo := newUndoFn(func(op vocab.ActivityStreamsObjectProperty) {
+			op.AppendIRI(mustParse(testNewActivityIRI))
+		})
+		following := newCollectionFn(testFederatedActorIRI2, testFederatedActorIRI)
+		expectFollowing := newCollectionFn(testFederatedActorIRI2)
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testNewActivityIRI)).Return(
+			mustSerializeToBytes(follow), nil)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI)),
+			db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
+				mustParse(testPersonIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testNewActivityIRI)),
+			db.EXPECT().Get(ctx, mustParse(testNewActivityIRI)).Return(follow, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testNewActivityIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Following(ctx, mustParse(testPersonIRI)).Return(following, nil),
+			db.EXPECT().Update(ctx, expectFollowing),
+			db.EXPECT().Unlock(ctx, mustParse(testPersonIRI)),
+		)
+		// Run & Verify
+		err := w.undo(ctx, undo)
+		assertEqual(t, err, nil)
+	})
+	t.Run("CallsCustomCallbackForOtherActivities", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, db, tp := setupFn(ctl)
+		block := streams.NewActivityStreamsBlock()
+		newActivityFn(block, mustParse(testFederatedActorIRI))
+		undo := newUndoFn(func(op vocab.ActivityStreamsObjectProperty) {
+			op.AppendActivityStreamsBlock(block)
+		})
+		var got vocab.ActivityStreamsUndo
+		w.Undo = func(c context.Context, v vocab.ActivityStreamsUndo) error {
+			got = v
+			return nil
+		}
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testNewActivityIRI)).Return(
+			mustSerializeToBytes(block), nil)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI)),
+			db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
+				mustParse(testPersonIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI)),
+		)
+		// Run & Verify
+		err := w.undo(ctx, undo)
+		assertEqual(t, err, nil)
+		assertEqual(t, got, undo)
+	})
+	t.Run("ErrorIfActorsDoNotMatch", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, _, tp := setupFn(ctl)
+		like := streams.NewActivityStreamsLike()
+		newActivityFn(like, mustParse(testNoteId1))
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		like.SetActivityStreamsActor(actor)
+		undo := newUndoFn(func(op vocab.ActivityStreamsObjectProperty) {
+			op.AppendActivityStreamsLike(like)
+		})
+		// Mock
+		tp.EXPECT().Dereference(ctx, mustParse(testNewActivityIRI)).Return(
+			mustSerializeToBytes(like), nil)
+		// Run & Verify
+		err := w.undo(ctx, undo)
+		if err == nil {
+			t.Fatalf("expected error, got none")
+		}
+	})
+}
+
+func TestSocialBlock(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (w SocialWrappedCallbacks, db *MockDatabase) {
+		setupData()
+		db = NewMockDatabase(ctl)
+		undeliverable := false
+		w = SocialWrappedCallbacks{
+			db:            db,
+			outboxIRI:     mustParse(testMyOutboxIRI),
+			undeliverable: &undeliverable,
+		}
+		return
+	}
+	newCollectionFn := func(iris ...string) vocab.ActivityStreamsCollection {
+		col := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		for _, iri := range iris {
+			items.AppendIRI(mustParse(iri))
+		}
+		col.SetActivityStreamsItems(items)
+		return col
+	}
+	newBlockFn := func() vocab.ActivityStreamsBlock {
+		b := streams.NewActivityStreamsBlock()
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testPersonIRI))
+		b.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testFederatedActorIRI))
+		b.SetActivityStreamsObject(op)
+		return b
+	}
+	t.Run("RemovesBlockedActorFromFollowersAndFollowing", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, db := setupFn(ctl)
+		block := newBlockFn()
+		var got vocab.ActivityStreamsBlock
+		w.Block = func(c context.Context, v vocab.ActivityStreamsBlock) error {
+			got = v
+			return nil
+		}
+		followers := newCollectionFn(testFederatedActorIRI, testFederatedActorIRI2)
+		following := newCollectionFn(testFederatedActorIRI2, testFederatedActorIRI)
+		// Mock
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI)),
+			db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(
+				mustParse(testPersonIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Followers(ctx, mustParse(testPersonIRI)).Return(followers, nil),
+			db.EXPECT().Update(ctx, newCollectionFn(testFederatedActorIRI2)),
+			db.EXPECT().Unlock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Following(ctx, mustParse(testPersonIRI)).Return(following, nil),
+			db.EXPECT().Update(ctx, newCollectionFn(testFederatedActorIRI2)),
+			db.EXPECT().Unlock(ctx, mustParse(testPersonIRI)),
+		)
+		// Run & Verify
+		err := w.block(ctx, block)
+		assertEqual(t, err, nil)
+		assertEqual(t, *w.undeliverable, true)
+		assertEqual(t, got, block)
+	})
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, _ := setupFn(ctl)
+		block := newBlockFn()
+		block.SetActivityStreamsObject(nil)
+		// Run & Verify
+		err := w.block(ctx, block)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+}
+
+func TestSocialMove(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (w SocialWrappedCallbacks, db *MockDatabase, tp *MockTransport) {
+		setupData()
+		db = NewMockDatabase(ctl)
+		tp = NewMockTransport(ctl)
+		undeliverable := true
+		w = SocialWrappedCallbacks{
+			db:        db,
+			outboxIRI: mustParse(testMyOutboxIRI),
+			newTransport: func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error) {
+				return tp, nil
+			},
+			undeliverable: &undeliverable,
+		}
+		return
+	}
+	newMoveFn := func() vocab.ActivityStreamsMove {
+		a := streams.NewActivityStreamsMove()
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testPersonIRI))
+		a.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testPersonIRI))
+		a.SetActivityStreamsObject(op)
+		target := streams.NewActivityStreamsTargetProperty()
+		target.AppendIRI(mustParse(testFederatedActorIRI))
+		a.SetActivityStreamsTarget(target)
+		return a
+	}
+	newPersonFn := func(id string, alsoKnownAs ...string) vocab.ActivityStreamsPerson {
+		p := streams.NewActivityStreamsPerson()
+		idp := streams.NewJSONLDIdProperty()
+		idp.Set(mustParse(id))
+		p.SetJSONLDId(idp)
+		followers := streams.NewActivityStreamsFollowersProperty()
+		followers.SetIRI(mustParse(id + "/followers"))
+		p.SetActivityStreamsFollowers(followers)
+		if len(alsoKnownAs) > 0 {
+			aka := streams.NewActivityStreamsAlsoKnownAsProperty()
+			for _, iri := range alsoKnownAs {
+				aka.AppendIRI(mustParse(iri))
+			}
+			p.SetActivityStreamsAlsoKnownAs(aka)
+		}
+		return p
+	}
+	t.Run("MovesOwnedActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, db, tp := setupFn(ctl)
+		a := newMoveFn()
+		expectActor := newPersonFn(testPersonIRI)
+		movedTo := streams.NewActivityStreamsMovedToProperty()
+		movedTo.SetIRI(mustParse(testFederatedActorIRI))
+		expectActor.SetActivityStreamsMovedTo(movedTo)
+		// Mock
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testPersonIRI)).Return(true, nil),
+			db.EXPECT().Get(ctx, mustParse(testPersonIRI)).Return(newPersonFn(testPersonIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActorIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testFederatedActorIRI)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActorIRI)),
+			tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
+				mustSerializeToBytes(newPersonFn(testFederatedActorIRI, testPersonIRI)), nil),
+			db.EXPECT().Lock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Update(ctx, expectActor),
+			db.EXPECT().Unlock(ctx, mustParse(testPersonIRI)),
+		)
+		// Run & Verify
+		err := w.move(ctx, a)
+		assertEqual(t, err, nil)
+		assertEqual(t, *w.undeliverable, false)
+		to := a.GetActivityStreamsTo()
+		assertNotEqual(t, to, nil)
+		assertEqual(t, to.Len(), 1)
+		assertEqual(t, to.At(0).GetIRI().String(), testPersonIRI+"/followers")
+	})
+	t.Run("ErrorIfTargetDoesNotReferenceActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, db, tp := setupFn(ctl)
+		a := newMoveFn()
+		// Mock
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testPersonIRI)).Return(true, nil),
+			db.EXPECT().Get(ctx, mustParse(testPersonIRI)).Return(newPersonFn(testPersonIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActorIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testFederatedActorIRI)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActorIRI)),
+			tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(
+				mustSerializeToBytes(newPersonFn(testFederatedActorIRI)), nil),
+		)
+		// Run & Verify
+		err := w.move(ctx, a)
+		assertNotEqual(t, err, nil)
+		assertEqual(t, a.GetActivityStreamsTo(), nil)
+	})
+	t.Run("IgnoresObjectNotOwned", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, db, _ := setupFn(ctl)
+		a := newMoveFn()
+		// Mock
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testPersonIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testPersonIRI)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testPersonIRI)),
+		)
+		// Run & Verify
+		err := w.move(ctx, a)
+		assertEqual(t, err, nil)
+		assertEqual(t, a.GetActivityStreamsTo(), nil)
+	})
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		w, _, _ := setupFn(ctl)
+		a := newMoveFn()
+		a.SetActivityStreamsObject(nil)
+		// Run & Verify
+		err := w.move(ctx, a)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+}
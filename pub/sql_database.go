@@ -0,0 +1,475 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+var _ TransactionalDatabase = &SQLDatabase{}
+
+// OutboxPager must be implemented by SQLDatabase.
+var _ OutboxPager = &SQLDatabase{}
+
+// SQLDialect is the placeholder syntax of the bind parameters accepted by a
+// database/sql driver.
+type SQLDialect int
+
+const (
+	// SQLDialectQuestion uses "?" placeholders, as used by SQLite and
+	// MySQL drivers.
+	SQLDialectQuestion SQLDialect = iota
+	// SQLDialectDollar uses "$1", "$2", ... placeholders, as used by
+	// PostgreSQL drivers.
+	SQLDialectDollar
+)
+
+// sqlSchema are the statements creating the tables used by a SQLDatabase.
+//
+// pub_objects holds the serialized ActivityStreams values, including the
+// 'followers', 'following', and 'liked' collections. pub_actor_boxes maps
+// actors to their inbox and outbox. pub_box_items holds the ordered items of
+// every inbox and outbox, with position 0 being the first item.
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS pub_objects (
+	id TEXT PRIMARY KEY,
+	payload TEXT NOT NULL
+)`,
+	`CREATE TABLE IF NOT EXISTS pub_actor_boxes (
+	actor_id TEXT PRIMARY KEY,
+	inbox TEXT UNIQUE,
+	outbox TEXT UNIQUE
+)`,
+	`CREATE TABLE IF NOT EXISTS pub_box_items (
+	box TEXT NOT NULL,
+	position INTEGER NOT NULL,
+	item TEXT NOT NULL,
+	PRIMARY KEY (box, position)
+)`,
+}
+
+const (
+	sqlSelectPayload     = "SELECT payload FROM pub_objects WHERE id = ?"
+	sqlCountObjects      = "SELECT COUNT(*) FROM pub_objects WHERE id = ?"
+	sqlInsertObject      = "INSERT INTO pub_objects (id, payload) VALUES (?, ?)"
+	sqlUpdateObject      = "UPDATE pub_objects SET payload = ? WHERE id = ?"
+	sqlDeleteObject      = "DELETE FROM pub_objects WHERE id = ?"
+	sqlCountBoxes        = "SELECT COUNT(*) FROM pub_actor_boxes WHERE inbox = ? OR outbox = ?"
+	sqlSelectInboxActor  = "SELECT actor_id FROM pub_actor_boxes WHERE inbox = ?"
+	sqlSelectOutboxActor = "SELECT actor_id FROM pub_actor_boxes WHERE outbox = ?"
+	sqlSelectInboxOutbox = "SELECT outbox FROM pub_actor_boxes WHERE inbox = ?"
+	sqlDeleteActorBoxes  = "DELETE FROM pub_actor_boxes WHERE actor_id = ?"
+	sqlInsertActorBoxes  = "INSERT INTO pub_actor_boxes (actor_id, inbox, outbox) VALUES (?, ?, ?)"
+	sqlCountBoxItem      = "SELECT COUNT(*) FROM pub_box_items WHERE box = ? AND item = ?"
+	sqlSelectBoxItems    = "SELECT item FROM pub_box_items WHERE box = ? ORDER BY position"
+	sqlSelectBoxRange    = "SELECT item FROM pub_box_items WHERE box = ? ORDER BY position LIMIT ? OFFSET ?"
+	sqlCountBoxItems     = "SELECT COUNT(*) FROM pub_box_items WHERE box = ?"
+	sqlDeleteBoxItems    = "DELETE FROM pub_box_items WHERE box = ?"
+	sqlInsertBoxItem     = "INSERT INTO pub_box_items (box, position, item) VALUES (?, ?, ?)"
+)
+
+// sqlNewIDRandomByteSize is the number of random bytes in an id created by a
+// SQLDatabase.
+const sqlNewIDRandomByteSize = 16
+
+// sqlTxKey is the context key of the transaction begun by a SQLDatabase.
+type sqlTxKey struct{}
+
+// sqlQueryer is the part of a sql.DB or sql.Tx used to run queries.
+type sqlQueryer interface {
+	ExecContext(c context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(c context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(c context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// SQLDatabase is a Database backed by a database/sql database. It may be used
+// directly, or as a reference when writing an application's own Database.
+//
+// The tables are created by CreateTables, and are written in SQL accepted by
+// SQLite and PostgreSQL. Other databases may need a schema of their own.
+//
+// It is a TransactionalDatabase, so the side effects of each activity are
+// applied atomically. The locks of Lock and Unlock are held in the process,
+// so only one process may use the database at a time.
+//
+// Actors stored with an 'inbox' and 'outbox' are mapped to them, and the
+// entire inbox and outbox are returned as a single page. When an actor has no
+// 'followers', 'following', or 'liked' IRI, the collection is stored at the
+// actor's IRI with "/followers", "/following", or "/liked" appended.
+type SQLDatabase struct {
+	db      *sql.DB
+	base    *url.URL
+	dialect SQLDialect
+	locks   iriLocks
+}
+
+// NewSQLDatabase creates a SQLDatabase owning the IRIs with the same scheme
+// and host as the base IRI. New ids are created beneath the base IRI.
+func NewSQLDatabase(db *sql.DB, dialect SQLDialect, base *url.URL) *SQLDatabase {
+	return &SQLDatabase{
+		db:      db,
+		base:    base,
+		dialect: dialect,
+	}
+}
+
+// CreateTables creates the tables of the SQLDatabase if they do not already
+// exist.
+func (s *SQLDatabase) CreateTables(c context.Context) error {
+	for _, stmt := range sqlSchema {
+		if _, err := s.db.ExecContext(c, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Lock takes the lock for the IRI, blocking until it is available.
+func (s *SQLDatabase) Lock(c context.Context, id *url.URL) error {
+	return s.locks.Lock(id)
+}
+
+// Unlock releases the lock for the IRI.
+func (s *SQLDatabase) Unlock(c context.Context, id *url.URL) error {
+	return s.locks.Unlock(id)
+}
+
+// Begin starts a new transaction, which is used by all calls given the
+// returned context.
+func (s *SQLDatabase) Begin(c context.Context) (tx context.Context, err error) {
+	t, err := s.db.BeginTx(c, nil)
+	if err != nil {
+		return
+	}
+	tx = context.WithValue(c, sqlTxKey{}, t)
+	return
+}
+
+// Commit commits the transaction in the context.
+func (s *SQLDatabase) Commit(tx context.Context) error {
+	t, ok := tx.Value(sqlTxKey{}).(*sql.Tx)
+	if !ok {
+		return fmt.Errorf("context has no transaction")
+	}
+	return t.Commit()
+}
+
+// Rollback aborts the transaction in the context.
+func (s *SQLDatabase) Rollback(tx context.Context) error {
+	t, ok := tx.Value(sqlTxKey{}).(*sql.Tx)
+	if !ok {
+		return fmt.Errorf("context has no transaction")
+	}
+	return t.Rollback()
+}
+
+// InboxContains returns true if the inbox contains the id.
+func (s *SQLDatabase) InboxContains(c context.Context, inbox, id *url.URL) (contains bool, err error) {
+	return s.count(c, sqlCountBoxItem, inbox.String(), id.String())
+}
+
+// GetInbox returns the entire inbox as a single page.
+func (s *SQLDatabase) GetInbox(c context.Context, inboxIRI *url.URL) (inbox vocab.ActivityStreamsOrderedCollectionPage, err error) {
+	items, err := s.boxItems(c, sqlSelectBoxItems, inboxIRI.String())
+	if err != nil {
+		return
+	}
+	inbox = newBoxPage(inboxIRI, items)
+	return
+}
+
+// SetInbox replaces the inbox with the items of the page. The page must have
+// the id of the inbox.
+func (s *SQLDatabase) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	return s.setBoxItems(c, inbox)
+}
+
+// Owns returns true if the IRI has the same scheme and host as the base IRI.
+func (s *SQLDatabase) Owns(c context.Context, id *url.URL) (owns bool, err error) {
+	return strings.EqualFold(id.Scheme, s.base.Scheme) && strings.EqualFold(id.Host, s.base.Host), nil
+}
+
+// ActorForOutbox returns the actor stored with the outbox.
+func (s *SQLDatabase) ActorForOutbox(c context.Context, outboxIRI *url.URL) (actorIRI *url.URL, err error) {
+	return s.selectIRI(c, sqlSelectOutboxActor, outboxIRI)
+}
+
+// ActorForInbox returns the actor stored with the inbox.
+func (s *SQLDatabase) ActorForInbox(c context.Context, inboxIRI *url.URL) (actorIRI *url.URL, err error) {
+	return s.selectIRI(c, sqlSelectInboxActor, inboxIRI)
+}
+
+// OutboxForInbox returns the outbox of the actor stored with the inbox.
+func (s *SQLDatabase) OutboxForInbox(c context.Context, inboxIRI *url.URL) (outboxIRI *url.URL, err error) {
+	return s.selectIRI(c, sqlSelectInboxOutbox, inboxIRI)
+}
+
+// Exists returns true if a value, inbox, or outbox is stored with the id.
+func (s *SQLDatabase) Exists(c context.Context, id *url.URL) (exists bool, err error) {
+	exists, err = s.count(c, sqlCountObjects, id.String())
+	if err != nil || exists {
+		return
+	}
+	return s.count(c, sqlCountBoxes, id.String(), id.String())
+}
+
+// Get returns the value stored with the id. An inbox or outbox is returned as
+// an OrderedCollection of all of its items.
+func (s *SQLDatabase) Get(c context.Context, id *url.URL) (value vocab.Type, err error) {
+	var payload string
+	err = s.queryer(c).QueryRowContext(c, s.rebind(sqlSelectPayload), id.String()).Scan(&payload)
+	if err == nil {
+		return deserializeValue(c, []byte(payload))
+	} else if err != sql.ErrNoRows {
+		return
+	}
+	var isBox bool
+	if isBox, err = s.count(c, sqlCountBoxes, id.String(), id.String()); err != nil {
+		return
+	} else if !isBox {
+		err = fmt.Errorf("no value with id: %s", id)
+		return
+	}
+	var items []*url.URL
+	if items, err = s.boxItems(c, sqlSelectBoxItems, id.String()); err != nil {
+		return
+	}
+	value = newBoxCollection(id, items)
+	return
+}
+
+// Create stores a new value.
+func (s *SQLDatabase) Create(c context.Context, asType vocab.Type) error {
+	return s.store(c, asType, true)
+}
+
+// Update replaces the stored value with the same id.
+func (s *SQLDatabase) Update(c context.Context, asType vocab.Type) error {
+	return s.store(c, asType, false)
+}
+
+// Delete removes the value stored with the id.
+func (s *SQLDatabase) Delete(c context.Context, id *url.URL) error {
+	_, err := s.queryer(c).ExecContext(c, s.rebind(sqlDeleteObject), id.String())
+	return err
+}
+
+// GetOutbox returns the entire outbox as a single page.
+func (s *SQLDatabase) GetOutbox(c context.Context, outboxIRI *url.URL) (inbox vocab.ActivityStreamsOrderedCollectionPage, err error) {
+	items, err := s.boxItems(c, sqlSelectBoxItems, outboxIRI.String())
+	if err != nil {
+		return
+	}
+	inbox = newBoxPage(outboxIRI, items)
+	return
+}
+
+// SetOutbox replaces the outbox with the items of the page. The page must
+// have the id of the outbox.
+func (s *SQLDatabase) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	return s.setBoxItems(c, outbox)
+}
+
+// OutboxItems returns the items of the outbox in the range, and the number of
+// items in the outbox. A negative offset or limit is an error.
+func (s *SQLDatabase) OutboxItems(c context.Context, outboxIRI *url.URL, offset, limit int) (items []*url.URL, totalItems int, err error) {
+	if offset < 0 || limit < 0 {
+		err = fmt.Errorf("invalid range of outbox items: offset %d, limit %d", offset, limit)
+		return
+	}
+	err = s.queryer(c).QueryRowContext(c, s.rebind(sqlCountBoxItems), outboxIRI.String()).Scan(&totalItems)
+	if err != nil || limit == 0 {
+		return
+	}
+	items, err = s.boxItems(c, sqlSelectBoxRange, outboxIRI.String(), limit, offset)
+	return
+}
+
+// NewID returns a new random id beneath the base IRI, such as
+// "https://example.com/note/0123456789abcdef0123456789abcdef" for a Note.
+func (s *SQLDatabase) NewID(c context.Context, t vocab.Type) (id *url.URL, err error) {
+	b := make([]byte, sqlNewIDRandomByteSize)
+	if _, err = rand.Read(b); err != nil {
+		return
+	}
+	return url.Parse(fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(s.base.String(), "/"), strings.ToLower(t.GetTypeName()), hex.EncodeToString(b)))
+}
+
+// Followers returns the 'followers' collection of the actor, creating it if
+// it does not yet exist.
+func (s *SQLDatabase) Followers(c context.Context, actorIRI *url.URL) (followers vocab.ActivityStreamsCollection, err error) {
+	return actorCollection(c, s, actorIRI, "followers", followersIRI)
+}
+
+// Following returns the 'following' collection of the actor, creating it if
+// it does not yet exist.
+func (s *SQLDatabase) Following(c context.Context, actorIRI *url.URL) (following vocab.ActivityStreamsCollection, err error) {
+	return actorCollection(c, s, actorIRI, "following", followingIRI)
+}
+
+// Liked returns the 'liked' collection of the actor, creating it if it does
+// not yet exist.
+func (s *SQLDatabase) Liked(c context.Context, actorIRI *url.URL) (liked vocab.ActivityStreamsCollection, err error) {
+	return actorCollection(c, s, actorIRI, "liked", likedIRI)
+}
+
+// queryer returns the transaction in the context, or the database if there is
+// none.
+func (s *SQLDatabase) queryer(c context.Context) sqlQueryer {
+	if t, ok := c.Value(sqlTxKey{}).(*sql.Tx); ok {
+		return t
+	}
+	return s.db
+}
+
+// inTx runs fn within the transaction in the context. If there is none, fn is
+// run within a new transaction that is committed when fn succeeds.
+func (s *SQLDatabase) inTx(c context.Context, fn func(q sqlQueryer) error) error {
+	if t, ok := c.Value(sqlTxKey{}).(*sql.Tx); ok {
+		return fn(t)
+	}
+	t, err := s.db.BeginTx(c, nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(t); err != nil {
+		t.Rollback()
+		return err
+	}
+	return t.Commit()
+}
+
+// rebind rewrites the "?" placeholders of the query for the SQLDialect.
+func (s *SQLDatabase) rebind(query string) string {
+	if s.dialect != SQLDialectDollar {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// count returns true if the COUNT query is greater than zero.
+func (s *SQLDatabase) count(c context.Context, query string, args ...interface{}) (bool, error) {
+	var n int
+	if err := s.queryer(c).QueryRowContext(c, s.rebind(query), args...).Scan(&n); err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// selectIRI returns the IRI selected by the query for the key IRI.
+func (s *SQLDatabase) selectIRI(c context.Context, query string, key *url.URL) (*url.URL, error) {
+	var v sql.NullString
+	err := s.queryer(c).QueryRowContext(c, s.rebind(query), key.String()).Scan(&v)
+	if err == sql.ErrNoRows || (err == nil && !v.Valid) {
+		return nil, fmt.Errorf("no IRI for: %s", key)
+	} else if err != nil {
+		return nil, err
+	}
+	return url.Parse(v.String)
+}
+
+// boxItems returns the ordered items of the inbox or outbox selected by the
+// query.
+func (s *SQLDatabase) boxItems(c context.Context, query string, args ...interface{}) (items []*url.URL, err error) {
+	rows, err := s.queryer(c).QueryContext(c, s.rebind(query), args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var item string
+		if err = rows.Scan(&item); err != nil {
+			return
+		}
+		var u *url.URL
+		if u, err = url.Parse(item); err != nil {
+			return
+		}
+		items = append(items, u)
+	}
+	err = rows.Err()
+	return
+}
+
+// setBoxItems replaces the items of the box with the id of the page.
+func (s *SQLDatabase) setBoxItems(c context.Context, page vocab.ActivityStreamsOrderedCollectionPage) error {
+	boxIRI, items, err := boxPageItems(page)
+	if err != nil {
+		return err
+	}
+	return s.inTx(c, func(q sqlQueryer) error {
+		if _, err := q.ExecContext(c, s.rebind(sqlDeleteBoxItems), boxIRI.String()); err != nil {
+			return err
+		}
+		for i, item := range items {
+			if _, err := q.ExecContext(c, s.rebind(sqlInsertBoxItem), boxIRI.String(), i, item.String()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// store serializes and stores the value, and maps the inbox and outbox of an
+// actor to it.
+func (s *SQLDatabase) store(c context.Context, asType vocab.Type, create bool) error {
+	id, err := GetId(asType)
+	if err != nil {
+		return err
+	}
+	b, err := serializeValue(asType)
+	if err != nil {
+		return err
+	}
+	inbox, outbox := actorBoxes(asType)
+	return s.inTx(c, func(q sqlQueryer) error {
+		if create {
+			if _, err := q.ExecContext(c, s.rebind(sqlInsertObject), id.String(), string(b)); err != nil {
+				return err
+			}
+		} else {
+			res, err := q.ExecContext(c, s.rebind(sqlUpdateObject), string(b), id.String())
+			if err != nil {
+				return err
+			}
+			if n, err := res.RowsAffected(); err != nil {
+				return err
+			} else if n == 0 {
+				return fmt.Errorf("no value with id: %s", id)
+			}
+		}
+		if inbox == nil && outbox == nil {
+			return nil
+		}
+		if _, err := q.ExecContext(c, s.rebind(sqlDeleteActorBoxes), id.String()); err != nil {
+			return err
+		}
+		_, err := q.ExecContext(c, s.rebind(sqlInsertActorBoxes), id.String(), nullableIRI(inbox), nullableIRI(outbox))
+		return err
+	})
+}
+
+// nullableIRI returns the IRI as a string, or a SQL NULL if it is nil.
+func nullableIRI(u *url.URL) sql.NullString {
+	if u == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: u.String(), Valid: true}
+}
@@ -0,0 +1,270 @@
+package pub
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// fakeSQLCall is a statement expected by a fakeSQLDriver, and its result.
+type fakeSQLCall struct {
+	query    string
+	args     []driver.Value
+	columns  []string
+	rows     [][]driver.Value
+	affected int64
+}
+
+// fakeSQLScript is the ordered statements expected by one fakeSQLDriver
+// connection. Transactions are expected as "BEGIN", "COMMIT", and "ROLLBACK".
+type fakeSQLScript struct {
+	t     *testing.T
+	calls []fakeSQLCall
+}
+
+// next returns the next expected call, failing the test if it does not match.
+func (f *fakeSQLScript) next(query string, args []driver.Value) (fakeSQLCall, error) {
+	f.t.Helper()
+	if len(f.calls) == 0 {
+		f.t.Errorf("unexpected statement: %s %v", query, args)
+		return fakeSQLCall{}, fmt.Errorf("unexpected statement")
+	}
+	call := f.calls[0]
+	f.calls = f.calls[1:]
+	if call.query != query || !reflect.DeepEqual(call.args, args) {
+		f.t.Errorf("expected statement %s %v, got %s %v", call.query, call.args, query, args)
+		return fakeSQLCall{}, fmt.Errorf("unexpected statement")
+	}
+	return call, nil
+}
+
+var (
+	fakeSQLScriptsMu sync.Mutex
+	fakeSQLScripts   = make(map[string]*fakeSQLScript)
+)
+
+func init() {
+	sql.Register("pubfake", fakeSQLDriver{})
+}
+
+// newFakeSQLDB opens a database that expects exactly the calls, in order.
+func newFakeSQLDB(t *testing.T, calls ...fakeSQLCall) (*sql.DB, *fakeSQLScript) {
+	s := &fakeSQLScript{t: t, calls: calls}
+	fakeSQLScriptsMu.Lock()
+	fakeSQLScripts[t.Name()] = s
+	fakeSQLScriptsMu.Unlock()
+	db, err := sql.Open("pubfake", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	return db, s
+}
+
+type fakeSQLDriver struct{}
+
+func (fakeSQLDriver) Open(name string) (driver.Conn, error) {
+	fakeSQLScriptsMu.Lock()
+	defer fakeSQLScriptsMu.Unlock()
+	return fakeSQLConn{fakeSQLScripts[name]}, nil
+}
+
+type fakeSQLConn struct{ s *fakeSQLScript }
+
+func (f fakeSQLConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeSQLStmt{f.s, query}, nil
+}
+
+func (f fakeSQLConn) Close() error { return nil }
+
+func (f fakeSQLConn) Begin() (driver.Tx, error) {
+	_, err := f.s.next("BEGIN", nil)
+	return fakeSQLTx(f), err
+}
+
+type fakeSQLTx struct{ s *fakeSQLScript }
+
+func (f fakeSQLTx) Commit() error {
+	_, err := f.s.next("COMMIT", nil)
+	return err
+}
+
+func (f fakeSQLTx) Rollback() error {
+	_, err := f.s.next("ROLLBACK", nil)
+	return err
+}
+
+type fakeSQLStmt struct {
+	s     *fakeSQLScript
+	query string
+}
+
+func (f fakeSQLStmt) Close() error  { return nil }
+func (f fakeSQLStmt) NumInput() int { return -1 }
+
+func (f fakeSQLStmt) Exec(args []driver.Value) (driver.Result, error) {
+	call, err := f.s.next(f.query, args)
+	return driver.RowsAffected(call.affected), err
+}
+
+func (f fakeSQLStmt) Query(args []driver.Value) (driver.Rows, error) {
+	call, err := f.s.next(f.query, args)
+	return &fakeSQLRows{call: call}, err
+}
+
+type fakeSQLRows struct {
+	call fakeSQLCall
+	i    int
+}
+
+func (f *fakeSQLRows) Columns() []string { return f.call.columns }
+func (f *fakeSQLRows) Close() error      { return nil }
+
+func (f *fakeSQLRows) Next(dest []driver.Value) error {
+	if f.i >= len(f.call.rows) {
+		return io.EOF
+	}
+	copy(dest, f.call.rows[f.i])
+	f.i++
+	return nil
+}
+
+func TestSQLDatabase(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(t *testing.T, calls ...fakeSQLCall) (*SQLDatabase, *fakeSQLScript) {
+		setupData()
+		db, s := newFakeSQLDB(t, calls...)
+		return NewSQLDatabase(db, SQLDialectQuestion, mustParse("https://example.com")), s
+	}
+	t.Run("GetsValue", func(t *testing.T) {
+		db, s := setupFn(t, fakeSQLCall{
+			query:   sqlSelectPayload,
+			args:    []driver.Value{testNoteId1},
+			columns: []string{"payload"},
+			rows:    [][]driver.Value{{string(mustSerializeToBytes(testMyNote))}},
+		})
+		got, err := db.Get(ctx, mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, mustSerializeToBytes(got), mustSerializeToBytes(testMyNote))
+		assertEqual(t, len(s.calls), 0)
+	})
+	t.Run("GetsBoxAsOrderedCollection", func(t *testing.T) {
+		db, s := setupFn(t, fakeSQLCall{
+			query:   sqlSelectPayload,
+			args:    []driver.Value{testMyInboxIRI},
+			columns: []string{"payload"},
+		}, fakeSQLCall{
+			query:   sqlCountBoxes,
+			args:    []driver.Value{testMyInboxIRI, testMyInboxIRI},
+			columns: []string{"count"},
+			rows:    [][]driver.Value{{int64(1)}},
+		}, fakeSQLCall{
+			query:   sqlSelectBoxItems,
+			args:    []driver.Value{testMyInboxIRI},
+			columns: []string{"item"},
+			rows:    [][]driver.Value{{testNewActivityIRI}, {testNewActivityIRI2}},
+		})
+		got, err := db.Get(ctx, mustParse(testMyInboxIRI))
+		assertEqual(t, err, nil)
+		col, ok := got.(vocab.ActivityStreamsOrderedCollection)
+		assertEqual(t, ok, true)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 2)
+		assertEqual(t, col.GetActivityStreamsOrderedItems().At(1).GetIRI().String(), testNewActivityIRI2)
+		assertEqual(t, len(s.calls), 0)
+	})
+	t.Run("CreatesValueInTransaction", func(t *testing.T) {
+		db, s := setupFn(t, fakeSQLCall{
+			query: "BEGIN",
+		}, fakeSQLCall{
+			query:    sqlInsertObject,
+			args:     []driver.Value{testNoteId1, string(mustSerializeToBytes(testMyNote))},
+			affected: 1,
+		}, fakeSQLCall{
+			query: "COMMIT",
+		})
+		err := db.Create(ctx, testMyNote)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(s.calls), 0)
+	})
+	t.Run("ErrorIfUpdatingMissingValue", func(t *testing.T) {
+		db, s := setupFn(t, fakeSQLCall{
+			query: "BEGIN",
+		}, fakeSQLCall{
+			query: sqlUpdateObject,
+			args:  []driver.Value{string(mustSerializeToBytes(testMyNote)), testNoteId1},
+		}, fakeSQLCall{
+			query: "ROLLBACK",
+		})
+		err := db.Update(ctx, testMyNote)
+		assertNotEqual(t, err, nil)
+		assertEqual(t, len(s.calls), 0)
+	})
+	t.Run("UsesTransactionInContext", func(t *testing.T) {
+		db, s := setupFn(t, fakeSQLCall{
+			query: "BEGIN",
+		}, fakeSQLCall{
+			query: sqlDeleteBoxItems,
+			args:  []driver.Value{testMyInboxIRI},
+		}, fakeSQLCall{
+			query:    sqlInsertBoxItem,
+			args:     []driver.Value{testMyInboxIRI, int64(0), testNewActivityIRI},
+			affected: 1,
+		}, fakeSQLCall{
+			query:    sqlDeleteObject,
+			args:     []driver.Value{testNoteId1},
+			affected: 1,
+		}, fakeSQLCall{
+			query: "COMMIT",
+		})
+		tx, err := db.Begin(ctx)
+		assertEqual(t, err, nil)
+		err = db.SetInbox(tx, newBoxPage(mustParse(testMyInboxIRI), []*url.URL{mustParse(testNewActivityIRI)}))
+		assertEqual(t, err, nil)
+		err = db.Delete(tx, mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		err = db.Commit(tx)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(s.calls), 0)
+	})
+	t.Run("ErrorIfNoActorForInbox", func(t *testing.T) {
+		db, s := setupFn(t, fakeSQLCall{
+			query:   sqlSelectInboxActor,
+			args:    []driver.Value{testMyInboxIRI},
+			columns: []string{"actor_id"},
+		})
+		_, err := db.ActorForInbox(ctx, mustParse(testMyInboxIRI))
+		assertNotEqual(t, err, nil)
+		assertEqual(t, len(s.calls), 0)
+	})
+	t.Run("GetsRangeOfOutboxItems", func(t *testing.T) {
+		db, s := setupFn(t, fakeSQLCall{
+			query:   sqlCountBoxItems,
+			args:    []driver.Value{testMyOutboxIRI},
+			columns: []string{"count"},
+			rows:    [][]driver.Value{{int64(3)}},
+		}, fakeSQLCall{
+			query:   sqlSelectBoxRange,
+			args:    []driver.Value{testMyOutboxIRI, int64(2), int64(1)},
+			columns: []string{"item"},
+			rows:    [][]driver.Value{{testNewActivityIRI2}, {testNewActivityIRI}},
+		})
+		items, total, err := db.OutboxItems(ctx, mustParse(testMyOutboxIRI), 1, 2)
+		assertEqual(t, err, nil)
+		assertEqual(t, total, 3)
+		assertEqual(t, len(items), 2)
+		assertEqual(t, items[1].String(), testNewActivityIRI)
+		assertEqual(t, len(s.calls), 0)
+	})
+	t.Run("RebindsDollarPlaceholders", func(t *testing.T) {
+		db := NewSQLDatabase(nil, SQLDialectDollar, mustParse("https://example.com"))
+		assertEqual(t, db.rebind(sqlInsertActorBoxes), "INSERT INTO pub_actor_boxes (actor_id, inbox, outbox) VALUES ($1, $2, $3)")
+	})
+}
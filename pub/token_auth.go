@@ -0,0 +1,183 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// bearerScheme is the Authorization scheme of OAuth 2.0 bearer tokens.
+	bearerScheme = "Bearer"
+	// wwwAuthenticateHeader is the header challenging a client to
+	// authenticate.
+	wwwAuthenticateHeader = "WWW-Authenticate"
+)
+
+// TokenIntrospector validates the OAuth 2.0 bearer tokens of C2S clients, for
+// example with the token introspection of RFC 7662 or by looking the token up
+// in the application's own store.
+type TokenIntrospector interface {
+	// Introspect returns whether the token is active, and if so the IRI of
+	// the actor on whose behalf the client acts and the scopes granted to
+	// the token.
+	//
+	// The actor is nil for a token acting on behalf of no actor, such as
+	// one obtained with client credentials. Such a token owns no box.
+	//
+	// An unknown, expired, or revoked token is not active, and is not an
+	// error. Returning an error results in the authentication method
+	// returning the error.
+	Introspect(c context.Context, token string) (active bool, actor *url.URL, scopes []string, err error)
+}
+
+// TokenGrant is the actor and scopes of a bearer token that authenticated a
+// request.
+type TokenGrant struct {
+	// Actor is the IRI of the actor on whose behalf the client acts.
+	Actor *url.URL
+	// Scopes are the scopes granted to the token.
+	Scopes []string
+}
+
+// HasScope determines whether the scope is granted.
+func (g *TokenGrant) HasScope(scope string) bool {
+	for _, s := range g.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenGrantKey is the context key of the TokenGrant of a request.
+type tokenGrantKey struct{}
+
+// TokenGrantFromContext returns the TokenGrant of the request authenticated
+// by a BearerTokenAuthenticator, or false if the request had no bearer token.
+func TokenGrantFromContext(c context.Context) (*TokenGrant, bool) {
+	g, ok := c.Value(tokenGrantKey{}).(*TokenGrant)
+	return g, ok
+}
+
+// BearerTokenAuthenticator authenticates the C2S requests of clients carrying
+// an OAuth 2.0 bearer token in their Authorization header.
+//
+// It provides the AuthenticatePostOutbox method of the SocialProtocol, and the
+// AuthenticateGetInbox and AuthenticateGetOutbox methods of the
+// CommonBehavior, so that an application can embed it instead of writing its
+// own:
+//
+//	type myAppsSocialProtocol struct {
+//	  *pub.BearerTokenAuthenticator
+//	  /* ... */
+//	}
+//
+// The TokenGrant of an authenticated request is added to the returned
+// context, and can be retrieved with TokenGrantFromContext.
+type BearerTokenAuthenticator struct {
+	db           Database
+	introspector TokenIntrospector
+	readScope    string
+	writeScope   string
+}
+
+// NewBearerTokenAuthenticator creates a BearerTokenAuthenticator validating
+// tokens with the TokenIntrospector.
+//
+// Reading an inbox requires the readScope, and posting to an outbox requires
+// the writeScope. An empty scope is not required.
+func NewBearerTokenAuthenticator(db Database, introspector TokenIntrospector, readScope, writeScope string) *BearerTokenAuthenticator {
+	return &BearerTokenAuthenticator{
+		db:           db,
+		introspector: introspector,
+		readScope:    readScope,
+		writeScope:   writeScope,
+	}
+}
+
+// AuthenticatePostOutbox requires a token granting the write scope, acting on
+// behalf of the actor owning the outbox.
+func (b *BearerTokenAuthenticator) AuthenticatePostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (out context.Context, authenticated bool, err error) {
+	return b.authenticate(c, w, r, b.writeScope, true, b.db.ActorForOutbox)
+}
+
+// AuthenticateGetInbox requires a token granting the read scope, acting on
+// behalf of the actor owning the inbox.
+func (b *BearerTokenAuthenticator) AuthenticateGetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (out context.Context, authenticated bool, err error) {
+	return b.authenticate(c, w, r, b.readScope, true, b.db.ActorForInbox)
+}
+
+// AuthenticateGetOutbox allows requests without a token, since an outbox is
+// public. A request with a token is only allowed if the token is active, and
+// its TokenGrant is added to the context when it grants the read scope on
+// behalf of the actor owning the outbox, so that GetOutbox can serve the
+// activities only that actor may see.
+func (b *BearerTokenAuthenticator) AuthenticateGetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (out context.Context, authenticated bool, err error) {
+	return b.authenticate(c, w, r, b.readScope, false, b.db.ActorForOutbox)
+}
+
+// authenticate validates the bearer token of the request, which is required
+// to act on behalf of the owner of the box if mustOwn is true.
+func (b *BearerTokenAuthenticator) authenticate(c context.Context, w http.ResponseWriter, r *http.Request, scope string, mustOwn bool, actorForBox func(context.Context, *url.URL) (*url.URL, error)) (out context.Context, authenticated bool, err error) {
+	out = c
+	token, ok := bearerToken(r)
+	if !ok {
+		if !mustOwn {
+			authenticated = true
+			return
+		}
+		w.Header().Set(wwwAuthenticateHeader, bearerScheme)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	active, actor, scopes, err := b.introspector.Introspect(c, token)
+	if err != nil {
+		return
+	} else if !active {
+		w.Header().Set(wwwAuthenticateHeader, fmt.Sprintf("%s error=%q", bearerScheme, "invalid_token"))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	grant := &TokenGrant{Actor: actor, Scopes: scopes}
+	allowed := actor != nil && (len(scope) == 0 || grant.HasScope(scope))
+	if allowed {
+		box := *boxIRI(c, r)
+		box.RawQuery = ""
+		var owner *url.URL
+		if err = b.db.Lock(c, &box); err != nil {
+			return
+		}
+		owner, err = actorForBox(c, &box)
+		b.db.Unlock(c, &box)
+		if err != nil {
+			return
+		}
+		allowed = owner.String() == actor.String()
+	}
+	if !allowed {
+		if !mustOwn {
+			authenticated = true
+			return
+		}
+		w.Header().Set(wwwAuthenticateHeader, fmt.Sprintf("%s error=%q", bearerScheme, "insufficient_scope"))
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	out = context.WithValue(c, tokenGrantKey{}, grant)
+	authenticated = true
+	return
+}
+
+// bearerToken returns the bearer token in the Authorization header of the
+// request.
+func bearerToken(r *http.Request) (token string, ok bool) {
+	auth := r.Header.Get("Authorization")
+	if len(auth) <= len(bearerScheme)+1 || !strings.EqualFold(auth[:len(bearerScheme)], bearerScheme) || auth[len(bearerScheme)] != ' ' {
+		return "", false
+	}
+	token = strings.TrimSpace(auth[len(bearerScheme)+1:])
+	return token, len(token) > 0
+}
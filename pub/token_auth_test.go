@@ -0,0 +1,199 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestBearerTokenAuthenticator(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (b *BearerTokenAuthenticator, db *MockDatabase, ti *MockTokenIntrospector) {
+		setupData()
+		db = NewMockDatabase(ctl)
+		ti = NewMockTokenIntrospector(ctl)
+		b = NewBearerTokenAuthenticator(db, ti, "read", "write")
+		return
+	}
+	withTokenFn := func(r *http.Request) *http.Request {
+		r.Header.Set("Authorization", "Bearer secret")
+		return r
+	}
+	t.Run("PostOutboxAuthenticatesOwner", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		b, db, ti := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := withTokenFn(toPostOutboxRequest(testMyNote))
+		// Mock
+		ti.EXPECT().Introspect(ctx, "secret").Return(true, mustParse(testPersonIRI), []string{"read", "write"}, nil)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI)),
+			db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(mustParse(testPersonIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI)),
+		)
+		// Run & Verify
+		c, authenticated, err := b.AuthenticatePostOutbox(ctx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, true)
+		grant, ok := TokenGrantFromContext(c)
+		assertEqual(t, ok, true)
+		assertEqual(t, grant.Actor.String(), testPersonIRI)
+		assertEqual(t, grant.HasScope("write"), true)
+	})
+	t.Run("PostOutboxAuthenticatesOwnerOfResolvedBox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		b, db, ti := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := withTokenFn(toPostOutboxRequest(testMyNote))
+		boxIRI := mustParse("https://example.com/users/addison/outbox")
+		boxCtx := context.WithValue(ctx, boxIRIKey{}, boxIRI)
+		// Mock
+		ti.EXPECT().Introspect(boxCtx, "secret").Return(true, mustParse(testPersonIRI), []string{"write"}, nil)
+		gomock.InOrder(
+			db.EXPECT().Lock(boxCtx, boxIRI),
+			db.EXPECT().ActorForOutbox(boxCtx, boxIRI).Return(mustParse(testPersonIRI), nil),
+			db.EXPECT().Unlock(boxCtx, boxIRI),
+		)
+		// Run & Verify
+		_, authenticated, err := b.AuthenticatePostOutbox(boxCtx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, true)
+	})
+	t.Run("PostOutboxUnauthorizedWithoutToken", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		b, _, _ := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toPostOutboxRequest(testMyNote)
+		// Run & Verify
+		_, authenticated, err := b.AuthenticatePostOutbox(ctx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, false)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+		assertEqual(t, resp.Header().Get("WWW-Authenticate"), "Bearer")
+	})
+	t.Run("PostOutboxUnauthorizedWithInactiveToken", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		b, _, ti := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := withTokenFn(toPostOutboxRequest(testMyNote))
+		// Mock
+		ti.EXPECT().Introspect(ctx, "secret").Return(false, nil, nil, nil)
+		// Run & Verify
+		_, authenticated, err := b.AuthenticatePostOutbox(ctx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, false)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+		assertEqual(t, resp.Header().Get("WWW-Authenticate"), `Bearer error="invalid_token"`)
+	})
+	t.Run("PostOutboxForbiddenWithoutWriteScope", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		b, _, ti := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := withTokenFn(toPostOutboxRequest(testMyNote))
+		// Mock
+		ti.EXPECT().Introspect(ctx, "secret").Return(true, mustParse(testPersonIRI), []string{"read"}, nil)
+		// Run & Verify
+		_, authenticated, err := b.AuthenticatePostOutbox(ctx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, false)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("PostOutboxForbiddenWithoutActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		b, _, ti := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := withTokenFn(toPostOutboxRequest(testMyNote))
+		// Mock
+		ti.EXPECT().Introspect(ctx, "secret").Return(true, nil, []string{"write"}, nil)
+		// Run & Verify
+		_, authenticated, err := b.AuthenticatePostOutbox(ctx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, false)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("GetInboxForbiddenForOtherActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		b, db, ti := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := withTokenFn(toGetInboxRequest())
+		// Mock
+		ti.EXPECT().Introspect(ctx, "secret").Return(true, mustParse(testFederatedActorIRI), []string{"read"}, nil)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testMyInboxIRI)),
+			db.EXPECT().ActorForInbox(ctx, mustParse(testMyInboxIRI)).Return(mustParse(testPersonIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI)),
+		)
+		// Run & Verify
+		_, authenticated, err := b.AuthenticateGetInbox(ctx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, false)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("GetOutboxAllowsPublicRequest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		b, _, _ := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toGetOutboxRequest()
+		// Run & Verify
+		c, authenticated, err := b.AuthenticateGetOutbox(ctx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, true)
+		_, ok := TokenGrantFromContext(c)
+		assertEqual(t, ok, false)
+	})
+	t.Run("GetOutboxAddsGrantOfOwner", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		b, db, ti := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := withTokenFn(toGetOutboxRequest())
+		// Mock
+		ti.EXPECT().Introspect(ctx, "secret").Return(true, mustParse(testPersonIRI), []string{"read"}, nil)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testMyOutboxIRI)),
+			db.EXPECT().ActorForOutbox(ctx, mustParse(testMyOutboxIRI)).Return(mustParse(testPersonIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testMyOutboxIRI)),
+		)
+		// Run & Verify
+		c, authenticated, err := b.AuthenticateGetOutbox(ctx, resp, req)
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, true)
+		_, ok := TokenGrantFromContext(c)
+		assertEqual(t, ok, true)
+	})
+	t.Run("ReturnsIntrospectionError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		b, _, ti := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := withTokenFn(toGetInboxRequest())
+		expectErr := fmt.Errorf("test error")
+		// Mock
+		ti.EXPECT().Introspect(ctx, "secret").Return(false, nil, nil, expectErr)
+		// Run & Verify
+		_, _, err := b.AuthenticateGetInbox(ctx, resp, req)
+		assertEqual(t, err, expectErr)
+	})
+}
@@ -2,15 +2,21 @@ package pub
 
 import (
 	"bytes"
+	"container/list"
 	"context"
 	"crypto"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/httpsig"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -56,18 +62,23 @@ var _ Transport = &HttpSigTransport{}
 //
 // No rate limiting is applied.
 //
-// Only one request is tried per call.
+// Only one request is tried per call, unless fallback signers are configured
+// with NewHttpSigTransportWithFallbacks.
 type HttpSigTransport struct {
-	client       HttpClient
-	appAgent     string
-	gofedAgent   string
-	clock        Clock
-	getSigner    httpsig.Signer
-	getSignerMu  *sync.Mutex
-	postSigner   httpsig.Signer
-	postSignerMu *sync.Mutex
-	pubKeyId     string
-	privKey      crypto.PrivateKey
+	client      HttpClient
+	appAgent    string
+	gofedAgent  string
+	clock       Clock
+	getSigners  []lockedSigner
+	postSigners []lockedSigner
+	pubKeyId    string
+	privKey     crypto.PrivateKey
+}
+
+// lockedSigner guards a Signer, which is not safe for concurrent use.
+type lockedSigner struct {
+	signer httpsig.Signer
+	mu     *sync.Mutex
 }
 
 // NewHttpSigTransport returns a new Transport.
@@ -78,7 +89,8 @@ type HttpSigTransport struct {
 // and an HTTP Signature signing algorithm.
 //
 // The client lets users issue requests through any HTTP client, including the
-// standard library's HTTP client.
+// standard library's HTTP client. NewHttpClient returns one configured for
+// federation.
 //
 // The appAgent uniquely identifies the calling application's requests, so peers
 // may aid debugging the requests incoming from this server. Note that the
@@ -92,67 +104,222 @@ func NewHttpSigTransport(
 	getSigner, postSigner httpsig.Signer,
 	pubKeyId string,
 	privKey crypto.PrivateKey) *HttpSigTransport {
+	return NewHttpSigTransportWithFallbacks(
+		client,
+		appAgent,
+		clock,
+		[]httpsig.Signer{getSigner},
+		[]httpsig.Signer{postSigner},
+		pubKeyId,
+		privKey)
+}
+
+// NewHttpSigTransportWithFallbacks returns a new Transport that retries
+// requests rejected with 401 Unauthorized using the next signer in the chain.
+//
+// Peers disagree on which HTTP Signature dialect they accept, such as
+// "hs2019" versus "rsa-sha256" or which headers are signed. Each chain lists
+// signers for the dialects to try, in order. A request is sent once per signer
+// until a peer responds with a status other than 401 Unauthorized, or the chain
+// is exhausted. Each chain must have at least one signer.
+//
+// The other parameters are the same as for NewHttpSigTransport.
+func NewHttpSigTransportWithFallbacks(
+	client HttpClient,
+	appAgent string,
+	clock Clock,
+	getSigners, postSigners []httpsig.Signer,
+	pubKeyId string,
+	privKey crypto.PrivateKey) *HttpSigTransport {
 	return &HttpSigTransport{
-		client:       client,
-		appAgent:     appAgent,
-		gofedAgent:   goFedUserAgent(),
-		clock:        clock,
-		getSigner:    getSigner,
-		getSignerMu:  &sync.Mutex{},
-		postSigner:   postSigner,
-		postSignerMu: &sync.Mutex{},
-		pubKeyId:     pubKeyId,
-		privKey:      privKey,
+		client:      client,
+		appAgent:    appAgent,
+		gofedAgent:  goFedUserAgent(),
+		clock:       clock,
+		getSigners:  lockSigners(getSigners),
+		postSigners: lockSigners(postSigners),
+		pubKeyId:    pubKeyId,
+		privKey:     privKey,
+	}
+}
+
+// requestHeaderKey is the context key of the headers added to requests.
+type requestHeaderKey struct{}
+
+// WithRequestHeader returns a context whose requests sent by a HttpSigTransport
+// also carry the headers, such as the identification headers some peers
+// require. The headers are signed if the signers are configured to sign them.
+//
+// A User-Agent header replaces the one built from the appAgent, so that an
+// application can identify its software and version in its own format. Other
+// headers set by the transport, such as Date, are not replaced.
+func WithRequestHeader(c context.Context, header http.Header) context.Context {
+	return context.WithValue(c, requestHeaderKey{}, header)
+}
+
+// addRequestHeader adds the headers of the context to the request.
+func addRequestHeader(c context.Context, req *http.Request) {
+	header, _ := c.Value(requestHeaderKey{}).(http.Header)
+	for k, v := range header {
+		k = http.CanonicalHeaderKey(k)
+		if _, ok := req.Header[k]; ok && k != "User-Agent" {
+			continue
+		}
+		req.Header[k] = append([]string(nil), v...)
+	}
+}
+
+// digestAlgorithmKey is the context key of the Digest algorithm of requests.
+type digestAlgorithmKey struct{}
+
+// WithDigestAlgorithm returns a context whose POST requests sent by a
+// HttpSigTransport carry a Digest computed with the algorithm, which is
+// httpsig.DigestSha256 or httpsig.DigestSha512, instead of SHA-256.
+func WithDigestAlgorithm(c context.Context, algo httpsig.DigestAlgorithm) context.Context {
+	return context.WithValue(c, digestAlgorithmKey{}, algo)
+}
+
+// digestAlgorithmFromContext returns the Digest algorithm added to the context
+// by WithDigestAlgorithm, or SHA-256.
+func digestAlgorithmFromContext(c context.Context) httpsig.DigestAlgorithm {
+	if algo, ok := c.Value(digestAlgorithmKey{}).(httpsig.DigestAlgorithm); ok {
+		return algo
+	}
+	return httpsig.DigestSha256
+}
+
+// lockSigners guards each of the signers with its own mutex.
+func lockSigners(signers []httpsig.Signer) []lockedSigner {
+	l := make([]lockedSigner, len(signers))
+	for i, s := range signers {
+		l[i] = lockedSigner{signer: s, mu: &sync.Mutex{}}
 	}
+	return l
 }
 
 // Dereference sends a GET request signed with an HTTP Signature to obtain an
 // ActivityStreams value.
 func (h HttpSigTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
-	req, err := http.NewRequest("GET", iri.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(c)
-	req.Header.Add(acceptHeader, acceptHeaderValue)
-	req.Header.Add("Accept-Charset", "utf-8")
-	req.Header.Add("Date", h.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
-	req.Header.Add("User-Agent", fmt.Sprintf("%s %s", h.appAgent, h.gofedAgent))
-	h.getSignerMu.Lock()
-	err = h.getSigner.SignRequest(h.privKey, h.pubKeyId, req, nil)
-	h.getSignerMu.Unlock()
-	if err != nil {
-		return nil, err
-	}
-	resp, err := h.client.Do(req)
+	date := h.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05") + " GMT"
+	resp, err := h.do(h.getSigners, func() (*http.Request, error) {
+		req, err := http.NewRequest("GET", iri.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(c)
+		req.Header.Add(acceptHeader, acceptHeaderValue)
+		req.Header.Add("Accept-Charset", "utf-8")
+		req.Header.Add("Date", date)
+		req.Header.Add("User-Agent", fmt.Sprintf("%s %s", h.appAgent, h.gofedAgent))
+		addRequestHeader(c, req)
+		return req, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GET request to %s failed (%d): %s", iri.String(), resp.StatusCode, resp.Status)
+		return nil, &DereferenceError{
+			IRI:        iri,
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Err:        statusCategory(resp.StatusCode),
+		}
+	}
+	if ct := resp.Header.Get(contentTypeHeader); isHTMLMediaType(ct) {
+		return nil, &DereferenceError{
+			IRI:         iri,
+			StatusCode:  resp.StatusCode,
+			Status:      resp.Status,
+			ContentType: ct,
+			Err:         ErrNotActivityStreamsMediaType,
+		}
 	}
 	return ioutil.ReadAll(resp.Body)
 }
 
-// Deliver sends a POST request with an HTTP Signature.
-func (h HttpSigTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
-	req, err := http.NewRequest("POST", to.String(), bytes.NewReader(b))
-	if err != nil {
-		return err
+// DereferenceError is the error of a GET request to a peer that did not
+// respond with an ActivityStreams value.
+//
+// Its Unwrap method returns the category of the error, if known, so that
+// errors.Is matches ErrObjectNotFound, ErrNotAuthorized, and
+// ErrNotActivityStreamsMediaType.
+type DereferenceError struct {
+	// IRI is the IRI that was dereferenced.
+	IRI *url.URL
+	// StatusCode and Status are those of the peer's response.
+	StatusCode int
+	Status     string
+	// ContentType is the media type of the peer's response, when it is not
+	// an ActivityStreams one.
+	ContentType string
+	// Err is the category of the error, or nil if it is not known.
+	Err error
+}
+
+// Error describes the failed request.
+func (d *DereferenceError) Error() string {
+	if d.ContentType != "" {
+		return fmt.Sprintf("GET request to %s returned %q: %v", d.IRI.String(), d.ContentType, d.Err)
 	}
-	req = req.WithContext(c)
-	req.Header.Add(contentTypeHeader, contentTypeHeaderValue)
-	req.Header.Add("Accept-Charset", "utf-8")
-	req.Header.Add("Date", h.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
-	req.Header.Add("User-Agent", fmt.Sprintf("%s %s", h.appAgent, h.gofedAgent))
-	h.postSignerMu.Lock()
-	err = h.postSigner.SignRequest(h.privKey, h.pubKeyId, req, b)
-	h.postSignerMu.Unlock()
+	return fmt.Sprintf("GET request to %s failed (%d): %s", d.IRI.String(), d.StatusCode, d.Status)
+}
+
+// Unwrap returns the category of the error.
+func (d *DereferenceError) Unwrap() error {
+	return d.Err
+}
+
+// statusCategory returns the error category of an unsuccessful status code, or
+// nil if it has none.
+func statusCategory(code int) error {
+	switch code {
+	case http.StatusNotFound, http.StatusGone:
+		return ErrObjectNotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrNotAuthorized
+	}
+	return nil
+}
+
+// isHTMLMediaType returns true if the Content-Type header value is a web page,
+// which peers serve instead of an ActivityStreams value when they ignore the
+// Accept header.
+func isHTMLMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
 	if err != nil {
-		return err
+		return false
 	}
-	resp, err := h.client.Do(req)
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
+
+// Deliver sends a POST request with an HTTP Signature.
+//
+// The request has a Digest header of the body, computed with SHA-256 unless the
+// context was given another algorithm by WithDigestAlgorithm. Signers must be
+// configured to sign the "digest" header for peers to accept it, including a
+// HttpSigVerifier.
+func (h HttpSigTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	date := h.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05") + " GMT"
+	resp, err := h.do(h.postSigners, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", to.String(), bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(c)
+		req.Header.Add(contentTypeHeader, contentTypeHeaderValue)
+		req.Header.Add("Accept-Charset", "utf-8")
+		req.Header.Add("Date", date)
+		req.Header.Add("User-Agent", fmt.Sprintf("%s %s", h.appAgent, h.gofedAgent))
+		if f := followersSynchronizationFromContext(c); f != nil {
+			req.Header.Add(collectionSynchronizationHeader, f.header(to.Host))
+		}
+		if err := addDigest(req.Header, digestAlgorithmFromContext(c), b); err != nil {
+			return nil, err
+		}
+		addRequestHeader(c, req)
+		return req, nil
+	})
 	if err != nil {
 		return err
 	}
@@ -163,37 +330,559 @@ func (h HttpSigTransport) Deliver(c context.Context, b []byte, to *url.URL) erro
 	return nil
 }
 
-// BatchDeliver sends concurrent POST requests. Returns an error if any of the
-// requests had an error.
+// do signs and sends the request built by newReq with each signer in turn,
+// until the peer responds with a status other than 401 Unauthorized. The
+// response to the last request sent is returned.
+//
+// The signers are not given the request body, whose Digest header is set by
+// newReq instead, since those of the httpsig package compute it incorrectly.
+func (h HttpSigTransport) do(signers []lockedSigner, newReq func() (*http.Request, error)) (*http.Response, error) {
+	for i, s := range signers {
+		req, err := newReq()
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		err = s.signer.SignRequest(h.privKey, h.pubKeyId, req, nil)
+		s.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := h.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusUnauthorized || i == len(signers)-1 {
+			return resp, nil
+		}
+		resp.Body.Close()
+		logEvent(req.Context(), LogDebug, "retrying request rejected with 401 Unauthorized using the next signer", "method", req.Method, "url", req.URL.String())
+	}
+	return nil, fmt.Errorf("no HTTP Signature signers configured")
+}
+
+// BatchDeliver sends concurrent POST requests. Returns a *BatchDeliverError if
+// any of the requests had an error.
+//
+// BatchDeliver returns as soon as the context is done, without waiting on the
+// requests still in flight. Per-recipient timeouts are applied by wrapping the
+// transport in a BudgetTransport.
 func (h HttpSigTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
-	var wg sync.WaitGroup
-	errCh := make(chan error, len(recipients))
-	for _, recipient := range recipients {
-		wg.Add(1)
-		go func(r *url.URL) {
-			defer wg.Done()
-			if err := h.Deliver(c, b, r); err != nil {
-				errCh <- err
-			}
-		}(recipient)
+	return batchDeliver(c, b, recipients, h.Deliver)
+}
+
+// RecipientError is the error delivering to a single recipient.
+type RecipientError struct {
+	// Recipient is the IRI the payload was not delivered to.
+	Recipient *url.URL
+	// Err is why the delivery failed.
+	Err error
+}
+
+// BatchDeliverError is returned by BatchDeliver when the delivery to at least
+// one of the recipients failed. It describes which recipients succeeded, so that
+// callers may retry only the failed ones.
+type BatchDeliverError struct {
+	// Delivered are the recipients the payload was delivered to.
+	Delivered []*url.URL
+	// Failed are the recipients the payload was not delivered to. Those
+	// still pending when the context was done fail with the context's
+	// error.
+	Failed []RecipientError
+	// Requeued are the recipients a BudgetTransport enqueued in its
+	// DeliveryQueue to be delivered later.
+	Requeued []*url.URL
+}
+
+// Error lists the error of each failed recipient.
+func (b *BatchDeliverError) Error() string {
+	errs := make([]string, len(b.Failed))
+	for i, f := range b.Failed {
+		errs[i] = fmt.Sprintf("%s: %s", f.Recipient, f.Err)
 	}
-	wg.Wait()
-	errs := make([]string, 0, len(recipients))
-outer:
-	for {
+	return fmt.Sprintf("batch deliver had at least one failure: %s", strings.Join(errs, "; "))
+}
+
+// deliverResult is the outcome of the delivery to the recipient at an index.
+type deliverResult struct {
+	idx int
+	err error
+}
+
+// startDeliveries calls deliver concurrently for each recipient, returning the
+// channel on which their outcomes are sent.
+func startDeliveries(c context.Context, b []byte, recipients []*url.URL, deliver func(context.Context, []byte, *url.URL) error) <-chan deliverResult {
+	// Buffered so that deliveries finishing after nobody waits on them do
+	// not leak their goroutines.
+	resCh := make(chan deliverResult, len(recipients))
+	for i, recipient := range recipients {
+		go func(i int, r *url.URL) {
+			resCh <- deliverResult{idx: i, err: deliver(c, b, r)}
+		}(i, recipient)
+	}
+	return resCh
+}
+
+// awaitDeliveries waits for the outcomes of n deliveries, or until the context
+// is done. Returns which deliveries completed and their errors, by index.
+func awaitDeliveries(c context.Context, resCh <-chan deliverResult, n int) (done []bool, errs []error) {
+	done = make([]bool, n)
+	errs = make([]error, n)
+	for pending := n; pending > 0; pending-- {
 		select {
-		case e := <-errCh:
-			errs = append(errs, e.Error())
-		default:
-			break outer
+		case res := <-resCh:
+			done[res.idx] = true
+			errs[res.idx] = res.err
+		case <-c.Done():
+			return
+		}
+	}
+	return
+}
+
+// newBatchDeliverError sorts the recipients by the outcome of their delivery.
+// Recipients whose delivery did not complete fail with the undone error, unless
+// it is nil.
+func newBatchDeliverError(recipients []*url.URL, done []bool, errs []error, undone error) *BatchDeliverError {
+	e := &BatchDeliverError{}
+	for i, r := range recipients {
+		if !done[i] {
+			if undone != nil {
+				e.Failed = append(e.Failed, RecipientError{Recipient: r, Err: undone})
+			}
+		} else if errs[i] != nil {
+			e.Failed = append(e.Failed, RecipientError{Recipient: r, Err: errs[i]})
+		} else {
+			e.Delivered = append(e.Delivered, r)
+		}
+	}
+	return e
+}
+
+// batchDeliver calls deliver concurrently for each recipient, until all are
+// done or the context is done. Returns a *BatchDeliverError if any of the
+// deliveries had an error or did not complete.
+func batchDeliver(c context.Context, b []byte, recipients []*url.URL, deliver func(context.Context, []byte, *url.URL) error) error {
+	done, errs := awaitDeliveries(c, startDeliveries(c, b, recipients, deliver), len(recipients))
+	if e := newBatchDeliverError(recipients, done, errs, c.Err()); len(e.Failed) > 0 {
+		return e
+	}
+	return nil
+}
+
+// errNoDeliveryQueue is the error of deliveries exceeding the budget of a
+// BudgetTransport without a DeliveryQueue.
+var errNoDeliveryQueue = errors.New("no delivery queue to retry deliveries exceeding the budget")
+
+// Transport must be implemented by BudgetTransport.
+var _ Transport = &BudgetTransport{}
+
+// BudgetTransport wraps another Transport, bounding how long each request and
+// each fan-out to multiple recipients may take.
+//
+// A BatchDeliver that exceeds the budget returns as soon as the budget elapses,
+// handing every recipient that has not yet been delivered to the DeliveryQueue.
+// This keeps the latency of the request submitting the activity bounded,
+// regardless of how many recipients it has.
+//
+// The wrapped Transport's Deliver is called concurrently, so it must be safe
+// for concurrent use. HttpSigTransport is.
+type BudgetTransport struct {
+	Transport
+	boxIRI     *url.URL
+	budget     time.Duration
+	perRequest time.Duration
+	queue      DeliveryQueue
+}
+
+// NewBudgetTransport returns a Transport delivering with the wrapped Transport.
+//
+// The budget is the overall wall-clock time allowed for a single BatchDeliver.
+// The perRequest timeout applies to each delivery within it, as well as to
+// Deliver and Dereference. A zero or negative duration disables that timeout.
+//
+// Recipients not delivered to within the budget are enqueued in the queue, on
+// behalf of the actor with the box the wrapped Transport was created for. If
+// the queue is nil, they fail instead.
+func NewBudgetTransport(t Transport, boxIRI *url.URL, budget, perRequest time.Duration, queue DeliveryQueue) *BudgetTransport {
+	return &BudgetTransport{
+		Transport:  t,
+		boxIRI:     boxIRI,
+		budget:     budget,
+		perRequest: perRequest,
+		queue:      queue,
+	}
+}
+
+// withTimeout returns a context that is cancelled after the duration, if it is
+// positive.
+func withTimeout(c context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	if d <= 0 {
+		return context.WithCancel(c)
+	}
+	return context.WithTimeout(c, d)
+}
+
+// Dereference fetches the ActivityStreams object with the wrapped Transport,
+// bounded by the per-request timeout.
+func (b *BudgetTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	c, cancel := withTimeout(c, b.perRequest)
+	defer cancel()
+	return b.Transport.Dereference(c, iri)
+}
+
+// Deliver sends an ActivityStreams object with the wrapped Transport, bounded
+// by the per-request timeout.
+func (b *BudgetTransport) Deliver(c context.Context, body []byte, to *url.URL) error {
+	c, cancel := withTimeout(c, b.perRequest)
+	defer cancel()
+	return b.Transport.Deliver(c, body, to)
+}
+
+// BatchDeliver sends concurrent deliveries, each bounded by the per-request
+// timeout, until all are done or the budget elapses.
+//
+// Recipients not yet delivered to when the budget elapses are enqueued. Returns
+// a *BatchDeliverError if any of the completed deliveries had an error, or if
+// enqueueing failed or there is no queue. If the caller's context is done first, the recipients not
+// yet delivered to are not enqueued, and fail with the context's error.
+func (b *BudgetTransport) BatchDeliver(c context.Context, body []byte, recipients []*url.URL) error {
+	budgetCtx, cancel := withTimeout(c, b.budget)
+	defer cancel()
+	done, errs := awaitDeliveries(budgetCtx, startDeliveries(budgetCtx, body, recipients, b.Deliver), len(recipients))
+	remaining := make([]*url.URL, 0, len(recipients))
+	for i, r := range recipients {
+		if !done[i] {
+			remaining = append(remaining, r)
 		}
 	}
-	if len(errs) > 0 {
-		return fmt.Errorf("batch deliver had at least one failure: %s", strings.Join(errs, "; "))
+	// The caller gave up, not the budget.
+	undone := c.Err()
+	if len(remaining) > 0 && undone == nil {
+		if b.queue == nil {
+			undone = errNoDeliveryQueue
+		} else {
+			undone = b.queue.Enqueue(c, b.boxIRI, body, remaining)
+		}
+		if undone != nil {
+			logEvent(c, LogError, "failed to schedule retry of deliveries exceeding the budget", "recipients", remaining, "error", undone)
+		} else {
+			logEvent(c, LogInfo, "scheduled retry of deliveries exceeding the budget", "recipients", remaining)
+		}
+	}
+	e := newBatchDeliverError(recipients, done, errs, undone)
+	if undone == nil && len(remaining) > 0 {
+		e.Requeued = remaining
+	}
+	if len(e.Failed) > 0 {
+		return e
 	}
 	return nil
 }
 
+// Transport must be implemented by RateLimitedTransport.
+var _ Transport = &RateLimitedTransport{}
+
+// RateLimitedTransport wraps another Transport, limiting the rate of requests
+// sent to each destination host.
+//
+// Requests beyond the limit are not failed. Instead, they wait their turn, so
+// that a burst of deliveries to a large instance or relay is spread out over
+// time instead of triggering rate limiting responses or bans from the peer.
+// Waiting stops early if the request's context is done.
+//
+// The wrapped Transport's Dereference and Deliver are called concurrently, so
+// they must be safe for concurrent use. HttpSigTransport is.
+type RateLimitedTransport struct {
+	Transport
+	limiter *hostLimiter
+}
+
+// NewRateLimitedTransport returns a Transport sending requests with the wrapped
+// Transport.
+//
+// Each destination host may be sent up to perSecond requests per second, as
+// measured by the clock, with bursts of up to burst requests. A zero or
+// negative perSecond disables rate limiting, and a burst less than one is
+// treated as one.
+func NewRateLimitedTransport(t Transport, clock Clock, perSecond float64, burst int) *RateLimitedTransport {
+	return &RateLimitedTransport{
+		Transport: t,
+		limiter:   newHostLimiter(clock, perSecond, burst),
+	}
+}
+
+// wait blocks until a request may be sent to the host, or until the context is
+// done.
+func (r *RateLimitedTransport) wait(c context.Context, host string) error {
+	d := r.limiter.reserve(host, true)
+	if d <= 0 {
+		return c.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-c.Done():
+		return c.Err()
+	}
+}
+
+// Dereference fetches the ActivityStreams object with the wrapped Transport,
+// once the rate limit of the IRI's host allows it.
+func (r *RateLimitedTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	if err := r.wait(c, iri.Host); err != nil {
+		return nil, err
+	}
+	return r.Transport.Dereference(c, iri)
+}
+
+// Deliver sends an ActivityStreams object with the wrapped Transport, once the
+// rate limit of the recipient's host allows it.
+func (r *RateLimitedTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	if err := r.wait(c, to.Host); err != nil {
+		return err
+	}
+	return r.Transport.Deliver(c, b, to)
+}
+
+// BatchDeliver sends concurrent deliveries, each waiting for the rate limit of
+// its recipient's host. Returns an error if any of the deliveries had an error.
+func (r *RateLimitedTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	return batchDeliver(c, b, recipients, r.Deliver)
+}
+
+// DereferenceCache stores the ActivityStreams payloads fetched from peers, so
+// that a CachingTransport does not fetch the same IRI repeatedly.
+//
+// It is called concurrently, so it must be safe for concurrent use.
+type DereferenceCache interface {
+	// Get returns the cached payload of the IRI, if it is present and has
+	// not expired.
+	Get(c context.Context, iri *url.URL) (b []byte, ok bool)
+	// Set caches the payload of the IRI.
+	Set(c context.Context, iri *url.URL, b []byte)
+	// Invalidate removes the IRI from the cache, so that it is fetched
+	// again when next dereferenced.
+	Invalidate(c context.Context, iri *url.URL)
+}
+
+// Transport must be implemented by CachingTransport.
+var _ Transport = &CachingTransport{}
+
+// CachingTransport wraps another Transport, answering Dereference calls from a
+// DereferenceCache when possible.
+//
+// Remote actors and objects, such as the actor owning a key that signed a
+// request, are otherwise fetched again every time they are needed. Deliveries
+// are not cached.
+//
+// FederatingWrappedCallbacks invalidates the objects of received Update and
+// Delete activities when its Cache is set to the same DereferenceCache.
+type CachingTransport struct {
+	Transport
+	cache DereferenceCache
+}
+
+// NewCachingTransport returns a Transport dereferencing with the wrapped
+// Transport when the IRI is not in the cache.
+func NewCachingTransport(t Transport, cache DereferenceCache) *CachingTransport {
+	return &CachingTransport{
+		Transport: t,
+		cache:     cache,
+	}
+}
+
+// Dereference returns the cached payload of the IRI, or fetches it with the
+// wrapped Transport and caches it. A context given to WithForceRefresh always
+// fetches the payload.
+func (t *CachingTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	if !isForceRefresh(c) {
+		if b, ok := t.cache.Get(c, iri); ok {
+			return b, nil
+		}
+	}
+	b, err := t.Transport.Dereference(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	t.cache.Set(c, iri, b)
+	return b, nil
+}
+
+// forceRefreshKey is the context key of dereferences that must be fetched from
+// peers.
+type forceRefreshKey struct{}
+
+// WithForceRefresh returns a context whose dereferences are fetched from peers
+// by a LocalFirstTransport or a CachingTransport, instead of being answered
+// with a local copy, such as to refresh a stale actor.
+func WithForceRefresh(c context.Context) context.Context {
+	return context.WithValue(c, forceRefreshKey{}, true)
+}
+
+// isForceRefresh returns true if the context was given to WithForceRefresh.
+func isForceRefresh(c context.Context) bool {
+	force, _ := c.Value(forceRefreshKey{}).(bool)
+	return force
+}
+
+// Transport must be implemented by LocalFirstTransport.
+var _ Transport = &LocalFirstTransport{}
+
+// LocalFirstTransport wraps another Transport, answering Dereference calls with
+// the values in the Database when it has them.
+//
+// Resolving the recipients of deliveries and the objects of activities
+// otherwise fetches the application's own actors, collections, and objects, as
+// well as the peer actors and objects it already stores, over HTTP.
+type LocalFirstTransport struct {
+	Transport
+	db Database
+}
+
+// NewLocalFirstTransport returns a Transport dereferencing with the wrapped
+// Transport when the IRI is not in the Database.
+func NewLocalFirstTransport(t Transport, db Database) *LocalFirstTransport {
+	return &LocalFirstTransport{
+		Transport: t,
+		db:        db,
+	}
+}
+
+// Dereference returns the serialized value of the IRI in the Database, or
+// fetches it with the wrapped Transport. A context given to WithForceRefresh
+// always fetches it.
+func (t *LocalFirstTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	if !isForceRefresh(c) {
+		b, err := t.local(c, iri)
+		if err != nil {
+			return nil, err
+		} else if b != nil {
+			return b, nil
+		}
+	}
+	return t.Transport.Dereference(c, iri)
+}
+
+// local returns the serialized value of the IRI in the Database, or nil if it
+// does not exist.
+func (t *LocalFirstTransport) local(c context.Context, iri *url.URL) ([]byte, error) {
+	if err := t.db.Lock(c, iri); err != nil {
+		return nil, err
+	}
+	defer t.db.Unlock(c, iri)
+	if exists, err := t.db.Exists(c, iri); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	v, err := t.db.Get(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	m, err := streams.Serialize(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+// DereferenceCache must be implemented by MemoryDereferenceCache.
+var _ DereferenceCache = &MemoryDereferenceCache{}
+
+// MemoryDereferenceCache is a DereferenceCache keeping payloads in memory for
+// a fixed amount of time, evicting the least recently used payload when it
+// is full. It is safe for concurrent use.
+type MemoryDereferenceCache struct {
+	ttl        time.Duration
+	maxEntries int
+	clock      Clock
+	mu         *sync.Mutex
+	// lru has the most recently used entries at the front.
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+// memoryDereferenceCacheEntry is a payload in a MemoryDereferenceCache.
+type memoryDereferenceCacheEntry struct {
+	iri     string
+	b       []byte
+	expires time.Time
+}
+
+// NewMemoryDereferenceCache returns an empty MemoryDereferenceCache.
+//
+// Payloads expire after the ttl, as measured by the clock. A zero or negative
+// ttl keeps payloads until they are evicted or invalidated. At most maxEntries
+// payloads are kept, and a zero or negative maxEntries does not limit them.
+func NewMemoryDereferenceCache(ttl time.Duration, maxEntries int, clock Clock) *MemoryDereferenceCache {
+	return &MemoryDereferenceCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		clock:      clock,
+		mu:         &sync.Mutex{},
+		lru:        list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the cached payload of the IRI, if it has not expired.
+func (m *MemoryDereferenceCache) Get(c context.Context, iri *url.URL) (b []byte, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[iri.String()]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*memoryDereferenceCacheEntry)
+	if m.ttl > 0 && !m.clock.Now().Before(entry.expires) {
+		m.remove(e)
+		return nil, false
+	}
+	m.lru.MoveToFront(e)
+	return entry.b, true
+}
+
+// Set caches the payload of the IRI, evicting the least recently used payload
+// if the cache is full.
+func (m *MemoryDereferenceCache) Set(c context.Context, iri *url.URL, b []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry := &memoryDereferenceCacheEntry{
+		iri: iri.String(),
+		b:   b,
+	}
+	if m.ttl > 0 {
+		entry.expires = m.clock.Now().Add(m.ttl)
+	}
+	if e, ok := m.entries[entry.iri]; ok {
+		e.Value = entry
+		m.lru.MoveToFront(e)
+		return
+	}
+	m.entries[entry.iri] = m.lru.PushFront(entry)
+	if m.maxEntries > 0 && m.lru.Len() > m.maxEntries {
+		m.remove(m.lru.Back())
+	}
+}
+
+// Invalidate removes the IRI from the cache.
+func (m *MemoryDereferenceCache) Invalidate(c context.Context, iri *url.URL) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if e, ok := m.entries[iri.String()]; ok {
+		m.remove(e)
+	}
+}
+
+// remove deletes the entry. The lock must be held.
+func (m *MemoryDereferenceCache) remove(e *list.Element) {
+	m.lru.Remove(e)
+	delete(m.entries, e.Value.(*memoryDereferenceCacheEntry).iri)
+}
+
 // HttpClient sends http requests, and is an abstraction only needed by the
 // HttpSigTransport. The standard library's Client satisfies this interface.
 type HttpClient interface {
@@ -6,8 +6,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-fed/httpsig"
 	"github.com/golang/mock/gomock"
 )
 
@@ -34,8 +37,31 @@ var (
 			testPrivKey)
 		return
 	}
+	httpSigFallbackSetupFn = func(ctl *gomock.Controller) (t *HttpSigTransport, c *MockClock, hc *MockHttpClient, s1, s2 *MockSigner) {
+		c = NewMockClock(ctl)
+		hc = NewMockHttpClient(ctl)
+		s1 = NewMockSigner(ctl)
+		s2 = NewMockSigner(ctl)
+		t = NewHttpSigTransportWithFallbacks(
+			hc,
+			testAppAgent,
+			c,
+			[]httpsig.Signer{s1, s2},
+			[]httpsig.Signer{s1, s2},
+			testPubKeyId,
+			testPrivKey)
+		return
+	}
 )
 
+// newTestResponse returns a response with the status code and body.
+func newTestResponse(code int, body []byte) *http.Response {
+	respR := httptest.NewRecorder()
+	respR.WriteHeader(code)
+	respR.Write(body)
+	return respR.Result()
+}
+
 func TestHttpSigTransportDereference(t *testing.T) {
 	ctx := context.Background()
 	t.Run("ReturnsErrorWhenHTTPStatusError", func(t *testing.T) {
@@ -78,6 +104,141 @@ func TestHttpSigTransportDereference(t *testing.T) {
 		assertByteEqual(t, b, testRespBody)
 		assertEqual(t, err, nil)
 	})
+	t.Run("SetsDigest", func(t *testing.T) {
+		for _, test := range []struct {
+			name   string
+			ctx    context.Context
+			digest string
+		}{
+			{"Sha256ByDefault", ctx, "SHA-256=eqvilq+YXa19cTFDbgTozpAcUU4Y40zUXuC6DH8hRZo="},
+			{"Sha512FromContext", WithDigestAlgorithm(ctx, httpsig.DigestSha512), "SHA-512="},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				// Setup
+				ctl := gomock.NewController(t)
+				defer ctl.Finish()
+				tp, c, hc, _, ps := httpSigSetupFn(ctl)
+				var req *http.Request
+				// Mock
+				c.EXPECT().Now().Return(now())
+				ps.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
+				hc.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+					req = r
+					return newTestResponse(http.StatusOK, nil), nil
+				})
+				// Run
+				err := tp.Deliver(test.ctx, testRespBody, mustParse(testFederatedActorIRI))
+				// Verify
+				assertEqual(t, err, nil)
+				assertEqual(t, strings.HasPrefix(req.Header.Get(digestHeader), test.digest), true)
+				assertEqual(t, verifyDigest(req.Header, testRespBody), nil)
+			})
+		}
+	})
+	t.Run("RetriesWithFallbackSignerWhenUnauthorized", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, hc, s1, s2 := httpSigFallbackSetupFn(ctl)
+		// Mock
+		c.EXPECT().Now().Return(now())
+		first := s1.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
+		firstDo := hc.EXPECT().Do(gomock.Any()).Return(newTestResponse(http.StatusUnauthorized, nil), nil).After(first)
+		second := s2.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil).After(firstDo)
+		hc.EXPECT().Do(gomock.Any()).Return(newTestResponse(http.StatusOK, testRespBody), nil).After(second)
+		// Run & Verify
+		b, err := tp.Dereference(ctx, mustParse(testNoteId1))
+		assertByteEqual(t, b, testRespBody)
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotRetryWhenNotUnauthorized", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, hc, s1, _ := httpSigFallbackSetupFn(ctl)
+		// Mock
+		c.EXPECT().Now().Return(now())
+		s1.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
+		hc.EXPECT().Do(gomock.Any()).Return(newTestResponse(http.StatusNotFound, nil), nil)
+		// Run & Verify
+		b, err := tp.Dereference(ctx, mustParse(testNoteId1))
+		assertEqual(t, len(b), 0)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ReturnsCategoryOfStatusError", func(t *testing.T) {
+		for _, test := range []struct {
+			name   string
+			code   int
+			expect error
+		}{
+			{"NotFound", http.StatusNotFound, ErrObjectNotFound},
+			{"Gone", http.StatusGone, ErrObjectNotFound},
+			{"Unauthorized", http.StatusUnauthorized, ErrNotAuthorized},
+			{"Forbidden", http.StatusForbidden, ErrNotAuthorized},
+			{"InternalServerError", http.StatusInternalServerError, nil},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				// Setup
+				ctl := gomock.NewController(t)
+				defer ctl.Finish()
+				tp, c, hc, gs, _ := httpSigSetupFn(ctl)
+				// Mock
+				c.EXPECT().Now().Return(now())
+				gs.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
+				hc.EXPECT().Do(gomock.Any()).Return(newTestResponse(test.code, nil), nil)
+				// Run & Verify
+				b, err := tp.Dereference(ctx, mustParse(testNoteId1))
+				assertEqual(t, len(b), 0)
+				derefErr, ok := err.(*DereferenceError)
+				assertEqual(t, ok, true)
+				assertEqual(t, derefErr.StatusCode, test.code)
+				assertEqual(t, derefErr.Unwrap(), test.expect)
+			})
+		}
+	})
+	t.Run("ReturnsErrorWhenHTML", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, hc, gs, _ := httpSigSetupFn(ctl)
+		resp := newTestResponse(http.StatusOK, []byte("<html></html>"))
+		resp.Header.Set(contentTypeHeader, "text/html; charset=utf-8")
+		// Mock
+		c.EXPECT().Now().Return(now())
+		gs.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
+		hc.EXPECT().Do(gomock.Any()).Return(resp, nil)
+		// Run & Verify
+		b, err := tp.Dereference(ctx, mustParse(testNoteId1))
+		assertEqual(t, len(b), 0)
+		derefErr, ok := err.(*DereferenceError)
+		assertEqual(t, ok, true)
+		assertEqual(t, derefErr.ContentType, "text/html; charset=utf-8")
+		assertEqual(t, derefErr.Unwrap(), ErrNotActivityStreamsMediaType)
+	})
+	t.Run("AddsRequestHeader", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, hc, gs, _ := httpSigSetupFn(ctl)
+		header := http.Header{}
+		header.Set("X-Instance-Contact", "admin@example.com")
+		header.Set("Accept-Charset", "latin1")
+		hctx := WithRequestHeader(ctx, header)
+		var got http.Header
+		// Mock
+		c.EXPECT().Now().Return(now())
+		gs.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
+		hc.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			got = r.Header
+			return newTestResponse(http.StatusOK, testRespBody), nil
+		})
+		// Run & Verify
+		_, err := tp.Dereference(hctx, mustParse(testNoteId1))
+		assertEqual(t, err, nil)
+		assertEqual(t, got.Get("X-Instance-Contact"), "admin@example.com")
+		assertEqual(t, got.Get("Accept-Charset"), "utf-8")
+		assertEqual(t, got.Get("User-Agent"), fmt.Sprintf("%s %s", testAppAgent, goFedUserAgent()))
+	})
 }
 
 func TestHttpSigTransportDeliver(t *testing.T) {
@@ -108,12 +269,86 @@ func TestHttpSigTransportDeliver(t *testing.T) {
 		resp := respR.Result()
 		// Mock
 		c.EXPECT().Now().Return(now())
-		ps.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), testRespBody)
+		ps.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
 		hc.EXPECT().Do(gomock.Any()).Return(resp, nil)
 		// Run & Verify
 		err := tp.Deliver(ctx, testRespBody, mustParse(testFederatedActorIRI))
 		assertEqual(t, err, nil)
 	})
+	t.Run("RetriesWithFallbackSignerWhenUnauthorized", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, hc, s1, s2 := httpSigFallbackSetupFn(ctl)
+		// Mock
+		c.EXPECT().Now().Return(now())
+		first := s1.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
+		firstDo := hc.EXPECT().Do(gomock.Any()).Return(newTestResponse(http.StatusUnauthorized, nil), nil).After(first)
+		second := s2.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil).After(firstDo)
+		hc.EXPECT().Do(gomock.Any()).Return(newTestResponse(http.StatusAccepted, nil), nil).After(second)
+		// Run & Verify
+		err := tp.Deliver(ctx, testRespBody, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+	})
+	t.Run("ReturnsErrorWhenAllSignersUnauthorized", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, hc, s1, s2 := httpSigFallbackSetupFn(ctl)
+		// Mock
+		c.EXPECT().Now().Return(now())
+		s1.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
+		s2.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
+		hc.EXPECT().Do(gomock.Any()).Return(newTestResponse(http.StatusUnauthorized, nil), nil).Times(2)
+		// Run & Verify
+		err := tp.Deliver(ctx, testRespBody, mustParse(testFederatedActorIRI))
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("AddsCollectionSynchronizationHeader", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, hc, _, ps := httpSigSetupFn(ctl)
+		f := &followersSynchronization{
+			collectionId: mustParse("https://example.com/addison/followers"),
+			url:          mustParse("https://example.com/addison/followers_synchronization"),
+			followers:    []*url.URL{mustParse(testFederatedActorIRI), mustParse(testPersonIRI)},
+		}
+		sctx := withFollowersSynchronization(ctx, f)
+		var got string
+		// Mock
+		c.EXPECT().Now().Return(now())
+		ps.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
+		hc.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			got = r.Header.Get(collectionSynchronizationHeader)
+			return newTestResponse(http.StatusAccepted, nil), nil
+		})
+		// Run & Verify
+		err := tp.Deliver(sctx, testRespBody, mustParse(testFederatedInboxIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, got, f.header("other.example.com"))
+	})
+	t.Run("ReplacesUserAgent", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, hc, _, ps := httpSigSetupFn(ctl)
+		header := http.Header{}
+		header.Set("User-Agent", "MyApp/1.2.3 (+https://example.com/)")
+		hctx := WithRequestHeader(ctx, header)
+		var got string
+		// Mock
+		c.EXPECT().Now().Return(now())
+		ps.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil)
+		hc.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			got = r.Header.Get("User-Agent")
+			return newTestResponse(http.StatusAccepted, nil), nil
+		})
+		// Run & Verify
+		err := tp.Deliver(hctx, testRespBody, mustParse(testFederatedInboxIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, got, "MyApp/1.2.3 (+https://example.com/)")
+	})
 }
 
 func TestHttpSigTransportBatchDeliver(t *testing.T) {
@@ -129,7 +364,7 @@ func TestHttpSigTransportBatchDeliver(t *testing.T) {
 		resp := respR.Result()
 		// Mock
 		c.EXPECT().Now().Return(now()).Times(2)
-		ps.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), testRespBody).Times(2)
+		ps.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil).Times(2)
 		hc.EXPECT().Do(gomock.Any()).Return(resp, nil).Times(2)
 		// Run & Verify
 		err := tp.BatchDeliver(ctx, testRespBody, []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)})
@@ -148,12 +383,483 @@ func TestHttpSigTransportBatchDeliver(t *testing.T) {
 		testErr := fmt.Errorf("test error")
 		// Mock
 		c.EXPECT().Now().Return(now()).Times(2)
-		ps.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), testRespBody).Times(2)
-		first := hc.EXPECT().Do(gomock.Any()).Return(resp, nil)
-		hc.EXPECT().Do(gomock.Any()).Return(errResp, testErr).After(first)
+		ps.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil).Times(2)
+		hc.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			if r.URL.String() == testFederatedActorIRI2 {
+				return errResp, testErr
+			}
+			return resp, nil
+		}).Times(2)
+		// Run & Verify
+		err := tp.BatchDeliver(ctx, testRespBody, []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)})
+		bErr, ok := err.(*BatchDeliverError)
+		assertEqual(t, ok, true)
+		assertEqual(t, len(bErr.Delivered), 1)
+		assertEqual(t, bErr.Delivered[0].String(), testFederatedActorIRI)
+		assertEqual(t, len(bErr.Failed), 1)
+		assertEqual(t, bErr.Failed[0].Recipient.String(), testFederatedActorIRI2)
+		assertEqual(t, bErr.Failed[0].Err, testErr)
+	})
+	t.Run("ReturnsWhenContextDone", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp, c, hc, _, ps := httpSigSetupFn(ctl)
+		// gomock cannot handle http.NewRequest w/ Body differences.
+		respR := httptest.NewRecorder()
+		respR.WriteHeader(http.StatusOK)
+		resp := respR.Result()
+		release := make(chan struct{})
+		defer close(release)
+		timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		// Mock
+		c.EXPECT().Now().Return(now()).Times(2)
+		ps.EXPECT().SignRequest(testPrivKey, testPubKeyId, gomock.Any(), nil).Times(2)
+		hc.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			if r.URL.String() == testFederatedActorIRI2 {
+				// A slow host ignoring the deadline.
+				<-release
+			}
+			return resp, nil
+		}).Times(2)
+		// Run & Verify
+		err := tp.BatchDeliver(timeoutCtx, testRespBody, []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)})
+		bErr, ok := err.(*BatchDeliverError)
+		assertEqual(t, ok, true)
+		assertEqual(t, len(bErr.Delivered), 1)
+		assertEqual(t, bErr.Delivered[0].String(), testFederatedActorIRI)
+		assertEqual(t, len(bErr.Failed), 1)
+		assertEqual(t, bErr.Failed[0].Recipient.String(), testFederatedActorIRI2)
+		assertEqual(t, bErr.Failed[0].Err, context.DeadlineExceeded)
+	})
+}
+
+func TestBudgetTransportDeliver(t *testing.T) {
+	ctx := context.Background()
+	t.Run("AppliesPerRequestTimeout", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		tp := NewBudgetTransport(wrapped, mustParse(testMyOutboxIRI), time.Minute, time.Millisecond, NewMockDeliveryQueue(ctl))
+		// Mock
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, mustParse(testFederatedActorIRI)).DoAndReturn(func(c context.Context, b []byte, to *url.URL) error {
+			<-c.Done()
+			return c.Err()
+		})
+		// Run & Verify
+		err := tp.Deliver(ctx, testRespBody, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, context.DeadlineExceeded)
+	})
+	t.Run("NoTimeoutWhenZero", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		tp := NewBudgetTransport(wrapped, mustParse(testMyOutboxIRI), 0, 0, NewMockDeliveryQueue(ctl))
+		// Mock
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, mustParse(testFederatedActorIRI)).DoAndReturn(func(c context.Context, b []byte, to *url.URL) error {
+			_, hasDeadline := c.Deadline()
+			assertEqual(t, hasDeadline, false)
+			return nil
+		})
+		// Run & Verify
+		err := tp.Deliver(ctx, testRespBody, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+	})
+}
+
+func TestBudgetTransportBatchDeliver(t *testing.T) {
+	ctx := context.Background()
+	blockFn := func(c context.Context, b []byte, to *url.URL) error {
+		<-c.Done()
+		return c.Err()
+	}
+	t.Run("DeliversWithinBudget", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		tp := NewBudgetTransport(wrapped, mustParse(testMyOutboxIRI), time.Minute, time.Minute, NewMockDeliveryQueue(ctl))
+		// Mock
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, gomock.Any()).Return(nil).Times(2)
+		// Run & Verify
+		err := tp.BatchDeliver(ctx, testRespBody, []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)})
+		assertEqual(t, err, nil)
+	})
+	t.Run("ReturnsErrorWhenOneErrors", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		tp := NewBudgetTransport(wrapped, mustParse(testMyOutboxIRI), time.Minute, time.Minute, NewMockDeliveryQueue(ctl))
+		// Mock
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, mustParse(testFederatedActorIRI)).Return(nil)
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, mustParse(testFederatedActorIRI2)).Return(testErr)
 		// Run & Verify
 		err := tp.BatchDeliver(ctx, testRespBody, []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)})
 		assertNotEqual(t, err, nil)
+	})
+	t.Run("EnqueuesRemainingWhenBudgetElapses", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		q := NewMockDeliveryQueue(ctl)
+		tp := NewBudgetTransport(wrapped, mustParse(testMyOutboxIRI), 10*time.Millisecond, time.Minute, q)
+		// Mock
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, mustParse(testFederatedActorIRI)).Return(nil)
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, mustParse(testFederatedActorIRI2)).DoAndReturn(blockFn)
+		q.EXPECT().Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, []*url.URL{mustParse(testFederatedActorIRI2)})
+		// Run & Verify
+		err := tp.BatchDeliver(ctx, testRespBody, []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)})
+		assertEqual(t, err, nil)
+	})
+	t.Run("ReturnsErrorWhenEnqueueFails", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		q := NewMockDeliveryQueue(ctl)
+		tp := NewBudgetTransport(wrapped, mustParse(testMyOutboxIRI), 10*time.Millisecond, time.Minute, q)
+		// Mock
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, mustParse(testFederatedActorIRI)).DoAndReturn(blockFn)
+		q.EXPECT().Enqueue(ctx, mustParse(testMyOutboxIRI), testRespBody, []*url.URL{mustParse(testFederatedActorIRI)}).Return(testErr)
+		// Run & Verify
+		err := tp.BatchDeliver(ctx, testRespBody, []*url.URL{mustParse(testFederatedActorIRI)})
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("FailsRemainingWhenNoQueue", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		tp := NewBudgetTransport(wrapped, mustParse(testMyOutboxIRI), 10*time.Millisecond, time.Minute, nil)
+		// Mock
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, mustParse(testFederatedActorIRI)).Return(nil)
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, mustParse(testFederatedActorIRI2)).DoAndReturn(blockFn)
+		// Run & Verify
+		err := tp.BatchDeliver(ctx, testRespBody, []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)})
+		bErr, ok := err.(*BatchDeliverError)
+		assertEqual(t, ok, true)
+		assertEqual(t, len(bErr.Failed), 1)
+		assertEqual(t, bErr.Failed[0].Recipient.String(), testFederatedActorIRI2)
+		assertEqual(t, bErr.Failed[0].Err, errNoDeliveryQueue)
+		assertEqual(t, len(bErr.Requeued), 0)
+	})
+	t.Run("DoesNotEnqueueWhenCallerCancels", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		tp := NewBudgetTransport(wrapped, mustParse(testMyOutboxIRI), time.Minute, time.Minute, NewMockDeliveryQueue(ctl))
+		cancelCtx, cancel := context.WithCancel(ctx)
+		// Mock
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, mustParse(testFederatedActorIRI)).DoAndReturn(func(c context.Context, b []byte, to *url.URL) error {
+			cancel()
+			return blockFn(c, b, to)
+		})
+		// Run & Verify
+		err := tp.BatchDeliver(cancelCtx, testRespBody, []*url.URL{mustParse(testFederatedActorIRI)})
+		bErr, ok := err.(*BatchDeliverError)
+		assertEqual(t, ok, true)
+		assertEqual(t, len(bErr.Failed), 1)
+		assertEqual(t, bErr.Failed[0].Err, context.Canceled)
+	})
+}
 
+func TestRateLimitedTransport(t *testing.T) {
+	ctx := context.Background()
+	const interval = 50 * time.Millisecond
+	t.Run("AllowsBurst", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().DoAndReturn(time.Now).AnyTimes()
+		tp := NewRateLimitedTransport(wrapped, clock, float64(time.Second/interval), 2)
+		// Mock
+		wrapped.EXPECT().Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)).Return(nil).Times(2)
+		// Run & Verify
+		start := time.Now()
+		assertEqual(t, tp.Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)), nil)
+		assertEqual(t, tp.Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)), nil)
+		assertEqual(t, time.Since(start) < interval, true)
+	})
+	t.Run("QueuesBeyondLimit", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().DoAndReturn(time.Now).AnyTimes()
+		tp := NewRateLimitedTransport(wrapped, clock, float64(time.Second/interval), 1)
+		// Mock
+		wrapped.EXPECT().Deliver(gomock.Any(), testRespBody, gomock.Any()).Return(nil).Times(3)
+		// Run & Verify
+		start := time.Now()
+		err := tp.BatchDeliver(ctx, testRespBody, []*url.URL{
+			mustParse(testFederatedInboxIRI),
+			mustParse(testFederatedInboxIRI2),
+			mustParse(testFederatedActorIRI),
+		})
+		assertEqual(t, err, nil)
+		assertEqual(t, time.Since(start) >= 2*interval, true)
+	})
+	t.Run("DoesNotLimitAcrossHosts", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().DoAndReturn(time.Now).AnyTimes()
+		tp := NewRateLimitedTransport(wrapped, clock, float64(time.Second/interval), 1)
+		// Mock
+		wrapped.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(testRespBody, nil)
+		wrapped.EXPECT().Dereference(ctx, mustParse(testToIRI)).Return(testRespBody, nil)
+		// Run & Verify
+		start := time.Now()
+		_, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		_, err = tp.Dereference(ctx, mustParse(testToIRI))
+		assertEqual(t, err, nil)
+		assertEqual(t, time.Since(start) < interval, true)
+	})
+	t.Run("StopsWaitingWhenContextDone", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().DoAndReturn(time.Now).AnyTimes()
+		tp := NewRateLimitedTransport(wrapped, clock, 1.0/60, 1)
+		cancelCtx, cancel := context.WithCancel(ctx)
+		// Mock
+		wrapped.EXPECT().Deliver(cancelCtx, testRespBody, mustParse(testFederatedInboxIRI)).Return(nil)
+		// Run & Verify
+		assertEqual(t, tp.Deliver(cancelCtx, testRespBody, mustParse(testFederatedInboxIRI)), nil)
+		time.AfterFunc(time.Millisecond, cancel)
+		err := tp.Deliver(cancelCtx, testRespBody, mustParse(testFederatedInboxIRI))
+		assertEqual(t, err, context.Canceled)
+	})
+	t.Run("MeasuresRateWithClock", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		clock := NewMockClock(ctl)
+		tp := NewRateLimitedTransport(wrapped, clock, 1.0/60, 1)
+		// Mock
+		gomock.InOrder(
+			clock.EXPECT().Now().Return(now()),
+			clock.EXPECT().Now().Return(now().Add(time.Minute)),
+		)
+		wrapped.EXPECT().Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)).Return(nil).Times(2)
+		// Run & Verify
+		start := time.Now()
+		assertEqual(t, tp.Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)), nil)
+		assertEqual(t, tp.Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)), nil)
+		assertEqual(t, time.Since(start) < time.Second, true)
+	})
+	t.Run("NoLimitWhenZero", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		clock := NewMockClock(ctl)
+		clock.EXPECT().Now().DoAndReturn(time.Now).AnyTimes()
+		tp := NewRateLimitedTransport(wrapped, clock, 0, 0)
+		// Mock
+		wrapped.EXPECT().Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)).Return(nil).Times(3)
+		// Run & Verify
+		for i := 0; i < 3; i++ {
+			assertEqual(t, tp.Deliver(ctx, testRespBody, mustParse(testFederatedInboxIRI)), nil)
+		}
+	})
+}
+
+func TestCachingTransportDereference(t *testing.T) {
+	ctx := context.Background()
+	t.Run("FetchesAndCachesOnMiss", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		cache := NewMockDereferenceCache(ctl)
+		tp := NewCachingTransport(wrapped, cache)
+		// Mock
+		cache.EXPECT().Get(ctx, mustParse(testFederatedActorIRI)).Return(nil, false)
+		wrapped.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(testRespBody, nil)
+		cache.EXPECT().Set(ctx, mustParse(testFederatedActorIRI), testRespBody)
+		// Run & Verify
+		b, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, testRespBody)
+	})
+	t.Run("ReturnsCachedPayload", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		cache := NewMockDereferenceCache(ctl)
+		tp := NewCachingTransport(wrapped, cache)
+		// Mock
+		cache.EXPECT().Get(ctx, mustParse(testFederatedActorIRI)).Return(testRespBody, true)
+		// Run & Verify
+		b, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, testRespBody)
+	})
+	t.Run("FetchesAndCachesWhenForcedToRefresh", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		cache := NewMockDereferenceCache(ctl)
+		tp := NewCachingTransport(wrapped, cache)
+		refreshCtx := WithForceRefresh(ctx)
+		// Mock
+		wrapped.EXPECT().Dereference(refreshCtx, mustParse(testFederatedActorIRI)).Return(testRespBody, nil)
+		cache.EXPECT().Set(refreshCtx, mustParse(testFederatedActorIRI), testRespBody)
+		// Run & Verify
+		b, err := tp.Dereference(refreshCtx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, testRespBody)
+	})
+	t.Run("DoesNotCacheErrors", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped := NewMockTransport(ctl)
+		cache := NewMockDereferenceCache(ctl)
+		tp := NewCachingTransport(wrapped, cache)
+		// Mock
+		cache.EXPECT().Get(ctx, mustParse(testFederatedActorIRI)).Return(nil, false)
+		wrapped.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(nil, testErr)
+		// Run & Verify
+		_, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, testErr)
+	})
+}
+
+func TestLocalFirstTransportDereference(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (wrapped *MockTransport, db *MockDatabase, tp *LocalFirstTransport) {
+		wrapped = NewMockTransport(ctl)
+		db = NewMockDatabase(ctl)
+		tp = NewLocalFirstTransport(wrapped, db)
+		return
+	}
+	t.Run("ReturnsStoredValue", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, db, tp := setupFn(ctl)
+		setupData()
+		// Mock
+		db.EXPECT().Lock(ctx, mustParse(testFederatedActorIRI))
+		db.EXPECT().Exists(ctx, mustParse(testFederatedActorIRI)).Return(true, nil)
+		db.EXPECT().Get(ctx, mustParse(testFederatedActorIRI)).Return(testFederatedPerson1, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testFederatedActorIRI))
+		// Run & Verify
+		b, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, mustSerializeToBytes(testFederatedPerson1))
+	})
+	t.Run("FetchesMissingValue", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped, db, tp := setupFn(ctl)
+		// Mock
+		db.EXPECT().Lock(ctx, mustParse(testFederatedActorIRI))
+		db.EXPECT().Exists(ctx, mustParse(testFederatedActorIRI)).Return(false, nil)
+		db.EXPECT().Unlock(ctx, mustParse(testFederatedActorIRI))
+		wrapped.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(testRespBody, nil)
+		// Run & Verify
+		b, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, testRespBody)
+	})
+	t.Run("FetchesWhenForcedToRefresh", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		wrapped, _, tp := setupFn(ctl)
+		refreshCtx := WithForceRefresh(ctx)
+		// Mock
+		wrapped.EXPECT().Dereference(refreshCtx, mustParse(testFederatedActorIRI)).Return(testRespBody, nil)
+		// Run & Verify
+		b, err := tp.Dereference(refreshCtx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		assertByteEqual(t, b, testRespBody)
+	})
+	t.Run("ReturnsDatabaseError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, db, tp := setupFn(ctl)
+		// Mock
+		db.EXPECT().Lock(ctx, mustParse(testFederatedActorIRI))
+		db.EXPECT().Exists(ctx, mustParse(testFederatedActorIRI)).Return(false, testErr)
+		db.EXPECT().Unlock(ctx, mustParse(testFederatedActorIRI))
+		// Run & Verify
+		_, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, testErr)
+	})
+}
+
+func TestMemoryDereferenceCache(t *testing.T) {
+	ctx := context.Background()
+	t.Run("ExpiresAfterTTL", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c := NewMockClock(ctl)
+		cache := NewMemoryDereferenceCache(time.Minute, 0, c)
+		// Mock
+		gomock.InOrder(
+			c.EXPECT().Now().Return(now()),
+			c.EXPECT().Now().Return(now().Add(time.Second)),
+			c.EXPECT().Now().Return(now().Add(time.Minute)),
+		)
+		// Run & Verify
+		cache.Set(ctx, mustParse(testFederatedActorIRI), testRespBody)
+		b, ok := cache.Get(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, ok, true)
+		assertByteEqual(t, b, testRespBody)
+		_, ok = cache.Get(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, ok, false)
+	})
+	t.Run("EvictsLeastRecentlyUsed", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cache := NewMemoryDereferenceCache(0, 2, NewMockClock(ctl))
+		// Run & Verify
+		cache.Set(ctx, mustParse(testFederatedActorIRI), testRespBody)
+		cache.Set(ctx, mustParse(testFederatedActorIRI2), testRespBody)
+		_, ok := cache.Get(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, ok, true)
+		cache.Set(ctx, mustParse(testFederatedActorIRI3), testRespBody)
+		_, ok = cache.Get(ctx, mustParse(testFederatedActorIRI2))
+		assertEqual(t, ok, false)
+		_, ok = cache.Get(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, ok, true)
+		_, ok = cache.Get(ctx, mustParse(testFederatedActorIRI3))
+		assertEqual(t, ok, true)
+	})
+	t.Run("Invalidates", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cache := NewMemoryDereferenceCache(0, 0, NewMockClock(ctl))
+		// Run & Verify
+		cache.Set(ctx, mustParse(testFederatedActorIRI), testRespBody)
+		cache.Invalidate(ctx, mustParse(testFederatedActorIRI))
+		_, ok := cache.Get(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, ok, false)
 	})
 }
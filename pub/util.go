@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -25,42 +26,39 @@ var (
 	// set. Can be returned by DelegateActor's PostInbox or PostOutbox so a
 	// Bad Request response is set.
 	ErrTargetRequired = errors.New("target property required on the provided activity")
+	// ErrOneOfAndAnyOf indicates the Question activity has both its oneOf
+	// and anyOf properties set, which is not permitted. Can be returned by
+	// DelegateActor's PostInbox or PostOutbox so a Bad Request response is
+	// set.
+	ErrOneOfAndAnyOf = errors.New("oneOf and anyOf properties both set on the provided question")
+	// ErrUnauthorizedUpdate indicates a federated Update changes an object
+	// that is not owned by its actor, or was not signed by its actor. A
+	// Forbidden response is set when returned by DelegateActor's PostInbox.
+	ErrUnauthorizedUpdate = errors.New("update of an object not owned by its actor")
+	// ErrObjectNotFound indicates a dereferenced IRI does not exist, or no
+	// longer exists. The error of a Transport's Dereference wraps it when
+	// the peer responds with Not Found or Gone.
+	ErrObjectNotFound = errors.New("object not found")
+	// ErrNotAuthorized indicates a peer refused to let the dereferenced IRI
+	// be fetched. The error of a Transport's Dereference wraps it when the
+	// peer responds with Unauthorized or Forbidden.
+	ErrNotAuthorized = errors.New("not authorized to fetch object")
+	// ErrNotActivityStreamsMediaType indicates a dereferenced IRI is not
+	// served as ActivityStreams, such as an HTML page of the object. The
+	// error of a Transport's Dereference wraps it.
+	ErrNotActivityStreamsMediaType = errors.New("response is not an ActivityStreams media type")
 )
 
-// activityStreamsMediaTypes contains all of the accepted ActivityStreams media
-// types. Generated at init time.
-var activityStreamsMediaTypes []string
-
-func init() {
-	activityStreamsMediaTypes = []string{
-		"application/activity+json",
-	}
-	jsonLdType := "application/ld+json"
-	for _, semi := range []string{";", " ;", " ; ", "; "} {
-		for _, profile := range []string{
-			"profile=https://www.w3.org/ns/activitystreams",
-			"profile=\"https://www.w3.org/ns/activitystreams\"",
-		} {
-			activityStreamsMediaTypes = append(
-				activityStreamsMediaTypes,
-				fmt.Sprintf("%s%s%s", jsonLdType, semi, profile))
-		}
-	}
-}
-
-// headerIsActivityPubMediaType returns true if the header string contains one
-// of the accepted ActivityStreams media types.
-//
-// Note we don't try to build a comprehensive parser and instead accept a
-// tolerable amount of whitespace since the HTTP specification is ambiguous
-// about the format and significance of whitespace.
-func headerIsActivityPubMediaType(header string) bool {
-	for _, mediaType := range activityStreamsMediaTypes {
-		if strings.Contains(header, mediaType) {
-			return true
-		}
+// isUndeserializable returns true if the error of deserializing a request body
+// means it is not an ActivityStreams value understood by this library, such as
+// one of an unknown type or without a type, so that a Bad Request response is
+// set.
+func isUndeserializable(err error) bool {
+	if streams.IsUnmatchedErr(err) {
+		return true
 	}
-	return false
+	_, ok := err.(vocab.DeserializeError)
+	return ok
 }
 
 const (
@@ -73,13 +71,13 @@ const (
 // isActivityPubPost returns true if the request is a POST request that has the
 // ActivityStreams content type header
 func isActivityPubPost(r *http.Request) bool {
-	return r.Method == "POST" && headerIsActivityPubMediaType(r.Header.Get(contentTypeHeader))
+	return r.Method == "POST" && IsActivityPubMediaType(r.Header.Get(contentTypeHeader))
 }
 
-// isActivityPubGet returns true if the request is a GET request that has the
-// ActivityStreams content type header
+// isActivityPubGet returns true if the request is a GET request that accepts
+// the ActivityStreams content type
 func isActivityPubGet(r *http.Request) bool {
-	return r.Method == "GET" && headerIsActivityPubMediaType(r.Header.Get(acceptHeader))
+	return r.Method == "GET" && AcceptsActivityPub(r.Header.Get(acceptHeader))
 }
 
 // dedupeOrderedItems deduplicates the 'orderedItems' within an ordered
@@ -343,18 +341,15 @@ func filterURLs(u []*url.URL, fn func(s string) bool) []*url.URL {
 	return u
 }
 
-const (
-	// PublicActivityPubIRI is the IRI that indicates an Activity is meant
-	// to be visible for general public consumption.
-	PublicActivityPubIRI = "https://www.w3.org/ns/activitystreams#Public"
-	publicJsonLD         = "Public"
-	publicJsonLDAS       = "as:Public"
-)
+// PublicActivityPubIRI is the IRI that indicates an Activity is meant to be
+// visible for general public consumption.
+const PublicActivityPubIRI = streams.PublicCollection
 
 // IsPublic determines if an IRI string is the Public collection as defined in
-// the spec, including JSON-LD compliant collections.
+// the spec, including JSON-LD compliant collections. It is the same as
+// streams.IsPublicString.
 func IsPublic(s string) bool {
-	return s == PublicActivityPubIRI || s == publicJsonLD || s == publicJsonLDAS
+	return streams.IsPublicString(s)
 }
 
 // getInboxes extracts the 'inbox' IRIs from actor types.
@@ -792,6 +787,50 @@ func mustHaveActivityActorsMatchObjectActors(c context.Context,
 	return nil
 }
 
+// removeFromActorCollection removes the ids of the objects from one of the
+// actor's collections, such as its 'liked' or 'following' collection.
+func removeFromActorCollection(c context.Context,
+	db Database,
+	actorIRI *url.URL,
+	collection func(context.Context, *url.URL) (vocab.ActivityStreamsCollection, error),
+	op vocab.ActivityStreamsObjectProperty) error {
+	if op == nil {
+		return nil
+	}
+	opIds := make(map[string]bool, op.Len())
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		id, err := ToId(iter)
+		if err != nil {
+			return err
+		}
+		opIds[id.String()] = true
+	}
+	if err := db.Lock(c, actorIRI); err != nil {
+		return err
+	}
+	defer db.Unlock(c, actorIRI)
+	col, err := collection(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	items := col.GetActivityStreamsItems()
+	if items == nil {
+		return nil
+	}
+	for i := 0; i < items.Len(); /*Conditional*/ {
+		id, err := ToId(items.At(i))
+		if err != nil {
+			return err
+		}
+		if opIds[id.String()] {
+			items.Remove(i)
+		} else {
+			i++
+		}
+	}
+	return db.Update(c, col)
+}
+
 // add implements the logic of adding object ids to a target Collection or
 // OrderedCollection. This logic is shared by both the C2S and S2S protocols.
 func add(c context.Context,
@@ -993,3 +1032,477 @@ func requestId(r *http.Request) *url.URL {
 	id.Scheme = "https"
 	return id
 }
+
+// iriLocks is a set of in-process locks, one for each IRI.
+type iriLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// Lock takes the lock for the IRI, blocking until it is available.
+func (l *iriLocks) Lock(id *url.URL) error {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*sync.Mutex)
+	}
+	m, ok := l.locks[id.String()]
+	if !ok {
+		m = &sync.Mutex{}
+		l.locks[id.String()] = m
+	}
+	l.mu.Unlock()
+	m.Lock()
+	return nil
+}
+
+// Unlock releases the lock for the IRI.
+func (l *iriLocks) Unlock(id *url.URL) error {
+	l.mu.Lock()
+	m, ok := l.locks[id.String()]
+	l.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("unlock of unlocked id: %s", id)
+	}
+	m.Unlock()
+	return nil
+}
+
+// iriProperty is a property whose value is either an IRI or a type with an
+// id, such as the 'inbox' or 'followers' property.
+type iriProperty interface {
+	IsIRI() bool
+	GetIRI() *url.URL
+	GetType() vocab.Type
+}
+
+// propertyIRI returns the IRI of the property's value, or nil if it has none.
+func propertyIRI(p iriProperty) *url.URL {
+	if p.IsIRI() {
+		return p.GetIRI()
+	} else if t := p.GetType(); t != nil {
+		if id, err := GetId(t); err == nil {
+			return id
+		}
+	}
+	return nil
+}
+
+// actorBoxes returns the inbox and outbox IRIs of an actor, which are nil if
+// the value is not an actor.
+func actorBoxes(t vocab.Type) (inbox, outbox *url.URL) {
+	if i, ok := t.(inboxer); ok && i.GetActivityStreamsInbox() != nil {
+		inbox = propertyIRI(i.GetActivityStreamsInbox())
+	}
+	if o, ok := t.(outboxer); ok && o.GetActivityStreamsOutbox() != nil {
+		outbox = propertyIRI(o.GetActivityStreamsOutbox())
+	}
+	return
+}
+
+// followersIRI returns the IRI of the actor's 'followers', or nil if it has
+// none.
+func followersIRI(actor vocab.Type) *url.URL {
+	if f, ok := actor.(followerser); ok && f.GetActivityStreamsFollowers() != nil {
+		return propertyIRI(f.GetActivityStreamsFollowers())
+	}
+	return nil
+}
+
+// followingIRI returns the IRI of the actor's 'following', or nil if it has
+// none.
+func followingIRI(actor vocab.Type) *url.URL {
+	if f, ok := actor.(followinger); ok && f.GetActivityStreamsFollowing() != nil {
+		return propertyIRI(f.GetActivityStreamsFollowing())
+	}
+	return nil
+}
+
+// authorIRIs returns the ids of the 'actor' and 'attributedTo' of a value.
+func authorIRIs(t vocab.Type) (authors []*url.URL, err error) {
+	if ac, ok := t.(actorer); ok && ac.GetActivityStreamsActor() != nil {
+		p := ac.GetActivityStreamsActor()
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			var id *url.URL
+			if id, err = ToId(iter); err != nil {
+				return
+			}
+			authors = append(authors, id)
+		}
+	}
+	if at, ok := t.(attributedToer); ok && at.GetActivityStreamsAttributedTo() != nil {
+		p := at.GetActivityStreamsAttributedTo()
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			var id *url.URL
+			if id, err = ToId(iter); err != nil {
+				return
+			}
+			authors = append(authors, id)
+		}
+	}
+	return
+}
+
+// likedIRI returns the IRI of the actor's 'liked', or nil if it has none.
+func likedIRI(actor vocab.Type) *url.URL {
+	if l, ok := actor.(likeder); ok && l.GetActivityStreamsLiked() != nil {
+		return propertyIRI(l.GetActivityStreamsLiked())
+	}
+	return nil
+}
+
+// actorCollection returns the actor's collection at the IRI returned by
+// colIRI, or at the actor's IRI with the suffix appended. An empty collection
+// is created in the Database if none exists.
+func actorCollection(c context.Context, db Database, actorIRI *url.URL, suffix string, colIRI func(actor vocab.Type) *url.URL) (vocab.ActivityStreamsCollection, error) {
+	var id *url.URL
+	if actor, err := db.Get(c, actorIRI); err == nil {
+		id = colIRI(actor)
+	}
+	if id == nil {
+		var err error
+		if id, err = url.Parse(strings.TrimSuffix(actorIRI.String(), "/") + "/" + suffix); err != nil {
+			return nil, err
+		}
+	}
+	if t, err := db.Get(c, id); err == nil {
+		col, ok := t.(vocab.ActivityStreamsCollection)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a Collection: %s", suffix, id)
+		}
+		return col, nil
+	}
+	col := streams.NewActivityStreamsCollection()
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.Set(id)
+	col.SetJSONLDId(idProp)
+	col.SetActivityStreamsItems(streams.NewActivityStreamsItemsProperty())
+	if err := db.Create(c, col); err != nil {
+		return nil, err
+	}
+	return col, nil
+}
+
+// newBoxPage returns the items of an inbox or outbox as a single
+// OrderedCollectionPage with the id of the box.
+func newBoxPage(boxIRI *url.URL, items []*url.URL) vocab.ActivityStreamsOrderedCollectionPage {
+	page := streams.NewActivityStreamsOrderedCollectionPage()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(boxIRI)
+	page.SetJSONLDId(id)
+	oi := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, item := range items {
+		oi.AppendIRI(item)
+	}
+	page.SetActivityStreamsOrderedItems(oi)
+	return page
+}
+
+// newBoxCollection returns the items of an inbox or outbox as an
+// OrderedCollection with the id of the box.
+func newBoxCollection(boxIRI *url.URL, items []*url.URL) vocab.ActivityStreamsOrderedCollection {
+	col := streams.NewActivityStreamsOrderedCollection()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(boxIRI)
+	col.SetJSONLDId(id)
+	oi := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, item := range items {
+		oi.AppendIRI(item)
+	}
+	col.SetActivityStreamsOrderedItems(oi)
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(len(items))
+	col.SetActivityStreamsTotalItems(total)
+	return col
+}
+
+// boxPageItems returns the id of the inbox or outbox page and the ids of its
+// ordered items.
+func boxPageItems(page vocab.ActivityStreamsOrderedCollectionPage) (boxIRI *url.URL, items []*url.URL, err error) {
+	boxIRI, err = GetId(page)
+	if err != nil {
+		return
+	}
+	if oi := page.GetActivityStreamsOrderedItems(); oi != nil {
+		for iter := oi.Begin(); iter != oi.End(); iter = iter.Next() {
+			var id *url.URL
+			id, err = ToId(iter)
+			if err != nil {
+				return
+			}
+			items = append(items, id)
+		}
+	}
+	return
+}
+
+// serializeValue serializes the value to JSON bytes for storage.
+func serializeValue(t vocab.Type) ([]byte, error) {
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+// deserializeValue resolves JSON bytes from storage into a new value.
+func deserializeValue(c context.Context, b []byte) (vocab.Type, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return streams.ToType(c, m)
+}
+
+// updateCollectionItem prepends the id to, or removes it from, the items of a
+// Collection or OrderedCollection, and sets its 'totalItems' to the number of
+// items. Returns whether the collection changed.
+func updateCollectionItem(col vocab.Type, id *url.URL, add bool) (changed bool, err error) {
+	var n int
+	if c, ok := col.(itemser); ok {
+		items := c.GetActivityStreamsItems()
+		if items == nil {
+			items = streams.NewActivityStreamsItemsProperty()
+			c.SetActivityStreamsItems(items)
+		}
+		if add {
+			items.PrependIRI(id)
+			changed = true
+		} else {
+			for i := items.Len() - 1; i >= 0; i-- {
+				if itemId, err := ToId(items.At(i)); err == nil && itemId.String() == id.String() {
+					items.Remove(i)
+					changed = true
+				}
+			}
+		}
+		n = items.Len()
+	} else if oc, ok := col.(orderedItemser); ok {
+		oItems := oc.GetActivityStreamsOrderedItems()
+		if oItems == nil {
+			oItems = streams.NewActivityStreamsOrderedItemsProperty()
+			oc.SetActivityStreamsOrderedItems(oItems)
+		}
+		if add {
+			oItems.PrependIRI(id)
+			changed = true
+		} else {
+			for i := oItems.Len() - 1; i >= 0; i-- {
+				if itemId, err := ToId(oItems.At(i)); err == nil && itemId.String() == id.String() {
+					oItems.Remove(i)
+					changed = true
+				}
+			}
+		}
+		n = oItems.Len()
+	} else {
+		return false, fmt.Errorf("type is neither a Collection nor an OrderedCollection: %T", col)
+	}
+	if t, ok := col.(totalItemser); ok && changed {
+		total := streams.NewActivityStreamsTotalItemsProperty()
+		total.Set(n)
+		t.SetActivityStreamsTotalItems(total)
+	}
+	return
+}
+
+// dereferenceType fetches the ActivityStreams value at the IRI.
+func dereferenceType(c context.Context, t Transport, iri *url.URL) (vocab.Type, error) {
+	b, err := t.Dereference(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return streams.ToType(c, m)
+}
+
+// collectionItemIRIs returns the ids of the 'items' of a Collection, or of the
+// 'orderedItems' of an OrderedCollection.
+func collectionItemIRIs(col vocab.Type) (ids []*url.URL, err error) {
+	if i, ok := col.(itemser); ok {
+		if items := i.GetActivityStreamsItems(); items != nil {
+			for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+				var id *url.URL
+				if id, err = ToId(iter); err != nil {
+					return
+				}
+				ids = append(ids, id)
+			}
+		}
+	} else if oi, ok := col.(orderedItemser); ok {
+		if items := oi.GetActivityStreamsOrderedItems(); items != nil {
+			for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+				var id *url.URL
+				if id, err = ToId(iter); err != nil {
+					return
+				}
+				ids = append(ids, id)
+			}
+		}
+	} else {
+		err = fmt.Errorf("%T is not a Collection or OrderedCollection", col)
+	}
+	return
+}
+
+// verifyMove ensures the Move is of its actor to a target that lists the actor
+// in its 'alsoKnownAs' property, returning the moved actor and the target. The
+// target is obtained with the fetch function.
+func verifyMove(c context.Context, a vocab.ActivityStreamsMove, fetch func(c context.Context, iri *url.URL) (vocab.Type, error)) (origin, target *url.URL, err error) {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return nil, nil, ErrObjectRequired
+	}
+	tp := a.GetActivityStreamsTarget()
+	if tp == nil || tp.Len() == 0 {
+		return nil, nil, ErrTargetRequired
+	}
+	if op.Len() != 1 || tp.Len() != 1 {
+		return nil, nil, fmt.Errorf("Move must have exactly one object and target")
+	}
+	if origin, err = ToId(op.At(0)); err != nil {
+		return
+	}
+	if target, err = ToId(tp.At(0)); err != nil {
+		return
+	}
+	isActor := false
+	if actors := a.GetActivityStreamsActor(); actors != nil {
+		for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && id.String() == origin.String() {
+				isActor = true
+				break
+			}
+		}
+	}
+	if !isActor {
+		return nil, nil, fmt.Errorf("Move object %s is not its actor", origin)
+	}
+	t, err := fetch(c, target)
+	if err != nil {
+		return nil, nil, err
+	}
+	if aka, ok := t.(alsoKnownAser); ok && aka.GetActivityStreamsAlsoKnownAs() != nil {
+		for iter := aka.GetActivityStreamsAlsoKnownAs().Begin(); iter != aka.GetActivityStreamsAlsoKnownAs().End(); iter = iter.Next() {
+			if iter.IsIRI() && iter.GetIRI().String() == origin.String() {
+				return origin, target, nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("Move target %s does not list %s in alsoKnownAs", target, origin)
+}
+
+// toVote returns the Question the Note votes in and the name of the option it
+// votes for. A vote is a Note with a single 'name' and a single 'inReplyTo'.
+func toVote(note vocab.ActivityStreamsNote) (question *url.URL, option string, ok bool) {
+	name := note.GetActivityStreamsName()
+	if name == nil || name.Len() != 1 || !name.At(0).IsXMLSchemaString() {
+		return
+	}
+	inReplyTo := note.GetActivityStreamsInReplyTo()
+	if inReplyTo == nil || inReplyTo.Len() != 1 {
+		return
+	}
+	question, err := ToId(inReplyTo.At(0))
+	if err != nil {
+		return nil, "", false
+	}
+	return question, name.At(0).GetXMLSchemaString(), true
+}
+
+// isQuestionClosed determines whether the Question no longer accepts votes
+// at the time now, because of its 'closed' or 'endTime' properties.
+func isQuestionClosed(q vocab.ActivityStreamsQuestion, now time.Time) bool {
+	if closed := q.GetActivityStreamsClosed(); closed != nil {
+		for iter := closed.Begin(); iter != closed.End(); iter = iter.Next() {
+			if iter.IsXMLSchemaBoolean() {
+				if iter.GetXMLSchemaBoolean() {
+					return true
+				}
+			} else if iter.IsXMLSchemaDateTime() {
+				if !now.Before(iter.GetXMLSchemaDateTime()) {
+					return true
+				}
+			} else {
+				// An object or link also indicates the Question is
+				// closed.
+				return true
+			}
+		}
+	}
+	if end := q.GetActivityStreamsEndTime(); end != nil && end.IsXMLSchemaDateTime() {
+		return !now.Before(end.Get())
+	}
+	return false
+}
+
+// tallyVote adds one to the 'totalItems' of the 'replies' of the Question's
+// 'oneOf' or 'anyOf' option with the name. Returns false if the Question has
+// no such option.
+func tallyVote(q vocab.ActivityStreamsQuestion, option string) bool {
+	var options []vocab.Type
+	if oneOf := q.GetActivityStreamsOneOf(); oneOf != nil {
+		for iter := oneOf.Begin(); iter != oneOf.End(); iter = iter.Next() {
+			options = append(options, iter.GetType())
+		}
+	}
+	if anyOf := q.GetActivityStreamsAnyOf(); anyOf != nil {
+		for iter := anyOf.Begin(); iter != anyOf.End(); iter = iter.Next() {
+			options = append(options, iter.GetType())
+		}
+	}
+	for _, t := range options {
+		named, ok := t.(namer)
+		if !ok || named.GetActivityStreamsName() == nil {
+			continue
+		}
+		name := named.GetActivityStreamsName()
+		if name.Len() != 1 || !name.At(0).IsXMLSchemaString() || name.At(0).GetXMLSchemaString() != option {
+			continue
+		}
+		r, ok := t.(replieser)
+		if !ok {
+			return false
+		}
+		replies := r.GetActivityStreamsReplies()
+		if replies == nil {
+			replies = streams.NewActivityStreamsRepliesProperty()
+			r.SetActivityStreamsReplies(replies)
+		}
+		col, ok := replies.GetType().(totalItemser)
+		if !ok {
+			// Replace an absent or referenced collection with
+			// one embedding the tally.
+			c := streams.NewActivityStreamsCollection()
+			if replies.IsIRI() {
+				id := streams.NewJSONLDIdProperty()
+				id.Set(replies.GetIRI())
+				c.SetJSONLDId(id)
+			}
+			replies.SetActivityStreamsCollection(c)
+			col = c
+		}
+		n := 0
+		if total := col.GetActivityStreamsTotalItems(); total != nil && total.IsXMLSchemaNonNegativeInteger() {
+			n = total.Get()
+		}
+		total := streams.NewActivityStreamsTotalItemsProperty()
+		total.Set(n + 1)
+		col.SetActivityStreamsTotalItems(total)
+		return true
+	}
+	return false
+}
+
+// addVoter adds one to the Question's 'votersCount'.
+func addVoter(q vocab.ActivityStreamsQuestion) {
+	n := 0
+	if count := q.GetTootVotersCount(); count != nil && count.IsXMLSchemaNonNegativeInteger() {
+		n = count.Get()
+	}
+	count := streams.NewTootVotersCountProperty()
+	count.Set(n + 1)
+	q.SetTootVotersCount(count)
+}
@@ -4,17 +4,12 @@ import (
 	"testing"
 )
 
-func TestHeaderIsActivityPubMediaType(t *testing.T) {
+func TestIsActivityPubMediaType(t *testing.T) {
 	tests := []struct {
 		name     string
 		input    string
 		expected bool
 	}{
-		{
-			"Mastodon Accept Header",
-			"application/activity+json, application/ld+json",
-			true,
-		},
 		{
 			"Plain Type",
 			"application/activity+json",
@@ -65,10 +60,86 @@ func TestHeaderIsActivityPubMediaType(t *testing.T) {
 			"application/ld+json;profile=\"https://www.w3.org/ns/activitystreams\"",
 			true,
 		},
+		{
+			"With Profile After Other Parameter",
+			"application/ld+json; charset=utf-8; profile=\"https://www.w3.org/ns/activitystreams\"",
+			true,
+		},
+		{
+			"With Profile Among Profiles",
+			"application/ld+json; profile=\"https://example.com/profile https://www.w3.org/ns/activitystreams\"",
+			true,
+		},
+		{
+			"With Other Profile",
+			"application/ld+json; profile=\"https://example.com/profile\"",
+			false,
+		},
+		{
+			"Uppercase",
+			"Application/Activity+JSON; charset=utf-8",
+			true,
+		},
+		{
+			"Other Type",
+			"application/json",
+			false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := IsActivityPubMediaType(test.input); actual != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestAcceptsActivityPub(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			"Mastodon Accept Header",
+			"application/activity+json, application/ld+json",
+			true,
+		},
+		{
+			"Weighted",
+			"application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"; q=0.9, text/html; q=0.5",
+			true,
+		},
+		{
+			"Prefers HTML",
+			"text/html, application/activity+json; q=0.1",
+			false,
+		},
+		{
+			"Zero Weight",
+			"application/activity+json; q=0",
+			false,
+		},
+		{
+			"Wildcard",
+			"*/*",
+			false,
+		},
+		{
+			"Browser",
+			"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			false,
+		},
+		{
+			"Empty",
+			"",
+			false,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			if actual := headerIsActivityPubMediaType(test.input); actual != test.expected {
+			if actual := AcceptsActivityPub(test.input); actual != test.expected {
 				t.Fatalf("expected %v, got %v", test.expected, actual)
 			}
 		})
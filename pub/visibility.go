@@ -0,0 +1,199 @@
+package pub
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// Visibility is the audience of an activity or object, as Mastodon and other
+// fediverse software present it to their users.
+type Visibility int
+
+const (
+	// VisibilityPublic is addressed 'to' the Public collection, and shown
+	// on public timelines.
+	VisibilityPublic Visibility = iota
+	// VisibilityUnlisted is addressed 'cc' the Public collection, so that
+	// anyone may see it but it is not shown on public timelines.
+	VisibilityUnlisted
+	// VisibilityFollowersOnly is addressed to the followers of its author,
+	// and not to the Public collection.
+	VisibilityFollowersOnly
+	// VisibilityDirect is only addressed to the actors it mentions.
+	VisibilityDirect
+)
+
+// String returns the name Mastodon gives to the visibility.
+func (v Visibility) String() string {
+	switch v {
+	case VisibilityPublic:
+		return "public"
+	case VisibilityUnlisted:
+		return "unlisted"
+	case VisibilityFollowersOnly:
+		return "private"
+	case VisibilityDirect:
+		return "direct"
+	default:
+		return fmt.Sprintf("Visibility(%d)", int(v))
+	}
+}
+
+// ClassifyVisibility returns the visibility of an activity or object with the
+// recipients, given the followers collection of its author:
+//
+//   - Public if the Public collection is in 'to',
+//   - Unlisted if the Public collection is in 'cc',
+//   - FollowersOnly if the followers collection is in 'to' or 'cc',
+//   - Direct otherwise.
+//
+// The followersIRI may be nil if it is not known, in which case activities
+// addressed to the followers are classified as Direct.
+func ClassifyVisibility(to, cc []*url.URL, followersIRI *url.URL) Visibility {
+	for _, u := range to {
+		if IsPublic(u.String()) {
+			return VisibilityPublic
+		}
+	}
+	for _, u := range cc {
+		if IsPublic(u.String()) {
+			return VisibilityUnlisted
+		}
+	}
+	if followersIRI != nil {
+		for _, u := range append(to, cc...) {
+			if u.String() == followersIRI.String() {
+				return VisibilityFollowersOnly
+			}
+		}
+	}
+	return VisibilityDirect
+}
+
+// GetVisibility returns the visibility of the activity or object from its 'to'
+// and 'cc' properties, as ClassifyVisibility does.
+func GetVisibility(t vocab.Type, followersIRI *url.URL) (Visibility, error) {
+	var to, cc []*url.URL
+	var err error
+	if v, ok := t.(toer); ok {
+		if p := v.GetActivityStreamsTo(); p != nil {
+			if to, err = recipientIds(p); err != nil {
+				return VisibilityDirect, err
+			}
+		}
+	}
+	if v, ok := t.(ccer); ok {
+		if p := v.GetActivityStreamsCc(); p != nil {
+			if cc, err = recipientIds(p); err != nil {
+				return VisibilityDirect, err
+			}
+		}
+	}
+	return ClassifyVisibility(to, cc, followersIRI), nil
+}
+
+// recipientIds returns the ids of the recipients in an addressing property.
+//
+// The property is serialized, as streams.IsPubliclyAddressed does, because the
+// Public collection may be addressed as streams.PublicCollectionTerm, which is
+// not an IRI and is kept as an unknown value that the iterators do not expose.
+func recipientIds(p interface {
+	Serialize() (interface{}, error)
+}) (ids []*url.URL, err error) {
+	s, err := p.Serialize()
+	if err != nil {
+		return
+	}
+	values, ok := s.([]interface{})
+	if !ok {
+		values = []interface{}{s}
+	}
+	for _, value := range values {
+		if m, ok := value.(map[string]interface{}); ok {
+			value = m["id"]
+		}
+		str, ok := value.(string)
+		if !ok {
+			err = fmt.Errorf("cannot determine id of recipient: %v", value)
+			return
+		}
+		var id *url.URL
+		if id, err = url.Parse(str); err != nil {
+			return
+		}
+		ids = append(ids, id)
+	}
+	return
+}
+
+// Addressing returns the 'to' and 'cc' recipients for the visibility, given the
+// followers collection of the author and the actors mentioned, in the way
+// Mastodon addresses its statuses:
+//
+//   - Public is 'to' the Public collection and 'cc' the followers,
+//   - Unlisted is 'to' the followers and 'cc' the Public collection,
+//   - FollowersOnly is 'to' the followers,
+//   - Direct is 'to' the mentioned actors only.
+//
+// The mentioned actors are in 'cc' unless the visibility is Direct. An error is
+// returned if the followersIRI is nil and the visibility is not Direct.
+func Addressing(v Visibility, followersIRI *url.URL, mentions []*url.URL) (to, cc []*url.URL, err error) {
+	public, err := url.Parse(PublicActivityPubIRI)
+	if err != nil {
+		return
+	}
+	if followersIRI == nil && v != VisibilityDirect {
+		err = fmt.Errorf("cannot address %s visibility: no followers collection", v)
+		return
+	}
+	switch v {
+	case VisibilityPublic:
+		to = []*url.URL{public}
+		cc = append([]*url.URL{followersIRI}, mentions...)
+	case VisibilityUnlisted:
+		to = []*url.URL{followersIRI}
+		cc = append([]*url.URL{public}, mentions...)
+	case VisibilityFollowersOnly:
+		to = []*url.URL{followersIRI}
+		cc = append(cc, mentions...)
+	case VisibilityDirect:
+		to = append(to, mentions...)
+	default:
+		err = fmt.Errorf("unknown visibility: %d", int(v))
+	}
+	return
+}
+
+// SetVisibility replaces the 'to' and 'cc' properties of the activity or object
+// with the recipients returned by Addressing. On error, they are left unchanged.
+func SetVisibility(t vocab.Type, v Visibility, followersIRI *url.URL, mentions []*url.URL) error {
+	tt, ok := t.(toer)
+	if !ok {
+		return fmt.Errorf("cannot address type %T: no 'to' property", t)
+	}
+	ct, ok := t.(ccer)
+	if !ok {
+		return fmt.Errorf("cannot address type %T: no 'cc' property", t)
+	}
+	to, cc, err := Addressing(v, followersIRI, mentions)
+	if err != nil {
+		return err
+	}
+	toProp := streams.NewActivityStreamsToProperty()
+	for _, u := range to {
+		toProp.AppendIRI(u)
+	}
+	tt.SetActivityStreamsTo(toProp)
+	if len(cc) == 0 {
+		ct.SetActivityStreamsCc(nil)
+		return nil
+	}
+	ccProp := streams.NewActivityStreamsCcProperty()
+	for _, u := range cc {
+		ccProp.AppendIRI(u)
+	}
+	ct.SetActivityStreamsCc(ccProp)
+	return nil
+}
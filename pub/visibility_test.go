@@ -0,0 +1,109 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/go-fed/activity/streams"
+)
+
+func TestClassifyVisibility(t *testing.T) {
+	public := mustParse(PublicActivityPubIRI)
+	followers := mustParse(testFederatedActorIRI + "/followers")
+	mention := mustParse(testFederatedActorIRI2)
+	tests := []struct {
+		name      string
+		to        []*url.URL
+		cc        []*url.URL
+		followers *url.URL
+		expect    Visibility
+	}{
+		{"Public", []*url.URL{public}, []*url.URL{followers}, followers, VisibilityPublic},
+		{"CompactedPublic", []*url.URL{mustParse("as:Public")}, nil, followers, VisibilityPublic},
+		{"Unlisted", []*url.URL{followers}, []*url.URL{public}, followers, VisibilityUnlisted},
+		{"FollowersOnly", []*url.URL{followers}, []*url.URL{mention}, followers, VisibilityFollowersOnly},
+		{"FollowersInCc", []*url.URL{mention}, []*url.URL{followers}, followers, VisibilityFollowersOnly},
+		{"Direct", []*url.URL{mention}, nil, followers, VisibilityDirect},
+		{"UnknownFollowers", []*url.URL{followers}, nil, nil, VisibilityDirect},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assertEqual(t, ClassifyVisibility(test.to, test.cc, test.followers), test.expect)
+		})
+	}
+}
+
+func TestGetVisibility(t *testing.T) {
+	followers := mustParse(testFederatedActorIRI + "/followers")
+	tests := []struct {
+		name   string
+		json   string
+		expect Visibility
+	}{
+		{"PublicTerm", `{"@context":"https://www.w3.org/ns/activitystreams","type":"Note","to":["Public"]}`, VisibilityPublic},
+		{"CompactedPublicInCc", `{"@context":"https://www.w3.org/ns/activitystreams","type":"Note","to":["` + followers.String() + `"],"cc":"as:Public"}`, VisibilityUnlisted},
+		{"EmbeddedFollowers", `{"@context":"https://www.w3.org/ns/activitystreams","type":"Note","to":[{"type":"Collection","id":"` + followers.String() + `"}]}`, VisibilityFollowersOnly},
+		{"Direct", `{"@context":"https://www.w3.org/ns/activitystreams","type":"Note","to":["` + testFederatedActorIRI2 + `"]}`, VisibilityDirect},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var m map[string]interface{}
+			err := json.Unmarshal([]byte(test.json), &m)
+			assertEqual(t, err, nil)
+			v, err := streams.ToType(context.Background(), m)
+			assertEqual(t, err, nil)
+			got, err := GetVisibility(v, followers)
+			assertEqual(t, err, nil)
+			assertEqual(t, got, test.expect)
+		})
+	}
+	t.Run("RecipientWithoutId", func(t *testing.T) {
+		note := streams.NewActivityStreamsNote()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendActivityStreamsPerson(streams.NewActivityStreamsPerson())
+		note.SetActivityStreamsTo(to)
+		_, err := GetVisibility(note, followers)
+		assertNotEqual(t, err, nil)
+	})
+}
+
+func TestAddressing(t *testing.T) {
+	followers := mustParse(testFederatedActorIRI + "/followers")
+	mention := mustParse(testFederatedActorIRI2)
+	for _, v := range []Visibility{VisibilityPublic, VisibilityUnlisted, VisibilityFollowersOnly, VisibilityDirect} {
+		t.Run(v.String(), func(t *testing.T) {
+			note := streams.NewActivityStreamsNote()
+			err := SetVisibility(note, v, followers, []*url.URL{mention})
+			assertEqual(t, err, nil)
+			got, err := GetVisibility(note, followers)
+			assertEqual(t, err, nil)
+			assertEqual(t, got, v)
+			to, cc, err := Addressing(v, followers, []*url.URL{mention})
+			assertEqual(t, err, nil)
+			mentioned := false
+			for _, u := range append(to, cc...) {
+				mentioned = mentioned || u.String() == mention.String()
+			}
+			assertEqual(t, mentioned, true)
+		})
+	}
+	t.Run("UnknownVisibility", func(t *testing.T) {
+		_, _, err := Addressing(Visibility(42), followers, nil)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("NoFollowers", func(t *testing.T) {
+		for _, v := range []Visibility{VisibilityPublic, VisibilityUnlisted, VisibilityFollowersOnly} {
+			_, _, err := Addressing(v, nil, []*url.URL{mention})
+			assertNotEqual(t, err, nil)
+			note := streams.NewActivityStreamsNote()
+			err = SetVisibility(note, v, nil, []*url.URL{mention})
+			assertNotEqual(t, err, nil)
+			assertEqual(t, note.GetActivityStreamsTo(), nil)
+		}
+		to, _, err := Addressing(VisibilityDirect, nil, []*url.URL{mention})
+		assertEqual(t, err, nil)
+		assertEqual(t, len(to), 1)
+	})
+}
@@ -0,0 +1,87 @@
+package webfinger
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+)
+
+// maxCacheEntries bounds the number of values cached by a Client or Resolver,
+// so that looking up many accounts cannot exhaust the server's memory.
+const maxCacheEntries = 10000
+
+// cacheEntry is a cached value, its key, and the time it expires.
+type cacheEntry struct {
+	key     string
+	v       interface{}
+	expires time.Time
+}
+
+// ttlCache keeps values for a time to live, evicting the least recently used
+// value once it holds maxEntries. It is safe for concurrent use.
+type ttlCache struct {
+	clock      pub.Clock
+	ttl        time.Duration
+	maxEntries int
+	mu         sync.Mutex
+	// lru is ordered from the most to the least recently used entry.
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+// newTTLCache returns a cache keeping values for the ttl, as measured by the
+// clock, and at most maxCacheEntries of them. A zero ttl disables the cache.
+func newTTLCache(clock pub.Clock, ttl time.Duration) *ttlCache {
+	return &ttlCache{
+		clock:      clock,
+		ttl:        ttl,
+		maxEntries: maxCacheEntries,
+		lru:        list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// get returns the unexpired value of the key, removing it if it has expired.
+func (c *ttlCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*cacheEntry)
+	if !c.clock.Now().Before(entry.expires) {
+		c.remove(e)
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	return entry.v, true
+}
+
+// put caches the value of the key, evicting the least recently used value if
+// the cache is full.
+func (c *ttlCache) put(key string, v interface{}) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := &cacheEntry{key: key, v: v, expires: c.clock.Now().Add(c.ttl)}
+	if e, ok := c.entries[key]; ok {
+		e.Value = entry
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	if c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove deletes the entry. The lock must be held.
+func (c *ttlCache) remove(e *list.Element) {
+	c.lru.Remove(e)
+	delete(c.entries, e.Value.(*cacheEntry).key)
+}
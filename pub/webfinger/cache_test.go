@@ -0,0 +1,46 @@
+package webfinger
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTTLCache(t *testing.T) {
+	setupFn := func() (c *ttlCache, clock *testClock) {
+		clock = &testClock{t: time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)}
+		c = newTTLCache(clock, time.Hour)
+		return
+	}
+	t.Run("ExpiresValue", func(t *testing.T) {
+		c, clock := setupFn()
+		c.put("a", 1)
+		if v, ok := c.get("a"); !ok || v != 1 {
+			t.Fatalf("got %v, %v, want 1, true", v, ok)
+		}
+		clock.t = clock.t.Add(time.Hour)
+		if _, ok := c.get("a"); ok {
+			t.Fatalf("got expired value")
+		} else if len(c.entries) != 0 {
+			t.Fatalf("got %d entries, want 0", len(c.entries))
+		}
+	})
+	t.Run("EvictsLeastRecentlyUsed", func(t *testing.T) {
+		c, _ := setupFn()
+		c.maxEntries = 2
+		c.put("a", 1)
+		c.put("b", 2)
+		c.get("a")
+		c.put("c", 3)
+		if _, ok := c.get("b"); ok {
+			t.Fatalf("got evicted value")
+		}
+		for _, key := range []string{"a", "c"} {
+			if _, ok := c.get(key); !ok {
+				t.Fatalf("missing value of %q", key)
+			}
+		}
+		if len(c.entries) != 2 {
+			t.Fatalf("got %d entries, want 2", len(c.entries))
+		}
+	})
+}
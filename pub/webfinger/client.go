@@ -0,0 +1,110 @@
+package webfinger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-fed/activity/pub"
+)
+
+// maxResponseSize bounds the size of the WebFinger responses read by a Client.
+const maxResponseSize = 1 << 20
+
+// Client resolves the accounts of remote hosts with WebFinger, caching each
+// Resource for a time to live.
+//
+// It is safe for concurrent use.
+type Client struct {
+	client   pub.HttpClient
+	appAgent string
+	clock    pub.Clock
+	ttl      time.Duration
+	cache    *ttlCache
+}
+
+// NewClient returns a Client sending requests with the HttpClient, identified
+// by the appAgent User-Agent, and caching each Resource for the ttl as
+// measured by the clock. A zero ttl disables the cache.
+func NewClient(client pub.HttpClient, appAgent string, clock pub.Clock, ttl time.Duration) *Client {
+	return &Client{
+		client:   client,
+		appAgent: appAgent,
+		clock:    clock,
+		ttl:      ttl,
+		cache:    newTTLCache(clock, ttl),
+	}
+}
+
+// Lookup returns the Resource of the account, which may be an "acct:" URI or
+// a "user@domain" handle.
+func (c *Client) Lookup(ctx context.Context, account string) (*Resource, error) {
+	username, host, err := ParseAccount(account)
+	if err != nil {
+		return nil, err
+	}
+	key := AccountURI(username, strings.ToLower(host))
+	if res, ok := c.cache.get(key); ok {
+		return res.(*Resource), nil
+	}
+	res, err := c.fetch(ctx, host, key)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.put(key, res)
+	return res, nil
+}
+
+// ResolveActor returns the IRI of the actor of the account, which may be an
+// "acct:" URI or a "user@domain" handle.
+func (c *Client) ResolveActor(ctx context.Context, account string) (*url.URL, error) {
+	res, err := c.Lookup(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	l := res.ActorLink()
+	if l == nil {
+		return nil, fmt.Errorf("webfinger: %s has no ActivityStreams link", account)
+	}
+	return url.Parse(l.Href)
+}
+
+// fetch requests the Resource of the account URI from the host.
+func (c *Client) fetch(ctx context.Context, host, account string) (*Resource, error) {
+	u := &url.URL{
+		Scheme:   "https",
+		Host:     host,
+		Path:     WellKnownPath,
+		RawQuery: url.Values{resourceQuery: []string{account}}.Encode(),
+	}
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", ContentType+", application/json")
+	req.Header.Set("User-Agent", c.appAgent)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("webfinger: GET %s responded with status (%d): %s", u, resp.StatusCode, resp.Status)
+	}
+	b, err := ioutil.ReadAll(&io.LimitedReader{R: resp.Body, N: maxResponseSize})
+	if err != nil {
+		return nil, err
+	}
+	var res Resource
+	if err = json.Unmarshal(b, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,79 @@
+package webfinger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testClock is a clock whose time is advanced by tests.
+type testClock struct {
+	t time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.t
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func() (c *Client, clock *testClock, host string, requests *int, server *httptest.Server) {
+		requests = new(int)
+		var h http.Handler
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*requests++
+			h.ServeHTTP(w, r)
+		}))
+		host = strings.TrimPrefix(server.URL, "https://")
+		h = NewHandler(host, testAccounts{})
+		clock = &testClock{t: time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)}
+		c = NewClient(server.Client(), "testApp", clock, time.Hour)
+		return
+	}
+	t.Run("ResolvesActor", func(t *testing.T) {
+		c, _, host, _, server := setupFn()
+		defer server.Close()
+		actor, err := c.ResolveActor(ctx, "@addison@"+host)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if actor.String() != testActorIRI {
+			t.Fatalf("got %s, want %s", actor, testActorIRI)
+		}
+	})
+	t.Run("CachesResource", func(t *testing.T) {
+		c, clock, host, requests, server := setupFn()
+		defer server.Close()
+		for i := 0; i < 2; i++ {
+			if _, err := c.Lookup(ctx, "acct:addison@"+host); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+		if *requests != 1 {
+			t.Fatalf("got %d requests, want 1", *requests)
+		}
+		clock.t = clock.t.Add(time.Hour)
+		if _, err := c.Lookup(ctx, "addison@"+host); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if *requests != 2 {
+			t.Fatalf("got %d requests after expiry, want 2", *requests)
+		}
+	})
+	t.Run("ErrorIfNotFound", func(t *testing.T) {
+		c, _, host, _, server := setupFn()
+		defer server.Close()
+		if _, err := c.ResolveActor(ctx, "dakota@"+host); err == nil {
+			t.Fatalf("expected error, got none")
+		}
+	})
+	t.Run("ErrorIfNoActorLink", func(t *testing.T) {
+		c, _, _, _, server := setupFn()
+		defer server.Close()
+		c.cache.put("acct:addison@example.com", &Resource{Subject: "acct:addison@example.com"})
+		if _, err := c.ResolveActor(ctx, "addison@example.com"); err == nil {
+			t.Fatalf("expected error, got none")
+		}
+	})
+}
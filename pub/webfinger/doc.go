@@ -0,0 +1,11 @@
+// Package webfinger implements the WebFinger protocol of RFC 7033, which
+// ActivityPub peers use to discover the actor behind a "user@domain" handle.
+//
+// A Handler serves "/.well-known/webfinger" for the actors of this server, and
+// a Client resolves the handles of remote actors, caching the results. A
+// Resolver also fetches the actors of the handles, to obtain their inboxes and
+// public keys.
+//
+// A HostMetaHandler serves "/.well-known/host-meta" for older implementations
+// that look up the WebFinger template before making requests.
+package webfinger
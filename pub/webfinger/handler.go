@@ -0,0 +1,124 @@
+package webfinger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrNotFound is returned by Accounts when there is no actor with a username.
+var ErrNotFound = errors.New("webfinger: account not found")
+
+// Accounts finds the actors of this server.
+type Accounts interface {
+	// Actor returns the IRI of the actor with the username, and the IRI of
+	// its web page if it has one.
+	//
+	// Returns ErrNotFound if there is no actor with the username.
+	Actor(c context.Context, username string) (actor, profile *url.URL, err error)
+}
+
+// Handler serves WebFinger requests for the accounts of a host.
+type Handler struct {
+	host     string
+	accounts Accounts
+}
+
+// Handler must implement http.Handler.
+var _ http.Handler = &Handler{}
+
+// NewHandler returns a Handler for the accounts of the host, which is the
+// domain in the "user@domain" handles of its actors.
+//
+// It is served at WellKnownPath:
+//
+//	http.Handle(webfinger.WellKnownPath, webfinger.NewHandler("example.com", myAccounts))
+func NewHandler(host string, accounts Accounts) *Handler {
+	return &Handler{
+		host:     host,
+		accounts: accounts,
+	}
+}
+
+// ServeHTTP responds with the Resource of the account in the "resource" query
+// parameter, limited to the links with the relations in any "rel" query
+// parameters.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	q := r.URL.Query()
+	resource := q.Get(resourceQuery)
+	if resource == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	username, host, err := ParseAccount(resource)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	} else if !strings.EqualFold(host, h.host) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	actor, profile, err := h.accounts.Actor(r.Context(), username)
+	if err == ErrNotFound {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	} else if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	res := Resource{
+		Subject: AccountURI(username, h.host),
+		Aliases: []string{actor.String()},
+		Links: []Link{
+			{
+				Rel:  SelfRel,
+				Type: ActivityStreamsType,
+				Href: actor.String(),
+			},
+		},
+	}
+	if profile != nil {
+		res.Aliases = append(res.Aliases, profile.String())
+		res.Links = append(res.Links, Link{
+			Rel:  ProfilePageRel,
+			Type: "text/html",
+			Href: profile.String(),
+		})
+	}
+	if rels := q[relQuery]; len(rels) > 0 {
+		res.Links = filterLinks(res.Links, rels)
+	}
+	b, err := json.Marshal(res)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", ContentType)
+	// WebFinger resources are public, and are fetched by web clients of
+	// other hosts.
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(http.StatusOK)
+	w.Write(b)
+}
+
+// filterLinks returns the links with one of the relations.
+func filterLinks(links []Link, rels []string) []Link {
+	var filtered []Link
+	for _, l := range links {
+		for _, rel := range rels {
+			if l.Rel == rel {
+				filtered = append(filtered, l)
+				break
+			}
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,129 @@
+package webfinger
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+const (
+	testHost       = "example.com"
+	testActorIRI   = "https://example.com/users/addison"
+	testProfileIRI = "https://example.com/@addison"
+)
+
+// testAccounts has the single actor "addison", with a web page.
+type testAccounts struct{}
+
+func (testAccounts) Actor(c context.Context, username string) (actor, profile *url.URL, err error) {
+	if username != "addison" {
+		return nil, nil, ErrNotFound
+	}
+	return mustParse(testActorIRI), mustParse(testProfileIRI), nil
+}
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func TestParseAccount(t *testing.T) {
+	for _, test := range []struct {
+		account  string
+		username string
+		host     string
+		err      bool
+	}{
+		{account: "acct:addison@example.com", username: "addison", host: "example.com"},
+		{account: "addison@example.com", username: "addison", host: "example.com"},
+		{account: "@addison@example.com", username: "addison", host: "example.com"},
+		{account: "addison@example.com:8443", username: "addison", host: "example.com:8443"},
+		{account: "addison", err: true},
+		{account: "@example.com", err: true},
+		{account: "addison@", err: true},
+	} {
+		username, host, err := ParseAccount(test.account)
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected error, got none", test.account)
+			}
+		} else if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.account, err)
+		} else if username != test.username || host != test.host {
+			t.Errorf("%s: got %q and %q, want %q and %q", test.account, username, host, test.username, test.host)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	serveFn := func(method, target string) *httptest.ResponseRecorder {
+		resp := httptest.NewRecorder()
+		NewHandler(testHost, testAccounts{}).ServeHTTP(resp, httptest.NewRequest(method, target, nil))
+		return resp
+	}
+	t.Run("ServesActor", func(t *testing.T) {
+		resp := serveFn("GET", WellKnownPath+"?resource=acct:addison@example.com")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("got status %d, want %d", resp.Code, http.StatusOK)
+		} else if ct := resp.Header().Get("Content-Type"); ct != ContentType {
+			t.Fatalf("got Content-Type %q, want %q", ct, ContentType)
+		}
+		var res Resource
+		if err := json.Unmarshal(resp.Body.Bytes(), &res); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		expect := Resource{
+			Subject: "acct:addison@example.com",
+			Aliases: []string{testActorIRI, testProfileIRI},
+			Links: []Link{
+				{Rel: SelfRel, Type: ActivityStreamsType, Href: testActorIRI},
+				{Rel: ProfilePageRel, Type: "text/html", Href: testProfileIRI},
+			},
+		}
+		if !reflect.DeepEqual(res, expect) {
+			t.Fatalf("got %+v, want %+v", res, expect)
+		} else if l := res.ActorLink(); l == nil || l.Href != testActorIRI {
+			t.Fatalf("got actor link %+v, want %s", l, testActorIRI)
+		}
+	})
+	t.Run("FiltersLinksByRel", func(t *testing.T) {
+		resp := serveFn("GET", WellKnownPath+"?resource=acct:addison@example.com&rel=self")
+		var res Resource
+		if err := json.Unmarshal(resp.Body.Bytes(), &res); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if len(res.Links) != 1 || res.Links[0].Rel != SelfRel {
+			t.Fatalf("got links %+v, want only the %q link", res.Links, SelfRel)
+		}
+	})
+	t.Run("NotFoundForUnknownUser", func(t *testing.T) {
+		resp := serveFn("GET", WellKnownPath+"?resource=acct:dakota@example.com")
+		if resp.Code != http.StatusNotFound {
+			t.Fatalf("got status %d, want %d", resp.Code, http.StatusNotFound)
+		}
+	})
+	t.Run("NotFoundForOtherHost", func(t *testing.T) {
+		resp := serveFn("GET", WellKnownPath+"?resource=acct:addison@other.example.com")
+		if resp.Code != http.StatusNotFound {
+			t.Fatalf("got status %d, want %d", resp.Code, http.StatusNotFound)
+		}
+	})
+	t.Run("BadRequestWithoutResource", func(t *testing.T) {
+		resp := serveFn("GET", WellKnownPath)
+		if resp.Code != http.StatusBadRequest {
+			t.Fatalf("got status %d, want %d", resp.Code, http.StatusBadRequest)
+		}
+	})
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		resp := serveFn("POST", WellKnownPath+"?resource=acct:addison@example.com")
+		if resp.Code != http.StatusMethodNotAllowed {
+			t.Fatalf("got status %d, want %d", resp.Code, http.StatusMethodNotAllowed)
+		}
+	})
+}
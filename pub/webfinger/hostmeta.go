@@ -0,0 +1,89 @@
+package webfinger
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// HostMetaPath is the path at which a host serves its host-meta
+	// document.
+	HostMetaPath = "/.well-known/host-meta"
+	// HostMetaContentType is the media type of a host-meta document.
+	HostMetaContentType = "application/xrd+xml"
+	// LRDDRel is the relation of the host-meta link to the WebFinger
+	// template.
+	LRDDRel = "lrdd"
+	// xrdNamespace is the XML namespace of XRD documents.
+	xrdNamespace = "http://docs.oasis-open.org/ns/xri/xrd-1.0"
+	// uriTemplateVar is replaced by the resource in a WebFinger template.
+	uriTemplateVar = "{uri}"
+)
+
+// xrd is an XRD document, of which host-meta documents are made.
+type xrd struct {
+	XMLName xml.Name  `xml:"http://docs.oasis-open.org/ns/xri/xrd-1.0 XRD"`
+	Links   []xrdLink `xml:"Link"`
+}
+
+// xrdLink is a link of an XRD document.
+type xrdLink struct {
+	Rel      string `xml:"rel,attr"`
+	Type     string `xml:"type,attr,omitempty"`
+	Template string `xml:"template,attr,omitempty"`
+}
+
+// HostMetaHandler serves the host-meta document of RFC 6415, which links to
+// the WebFinger template of a host. Older implementations look it up before
+// making WebFinger requests.
+type HostMetaHandler struct {
+	body []byte
+}
+
+// HostMetaHandler must implement http.Handler.
+var _ http.Handler = &HostMetaHandler{}
+
+// NewHostMetaHandler returns a HostMetaHandler for the host serving WebFinger
+// requests at WellKnownPath over HTTPS.
+//
+// It is served at HostMetaPath:
+//
+//	http.Handle(webfinger.HostMetaPath, webfinger.NewHostMetaHandler("example.com"))
+func NewHostMetaHandler(host string) *HostMetaHandler {
+	u := &url.URL{
+		Scheme:   "https",
+		Host:     host,
+		Path:     WellKnownPath,
+		RawQuery: resourceQuery + "=" + uriTemplateVar,
+	}
+	b, err := xml.MarshalIndent(xrd{
+		Links: []xrdLink{
+			{
+				Rel:      LRDDRel,
+				Type:     ContentType,
+				Template: u.String(),
+			},
+		},
+	}, "", "  ")
+	if err != nil {
+		// The document is made only of strings.
+		panic(err)
+	}
+	return &HostMetaHandler{
+		body: append([]byte(xml.Header), b...),
+	}
+}
+
+// ServeHTTP responds with the host-meta document.
+func (h *HostMetaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", HostMetaContentType)
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(http.StatusOK)
+	w.Write(h.body)
+}
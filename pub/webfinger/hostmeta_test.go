@@ -0,0 +1,32 @@
+package webfinger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHostMetaHandler(t *testing.T) {
+	t.Run("ServesWebFingerTemplate", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		NewHostMetaHandler(testHost).ServeHTTP(resp, httptest.NewRequest("GET", HostMetaPath, nil))
+		expect := `<?xml version="1.0" encoding="UTF-8"?>
+<XRD xmlns="http://docs.oasis-open.org/ns/xri/xrd-1.0">
+  <Link rel="lrdd" type="application/jrd+json" template="https://example.com/.well-known/webfinger?resource={uri}"></Link>
+</XRD>`
+		if resp.Code != http.StatusOK {
+			t.Fatalf("got status %d, want %d", resp.Code, http.StatusOK)
+		} else if ct := resp.Header().Get("Content-Type"); ct != HostMetaContentType {
+			t.Fatalf("got Content-Type %q, want %q", ct, HostMetaContentType)
+		} else if body := resp.Body.String(); body != expect {
+			t.Fatalf("got %s, want %s", body, expect)
+		}
+	})
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		NewHostMetaHandler(testHost).ServeHTTP(resp, httptest.NewRequest("POST", HostMetaPath, nil))
+		if resp.Code != http.StatusMethodNotAllowed {
+			t.Fatalf("got status %d, want %d", resp.Code, http.StatusMethodNotAllowed)
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package webfinger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// RemoteActor is the actor of an account, as resolved by a Resolver.
+type RemoteActor struct {
+	// ID is the IRI of the actor.
+	ID *url.URL
+	// Actor is the deserialized ActivityStreams actor.
+	Actor vocab.Type
+	// Inbox is the IRI of the actor's inbox.
+	Inbox *url.URL
+	// SharedInbox is the IRI of the actor's 'sharedInbox' endpoint, or nil
+	// if it has none.
+	SharedInbox *url.URL
+	// PublicKeys are the keys in the actor's 'publicKey' property.
+	PublicKeys []PublicKey
+}
+
+// PublicKey is a key verifying the HTTP Signatures of a RemoteActor.
+type PublicKey struct {
+	// ID is the keyId of the signatures made with the key.
+	ID *url.URL
+	// Owner is the IRI of the actor owning the key.
+	Owner *url.URL
+	// PEM is the encoded public key. It is empty if the actor only
+	// references the key by its id.
+	PEM string
+}
+
+// Resolver resolves accounts to their actors with WebFinger, fetching the
+// actors with a Transport. It caches each RemoteActor for the time to live of
+// its Client.
+//
+// It is safe for concurrent use, as long as the Transport is.
+type Resolver struct {
+	client    *Client
+	transport pub.Transport
+	cache     *ttlCache
+}
+
+// NewResolver returns a Resolver looking up accounts with the Client and
+// fetching their actors with the Transport.
+func NewResolver(client *Client, t pub.Transport) *Resolver {
+	return &Resolver{
+		client:    client,
+		transport: t,
+		cache:     newTTLCache(client.clock, client.ttl),
+	}
+}
+
+// ResolveActor returns the actor of the account, which may be an "acct:" URI
+// or a "user@domain" handle.
+//
+// The actor is fetched from the link in the account's WebFinger Resource, and
+// must have that link as its id, and an inbox.
+func (r *Resolver) ResolveActor(c context.Context, account string) (*RemoteActor, error) {
+	username, host, err := ParseAccount(account)
+	if err != nil {
+		return nil, err
+	}
+	key := AccountURI(username, strings.ToLower(host))
+	if a, ok := r.cache.get(key); ok {
+		return a.(*RemoteActor), nil
+	}
+	iri, err := r.client.ResolveActor(c, key)
+	if err != nil {
+		return nil, err
+	}
+	b, err := r.transport.Dereference(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	t, err := streams.ToType(c, m)
+	if err != nil {
+		return nil, err
+	}
+	a, err := toRemoteActor(t)
+	if err != nil {
+		return nil, err
+	} else if a.ID.String() != iri.String() {
+		return nil, fmt.Errorf("webfinger: actor of %s has id %s, want %s", account, a.ID, iri)
+	}
+	r.cache.put(key, a)
+	return a, nil
+}
+
+// actor is an ActivityStreams actor, with the properties of a RemoteActor.
+type actor interface {
+	vocab.Type
+	GetActivityStreamsInbox() vocab.ActivityStreamsInboxProperty
+	GetW3IDSecurityV1PublicKey() vocab.W3IDSecurityV1PublicKeyProperty
+	GetUnknownProperties() map[string]interface{}
+}
+
+// toRemoteActor returns the RemoteActor of the ActivityStreams value, which
+// must be an actor.
+func toRemoteActor(t vocab.Type) (*RemoteActor, error) {
+	id, err := pub.GetId(t)
+	if err != nil {
+		return nil, err
+	}
+	act, ok := t.(actor)
+	if !ok || act.GetActivityStreamsInbox() == nil {
+		return nil, fmt.Errorf("webfinger: %s is not an actor with an inbox", id)
+	}
+	inbox, err := pub.ToId(act.GetActivityStreamsInbox())
+	if err != nil {
+		return nil, err
+	}
+	a := &RemoteActor{
+		ID:          id,
+		Actor:       t,
+		Inbox:       inbox,
+		SharedInbox: streams.GetSharedInbox(act),
+	}
+	if keys := act.GetW3IDSecurityV1PublicKey(); keys != nil {
+		for iter := keys.Begin(); iter != keys.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				a.PublicKeys = append(a.PublicKeys, PublicKey{ID: iter.GetIRI(), Owner: id})
+				continue
+			} else if !iter.IsW3IDSecurityV1PublicKey() {
+				continue
+			}
+			k := iter.Get()
+			pk := PublicKey{Owner: id}
+			if kid := k.GetJSONLDId(); kid != nil {
+				pk.ID = kid.Get()
+			}
+			if owner := k.GetW3IDSecurityV1Owner(); owner != nil && owner.Get() != nil {
+				pk.Owner = owner.Get()
+			}
+			if pem := k.GetW3IDSecurityV1PublicKeyPem(); pem != nil {
+				pk.PEM = pem.Get()
+			}
+			a.PublicKeys = append(a.PublicKeys, pk)
+		}
+	}
+	return a, nil
+}
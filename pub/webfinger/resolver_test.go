@@ -0,0 +1,117 @@
+package webfinger
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testActor is the actor with the id testActorIRI.
+const testActor = `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    "https://w3id.org/security/v1"
+  ],
+  "id": "https://example.com/users/addison",
+  "type": "Person",
+  "inbox": "https://example.com/users/addison/inbox",
+  "endpoints": {
+    "sharedInbox": "https://example.com/inbox"
+  },
+  "publicKey": {
+    "id": "https://example.com/users/addison#main-key",
+    "owner": "https://example.com/users/addison",
+    "publicKeyPem": "-----BEGIN PUBLIC KEY-----"
+  }
+}`
+
+// testTransport dereferences the values it has, counting the requests.
+type testTransport struct {
+	values   map[string]string
+	requests int
+}
+
+func (t *testTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	t.requests++
+	v, ok := t.values[iri.String()]
+	if !ok {
+		return nil, fmt.Errorf("no value at %s", iri)
+	}
+	return []byte(v), nil
+}
+
+func (t *testTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	return fmt.Errorf("unexpected delivery to %s", to)
+}
+
+func (t *testTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	return fmt.Errorf("unexpected batch delivery")
+}
+
+func TestResolver(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(actor string) (r *Resolver, tp *testTransport, host string, server *httptest.Server) {
+		server = httptest.NewTLSServer(nil)
+		host = strings.TrimPrefix(server.URL, "https://")
+		server.Config.Handler = NewHandler(host, testAccounts{})
+		clock := &testClock{t: time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)}
+		tp = &testTransport{values: map[string]string{testActorIRI: actor}}
+		r = NewResolver(NewClient(server.Client(), "testApp", clock, time.Hour), tp)
+		return
+	}
+	t.Run("ResolvesActor", func(t *testing.T) {
+		r, _, host, server := setupFn(testActor)
+		defer server.Close()
+		a, err := r.ResolveActor(ctx, "addison@"+host)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		} else if a.ID.String() != testActorIRI {
+			t.Fatalf("got id %s, want %s", a.ID, testActorIRI)
+		} else if a.Inbox.String() != testActorIRI+"/inbox" {
+			t.Fatalf("got inbox %s, want %s", a.Inbox, testActorIRI+"/inbox")
+		} else if a.SharedInbox == nil || a.SharedInbox.String() != "https://example.com/inbox" {
+			t.Fatalf("got sharedInbox %s, want https://example.com/inbox", a.SharedInbox)
+		} else if a.Actor.GetTypeName() != "Person" {
+			t.Fatalf("got type %s, want Person", a.Actor.GetTypeName())
+		}
+		if len(a.PublicKeys) != 1 {
+			t.Fatalf("got %d public keys, want 1", len(a.PublicKeys))
+		} else if k := a.PublicKeys[0]; k.ID.String() != testActorIRI+"#main-key" || k.Owner.String() != testActorIRI || k.PEM != "-----BEGIN PUBLIC KEY-----" {
+			t.Fatalf("got public key %+v", k)
+		}
+	})
+	t.Run("CachesActor", func(t *testing.T) {
+		r, tp, host, server := setupFn(testActor)
+		defer server.Close()
+		for i := 0; i < 2; i++ {
+			if _, err := r.ResolveActor(ctx, "acct:addison@"+host); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+		if tp.requests != 1 {
+			t.Fatalf("got %d requests, want 1", tp.requests)
+		}
+	})
+	t.Run("ErrorIfActorHasOtherId", func(t *testing.T) {
+		r, _, host, server := setupFn(strings.Replace(testActor, `"id": "https://example.com/users/addison"`, `"id": "https://example.com/users/dakota"`, 1))
+		defer server.Close()
+		if _, err := r.ResolveActor(ctx, "addison@"+host); err == nil {
+			t.Fatalf("expected error, got none")
+		}
+	})
+	t.Run("ErrorIfNotActor", func(t *testing.T) {
+		r, _, host, server := setupFn(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/users/addison",
+  "type": "Note"
+}`)
+		defer server.Close()
+		if _, err := r.ResolveActor(ctx, "addison@"+host); err == nil {
+			t.Fatalf("expected error, got none")
+		}
+	})
+}
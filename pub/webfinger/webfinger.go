@@ -0,0 +1,79 @@
+package webfinger
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// WellKnownPath is the path at which a host serves WebFinger requests.
+	WellKnownPath = "/.well-known/webfinger"
+	// ContentType is the media type of a WebFinger response.
+	ContentType = "application/jrd+json"
+	// SelfRel is the relation of the link to an actor's ActivityStreams
+	// document.
+	SelfRel = "self"
+	// ProfilePageRel is the relation of the link to an actor's web page.
+	ProfilePageRel = "http://webfinger.net/rel/profile-page"
+	// ActivityStreamsType is the media type of the link to an actor's
+	// ActivityStreams document.
+	ActivityStreamsType = "application/activity+json"
+	// resourceQuery is the query parameter naming the requested resource.
+	resourceQuery = "resource"
+	// relQuery is the query parameter restricting the returned links.
+	relQuery = "rel"
+	// acctScheme is the scheme of account URIs.
+	acctScheme = "acct:"
+	// ldJSONActivityStreamsType is the other media type peers use for the
+	// link to an actor's ActivityStreams document.
+	ldJSONActivityStreamsType = `application/ld+json; profile="https://www.w3.org/ns/activitystreams"`
+)
+
+// Resource is a JSON Resource Descriptor, the body of a WebFinger response.
+type Resource struct {
+	Subject string   `json:"subject"`
+	Aliases []string `json:"aliases,omitempty"`
+	Links   []Link   `json:"links,omitempty"`
+}
+
+// Link is a link of a Resource.
+type Link struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type,omitempty"`
+	Href string `json:"href,omitempty"`
+	// Template is used instead of Href by links such as the
+	// "http://ostatus.org/schema/1.0/subscribe" link.
+	Template string `json:"template,omitempty"`
+}
+
+// ActorLink returns the link to the ActivityStreams document of the actor
+// described by the Resource, or nil if there is none.
+func (r *Resource) ActorLink() *Link {
+	for i, l := range r.Links {
+		if l.Rel != SelfRel || l.Href == "" {
+			continue
+		}
+		if l.Type == ActivityStreamsType || l.Type == ldJSONActivityStreamsType {
+			return &r.Links[i]
+		}
+	}
+	return nil
+}
+
+// ParseAccount splits an account into its username and host. The account may
+// be an "acct:" URI, or a "user@domain" handle optionally prefixed with "@".
+func ParseAccount(account string) (username, host string, err error) {
+	s := strings.TrimPrefix(account, acctScheme)
+	s = strings.TrimPrefix(s, "@")
+	i := strings.LastIndex(s, "@")
+	if i <= 0 || i == len(s)-1 {
+		err = fmt.Errorf("account %q is not of the form user@domain", account)
+		return
+	}
+	return s[:i], s[i+1:], nil
+}
+
+// AccountURI returns the "acct:" URI of the username at the host.
+func AccountURI(username, host string) string {
+	return acctScheme + username + "@" + host
+}
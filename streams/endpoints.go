@@ -0,0 +1,141 @@
+package streams
+
+import (
+	"net/url"
+)
+
+const (
+	// endpointsProperty is the name of the ActivityPub 'endpoints' property
+	// on actors.
+	endpointsProperty = "endpoints"
+	// EndpointSharedInbox is the 'sharedInbox' endpoint of an actor.
+	EndpointSharedInbox = "sharedInbox"
+	// EndpointProxyUrl is the 'proxyUrl' endpoint of an actor.
+	EndpointProxyUrl = "proxyUrl"
+	// EndpointOauthAuthorizationEndpoint is the
+	// 'oauthAuthorizationEndpoint' endpoint of an actor.
+	EndpointOauthAuthorizationEndpoint = "oauthAuthorizationEndpoint"
+	// EndpointOauthTokenEndpoint is the 'oauthTokenEndpoint' endpoint of an
+	// actor.
+	EndpointOauthTokenEndpoint = "oauthTokenEndpoint"
+	// EndpointProvideClientKey is the 'provideClientKey' endpoint of an
+	// actor.
+	EndpointProvideClientKey = "provideClientKey"
+	// EndpointSignClientKey is the 'signClientKey' endpoint of an actor.
+	EndpointSignClientKey = "signClientKey"
+	// EndpointUploadMedia is the 'uploadMedia' endpoint of an actor.
+	EndpointUploadMedia = "uploadMedia"
+)
+
+// endpointsHolder is any ActivityStreams type that can have an 'endpoints'
+// property, such as the actor types.
+//
+// The 'endpoints' value is a JSON object without a 'type', so it is not
+// deserialized into a type and is kept with the unknown properties instead.
+type endpointsHolder interface {
+	GetUnknownProperties() map[string]interface{}
+}
+
+// GetEndpoint returns the IRI of the named endpoint of an actor, such as
+// EndpointSharedInbox. It returns nil if the actor has no 'endpoints', if the
+// 'endpoints' is only an IRI to another document, or if the named endpoint is
+// absent or not an IRI.
+func GetEndpoint(actor endpointsHolder, name string) *url.URL {
+	endpoints, ok := actor.GetUnknownProperties()[endpointsProperty].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	s, ok := endpoints[name].(string)
+	if !ok {
+		return nil
+	}
+	u, err := url.Parse(s)
+	if err != nil || len(u.Scheme) == 0 {
+		return nil
+	}
+	return u
+}
+
+// SetEndpoint sets the IRI of the named endpoint of an actor, such as
+// EndpointSharedInbox. The 'endpoints' object is created if it does not already
+// exist, replacing an 'endpoints' that is only an IRI. A nil IRI removes the
+// endpoint, and removes the 'endpoints' object once it is empty.
+func SetEndpoint(actor endpointsHolder, name string, iri *url.URL) {
+	unknown := actor.GetUnknownProperties()
+	endpoints, ok := unknown[endpointsProperty].(map[string]interface{})
+	if iri == nil {
+		if !ok {
+			return
+		}
+		delete(endpoints, name)
+		if len(endpoints) == 0 {
+			delete(unknown, endpointsProperty)
+		}
+		return
+	}
+	if !ok {
+		endpoints = make(map[string]interface{})
+		unknown[endpointsProperty] = endpoints
+	}
+	endpoints[name] = iri.String()
+}
+
+// GetSharedInbox returns the 'sharedInbox' endpoint of an actor, or nil if it
+// has none.
+func GetSharedInbox(actor endpointsHolder) *url.URL {
+	return GetEndpoint(actor, EndpointSharedInbox)
+}
+
+// SetSharedInbox sets the 'sharedInbox' endpoint of an actor, creating the
+// 'endpoints' object if needed.
+func SetSharedInbox(actor endpointsHolder, iri *url.URL) {
+	SetEndpoint(actor, EndpointSharedInbox, iri)
+}
+
+// GetProxyUrl returns the 'proxyUrl' endpoint of an actor, or nil if it has
+// none.
+func GetProxyUrl(actor endpointsHolder) *url.URL {
+	return GetEndpoint(actor, EndpointProxyUrl)
+}
+
+// SetProxyUrl sets the 'proxyUrl' endpoint of an actor, creating the
+// 'endpoints' object if needed.
+func SetProxyUrl(actor endpointsHolder, iri *url.URL) {
+	SetEndpoint(actor, EndpointProxyUrl, iri)
+}
+
+// GetOauthAuthorizationEndpoint returns the 'oauthAuthorizationEndpoint'
+// endpoint of an actor, or nil if it has none.
+func GetOauthAuthorizationEndpoint(actor endpointsHolder) *url.URL {
+	return GetEndpoint(actor, EndpointOauthAuthorizationEndpoint)
+}
+
+// SetOauthAuthorizationEndpoint sets the 'oauthAuthorizationEndpoint' endpoint
+// of an actor, creating the 'endpoints' object if needed.
+func SetOauthAuthorizationEndpoint(actor endpointsHolder, iri *url.URL) {
+	SetEndpoint(actor, EndpointOauthAuthorizationEndpoint, iri)
+}
+
+// GetOauthTokenEndpoint returns the 'oauthTokenEndpoint' endpoint of an actor,
+// or nil if it has none.
+func GetOauthTokenEndpoint(actor endpointsHolder) *url.URL {
+	return GetEndpoint(actor, EndpointOauthTokenEndpoint)
+}
+
+// SetOauthTokenEndpoint sets the 'oauthTokenEndpoint' endpoint of an actor,
+// creating the 'endpoints' object if needed.
+func SetOauthTokenEndpoint(actor endpointsHolder, iri *url.URL) {
+	SetEndpoint(actor, EndpointOauthTokenEndpoint, iri)
+}
+
+// GetUploadMedia returns the 'uploadMedia' endpoint of an actor, or nil if it
+// has none.
+func GetUploadMedia(actor endpointsHolder) *url.URL {
+	return GetEndpoint(actor, EndpointUploadMedia)
+}
+
+// SetUploadMedia sets the 'uploadMedia' endpoint of an actor, creating the
+// 'endpoints' object if needed.
+func SetUploadMedia(actor endpointsHolder, iri *url.URL) {
+	SetEndpoint(actor, EndpointUploadMedia, iri)
+}
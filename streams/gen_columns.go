@@ -0,0 +1,2995 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+// Column describes how a property of an ActivityStreams type can be stored in a
+// relational database column. It is intended for ORMs and migration
+// generators, so that applications storing properties in columns stay in sync
+// with the vocabulary.
+type Column struct {
+	// Property is the name of the property.
+	Property string
+	// Vocabulary is the name of the vocabulary defining the property.
+	Vocabulary string
+	// GoKind is the Go type of the property's value. Properties whose
+	// values are ActivityStreams types are stored as their IRI.
+	GoKind string
+	// SQLType is a portable SQL column type for the property. Values
+	// that cannot be stored in a scalar column, such as non-functional
+	// properties, natural language maps, or properties with values of
+	// several kinds, are JSON.
+	SQLType string
+	// Nullable is true if the property may be absent.
+	Nullable bool
+}
+
+// Columns are the columns of every ActivityStreams type, keyed by the vocabulary
+// name followed by the type name, such as "ActivityStreamsAccept". The
+// columns are in property order.
+var Columns = map[string][]Column{
+	"ActivityStreamsAccept": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsActivity": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsAdd": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsAnnounce": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsApplication": {
+		{"alsoKnownAs", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"discoverable", "Toot", "bool", "BOOLEAN", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"featured", "Toot", "*url.URL", "TEXT", true},
+		{"featuredTags", "Toot", "*url.URL", "TEXT", true},
+		{"followers", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"following", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inbox", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"indexable", "Toot", "bool", "BOOLEAN", true},
+		{"liked", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"movedTo", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"outbox", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"preferredUsername", "ActivityStreams", "string", "TEXT", true},
+		{"preferredUsernameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"publicKey", "W3IDSecurityV1", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"streams", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsArrive": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsArticle": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsAudio": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"blurhash", "Toot", "string", "TEXT", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsBlock": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsCollection": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"current", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"first", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"items", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"last", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"totalItems", "ActivityStreams", "int", "BIGINT", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsCollectionPage": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"current", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"first", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"items", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"last", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"next", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"partOf", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"prev", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"totalItems", "ActivityStreams", "int", "BIGINT", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsCreate": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsDelete": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsDislike": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsDocument": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"blurhash", "Toot", "string", "TEXT", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsEvent": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsFlag": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsFollow": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsGroup": {
+		{"alsoKnownAs", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"discoverable", "Toot", "bool", "BOOLEAN", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"featured", "Toot", "*url.URL", "TEXT", true},
+		{"featuredTags", "Toot", "*url.URL", "TEXT", true},
+		{"followers", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"following", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inbox", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"indexable", "Toot", "bool", "BOOLEAN", true},
+		{"liked", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"movedTo", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"outbox", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"preferredUsername", "ActivityStreams", "string", "TEXT", true},
+		{"preferredUsernameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"publicKey", "W3IDSecurityV1", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"streams", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsHashtag": {
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"fps", "PeerTube", "int", "BIGINT", true},
+		{"height", "ActivityStreams", "int", "BIGINT", true},
+		{"href", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"hreflang", "ActivityStreams", "string", "TEXT", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"rel", "ActivityStreams", "[]string", "JSON", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"width", "ActivityStreams", "int", "BIGINT", true},
+	},
+	"ActivityStreamsIgnore": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsImage": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"blurhash", "Toot", "string", "TEXT", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"height", "ActivityStreams", "int", "BIGINT", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"width", "ActivityStreams", "int", "BIGINT", true},
+	},
+	"ActivityStreamsIntransitiveActivity": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsInvite": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsJoin": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsLeave": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsLike": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsLink": {
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"fps", "PeerTube", "int", "BIGINT", true},
+		{"height", "ActivityStreams", "int", "BIGINT", true},
+		{"href", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"hreflang", "ActivityStreams", "string", "TEXT", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"rel", "ActivityStreams", "[]string", "JSON", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"width", "ActivityStreams", "int", "BIGINT", true},
+	},
+	"ActivityStreamsListen": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsMention": {
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"fps", "PeerTube", "int", "BIGINT", true},
+		{"height", "ActivityStreams", "int", "BIGINT", true},
+		{"href", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"hreflang", "ActivityStreams", "string", "TEXT", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"rel", "ActivityStreams", "[]string", "JSON", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"width", "ActivityStreams", "int", "BIGINT", true},
+	},
+	"ActivityStreamsMove": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsNote": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsObject": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsOffer": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsOrderedCollection": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"current", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"earlyItems", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"first", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"last", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"orderedItems", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"totalItems", "ActivityStreams", "int", "BIGINT", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsOrderedCollectionPage": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"current", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"earlyItems", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"first", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"last", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"next", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"orderedItems", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"partOf", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"prev", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startIndex", "ActivityStreams", "int", "BIGINT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"totalItems", "ActivityStreams", "int", "BIGINT", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsOrganization": {
+		{"alsoKnownAs", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"discoverable", "Toot", "bool", "BOOLEAN", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"featured", "Toot", "*url.URL", "TEXT", true},
+		{"featuredTags", "Toot", "*url.URL", "TEXT", true},
+		{"followers", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"following", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inbox", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"indexable", "Toot", "bool", "BOOLEAN", true},
+		{"liked", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"movedTo", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"outbox", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"preferredUsername", "ActivityStreams", "string", "TEXT", true},
+		{"preferredUsernameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"publicKey", "W3IDSecurityV1", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"streams", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsPage": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"blurhash", "Toot", "string", "TEXT", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsPerson": {
+		{"alsoKnownAs", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"discoverable", "Toot", "bool", "BOOLEAN", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"featured", "Toot", "*url.URL", "TEXT", true},
+		{"featuredTags", "Toot", "*url.URL", "TEXT", true},
+		{"followers", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"following", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inbox", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"indexable", "Toot", "bool", "BOOLEAN", true},
+		{"liked", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"movedTo", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"outbox", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"preferredUsername", "ActivityStreams", "string", "TEXT", true},
+		{"preferredUsernameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"publicKey", "W3IDSecurityV1", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"streams", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsPlace": {
+		{"accuracy", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"latitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"longitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"radius", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"units", "ActivityStreams", "interface{}", "TEXT", true},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsProfile": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"describes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsQuestion": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"anyOf", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"closed", "ActivityStreams", "[]interface{}", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"oneOf", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"votersCount", "Toot", "int", "BIGINT", true},
+	},
+	"ActivityStreamsRead": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsReject": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsRelationship": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"relationship", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"subject", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsRemove": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsService": {
+		{"alsoKnownAs", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"discoverable", "Toot", "bool", "BOOLEAN", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"featured", "Toot", "*url.URL", "TEXT", true},
+		{"featuredTags", "Toot", "*url.URL", "TEXT", true},
+		{"followers", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"following", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inbox", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"indexable", "Toot", "bool", "BOOLEAN", true},
+		{"liked", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"movedTo", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"outbox", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"preferredUsername", "ActivityStreams", "string", "TEXT", true},
+		{"preferredUsernameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"publicKey", "W3IDSecurityV1", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"streams", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsTentativeAccept": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsTentativeReject": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsTombstone": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"deleted", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"formerType", "ActivityStreams", "[]interface{}", "JSON", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsTravel": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsUndo": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsUpdate": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ActivityStreamsVideo": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"blurhash", "Toot", "string", "TEXT", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"commentsEnabled", "PeerTube", "bool", "BOOLEAN", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"fps", "PeerTube", "int", "BIGINT", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"licence", "PeerTube", "*url.URL", "TEXT", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"views", "PeerTube", "int", "BIGINT", true},
+	},
+	"ActivityStreamsView": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ForgeFedBranch": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"ref", "ForgeFed", "string", "TEXT", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ForgeFedCommit": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"committed", "ForgeFed", "time.Time", "TIMESTAMP", true},
+		{"committedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"description", "ForgeFed", "*url.URL", "TEXT", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"filesAdded", "ForgeFed", "[]string", "JSON", true},
+		{"filesModified", "ForgeFed", "[]string", "JSON", true},
+		{"filesRemoved", "ForgeFed", "[]string", "JSON", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"hash", "ForgeFed", "string", "TEXT", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ForgeFedPush": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ForgeFedRepository": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"forks", "ForgeFed", "*url.URL", "TEXT", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ForgeFedTicket": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"assignedTo", "ForgeFed", "*url.URL", "TEXT", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"dependants", "ForgeFed", "*url.URL", "TEXT", true},
+		{"dependedBy", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"dependencies", "ForgeFed", "*url.URL", "TEXT", true},
+		{"dependsOn", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"isResolved", "ForgeFed", "bool", "BOOLEAN", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"ForgeFedTicketDependency": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"relationship", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"subject", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"LitepubEmojiReact": {
+		{"actor", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"instrument", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"origin", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"result", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"target", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"SchemaPropertyValue": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"value", "Schema", "string", "TEXT", true},
+	},
+	"TootEmoji": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"TootIdentityProof": {
+		{"altitude", "ActivityStreams", "float64", "DOUBLE PRECISION", true},
+		{"attachment", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"attributedTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"audience", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bcc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"bto", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"cc", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"content", "ActivityStreams", "[]string", "JSON", true},
+		{"contentMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"context", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"duration", "ActivityStreams", "time.Duration", "INTERVAL", true},
+		{"endTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"generator", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"icon", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"image", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"inReplyTo", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"likes", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"location", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"mediaType", "ActivityStreams", "string", "TEXT", true},
+		{"name", "ActivityStreams", "[]string", "JSON", true},
+		{"nameMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"object", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"preview", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"published", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"replies", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"sensitive", "ActivityStreams", "bool", "BOOLEAN", true},
+		{"shares", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"signatureAlgorithm", "Toot", "string", "TEXT", true},
+		{"signatureValue", "Toot", "string", "TEXT", true},
+		{"source", "ActivityStreams", "*url.URL", "TEXT", true},
+		{"startTime", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"summary", "ActivityStreams", "[]string", "JSON", true},
+		{"summaryMap", "ActivityStreams", "map[string]string", "JSON", true},
+		{"tag", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"team", "ForgeFed", "*url.URL", "TEXT", true},
+		{"ticketsTrackedBy", "ForgeFed", "*url.URL", "TEXT", true},
+		{"to", "ActivityStreams", "[]*url.URL", "JSON", true},
+		{"tracksTicketsFor", "ForgeFed", "[]*url.URL", "JSON", true},
+		{"type", "JSONLD", "[]interface{}", "JSON", false},
+		{"updated", "ActivityStreams", "time.Time", "TIMESTAMP", true},
+		{"url", "ActivityStreams", "[]*url.URL", "JSON", true},
+	},
+	"W3IDSecurityV1PublicKey": {
+		{"id", "JSONLD", "*url.URL", "TEXT", true},
+		{"owner", "W3IDSecurityV1", "*url.URL", "TEXT", true},
+		{"publicKeyPem", "W3IDSecurityV1", "string", "TEXT", true},
+	},
+}
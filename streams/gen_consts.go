@@ -56,6 +56,9 @@ var ActivityStreamsDocumentName string = "Document"
 // TootEmojiName is the string literal of the name for the Emoji type in the Toot vocabulary.
 var TootEmojiName string = "Emoji"
 
+// LitepubEmojiReactName is the string literal of the name for the EmojiReact type in the Litepub vocabulary.
+var LitepubEmojiReactName string = "EmojiReact"
+
 // ActivityStreamsEventName is the string literal of the name for the Event type in the ActivityStreams vocabulary.
 var ActivityStreamsEventName string = "Event"
 
@@ -68,6 +71,9 @@ var ActivityStreamsFollowName string = "Follow"
 // ActivityStreamsGroupName is the string literal of the name for the Group type in the ActivityStreams vocabulary.
 var ActivityStreamsGroupName string = "Group"
 
+// ActivityStreamsHashtagName is the string literal of the name for the Hashtag type in the ActivityStreams vocabulary.
+var ActivityStreamsHashtagName string = "Hashtag"
+
 // TootIdentityProofName is the string literal of the name for the IdentityProof type in the Toot vocabulary.
 var TootIdentityProofName string = "IdentityProof"
 
@@ -134,6 +140,9 @@ var ActivityStreamsPlaceName string = "Place"
 // ActivityStreamsProfileName is the string literal of the name for the Profile type in the ActivityStreams vocabulary.
 var ActivityStreamsProfileName string = "Profile"
 
+// SchemaPropertyValueName is the string literal of the name for the PropertyValue type in the Schema vocabulary.
+var SchemaPropertyValueName string = "PropertyValue"
+
 // W3IDSecurityV1PublicKeyName is the string literal of the name for the PublicKey type in the W3IDSecurityV1 vocabulary.
 var W3IDSecurityV1PublicKeyName string = "PublicKey"
 
@@ -197,6 +206,9 @@ var ActivityStreamsAccuracyPropertyName string = "accuracy"
 // ActivityStreamsActorPropertyName is the string literal of the name for the actor property in the ActivityStreams vocabulary.
 var ActivityStreamsActorPropertyName string = "actor"
 
+// ActivityStreamsAlsoKnownAsPropertyName is the string literal of the name for the alsoKnownAs property in the ActivityStreams vocabulary.
+var ActivityStreamsAlsoKnownAsPropertyName string = "alsoKnownAs"
+
 // ActivityStreamsAltitudePropertyName is the string literal of the name for the altitude property in the ActivityStreams vocabulary.
 var ActivityStreamsAltitudePropertyName string = "altitude"
 
@@ -230,6 +242,9 @@ var ActivityStreamsCcPropertyName string = "cc"
 // ActivityStreamsClosedPropertyName is the string literal of the name for the closed property in the ActivityStreams vocabulary.
 var ActivityStreamsClosedPropertyName string = "closed"
 
+// PeerTubeCommentsEnabledPropertyName is the string literal of the name for the commentsEnabled property in the PeerTube vocabulary.
+var PeerTubeCommentsEnabledPropertyName string = "commentsEnabled"
+
 // ForgeFedCommittedPropertyName is the string literal of the name for the committed property in the ForgeFed vocabulary.
 var ForgeFedCommittedPropertyName string = "committed"
 
@@ -284,6 +299,9 @@ var ActivityStreamsEndTimePropertyName string = "endTime"
 // TootFeaturedPropertyName is the string literal of the name for the featured property in the Toot vocabulary.
 var TootFeaturedPropertyName string = "featured"
 
+// TootFeaturedTagsPropertyName is the string literal of the name for the featuredTags property in the Toot vocabulary.
+var TootFeaturedTagsPropertyName string = "featuredTags"
+
 // ForgeFedFilesAddedPropertyName is the string literal of the name for the filesAdded property in the ForgeFed vocabulary.
 var ForgeFedFilesAddedPropertyName string = "filesAdded"
 
@@ -308,6 +326,9 @@ var ForgeFedForksPropertyName string = "forks"
 // ActivityStreamsFormerTypePropertyName is the string literal of the name for the formerType property in the ActivityStreams vocabulary.
 var ActivityStreamsFormerTypePropertyName string = "formerType"
 
+// PeerTubeFpsPropertyName is the string literal of the name for the fps property in the PeerTube vocabulary.
+var PeerTubeFpsPropertyName string = "fps"
+
 // ActivityStreamsGeneratorPropertyName is the string literal of the name for the generator property in the ActivityStreams vocabulary.
 var ActivityStreamsGeneratorPropertyName string = "generator"
 
@@ -335,6 +356,9 @@ var ActivityStreamsInReplyToPropertyName string = "inReplyTo"
 // ActivityStreamsInboxPropertyName is the string literal of the name for the inbox property in the ActivityStreams vocabulary.
 var ActivityStreamsInboxPropertyName string = "inbox"
 
+// TootIndexablePropertyName is the string literal of the name for the indexable property in the Toot vocabulary.
+var TootIndexablePropertyName string = "indexable"
+
 // ActivityStreamsInstrumentPropertyName is the string literal of the name for the instrument property in the ActivityStreams vocabulary.
 var ActivityStreamsInstrumentPropertyName string = "instrument"
 
@@ -350,6 +374,9 @@ var ActivityStreamsLastPropertyName string = "last"
 // ActivityStreamsLatitudePropertyName is the string literal of the name for the latitude property in the ActivityStreams vocabulary.
 var ActivityStreamsLatitudePropertyName string = "latitude"
 
+// PeerTubeLicencePropertyName is the string literal of the name for the licence property in the PeerTube vocabulary.
+var PeerTubeLicencePropertyName string = "licence"
+
 // ActivityStreamsLikedPropertyName is the string literal of the name for the liked property in the ActivityStreams vocabulary.
 var ActivityStreamsLikedPropertyName string = "liked"
 
@@ -365,6 +392,9 @@ var ActivityStreamsLongitudePropertyName string = "longitude"
 // ActivityStreamsMediaTypePropertyName is the string literal of the name for the mediaType property in the ActivityStreams vocabulary.
 var ActivityStreamsMediaTypePropertyName string = "mediaType"
 
+// ActivityStreamsMovedToPropertyName is the string literal of the name for the movedTo property in the ActivityStreams vocabulary.
+var ActivityStreamsMovedToPropertyName string = "movedTo"
+
 // ActivityStreamsNamePropertyName is the string literal of the name for the name property in the ActivityStreams vocabulary.
 var ActivityStreamsNamePropertyName string = "name"
 
@@ -434,6 +464,9 @@ var ActivityStreamsRepliesPropertyName string = "replies"
 // ActivityStreamsResultPropertyName is the string literal of the name for the result property in the ActivityStreams vocabulary.
 var ActivityStreamsResultPropertyName string = "result"
 
+// ActivityStreamsSensitivePropertyName is the string literal of the name for the sensitive property in the ActivityStreams vocabulary.
+var ActivityStreamsSensitivePropertyName string = "sensitive"
+
 // ActivityStreamsSharesPropertyName is the string literal of the name for the shares property in the ActivityStreams vocabulary.
 var ActivityStreamsSharesPropertyName string = "shares"
 
@@ -494,6 +527,12 @@ var ActivityStreamsUpdatedPropertyName string = "updated"
 // ActivityStreamsUrlPropertyName is the string literal of the name for the url property in the ActivityStreams vocabulary.
 var ActivityStreamsUrlPropertyName string = "url"
 
+// SchemaValuePropertyName is the string literal of the name for the value property in the Schema vocabulary.
+var SchemaValuePropertyName string = "value"
+
+// PeerTubeViewsPropertyName is the string literal of the name for the views property in the PeerTube vocabulary.
+var PeerTubeViewsPropertyName string = "views"
+
 // TootVotersCountPropertyName is the string literal of the name for the votersCount property in the Toot vocabulary.
 var TootVotersCountPropertyName string = "votersCount"
 
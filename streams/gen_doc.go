@@ -36,7 +36,9 @@
 // propagate it throughout the rest of an application. The Manager is
 // instantiated as a singleton at init time in this library. It is then
 // injected into each implementation library so they can deserialize their
-// needed types without relying on the underlying concrete type.
+// needed types without relying on the underlying concrete type. Each of its
+// deserialization methods has a variant accepting a context, which the
+// JSONResolver uses, that returns the context's error once it is done.
 //
 // Subdirectories of this package include implementation files and functions
 // that are not intended to be directly linked to applications, but are used
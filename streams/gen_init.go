@@ -5,6 +5,7 @@ package streams
 import (
 	propertyaccuracy "github.com/go-fed/activity/streams/impl/activitystreams/property_accuracy"
 	propertyactor "github.com/go-fed/activity/streams/impl/activitystreams/property_actor"
+	propertyalsoknownas "github.com/go-fed/activity/streams/impl/activitystreams/property_alsoknownas"
 	propertyaltitude "github.com/go-fed/activity/streams/impl/activitystreams/property_altitude"
 	propertyanyof "github.com/go-fed/activity/streams/impl/activitystreams/property_anyof"
 	propertyattachment "github.com/go-fed/activity/streams/impl/activitystreams/property_attachment"
@@ -42,6 +43,7 @@ import (
 	propertylocation "github.com/go-fed/activity/streams/impl/activitystreams/property_location"
 	propertylongitude "github.com/go-fed/activity/streams/impl/activitystreams/property_longitude"
 	propertymediatype "github.com/go-fed/activity/streams/impl/activitystreams/property_mediatype"
+	propertymovedto "github.com/go-fed/activity/streams/impl/activitystreams/property_movedto"
 	propertyname "github.com/go-fed/activity/streams/impl/activitystreams/property_name"
 	propertynext "github.com/go-fed/activity/streams/impl/activitystreams/property_next"
 	propertyobject "github.com/go-fed/activity/streams/impl/activitystreams/property_object"
@@ -59,6 +61,7 @@ import (
 	propertyrelationship "github.com/go-fed/activity/streams/impl/activitystreams/property_relationship"
 	propertyreplies "github.com/go-fed/activity/streams/impl/activitystreams/property_replies"
 	propertyresult "github.com/go-fed/activity/streams/impl/activitystreams/property_result"
+	propertysensitive "github.com/go-fed/activity/streams/impl/activitystreams/property_sensitive"
 	propertyshares "github.com/go-fed/activity/streams/impl/activitystreams/property_shares"
 	propertysource "github.com/go-fed/activity/streams/impl/activitystreams/property_source"
 	propertystartindex "github.com/go-fed/activity/streams/impl/activitystreams/property_startindex"
@@ -93,6 +96,7 @@ import (
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -153,9 +157,18 @@ import (
 	typerepository "github.com/go-fed/activity/streams/impl/forgefed/type_repository"
 	typeticket "github.com/go-fed/activity/streams/impl/forgefed/type_ticket"
 	typeticketdependency "github.com/go-fed/activity/streams/impl/forgefed/type_ticketdependency"
+	typeemojireact "github.com/go-fed/activity/streams/impl/litepub/type_emojireact"
+	propertycommentsenabled "github.com/go-fed/activity/streams/impl/peertube/property_commentsenabled"
+	propertyfps "github.com/go-fed/activity/streams/impl/peertube/property_fps"
+	propertylicence "github.com/go-fed/activity/streams/impl/peertube/property_licence"
+	propertyviews "github.com/go-fed/activity/streams/impl/peertube/property_views"
+	propertyvalue "github.com/go-fed/activity/streams/impl/schema/property_value"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/schema/type_propertyvalue"
 	propertyblurhash "github.com/go-fed/activity/streams/impl/toot/property_blurhash"
 	propertydiscoverable "github.com/go-fed/activity/streams/impl/toot/property_discoverable"
 	propertyfeatured "github.com/go-fed/activity/streams/impl/toot/property_featured"
+	propertyfeaturedtags "github.com/go-fed/activity/streams/impl/toot/property_featuredtags"
+	propertyindexable "github.com/go-fed/activity/streams/impl/toot/property_indexable"
 	propertysignaturealgorithm "github.com/go-fed/activity/streams/impl/toot/property_signaturealgorithm"
 	propertysignaturevalue "github.com/go-fed/activity/streams/impl/toot/property_signaturevalue"
 	propertyvoterscount "github.com/go-fed/activity/streams/impl/toot/property_voterscount"
@@ -182,6 +195,7 @@ func init() {
 	mgr = &Manager{}
 	propertyaccuracy.SetManager(mgr)
 	propertyactor.SetManager(mgr)
+	propertyalsoknownas.SetManager(mgr)
 	propertyaltitude.SetManager(mgr)
 	propertyanyof.SetManager(mgr)
 	propertyattachment.SetManager(mgr)
@@ -219,6 +233,7 @@ func init() {
 	propertylocation.SetManager(mgr)
 	propertylongitude.SetManager(mgr)
 	propertymediatype.SetManager(mgr)
+	propertymovedto.SetManager(mgr)
 	propertyname.SetManager(mgr)
 	propertynext.SetManager(mgr)
 	propertyobject.SetManager(mgr)
@@ -236,6 +251,7 @@ func init() {
 	propertyrelationship.SetManager(mgr)
 	propertyreplies.SetManager(mgr)
 	propertyresult.SetManager(mgr)
+	propertysensitive.SetManager(mgr)
 	propertyshares.SetManager(mgr)
 	propertysource.SetManager(mgr)
 	propertystartindex.SetManager(mgr)
@@ -270,6 +286,7 @@ func init() {
 	typeflag.SetManager(mgr)
 	typefollow.SetManager(mgr)
 	typegroup.SetManager(mgr)
+	typehashtag.SetManager(mgr)
 	typeignore.SetManager(mgr)
 	typeimage.SetManager(mgr)
 	typeintransitiveactivity.SetManager(mgr)
@@ -330,9 +347,18 @@ func init() {
 	typerepository.SetManager(mgr)
 	typeticket.SetManager(mgr)
 	typeticketdependency.SetManager(mgr)
+	typeemojireact.SetManager(mgr)
+	propertycommentsenabled.SetManager(mgr)
+	propertyfps.SetManager(mgr)
+	propertylicence.SetManager(mgr)
+	propertyviews.SetManager(mgr)
+	propertyvalue.SetManager(mgr)
+	typepropertyvalue.SetManager(mgr)
 	propertyblurhash.SetManager(mgr)
 	propertydiscoverable.SetManager(mgr)
 	propertyfeatured.SetManager(mgr)
+	propertyfeaturedtags.SetManager(mgr)
+	propertyindexable.SetManager(mgr)
 	propertysignaturealgorithm.SetManager(mgr)
 	propertysignaturevalue.SetManager(mgr)
 	propertyvoterscount.SetManager(mgr)
@@ -361,6 +387,7 @@ func init() {
 	typeflag.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typefollow.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typegroup.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typehashtag.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeignore.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeimage.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeintransitiveactivity.SetTypePropertyConstructor(NewJSONLDTypeProperty)
@@ -402,6 +429,8 @@ func init() {
 	typerepository.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeticket.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeticketdependency.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typeemojireact.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typepropertyvalue.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeemoji.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeidentityproof.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typepublickey.SetTypePropertyConstructor(NewJSONLDTypeProperty)
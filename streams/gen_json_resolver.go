@@ -67,6 +67,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.TootEmoji) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.LitepubEmojiReact) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsEvent) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsFlag) error:
@@ -75,6 +77,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsGroup) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ActivityStreamsHashtag) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.TootIdentityProof) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsIgnore) error:
@@ -119,6 +123,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsProfile) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.SchemaPropertyValue) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.W3IDSecurityV1PublicKey) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ForgeFedPush) error:
@@ -216,11 +222,16 @@ func toAliasMap(i interface{}) (m map[string]string) {
 // if the ActivityStreams type does not match callbackers or is not a type
 // handled by the generated code. If multiple types are present, it will check
 // each one in order and apply only the first one. It returns an unhandled
-// error for a multi-typed object if none of the types were able to be handled.
+// error for a multi-typed object if none of the types were able to be
+// handled. It returns the context's error, without deserializing the payload,
+// if the context is done.
 func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{}) error {
 	typeValue, ok := m["type"]
 	if !ok {
-		return fmt.Errorf("cannot determine ActivityStreams type: 'type' property is missing")
+		return vocab.DeserializeError{
+			Err:    vocab.ErrMissingType,
+			Reason: "cannot determine ActivityStreams type: 'type' property is missing",
+		}
 	}
 	rawContext, ok := m["@context"]
 	if !ok {
@@ -250,6 +261,20 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 		if len(TootAlias) > 0 {
 			TootAlias += ":"
 		}
+		LitepubAlias, ok := aliasMap["https://litepub.social/ns"]
+		if !ok {
+			LitepubAlias = aliasMap["http://litepub.social/ns"]
+		}
+		if len(LitepubAlias) > 0 {
+			LitepubAlias += ":"
+		}
+		SchemaAlias, ok := aliasMap["https://schema.org"]
+		if !ok {
+			SchemaAlias = aliasMap["http://schema.org"]
+		}
+		if len(SchemaAlias) > 0 {
+			SchemaAlias += ":"
+		}
 		W3IDSecurityV1Alias, ok := aliasMap["https://w3id.org/security/v1"]
 		if !ok {
 			W3IDSecurityV1Alias = aliasMap["http://w3id.org/security/v1"]
@@ -259,7 +284,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 		}
 
 		if typeString == ActivityStreamsAlias+"Accept" {
-			v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeAcceptActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -270,7 +295,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Activity" {
-			v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeActivityActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -281,7 +306,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Add" {
-			v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeAddActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -292,7 +317,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Announce" {
-			v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeAnnounceActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -303,7 +328,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Application" {
-			v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeApplicationActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -314,7 +339,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Arrive" {
-			v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeArriveActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -325,7 +350,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Article" {
-			v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeArticleActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -336,7 +361,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Audio" {
-			v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeAudioActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -347,7 +372,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Block" {
-			v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeBlockActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -358,7 +383,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ForgeFedAlias+"Branch" {
-			v, err := mgr.DeserializeBranchForgeFed()(m, aliasMap)
+			v, err := mgr.DeserializeBranchForgeFedContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -369,7 +394,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Collection" {
-			v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeCollectionActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -380,7 +405,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"CollectionPage" {
-			v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeCollectionPageActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -391,7 +416,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ForgeFedAlias+"Commit" {
-			v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap)
+			v, err := mgr.DeserializeCommitForgeFedContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -402,7 +427,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Create" {
-			v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeCreateActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -413,7 +438,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Delete" {
-			v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeDeleteActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -424,7 +449,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Dislike" {
-			v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeDislikeActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -435,7 +460,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Document" {
-			v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeDocumentActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -446,7 +471,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == TootAlias+"Emoji" {
-			v, err := mgr.DeserializeEmojiToot()(m, aliasMap)
+			v, err := mgr.DeserializeEmojiTootContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -456,8 +481,19 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == LitepubAlias+"EmojiReact" {
+			v, err := mgr.DeserializeEmojiReactLitepubContext()(ctx, m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.LitepubEmojiReact) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Event" {
-			v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeEventActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -468,7 +504,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Flag" {
-			v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeFlagActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -479,7 +515,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Follow" {
-			v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeFollowActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -490,7 +526,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Group" {
-			v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeGroupActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -500,8 +536,19 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ActivityStreamsAlias+"Hashtag" {
+			v, err := mgr.DeserializeHashtagActivityStreamsContext()(ctx, m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ActivityStreamsHashtag) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == TootAlias+"IdentityProof" {
-			v, err := mgr.DeserializeIdentityProofToot()(m, aliasMap)
+			v, err := mgr.DeserializeIdentityProofTootContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -512,7 +559,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Ignore" {
-			v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeIgnoreActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -523,7 +570,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Image" {
-			v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeImageActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -534,7 +581,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"IntransitiveActivity" {
-			v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeIntransitiveActivityActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -545,7 +592,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Invite" {
-			v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeInviteActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -556,7 +603,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Join" {
-			v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeJoinActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -567,7 +614,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Leave" {
-			v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeLeaveActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -578,7 +625,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Like" {
-			v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeLikeActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -589,7 +636,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Link" {
-			v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeLinkActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -600,7 +647,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Listen" {
-			v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeListenActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -611,7 +658,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Mention" {
-			v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeMentionActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -622,7 +669,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Move" {
-			v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeMoveActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -633,7 +680,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Note" {
-			v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeNoteActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -644,7 +691,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Object" {
-			v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeObjectActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -655,7 +702,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Offer" {
-			v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeOfferActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -666,7 +713,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"OrderedCollection" {
-			v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeOrderedCollectionActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -677,7 +724,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"OrderedCollectionPage" {
-			v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeOrderedCollectionPageActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -688,7 +735,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Organization" {
-			v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeOrganizationActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -699,7 +746,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Page" {
-			v, err := mgr.DeserializePageActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializePageActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -710,7 +757,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Person" {
-			v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializePersonActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -721,7 +768,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Place" {
-			v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializePlaceActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -732,7 +779,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Profile" {
-			v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeProfileActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -742,8 +789,19 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == SchemaAlias+"PropertyValue" {
+			v, err := mgr.DeserializePropertyValueSchemaContext()(ctx, m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.SchemaPropertyValue) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == W3IDSecurityV1Alias+"PublicKey" {
-			v, err := mgr.DeserializePublicKeyW3IDSecurityV1()(m, aliasMap)
+			v, err := mgr.DeserializePublicKeyW3IDSecurityV1Context()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -754,7 +812,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ForgeFedAlias+"Push" {
-			v, err := mgr.DeserializePushForgeFed()(m, aliasMap)
+			v, err := mgr.DeserializePushForgeFedContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -765,7 +823,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Question" {
-			v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeQuestionActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -776,7 +834,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Read" {
-			v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeReadActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -787,7 +845,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Reject" {
-			v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeRejectActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -798,7 +856,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Relationship" {
-			v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeRelationshipActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -809,7 +867,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Remove" {
-			v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeRemoveActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -820,7 +878,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ForgeFedAlias+"Repository" {
-			v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap)
+			v, err := mgr.DeserializeRepositoryForgeFedContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -831,7 +889,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Service" {
-			v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeServiceActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -842,7 +900,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"TentativeAccept" {
-			v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeTentativeAcceptActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -853,7 +911,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"TentativeReject" {
-			v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeTentativeRejectActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -864,7 +922,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ForgeFedAlias+"Ticket" {
-			v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap)
+			v, err := mgr.DeserializeTicketForgeFedContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -875,7 +933,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ForgeFedAlias+"TicketDependency" {
-			v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap)
+			v, err := mgr.DeserializeTicketDependencyForgeFedContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -886,7 +944,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Tombstone" {
-			v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeTombstoneActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -897,7 +955,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Travel" {
-			v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeTravelActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -908,7 +966,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Undo" {
-			v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeUndoActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -919,7 +977,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Update" {
-			v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeUpdateActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -930,7 +988,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Video" {
-			v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeVideoActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -941,7 +999,7 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 			}
 			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"View" {
-			v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap)
+			v, err := mgr.DeserializeViewActivityStreamsContext()(ctx, m, aliasMap)
 			if err != nil {
 				return err
 			}
@@ -3,8 +3,10 @@
 package streams
 
 import (
+	"context"
 	propertyaccuracy "github.com/go-fed/activity/streams/impl/activitystreams/property_accuracy"
 	propertyactor "github.com/go-fed/activity/streams/impl/activitystreams/property_actor"
+	propertyalsoknownas "github.com/go-fed/activity/streams/impl/activitystreams/property_alsoknownas"
 	propertyaltitude "github.com/go-fed/activity/streams/impl/activitystreams/property_altitude"
 	propertyanyof "github.com/go-fed/activity/streams/impl/activitystreams/property_anyof"
 	propertyattachment "github.com/go-fed/activity/streams/impl/activitystreams/property_attachment"
@@ -42,6 +44,7 @@ import (
 	propertylocation "github.com/go-fed/activity/streams/impl/activitystreams/property_location"
 	propertylongitude "github.com/go-fed/activity/streams/impl/activitystreams/property_longitude"
 	propertymediatype "github.com/go-fed/activity/streams/impl/activitystreams/property_mediatype"
+	propertymovedto "github.com/go-fed/activity/streams/impl/activitystreams/property_movedto"
 	propertyname "github.com/go-fed/activity/streams/impl/activitystreams/property_name"
 	propertynext "github.com/go-fed/activity/streams/impl/activitystreams/property_next"
 	propertyobject "github.com/go-fed/activity/streams/impl/activitystreams/property_object"
@@ -59,6 +62,7 @@ import (
 	propertyrelationship "github.com/go-fed/activity/streams/impl/activitystreams/property_relationship"
 	propertyreplies "github.com/go-fed/activity/streams/impl/activitystreams/property_replies"
 	propertyresult "github.com/go-fed/activity/streams/impl/activitystreams/property_result"
+	propertysensitive "github.com/go-fed/activity/streams/impl/activitystreams/property_sensitive"
 	propertyshares "github.com/go-fed/activity/streams/impl/activitystreams/property_shares"
 	propertysource "github.com/go-fed/activity/streams/impl/activitystreams/property_source"
 	propertystartindex "github.com/go-fed/activity/streams/impl/activitystreams/property_startindex"
@@ -93,6 +97,7 @@ import (
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -155,9 +160,18 @@ import (
 	typeticketdependency "github.com/go-fed/activity/streams/impl/forgefed/type_ticketdependency"
 	propertyid "github.com/go-fed/activity/streams/impl/jsonld/property_id"
 	propertytype "github.com/go-fed/activity/streams/impl/jsonld/property_type"
+	typeemojireact "github.com/go-fed/activity/streams/impl/litepub/type_emojireact"
+	propertycommentsenabled "github.com/go-fed/activity/streams/impl/peertube/property_commentsenabled"
+	propertyfps "github.com/go-fed/activity/streams/impl/peertube/property_fps"
+	propertylicence "github.com/go-fed/activity/streams/impl/peertube/property_licence"
+	propertyviews "github.com/go-fed/activity/streams/impl/peertube/property_views"
+	propertyvalue "github.com/go-fed/activity/streams/impl/schema/property_value"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/schema/type_propertyvalue"
 	propertyblurhash "github.com/go-fed/activity/streams/impl/toot/property_blurhash"
 	propertydiscoverable "github.com/go-fed/activity/streams/impl/toot/property_discoverable"
 	propertyfeatured "github.com/go-fed/activity/streams/impl/toot/property_featured"
+	propertyfeaturedtags "github.com/go-fed/activity/streams/impl/toot/property_featuredtags"
+	propertyindexable "github.com/go-fed/activity/streams/impl/toot/property_indexable"
 	propertysignaturealgorithm "github.com/go-fed/activity/streams/impl/toot/property_signaturealgorithm"
 	propertysignaturevalue "github.com/go-fed/activity/streams/impl/toot/property_signaturevalue"
 	propertyvoterscount "github.com/go-fed/activity/streams/impl/toot/property_voterscount"
@@ -189,6 +203,19 @@ func (this Manager) DeserializeAcceptActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeAcceptActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsAccept" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeAcceptActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsAccept, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAccept, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAcceptActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeAccuracyPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsAccuracyProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -202,6 +229,19 @@ func (this Manager) DeserializeAccuracyPropertyActivityStreams() func(map[string
 	}
 }
 
+// DeserializeAccuracyPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsAccuracyProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeAccuracyPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsAccuracyProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAccuracyProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAccuracyPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeActivityActivityStreams returns the deserialization method for the
 // "ActivityStreamsActivity" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -215,6 +255,19 @@ func (this Manager) DeserializeActivityActivityStreams() func(map[string]interfa
 	}
 }
 
+// DeserializeActivityActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsActivity" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeActivityActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsActivity, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsActivity, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeActivityActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeActorPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsActorProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -228,6 +281,19 @@ func (this Manager) DeserializeActorPropertyActivityStreams() func(map[string]in
 	}
 }
 
+// DeserializeActorPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsActorProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeActorPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsActorProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsActorProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeActorPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeAddActivityStreams returns the deserialization method for the
 // "ActivityStreamsAdd" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -241,6 +307,45 @@ func (this Manager) DeserializeAddActivityStreams() func(map[string]interface{},
 	}
 }
 
+// DeserializeAddActivityStreamsContext returns the deserialization method for the
+// "ActivityStreamsAdd" type or property in the vocabulary "ActivityStreams",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeAddActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsAdd, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAdd, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAddActivityStreams()(m, aliasMap)
+	}
+}
+
+// DeserializeAlsoKnownAsPropertyActivityStreams returns the deserialization
+// method for the "ActivityStreamsAlsoKnownAsProperty" non-functional property
+// in the vocabulary "ActivityStreams"
+func (this Manager) DeserializeAlsoKnownAsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+		i, err := propertyalsoknownas.DeserializeAlsoKnownAsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeAlsoKnownAsPropertyActivityStreamsContext returns the
+// deserialization method for the "ActivityStreamsAlsoKnownAsProperty" type or
+// property in the vocabulary "ActivityStreams", accepting a context. It
+// returns the context's error without deserializing once the context is done.
+func (this Manager) DeserializeAlsoKnownAsPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAlsoKnownAsPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeAltitudePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsAltitudeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -254,6 +359,19 @@ func (this Manager) DeserializeAltitudePropertyActivityStreams() func(map[string
 	}
 }
 
+// DeserializeAltitudePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsAltitudeProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeAltitudePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsAltitudeProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAltitudeProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAltitudePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeAnnounceActivityStreams returns the deserialization method for the
 // "ActivityStreamsAnnounce" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -267,6 +385,19 @@ func (this Manager) DeserializeAnnounceActivityStreams() func(map[string]interfa
 	}
 }
 
+// DeserializeAnnounceActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsAnnounce" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeAnnounceActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsAnnounce, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAnnounce, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAnnounceActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeAnyOfPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsAnyOfProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -280,6 +411,19 @@ func (this Manager) DeserializeAnyOfPropertyActivityStreams() func(map[string]in
 	}
 }
 
+// DeserializeAnyOfPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsAnyOfProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeAnyOfPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsAnyOfProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAnyOfProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAnyOfPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeApplicationActivityStreams returns the deserialization method for
 // the "ActivityStreamsApplication" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -293,6 +437,19 @@ func (this Manager) DeserializeApplicationActivityStreams() func(map[string]inte
 	}
 }
 
+// DeserializeApplicationActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsApplication" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeApplicationActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsApplication, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsApplication, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeApplicationActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeArriveActivityStreams returns the deserialization method for the
 // "ActivityStreamsArrive" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -306,6 +463,19 @@ func (this Manager) DeserializeArriveActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeArriveActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsArrive" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeArriveActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsArrive, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsArrive, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeArriveActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeArticleActivityStreams returns the deserialization method for the
 // "ActivityStreamsArticle" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -319,6 +489,19 @@ func (this Manager) DeserializeArticleActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializeArticleActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsArticle" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeArticleActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsArticle, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsArticle, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeArticleActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeAssignedToPropertyForgeFed returns the deserialization method for
 // the "ForgeFedAssignedToProperty" non-functional property in the vocabulary
 // "ForgeFed"
@@ -332,6 +515,19 @@ func (this Manager) DeserializeAssignedToPropertyForgeFed() func(map[string]inte
 	}
 }
 
+// DeserializeAssignedToPropertyForgeFedContext returns the deserialization method
+// for the "ForgeFedAssignedToProperty" type or property in the vocabulary
+// "ForgeFed", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeAssignedToPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedAssignedToProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedAssignedToProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAssignedToPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeAttachmentPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsAttachmentProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -345,6 +541,19 @@ func (this Manager) DeserializeAttachmentPropertyActivityStreams() func(map[stri
 	}
 }
 
+// DeserializeAttachmentPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsAttachmentProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeAttachmentPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsAttachmentProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAttachmentProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAttachmentPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeAttributedToPropertyActivityStreams returns the deserialization
 // method for the "ActivityStreamsAttributedToProperty" non-functional
 // property in the vocabulary "ActivityStreams"
@@ -358,6 +567,19 @@ func (this Manager) DeserializeAttributedToPropertyActivityStreams() func(map[st
 	}
 }
 
+// DeserializeAttributedToPropertyActivityStreamsContext returns the
+// deserialization method for the "ActivityStreamsAttributedToProperty" type
+// or property in the vocabulary "ActivityStreams", accepting a context. It
+// returns the context's error without deserializing once the context is done.
+func (this Manager) DeserializeAttributedToPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsAttributedToProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAttributedToProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAttributedToPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeAudiencePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsAudienceProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -371,6 +593,19 @@ func (this Manager) DeserializeAudiencePropertyActivityStreams() func(map[string
 	}
 }
 
+// DeserializeAudiencePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsAudienceProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeAudiencePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsAudienceProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAudienceProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAudiencePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeAudioActivityStreams returns the deserialization method for the
 // "ActivityStreamsAudio" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -384,6 +619,19 @@ func (this Manager) DeserializeAudioActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeAudioActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsAudio" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeAudioActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsAudio, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAudio, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeAudioActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeBccPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsBccProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -397,6 +645,19 @@ func (this Manager) DeserializeBccPropertyActivityStreams() func(map[string]inte
 	}
 }
 
+// DeserializeBccPropertyActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsBccProperty" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeBccPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsBccProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsBccProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeBccPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeBlockActivityStreams returns the deserialization method for the
 // "ActivityStreamsBlock" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -410,6 +671,19 @@ func (this Manager) DeserializeBlockActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeBlockActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsBlock" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeBlockActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsBlock, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsBlock, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeBlockActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeBlurhashPropertyToot returns the deserialization method for the
 // "TootBlurhashProperty" non-functional property in the vocabulary "Toot"
 func (this Manager) DeserializeBlurhashPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootBlurhashProperty, error) {
@@ -422,6 +696,19 @@ func (this Manager) DeserializeBlurhashPropertyToot() func(map[string]interface{
 	}
 }
 
+// DeserializeBlurhashPropertyTootContext returns the deserialization method for
+// the "TootBlurhashProperty" type or property in the vocabulary "Toot",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeBlurhashPropertyTootContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.TootBlurhashProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.TootBlurhashProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeBlurhashPropertyToot()(m, aliasMap)
+	}
+}
+
 // DeserializeBranchForgeFed returns the deserialization method for the
 // "ForgeFedBranch" non-functional property in the vocabulary "ForgeFed"
 func (this Manager) DeserializeBranchForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedBranch, error) {
@@ -434,6 +721,19 @@ func (this Manager) DeserializeBranchForgeFed() func(map[string]interface{}, map
 	}
 }
 
+// DeserializeBranchForgeFedContext returns the deserialization method for the
+// "ForgeFedBranch" type or property in the vocabulary "ForgeFed", accepting a
+// context. It returns the context's error without deserializing once the
+// context is done.
+func (this Manager) DeserializeBranchForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedBranch, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedBranch, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeBranchForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeBtoPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsBtoProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -447,6 +747,19 @@ func (this Manager) DeserializeBtoPropertyActivityStreams() func(map[string]inte
 	}
 }
 
+// DeserializeBtoPropertyActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsBtoProperty" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeBtoPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsBtoProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsBtoProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeBtoPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeCcPropertyActivityStreams returns the deserialization method for the
 // "ActivityStreamsCcProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -460,6 +773,19 @@ func (this Manager) DeserializeCcPropertyActivityStreams() func(map[string]inter
 	}
 }
 
+// DeserializeCcPropertyActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsCcProperty" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeCcPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsCcProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCcProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeCcPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeClosedPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsClosedProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -473,6 +799,19 @@ func (this Manager) DeserializeClosedPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeClosedPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsClosedProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeClosedPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsClosedProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsClosedProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeClosedPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeCollectionActivityStreams returns the deserialization method for the
 // "ActivityStreamsCollection" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -486,6 +825,19 @@ func (this Manager) DeserializeCollectionActivityStreams() func(map[string]inter
 	}
 }
 
+// DeserializeCollectionActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsCollection" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeCollectionActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsCollection, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCollection, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeCollectionActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeCollectionPageActivityStreams returns the deserialization method for
 // the "ActivityStreamsCollectionPage" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -499,6 +851,45 @@ func (this Manager) DeserializeCollectionPageActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeCollectionPageActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsCollectionPage" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeCollectionPageActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsCollectionPage, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCollectionPage, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeCollectionPageActivityStreams()(m, aliasMap)
+	}
+}
+
+// DeserializeCommentsEnabledPropertyPeerTube returns the deserialization method
+// for the "PeerTubeCommentsEnabledProperty" non-functional property in the
+// vocabulary "PeerTube"
+func (this Manager) DeserializeCommentsEnabledPropertyPeerTube() func(map[string]interface{}, map[string]string) (vocab.PeerTubeCommentsEnabledProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.PeerTubeCommentsEnabledProperty, error) {
+		i, err := propertycommentsenabled.DeserializeCommentsEnabledProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeCommentsEnabledPropertyPeerTubeContext returns the deserialization
+// method for the "PeerTubeCommentsEnabledProperty" type or property in the
+// vocabulary "PeerTube", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeCommentsEnabledPropertyPeerTubeContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.PeerTubeCommentsEnabledProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.PeerTubeCommentsEnabledProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeCommentsEnabledPropertyPeerTube()(m, aliasMap)
+	}
+}
+
 // DeserializeCommitForgeFed returns the deserialization method for the
 // "ForgeFedCommit" non-functional property in the vocabulary "ForgeFed"
 func (this Manager) DeserializeCommitForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedCommit, error) {
@@ -511,6 +902,19 @@ func (this Manager) DeserializeCommitForgeFed() func(map[string]interface{}, map
 	}
 }
 
+// DeserializeCommitForgeFedContext returns the deserialization method for the
+// "ForgeFedCommit" type or property in the vocabulary "ForgeFed", accepting a
+// context. It returns the context's error without deserializing once the
+// context is done.
+func (this Manager) DeserializeCommitForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedCommit, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedCommit, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeCommitForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeCommittedByPropertyForgeFed returns the deserialization method for
 // the "ForgeFedCommittedByProperty" non-functional property in the vocabulary
 // "ForgeFed"
@@ -524,6 +928,19 @@ func (this Manager) DeserializeCommittedByPropertyForgeFed() func(map[string]int
 	}
 }
 
+// DeserializeCommittedByPropertyForgeFedContext returns the deserialization
+// method for the "ForgeFedCommittedByProperty" type or property in the
+// vocabulary "ForgeFed", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeCommittedByPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedCommittedByProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedCommittedByProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeCommittedByPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeCommittedPropertyForgeFed returns the deserialization method for the
 // "ForgeFedCommittedProperty" non-functional property in the vocabulary
 // "ForgeFed"
@@ -537,6 +954,19 @@ func (this Manager) DeserializeCommittedPropertyForgeFed() func(map[string]inter
 	}
 }
 
+// DeserializeCommittedPropertyForgeFedContext returns the deserialization method
+// for the "ForgeFedCommittedProperty" type or property in the vocabulary
+// "ForgeFed", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeCommittedPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedCommittedProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedCommittedProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeCommittedPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeContentPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsContentProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -550,6 +980,19 @@ func (this Manager) DeserializeContentPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeContentPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsContentProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeContentPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsContentProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsContentProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeContentPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeContextPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsContextProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -563,6 +1006,19 @@ func (this Manager) DeserializeContextPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeContextPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsContextProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeContextPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsContextProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsContextProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeContextPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeCreateActivityStreams returns the deserialization method for the
 // "ActivityStreamsCreate" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -576,6 +1032,19 @@ func (this Manager) DeserializeCreateActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeCreateActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsCreate" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeCreateActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsCreate, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCreate, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeCreateActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeCurrentPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsCurrentProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -589,6 +1058,19 @@ func (this Manager) DeserializeCurrentPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeCurrentPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsCurrentProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeCurrentPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsCurrentProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCurrentProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeCurrentPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeDeleteActivityStreams returns the deserialization method for the
 // "ActivityStreamsDelete" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -602,6 +1084,19 @@ func (this Manager) DeserializeDeleteActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeDeleteActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsDelete" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeDeleteActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsDelete, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDelete, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDeleteActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeDeletedPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsDeletedProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -615,6 +1110,19 @@ func (this Manager) DeserializeDeletedPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeDeletedPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsDeletedProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeDeletedPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsDeletedProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDeletedProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDeletedPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeDependantsPropertyForgeFed returns the deserialization method for
 // the "ForgeFedDependantsProperty" non-functional property in the vocabulary
 // "ForgeFed"
@@ -628,6 +1136,19 @@ func (this Manager) DeserializeDependantsPropertyForgeFed() func(map[string]inte
 	}
 }
 
+// DeserializeDependantsPropertyForgeFedContext returns the deserialization method
+// for the "ForgeFedDependantsProperty" type or property in the vocabulary
+// "ForgeFed", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeDependantsPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedDependantsProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependantsProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDependantsPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeDependedByPropertyForgeFed returns the deserialization method for
 // the "ForgeFedDependedByProperty" non-functional property in the vocabulary
 // "ForgeFed"
@@ -641,6 +1162,19 @@ func (this Manager) DeserializeDependedByPropertyForgeFed() func(map[string]inte
 	}
 }
 
+// DeserializeDependedByPropertyForgeFedContext returns the deserialization method
+// for the "ForgeFedDependedByProperty" type or property in the vocabulary
+// "ForgeFed", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeDependedByPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedDependedByProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependedByProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDependedByPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeDependenciesPropertyForgeFed returns the deserialization method for
 // the "ForgeFedDependenciesProperty" non-functional property in the
 // vocabulary "ForgeFed"
@@ -654,6 +1188,19 @@ func (this Manager) DeserializeDependenciesPropertyForgeFed() func(map[string]in
 	}
 }
 
+// DeserializeDependenciesPropertyForgeFedContext returns the deserialization
+// method for the "ForgeFedDependenciesProperty" type or property in the
+// vocabulary "ForgeFed", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeDependenciesPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedDependenciesProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependenciesProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDependenciesPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeDependsOnPropertyForgeFed returns the deserialization method for the
 // "ForgeFedDependsOnProperty" non-functional property in the vocabulary
 // "ForgeFed"
@@ -667,6 +1214,19 @@ func (this Manager) DeserializeDependsOnPropertyForgeFed() func(map[string]inter
 	}
 }
 
+// DeserializeDependsOnPropertyForgeFedContext returns the deserialization method
+// for the "ForgeFedDependsOnProperty" type or property in the vocabulary
+// "ForgeFed", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeDependsOnPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedDependsOnProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDependsOnProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDependsOnPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeDescribesPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsDescribesProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -680,6 +1240,19 @@ func (this Manager) DeserializeDescribesPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeDescribesPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsDescribesProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeDescribesPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsDescribesProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDescribesProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDescribesPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeDescriptionPropertyForgeFed returns the deserialization method for
 // the "ForgeFedDescriptionProperty" non-functional property in the vocabulary
 // "ForgeFed"
@@ -693,6 +1266,19 @@ func (this Manager) DeserializeDescriptionPropertyForgeFed() func(map[string]int
 	}
 }
 
+// DeserializeDescriptionPropertyForgeFedContext returns the deserialization
+// method for the "ForgeFedDescriptionProperty" type or property in the
+// vocabulary "ForgeFed", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeDescriptionPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedDescriptionProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedDescriptionProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDescriptionPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeDiscoverablePropertyToot returns the deserialization method for the
 // "TootDiscoverableProperty" non-functional property in the vocabulary "Toot"
 func (this Manager) DeserializeDiscoverablePropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootDiscoverableProperty, error) {
@@ -705,6 +1291,19 @@ func (this Manager) DeserializeDiscoverablePropertyToot() func(map[string]interf
 	}
 }
 
+// DeserializeDiscoverablePropertyTootContext returns the deserialization method
+// for the "TootDiscoverableProperty" type or property in the vocabulary
+// "Toot", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeDiscoverablePropertyTootContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.TootDiscoverableProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.TootDiscoverableProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDiscoverablePropertyToot()(m, aliasMap)
+	}
+}
+
 // DeserializeDislikeActivityStreams returns the deserialization method for the
 // "ActivityStreamsDislike" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -718,6 +1317,19 @@ func (this Manager) DeserializeDislikeActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializeDislikeActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsDislike" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeDislikeActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsDislike, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDislike, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDislikeActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeDocumentActivityStreams returns the deserialization method for the
 // "ActivityStreamsDocument" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -731,6 +1343,19 @@ func (this Manager) DeserializeDocumentActivityStreams() func(map[string]interfa
 	}
 }
 
+// DeserializeDocumentActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsDocument" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeDocumentActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsDocument, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDocument, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDocumentActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeDurationPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsDurationProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -744,6 +1369,19 @@ func (this Manager) DeserializeDurationPropertyActivityStreams() func(map[string
 	}
 }
 
+// DeserializeDurationPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsDurationProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeDurationPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsDurationProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDurationProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeDurationPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeEarlyItemsPropertyForgeFed returns the deserialization method for
 // the "ForgeFedEarlyItemsProperty" non-functional property in the vocabulary
 // "ForgeFed"
@@ -757,11 +1395,24 @@ func (this Manager) DeserializeEarlyItemsPropertyForgeFed() func(map[string]inte
 	}
 }
 
-// DeserializeEmojiToot returns the deserialization method for the "TootEmoji"
-// non-functional property in the vocabulary "Toot"
-func (this Manager) DeserializeEmojiToot() func(map[string]interface{}, map[string]string) (vocab.TootEmoji, error) {
-	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootEmoji, error) {
-		i, err := typeemoji.DeserializeEmoji(m, aliasMap)
+// DeserializeEarlyItemsPropertyForgeFedContext returns the deserialization method
+// for the "ForgeFedEarlyItemsProperty" type or property in the vocabulary
+// "ForgeFed", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeEarlyItemsPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedEarlyItemsProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedEarlyItemsProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeEarlyItemsPropertyForgeFed()(m, aliasMap)
+	}
+}
+
+// DeserializeEmojiReactLitepub returns the deserialization method for the
+// "LitepubEmojiReact" non-functional property in the vocabulary "Litepub"
+func (this Manager) DeserializeEmojiReactLitepub() func(map[string]interface{}, map[string]string) (vocab.LitepubEmojiReact, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.LitepubEmojiReact, error) {
+		i, err := typeemojireact.DeserializeEmojiReact(m, aliasMap)
 		if i == nil {
 			return nil, err
 		}
@@ -769,8 +1420,46 @@ func (this Manager) DeserializeEmojiToot() func(map[string]interface{}, map[stri
 	}
 }
 
-// DeserializeEndTimePropertyActivityStreams returns the deserialization method
-// for the "ActivityStreamsEndTimeProperty" non-functional property in the
+// DeserializeEmojiReactLitepubContext returns the deserialization method for the
+// "LitepubEmojiReact" type or property in the vocabulary "Litepub", accepting
+// a context. It returns the context's error without deserializing once the
+// context is done.
+func (this Manager) DeserializeEmojiReactLitepubContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.LitepubEmojiReact, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.LitepubEmojiReact, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeEmojiReactLitepub()(m, aliasMap)
+	}
+}
+
+// DeserializeEmojiToot returns the deserialization method for the "TootEmoji"
+// non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeEmojiToot() func(map[string]interface{}, map[string]string) (vocab.TootEmoji, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootEmoji, error) {
+		i, err := typeemoji.DeserializeEmoji(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeEmojiTootContext returns the deserialization method for the
+// "TootEmoji" type or property in the vocabulary "Toot", accepting a context.
+// It returns the context's error without deserializing once the context is
+// done.
+func (this Manager) DeserializeEmojiTootContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.TootEmoji, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.TootEmoji, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeEmojiToot()(m, aliasMap)
+	}
+}
+
+// DeserializeEndTimePropertyActivityStreams returns the deserialization method
+// for the "ActivityStreamsEndTimeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
 func (this Manager) DeserializeEndTimePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEndTimeProperty, error) {
 	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsEndTimeProperty, error) {
@@ -782,6 +1471,19 @@ func (this Manager) DeserializeEndTimePropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeEndTimePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsEndTimeProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeEndTimePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsEndTimeProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsEndTimeProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeEndTimePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeEventActivityStreams returns the deserialization method for the
 // "ActivityStreamsEvent" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -795,6 +1497,19 @@ func (this Manager) DeserializeEventActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeEventActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsEvent" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeEventActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsEvent, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsEvent, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeEventActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeFeaturedPropertyToot returns the deserialization method for the
 // "TootFeaturedProperty" non-functional property in the vocabulary "Toot"
 func (this Manager) DeserializeFeaturedPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootFeaturedProperty, error) {
@@ -807,6 +1522,44 @@ func (this Manager) DeserializeFeaturedPropertyToot() func(map[string]interface{
 	}
 }
 
+// DeserializeFeaturedPropertyTootContext returns the deserialization method for
+// the "TootFeaturedProperty" type or property in the vocabulary "Toot",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeFeaturedPropertyTootContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.TootFeaturedProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.TootFeaturedProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFeaturedPropertyToot()(m, aliasMap)
+	}
+}
+
+// DeserializeFeaturedTagsPropertyToot returns the deserialization method for the
+// "TootFeaturedTagsProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeFeaturedTagsPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootFeaturedTagsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootFeaturedTagsProperty, error) {
+		i, err := propertyfeaturedtags.DeserializeFeaturedTagsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeFeaturedTagsPropertyTootContext returns the deserialization method
+// for the "TootFeaturedTagsProperty" type or property in the vocabulary
+// "Toot", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeFeaturedTagsPropertyTootContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.TootFeaturedTagsProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.TootFeaturedTagsProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFeaturedTagsPropertyToot()(m, aliasMap)
+	}
+}
+
 // DeserializeFilesAddedPropertyForgeFed returns the deserialization method for
 // the "ForgeFedFilesAddedProperty" non-functional property in the vocabulary
 // "ForgeFed"
@@ -820,6 +1573,19 @@ func (this Manager) DeserializeFilesAddedPropertyForgeFed() func(map[string]inte
 	}
 }
 
+// DeserializeFilesAddedPropertyForgeFedContext returns the deserialization method
+// for the "ForgeFedFilesAddedProperty" type or property in the vocabulary
+// "ForgeFed", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeFilesAddedPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedFilesAddedProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedFilesAddedProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFilesAddedPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeFilesModifiedPropertyForgeFed returns the deserialization method for
 // the "ForgeFedFilesModifiedProperty" non-functional property in the
 // vocabulary "ForgeFed"
@@ -833,6 +1599,19 @@ func (this Manager) DeserializeFilesModifiedPropertyForgeFed() func(map[string]i
 	}
 }
 
+// DeserializeFilesModifiedPropertyForgeFedContext returns the deserialization
+// method for the "ForgeFedFilesModifiedProperty" type or property in the
+// vocabulary "ForgeFed", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeFilesModifiedPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedFilesModifiedProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedFilesModifiedProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFilesModifiedPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeFilesRemovedPropertyForgeFed returns the deserialization method for
 // the "ForgeFedFilesRemovedProperty" non-functional property in the
 // vocabulary "ForgeFed"
@@ -846,6 +1625,19 @@ func (this Manager) DeserializeFilesRemovedPropertyForgeFed() func(map[string]in
 	}
 }
 
+// DeserializeFilesRemovedPropertyForgeFedContext returns the deserialization
+// method for the "ForgeFedFilesRemovedProperty" type or property in the
+// vocabulary "ForgeFed", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeFilesRemovedPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedFilesRemovedProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedFilesRemovedProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFilesRemovedPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeFirstPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsFirstProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -859,6 +1651,19 @@ func (this Manager) DeserializeFirstPropertyActivityStreams() func(map[string]in
 	}
 }
 
+// DeserializeFirstPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsFirstProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeFirstPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsFirstProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFirstProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFirstPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeFlagActivityStreams returns the deserialization method for the
 // "ActivityStreamsFlag" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -872,6 +1677,19 @@ func (this Manager) DeserializeFlagActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeFlagActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsFlag" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeFlagActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsFlag, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFlag, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFlagActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeFollowActivityStreams returns the deserialization method for the
 // "ActivityStreamsFollow" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -885,6 +1703,19 @@ func (this Manager) DeserializeFollowActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeFollowActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsFollow" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeFollowActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsFollow, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFollow, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFollowActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeFollowersPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsFollowersProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -898,6 +1729,19 @@ func (this Manager) DeserializeFollowersPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeFollowersPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsFollowersProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeFollowersPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsFollowersProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFollowersProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFollowersPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeFollowingPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsFollowingProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -911,6 +1755,19 @@ func (this Manager) DeserializeFollowingPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeFollowingPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsFollowingProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeFollowingPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsFollowingProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFollowingProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFollowingPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeForksPropertyForgeFed returns the deserialization method for the
 // "ForgeFedForksProperty" non-functional property in the vocabulary "ForgeFed"
 func (this Manager) DeserializeForksPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedForksProperty, error) {
@@ -923,6 +1780,19 @@ func (this Manager) DeserializeForksPropertyForgeFed() func(map[string]interface
 	}
 }
 
+// DeserializeForksPropertyForgeFedContext returns the deserialization method for
+// the "ForgeFedForksProperty" type or property in the vocabulary "ForgeFed",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeForksPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedForksProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedForksProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeForksPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeFormerTypePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsFormerTypeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -936,6 +1806,44 @@ func (this Manager) DeserializeFormerTypePropertyActivityStreams() func(map[stri
 	}
 }
 
+// DeserializeFormerTypePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsFormerTypeProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeFormerTypePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsFormerTypeProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFormerTypeProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFormerTypePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
+// DeserializeFpsPropertyPeerTube returns the deserialization method for the
+// "PeerTubeFpsProperty" non-functional property in the vocabulary "PeerTube"
+func (this Manager) DeserializeFpsPropertyPeerTube() func(map[string]interface{}, map[string]string) (vocab.PeerTubeFpsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.PeerTubeFpsProperty, error) {
+		i, err := propertyfps.DeserializeFpsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeFpsPropertyPeerTubeContext returns the deserialization method for
+// the "PeerTubeFpsProperty" type or property in the vocabulary "PeerTube",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeFpsPropertyPeerTubeContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.PeerTubeFpsProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.PeerTubeFpsProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeFpsPropertyPeerTube()(m, aliasMap)
+	}
+}
+
 // DeserializeGeneratorPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsGeneratorProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -949,6 +1857,19 @@ func (this Manager) DeserializeGeneratorPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeGeneratorPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsGeneratorProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeGeneratorPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsGeneratorProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsGeneratorProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeGeneratorPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeGroupActivityStreams returns the deserialization method for the
 // "ActivityStreamsGroup" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -962,6 +1883,19 @@ func (this Manager) DeserializeGroupActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeGroupActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsGroup" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeGroupActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsGroup, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsGroup, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeGroupActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeHashPropertyForgeFed returns the deserialization method for the
 // "ForgeFedHashProperty" non-functional property in the vocabulary "ForgeFed"
 func (this Manager) DeserializeHashPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedHashProperty, error) {
@@ -974,6 +1908,45 @@ func (this Manager) DeserializeHashPropertyForgeFed() func(map[string]interface{
 	}
 }
 
+// DeserializeHashPropertyForgeFedContext returns the deserialization method for
+// the "ForgeFedHashProperty" type or property in the vocabulary "ForgeFed",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeHashPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedHashProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedHashProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeHashPropertyForgeFed()(m, aliasMap)
+	}
+}
+
+// DeserializeHashtagActivityStreams returns the deserialization method for the
+// "ActivityStreamsHashtag" non-functional property in the vocabulary
+// "ActivityStreams"
+func (this Manager) DeserializeHashtagActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHashtag, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHashtag, error) {
+		i, err := typehashtag.DeserializeHashtag(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeHashtagActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsHashtag" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeHashtagActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsHashtag, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHashtag, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeHashtagActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeHeightPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsHeightProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -987,6 +1960,19 @@ func (this Manager) DeserializeHeightPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeHeightPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsHeightProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeHeightPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsHeightProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHeightProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeHeightPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeHrefPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsHrefProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1000,6 +1986,19 @@ func (this Manager) DeserializeHrefPropertyActivityStreams() func(map[string]int
 	}
 }
 
+// DeserializeHrefPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsHrefProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeHrefPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsHrefProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHrefProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeHrefPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeHreflangPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsHreflangProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1013,6 +2012,19 @@ func (this Manager) DeserializeHreflangPropertyActivityStreams() func(map[string
 	}
 }
 
+// DeserializeHreflangPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsHreflangProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeHreflangPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsHreflangProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHreflangProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeHreflangPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeIconPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsIconProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1026,6 +2038,19 @@ func (this Manager) DeserializeIconPropertyActivityStreams() func(map[string]int
 	}
 }
 
+// DeserializeIconPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsIconProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeIconPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsIconProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsIconProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeIconPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeIdPropertyJSONLD returns the deserialization method for the
 // "JSONLDIdProperty" non-functional property in the vocabulary "JSONLD"
 func (this Manager) DeserializeIdPropertyJSONLD() func(map[string]interface{}, map[string]string) (vocab.JSONLDIdProperty, error) {
@@ -1038,6 +2063,19 @@ func (this Manager) DeserializeIdPropertyJSONLD() func(map[string]interface{}, m
 	}
 }
 
+// DeserializeIdPropertyJSONLDContext returns the deserialization method for the
+// "JSONLDIdProperty" type or property in the vocabulary "JSONLD", accepting a
+// context. It returns the context's error without deserializing once the
+// context is done.
+func (this Manager) DeserializeIdPropertyJSONLDContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.JSONLDIdProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.JSONLDIdProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeIdPropertyJSONLD()(m, aliasMap)
+	}
+}
+
 // DeserializeIdentityProofToot returns the deserialization method for the
 // "TootIdentityProof" non-functional property in the vocabulary "Toot"
 func (this Manager) DeserializeIdentityProofToot() func(map[string]interface{}, map[string]string) (vocab.TootIdentityProof, error) {
@@ -1050,6 +2088,19 @@ func (this Manager) DeserializeIdentityProofToot() func(map[string]interface{},
 	}
 }
 
+// DeserializeIdentityProofTootContext returns the deserialization method for the
+// "TootIdentityProof" type or property in the vocabulary "Toot", accepting a
+// context. It returns the context's error without deserializing once the
+// context is done.
+func (this Manager) DeserializeIdentityProofTootContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.TootIdentityProof, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.TootIdentityProof, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeIdentityProofToot()(m, aliasMap)
+	}
+}
+
 // DeserializeIgnoreActivityStreams returns the deserialization method for the
 // "ActivityStreamsIgnore" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1063,6 +2114,19 @@ func (this Manager) DeserializeIgnoreActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeIgnoreActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsIgnore" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeIgnoreActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsIgnore, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsIgnore, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeIgnoreActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeImageActivityStreams returns the deserialization method for the
 // "ActivityStreamsImage" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1076,6 +2140,19 @@ func (this Manager) DeserializeImageActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeImageActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsImage" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeImageActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsImage, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsImage, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeImageActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeImagePropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsImageProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1089,6 +2166,19 @@ func (this Manager) DeserializeImagePropertyActivityStreams() func(map[string]in
 	}
 }
 
+// DeserializeImagePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsImageProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeImagePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsImageProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsImageProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeImagePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeInReplyToPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsInReplyToProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1102,6 +2192,19 @@ func (this Manager) DeserializeInReplyToPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeInReplyToPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsInReplyToProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeInReplyToPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsInReplyToProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInReplyToProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeInReplyToPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeInboxPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsInboxProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1115,6 +2218,44 @@ func (this Manager) DeserializeInboxPropertyActivityStreams() func(map[string]in
 	}
 }
 
+// DeserializeInboxPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsInboxProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeInboxPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsInboxProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInboxProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeInboxPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
+// DeserializeIndexablePropertyToot returns the deserialization method for the
+// "TootIndexableProperty" non-functional property in the vocabulary "Toot"
+func (this Manager) DeserializeIndexablePropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootIndexableProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.TootIndexableProperty, error) {
+		i, err := propertyindexable.DeserializeIndexableProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeIndexablePropertyTootContext returns the deserialization method for
+// the "TootIndexableProperty" type or property in the vocabulary "Toot",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeIndexablePropertyTootContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.TootIndexableProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.TootIndexableProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeIndexablePropertyToot()(m, aliasMap)
+	}
+}
+
 // DeserializeInstrumentPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsInstrumentProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1128,6 +2269,19 @@ func (this Manager) DeserializeInstrumentPropertyActivityStreams() func(map[stri
 	}
 }
 
+// DeserializeInstrumentPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsInstrumentProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeInstrumentPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsInstrumentProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInstrumentProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeInstrumentPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeIntransitiveActivityActivityStreams returns the deserialization
 // method for the "ActivityStreamsIntransitiveActivity" non-functional
 // property in the vocabulary "ActivityStreams"
@@ -1141,6 +2295,19 @@ func (this Manager) DeserializeIntransitiveActivityActivityStreams() func(map[st
 	}
 }
 
+// DeserializeIntransitiveActivityActivityStreamsContext returns the
+// deserialization method for the "ActivityStreamsIntransitiveActivity" type
+// or property in the vocabulary "ActivityStreams", accepting a context. It
+// returns the context's error without deserializing once the context is done.
+func (this Manager) DeserializeIntransitiveActivityActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsIntransitiveActivity, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsIntransitiveActivity, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeInviteActivityStreams returns the deserialization method for the
 // "ActivityStreamsInvite" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1154,6 +2321,19 @@ func (this Manager) DeserializeInviteActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeInviteActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsInvite" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeInviteActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsInvite, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInvite, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeInviteActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeIsResolvedPropertyForgeFed returns the deserialization method for
 // the "ForgeFedIsResolvedProperty" non-functional property in the vocabulary
 // "ForgeFed"
@@ -1167,6 +2347,19 @@ func (this Manager) DeserializeIsResolvedPropertyForgeFed() func(map[string]inte
 	}
 }
 
+// DeserializeIsResolvedPropertyForgeFedContext returns the deserialization method
+// for the "ForgeFedIsResolvedProperty" type or property in the vocabulary
+// "ForgeFed", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeIsResolvedPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedIsResolvedProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedIsResolvedProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeIsResolvedPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeItemsPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsItemsProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1180,6 +2373,19 @@ func (this Manager) DeserializeItemsPropertyActivityStreams() func(map[string]in
 	}
 }
 
+// DeserializeItemsPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsItemsProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeItemsPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsItemsProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsItemsProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeItemsPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeJoinActivityStreams returns the deserialization method for the
 // "ActivityStreamsJoin" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1193,6 +2399,19 @@ func (this Manager) DeserializeJoinActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeJoinActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsJoin" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeJoinActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsJoin, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsJoin, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeJoinActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeLastPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsLastProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1206,6 +2425,19 @@ func (this Manager) DeserializeLastPropertyActivityStreams() func(map[string]int
 	}
 }
 
+// DeserializeLastPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsLastProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeLastPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsLastProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLastProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeLastPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeLatitudePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsLatitudeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1219,6 +2451,19 @@ func (this Manager) DeserializeLatitudePropertyActivityStreams() func(map[string
 	}
 }
 
+// DeserializeLatitudePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsLatitudeProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeLatitudePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsLatitudeProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLatitudeProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeLatitudePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeLeaveActivityStreams returns the deserialization method for the
 // "ActivityStreamsLeave" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1232,6 +2477,45 @@ func (this Manager) DeserializeLeaveActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeLeaveActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsLeave" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeLeaveActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsLeave, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLeave, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeLeaveActivityStreams()(m, aliasMap)
+	}
+}
+
+// DeserializeLicencePropertyPeerTube returns the deserialization method for the
+// "PeerTubeLicenceProperty" non-functional property in the vocabulary
+// "PeerTube"
+func (this Manager) DeserializeLicencePropertyPeerTube() func(map[string]interface{}, map[string]string) (vocab.PeerTubeLicenceProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.PeerTubeLicenceProperty, error) {
+		i, err := propertylicence.DeserializeLicenceProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeLicencePropertyPeerTubeContext returns the deserialization method
+// for the "PeerTubeLicenceProperty" type or property in the vocabulary
+// "PeerTube", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeLicencePropertyPeerTubeContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.PeerTubeLicenceProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.PeerTubeLicenceProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeLicencePropertyPeerTube()(m, aliasMap)
+	}
+}
+
 // DeserializeLikeActivityStreams returns the deserialization method for the
 // "ActivityStreamsLike" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1245,6 +2529,19 @@ func (this Manager) DeserializeLikeActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeLikeActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsLike" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeLikeActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsLike, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLike, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeLikeActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeLikedPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsLikedProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1258,6 +2555,19 @@ func (this Manager) DeserializeLikedPropertyActivityStreams() func(map[string]in
 	}
 }
 
+// DeserializeLikedPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsLikedProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeLikedPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsLikedProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLikedProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeLikedPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeLikesPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsLikesProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1271,6 +2581,19 @@ func (this Manager) DeserializeLikesPropertyActivityStreams() func(map[string]in
 	}
 }
 
+// DeserializeLikesPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsLikesProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeLikesPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsLikesProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLikesProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeLikesPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeLinkActivityStreams returns the deserialization method for the
 // "ActivityStreamsLink" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1284,6 +2607,19 @@ func (this Manager) DeserializeLinkActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeLinkActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsLink" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeLinkActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsLink, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLink, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeLinkActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeListenActivityStreams returns the deserialization method for the
 // "ActivityStreamsListen" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1297,6 +2633,19 @@ func (this Manager) DeserializeListenActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeListenActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsListen" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeListenActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsListen, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsListen, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeListenActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeLocationPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsLocationProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1310,6 +2659,19 @@ func (this Manager) DeserializeLocationPropertyActivityStreams() func(map[string
 	}
 }
 
+// DeserializeLocationPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsLocationProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeLocationPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsLocationProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLocationProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeLocationPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeLongitudePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsLongitudeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1323,6 +2685,19 @@ func (this Manager) DeserializeLongitudePropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeLongitudePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsLongitudeProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeLongitudePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsLongitudeProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLongitudeProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeLongitudePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeMediaTypePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsMediaTypeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1336,6 +2711,19 @@ func (this Manager) DeserializeMediaTypePropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeMediaTypePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsMediaTypeProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeMediaTypePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsMediaTypeProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMediaTypeProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeMediaTypePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeMentionActivityStreams returns the deserialization method for the
 // "ActivityStreamsMention" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1349,6 +2737,19 @@ func (this Manager) DeserializeMentionActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializeMentionActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsMention" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeMentionActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsMention, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMention, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeMentionActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeMoveActivityStreams returns the deserialization method for the
 // "ActivityStreamsMove" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1362,6 +2763,45 @@ func (this Manager) DeserializeMoveActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeMoveActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsMove" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeMoveActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsMove, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMove, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeMoveActivityStreams()(m, aliasMap)
+	}
+}
+
+// DeserializeMovedToPropertyActivityStreams returns the deserialization method
+// for the "ActivityStreamsMovedToProperty" non-functional property in the
+// vocabulary "ActivityStreams"
+func (this Manager) DeserializeMovedToPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsMovedToProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMovedToProperty, error) {
+		i, err := propertymovedto.DeserializeMovedToProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeMovedToPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsMovedToProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeMovedToPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsMovedToProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMovedToProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeMovedToPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeNamePropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsNameProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1375,6 +2815,19 @@ func (this Manager) DeserializeNamePropertyActivityStreams() func(map[string]int
 	}
 }
 
+// DeserializeNamePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsNameProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeNamePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsNameProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsNameProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeNamePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeNextPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsNextProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1388,6 +2841,19 @@ func (this Manager) DeserializeNextPropertyActivityStreams() func(map[string]int
 	}
 }
 
+// DeserializeNextPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsNextProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeNextPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsNextProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsNextProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeNextPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeNoteActivityStreams returns the deserialization method for the
 // "ActivityStreamsNote" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1401,6 +2867,19 @@ func (this Manager) DeserializeNoteActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeNoteActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsNote" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeNoteActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsNote, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsNote, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeNoteActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeObjectActivityStreams returns the deserialization method for the
 // "ActivityStreamsObject" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1414,6 +2893,19 @@ func (this Manager) DeserializeObjectActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeObjectActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsObject" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeObjectActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsObject, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsObject, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeObjectActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeObjectPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsObjectProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1427,6 +2919,19 @@ func (this Manager) DeserializeObjectPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeObjectPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsObjectProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeObjectPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsObjectProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsObjectProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeObjectPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeOfferActivityStreams returns the deserialization method for the
 // "ActivityStreamsOffer" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1440,6 +2945,19 @@ func (this Manager) DeserializeOfferActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeOfferActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsOffer" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeOfferActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsOffer, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOffer, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeOfferActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeOneOfPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsOneOfProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1453,6 +2971,19 @@ func (this Manager) DeserializeOneOfPropertyActivityStreams() func(map[string]in
 	}
 }
 
+// DeserializeOneOfPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsOneOfProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeOneOfPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsOneOfProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOneOfProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeOneOfPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeOrderedCollectionActivityStreams returns the deserialization method
 // for the "ActivityStreamsOrderedCollection" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1466,6 +2997,19 @@ func (this Manager) DeserializeOrderedCollectionActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeOrderedCollectionActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsOrderedCollection" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeOrderedCollectionActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrderedCollection, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrderedCollection, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeOrderedCollectionActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeOrderedCollectionPageActivityStreams returns the deserialization
 // method for the "ActivityStreamsOrderedCollectionPage" non-functional
 // property in the vocabulary "ActivityStreams"
@@ -1479,6 +3023,19 @@ func (this Manager) DeserializeOrderedCollectionPageActivityStreams() func(map[s
 	}
 }
 
+// DeserializeOrderedCollectionPageActivityStreamsContext returns the
+// deserialization method for the "ActivityStreamsOrderedCollectionPage" type
+// or property in the vocabulary "ActivityStreams", accepting a context. It
+// returns the context's error without deserializing once the context is done.
+func (this Manager) DeserializeOrderedCollectionPageActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeOrderedItemsPropertyActivityStreams returns the deserialization
 // method for the "ActivityStreamsOrderedItemsProperty" non-functional
 // property in the vocabulary "ActivityStreams"
@@ -1492,6 +3049,19 @@ func (this Manager) DeserializeOrderedItemsPropertyActivityStreams() func(map[st
 	}
 }
 
+// DeserializeOrderedItemsPropertyActivityStreamsContext returns the
+// deserialization method for the "ActivityStreamsOrderedItemsProperty" type
+// or property in the vocabulary "ActivityStreams", accepting a context. It
+// returns the context's error without deserializing once the context is done.
+func (this Manager) DeserializeOrderedItemsPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrderedItemsProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrderedItemsProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeOrderedItemsPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeOrganizationActivityStreams returns the deserialization method for
 // the "ActivityStreamsOrganization" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1505,6 +3075,19 @@ func (this Manager) DeserializeOrganizationActivityStreams() func(map[string]int
 	}
 }
 
+// DeserializeOrganizationActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsOrganization" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeOrganizationActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrganization, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrganization, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeOrganizationActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeOriginPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsOriginProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1518,6 +3101,19 @@ func (this Manager) DeserializeOriginPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeOriginPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsOriginProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeOriginPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsOriginProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOriginProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeOriginPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeOutboxPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsOutboxProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1531,6 +3127,19 @@ func (this Manager) DeserializeOutboxPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeOutboxPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsOutboxProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeOutboxPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsOutboxProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOutboxProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeOutboxPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeOwnerPropertyW3IDSecurityV1 returns the deserialization method for
 // the "W3IDSecurityV1OwnerProperty" non-functional property in the vocabulary
 // "W3IDSecurityV1"
@@ -1544,6 +3153,19 @@ func (this Manager) DeserializeOwnerPropertyW3IDSecurityV1() func(map[string]int
 	}
 }
 
+// DeserializeOwnerPropertyW3IDSecurityV1Context returns the deserialization
+// method for the "W3IDSecurityV1OwnerProperty" type or property in the
+// vocabulary "W3IDSecurityV1", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeOwnerPropertyW3IDSecurityV1Context() func(context.Context, map[string]interface{}, map[string]string) (vocab.W3IDSecurityV1OwnerProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDSecurityV1OwnerProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeOwnerPropertyW3IDSecurityV1()(m, aliasMap)
+	}
+}
+
 // DeserializePageActivityStreams returns the deserialization method for the
 // "ActivityStreamsPage" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1557,6 +3179,19 @@ func (this Manager) DeserializePageActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializePageActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsPage" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializePageActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsPage, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPage, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePageActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializePartOfPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsPartOfProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1570,6 +3205,19 @@ func (this Manager) DeserializePartOfPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializePartOfPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsPartOfProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializePartOfPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsPartOfProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPartOfProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePartOfPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializePersonActivityStreams returns the deserialization method for the
 // "ActivityStreamsPerson" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1583,6 +3231,19 @@ func (this Manager) DeserializePersonActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializePersonActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsPerson" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializePersonActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsPerson, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPerson, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePersonActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializePlaceActivityStreams returns the deserialization method for the
 // "ActivityStreamsPlace" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1596,6 +3257,19 @@ func (this Manager) DeserializePlaceActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializePlaceActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsPlace" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializePlaceActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsPlace, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPlace, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePlaceActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializePreferredUsernamePropertyActivityStreams returns the deserialization
 // method for the "ActivityStreamsPreferredUsernameProperty" non-functional
 // property in the vocabulary "ActivityStreams"
@@ -1609,6 +3283,20 @@ func (this Manager) DeserializePreferredUsernamePropertyActivityStreams() func(m
 	}
 }
 
+// DeserializePreferredUsernamePropertyActivityStreamsContext returns the
+// deserialization method for the "ActivityStreamsPreferredUsernameProperty"
+// type or property in the vocabulary "ActivityStreams", accepting a context.
+// It returns the context's error without deserializing once the context is
+// done.
+func (this Manager) DeserializePreferredUsernamePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsPreferredUsernameProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPreferredUsernameProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePreferredUsernamePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializePrevPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsPrevProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1622,6 +3310,19 @@ func (this Manager) DeserializePrevPropertyActivityStreams() func(map[string]int
 	}
 }
 
+// DeserializePrevPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsPrevProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializePrevPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsPrevProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPrevProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePrevPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializePreviewPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsPreviewProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1635,6 +3336,19 @@ func (this Manager) DeserializePreviewPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializePreviewPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsPreviewProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializePreviewPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsPreviewProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPreviewProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePreviewPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeProfileActivityStreams returns the deserialization method for the
 // "ActivityStreamsProfile" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1648,6 +3362,44 @@ func (this Manager) DeserializeProfileActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializeProfileActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsProfile" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeProfileActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsProfile, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsProfile, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeProfileActivityStreams()(m, aliasMap)
+	}
+}
+
+// DeserializePropertyValueSchema returns the deserialization method for the
+// "SchemaPropertyValue" non-functional property in the vocabulary "Schema"
+func (this Manager) DeserializePropertyValueSchema() func(map[string]interface{}, map[string]string) (vocab.SchemaPropertyValue, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.SchemaPropertyValue, error) {
+		i, err := typepropertyvalue.DeserializePropertyValue(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializePropertyValueSchemaContext returns the deserialization method for
+// the "SchemaPropertyValue" type or property in the vocabulary "Schema",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializePropertyValueSchemaContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.SchemaPropertyValue, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.SchemaPropertyValue, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePropertyValueSchema()(m, aliasMap)
+	}
+}
+
 // DeserializePublicKeyPemPropertyW3IDSecurityV1 returns the deserialization
 // method for the "W3IDSecurityV1PublicKeyPemProperty" non-functional property
 // in the vocabulary "W3IDSecurityV1"
@@ -1661,6 +3413,19 @@ func (this Manager) DeserializePublicKeyPemPropertyW3IDSecurityV1() func(map[str
 	}
 }
 
+// DeserializePublicKeyPemPropertyW3IDSecurityV1Context returns the
+// deserialization method for the "W3IDSecurityV1PublicKeyPemProperty" type or
+// property in the vocabulary "W3IDSecurityV1", accepting a context. It
+// returns the context's error without deserializing once the context is done.
+func (this Manager) DeserializePublicKeyPemPropertyW3IDSecurityV1Context() func(context.Context, map[string]interface{}, map[string]string) (vocab.W3IDSecurityV1PublicKeyPemProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDSecurityV1PublicKeyPemProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePublicKeyPemPropertyW3IDSecurityV1()(m, aliasMap)
+	}
+}
+
 // DeserializePublicKeyPropertyW3IDSecurityV1 returns the deserialization method
 // for the "W3IDSecurityV1PublicKeyProperty" non-functional property in the
 // vocabulary "W3IDSecurityV1"
@@ -1674,6 +3439,19 @@ func (this Manager) DeserializePublicKeyPropertyW3IDSecurityV1() func(map[string
 	}
 }
 
+// DeserializePublicKeyPropertyW3IDSecurityV1Context returns the deserialization
+// method for the "W3IDSecurityV1PublicKeyProperty" type or property in the
+// vocabulary "W3IDSecurityV1", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializePublicKeyPropertyW3IDSecurityV1Context() func(context.Context, map[string]interface{}, map[string]string) (vocab.W3IDSecurityV1PublicKeyProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDSecurityV1PublicKeyProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePublicKeyPropertyW3IDSecurityV1()(m, aliasMap)
+	}
+}
+
 // DeserializePublicKeyW3IDSecurityV1 returns the deserialization method for the
 // "W3IDSecurityV1PublicKey" non-functional property in the vocabulary
 // "W3IDSecurityV1"
@@ -1687,6 +3465,19 @@ func (this Manager) DeserializePublicKeyW3IDSecurityV1() func(map[string]interfa
 	}
 }
 
+// DeserializePublicKeyW3IDSecurityV1Context returns the deserialization method
+// for the "W3IDSecurityV1PublicKey" type or property in the vocabulary
+// "W3IDSecurityV1", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializePublicKeyW3IDSecurityV1Context() func(context.Context, map[string]interface{}, map[string]string) (vocab.W3IDSecurityV1PublicKey, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDSecurityV1PublicKey, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePublicKeyW3IDSecurityV1()(m, aliasMap)
+	}
+}
+
 // DeserializePublishedPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsPublishedProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1700,6 +3491,19 @@ func (this Manager) DeserializePublishedPropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializePublishedPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsPublishedProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializePublishedPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsPublishedProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPublishedProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePublishedPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializePushForgeFed returns the deserialization method for the
 // "ForgeFedPush" non-functional property in the vocabulary "ForgeFed"
 func (this Manager) DeserializePushForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedPush, error) {
@@ -1712,6 +3516,19 @@ func (this Manager) DeserializePushForgeFed() func(map[string]interface{}, map[s
 	}
 }
 
+// DeserializePushForgeFedContext returns the deserialization method for the
+// "ForgeFedPush" type or property in the vocabulary "ForgeFed", accepting a
+// context. It returns the context's error without deserializing once the
+// context is done.
+func (this Manager) DeserializePushForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedPush, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedPush, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializePushForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeQuestionActivityStreams returns the deserialization method for the
 // "ActivityStreamsQuestion" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1725,6 +3542,19 @@ func (this Manager) DeserializeQuestionActivityStreams() func(map[string]interfa
 	}
 }
 
+// DeserializeQuestionActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsQuestion" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeQuestionActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsQuestion, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsQuestion, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeQuestionActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeRadiusPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsRadiusProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1734,7 +3564,20 @@ func (this Manager) DeserializeRadiusPropertyActivityStreams() func(map[string]i
 		if i == nil {
 			return nil, err
 		}
-		return i, err
+		return i, err
+	}
+}
+
+// DeserializeRadiusPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsRadiusProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeRadiusPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsRadiusProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRadiusProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeRadiusPropertyActivityStreams()(m, aliasMap)
 	}
 }
 
@@ -1751,6 +3594,19 @@ func (this Manager) DeserializeReadActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeReadActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsRead" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeReadActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsRead, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRead, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeReadActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeRefPropertyForgeFed returns the deserialization method for the
 // "ForgeFedRefProperty" non-functional property in the vocabulary "ForgeFed"
 func (this Manager) DeserializeRefPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedRefProperty, error) {
@@ -1763,6 +3619,19 @@ func (this Manager) DeserializeRefPropertyForgeFed() func(map[string]interface{}
 	}
 }
 
+// DeserializeRefPropertyForgeFedContext returns the deserialization method for
+// the "ForgeFedRefProperty" type or property in the vocabulary "ForgeFed",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeRefPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedRefProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedRefProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeRefPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeRejectActivityStreams returns the deserialization method for the
 // "ActivityStreamsReject" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1776,6 +3645,19 @@ func (this Manager) DeserializeRejectActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeRejectActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsReject" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeRejectActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsReject, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsReject, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeRejectActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeRelPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsRelProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1789,6 +3671,19 @@ func (this Manager) DeserializeRelPropertyActivityStreams() func(map[string]inte
 	}
 }
 
+// DeserializeRelPropertyActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsRelProperty" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeRelPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsRelProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRelProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeRelPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeRelationshipActivityStreams returns the deserialization method for
 // the "ActivityStreamsRelationship" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1802,6 +3697,19 @@ func (this Manager) DeserializeRelationshipActivityStreams() func(map[string]int
 	}
 }
 
+// DeserializeRelationshipActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsRelationship" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeRelationshipActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsRelationship, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRelationship, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeRelationshipActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeRelationshipPropertyActivityStreams returns the deserialization
 // method for the "ActivityStreamsRelationshipProperty" non-functional
 // property in the vocabulary "ActivityStreams"
@@ -1815,6 +3723,19 @@ func (this Manager) DeserializeRelationshipPropertyActivityStreams() func(map[st
 	}
 }
 
+// DeserializeRelationshipPropertyActivityStreamsContext returns the
+// deserialization method for the "ActivityStreamsRelationshipProperty" type
+// or property in the vocabulary "ActivityStreams", accepting a context. It
+// returns the context's error without deserializing once the context is done.
+func (this Manager) DeserializeRelationshipPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsRelationshipProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRelationshipProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeRelationshipPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeRemoveActivityStreams returns the deserialization method for the
 // "ActivityStreamsRemove" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1828,6 +3749,19 @@ func (this Manager) DeserializeRemoveActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeRemoveActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsRemove" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeRemoveActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsRemove, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRemove, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeRemoveActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeRepliesPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsRepliesProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1841,6 +3775,19 @@ func (this Manager) DeserializeRepliesPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeRepliesPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsRepliesProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeRepliesPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsRepliesProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRepliesProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeRepliesPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeRepositoryForgeFed returns the deserialization method for the
 // "ForgeFedRepository" non-functional property in the vocabulary "ForgeFed"
 func (this Manager) DeserializeRepositoryForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedRepository, error) {
@@ -1853,6 +3800,19 @@ func (this Manager) DeserializeRepositoryForgeFed() func(map[string]interface{},
 	}
 }
 
+// DeserializeRepositoryForgeFedContext returns the deserialization method for the
+// "ForgeFedRepository" type or property in the vocabulary "ForgeFed",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeRepositoryForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedRepository, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedRepository, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeRepositoryForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeResultPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsResultProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1866,6 +3826,45 @@ func (this Manager) DeserializeResultPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeResultPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsResultProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeResultPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsResultProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsResultProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeResultPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
+// DeserializeSensitivePropertyActivityStreams returns the deserialization method
+// for the "ActivityStreamsSensitiveProperty" non-functional property in the
+// vocabulary "ActivityStreams"
+func (this Manager) DeserializeSensitivePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsSensitiveProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSensitiveProperty, error) {
+		i, err := propertysensitive.DeserializeSensitiveProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeSensitivePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsSensitiveProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeSensitivePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsSensitiveProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSensitiveProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeSensitivePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeServiceActivityStreams returns the deserialization method for the
 // "ActivityStreamsService" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1879,6 +3878,19 @@ func (this Manager) DeserializeServiceActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializeServiceActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsService" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeServiceActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsService, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsService, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeServiceActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeSharesPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsSharesProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1892,6 +3904,19 @@ func (this Manager) DeserializeSharesPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeSharesPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsSharesProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeSharesPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsSharesProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSharesProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeSharesPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeSignatureAlgorithmPropertyToot returns the deserialization method
 // for the "TootSignatureAlgorithmProperty" non-functional property in the
 // vocabulary "Toot"
@@ -1905,6 +3930,19 @@ func (this Manager) DeserializeSignatureAlgorithmPropertyToot() func(map[string]
 	}
 }
 
+// DeserializeSignatureAlgorithmPropertyTootContext returns the deserialization
+// method for the "TootSignatureAlgorithmProperty" type or property in the
+// vocabulary "Toot", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeSignatureAlgorithmPropertyTootContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.TootSignatureAlgorithmProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.TootSignatureAlgorithmProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeSignatureAlgorithmPropertyToot()(m, aliasMap)
+	}
+}
+
 // DeserializeSignatureValuePropertyToot returns the deserialization method for
 // the "TootSignatureValueProperty" non-functional property in the vocabulary
 // "Toot"
@@ -1918,6 +3956,19 @@ func (this Manager) DeserializeSignatureValuePropertyToot() func(map[string]inte
 	}
 }
 
+// DeserializeSignatureValuePropertyTootContext returns the deserialization method
+// for the "TootSignatureValueProperty" type or property in the vocabulary
+// "Toot", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeSignatureValuePropertyTootContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.TootSignatureValueProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.TootSignatureValueProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeSignatureValuePropertyToot()(m, aliasMap)
+	}
+}
+
 // DeserializeSourcePropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsSourceProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1931,6 +3982,19 @@ func (this Manager) DeserializeSourcePropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeSourcePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsSourceProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeSourcePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsSourceProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSourceProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeSourcePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeStartIndexPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsStartIndexProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1944,6 +4008,19 @@ func (this Manager) DeserializeStartIndexPropertyActivityStreams() func(map[stri
 	}
 }
 
+// DeserializeStartIndexPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsStartIndexProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeStartIndexPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsStartIndexProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsStartIndexProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeStartIndexPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeStartTimePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsStartTimeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1957,6 +4034,19 @@ func (this Manager) DeserializeStartTimePropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeStartTimePropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsStartTimeProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeStartTimePropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsStartTimeProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsStartTimeProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeStartTimePropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeStreamsPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsStreamsProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1970,6 +4060,19 @@ func (this Manager) DeserializeStreamsPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeStreamsPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsStreamsProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeStreamsPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsStreamsProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsStreamsProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeStreamsPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeSubjectPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsSubjectProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1983,6 +4086,19 @@ func (this Manager) DeserializeSubjectPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeSubjectPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsSubjectProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeSubjectPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsSubjectProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSubjectProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeSubjectPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeSummaryPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsSummaryProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1996,6 +4112,19 @@ func (this Manager) DeserializeSummaryPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeSummaryPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsSummaryProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeSummaryPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsSummaryProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSummaryProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeSummaryPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeTagPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsTagProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -2009,6 +4138,19 @@ func (this Manager) DeserializeTagPropertyActivityStreams() func(map[string]inte
 	}
 }
 
+// DeserializeTagPropertyActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsTagProperty" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeTagPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsTagProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTagProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTagPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeTargetPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsTargetProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -2022,6 +4164,19 @@ func (this Manager) DeserializeTargetPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeTargetPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsTargetProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeTargetPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsTargetProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTargetProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTargetPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeTeamPropertyForgeFed returns the deserialization method for the
 // "ForgeFedTeamProperty" non-functional property in the vocabulary "ForgeFed"
 func (this Manager) DeserializeTeamPropertyForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTeamProperty, error) {
@@ -2034,6 +4189,19 @@ func (this Manager) DeserializeTeamPropertyForgeFed() func(map[string]interface{
 	}
 }
 
+// DeserializeTeamPropertyForgeFedContext returns the deserialization method for
+// the "ForgeFedTeamProperty" type or property in the vocabulary "ForgeFed",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeTeamPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedTeamProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTeamProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTeamPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeTentativeAcceptActivityStreams returns the deserialization method
 // for the "ActivityStreamsTentativeAccept" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -2047,6 +4215,19 @@ func (this Manager) DeserializeTentativeAcceptActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeTentativeAcceptActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsTentativeAccept" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeTentativeAcceptActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsTentativeAccept, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTentativeAccept, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTentativeAcceptActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeTentativeRejectActivityStreams returns the deserialization method
 // for the "ActivityStreamsTentativeReject" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -2060,6 +4241,19 @@ func (this Manager) DeserializeTentativeRejectActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeTentativeRejectActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsTentativeReject" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeTentativeRejectActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsTentativeReject, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTentativeReject, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTentativeRejectActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeTicketDependencyForgeFed returns the deserialization method for the
 // "ForgeFedTicketDependency" non-functional property in the vocabulary
 // "ForgeFed"
@@ -2073,6 +4267,19 @@ func (this Manager) DeserializeTicketDependencyForgeFed() func(map[string]interf
 	}
 }
 
+// DeserializeTicketDependencyForgeFedContext returns the deserialization method
+// for the "ForgeFedTicketDependency" type or property in the vocabulary
+// "ForgeFed", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeTicketDependencyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedTicketDependency, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTicketDependency, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTicketDependencyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeTicketForgeFed returns the deserialization method for the
 // "ForgeFedTicket" non-functional property in the vocabulary "ForgeFed"
 func (this Manager) DeserializeTicketForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedTicket, error) {
@@ -2085,6 +4292,19 @@ func (this Manager) DeserializeTicketForgeFed() func(map[string]interface{}, map
 	}
 }
 
+// DeserializeTicketForgeFedContext returns the deserialization method for the
+// "ForgeFedTicket" type or property in the vocabulary "ForgeFed", accepting a
+// context. It returns the context's error without deserializing once the
+// context is done.
+func (this Manager) DeserializeTicketForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedTicket, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTicket, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTicketForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeTicketsTrackedByPropertyForgeFed returns the deserialization method
 // for the "ForgeFedTicketsTrackedByProperty" non-functional property in the
 // vocabulary "ForgeFed"
@@ -2098,6 +4318,19 @@ func (this Manager) DeserializeTicketsTrackedByPropertyForgeFed() func(map[strin
 	}
 }
 
+// DeserializeTicketsTrackedByPropertyForgeFedContext returns the deserialization
+// method for the "ForgeFedTicketsTrackedByProperty" type or property in the
+// vocabulary "ForgeFed", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeTicketsTrackedByPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedTicketsTrackedByProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTicketsTrackedByProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTicketsTrackedByPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeToPropertyActivityStreams returns the deserialization method for the
 // "ActivityStreamsToProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -2111,6 +4344,19 @@ func (this Manager) DeserializeToPropertyActivityStreams() func(map[string]inter
 	}
 }
 
+// DeserializeToPropertyActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsToProperty" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeToPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsToProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsToProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeToPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeTombstoneActivityStreams returns the deserialization method for the
 // "ActivityStreamsTombstone" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -2124,6 +4370,19 @@ func (this Manager) DeserializeTombstoneActivityStreams() func(map[string]interf
 	}
 }
 
+// DeserializeTombstoneActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsTombstone" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeTombstoneActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsTombstone, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTombstone, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTombstoneActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeTotalItemsPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsTotalItemsProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -2137,6 +4396,19 @@ func (this Manager) DeserializeTotalItemsPropertyActivityStreams() func(map[stri
 	}
 }
 
+// DeserializeTotalItemsPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsTotalItemsProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeTotalItemsPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsTotalItemsProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTotalItemsProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTotalItemsPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeTracksTicketsForPropertyForgeFed returns the deserialization method
 // for the "ForgeFedTracksTicketsForProperty" non-functional property in the
 // vocabulary "ForgeFed"
@@ -2150,6 +4422,19 @@ func (this Manager) DeserializeTracksTicketsForPropertyForgeFed() func(map[strin
 	}
 }
 
+// DeserializeTracksTicketsForPropertyForgeFedContext returns the deserialization
+// method for the "ForgeFedTracksTicketsForProperty" type or property in the
+// vocabulary "ForgeFed", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeTracksTicketsForPropertyForgeFedContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ForgeFedTracksTicketsForProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ForgeFedTracksTicketsForProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTracksTicketsForPropertyForgeFed()(m, aliasMap)
+	}
+}
+
 // DeserializeTravelActivityStreams returns the deserialization method for the
 // "ActivityStreamsTravel" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -2163,6 +4448,19 @@ func (this Manager) DeserializeTravelActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeTravelActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsTravel" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeTravelActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsTravel, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTravel, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTravelActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeTypePropertyJSONLD returns the deserialization method for the
 // "JSONLDTypeProperty" non-functional property in the vocabulary "JSONLD"
 func (this Manager) DeserializeTypePropertyJSONLD() func(map[string]interface{}, map[string]string) (vocab.JSONLDTypeProperty, error) {
@@ -2175,6 +4473,19 @@ func (this Manager) DeserializeTypePropertyJSONLD() func(map[string]interface{},
 	}
 }
 
+// DeserializeTypePropertyJSONLDContext returns the deserialization method for the
+// "JSONLDTypeProperty" type or property in the vocabulary "JSONLD", accepting
+// a context. It returns the context's error without deserializing once the
+// context is done.
+func (this Manager) DeserializeTypePropertyJSONLDContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.JSONLDTypeProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.JSONLDTypeProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeTypePropertyJSONLD()(m, aliasMap)
+	}
+}
+
 // DeserializeUndoActivityStreams returns the deserialization method for the
 // "ActivityStreamsUndo" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -2188,6 +4499,19 @@ func (this Manager) DeserializeUndoActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeUndoActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsUndo" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeUndoActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsUndo, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUndo, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeUndoActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeUnitsPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsUnitsProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -2201,6 +4525,19 @@ func (this Manager) DeserializeUnitsPropertyActivityStreams() func(map[string]in
 	}
 }
 
+// DeserializeUnitsPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsUnitsProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeUnitsPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsUnitsProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUnitsProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeUnitsPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeUpdateActivityStreams returns the deserialization method for the
 // "ActivityStreamsUpdate" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -2214,6 +4551,19 @@ func (this Manager) DeserializeUpdateActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeUpdateActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsUpdate" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeUpdateActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsUpdate, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUpdate, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeUpdateActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeUpdatedPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsUpdatedProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -2227,6 +4577,19 @@ func (this Manager) DeserializeUpdatedPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeUpdatedPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsUpdatedProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeUpdatedPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsUpdatedProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUpdatedProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeUpdatedPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeUrlPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsUrlProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -2240,6 +4603,44 @@ func (this Manager) DeserializeUrlPropertyActivityStreams() func(map[string]inte
 	}
 }
 
+// DeserializeUrlPropertyActivityStreamsContext returns the deserialization method
+// for the "ActivityStreamsUrlProperty" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeUrlPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsUrlProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUrlProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeUrlPropertyActivityStreams()(m, aliasMap)
+	}
+}
+
+// DeserializeValuePropertySchema returns the deserialization method for the
+// "SchemaValueProperty" non-functional property in the vocabulary "Schema"
+func (this Manager) DeserializeValuePropertySchema() func(map[string]interface{}, map[string]string) (vocab.SchemaValueProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.SchemaValueProperty, error) {
+		i, err := propertyvalue.DeserializeValueProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeValuePropertySchemaContext returns the deserialization method for
+// the "SchemaValueProperty" type or property in the vocabulary "Schema",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeValuePropertySchemaContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.SchemaValueProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.SchemaValueProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeValuePropertySchema()(m, aliasMap)
+	}
+}
+
 // DeserializeVideoActivityStreams returns the deserialization method for the
 // "ActivityStreamsVideo" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -2253,6 +4654,19 @@ func (this Manager) DeserializeVideoActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeVideoActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsVideo" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeVideoActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsVideo, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsVideo, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeVideoActivityStreams()(m, aliasMap)
+	}
+}
+
 // DeserializeViewActivityStreams returns the deserialization method for the
 // "ActivityStreamsView" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -2266,6 +4680,44 @@ func (this Manager) DeserializeViewActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeViewActivityStreamsContext returns the deserialization method for
+// the "ActivityStreamsView" type or property in the vocabulary
+// "ActivityStreams", accepting a context. It returns the context's error
+// without deserializing once the context is done.
+func (this Manager) DeserializeViewActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsView, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsView, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeViewActivityStreams()(m, aliasMap)
+	}
+}
+
+// DeserializeViewsPropertyPeerTube returns the deserialization method for the
+// "PeerTubeViewsProperty" non-functional property in the vocabulary "PeerTube"
+func (this Manager) DeserializeViewsPropertyPeerTube() func(map[string]interface{}, map[string]string) (vocab.PeerTubeViewsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.PeerTubeViewsProperty, error) {
+		i, err := propertyviews.DeserializeViewsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeViewsPropertyPeerTubeContext returns the deserialization method for
+// the "PeerTubeViewsProperty" type or property in the vocabulary "PeerTube",
+// accepting a context. It returns the context's error without deserializing
+// once the context is done.
+func (this Manager) DeserializeViewsPropertyPeerTubeContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.PeerTubeViewsProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.PeerTubeViewsProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeViewsPropertyPeerTube()(m, aliasMap)
+	}
+}
+
 // DeserializeVotersCountPropertyToot returns the deserialization method for the
 // "TootVotersCountProperty" non-functional property in the vocabulary "Toot"
 func (this Manager) DeserializeVotersCountPropertyToot() func(map[string]interface{}, map[string]string) (vocab.TootVotersCountProperty, error) {
@@ -2278,6 +4730,19 @@ func (this Manager) DeserializeVotersCountPropertyToot() func(map[string]interfa
 	}
 }
 
+// DeserializeVotersCountPropertyTootContext returns the deserialization method
+// for the "TootVotersCountProperty" type or property in the vocabulary
+// "Toot", accepting a context. It returns the context's error without
+// deserializing once the context is done.
+func (this Manager) DeserializeVotersCountPropertyTootContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.TootVotersCountProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.TootVotersCountProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeVotersCountPropertyToot()(m, aliasMap)
+	}
+}
+
 // DeserializeWidthPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsWidthProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -2290,3 +4755,16 @@ func (this Manager) DeserializeWidthPropertyActivityStreams() func(map[string]in
 		return i, err
 	}
 }
+
+// DeserializeWidthPropertyActivityStreamsContext returns the deserialization
+// method for the "ActivityStreamsWidthProperty" type or property in the
+// vocabulary "ActivityStreams", accepting a context. It returns the context's
+// error without deserializing once the context is done.
+func (this Manager) DeserializeWidthPropertyActivityStreamsContext() func(context.Context, map[string]interface{}, map[string]string) (vocab.ActivityStreamsWidthProperty, error) {
+	return func(ctx context.Context, m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsWidthProperty, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return this.DeserializeWidthPropertyActivityStreams()(m, aliasMap)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -174,6 +175,12 @@ func IsOrExtendsActivityStreamsGroup(other vocab.Type) bool {
 	return typegroup.IsOrExtendsGroup(other)
 }
 
+// IsOrExtendsActivityStreamsHashtag returns true if the other provided type is
+// the Hashtag type or extends from the Hashtag type.
+func IsOrExtendsActivityStreamsHashtag(other vocab.Type) bool {
+	return typehashtag.IsOrExtendsHashtag(other)
+}
+
 // IsOrExtendsActivityStreamsIgnore returns true if the other provided type is the
 // Ignore type or extends from the Ignore type.
 func IsOrExtendsActivityStreamsIgnore(other vocab.Type) bool {
@@ -5,6 +5,7 @@ package streams
 import (
 	propertyaccuracy "github.com/go-fed/activity/streams/impl/activitystreams/property_accuracy"
 	propertyactor "github.com/go-fed/activity/streams/impl/activitystreams/property_actor"
+	propertyalsoknownas "github.com/go-fed/activity/streams/impl/activitystreams/property_alsoknownas"
 	propertyaltitude "github.com/go-fed/activity/streams/impl/activitystreams/property_altitude"
 	propertyanyof "github.com/go-fed/activity/streams/impl/activitystreams/property_anyof"
 	propertyattachment "github.com/go-fed/activity/streams/impl/activitystreams/property_attachment"
@@ -42,6 +43,7 @@ import (
 	propertylocation "github.com/go-fed/activity/streams/impl/activitystreams/property_location"
 	propertylongitude "github.com/go-fed/activity/streams/impl/activitystreams/property_longitude"
 	propertymediatype "github.com/go-fed/activity/streams/impl/activitystreams/property_mediatype"
+	propertymovedto "github.com/go-fed/activity/streams/impl/activitystreams/property_movedto"
 	propertyname "github.com/go-fed/activity/streams/impl/activitystreams/property_name"
 	propertynext "github.com/go-fed/activity/streams/impl/activitystreams/property_next"
 	propertyobject "github.com/go-fed/activity/streams/impl/activitystreams/property_object"
@@ -59,6 +61,7 @@ import (
 	propertyrelationship "github.com/go-fed/activity/streams/impl/activitystreams/property_relationship"
 	propertyreplies "github.com/go-fed/activity/streams/impl/activitystreams/property_replies"
 	propertyresult "github.com/go-fed/activity/streams/impl/activitystreams/property_result"
+	propertysensitive "github.com/go-fed/activity/streams/impl/activitystreams/property_sensitive"
 	propertyshares "github.com/go-fed/activity/streams/impl/activitystreams/property_shares"
 	propertysource "github.com/go-fed/activity/streams/impl/activitystreams/property_source"
 	propertystartindex "github.com/go-fed/activity/streams/impl/activitystreams/property_startindex"
@@ -89,6 +92,12 @@ func NewActivityStreamsActorProperty() vocab.ActivityStreamsActorProperty {
 	return propertyactor.NewActivityStreamsActorProperty()
 }
 
+// NewActivityStreamsActivityStreamsAlsoKnownAsProperty creates a new
+// ActivityStreamsAlsoKnownAsProperty
+func NewActivityStreamsAlsoKnownAsProperty() vocab.ActivityStreamsAlsoKnownAsProperty {
+	return propertyalsoknownas.NewActivityStreamsAlsoKnownAsProperty()
+}
+
 // NewActivityStreamsActivityStreamsAltitudeProperty creates a new
 // ActivityStreamsAltitudeProperty
 func NewActivityStreamsAltitudeProperty() vocab.ActivityStreamsAltitudeProperty {
@@ -311,6 +320,12 @@ func NewActivityStreamsMediaTypeProperty() vocab.ActivityStreamsMediaTypePropert
 	return propertymediatype.NewActivityStreamsMediaTypeProperty()
 }
 
+// NewActivityStreamsActivityStreamsMovedToProperty creates a new
+// ActivityStreamsMovedToProperty
+func NewActivityStreamsMovedToProperty() vocab.ActivityStreamsMovedToProperty {
+	return propertymovedto.NewActivityStreamsMovedToProperty()
+}
+
 // NewActivityStreamsActivityStreamsNameProperty creates a new
 // ActivityStreamsNameProperty
 func NewActivityStreamsNameProperty() vocab.ActivityStreamsNameProperty {
@@ -413,6 +428,12 @@ func NewActivityStreamsResultProperty() vocab.ActivityStreamsResultProperty {
 	return propertyresult.NewActivityStreamsResultProperty()
 }
 
+// NewActivityStreamsActivityStreamsSensitiveProperty creates a new
+// ActivityStreamsSensitiveProperty
+func NewActivityStreamsSensitiveProperty() vocab.ActivityStreamsSensitiveProperty {
+	return propertysensitive.NewActivityStreamsSensitiveProperty()
+}
+
 // NewActivityStreamsActivityStreamsSharesProperty creates a new
 // ActivityStreamsSharesProperty
 func NewActivityStreamsSharesProperty() vocab.ActivityStreamsSharesProperty {
@@ -22,6 +22,7 @@ import (
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -155,6 +156,11 @@ func NewActivityStreamsGroup() vocab.ActivityStreamsGroup {
 	return typegroup.NewActivityStreamsGroup()
 }
 
+// NewActivityStreamsHashtag creates a new ActivityStreamsHashtag
+func NewActivityStreamsHashtag() vocab.ActivityStreamsHashtag {
+	return typehashtag.NewActivityStreamsHashtag()
+}
+
 // NewActivityStreamsIgnore creates a new ActivityStreamsIgnore
 func NewActivityStreamsIgnore() vocab.ActivityStreamsIgnore {
 	return typeignore.NewActivityStreamsIgnore()
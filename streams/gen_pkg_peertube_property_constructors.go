@@ -0,0 +1,32 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	propertycommentsenabled "github.com/go-fed/activity/streams/impl/peertube/property_commentsenabled"
+	propertyfps "github.com/go-fed/activity/streams/impl/peertube/property_fps"
+	propertylicence "github.com/go-fed/activity/streams/impl/peertube/property_licence"
+	propertyviews "github.com/go-fed/activity/streams/impl/peertube/property_views"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewPeerTubePeerTubeCommentsEnabledProperty creates a new
+// PeerTubeCommentsEnabledProperty
+func NewPeerTubeCommentsEnabledProperty() vocab.PeerTubeCommentsEnabledProperty {
+	return propertycommentsenabled.NewPeerTubeCommentsEnabledProperty()
+}
+
+// NewPeerTubePeerTubeFpsProperty creates a new PeerTubeFpsProperty
+func NewPeerTubeFpsProperty() vocab.PeerTubeFpsProperty {
+	return propertyfps.NewPeerTubeFpsProperty()
+}
+
+// NewPeerTubePeerTubeLicenceProperty creates a new PeerTubeLicenceProperty
+func NewPeerTubeLicenceProperty() vocab.PeerTubeLicenceProperty {
+	return propertylicence.NewPeerTubeLicenceProperty()
+}
+
+// NewPeerTubePeerTubeViewsProperty creates a new PeerTubeViewsProperty
+func NewPeerTubeViewsProperty() vocab.PeerTubeViewsProperty {
+	return propertyviews.NewPeerTubeViewsProperty()
+}
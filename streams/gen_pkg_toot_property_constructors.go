@@ -6,6 +6,8 @@ import (
 	propertyblurhash "github.com/go-fed/activity/streams/impl/toot/property_blurhash"
 	propertydiscoverable "github.com/go-fed/activity/streams/impl/toot/property_discoverable"
 	propertyfeatured "github.com/go-fed/activity/streams/impl/toot/property_featured"
+	propertyfeaturedtags "github.com/go-fed/activity/streams/impl/toot/property_featuredtags"
+	propertyindexable "github.com/go-fed/activity/streams/impl/toot/property_indexable"
 	propertysignaturealgorithm "github.com/go-fed/activity/streams/impl/toot/property_signaturealgorithm"
 	propertysignaturevalue "github.com/go-fed/activity/streams/impl/toot/property_signaturevalue"
 	propertyvoterscount "github.com/go-fed/activity/streams/impl/toot/property_voterscount"
@@ -27,6 +29,16 @@ func NewTootFeaturedProperty() vocab.TootFeaturedProperty {
 	return propertyfeatured.NewTootFeaturedProperty()
 }
 
+// NewTootTootFeaturedTagsProperty creates a new TootFeaturedTagsProperty
+func NewTootFeaturedTagsProperty() vocab.TootFeaturedTagsProperty {
+	return propertyfeaturedtags.NewTootFeaturedTagsProperty()
+}
+
+// NewTootTootIndexableProperty creates a new TootIndexableProperty
+func NewTootIndexableProperty() vocab.TootIndexableProperty {
+	return propertyindexable.NewTootIndexableProperty()
+}
+
 // NewTootTootSignatureAlgorithmProperty creates a new
 // TootSignatureAlgorithmProperty
 func NewTootSignatureAlgorithmProperty() vocab.TootSignatureAlgorithmProperty {
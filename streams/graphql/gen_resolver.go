@@ -0,0 +1,319 @@
+// Code generated by astool. DO NOT EDIT.
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+)
+
+// Database is the part of an application's database that is needed to resolve
+// ActivityStreams values by their ids. The go-fed pub.Database satisfies this
+// interface.
+type Database interface {
+	// Get returns the ActivityStreams value with the specified id.
+	Get(c context.Context, id *url.URL) (value vocab.Type, err error)
+}
+
+// Node is a resolved ActivityStreams value. Its fields are resolved with a
+// Resolver.
+type Node struct {
+	// TypeName is the name of the value's type in the Schema. It is empty
+	// when the value's type is not known to this package.
+	TypeName string
+	// Value is the JSON-LD serialization of the value.
+	Value map[string]interface{}
+}
+
+// Resolver resolves the query and fields of the Schema, dereferencing ids using a
+// Database. Applications adapt it to the GraphQL server of their choice,
+// instead of writing a resolver for every field of every type.
+type Resolver struct {
+	db Database
+}
+
+// NewResolver creates a Resolver that fetches values from the Database.
+func NewResolver(db Database) *Resolver {
+	return &Resolver{db: db}
+}
+
+// Field resolves a field of a Node. Fields whose values are ActivityStreams types
+// resolve to a *Node, or a []*Node for non-functional properties, with any
+// ids being dereferenced using the Database. All other fields resolve to
+// their JSON-LD value, which is always a slice for non-functional properties.
+// Absent fields resolve to nil.
+func (this *Resolver) Field(c context.Context, n *Node, field string) (v interface{}, err error) {
+	raw, ok := n.Value[field]
+	if !ok {
+		return
+	}
+	if !listFields[field] {
+		if objectFields[field] {
+			return this.toNode(c, raw)
+		}
+		return raw, nil
+	}
+	elems, ok := raw.([]interface{})
+	if !ok {
+		elems = []interface{}{raw}
+	}
+	if !objectFields[field] {
+		return elems, nil
+	}
+	nodes := make([]*Node, 0, len(elems))
+	for _, elem := range elems {
+		var node *Node
+		if node, err = this.toNode(c, elem); err != nil {
+			return
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// Node resolves the 'node' query by fetching the value with the given id from the
+// Database.
+func (this *Resolver) Node(c context.Context, id string) (n *Node, err error) {
+	var u *url.URL
+	if u, err = url.Parse(id); err != nil {
+		return
+	}
+	var t vocab.Type
+	if t, err = this.db.Get(c, u); err != nil {
+		return
+	}
+	var m map[string]interface{}
+	if m, err = t.Serialize(); err != nil {
+		return
+	}
+	n = &Node{
+		TypeName: typeNames[t.GetTypeName()],
+		Value:    m,
+	}
+	return
+}
+
+// toNode converts a JSON-LD value into a Node, dereferencing it if it is an id.
+func (this *Resolver) toNode(c context.Context, v interface{}) (*Node, error) {
+	switch t := v.(type) {
+	case string:
+		return this.Node(c, t)
+	case map[string]interface{}:
+		return &Node{
+			TypeName: toTypeName(t["type"]),
+			Value:    t,
+		}, nil
+	default:
+		return nil, fmt.Errorf("cannot resolve a node from a value of type %T", v)
+	}
+}
+
+// toTypeName determines the name of the type in the Schema for a JSON-LD 'type'
+// value. Returns an empty string if no type is known.
+func toTypeName(v interface{}) string {
+	names, ok := v.([]interface{})
+	if !ok {
+		names = []interface{}{v}
+	}
+	for _, name := range names {
+		s, ok := name.(string)
+		if !ok {
+			continue
+		}
+		// Remove any alias or IRI prefix.
+		if i := strings.LastIndexAny(s, ":/#"); i >= 0 {
+			s = s[i+1:]
+		}
+		if n, ok := typeNames[s]; ok {
+			return n
+		}
+	}
+	return ""
+}
+
+// typeNames maps the ActivityStreams type names to the names of the types in the Schema.
+var typeNames = map[string]string{
+	"Accept":                "ActivityStreamsAccept",
+	"Activity":              "ActivityStreamsActivity",
+	"Add":                   "ActivityStreamsAdd",
+	"Announce":              "ActivityStreamsAnnounce",
+	"Application":           "ActivityStreamsApplication",
+	"Arrive":                "ActivityStreamsArrive",
+	"Article":               "ActivityStreamsArticle",
+	"Audio":                 "ActivityStreamsAudio",
+	"Block":                 "ActivityStreamsBlock",
+	"Branch":                "ForgeFedBranch",
+	"Collection":            "ActivityStreamsCollection",
+	"CollectionPage":        "ActivityStreamsCollectionPage",
+	"Commit":                "ForgeFedCommit",
+	"Create":                "ActivityStreamsCreate",
+	"Delete":                "ActivityStreamsDelete",
+	"Dislike":               "ActivityStreamsDislike",
+	"Document":              "ActivityStreamsDocument",
+	"Emoji":                 "TootEmoji",
+	"EmojiReact":            "LitepubEmojiReact",
+	"Event":                 "ActivityStreamsEvent",
+	"Flag":                  "ActivityStreamsFlag",
+	"Follow":                "ActivityStreamsFollow",
+	"Group":                 "ActivityStreamsGroup",
+	"Hashtag":               "ActivityStreamsHashtag",
+	"IdentityProof":         "TootIdentityProof",
+	"Ignore":                "ActivityStreamsIgnore",
+	"Image":                 "ActivityStreamsImage",
+	"IntransitiveActivity":  "ActivityStreamsIntransitiveActivity",
+	"Invite":                "ActivityStreamsInvite",
+	"Join":                  "ActivityStreamsJoin",
+	"Leave":                 "ActivityStreamsLeave",
+	"Like":                  "ActivityStreamsLike",
+	"Link":                  "ActivityStreamsLink",
+	"Listen":                "ActivityStreamsListen",
+	"Mention":               "ActivityStreamsMention",
+	"Move":                  "ActivityStreamsMove",
+	"Note":                  "ActivityStreamsNote",
+	"Object":                "ActivityStreamsObject",
+	"Offer":                 "ActivityStreamsOffer",
+	"OrderedCollection":     "ActivityStreamsOrderedCollection",
+	"OrderedCollectionPage": "ActivityStreamsOrderedCollectionPage",
+	"Organization":          "ActivityStreamsOrganization",
+	"Page":                  "ActivityStreamsPage",
+	"Person":                "ActivityStreamsPerson",
+	"Place":                 "ActivityStreamsPlace",
+	"Profile":               "ActivityStreamsProfile",
+	"PropertyValue":         "SchemaPropertyValue",
+	"PublicKey":             "W3IDSecurityV1PublicKey",
+	"Push":                  "ForgeFedPush",
+	"Question":              "ActivityStreamsQuestion",
+	"Read":                  "ActivityStreamsRead",
+	"Reject":                "ActivityStreamsReject",
+	"Relationship":          "ActivityStreamsRelationship",
+	"Remove":                "ActivityStreamsRemove",
+	"Repository":            "ForgeFedRepository",
+	"Service":               "ActivityStreamsService",
+	"TentativeAccept":       "ActivityStreamsTentativeAccept",
+	"TentativeReject":       "ActivityStreamsTentativeReject",
+	"Ticket":                "ForgeFedTicket",
+	"TicketDependency":      "ForgeFedTicketDependency",
+	"Tombstone":             "ActivityStreamsTombstone",
+	"Travel":                "ActivityStreamsTravel",
+	"Undo":                  "ActivityStreamsUndo",
+	"Update":                "ActivityStreamsUpdate",
+	"Video":                 "ActivityStreamsVideo",
+	"View":                  "ActivityStreamsView",
+}
+
+// objectFields are the fields whose values are resolved as a Node.
+var objectFields = map[string]bool{
+	"actor":            true,
+	"alsoKnownAs":      true,
+	"anyOf":            true,
+	"assignedTo":       true,
+	"attachment":       true,
+	"attributedTo":     true,
+	"audience":         true,
+	"bcc":              true,
+	"bto":              true,
+	"cc":               true,
+	"committedBy":      true,
+	"context":          true,
+	"current":          true,
+	"dependants":       true,
+	"dependedBy":       true,
+	"dependencies":     true,
+	"dependsOn":        true,
+	"describes":        true,
+	"description":      true,
+	"earlyItems":       true,
+	"featured":         true,
+	"featuredTags":     true,
+	"first":            true,
+	"followers":        true,
+	"following":        true,
+	"forks":            true,
+	"generator":        true,
+	"icon":             true,
+	"image":            true,
+	"inReplyTo":        true,
+	"inbox":            true,
+	"instrument":       true,
+	"items":            true,
+	"last":             true,
+	"licence":          true,
+	"liked":            true,
+	"likes":            true,
+	"location":         true,
+	"movedTo":          true,
+	"next":             true,
+	"object":           true,
+	"oneOf":            true,
+	"orderedItems":     true,
+	"origin":           true,
+	"outbox":           true,
+	"partOf":           true,
+	"prev":             true,
+	"preview":          true,
+	"publicKey":        true,
+	"relationship":     true,
+	"replies":          true,
+	"result":           true,
+	"shares":           true,
+	"source":           true,
+	"streams":          true,
+	"subject":          true,
+	"tag":              true,
+	"target":           true,
+	"team":             true,
+	"ticketsTrackedBy": true,
+	"to":               true,
+	"tracksTicketsFor": true,
+}
+
+// listFields are the fields of non-functional properties, whose values are always resolved as a slice.
+var listFields = map[string]bool{
+	"actor":            true,
+	"alsoKnownAs":      true,
+	"anyOf":            true,
+	"attachment":       true,
+	"attributedTo":     true,
+	"audience":         true,
+	"bcc":              true,
+	"bto":              true,
+	"cc":               true,
+	"closed":           true,
+	"content":          true,
+	"context":          true,
+	"dependedBy":       true,
+	"dependsOn":        true,
+	"earlyItems":       true,
+	"filesAdded":       true,
+	"filesModified":    true,
+	"filesRemoved":     true,
+	"formerType":       true,
+	"generator":        true,
+	"icon":             true,
+	"image":            true,
+	"inReplyTo":        true,
+	"instrument":       true,
+	"items":            true,
+	"location":         true,
+	"name":             true,
+	"object":           true,
+	"oneOf":            true,
+	"orderedItems":     true,
+	"origin":           true,
+	"preview":          true,
+	"publicKey":        true,
+	"rel":              true,
+	"relationship":     true,
+	"result":           true,
+	"streams":          true,
+	"summary":          true,
+	"tag":              true,
+	"target":           true,
+	"to":               true,
+	"tracksTicketsFor": true,
+	"type":             true,
+	"url":              true,
+}
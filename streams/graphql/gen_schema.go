@@ -0,0 +1,3053 @@
+// Code generated by astool. DO NOT EDIT.
+
+package graphql
+
+// Schema is the GraphQL schema definition language document describing every
+// ActivityStreams type known to this package. Each type implements the Node
+// interface, and the node query fetches any value by its id. Custom scalars
+// must be supplied by the GraphQL server: DateTime is an xsd:dateTime string,
+// and JSON is any JSON-LD value.
+const Schema = `scalar DateTime
+
+scalar JSON
+
+interface Node {
+  id: ID
+  type: [String!]
+}
+
+type Query {
+  node(id: ID!): Node
+}
+
+type ActivityStreamsAccept implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsActivity implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsAdd implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsAnnounce implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsApplication implements Node {
+  alsoKnownAs: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  discoverable: Boolean
+  duration: String
+  endTime: DateTime
+  featured: Node
+  featuredTags: Node
+  followers: Node
+  following: Node
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  inbox: Node
+  indexable: Boolean
+  liked: Node
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  movedTo: Node
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  outbox: Node
+  preferredUsername: String
+  preferredUsernameMap: JSON
+  preview: [Node!]
+  publicKey: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  streams: [Node!]
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsArrive implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsArticle implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsAudio implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  blurhash: String
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsBlock implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ForgeFedBranch implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  ref: String
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsCollection implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  current: Node
+  duration: String
+  endTime: DateTime
+  first: Node
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  items: [Node!]
+  last: Node
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  totalItems: Int
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsCollectionPage implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  current: Node
+  duration: String
+  endTime: DateTime
+  first: Node
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  items: [Node!]
+  last: Node
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  next: Node
+  object: [Node!]
+  partOf: Node
+  prev: Node
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  totalItems: Int
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ForgeFedCommit implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  committed: DateTime
+  committedBy: Node
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  description: Node
+  duration: String
+  endTime: DateTime
+  filesAdded: [String!]
+  filesModified: [String!]
+  filesRemoved: [String!]
+  generator: [Node!]
+  hash: String
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsCreate implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsDelete implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsDislike implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsDocument implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  blurhash: String
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type TootEmoji implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type LitepubEmojiReact implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsEvent implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsFlag implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsFollow implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsGroup implements Node {
+  alsoKnownAs: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  discoverable: Boolean
+  duration: String
+  endTime: DateTime
+  featured: Node
+  featuredTags: Node
+  followers: Node
+  following: Node
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  inbox: Node
+  indexable: Boolean
+  liked: Node
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  movedTo: Node
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  outbox: Node
+  preferredUsername: String
+  preferredUsernameMap: JSON
+  preview: [Node!]
+  publicKey: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  streams: [Node!]
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsHashtag implements Node {
+  attributedTo: [Node!]
+  fps: Int
+  height: Int
+  href: String
+  hreflang: String
+  id: ID
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  preview: [Node!]
+  rel: [String!]
+  summary: [String!]
+  summaryMap: JSON
+  type: [String!]
+  width: Int
+}
+
+type TootIdentityProof implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  signatureAlgorithm: String
+  signatureValue: String
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsIgnore implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsImage implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  blurhash: String
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  height: Int
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+  width: Int
+}
+
+type ActivityStreamsIntransitiveActivity implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsInvite implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsJoin implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsLeave implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsLike implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsLink implements Node {
+  attributedTo: [Node!]
+  fps: Int
+  height: Int
+  href: String
+  hreflang: String
+  id: ID
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  preview: [Node!]
+  rel: [String!]
+  summary: [String!]
+  summaryMap: JSON
+  type: [String!]
+  width: Int
+}
+
+type ActivityStreamsListen implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsMention implements Node {
+  attributedTo: [Node!]
+  fps: Int
+  height: Int
+  href: String
+  hreflang: String
+  id: ID
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  preview: [Node!]
+  rel: [String!]
+  summary: [String!]
+  summaryMap: JSON
+  type: [String!]
+  width: Int
+}
+
+type ActivityStreamsMove implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsNote implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsObject implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsOffer implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsOrderedCollection implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  current: Node
+  duration: String
+  earlyItems: [Node!]
+  endTime: DateTime
+  first: Node
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  last: Node
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  orderedItems: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  totalItems: Int
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsOrderedCollectionPage implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  current: Node
+  duration: String
+  earlyItems: [Node!]
+  endTime: DateTime
+  first: Node
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  last: Node
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  next: Node
+  object: [Node!]
+  orderedItems: [Node!]
+  partOf: Node
+  prev: Node
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startIndex: Int
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  totalItems: Int
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsOrganization implements Node {
+  alsoKnownAs: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  discoverable: Boolean
+  duration: String
+  endTime: DateTime
+  featured: Node
+  featuredTags: Node
+  followers: Node
+  following: Node
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  inbox: Node
+  indexable: Boolean
+  liked: Node
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  movedTo: Node
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  outbox: Node
+  preferredUsername: String
+  preferredUsernameMap: JSON
+  preview: [Node!]
+  publicKey: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  streams: [Node!]
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsPage implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  blurhash: String
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsPerson implements Node {
+  alsoKnownAs: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  discoverable: Boolean
+  duration: String
+  endTime: DateTime
+  featured: Node
+  featuredTags: Node
+  followers: Node
+  following: Node
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  inbox: Node
+  indexable: Boolean
+  liked: Node
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  movedTo: Node
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  outbox: Node
+  preferredUsername: String
+  preferredUsernameMap: JSON
+  preview: [Node!]
+  publicKey: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  streams: [Node!]
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsPlace implements Node {
+  accuracy: Float
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  latitude: Float
+  likes: Node
+  location: [Node!]
+  longitude: Float
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  radius: Float
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  units: String
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsProfile implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  describes: Node
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type SchemaPropertyValue implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+  value: String
+}
+
+type W3IDSecurityV1PublicKey implements Node {
+  id: ID
+  owner: String
+  publicKeyPem: String
+}
+
+type ForgeFedPush implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsQuestion implements Node {
+  actor: [Node!]
+  altitude: Float
+  anyOf: [Node!]
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  closed: [JSON!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  oneOf: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+  votersCount: Int
+}
+
+type ActivityStreamsRead implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsReject implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsRelationship implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  relationship: [Node!]
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  subject: Node
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsRemove implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ForgeFedRepository implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  forks: Node
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsService implements Node {
+  alsoKnownAs: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  discoverable: Boolean
+  duration: String
+  endTime: DateTime
+  featured: Node
+  featuredTags: Node
+  followers: Node
+  following: Node
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  inbox: Node
+  indexable: Boolean
+  liked: Node
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  movedTo: Node
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  outbox: Node
+  preferredUsername: String
+  preferredUsernameMap: JSON
+  preview: [Node!]
+  publicKey: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  streams: [Node!]
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsTentativeAccept implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsTentativeReject implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ForgeFedTicket implements Node {
+  altitude: Float
+  assignedTo: Node
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  dependants: Node
+  dependedBy: [Node!]
+  dependencies: Node
+  dependsOn: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  isResolved: Boolean
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ForgeFedTicketDependency implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  relationship: [Node!]
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  subject: Node
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsTombstone implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  deleted: DateTime
+  duration: String
+  endTime: DateTime
+  formerType: [JSON!]
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsTravel implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsUndo implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsUpdate implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+
+type ActivityStreamsVideo implements Node {
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  blurhash: String
+  bto: [Node!]
+  cc: [Node!]
+  commentsEnabled: Boolean
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  fps: Int
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  licence: Node
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+  views: Int
+}
+
+type ActivityStreamsView implements Node {
+  actor: [Node!]
+  altitude: Float
+  attachment: [Node!]
+  attributedTo: [Node!]
+  audience: [Node!]
+  bcc: [Node!]
+  bto: [Node!]
+  cc: [Node!]
+  content: [String!]
+  contentMap: JSON
+  context: [Node!]
+  duration: String
+  endTime: DateTime
+  generator: [Node!]
+  icon: [Node!]
+  id: ID
+  image: [Node!]
+  inReplyTo: [Node!]
+  instrument: [Node!]
+  likes: Node
+  location: [Node!]
+  mediaType: String
+  name: [String!]
+  nameMap: JSON
+  object: [Node!]
+  origin: [Node!]
+  preview: [Node!]
+  published: DateTime
+  replies: Node
+  result: [Node!]
+  sensitive: Boolean
+  shares: Node
+  source: Node
+  startTime: DateTime
+  summary: [String!]
+  summaryMap: JSON
+  tag: [Node!]
+  target: [Node!]
+  team: Node
+  ticketsTrackedBy: Node
+  to: [Node!]
+  tracksTicketsFor: [Node!]
+  type: [String!]
+  updated: DateTime
+  url: [JSON!]
+}
+`
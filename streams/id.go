@@ -0,0 +1,50 @@
+package streams
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// GetId returns the IRI of the 'id' of a value, or nil if it has none.
+func GetId(t vocab.Type) *url.URL {
+	if id := t.GetJSONLDId(); id != nil {
+		return id.Get()
+	}
+	return nil
+}
+
+// GetIdString returns the IRI of the 'id' of a value as a string, or an empty
+// string if it has none.
+func GetIdString(t vocab.Type) string {
+	if iri := GetId(t); iri != nil {
+		return iri.String()
+	}
+	return ""
+}
+
+// SetId sets the 'id' of a value to an IRI, creating the 'id' property if
+// needed. The 'id' property is removed if the IRI is nil.
+func SetId(t vocab.Type, iri *url.URL) {
+	if iri == nil {
+		t.SetJSONLDId(nil)
+		return
+	}
+	id := NewJSONLDIdProperty()
+	id.Set(iri)
+	t.SetJSONLDId(id)
+}
+
+// SetIdString sets the 'id' of a value to an IRI given as a string, creating the
+// 'id' property if needed. It returns an error, leaving the 'id' unchanged, if
+// the string is not an absolute IRI.
+func SetIdString(t vocab.Type, s string) error {
+	iri, err := url.Parse(s)
+	if err != nil {
+		return err
+	} else if !iri.IsAbs() {
+		return fmt.Errorf("id %q is not an absolute IRI", s)
+	}
+	SetId(t, iri)
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	float "github.com/go-fed/activity/streams/values/float"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ActivityStreamsAccuracyProperty is the functional property "accuracy". It is
@@ -39,7 +41,8 @@ func DeserializeAccuracyProperty(m map[string]interface{}, aliasMap map[string]s
 			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 			if err == nil && len(u.Scheme) > 0 {
-				this := &ActivityStreamsAccuracyProperty{
+				this := poolActivityStreamsAccuracyProperty.Get().(*ActivityStreamsAccuracyProperty)
+				*this = ActivityStreamsAccuracyProperty{
 					alias: alias,
 					iri:   u,
 				}
@@ -47,14 +50,16 @@ func DeserializeAccuracyProperty(m map[string]interface{}, aliasMap map[string]s
 			}
 		}
 		if v, err := float.DeserializeFloat(i); err == nil {
-			this := &ActivityStreamsAccuracyProperty{
+			this := poolActivityStreamsAccuracyProperty.Get().(*ActivityStreamsAccuracyProperty)
+			*this = ActivityStreamsAccuracyProperty{
 				alias:                alias,
 				hasFloatMember:       true,
 				xmlschemaFloatMember: v,
 			}
 			return this, nil
 		}
-		this := &ActivityStreamsAccuracyProperty{
+		this := poolActivityStreamsAccuracyProperty.Get().(*ActivityStreamsAccuracyProperty)
+		*this = ActivityStreamsAccuracyProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -68,6 +73,14 @@ func NewActivityStreamsAccuracyProperty() *ActivityStreamsAccuracyProperty {
 	return &ActivityStreamsAccuracyProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ActivityStreamsAccuracyProperty) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+}
+
 // Clear ensures no value of this property is set. Calling IsXMLSchemaFloat
 // afterwards will return false.
 func (this *ActivityStreamsAccuracyProperty) Clear() {
@@ -76,6 +89,42 @@ func (this *ActivityStreamsAccuracyProperty) Clear() {
 	this.hasFloatMember = false
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsAccuracyProperty) Compare(o vocab.ActivityStreamsAccuracyProperty) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsXMLSchemaFloat() && !o.IsXMLSchemaFloat() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsXMLSchemaFloat() && !o.IsXMLSchemaFloat() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsXMLSchemaFloat() && o.IsXMLSchemaFloat() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	if lhs, rhs := this.Get(), o.Get(); float.LessFloat(lhs, rhs) {
+		return -1
+	} else if float.LessFloat(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
 // Get returns the value of this property. When IsXMLSchemaFloat returns false,
 // Get will return any arbitrary value.
 func (this ActivityStreamsAccuracyProperty) Get() float64 {
@@ -107,17 +156,8 @@ func (this ActivityStreamsAccuracyProperty) IsXMLSchemaFloat() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsAccuracyProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -139,30 +179,7 @@ func (this ActivityStreamsAccuracyProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsAccuracyProperty) LessThan(o vocab.ActivityStreamsAccuracyProperty) bool {
-	// LessThan comparison for if either or both are IRIs.
-	if this.IsIRI() && o.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	} else if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsXMLSchemaFloat() && !o.IsXMLSchemaFloat() {
-		// Both are unknowns.
-		return false
-	} else if this.IsXMLSchemaFloat() && !o.IsXMLSchemaFloat() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsXMLSchemaFloat() && o.IsXMLSchemaFloat() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return float.LessFloat(this.Get(), o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "accuracy".
@@ -174,6 +191,15 @@ func (this ActivityStreamsAccuracyProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsAccuracyProperty) Release() {
+	*this = ActivityStreamsAccuracyProperty{}
+	poolActivityStreamsAccuracyProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -201,3 +227,8 @@ func (this *ActivityStreamsAccuracyProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.iri = v
 }
+
+// poolActivityStreamsAccuracyProperty holds the released values of ActivityStreamsAccuracyProperty, which are reused when deserializing.
+var poolActivityStreamsAccuracyProperty = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsAccuracyProperty{}
+}}
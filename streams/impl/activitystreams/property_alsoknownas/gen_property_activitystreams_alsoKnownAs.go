@@ -0,0 +1,1097 @@
+// Code generated by astool. DO NOT EDIT.
+
+package propertyalsoknownas
+
+import (
+	"fmt"
+	proplist "github.com/go-fed/activity/streams/impl/proplist"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// ActivityStreamsAlsoKnownAsPropertyIterator is an iterator for a property. It is
+// permitted to be one of multiple value types. At most, one type of value can
+// be present, or none at all. Setting a value will clear the other types of
+// values so that only one of the 'Is' methods will return true. It is
+// possible to clear all values, so that this property is empty.
+type ActivityStreamsAlsoKnownAsPropertyIterator struct {
+	activitystreamsApplicationMember  vocab.ActivityStreamsApplication
+	activitystreamsGroupMember        vocab.ActivityStreamsGroup
+	activitystreamsOrganizationMember vocab.ActivityStreamsOrganization
+	activitystreamsPersonMember       vocab.ActivityStreamsPerson
+	activitystreamsServiceMember      vocab.ActivityStreamsService
+	unknown                           interface{}
+	iri                               *url.URL
+	alias                             string
+	proplist.Links[*ActivityStreamsAlsoKnownAsPropertyIterator]
+}
+
+// NewActivityStreamsAlsoKnownAsPropertyIterator creates a new
+// ActivityStreamsAlsoKnownAs property.
+func NewActivityStreamsAlsoKnownAsPropertyIterator() *ActivityStreamsAlsoKnownAsPropertyIterator {
+	return &ActivityStreamsAlsoKnownAsPropertyIterator{alias: ""}
+}
+
+// deserializeActivityStreamsAlsoKnownAsPropertyIterator creates an iterator from
+// an element that has been unmarshalled from a text or binary format.
+func deserializeActivityStreamsAlsoKnownAsPropertyIterator(i interface{}, aliasMap map[string]string) (*ActivityStreamsAlsoKnownAsPropertyIterator, error) {
+	alias := ""
+	if a, ok := aliasMap["https://www.w3.org/ns/activitystreams"]; ok {
+		alias = a
+	}
+	if s, ok := i.(string); ok {
+		u, err := url.Parse(s)
+		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
+		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
+		if err == nil && len(u.Scheme) > 0 {
+			this := poolActivityStreamsAlsoKnownAsPropertyIterator.Get().(*ActivityStreamsAlsoKnownAsPropertyIterator)
+			*this = ActivityStreamsAlsoKnownAsPropertyIterator{
+				alias: alias,
+				iri:   u,
+			}
+			return this, nil
+		}
+	}
+	if m, ok := i.(map[string]interface{}); ok {
+		// Begin: Determine the types of the value, to deserialize it as them first
+		typeValue, hasType := m["type"]
+		isType := func(vocabURI, name string) bool {
+			aliasPrefix := ""
+			if a, ok := aliasMap[vocabURI]; ok {
+				aliasPrefix = a + ":"
+			}
+			switch t := typeValue.(type) {
+			case string:
+				return strings.TrimPrefix(t, aliasPrefix) == name
+			case []interface{}:
+				for _, elem := range t {
+					if s, ok := elem.(string); ok && strings.TrimPrefix(s, aliasPrefix) == name {
+						return true
+					}
+				}
+			}
+			return false
+		}
+		// End: Determine the types of the value, to deserialize it as them first
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Application") {
+			if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsAlsoKnownAsPropertyIterator.Get().(*ActivityStreamsAlsoKnownAsPropertyIterator)
+				*this = ActivityStreamsAlsoKnownAsPropertyIterator{
+					activitystreamsApplicationMember: v,
+					alias:                            alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Group") {
+			if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsAlsoKnownAsPropertyIterator.Get().(*ActivityStreamsAlsoKnownAsPropertyIterator)
+				*this = ActivityStreamsAlsoKnownAsPropertyIterator{
+					activitystreamsGroupMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Organization") {
+			if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsAlsoKnownAsPropertyIterator.Get().(*ActivityStreamsAlsoKnownAsPropertyIterator)
+				*this = ActivityStreamsAlsoKnownAsPropertyIterator{
+					activitystreamsOrganizationMember: v,
+					alias:                             alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Person") {
+			if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsAlsoKnownAsPropertyIterator.Get().(*ActivityStreamsAlsoKnownAsPropertyIterator)
+				*this = ActivityStreamsAlsoKnownAsPropertyIterator{
+					activitystreamsPersonMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Service") {
+			if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsAlsoKnownAsPropertyIterator.Get().(*ActivityStreamsAlsoKnownAsPropertyIterator)
+				*this = ActivityStreamsAlsoKnownAsPropertyIterator{
+					activitystreamsServiceMember: v,
+					alias:                        alias,
+				}
+				return this, nil
+			}
+		}
+	}
+	this := poolActivityStreamsAlsoKnownAsPropertyIterator.Get().(*ActivityStreamsAlsoKnownAsPropertyIterator)
+	*this = ActivityStreamsAlsoKnownAsPropertyIterator{
+		alias:   alias,
+		unknown: i,
+	}
+	return this, nil
+}
+
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+	if this.IsActivityStreamsApplication() {
+		if a, ok := this.activitystreamsApplicationMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsApplicationMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsGroup() {
+		if a, ok := this.activitystreamsGroupMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsGroupMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOrganization() {
+		if a, ok := this.activitystreamsOrganizationMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrganizationMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsPerson() {
+		if a, ok := this.activitystreamsPersonMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsPersonMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsService() {
+		if a, ok := this.activitystreamsServiceMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsServiceMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	}
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) Compare(o vocab.ActivityStreamsAlsoKnownAsPropertyIterator) int {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return -1
+	} else if idx1 > idx2 {
+		return 1
+	} else if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().Compare(o.GetActivityStreamsApplication())
+	} else if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().Compare(o.GetActivityStreamsGroup())
+	} else if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().Compare(o.GetActivityStreamsOrganization())
+	} else if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().Compare(o.GetActivityStreamsPerson())
+	} else if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().Compare(o.GetActivityStreamsService())
+	} else if this.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	}
+	return 0
+}
+
+// GetActivityStreamsApplication returns the value of this property. When
+// IsActivityStreamsApplication returns false, GetActivityStreamsApplication
+// will return an arbitrary value.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) GetActivityStreamsApplication() vocab.ActivityStreamsApplication {
+	return this.activitystreamsApplicationMember
+}
+
+// GetActivityStreamsGroup returns the value of this property. When
+// IsActivityStreamsGroup returns false, GetActivityStreamsGroup will return
+// an arbitrary value.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) GetActivityStreamsGroup() vocab.ActivityStreamsGroup {
+	return this.activitystreamsGroupMember
+}
+
+// GetActivityStreamsOrganization returns the value of this property. When
+// IsActivityStreamsOrganization returns false, GetActivityStreamsOrganization
+// will return an arbitrary value.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) GetActivityStreamsOrganization() vocab.ActivityStreamsOrganization {
+	return this.activitystreamsOrganizationMember
+}
+
+// GetActivityStreamsPerson returns the value of this property. When
+// IsActivityStreamsPerson returns false, GetActivityStreamsPerson will return
+// an arbitrary value.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) GetActivityStreamsPerson() vocab.ActivityStreamsPerson {
+	return this.activitystreamsPersonMember
+}
+
+// GetActivityStreamsService returns the value of this property. When
+// IsActivityStreamsService returns false, GetActivityStreamsService will
+// return an arbitrary value.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) GetActivityStreamsService() vocab.ActivityStreamsService {
+	return this.activitystreamsServiceMember
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return an arbitrary value.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) GetIRI() *url.URL {
+	return this.iri
+}
+
+// GetType returns the value in this property as a Type. Returns nil if the value
+// is not an ActivityStreams type, such as an IRI or another value.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) GetType() vocab.Type {
+	if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication()
+	}
+	if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup()
+	}
+	if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization()
+	}
+	if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson()
+	}
+	if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService()
+	}
+
+	return nil
+}
+
+// HasAny returns true if any of the different values is set.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) HasAny() bool {
+	return this.IsActivityStreamsApplication() ||
+		this.IsActivityStreamsGroup() ||
+		this.IsActivityStreamsOrganization() ||
+		this.IsActivityStreamsPerson() ||
+		this.IsActivityStreamsService() ||
+		this.iri != nil
+}
+
+// IsActivityStreamsApplication returns true if this property has a type of
+// "Application". When true, use the GetActivityStreamsApplication and
+// SetActivityStreamsApplication methods to access and set this property.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) IsActivityStreamsApplication() bool {
+	return this.activitystreamsApplicationMember != nil
+}
+
+// IsActivityStreamsGroup returns true if this property has a type of "Group".
+// When true, use the GetActivityStreamsGroup and SetActivityStreamsGroup
+// methods to access and set this property.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) IsActivityStreamsGroup() bool {
+	return this.activitystreamsGroupMember != nil
+}
+
+// IsActivityStreamsOrganization returns true if this property has a type of
+// "Organization". When true, use the GetActivityStreamsOrganization and
+// SetActivityStreamsOrganization methods to access and set this property.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) IsActivityStreamsOrganization() bool {
+	return this.activitystreamsOrganizationMember != nil
+}
+
+// IsActivityStreamsPerson returns true if this property has a type of "Person".
+// When true, use the GetActivityStreamsPerson and SetActivityStreamsPerson
+// methods to access and set this property.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) IsActivityStreamsPerson() bool {
+	return this.activitystreamsPersonMember != nil
+}
+
+// IsActivityStreamsService returns true if this property has a type of "Service".
+// When true, use the GetActivityStreamsService and SetActivityStreamsService
+// methods to access and set this property.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) IsActivityStreamsService() bool {
+	return this.activitystreamsServiceMember != nil
+}
+
+// IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
+// to access and set this property
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) IsIRI() bool {
+	return this.iri != nil
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) JSONLDContext() map[string]string {
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) KindIndex() int {
+	if this.IsActivityStreamsApplication() {
+		return 0
+	}
+	if this.IsActivityStreamsGroup() {
+		return 1
+	}
+	if this.IsActivityStreamsOrganization() {
+		return 2
+	}
+	if this.IsActivityStreamsPerson() {
+		return 3
+	}
+	if this.IsActivityStreamsService() {
+		return 4
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) LessThan(o vocab.ActivityStreamsAlsoKnownAsPropertyIterator) bool {
+	return this.Compare(o) < 0
+}
+
+// Name returns the name of this property: "ActivityStreamsAlsoKnownAs".
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":" + "ActivityStreamsAlsoKnownAs"
+	} else {
+		return "ActivityStreamsAlsoKnownAs"
+	}
+}
+
+// Next returns the next iterator, or nil if there is no next iterator. It remains
+// correct when values are added to or removed from the property, including
+// this one, without obtaining the iterator again.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) Next() vocab.ActivityStreamsAlsoKnownAsPropertyIterator {
+	if it := proplist.Next(&this); it != nil {
+		return it
+	}
+	return nil
+}
+
+// Prev returns the previous iterator, or nil if there is no previous iterator. It
+// remains correct when values are added to or removed from the property,
+// including this one, without obtaining the iterator again.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) Prev() vocab.ActivityStreamsAlsoKnownAsPropertyIterator {
+	if it := proplist.Prev(&this); it != nil {
+		return it
+	}
+	return nil
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsAlsoKnownAsPropertyIterator) Release() {
+	if r, ok := this.activitystreamsApplicationMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsGroupMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOrganizationMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsPersonMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsServiceMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	*this = ActivityStreamsAlsoKnownAsPropertyIterator{}
+	poolActivityStreamsAlsoKnownAsPropertyIterator.Put(this)
+}
+
+// SetActivityStreamsApplication sets the value of this property. Calling
+// IsActivityStreamsApplication afterwards returns true.
+func (this *ActivityStreamsAlsoKnownAsPropertyIterator) SetActivityStreamsApplication(v vocab.ActivityStreamsApplication) {
+	this.clear()
+	this.activitystreamsApplicationMember = v
+}
+
+// SetActivityStreamsGroup sets the value of this property. Calling
+// IsActivityStreamsGroup afterwards returns true.
+func (this *ActivityStreamsAlsoKnownAsPropertyIterator) SetActivityStreamsGroup(v vocab.ActivityStreamsGroup) {
+	this.clear()
+	this.activitystreamsGroupMember = v
+}
+
+// SetActivityStreamsOrganization sets the value of this property. Calling
+// IsActivityStreamsOrganization afterwards returns true.
+func (this *ActivityStreamsAlsoKnownAsPropertyIterator) SetActivityStreamsOrganization(v vocab.ActivityStreamsOrganization) {
+	this.clear()
+	this.activitystreamsOrganizationMember = v
+}
+
+// SetActivityStreamsPerson sets the value of this property. Calling
+// IsActivityStreamsPerson afterwards returns true.
+func (this *ActivityStreamsAlsoKnownAsPropertyIterator) SetActivityStreamsPerson(v vocab.ActivityStreamsPerson) {
+	this.clear()
+	this.activitystreamsPersonMember = v
+}
+
+// SetActivityStreamsService sets the value of this property. Calling
+// IsActivityStreamsService afterwards returns true.
+func (this *ActivityStreamsAlsoKnownAsPropertyIterator) SetActivityStreamsService(v vocab.ActivityStreamsService) {
+	this.clear()
+	this.activitystreamsServiceMember = v
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards returns true.
+func (this *ActivityStreamsAlsoKnownAsPropertyIterator) SetIRI(v *url.URL) {
+	this.clear()
+	this.iri = v
+}
+
+// SetType attempts to set the property for the arbitrary type. Returns an error
+// if it is not a valid type to set on this property.
+func (this *ActivityStreamsAlsoKnownAsPropertyIterator) SetType(t vocab.Type) error {
+	if v, ok := t.(vocab.ActivityStreamsApplication); ok {
+		this.SetActivityStreamsApplication(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsGroup); ok {
+		this.SetActivityStreamsGroup(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsOrganization); ok {
+		this.SetActivityStreamsOrganization(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsPerson); ok {
+		this.SetActivityStreamsPerson(v)
+		return nil
+	}
+	if v, ok := t.(vocab.ActivityStreamsService); ok {
+		this.SetActivityStreamsService(v)
+		return nil
+	}
+
+	return fmt.Errorf("illegal type to set on ActivityStreamsAlsoKnownAs property: %T", t)
+}
+
+// clear ensures no value of this property is set. Calling HasAny or any of the
+// 'Is' methods afterwards will return false.
+func (this *ActivityStreamsAlsoKnownAsPropertyIterator) clear() {
+	this.activitystreamsApplicationMember = nil
+	this.activitystreamsGroupMember = nil
+	this.activitystreamsOrganizationMember = nil
+	this.activitystreamsPersonMember = nil
+	this.activitystreamsServiceMember = nil
+	this.unknown = nil
+	this.iri = nil
+}
+
+// serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this ActivityStreamsAlsoKnownAsPropertyIterator) serialize() (interface{}, error) {
+	if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().Serialize()
+	} else if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().Serialize()
+	} else if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().Serialize()
+	} else if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().Serialize()
+	} else if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().Serialize()
+	} else if this.IsIRI() {
+		return this.iri.String(), nil
+	}
+	return this.unknown, nil
+}
+
+// ActivityStreamsAlsoKnownAsProperty is the non-functional property
+// "alsoKnownAs". It is permitted to have one or more values, and of different
+// value types.
+type ActivityStreamsAlsoKnownAsProperty struct {
+	properties proplist.List[*ActivityStreamsAlsoKnownAsPropertyIterator]
+	alias      string
+}
+
+// DeserializeAlsoKnownAsProperty creates a "alsoKnownAs" property from an
+// interface representation that has been unmarshalled from a text or binary
+// format.
+func DeserializeAlsoKnownAsProperty(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://www.w3.org/ns/activitystreams"]; ok {
+		alias = a
+	}
+	propName := "alsoKnownAs"
+	if len(alias) > 0 {
+		propName = fmt.Sprintf("%s:%s", alias, "alsoKnownAs")
+	}
+	i, ok := m[propName]
+
+	if ok {
+		// A released value keeps its properties for reuse.
+		this := poolActivityStreamsAlsoKnownAsProperty.Get().(*ActivityStreamsAlsoKnownAsProperty)
+		this.alias = alias
+		if list, ok := i.([]interface{}); ok {
+			for _, iterator := range list {
+				if p, err := deserializeActivityStreamsAlsoKnownAsPropertyIterator(iterator, aliasMap); err != nil {
+					return this, err
+				} else if p != nil {
+					this.properties = append(this.properties, p)
+				}
+			}
+		} else {
+			if p, err := deserializeActivityStreamsAlsoKnownAsPropertyIterator(i, aliasMap); err != nil {
+				return this, err
+			} else if p != nil {
+				this.properties = append(this.properties, p)
+			}
+		}
+		// Set up the properties for iteration.
+		this.properties.Link()
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewActivityStreamsAlsoKnownAsProperty creates a new alsoKnownAs property.
+func NewActivityStreamsAlsoKnownAsProperty() *ActivityStreamsAlsoKnownAsProperty {
+	return &ActivityStreamsAlsoKnownAsProperty{alias: ""}
+}
+
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and each of its values.
+func (this ActivityStreamsAlsoKnownAsProperty) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+	for _, elem := range this.properties {
+		elem.AddJSONLDContext(m)
+	}
+}
+
+// AppendActivityStreamsApplication appends a Application value to the back of a
+// list of the property "alsoKnownAs".
+func (this *ActivityStreamsAlsoKnownAsProperty) AppendActivityStreamsApplication(v vocab.ActivityStreamsApplication) {
+	this.properties.Append(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsApplicationMember: v,
+		alias:                            this.alias,
+	})
+}
+
+// AppendActivityStreamsGroup appends a Group value to the back of a list of the
+// property "alsoKnownAs".
+func (this *ActivityStreamsAlsoKnownAsProperty) AppendActivityStreamsGroup(v vocab.ActivityStreamsGroup) {
+	this.properties.Append(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsGroupMember: v,
+		alias:                      this.alias,
+	})
+}
+
+// AppendActivityStreamsOrganization appends a Organization value to the back of a
+// list of the property "alsoKnownAs".
+func (this *ActivityStreamsAlsoKnownAsProperty) AppendActivityStreamsOrganization(v vocab.ActivityStreamsOrganization) {
+	this.properties.Append(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsOrganizationMember: v,
+		alias:                             this.alias,
+	})
+}
+
+// AppendActivityStreamsPerson appends a Person value to the back of a list of the
+// property "alsoKnownAs".
+func (this *ActivityStreamsAlsoKnownAsProperty) AppendActivityStreamsPerson(v vocab.ActivityStreamsPerson) {
+	this.properties.Append(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsPersonMember: v,
+		alias:                       this.alias,
+	})
+}
+
+// AppendActivityStreamsService appends a Service value to the back of a list of
+// the property "alsoKnownAs".
+func (this *ActivityStreamsAlsoKnownAsProperty) AppendActivityStreamsService(v vocab.ActivityStreamsService) {
+	this.properties.Append(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsServiceMember: v,
+		alias:                        this.alias,
+	})
+}
+
+// AppendIRI appends an IRI value to the back of a list of the property
+// "alsoKnownAs"
+func (this *ActivityStreamsAlsoKnownAsProperty) AppendIRI(v *url.URL) {
+	this.properties.Append(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// AppendType appends an arbitrary type value to the back of a list of the
+// property "alsoKnownAs". Returns an error if the type is not a valid one to
+// set for this property.
+func (this *ActivityStreamsAlsoKnownAsProperty) AppendType(t vocab.Type) error {
+	n := &ActivityStreamsAlsoKnownAsPropertyIterator{alias: this.alias}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties.Append(n)
+	return nil
+}
+
+// At returns the property value for the specified index. Panics if the index is
+// out of bounds.
+func (this ActivityStreamsAlsoKnownAsProperty) At(index int) vocab.ActivityStreamsAlsoKnownAsPropertyIterator {
+	return this.properties[index]
+}
+
+// Begin returns the first iterator, or nil if empty. Can be used with the
+// iterator's Next method and this property's End method to iterate from front
+// to back through all values.
+func (this ActivityStreamsAlsoKnownAsProperty) Begin() vocab.ActivityStreamsAlsoKnownAsPropertyIterator {
+	if this.Empty() {
+		return nil
+	} else {
+		return this.properties[0]
+	}
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsAlsoKnownAsProperty) Compare(o vocab.ActivityStreamsAlsoKnownAsProperty) int {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if c := this.properties[i].Compare(o.At(i)); c != 0 {
+			return c
+		}
+	}
+	if l1 < l2 {
+		return -1
+	} else if l1 > l2 {
+		return 1
+	}
+	return 0
+}
+
+// Empty returns returns true if there are no elements.
+func (this ActivityStreamsAlsoKnownAsProperty) Empty() bool {
+	return this.Len() == 0
+}
+
+// End returns beyond-the-last iterator, which is nil. Can be used with the
+// iterator's Next method and this property's Begin method to iterate from
+// front to back through all values.
+func (this ActivityStreamsAlsoKnownAsProperty) End() vocab.ActivityStreamsAlsoKnownAsPropertyIterator {
+	return nil
+}
+
+// InsertActivityStreamsApplication inserts a Application value at the specified
+// index for a property "alsoKnownAs". Existing elements at that index and
+// higher are shifted back once.
+func (this *ActivityStreamsAlsoKnownAsProperty) InsertActivityStreamsApplication(idx int, v vocab.ActivityStreamsApplication) {
+	this.properties.Insert(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsApplicationMember: v,
+		alias:                            this.alias,
+	})
+}
+
+// InsertActivityStreamsGroup inserts a Group value at the specified index for a
+// property "alsoKnownAs". Existing elements at that index and higher are
+// shifted back once.
+func (this *ActivityStreamsAlsoKnownAsProperty) InsertActivityStreamsGroup(idx int, v vocab.ActivityStreamsGroup) {
+	this.properties.Insert(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsGroupMember: v,
+		alias:                      this.alias,
+	})
+}
+
+// InsertActivityStreamsOrganization inserts a Organization value at the specified
+// index for a property "alsoKnownAs". Existing elements at that index and
+// higher are shifted back once.
+func (this *ActivityStreamsAlsoKnownAsProperty) InsertActivityStreamsOrganization(idx int, v vocab.ActivityStreamsOrganization) {
+	this.properties.Insert(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsOrganizationMember: v,
+		alias:                             this.alias,
+	})
+}
+
+// InsertActivityStreamsPerson inserts a Person value at the specified index for a
+// property "alsoKnownAs". Existing elements at that index and higher are
+// shifted back once.
+func (this *ActivityStreamsAlsoKnownAsProperty) InsertActivityStreamsPerson(idx int, v vocab.ActivityStreamsPerson) {
+	this.properties.Insert(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsPersonMember: v,
+		alias:                       this.alias,
+	})
+}
+
+// InsertActivityStreamsService inserts a Service value at the specified index for
+// a property "alsoKnownAs". Existing elements at that index and higher are
+// shifted back once.
+func (this *ActivityStreamsAlsoKnownAsProperty) InsertActivityStreamsService(idx int, v vocab.ActivityStreamsService) {
+	this.properties.Insert(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsServiceMember: v,
+		alias:                        this.alias,
+	})
+}
+
+// Insert inserts an IRI value at the specified index for a property
+// "alsoKnownAs". Existing elements at that index and higher are shifted back
+// once.
+func (this *ActivityStreamsAlsoKnownAsProperty) InsertIRI(idx int, v *url.URL) {
+	this.properties.Insert(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// InsertType inserts an arbitrary type value at the specified index for the
+// property "alsoKnownAs". Existing elements at that index and higher are
+// shifted back once. Returns an error if the type is not a valid one to set
+// for this property.
+func (this *ActivityStreamsAlsoKnownAsProperty) InsertType(idx int, t vocab.Type) error {
+	n := &ActivityStreamsAlsoKnownAsPropertyIterator{alias: this.alias}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties.Insert(idx, n)
+	return nil
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this ActivityStreamsAlsoKnownAsProperty) JSONLDContext() map[string]string {
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API method specifically needed only for alternate implementations
+// for go-fed. Applications should not use this method. Panics if the index is
+// out of bounds.
+func (this ActivityStreamsAlsoKnownAsProperty) KindIndex(idx int) int {
+	return this.properties[idx].KindIndex()
+}
+
+// Len returns the number of values that exist for the "alsoKnownAs" property.
+func (this ActivityStreamsAlsoKnownAsProperty) Len() (length int) {
+	return this.properties.Len()
+}
+
+// Less computes whether another property is less than this one. Mixing types
+// results in a consistent but arbitrary ordering
+func (this ActivityStreamsAlsoKnownAsProperty) Less(i, j int) bool {
+	idx1 := this.KindIndex(i)
+	idx2 := this.KindIndex(j)
+	if idx1 < idx2 {
+		return true
+	} else if idx1 == idx2 {
+		if idx1 == 0 {
+			lhs := this.properties[i].GetActivityStreamsApplication()
+			rhs := this.properties[j].GetActivityStreamsApplication()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 1 {
+			lhs := this.properties[i].GetActivityStreamsGroup()
+			rhs := this.properties[j].GetActivityStreamsGroup()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 2 {
+			lhs := this.properties[i].GetActivityStreamsOrganization()
+			rhs := this.properties[j].GetActivityStreamsOrganization()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 3 {
+			lhs := this.properties[i].GetActivityStreamsPerson()
+			rhs := this.properties[j].GetActivityStreamsPerson()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 4 {
+			lhs := this.properties[i].GetActivityStreamsService()
+			rhs := this.properties[j].GetActivityStreamsService()
+			return lhs.LessThan(rhs)
+		} else if idx1 == -2 {
+			lhs := this.properties[i].GetIRI()
+			rhs := this.properties[j].GetIRI()
+			return lhs.String() < rhs.String()
+		}
+	}
+	return false
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this ActivityStreamsAlsoKnownAsProperty) LessThan(o vocab.ActivityStreamsAlsoKnownAsProperty) bool {
+	return this.Compare(o) < 0
+}
+
+// Name returns the name of this property ("alsoKnownAs") with any alias.
+func (this ActivityStreamsAlsoKnownAsProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":" + "alsoKnownAs"
+	} else {
+		return "alsoKnownAs"
+	}
+}
+
+// PrependActivityStreamsApplication prepends a Application value to the front of
+// a list of the property "alsoKnownAs".
+func (this *ActivityStreamsAlsoKnownAsProperty) PrependActivityStreamsApplication(v vocab.ActivityStreamsApplication) {
+	this.properties.Prepend(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsApplicationMember: v,
+		alias:                            this.alias,
+	})
+}
+
+// PrependActivityStreamsGroup prepends a Group value to the front of a list of
+// the property "alsoKnownAs".
+func (this *ActivityStreamsAlsoKnownAsProperty) PrependActivityStreamsGroup(v vocab.ActivityStreamsGroup) {
+	this.properties.Prepend(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsGroupMember: v,
+		alias:                      this.alias,
+	})
+}
+
+// PrependActivityStreamsOrganization prepends a Organization value to the front
+// of a list of the property "alsoKnownAs".
+func (this *ActivityStreamsAlsoKnownAsProperty) PrependActivityStreamsOrganization(v vocab.ActivityStreamsOrganization) {
+	this.properties.Prepend(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsOrganizationMember: v,
+		alias:                             this.alias,
+	})
+}
+
+// PrependActivityStreamsPerson prepends a Person value to the front of a list of
+// the property "alsoKnownAs".
+func (this *ActivityStreamsAlsoKnownAsProperty) PrependActivityStreamsPerson(v vocab.ActivityStreamsPerson) {
+	this.properties.Prepend(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsPersonMember: v,
+		alias:                       this.alias,
+	})
+}
+
+// PrependActivityStreamsService prepends a Service value to the front of a list
+// of the property "alsoKnownAs".
+func (this *ActivityStreamsAlsoKnownAsProperty) PrependActivityStreamsService(v vocab.ActivityStreamsService) {
+	this.properties.Prepend(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsServiceMember: v,
+		alias:                        this.alias,
+	})
+}
+
+// PrependIRI prepends an IRI value to the front of a list of the property
+// "alsoKnownAs".
+func (this *ActivityStreamsAlsoKnownAsProperty) PrependIRI(v *url.URL) {
+	this.properties.Prepend(&ActivityStreamsAlsoKnownAsPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// PrependType prepends an arbitrary type value to the front of a list of the
+// property "alsoKnownAs". Returns an error if the type is not a valid one to
+// set for this property.
+func (this *ActivityStreamsAlsoKnownAsProperty) PrependType(t vocab.Type) error {
+	n := &ActivityStreamsAlsoKnownAsPropertyIterator{alias: this.alias}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties.Prepend(n)
+	return nil
+}
+
+// Release returns this property and its values to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsAlsoKnownAsProperty) Release() {
+	for i, elem := range this.properties {
+		elem.Release()
+		this.properties[i] = nil
+	}
+	*this = ActivityStreamsAlsoKnownAsProperty{properties: this.properties[:0]}
+	poolActivityStreamsAlsoKnownAsProperty.Put(this)
+}
+
+// Remove deletes an element at the specified index from a list of the property
+// "alsoKnownAs", regardless of its type. Panics if the index is out of bounds.
+func (this *ActivityStreamsAlsoKnownAsProperty) Remove(idx int) {
+	this.properties.Remove(idx)
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this ActivityStreamsAlsoKnownAsProperty) Serialize() (interface{}, error) {
+	s := make([]interface{}, 0, len(this.properties))
+	for _, iterator := range this.properties {
+		if b, err := iterator.serialize(); err != nil {
+			return s, err
+		} else {
+			s = append(s, b)
+		}
+	}
+	// Shortcut: if serializing one value, don't return an array -- pretty sure other Fediverse software would choke on a "type" value with array, for example.
+	if len(s) == 1 {
+		return s[0], nil
+	}
+	return s, nil
+}
+
+// SetActivityStreamsApplication sets a Application value to be at the specified
+// index for the property "alsoKnownAs". Panics if the index is out of bounds.
+func (this *ActivityStreamsAlsoKnownAsProperty) SetActivityStreamsApplication(idx int, v vocab.ActivityStreamsApplication) {
+	this.properties.Set(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsApplicationMember: v,
+		alias:                            this.alias,
+	})
+}
+
+// SetActivityStreamsGroup sets a Group value to be at the specified index for the
+// property "alsoKnownAs". Panics if the index is out of bounds.
+func (this *ActivityStreamsAlsoKnownAsProperty) SetActivityStreamsGroup(idx int, v vocab.ActivityStreamsGroup) {
+	this.properties.Set(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsGroupMember: v,
+		alias:                      this.alias,
+	})
+}
+
+// SetActivityStreamsOrganization sets a Organization value to be at the specified
+// index for the property "alsoKnownAs". Panics if the index is out of bounds.
+func (this *ActivityStreamsAlsoKnownAsProperty) SetActivityStreamsOrganization(idx int, v vocab.ActivityStreamsOrganization) {
+	this.properties.Set(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsOrganizationMember: v,
+		alias:                             this.alias,
+	})
+}
+
+// SetActivityStreamsPerson sets a Person value to be at the specified index for
+// the property "alsoKnownAs". Panics if the index is out of bounds.
+func (this *ActivityStreamsAlsoKnownAsProperty) SetActivityStreamsPerson(idx int, v vocab.ActivityStreamsPerson) {
+	this.properties.Set(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsPersonMember: v,
+		alias:                       this.alias,
+	})
+}
+
+// SetActivityStreamsService sets a Service value to be at the specified index for
+// the property "alsoKnownAs". Panics if the index is out of bounds.
+func (this *ActivityStreamsAlsoKnownAsProperty) SetActivityStreamsService(idx int, v vocab.ActivityStreamsService) {
+	this.properties.Set(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		activitystreamsServiceMember: v,
+		alias:                        this.alias,
+	})
+}
+
+// SetIRI sets an IRI value to be at the specified index for the property
+// "alsoKnownAs". Panics if the index is out of bounds.
+func (this *ActivityStreamsAlsoKnownAsProperty) SetIRI(idx int, v *url.URL) {
+	this.properties.Set(idx, &ActivityStreamsAlsoKnownAsPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// SetType sets an arbitrary type value to the specified index of the property
+// "alsoKnownAs". Returns an error if the type is not a valid one to set for
+// this property. Panics if the index is out of bounds.
+func (this *ActivityStreamsAlsoKnownAsProperty) SetType(idx int, t vocab.Type) error {
+	n := &ActivityStreamsAlsoKnownAsPropertyIterator{alias: this.alias}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties.Set(idx, n)
+	return nil
+}
+
+// Swap swaps the location of values at two indices for the "alsoKnownAs" property.
+func (this ActivityStreamsAlsoKnownAsProperty) Swap(i, j int) {
+	this.properties.Swap(i, j)
+}
+
+// Values returns the iterators of all values from front to back, so they can be
+// ranged over. Adding values to or removing them from this property
+// afterwards does not change the returned slice.
+func (this ActivityStreamsAlsoKnownAsProperty) Values() []vocab.ActivityStreamsAlsoKnownAsPropertyIterator {
+	values := make([]vocab.ActivityStreamsAlsoKnownAsPropertyIterator, len(this.properties))
+	for i, elem := range this.properties {
+		values[i] = elem
+	}
+	return values
+}
+
+// poolActivityStreamsAlsoKnownAsProperty holds the released values of ActivityStreamsAlsoKnownAsProperty, which are reused when deserializing.
+var poolActivityStreamsAlsoKnownAsProperty = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsAlsoKnownAsProperty{}
+}}
+
+// poolActivityStreamsAlsoKnownAsPropertyIterator holds the released values of ActivityStreamsAlsoKnownAsPropertyIterator, which are reused when deserializing.
+var poolActivityStreamsAlsoKnownAsPropertyIterator = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsAlsoKnownAsPropertyIterator{}
+}}
@@ -8,6 +8,8 @@ import (
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ActivityStreamsPreferredUsernameProperty is the functional property
@@ -49,7 +51,8 @@ func DeserializePreferredUsernameProperty(m map[string]interface{}, aliasMap map
 			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 			if err == nil && len(u.Scheme) > 0 {
-				this := &ActivityStreamsPreferredUsernameProperty{
+				this := poolActivityStreamsPreferredUsernameProperty.Get().(*ActivityStreamsPreferredUsernameProperty)
+				*this = ActivityStreamsPreferredUsernameProperty{
 					alias: alias,
 					iri:   u,
 				}
@@ -57,20 +60,23 @@ func DeserializePreferredUsernameProperty(m map[string]interface{}, aliasMap map
 			}
 		}
 		if v, err := string1.DeserializeString(i); err == nil {
-			this := &ActivityStreamsPreferredUsernameProperty{
+			this := poolActivityStreamsPreferredUsernameProperty.Get().(*ActivityStreamsPreferredUsernameProperty)
+			*this = ActivityStreamsPreferredUsernameProperty{
 				alias:                 alias,
 				hasStringMember:       true,
 				xmlschemaStringMember: v,
 			}
 			return this, nil
 		} else if v, err := langstring.DeserializeLangString(i); err == nil {
-			this := &ActivityStreamsPreferredUsernameProperty{
+			this := poolActivityStreamsPreferredUsernameProperty.Get().(*ActivityStreamsPreferredUsernameProperty)
+			*this = ActivityStreamsPreferredUsernameProperty{
 				alias:               alias,
 				rdfLangStringMember: v,
 			}
 			return this, nil
 		}
-		this := &ActivityStreamsPreferredUsernameProperty{
+		this := poolActivityStreamsPreferredUsernameProperty.Get().(*ActivityStreamsPreferredUsernameProperty)
+		*this = ActivityStreamsPreferredUsernameProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -85,6 +91,14 @@ func NewActivityStreamsPreferredUsernameProperty() *ActivityStreamsPreferredUser
 	return &ActivityStreamsPreferredUsernameProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ActivityStreamsPreferredUsernameProperty) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+}
+
 // Clear ensures no value and no language map for this property is set. Calling
 // HasAny or any of the 'Is' methods afterwards will return false.
 func (this *ActivityStreamsPreferredUsernameProperty) Clear() {
@@ -95,6 +109,38 @@ func (this *ActivityStreamsPreferredUsernameProperty) Clear() {
 	this.rdfLangStringMember = nil
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsPreferredUsernameProperty) Compare(o vocab.ActivityStreamsPreferredUsernameProperty) int {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return -1
+	} else if idx1 > idx2 {
+		return 1
+	} else if this.IsXMLSchemaString() {
+		if lhs, rhs := this.GetXMLSchemaString(), o.GetXMLSchemaString(); string1.LessString(lhs, rhs) {
+			return -1
+		} else if string1.LessString(rhs, lhs) {
+			return 1
+		}
+		return 0
+	} else if this.IsRDFLangString() {
+		if lhs, rhs := this.GetRDFLangString(), o.GetRDFLangString(); langstring.LessLangString(lhs, rhs) {
+			return -1
+		} else if langstring.LessLangString(rhs, lhs) {
+			return 1
+		}
+		return 0
+	} else if this.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	}
+	return 0
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this ActivityStreamsPreferredUsernameProperty) GetIRI() *url.URL {
@@ -173,17 +219,8 @@ func (this ActivityStreamsPreferredUsernameProperty) IsXMLSchemaString() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsPreferredUsernameProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -208,20 +245,7 @@ func (this ActivityStreamsPreferredUsernameProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsPreferredUsernameProperty) LessThan(o vocab.ActivityStreamsPreferredUsernameProperty) bool {
-	idx1 := this.KindIndex()
-	idx2 := o.KindIndex()
-	if idx1 < idx2 {
-		return true
-	} else if idx1 > idx2 {
-		return false
-	} else if this.IsXMLSchemaString() {
-		return string1.LessString(this.GetXMLSchemaString(), o.GetXMLSchemaString())
-	} else if this.IsRDFLangString() {
-		return langstring.LessLangString(this.GetRDFLangString(), o.GetRDFLangString())
-	} else if this.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	}
-	return false
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "preferredUsername".
@@ -233,6 +257,15 @@ func (this ActivityStreamsPreferredUsernameProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsPreferredUsernameProperty) Release() {
+	*this = ActivityStreamsPreferredUsernameProperty{}
+	poolActivityStreamsPreferredUsernameProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -282,3 +315,8 @@ func (this *ActivityStreamsPreferredUsernameProperty) SetXMLSchemaString(v strin
 	this.xmlschemaStringMember = v
 	this.hasStringMember = true
 }
+
+// poolActivityStreamsPreferredUsernameProperty holds the released values of ActivityStreamsPreferredUsernameProperty, which are reused when deserializing.
+var poolActivityStreamsPreferredUsernameProperty = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsPreferredUsernameProperty{}
+}}
@@ -4,9 +4,12 @@ package propertyrel
 
 import (
 	"fmt"
+	proplist "github.com/go-fed/activity/streams/impl/proplist"
 	rfc5988 "github.com/go-fed/activity/streams/values/rfc5988"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ActivityStreamsRelPropertyIterator is an iterator for a property. It is
@@ -17,8 +20,7 @@ type ActivityStreamsRelPropertyIterator struct {
 	unknown          interface{}
 	iri              *url.URL
 	alias            string
-	myIdx            int
-	parent           vocab.ActivityStreamsRelProperty
+	proplist.Links[*ActivityStreamsRelPropertyIterator]
 }
 
 // NewActivityStreamsRelPropertyIterator creates a new ActivityStreamsRel property.
@@ -38,7 +40,8 @@ func deserializeActivityStreamsRelPropertyIterator(i interface{}, aliasMap map[s
 		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 		if err == nil && len(u.Scheme) > 0 {
-			this := &ActivityStreamsRelPropertyIterator{
+			this := poolActivityStreamsRelPropertyIterator.Get().(*ActivityStreamsRelPropertyIterator)
+			*this = ActivityStreamsRelPropertyIterator{
 				alias: alias,
 				iri:   u,
 			}
@@ -46,20 +49,66 @@ func deserializeActivityStreamsRelPropertyIterator(i interface{}, aliasMap map[s
 		}
 	}
 	if v, err := rfc5988.DeserializeRfc5988(i); err == nil {
-		this := &ActivityStreamsRelPropertyIterator{
+		this := poolActivityStreamsRelPropertyIterator.Get().(*ActivityStreamsRelPropertyIterator)
+		*this = ActivityStreamsRelPropertyIterator{
 			alias:            alias,
 			hasRfc5988Member: true,
 			rfcRfc5988Member: v,
 		}
 		return this, nil
 	}
-	this := &ActivityStreamsRelPropertyIterator{
+	this := poolActivityStreamsRelPropertyIterator.Get().(*ActivityStreamsRelPropertyIterator)
+	*this = ActivityStreamsRelPropertyIterator{
 		alias:   alias,
 		unknown: i,
 	}
 	return this, nil
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ActivityStreamsRelPropertyIterator) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsRelPropertyIterator) Compare(o vocab.ActivityStreamsRelPropertyIterator) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsRFCRfc5988() && !o.IsRFCRfc5988() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsRFCRfc5988() && !o.IsRFCRfc5988() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsRFCRfc5988() && o.IsRFCRfc5988() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	if lhs, rhs := this.Get(), o.Get(); rfc5988.LessRfc5988(lhs, rhs) {
+		return -1
+	} else if rfc5988.LessRfc5988(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
 // Get returns the value of this property. When IsRFCRfc5988 returns false, Get
 // will return any arbitrary value.
 func (this ActivityStreamsRelPropertyIterator) Get() string {
@@ -91,17 +140,8 @@ func (this ActivityStreamsRelPropertyIterator) IsRFCRfc5988() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsRelPropertyIterator) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -123,30 +163,7 @@ func (this ActivityStreamsRelPropertyIterator) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsRelPropertyIterator) LessThan(o vocab.ActivityStreamsRelPropertyIterator) bool {
-	// LessThan comparison for if either or both are IRIs.
-	if this.IsIRI() && o.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	} else if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsRFCRfc5988() && !o.IsRFCRfc5988() {
-		// Both are unknowns.
-		return false
-	} else if this.IsRFCRfc5988() && !o.IsRFCRfc5988() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsRFCRfc5988() && o.IsRFCRfc5988() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return rfc5988.LessRfc5988(this.Get(), o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "ActivityStreamsRel".
@@ -158,22 +175,33 @@ func (this ActivityStreamsRelPropertyIterator) Name() string {
 	}
 }
 
-// Next returns the next iterator, or nil if there is no next iterator.
+// Next returns the next iterator, or nil if there is no next iterator. It remains
+// correct when values are added to or removed from the property, including
+// this one, without obtaining the iterator again.
 func (this ActivityStreamsRelPropertyIterator) Next() vocab.ActivityStreamsRelPropertyIterator {
-	if this.myIdx+1 >= this.parent.Len() {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx + 1)
+	if it := proplist.Next(&this); it != nil {
+		return it
 	}
+	return nil
 }
 
-// Prev returns the previous iterator, or nil if there is no previous iterator.
+// Prev returns the previous iterator, or nil if there is no previous iterator. It
+// remains correct when values are added to or removed from the property,
+// including this one, without obtaining the iterator again.
 func (this ActivityStreamsRelPropertyIterator) Prev() vocab.ActivityStreamsRelPropertyIterator {
-	if this.myIdx-1 < 0 {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx - 1)
+	if it := proplist.Prev(&this); it != nil {
+		return it
 	}
+	return nil
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsRelPropertyIterator) Release() {
+	*this = ActivityStreamsRelPropertyIterator{}
+	poolActivityStreamsRelPropertyIterator.Put(this)
 }
 
 // Set sets the value of this property. Calling IsRFCRfc5988 afterwards will
@@ -215,7 +243,7 @@ func (this ActivityStreamsRelPropertyIterator) serialize() (interface{}, error)
 // ActivityStreamsRelProperty is the non-functional property "rel". It is
 // permitted to have one or more values, and of different value types.
 type ActivityStreamsRelProperty struct {
-	properties []*ActivityStreamsRelPropertyIterator
+	properties proplist.List[*ActivityStreamsRelPropertyIterator]
 	alias      string
 }
 
@@ -233,10 +261,9 @@ func DeserializeRelProperty(m map[string]interface{}, aliasMap map[string]string
 	i, ok := m[propName]
 
 	if ok {
-		this := &ActivityStreamsRelProperty{
-			alias:      alias,
-			properties: []*ActivityStreamsRelPropertyIterator{},
-		}
+		// A released value keeps its properties for reuse.
+		this := poolActivityStreamsRelProperty.Get().(*ActivityStreamsRelProperty)
+		this.alias = alias
 		if list, ok := i.([]interface{}); ok {
 			for _, iterator := range list {
 				if p, err := deserializeActivityStreamsRelPropertyIterator(iterator, aliasMap); err != nil {
@@ -253,10 +280,7 @@ func DeserializeRelProperty(m map[string]interface{}, aliasMap map[string]string
 			}
 		}
 		// Set up the properties for iteration.
-		for idx, ele := range this.properties {
-			ele.parent = this
-			ele.myIdx = idx
-		}
+		this.properties.Link()
 		return this, nil
 	}
 	return nil, nil
@@ -267,24 +291,31 @@ func NewActivityStreamsRelProperty() *ActivityStreamsRelProperty {
 	return &ActivityStreamsRelProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and each of its values.
+func (this ActivityStreamsRelProperty) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+	for _, elem := range this.properties {
+		elem.AddJSONLDContext(m)
+	}
+}
+
 // AppendIRI appends an IRI value to the back of a list of the property "rel"
 func (this *ActivityStreamsRelProperty) AppendIRI(v *url.URL) {
-	this.properties = append(this.properties, &ActivityStreamsRelPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  this.Len(),
-		parent: this,
+	this.properties.Append(&ActivityStreamsRelPropertyIterator{
+		alias: this.alias,
+		iri:   v,
 	})
 }
 
 // AppendRFCRfc5988 appends a rfc5988 value to the back of a list of the property
-// "rel". Invalidates iterators that are traversing using Prev.
+// "rel".
 func (this *ActivityStreamsRelProperty) AppendRFCRfc5988(v string) {
-	this.properties = append(this.properties, &ActivityStreamsRelPropertyIterator{
+	this.properties.Append(&ActivityStreamsRelPropertyIterator{
 		alias:            this.alias,
 		hasRfc5988Member: true,
-		myIdx:            this.Len(),
-		parent:           this,
 		rfcRfc5988Member: v,
 	})
 }
@@ -306,6 +337,31 @@ func (this ActivityStreamsRelProperty) Begin() vocab.ActivityStreamsRelPropertyI
 	}
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsRelProperty) Compare(o vocab.ActivityStreamsRelProperty) int {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if c := this.properties[i].Compare(o.At(i)); c != 0 {
+			return c
+		}
+	}
+	if l1 < l2 {
+		return -1
+	} else if l1 > l2 {
+		return 1
+	}
+	return 0
+}
+
 // Empty returns returns true if there are no elements.
 func (this ActivityStreamsRelProperty) Empty() bool {
 	return this.Len() == 0
@@ -320,55 +376,29 @@ func (this ActivityStreamsRelProperty) End() vocab.ActivityStreamsRelPropertyIte
 
 // Insert inserts an IRI value at the specified index for a property "rel".
 // Existing elements at that index and higher are shifted back once.
-// Invalidates all iterators.
 func (this *ActivityStreamsRelProperty) InsertIRI(idx int, v *url.URL) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsRelPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, &ActivityStreamsRelPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // InsertRFCRfc5988 inserts a rfc5988 value at the specified index for a property
 // "rel". Existing elements at that index and higher are shifted back once.
-// Invalidates all iterators.
 func (this *ActivityStreamsRelProperty) InsertRFCRfc5988(idx int, v string) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsRelPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsRelPropertyIterator{
 		alias:            this.alias,
 		hasRfc5988Member: true,
-		myIdx:            idx,
-		parent:           this,
 		rfcRfc5988Member: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsRelProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	for _, elem := range this.properties {
-		child := elem.JSONLDContext()
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		for k, v := range child {
-			m[k] = v
-		}
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -382,7 +412,7 @@ func (this ActivityStreamsRelProperty) KindIndex(idx int) int {
 
 // Len returns the number of values that exist for the "rel" property.
 func (this ActivityStreamsRelProperty) Len() (length int) {
-	return len(this.properties)
+	return this.properties.Len()
 }
 
 // Less computes whether another property is less than this one. Mixing types
@@ -411,20 +441,7 @@ func (this ActivityStreamsRelProperty) Less(i, j int) bool {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsRelProperty) LessThan(o vocab.ActivityStreamsRelProperty) bool {
-	l1 := this.Len()
-	l2 := o.Len()
-	l := l1
-	if l2 < l1 {
-		l = l2
-	}
-	for i := 0; i < l; i++ {
-		if this.properties[i].LessThan(o.At(i)) {
-			return true
-		} else if o.At(i).LessThan(this.properties[i]) {
-			return false
-		}
-	}
-	return l1 < l2
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property ("rel") with any alias.
@@ -438,43 +455,39 @@ func (this ActivityStreamsRelProperty) Name() string {
 
 // PrependIRI prepends an IRI value to the front of a list of the property "rel".
 func (this *ActivityStreamsRelProperty) PrependIRI(v *url.URL) {
-	this.properties = append([]*ActivityStreamsRelPropertyIterator{{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  0,
-		parent: this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(&ActivityStreamsRelPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // PrependRFCRfc5988 prepends a rfc5988 value to the front of a list of the
-// property "rel". Invalidates all iterators.
+// property "rel".
 func (this *ActivityStreamsRelProperty) PrependRFCRfc5988(v string) {
-	this.properties = append([]*ActivityStreamsRelPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsRelPropertyIterator{
 		alias:            this.alias,
 		hasRfc5988Member: true,
-		myIdx:            0,
-		parent:           this,
 		rfcRfc5988Member: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
+	})
+}
+
+// Release returns this property and its values to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsRelProperty) Release() {
+	for i, elem := range this.properties {
+		elem.Release()
+		this.properties[i] = nil
 	}
+	*this = ActivityStreamsRelProperty{properties: this.properties[:0]}
+	poolActivityStreamsRelProperty.Put(this)
 }
 
 // Remove deletes an element at the specified index from a list of the property
 // "rel", regardless of its type. Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ActivityStreamsRelProperty) Remove(idx int) {
-	(this.properties)[idx].parent = nil
-	copy((this.properties)[idx:], (this.properties)[idx+1:])
-	(this.properties)[len(this.properties)-1] = &ActivityStreamsRelPropertyIterator{}
-	this.properties = (this.properties)[:len(this.properties)-1]
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Remove(idx)
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -498,31 +511,46 @@ func (this ActivityStreamsRelProperty) Serialize() (interface{}, error) {
 }
 
 // Set sets a rfc5988 value to be at the specified index for the property "rel".
-// Panics if the index is out of bounds. Invalidates all iterators.
+// Panics if the index is out of bounds.
 func (this *ActivityStreamsRelProperty) Set(idx int, v string) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsRelPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsRelPropertyIterator{
 		alias:            this.alias,
 		hasRfc5988Member: true,
-		myIdx:            idx,
-		parent:           this,
 		rfcRfc5988Member: v,
-	}
+	})
 }
 
 // SetIRI sets an IRI value to be at the specified index for the property "rel".
 // Panics if the index is out of bounds.
 func (this *ActivityStreamsRelProperty) SetIRI(idx int, v *url.URL) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsRelPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
+	this.properties.Set(idx, &ActivityStreamsRelPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // Swap swaps the location of values at two indices for the "rel" property.
 func (this ActivityStreamsRelProperty) Swap(i, j int) {
-	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+	this.properties.Swap(i, j)
 }
+
+// Values returns the iterators of all values from front to back, so they can be
+// ranged over. Adding values to or removing them from this property
+// afterwards does not change the returned slice.
+func (this ActivityStreamsRelProperty) Values() []vocab.ActivityStreamsRelPropertyIterator {
+	values := make([]vocab.ActivityStreamsRelPropertyIterator, len(this.properties))
+	for i, elem := range this.properties {
+		values[i] = elem
+	}
+	return values
+}
+
+// poolActivityStreamsRelProperty holds the released values of ActivityStreamsRelProperty, which are reused when deserializing.
+var poolActivityStreamsRelProperty = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsRelProperty{}
+}}
+
+// poolActivityStreamsRelPropertyIterator holds the released values of ActivityStreamsRelPropertyIterator, which are reused when deserializing.
+var poolActivityStreamsRelPropertyIterator = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsRelPropertyIterator{}
+}}
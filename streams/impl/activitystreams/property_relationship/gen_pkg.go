@@ -77,6 +77,10 @@ type privateManager interface {
 	// for the "ActivityStreamsDocument" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeDocumentActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDocument, error)
+	// DeserializeEmojiReactLitepub returns the deserialization method for the
+	// "LitepubEmojiReact" non-functional property in the vocabulary
+	// "Litepub"
+	DeserializeEmojiReactLitepub() func(map[string]interface{}, map[string]string) (vocab.LitepubEmojiReact, error)
 	// DeserializeEmojiToot returns the deserialization method for the
 	// "TootEmoji" non-functional property in the vocabulary "Toot"
 	DeserializeEmojiToot() func(map[string]interface{}, map[string]string) (vocab.TootEmoji, error)
@@ -177,6 +181,10 @@ type privateManager interface {
 	// for the "ActivityStreamsProfile" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeProfileActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsProfile, error)
+	// DeserializePropertyValueSchema returns the deserialization method for
+	// the "SchemaPropertyValue" non-functional property in the vocabulary
+	// "Schema"
+	DeserializePropertyValueSchema() func(map[string]interface{}, map[string]string) (vocab.SchemaPropertyValue, error)
 	// DeserializePushForgeFed returns the deserialization method for the
 	// "ForgeFedPush" non-functional property in the vocabulary "ForgeFed"
 	DeserializePushForgeFed() func(map[string]interface{}, map[string]string) (vocab.ForgeFedPush, error)
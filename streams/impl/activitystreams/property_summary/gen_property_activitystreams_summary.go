@@ -4,10 +4,13 @@ package propertysummary
 
 import (
 	"fmt"
+	proplist "github.com/go-fed/activity/streams/impl/proplist"
 	langstring "github.com/go-fed/activity/streams/values/langString"
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ActivityStreamsSummaryPropertyIterator is an iterator for a property. It is
@@ -22,8 +25,7 @@ type ActivityStreamsSummaryPropertyIterator struct {
 	unknown               interface{}
 	iri                   *url.URL
 	alias                 string
-	myIdx                 int
-	parent                vocab.ActivityStreamsSummaryProperty
+	proplist.Links[*ActivityStreamsSummaryPropertyIterator]
 }
 
 // NewActivityStreamsSummaryPropertyIterator creates a new ActivityStreamsSummary
@@ -44,7 +46,8 @@ func deserializeActivityStreamsSummaryPropertyIterator(i interface{}, aliasMap m
 		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 		if err == nil && len(u.Scheme) > 0 {
-			this := &ActivityStreamsSummaryPropertyIterator{
+			this := poolActivityStreamsSummaryPropertyIterator.Get().(*ActivityStreamsSummaryPropertyIterator)
+			*this = ActivityStreamsSummaryPropertyIterator{
 				alias: alias,
 				iri:   u,
 			}
@@ -52,26 +55,69 @@ func deserializeActivityStreamsSummaryPropertyIterator(i interface{}, aliasMap m
 		}
 	}
 	if v, err := string1.DeserializeString(i); err == nil {
-		this := &ActivityStreamsSummaryPropertyIterator{
+		this := poolActivityStreamsSummaryPropertyIterator.Get().(*ActivityStreamsSummaryPropertyIterator)
+		*this = ActivityStreamsSummaryPropertyIterator{
 			alias:                 alias,
 			hasStringMember:       true,
 			xmlschemaStringMember: v,
 		}
 		return this, nil
 	} else if v, err := langstring.DeserializeLangString(i); err == nil {
-		this := &ActivityStreamsSummaryPropertyIterator{
+		this := poolActivityStreamsSummaryPropertyIterator.Get().(*ActivityStreamsSummaryPropertyIterator)
+		*this = ActivityStreamsSummaryPropertyIterator{
 			alias:               alias,
 			rdfLangStringMember: v,
 		}
 		return this, nil
 	}
-	this := &ActivityStreamsSummaryPropertyIterator{
+	this := poolActivityStreamsSummaryPropertyIterator.Get().(*ActivityStreamsSummaryPropertyIterator)
+	*this = ActivityStreamsSummaryPropertyIterator{
 		alias:   alias,
 		unknown: i,
 	}
 	return this, nil
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ActivityStreamsSummaryPropertyIterator) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsSummaryPropertyIterator) Compare(o vocab.ActivityStreamsSummaryPropertyIterator) int {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return -1
+	} else if idx1 > idx2 {
+		return 1
+	} else if this.IsXMLSchemaString() {
+		if lhs, rhs := this.GetXMLSchemaString(), o.GetXMLSchemaString(); string1.LessString(lhs, rhs) {
+			return -1
+		} else if string1.LessString(rhs, lhs) {
+			return 1
+		}
+		return 0
+	} else if this.IsRDFLangString() {
+		if lhs, rhs := this.GetRDFLangString(), o.GetRDFLangString(); langstring.LessLangString(lhs, rhs) {
+			return -1
+		} else if langstring.LessLangString(rhs, lhs) {
+			return 1
+		}
+		return 0
+	} else if this.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	}
+	return 0
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this ActivityStreamsSummaryPropertyIterator) GetIRI() *url.URL {
@@ -150,17 +196,8 @@ func (this ActivityStreamsSummaryPropertyIterator) IsXMLSchemaString() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsSummaryPropertyIterator) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -185,20 +222,7 @@ func (this ActivityStreamsSummaryPropertyIterator) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsSummaryPropertyIterator) LessThan(o vocab.ActivityStreamsSummaryPropertyIterator) bool {
-	idx1 := this.KindIndex()
-	idx2 := o.KindIndex()
-	if idx1 < idx2 {
-		return true
-	} else if idx1 > idx2 {
-		return false
-	} else if this.IsXMLSchemaString() {
-		return string1.LessString(this.GetXMLSchemaString(), o.GetXMLSchemaString())
-	} else if this.IsRDFLangString() {
-		return langstring.LessLangString(this.GetRDFLangString(), o.GetRDFLangString())
-	} else if this.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	}
-	return false
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "ActivityStreamsSummary".
@@ -210,22 +234,33 @@ func (this ActivityStreamsSummaryPropertyIterator) Name() string {
 	}
 }
 
-// Next returns the next iterator, or nil if there is no next iterator.
+// Next returns the next iterator, or nil if there is no next iterator. It remains
+// correct when values are added to or removed from the property, including
+// this one, without obtaining the iterator again.
 func (this ActivityStreamsSummaryPropertyIterator) Next() vocab.ActivityStreamsSummaryPropertyIterator {
-	if this.myIdx+1 >= this.parent.Len() {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx + 1)
+	if it := proplist.Next(&this); it != nil {
+		return it
 	}
+	return nil
 }
 
-// Prev returns the previous iterator, or nil if there is no previous iterator.
+// Prev returns the previous iterator, or nil if there is no previous iterator. It
+// remains correct when values are added to or removed from the property,
+// including this one, without obtaining the iterator again.
 func (this ActivityStreamsSummaryPropertyIterator) Prev() vocab.ActivityStreamsSummaryPropertyIterator {
-	if this.myIdx-1 < 0 {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx - 1)
+	if it := proplist.Prev(&this); it != nil {
+		return it
 	}
+	return nil
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsSummaryPropertyIterator) Release() {
+	*this = ActivityStreamsSummaryPropertyIterator{}
+	poolActivityStreamsSummaryPropertyIterator.Put(this)
 }
 
 // SetIRI sets the value of this property. Calling IsIRI afterwards returns true.
@@ -291,7 +326,7 @@ func (this ActivityStreamsSummaryPropertyIterator) serialize() (interface{}, err
 // ActivityStreamsSummaryProperty is the non-functional property "summary". It is
 // permitted to have one or more values, and of different value types.
 type ActivityStreamsSummaryProperty struct {
-	properties []*ActivityStreamsSummaryPropertyIterator
+	properties proplist.List[*ActivityStreamsSummaryPropertyIterator]
 	alias      string
 }
 
@@ -312,10 +347,9 @@ func DeserializeSummaryProperty(m map[string]interface{}, aliasMap map[string]st
 		i, ok = m[propName+"Map"]
 	}
 	if ok {
-		this := &ActivityStreamsSummaryProperty{
-			alias:      alias,
-			properties: []*ActivityStreamsSummaryPropertyIterator{},
-		}
+		// A released value keeps its properties for reuse.
+		this := poolActivityStreamsSummaryProperty.Get().(*ActivityStreamsSummaryProperty)
+		this.alias = alias
 		if list, ok := i.([]interface{}); ok {
 			for _, iterator := range list {
 				if p, err := deserializeActivityStreamsSummaryPropertyIterator(iterator, aliasMap); err != nil {
@@ -332,10 +366,7 @@ func DeserializeSummaryProperty(m map[string]interface{}, aliasMap map[string]st
 			}
 		}
 		// Set up the properties for iteration.
-		for idx, ele := range this.properties {
-			ele.parent = this
-			ele.myIdx = idx
-		}
+		this.properties.Link()
 		return this, nil
 	}
 	return nil, nil
@@ -346,35 +377,40 @@ func NewActivityStreamsSummaryProperty() *ActivityStreamsSummaryProperty {
 	return &ActivityStreamsSummaryProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and each of its values.
+func (this ActivityStreamsSummaryProperty) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+	for _, elem := range this.properties {
+		elem.AddJSONLDContext(m)
+	}
+}
+
 // AppendIRI appends an IRI value to the back of a list of the property "summary"
 func (this *ActivityStreamsSummaryProperty) AppendIRI(v *url.URL) {
-	this.properties = append(this.properties, &ActivityStreamsSummaryPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  this.Len(),
-		parent: this,
+	this.properties.Append(&ActivityStreamsSummaryPropertyIterator{
+		alias: this.alias,
+		iri:   v,
 	})
 }
 
 // AppendRDFLangString appends a langString value to the back of a list of the
-// property "summary". Invalidates iterators that are traversing using Prev.
+// property "summary".
 func (this *ActivityStreamsSummaryProperty) AppendRDFLangString(v map[string]string) {
-	this.properties = append(this.properties, &ActivityStreamsSummaryPropertyIterator{
+	this.properties.Append(&ActivityStreamsSummaryPropertyIterator{
 		alias:               this.alias,
-		myIdx:               this.Len(),
-		parent:              this,
 		rdfLangStringMember: v,
 	})
 }
 
 // AppendXMLSchemaString appends a string value to the back of a list of the
-// property "summary". Invalidates iterators that are traversing using Prev.
+// property "summary".
 func (this *ActivityStreamsSummaryProperty) AppendXMLSchemaString(v string) {
-	this.properties = append(this.properties, &ActivityStreamsSummaryPropertyIterator{
+	this.properties.Append(&ActivityStreamsSummaryPropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 this.Len(),
-		parent:                this,
 		xmlschemaStringMember: v,
 	})
 }
@@ -396,6 +432,31 @@ func (this ActivityStreamsSummaryProperty) Begin() vocab.ActivityStreamsSummaryP
 	}
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsSummaryProperty) Compare(o vocab.ActivityStreamsSummaryProperty) int {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if c := this.properties[i].Compare(o.At(i)); c != 0 {
+			return c
+		}
+	}
+	if l1 < l2 {
+		return -1
+	} else if l1 > l2 {
+		return 1
+	}
+	return 0
+}
+
 // Empty returns returns true if there are no elements.
 func (this ActivityStreamsSummaryProperty) Empty() bool {
 	return this.Len() == 0
@@ -410,72 +471,40 @@ func (this ActivityStreamsSummaryProperty) End() vocab.ActivityStreamsSummaryPro
 
 // Insert inserts an IRI value at the specified index for a property "summary".
 // Existing elements at that index and higher are shifted back once.
-// Invalidates all iterators.
 func (this *ActivityStreamsSummaryProperty) InsertIRI(idx int, v *url.URL) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsSummaryPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, &ActivityStreamsSummaryPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // InsertRDFLangString inserts a langString value at the specified index for a
 // property "summary". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsSummaryProperty) InsertRDFLangString(idx int, v map[string]string) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsSummaryPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsSummaryPropertyIterator{
 		alias:               this.alias,
-		myIdx:               idx,
-		parent:              this,
 		rdfLangStringMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertXMLSchemaString inserts a string value at the specified index for a
 // property "summary". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsSummaryProperty) InsertXMLSchemaString(idx int, v string) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsSummaryPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsSummaryPropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaStringMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsSummaryProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	for _, elem := range this.properties {
-		child := elem.JSONLDContext()
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		for k, v := range child {
-			m[k] = v
-		}
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -489,7 +518,7 @@ func (this ActivityStreamsSummaryProperty) KindIndex(idx int) int {
 
 // Len returns the number of values that exist for the "summary" property.
 func (this ActivityStreamsSummaryProperty) Len() (length int) {
-	return len(this.properties)
+	return this.properties.Len()
 }
 
 // Less computes whether another property is less than this one. Mixing types
@@ -522,20 +551,7 @@ func (this ActivityStreamsSummaryProperty) Less(i, j int) bool {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsSummaryProperty) LessThan(o vocab.ActivityStreamsSummaryProperty) bool {
-	l1 := this.Len()
-	l2 := o.Len()
-	l := l1
-	if l2 < l1 {
-		l = l2
-	}
-	for i := 0; i < l; i++ {
-		if this.properties[i].LessThan(o.At(i)) {
-			return true
-		} else if o.At(i).LessThan(this.properties[i]) {
-			return false
-		}
-	}
-	return l1 < l2
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property ("summary") with any alias.
@@ -550,57 +566,48 @@ func (this ActivityStreamsSummaryProperty) Name() string {
 // PrependIRI prepends an IRI value to the front of a list of the property
 // "summary".
 func (this *ActivityStreamsSummaryProperty) PrependIRI(v *url.URL) {
-	this.properties = append([]*ActivityStreamsSummaryPropertyIterator{{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  0,
-		parent: this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(&ActivityStreamsSummaryPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // PrependRDFLangString prepends a langString value to the front of a list of the
-// property "summary". Invalidates all iterators.
+// property "summary".
 func (this *ActivityStreamsSummaryProperty) PrependRDFLangString(v map[string]string) {
-	this.properties = append([]*ActivityStreamsSummaryPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsSummaryPropertyIterator{
 		alias:               this.alias,
-		myIdx:               0,
-		parent:              this,
 		rdfLangStringMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependXMLSchemaString prepends a string value to the front of a list of the
-// property "summary". Invalidates all iterators.
+// property "summary".
 func (this *ActivityStreamsSummaryProperty) PrependXMLSchemaString(v string) {
-	this.properties = append([]*ActivityStreamsSummaryPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsSummaryPropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 0,
-		parent:                this,
 		xmlschemaStringMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
+	})
+}
+
+// Release returns this property and its values to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsSummaryProperty) Release() {
+	for i, elem := range this.properties {
+		elem.Release()
+		this.properties[i] = nil
 	}
+	*this = ActivityStreamsSummaryProperty{properties: this.properties[:0]}
+	poolActivityStreamsSummaryProperty.Put(this)
 }
 
 // Remove deletes an element at the specified index from a list of the property
 // "summary", regardless of its type. Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ActivityStreamsSummaryProperty) Remove(idx int) {
-	(this.properties)[idx].parent = nil
-	copy((this.properties)[idx:], (this.properties)[idx+1:])
-	(this.properties)[len(this.properties)-1] = &ActivityStreamsSummaryPropertyIterator{}
-	this.properties = (this.properties)[:len(this.properties)-1]
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Remove(idx)
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -626,43 +633,53 @@ func (this ActivityStreamsSummaryProperty) Serialize() (interface{}, error) {
 // SetIRI sets an IRI value to be at the specified index for the property
 // "summary". Panics if the index is out of bounds.
 func (this *ActivityStreamsSummaryProperty) SetIRI(idx int, v *url.URL) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsSummaryPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
+	this.properties.Set(idx, &ActivityStreamsSummaryPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // SetRDFLangString sets a langString value to be at the specified index for the
-// property "summary". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "summary". Panics if the index is out of bounds.
 func (this *ActivityStreamsSummaryProperty) SetRDFLangString(idx int, v map[string]string) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsSummaryPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsSummaryPropertyIterator{
 		alias:               this.alias,
-		myIdx:               idx,
-		parent:              this,
 		rdfLangStringMember: v,
-	}
+	})
 }
 
 // SetXMLSchemaString sets a string value to be at the specified index for the
-// property "summary". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "summary". Panics if the index is out of bounds.
 func (this *ActivityStreamsSummaryProperty) SetXMLSchemaString(idx int, v string) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsSummaryPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsSummaryPropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaStringMember: v,
-	}
+	})
 }
 
 // Swap swaps the location of values at two indices for the "summary" property.
 func (this ActivityStreamsSummaryProperty) Swap(i, j int) {
-	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+	this.properties.Swap(i, j)
+}
+
+// Values returns the iterators of all values from front to back, so they can be
+// ranged over. Adding values to or removing them from this property
+// afterwards does not change the returned slice.
+func (this ActivityStreamsSummaryProperty) Values() []vocab.ActivityStreamsSummaryPropertyIterator {
+	values := make([]vocab.ActivityStreamsSummaryPropertyIterator, len(this.properties))
+	for i, elem := range this.properties {
+		values[i] = elem
+	}
+	return values
 }
+
+// poolActivityStreamsSummaryProperty holds the released values of ActivityStreamsSummaryProperty, which are reused when deserializing.
+var poolActivityStreamsSummaryProperty = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsSummaryProperty{}
+}}
+
+// poolActivityStreamsSummaryPropertyIterator holds the released values of ActivityStreamsSummaryPropertyIterator, which are reused when deserializing.
+var poolActivityStreamsSummaryPropertyIterator = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsSummaryPropertyIterator{}
+}}
@@ -4,8 +4,11 @@ package propertyto
 
 import (
 	"fmt"
+	proplist "github.com/go-fed/activity/streams/impl/proplist"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ActivityStreamsToPropertyIterator is an iterator for a property. It is
@@ -34,10 +37,12 @@ type ActivityStreamsToPropertyIterator struct {
 	activitystreamsDislikeMember               vocab.ActivityStreamsDislike
 	activitystreamsDocumentMember              vocab.ActivityStreamsDocument
 	tootEmojiMember                            vocab.TootEmoji
+	litepubEmojiReactMember                    vocab.LitepubEmojiReact
 	activitystreamsEventMember                 vocab.ActivityStreamsEvent
 	activitystreamsFlagMember                  vocab.ActivityStreamsFlag
 	activitystreamsFollowMember                vocab.ActivityStreamsFollow
 	activitystreamsGroupMember                 vocab.ActivityStreamsGroup
+	activitystreamsHashtagMember               vocab.ActivityStreamsHashtag
 	tootIdentityProofMember                    vocab.TootIdentityProof
 	activitystreamsIgnoreMember                vocab.ActivityStreamsIgnore
 	activitystreamsImageMember                 vocab.ActivityStreamsImage
@@ -58,6 +63,7 @@ type ActivityStreamsToPropertyIterator struct {
 	activitystreamsPersonMember                vocab.ActivityStreamsPerson
 	activitystreamsPlaceMember                 vocab.ActivityStreamsPlace
 	activitystreamsProfileMember               vocab.ActivityStreamsProfile
+	schemaPropertyValueMember                  vocab.SchemaPropertyValue
 	forgefedPushMember                         vocab.ForgeFedPush
 	activitystreamsQuestionMember              vocab.ActivityStreamsQuestion
 	activitystreamsReadMember                  vocab.ActivityStreamsRead
@@ -79,8 +85,7 @@ type ActivityStreamsToPropertyIterator struct {
 	unknown                                    interface{}
 	iri                                        *url.URL
 	alias                                      string
-	myIdx                                      int
-	parent                                     vocab.ActivityStreamsToProperty
+	proplist.Links[*ActivityStreamsToPropertyIterator]
 }
 
 // NewActivityStreamsToPropertyIterator creates a new ActivityStreamsTo property.
@@ -100,7 +105,8 @@ func deserializeActivityStreamsToPropertyIterator(i interface{}, aliasMap map[st
 		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 		if err == nil && len(u.Scheme) > 0 {
-			this := &ActivityStreamsToPropertyIterator{
+			this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+			*this = ActivityStreamsToPropertyIterator{
 				alias: alias,
 				iri:   u,
 			}
@@ -108,385 +114,1815 @@ func deserializeActivityStreamsToPropertyIterator(i interface{}, aliasMap map[st
 		}
 	}
 	if m, ok := i.(map[string]interface{}); ok {
-		if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsObjectMember: v,
-				alias:                       alias,
+		// Begin: Determine the types of the value, to deserialize it as them first
+		typeValue, hasType := m["type"]
+		isType := func(vocabURI, name string) bool {
+			aliasPrefix := ""
+			if a, ok := aliasMap[vocabURI]; ok {
+				aliasPrefix = a + ":"
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsLinkMember: v,
-				alias:                     alias,
+			switch t := typeValue.(type) {
+			case string:
+				return strings.TrimPrefix(t, aliasPrefix) == name
+			case []interface{}:
+				for _, elem := range t {
+					if s, ok := elem.(string); ok && strings.TrimPrefix(s, aliasPrefix) == name {
+						return true
+					}
+				}
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsAcceptMember: v,
-				alias:                       alias,
+			return false
+		}
+		// End: Determine the types of the value, to deserialize it as them first
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Object") {
+			if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsObjectMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsActivityMember: v,
-				alias:                         alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Link") {
+			if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsLinkMember: v,
+					alias:                     alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsAddMember: v,
-				alias:                    alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Accept") {
+			if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsAcceptMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsAnnounceMember: v,
-				alias:                         alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Activity") {
+			if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsActivityMember: v,
+					alias:                         alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsApplicationMember: v,
-				alias:                            alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Add") {
+			if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsAddMember: v,
+					alias:                    alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsArriveMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Announce") {
+			if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsAnnounceMember: v,
+					alias:                         alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsArticleMember: v,
-				alias:                        alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Application") {
+			if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsApplicationMember: v,
+					alias:                            alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsAudioMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Arrive") {
+			if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsArriveMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsBlockMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Article") {
+			if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsArticleMember: v,
+					alias:                        alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeBranchForgeFed()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				alias:                alias,
-				forgefedBranchMember: v,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Audio") {
+			if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsAudioMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsCollectionMember: v,
-				alias:                           alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Block") {
+			if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsBlockMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsCollectionPageMember: v,
-				alias:                               alias,
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "Branch") {
+			if v, err := mgr.DeserializeBranchForgeFed()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					alias:                alias,
+					forgefedBranchMember: v,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				alias:                alias,
-				forgefedCommitMember: v,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Collection") {
+			if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsCollectionMember: v,
+					alias:                           alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsCreateMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "CollectionPage") {
+			if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsCollectionPageMember: v,
+					alias:                               alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsDeleteMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "Commit") {
+			if v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					alias:                alias,
+					forgefedCommitMember: v,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsDislikeMember: v,
-				alias:                        alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Create") {
+			if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsCreateMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsDocumentMember: v,
-				alias:                         alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Delete") {
+			if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsDeleteMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeEmojiToot()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				alias:           alias,
-				tootEmojiMember: v,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Dislike") {
+			if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsDislikeMember: v,
+					alias:                        alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsEventMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Document") {
+			if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsDocumentMember: v,
+					alias:                         alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsFlagMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("http://joinmastodon.org/ns", "Emoji") {
+			if v, err := mgr.DeserializeEmojiToot()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					alias:           alias,
+					tootEmojiMember: v,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsFollowMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("http://litepub.social/ns", "EmojiReact") {
+			if v, err := mgr.DeserializeEmojiReactLitepub()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					alias:                   alias,
+					litepubEmojiReactMember: v,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsGroupMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Event") {
+			if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsEventMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeIdentityProofToot()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				alias:                   alias,
-				tootIdentityProofMember: v,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Flag") {
+			if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsFlagMember: v,
+					alias:                     alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsIgnoreMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Follow") {
+			if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsFollowMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsImageMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Group") {
+			if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsGroupMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsIntransitiveActivityMember: v,
-				alias: alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Hashtag") {
+			if v, err := mgr.DeserializeHashtagActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsHashtagMember: v,
+					alias:                        alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsInviteMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("http://joinmastodon.org/ns", "IdentityProof") {
+			if v, err := mgr.DeserializeIdentityProofToot()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					alias:                   alias,
+					tootIdentityProofMember: v,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsJoinMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Ignore") {
+			if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsIgnoreMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsLeaveMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Image") {
+			if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsImageMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsLikeMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "IntransitiveActivity") {
+			if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsIntransitiveActivityMember: v,
+					alias: alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsListenMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Invite") {
+			if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsInviteMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsMentionMember: v,
-				alias:                        alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Join") {
+			if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsJoinMember: v,
+					alias:                     alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsMoveMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Leave") {
+			if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsLeaveMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsNoteMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Like") {
+			if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsLikeMember: v,
+					alias:                     alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsOfferMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Listen") {
+			if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsListenMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsOrderedCollectionMember: v,
-				alias:                                  alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Mention") {
+			if v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsMentionMember: v,
+					alias:                        alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsOrderedCollectionPageMember: v,
-				alias: alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Move") {
+			if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsMoveMember: v,
+					alias:                     alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsOrganizationMember: v,
-				alias:                             alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Note") {
+			if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsNoteMember: v,
+					alias:                     alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsPageMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Offer") {
+			if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsOfferMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsPersonMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "OrderedCollection") {
+			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsOrderedCollectionMember: v,
+					alias:                                  alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsPlaceMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "OrderedCollectionPage") {
+			if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsOrderedCollectionPageMember: v,
+					alias: alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsProfileMember: v,
-				alias:                        alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Organization") {
+			if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsOrganizationMember: v,
+					alias:                             alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializePushForgeFed()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				alias:              alias,
-				forgefedPushMember: v,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Page") {
+			if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsPageMember: v,
+					alias:                     alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsQuestionMember: v,
-				alias:                         alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Person") {
+			if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsPersonMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsReadMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Place") {
+			if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsPlaceMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsRejectMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Profile") {
+			if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsProfileMember: v,
+					alias:                        alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("http://schema.org", "PropertyValue") {
+			if v, err := mgr.DeserializePropertyValueSchema()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					alias:                     alias,
+					schemaPropertyValueMember: v,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "Push") {
+			if v, err := mgr.DeserializePushForgeFed()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					alias:              alias,
+					forgefedPushMember: v,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Question") {
+			if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsQuestionMember: v,
+					alias:                         alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Read") {
+			if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsReadMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Reject") {
+			if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsRejectMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Relationship") {
+			if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsRelationshipMember: v,
+					alias:                             alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Remove") {
+			if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsRemoveMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "Repository") {
+			if v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					alias:                    alias,
+					forgefedRepositoryMember: v,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Service") {
+			if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsServiceMember: v,
+					alias:                        alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "TentativeAccept") {
+			if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsTentativeAcceptMember: v,
+					alias:                                alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "TentativeReject") {
+			if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsTentativeRejectMember: v,
+					alias:                                alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "Ticket") {
+			if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					alias:                alias,
+					forgefedTicketMember: v,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "TicketDependency") {
+			if v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					alias:                          alias,
+					forgefedTicketDependencyMember: v,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Tombstone") {
+			if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsTombstoneMember: v,
+					alias:                          alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Travel") {
+			if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsTravelMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Undo") {
+			if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsUndoMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Update") {
+			if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsUpdateMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Video") {
+			if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsVideoMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "View") {
+			if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+				*this = ActivityStreamsToPropertyIterator{
+					activitystreamsViewMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			}
+		}
+	}
+	this := poolActivityStreamsToPropertyIterator.Get().(*ActivityStreamsToPropertyIterator)
+	*this = ActivityStreamsToPropertyIterator{
+		alias:   alias,
+		unknown: i,
+	}
+	return this, nil
+}
+
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ActivityStreamsToPropertyIterator) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+	if this.IsActivityStreamsObject() {
+		if a, ok := this.activitystreamsObjectMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsObjectMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsLink() {
+		if a, ok := this.activitystreamsLinkMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsLinkMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAccept() {
+		if a, ok := this.activitystreamsAcceptMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAcceptMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsActivity() {
+		if a, ok := this.activitystreamsActivityMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsActivityMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAdd() {
+		if a, ok := this.activitystreamsAddMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAddMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAnnounce() {
+		if a, ok := this.activitystreamsAnnounceMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAnnounceMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsApplication() {
+		if a, ok := this.activitystreamsApplicationMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsApplicationMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsArrive() {
+		if a, ok := this.activitystreamsArriveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsArriveMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsArticle() {
+		if a, ok := this.activitystreamsArticleMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsArticleMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAudio() {
+		if a, ok := this.activitystreamsAudioMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAudioMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsBlock() {
+		if a, ok := this.activitystreamsBlockMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsBlockMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsForgeFedBranch() {
+		if a, ok := this.forgefedBranchMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedBranchMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsCollection() {
+		if a, ok := this.activitystreamsCollectionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsCollectionMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsCollectionPage() {
+		if a, ok := this.activitystreamsCollectionPageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsCollectionPageMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsForgeFedCommit() {
+		if a, ok := this.forgefedCommitMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedCommitMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsCreate() {
+		if a, ok := this.activitystreamsCreateMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsCreateMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsDelete() {
+		if a, ok := this.activitystreamsDeleteMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsDeleteMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsDislike() {
+		if a, ok := this.activitystreamsDislikeMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsDislikeMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsDocument() {
+		if a, ok := this.activitystreamsDocumentMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsDocumentMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsTootEmoji() {
+		if a, ok := this.tootEmojiMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.tootEmojiMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsLitepubEmojiReact() {
+		if a, ok := this.litepubEmojiReactMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.litepubEmojiReactMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsEvent() {
+		if a, ok := this.activitystreamsEventMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsEventMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsFlag() {
+		if a, ok := this.activitystreamsFlagMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsFlagMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsFollow() {
+		if a, ok := this.activitystreamsFollowMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsFollowMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsGroup() {
+		if a, ok := this.activitystreamsGroupMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsGroupMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsHashtag() {
+		if a, ok := this.activitystreamsHashtagMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsHashtagMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsTootIdentityProof() {
+		if a, ok := this.tootIdentityProofMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.tootIdentityProofMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsIgnore() {
+		if a, ok := this.activitystreamsIgnoreMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsIgnoreMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsImage() {
+		if a, ok := this.activitystreamsImageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsImageMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		if a, ok := this.activitystreamsIntransitiveActivityMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsIntransitiveActivityMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsInvite() {
+		if a, ok := this.activitystreamsInviteMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsInviteMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsJoin() {
+		if a, ok := this.activitystreamsJoinMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsJoinMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsLeave() {
+		if a, ok := this.activitystreamsLeaveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsLeaveMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsLike() {
+		if a, ok := this.activitystreamsLikeMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsLikeMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsListen() {
+		if a, ok := this.activitystreamsListenMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsListenMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsMention() {
+		if a, ok := this.activitystreamsMentionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsMentionMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsMove() {
+		if a, ok := this.activitystreamsMoveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsMoveMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsNote() {
+		if a, ok := this.activitystreamsNoteMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsNoteMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOffer() {
+		if a, ok := this.activitystreamsOfferMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOfferMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOrderedCollection() {
+		if a, ok := this.activitystreamsOrderedCollectionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrderedCollectionMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		if a, ok := this.activitystreamsOrderedCollectionPageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrderedCollectionPageMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOrganization() {
+		if a, ok := this.activitystreamsOrganizationMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrganizationMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsPage() {
+		if a, ok := this.activitystreamsPageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsPageMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsPerson() {
+		if a, ok := this.activitystreamsPersonMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsPersonMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsPlace() {
+		if a, ok := this.activitystreamsPlaceMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsPlaceMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsProfile() {
+		if a, ok := this.activitystreamsProfileMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsProfileMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsSchemaPropertyValue() {
+		if a, ok := this.schemaPropertyValueMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.schemaPropertyValueMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsForgeFedPush() {
+		if a, ok := this.forgefedPushMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedPushMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsQuestion() {
+		if a, ok := this.activitystreamsQuestionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsQuestionMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsRead() {
+		if a, ok := this.activitystreamsReadMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsReadMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsReject() {
+		if a, ok := this.activitystreamsRejectMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsRejectMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsRelationshipMember: v,
-				alias:                             alias,
+		}
+	} else if this.IsActivityStreamsRelationship() {
+		if a, ok := this.activitystreamsRelationshipMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsRelationshipMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsRemoveMember: v,
-				alias:                       alias,
+		}
+	} else if this.IsActivityStreamsRemove() {
+		if a, ok := this.activitystreamsRemoveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsRemoveMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				alias:                    alias,
-				forgefedRepositoryMember: v,
+		}
+	} else if this.IsForgeFedRepository() {
+		if a, ok := this.forgefedRepositoryMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedRepositoryMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsServiceMember: v,
-				alias:                        alias,
+		}
+	} else if this.IsActivityStreamsService() {
+		if a, ok := this.activitystreamsServiceMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsServiceMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsTentativeAcceptMember: v,
-				alias:                                alias,
+		}
+	} else if this.IsActivityStreamsTentativeAccept() {
+		if a, ok := this.activitystreamsTentativeAcceptMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTentativeAcceptMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsTentativeRejectMember: v,
-				alias:                                alias,
+		}
+	} else if this.IsActivityStreamsTentativeReject() {
+		if a, ok := this.activitystreamsTentativeRejectMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTentativeRejectMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				alias:                alias,
-				forgefedTicketMember: v,
+		}
+	} else if this.IsForgeFedTicket() {
+		if a, ok := this.forgefedTicketMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedTicketMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				alias:                          alias,
-				forgefedTicketDependencyMember: v,
+		}
+	} else if this.IsForgeFedTicketDependency() {
+		if a, ok := this.forgefedTicketDependencyMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedTicketDependencyMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsTombstoneMember: v,
-				alias:                          alias,
+		}
+	} else if this.IsActivityStreamsTombstone() {
+		if a, ok := this.activitystreamsTombstoneMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTombstoneMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsTravelMember: v,
-				alias:                       alias,
+		}
+	} else if this.IsActivityStreamsTravel() {
+		if a, ok := this.activitystreamsTravelMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTravelMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsUndoMember: v,
-				alias:                     alias,
+		}
+	} else if this.IsActivityStreamsUndo() {
+		if a, ok := this.activitystreamsUndoMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsUndoMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsUpdateMember: v,
-				alias:                       alias,
+		}
+	} else if this.IsActivityStreamsUpdate() {
+		if a, ok := this.activitystreamsUpdateMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsUpdateMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsVideoMember: v,
-				alias:                      alias,
+		}
+	} else if this.IsActivityStreamsVideo() {
+		if a, ok := this.activitystreamsVideoMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsVideoMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsToPropertyIterator{
-				activitystreamsViewMember: v,
-				alias:                     alias,
+		}
+	} else if this.IsActivityStreamsView() {
+		if a, ok := this.activitystreamsViewMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsViewMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
 		}
 	}
-	this := &ActivityStreamsToPropertyIterator{
-		alias:   alias,
-		unknown: i,
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsToPropertyIterator) Compare(o vocab.ActivityStreamsToPropertyIterator) int {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return -1
+	} else if idx1 > idx2 {
+		return 1
+	} else if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject().Compare(o.GetActivityStreamsObject())
+	} else if this.IsActivityStreamsLink() {
+		return this.GetActivityStreamsLink().Compare(o.GetActivityStreamsLink())
+	} else if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept().Compare(o.GetActivityStreamsAccept())
+	} else if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity().Compare(o.GetActivityStreamsActivity())
+	} else if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd().Compare(o.GetActivityStreamsAdd())
+	} else if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce().Compare(o.GetActivityStreamsAnnounce())
+	} else if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().Compare(o.GetActivityStreamsApplication())
+	} else if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive().Compare(o.GetActivityStreamsArrive())
+	} else if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle().Compare(o.GetActivityStreamsArticle())
+	} else if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio().Compare(o.GetActivityStreamsAudio())
+	} else if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock().Compare(o.GetActivityStreamsBlock())
+	} else if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch().Compare(o.GetForgeFedBranch())
+	} else if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection().Compare(o.GetActivityStreamsCollection())
+	} else if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage().Compare(o.GetActivityStreamsCollectionPage())
+	} else if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().Compare(o.GetForgeFedCommit())
+	} else if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate().Compare(o.GetActivityStreamsCreate())
+	} else if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete().Compare(o.GetActivityStreamsDelete())
+	} else if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike().Compare(o.GetActivityStreamsDislike())
+	} else if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument().Compare(o.GetActivityStreamsDocument())
+	} else if this.IsTootEmoji() {
+		return this.GetTootEmoji().Compare(o.GetTootEmoji())
+	} else if this.IsLitepubEmojiReact() {
+		return this.GetLitepubEmojiReact().Compare(o.GetLitepubEmojiReact())
+	} else if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent().Compare(o.GetActivityStreamsEvent())
+	} else if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag().Compare(o.GetActivityStreamsFlag())
+	} else if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow().Compare(o.GetActivityStreamsFollow())
+	} else if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().Compare(o.GetActivityStreamsGroup())
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().Compare(o.GetActivityStreamsHashtag())
+	} else if this.IsTootIdentityProof() {
+		return this.GetTootIdentityProof().Compare(o.GetTootIdentityProof())
+	} else if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore().Compare(o.GetActivityStreamsIgnore())
+	} else if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage().Compare(o.GetActivityStreamsImage())
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity().Compare(o.GetActivityStreamsIntransitiveActivity())
+	} else if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite().Compare(o.GetActivityStreamsInvite())
+	} else if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin().Compare(o.GetActivityStreamsJoin())
+	} else if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave().Compare(o.GetActivityStreamsLeave())
+	} else if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike().Compare(o.GetActivityStreamsLike())
+	} else if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen().Compare(o.GetActivityStreamsListen())
+	} else if this.IsActivityStreamsMention() {
+		return this.GetActivityStreamsMention().Compare(o.GetActivityStreamsMention())
+	} else if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove().Compare(o.GetActivityStreamsMove())
+	} else if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote().Compare(o.GetActivityStreamsNote())
+	} else if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer().Compare(o.GetActivityStreamsOffer())
+	} else if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection().Compare(o.GetActivityStreamsOrderedCollection())
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage().Compare(o.GetActivityStreamsOrderedCollectionPage())
+	} else if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().Compare(o.GetActivityStreamsOrganization())
+	} else if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage().Compare(o.GetActivityStreamsPage())
+	} else if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().Compare(o.GetActivityStreamsPerson())
+	} else if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace().Compare(o.GetActivityStreamsPlace())
+	} else if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile().Compare(o.GetActivityStreamsProfile())
+	} else if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue().Compare(o.GetSchemaPropertyValue())
+	} else if this.IsForgeFedPush() {
+		return this.GetForgeFedPush().Compare(o.GetForgeFedPush())
+	} else if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion().Compare(o.GetActivityStreamsQuestion())
+	} else if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead().Compare(o.GetActivityStreamsRead())
+	} else if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject().Compare(o.GetActivityStreamsReject())
+	} else if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship().Compare(o.GetActivityStreamsRelationship())
+	} else if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove().Compare(o.GetActivityStreamsRemove())
+	} else if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().Compare(o.GetForgeFedRepository())
+	} else if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().Compare(o.GetActivityStreamsService())
+	} else if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept().Compare(o.GetActivityStreamsTentativeAccept())
+	} else if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject().Compare(o.GetActivityStreamsTentativeReject())
+	} else if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().Compare(o.GetForgeFedTicket())
+	} else if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().Compare(o.GetForgeFedTicketDependency())
+	} else if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone().Compare(o.GetActivityStreamsTombstone())
+	} else if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel().Compare(o.GetActivityStreamsTravel())
+	} else if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo().Compare(o.GetActivityStreamsUndo())
+	} else if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate().Compare(o.GetActivityStreamsUpdate())
+	} else if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo().Compare(o.GetActivityStreamsVideo())
+	} else if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView().Compare(o.GetActivityStreamsView())
+	} else if this.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
 	}
-	return this, nil
+	return 0
 }
 
 // GetActivityStreamsAccept returns the value of this property. When
@@ -622,6 +2058,13 @@ func (this ActivityStreamsToPropertyIterator) GetActivityStreamsGroup() vocab.Ac
 	return this.activitystreamsGroupMember
 }
 
+// GetActivityStreamsHashtag returns the value of this property. When
+// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag will
+// return an arbitrary value.
+func (this ActivityStreamsToPropertyIterator) GetActivityStreamsHashtag() vocab.ActivityStreamsHashtag {
+	return this.activitystreamsHashtagMember
+}
+
 // GetActivityStreamsIgnore returns the value of this property. When
 // IsActivityStreamsIgnore returns false, GetActivityStreamsIgnore will return
 // an arbitrary value.
@@ -911,6 +2354,20 @@ func (this ActivityStreamsToPropertyIterator) GetIRI() *url.URL {
 	return this.iri
 }
 
+// GetLitepubEmojiReact returns the value of this property. When
+// IsLitepubEmojiReact returns false, GetLitepubEmojiReact will return an
+// arbitrary value.
+func (this ActivityStreamsToPropertyIterator) GetLitepubEmojiReact() vocab.LitepubEmojiReact {
+	return this.litepubEmojiReactMember
+}
+
+// GetSchemaPropertyValue returns the value of this property. When
+// IsSchemaPropertyValue returns false, GetSchemaPropertyValue will return an
+// arbitrary value.
+func (this ActivityStreamsToPropertyIterator) GetSchemaPropertyValue() vocab.SchemaPropertyValue {
+	return this.schemaPropertyValueMember
+}
+
 // GetTootEmoji returns the value of this property. When IsTootEmoji returns
 // false, GetTootEmoji will return an arbitrary value.
 func (this ActivityStreamsToPropertyIterator) GetTootEmoji() vocab.TootEmoji {
@@ -987,6 +2444,9 @@ func (this ActivityStreamsToPropertyIterator) GetType() vocab.Type {
 	if this.IsTootEmoji() {
 		return this.GetTootEmoji()
 	}
+	if this.IsLitepubEmojiReact() {
+		return this.GetLitepubEmojiReact()
+	}
 	if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent()
 	}
@@ -999,6 +2459,9 @@ func (this ActivityStreamsToPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup()
 	}
+	if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag()
+	}
 	if this.IsTootIdentityProof() {
 		return this.GetTootIdentityProof()
 	}
@@ -1059,6 +2522,9 @@ func (this ActivityStreamsToPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile()
 	}
+	if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue()
+	}
 	if this.IsForgeFedPush() {
 		return this.GetForgeFedPush()
 	}
@@ -1139,10 +2605,12 @@ func (this ActivityStreamsToPropertyIterator) HasAny() bool {
 		this.IsActivityStreamsDislike() ||
 		this.IsActivityStreamsDocument() ||
 		this.IsTootEmoji() ||
+		this.IsLitepubEmojiReact() ||
 		this.IsActivityStreamsEvent() ||
 		this.IsActivityStreamsFlag() ||
 		this.IsActivityStreamsFollow() ||
 		this.IsActivityStreamsGroup() ||
+		this.IsActivityStreamsHashtag() ||
 		this.IsTootIdentityProof() ||
 		this.IsActivityStreamsIgnore() ||
 		this.IsActivityStreamsImage() ||
@@ -1163,6 +2631,7 @@ func (this ActivityStreamsToPropertyIterator) HasAny() bool {
 		this.IsActivityStreamsPerson() ||
 		this.IsActivityStreamsPlace() ||
 		this.IsActivityStreamsProfile() ||
+		this.IsSchemaPropertyValue() ||
 		this.IsForgeFedPush() ||
 		this.IsActivityStreamsQuestion() ||
 		this.IsActivityStreamsRead() ||
@@ -1317,6 +2786,13 @@ func (this ActivityStreamsToPropertyIterator) IsActivityStreamsGroup() bool {
 	return this.activitystreamsGroupMember != nil
 }
 
+// IsActivityStreamsHashtag returns true if this property has a type of "Hashtag".
+// When true, use the GetActivityStreamsHashtag and SetActivityStreamsHashtag
+// methods to access and set this property.
+func (this ActivityStreamsToPropertyIterator) IsActivityStreamsHashtag() bool {
+	return this.activitystreamsHashtagMember != nil
+}
+
 // IsActivityStreamsIgnore returns true if this property has a type of "Ignore".
 // When true, use the GetActivityStreamsIgnore and SetActivityStreamsIgnore
 // methods to access and set this property.
@@ -1615,6 +3091,20 @@ func (this ActivityStreamsToPropertyIterator) IsIRI() bool {
 	return this.iri != nil
 }
 
+// IsLitepubEmojiReact returns true if this property has a type of "EmojiReact".
+// When true, use the GetLitepubEmojiReact and SetLitepubEmojiReact methods to
+// access and set this property.
+func (this ActivityStreamsToPropertyIterator) IsLitepubEmojiReact() bool {
+	return this.litepubEmojiReactMember != nil
+}
+
+// IsSchemaPropertyValue returns true if this property has a type of
+// "PropertyValue". When true, use the GetSchemaPropertyValue and
+// SetSchemaPropertyValue methods to access and set this property.
+func (this ActivityStreamsToPropertyIterator) IsSchemaPropertyValue() bool {
+	return this.schemaPropertyValueMember != nil
+}
+
 // IsTootEmoji returns true if this property has a type of "Emoji". When true, use
 // the GetTootEmoji and SetTootEmoji methods to access and set this property.
 func (this ActivityStreamsToPropertyIterator) IsTootEmoji() bool {
@@ -1632,141 +3122,8 @@ func (this ActivityStreamsToPropertyIterator) IsTootIdentityProof() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsToPropertyIterator) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	var child map[string]string
-	if this.IsActivityStreamsObject() {
-		child = this.GetActivityStreamsObject().JSONLDContext()
-	} else if this.IsActivityStreamsLink() {
-		child = this.GetActivityStreamsLink().JSONLDContext()
-	} else if this.IsActivityStreamsAccept() {
-		child = this.GetActivityStreamsAccept().JSONLDContext()
-	} else if this.IsActivityStreamsActivity() {
-		child = this.GetActivityStreamsActivity().JSONLDContext()
-	} else if this.IsActivityStreamsAdd() {
-		child = this.GetActivityStreamsAdd().JSONLDContext()
-	} else if this.IsActivityStreamsAnnounce() {
-		child = this.GetActivityStreamsAnnounce().JSONLDContext()
-	} else if this.IsActivityStreamsApplication() {
-		child = this.GetActivityStreamsApplication().JSONLDContext()
-	} else if this.IsActivityStreamsArrive() {
-		child = this.GetActivityStreamsArrive().JSONLDContext()
-	} else if this.IsActivityStreamsArticle() {
-		child = this.GetActivityStreamsArticle().JSONLDContext()
-	} else if this.IsActivityStreamsAudio() {
-		child = this.GetActivityStreamsAudio().JSONLDContext()
-	} else if this.IsActivityStreamsBlock() {
-		child = this.GetActivityStreamsBlock().JSONLDContext()
-	} else if this.IsForgeFedBranch() {
-		child = this.GetForgeFedBranch().JSONLDContext()
-	} else if this.IsActivityStreamsCollection() {
-		child = this.GetActivityStreamsCollection().JSONLDContext()
-	} else if this.IsActivityStreamsCollectionPage() {
-		child = this.GetActivityStreamsCollectionPage().JSONLDContext()
-	} else if this.IsForgeFedCommit() {
-		child = this.GetForgeFedCommit().JSONLDContext()
-	} else if this.IsActivityStreamsCreate() {
-		child = this.GetActivityStreamsCreate().JSONLDContext()
-	} else if this.IsActivityStreamsDelete() {
-		child = this.GetActivityStreamsDelete().JSONLDContext()
-	} else if this.IsActivityStreamsDislike() {
-		child = this.GetActivityStreamsDislike().JSONLDContext()
-	} else if this.IsActivityStreamsDocument() {
-		child = this.GetActivityStreamsDocument().JSONLDContext()
-	} else if this.IsTootEmoji() {
-		child = this.GetTootEmoji().JSONLDContext()
-	} else if this.IsActivityStreamsEvent() {
-		child = this.GetActivityStreamsEvent().JSONLDContext()
-	} else if this.IsActivityStreamsFlag() {
-		child = this.GetActivityStreamsFlag().JSONLDContext()
-	} else if this.IsActivityStreamsFollow() {
-		child = this.GetActivityStreamsFollow().JSONLDContext()
-	} else if this.IsActivityStreamsGroup() {
-		child = this.GetActivityStreamsGroup().JSONLDContext()
-	} else if this.IsTootIdentityProof() {
-		child = this.GetTootIdentityProof().JSONLDContext()
-	} else if this.IsActivityStreamsIgnore() {
-		child = this.GetActivityStreamsIgnore().JSONLDContext()
-	} else if this.IsActivityStreamsImage() {
-		child = this.GetActivityStreamsImage().JSONLDContext()
-	} else if this.IsActivityStreamsIntransitiveActivity() {
-		child = this.GetActivityStreamsIntransitiveActivity().JSONLDContext()
-	} else if this.IsActivityStreamsInvite() {
-		child = this.GetActivityStreamsInvite().JSONLDContext()
-	} else if this.IsActivityStreamsJoin() {
-		child = this.GetActivityStreamsJoin().JSONLDContext()
-	} else if this.IsActivityStreamsLeave() {
-		child = this.GetActivityStreamsLeave().JSONLDContext()
-	} else if this.IsActivityStreamsLike() {
-		child = this.GetActivityStreamsLike().JSONLDContext()
-	} else if this.IsActivityStreamsListen() {
-		child = this.GetActivityStreamsListen().JSONLDContext()
-	} else if this.IsActivityStreamsMention() {
-		child = this.GetActivityStreamsMention().JSONLDContext()
-	} else if this.IsActivityStreamsMove() {
-		child = this.GetActivityStreamsMove().JSONLDContext()
-	} else if this.IsActivityStreamsNote() {
-		child = this.GetActivityStreamsNote().JSONLDContext()
-	} else if this.IsActivityStreamsOffer() {
-		child = this.GetActivityStreamsOffer().JSONLDContext()
-	} else if this.IsActivityStreamsOrderedCollection() {
-		child = this.GetActivityStreamsOrderedCollection().JSONLDContext()
-	} else if this.IsActivityStreamsOrderedCollectionPage() {
-		child = this.GetActivityStreamsOrderedCollectionPage().JSONLDContext()
-	} else if this.IsActivityStreamsOrganization() {
-		child = this.GetActivityStreamsOrganization().JSONLDContext()
-	} else if this.IsActivityStreamsPage() {
-		child = this.GetActivityStreamsPage().JSONLDContext()
-	} else if this.IsActivityStreamsPerson() {
-		child = this.GetActivityStreamsPerson().JSONLDContext()
-	} else if this.IsActivityStreamsPlace() {
-		child = this.GetActivityStreamsPlace().JSONLDContext()
-	} else if this.IsActivityStreamsProfile() {
-		child = this.GetActivityStreamsProfile().JSONLDContext()
-	} else if this.IsForgeFedPush() {
-		child = this.GetForgeFedPush().JSONLDContext()
-	} else if this.IsActivityStreamsQuestion() {
-		child = this.GetActivityStreamsQuestion().JSONLDContext()
-	} else if this.IsActivityStreamsRead() {
-		child = this.GetActivityStreamsRead().JSONLDContext()
-	} else if this.IsActivityStreamsReject() {
-		child = this.GetActivityStreamsReject().JSONLDContext()
-	} else if this.IsActivityStreamsRelationship() {
-		child = this.GetActivityStreamsRelationship().JSONLDContext()
-	} else if this.IsActivityStreamsRemove() {
-		child = this.GetActivityStreamsRemove().JSONLDContext()
-	} else if this.IsForgeFedRepository() {
-		child = this.GetForgeFedRepository().JSONLDContext()
-	} else if this.IsActivityStreamsService() {
-		child = this.GetActivityStreamsService().JSONLDContext()
-	} else if this.IsActivityStreamsTentativeAccept() {
-		child = this.GetActivityStreamsTentativeAccept().JSONLDContext()
-	} else if this.IsActivityStreamsTentativeReject() {
-		child = this.GetActivityStreamsTentativeReject().JSONLDContext()
-	} else if this.IsForgeFedTicket() {
-		child = this.GetForgeFedTicket().JSONLDContext()
-	} else if this.IsForgeFedTicketDependency() {
-		child = this.GetForgeFedTicketDependency().JSONLDContext()
-	} else if this.IsActivityStreamsTombstone() {
-		child = this.GetActivityStreamsTombstone().JSONLDContext()
-	} else if this.IsActivityStreamsTravel() {
-		child = this.GetActivityStreamsTravel().JSONLDContext()
-	} else if this.IsActivityStreamsUndo() {
-		child = this.GetActivityStreamsUndo().JSONLDContext()
-	} else if this.IsActivityStreamsUpdate() {
-		child = this.GetActivityStreamsUpdate().JSONLDContext()
-	} else if this.IsActivityStreamsVideo() {
-		child = this.GetActivityStreamsVideo().JSONLDContext()
-	} else if this.IsActivityStreamsView() {
-		child = this.GetActivityStreamsView().JSONLDContext()
-	}
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -1834,131 +3191,140 @@ func (this ActivityStreamsToPropertyIterator) KindIndex() int {
 	if this.IsTootEmoji() {
 		return 19
 	}
-	if this.IsActivityStreamsEvent() {
+	if this.IsLitepubEmojiReact() {
 		return 20
 	}
-	if this.IsActivityStreamsFlag() {
+	if this.IsActivityStreamsEvent() {
 		return 21
 	}
-	if this.IsActivityStreamsFollow() {
+	if this.IsActivityStreamsFlag() {
 		return 22
 	}
-	if this.IsActivityStreamsGroup() {
+	if this.IsActivityStreamsFollow() {
 		return 23
 	}
-	if this.IsTootIdentityProof() {
+	if this.IsActivityStreamsGroup() {
 		return 24
 	}
-	if this.IsActivityStreamsIgnore() {
+	if this.IsActivityStreamsHashtag() {
 		return 25
 	}
-	if this.IsActivityStreamsImage() {
+	if this.IsTootIdentityProof() {
 		return 26
 	}
-	if this.IsActivityStreamsIntransitiveActivity() {
+	if this.IsActivityStreamsIgnore() {
 		return 27
 	}
-	if this.IsActivityStreamsInvite() {
+	if this.IsActivityStreamsImage() {
 		return 28
 	}
-	if this.IsActivityStreamsJoin() {
+	if this.IsActivityStreamsIntransitiveActivity() {
 		return 29
 	}
-	if this.IsActivityStreamsLeave() {
+	if this.IsActivityStreamsInvite() {
 		return 30
 	}
-	if this.IsActivityStreamsLike() {
+	if this.IsActivityStreamsJoin() {
 		return 31
 	}
-	if this.IsActivityStreamsListen() {
+	if this.IsActivityStreamsLeave() {
 		return 32
 	}
-	if this.IsActivityStreamsMention() {
+	if this.IsActivityStreamsLike() {
 		return 33
 	}
-	if this.IsActivityStreamsMove() {
+	if this.IsActivityStreamsListen() {
 		return 34
 	}
-	if this.IsActivityStreamsNote() {
+	if this.IsActivityStreamsMention() {
 		return 35
 	}
-	if this.IsActivityStreamsOffer() {
+	if this.IsActivityStreamsMove() {
 		return 36
 	}
-	if this.IsActivityStreamsOrderedCollection() {
+	if this.IsActivityStreamsNote() {
 		return 37
 	}
+	if this.IsActivityStreamsOffer() {
+		return 38
+	}
+	if this.IsActivityStreamsOrderedCollection() {
+		return 39
+	}
 	if this.IsActivityStreamsOrderedCollectionPage() {
-		return 38
+		return 40
 	}
 	if this.IsActivityStreamsOrganization() {
-		return 39
+		return 41
 	}
 	if this.IsActivityStreamsPage() {
-		return 40
+		return 42
 	}
 	if this.IsActivityStreamsPerson() {
-		return 41
+		return 43
 	}
 	if this.IsActivityStreamsPlace() {
-		return 42
+		return 44
 	}
 	if this.IsActivityStreamsProfile() {
-		return 43
+		return 45
+	}
+	if this.IsSchemaPropertyValue() {
+		return 46
 	}
 	if this.IsForgeFedPush() {
-		return 44
+		return 47
 	}
 	if this.IsActivityStreamsQuestion() {
-		return 45
+		return 48
 	}
 	if this.IsActivityStreamsRead() {
-		return 46
+		return 49
 	}
 	if this.IsActivityStreamsReject() {
-		return 47
+		return 50
 	}
 	if this.IsActivityStreamsRelationship() {
-		return 48
+		return 51
 	}
 	if this.IsActivityStreamsRemove() {
-		return 49
+		return 52
 	}
 	if this.IsForgeFedRepository() {
-		return 50
+		return 53
 	}
 	if this.IsActivityStreamsService() {
-		return 51
+		return 54
 	}
 	if this.IsActivityStreamsTentativeAccept() {
-		return 52
+		return 55
 	}
 	if this.IsActivityStreamsTentativeReject() {
-		return 53
+		return 56
 	}
 	if this.IsForgeFedTicket() {
-		return 54
+		return 57
 	}
 	if this.IsForgeFedTicketDependency() {
-		return 55
+		return 58
 	}
 	if this.IsActivityStreamsTombstone() {
-		return 56
+		return 59
 	}
 	if this.IsActivityStreamsTravel() {
-		return 57
+		return 60
 	}
 	if this.IsActivityStreamsUndo() {
-		return 58
+		return 61
 	}
 	if this.IsActivityStreamsUpdate() {
-		return 59
+		return 62
 	}
 	if this.IsActivityStreamsVideo() {
-		return 60
+		return 63
 	}
 	if this.IsActivityStreamsView() {
-		return 61
+		return 64
 	}
 	if this.IsIRI() {
 		return -2
@@ -1971,140 +3337,7 @@ func (this ActivityStreamsToPropertyIterator) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsToPropertyIterator) LessThan(o vocab.ActivityStreamsToPropertyIterator) bool {
-	idx1 := this.KindIndex()
-	idx2 := o.KindIndex()
-	if idx1 < idx2 {
-		return true
-	} else if idx1 > idx2 {
-		return false
-	} else if this.IsActivityStreamsObject() {
-		return this.GetActivityStreamsObject().LessThan(o.GetActivityStreamsObject())
-	} else if this.IsActivityStreamsLink() {
-		return this.GetActivityStreamsLink().LessThan(o.GetActivityStreamsLink())
-	} else if this.IsActivityStreamsAccept() {
-		return this.GetActivityStreamsAccept().LessThan(o.GetActivityStreamsAccept())
-	} else if this.IsActivityStreamsActivity() {
-		return this.GetActivityStreamsActivity().LessThan(o.GetActivityStreamsActivity())
-	} else if this.IsActivityStreamsAdd() {
-		return this.GetActivityStreamsAdd().LessThan(o.GetActivityStreamsAdd())
-	} else if this.IsActivityStreamsAnnounce() {
-		return this.GetActivityStreamsAnnounce().LessThan(o.GetActivityStreamsAnnounce())
-	} else if this.IsActivityStreamsApplication() {
-		return this.GetActivityStreamsApplication().LessThan(o.GetActivityStreamsApplication())
-	} else if this.IsActivityStreamsArrive() {
-		return this.GetActivityStreamsArrive().LessThan(o.GetActivityStreamsArrive())
-	} else if this.IsActivityStreamsArticle() {
-		return this.GetActivityStreamsArticle().LessThan(o.GetActivityStreamsArticle())
-	} else if this.IsActivityStreamsAudio() {
-		return this.GetActivityStreamsAudio().LessThan(o.GetActivityStreamsAudio())
-	} else if this.IsActivityStreamsBlock() {
-		return this.GetActivityStreamsBlock().LessThan(o.GetActivityStreamsBlock())
-	} else if this.IsForgeFedBranch() {
-		return this.GetForgeFedBranch().LessThan(o.GetForgeFedBranch())
-	} else if this.IsActivityStreamsCollection() {
-		return this.GetActivityStreamsCollection().LessThan(o.GetActivityStreamsCollection())
-	} else if this.IsActivityStreamsCollectionPage() {
-		return this.GetActivityStreamsCollectionPage().LessThan(o.GetActivityStreamsCollectionPage())
-	} else if this.IsForgeFedCommit() {
-		return this.GetForgeFedCommit().LessThan(o.GetForgeFedCommit())
-	} else if this.IsActivityStreamsCreate() {
-		return this.GetActivityStreamsCreate().LessThan(o.GetActivityStreamsCreate())
-	} else if this.IsActivityStreamsDelete() {
-		return this.GetActivityStreamsDelete().LessThan(o.GetActivityStreamsDelete())
-	} else if this.IsActivityStreamsDislike() {
-		return this.GetActivityStreamsDislike().LessThan(o.GetActivityStreamsDislike())
-	} else if this.IsActivityStreamsDocument() {
-		return this.GetActivityStreamsDocument().LessThan(o.GetActivityStreamsDocument())
-	} else if this.IsTootEmoji() {
-		return this.GetTootEmoji().LessThan(o.GetTootEmoji())
-	} else if this.IsActivityStreamsEvent() {
-		return this.GetActivityStreamsEvent().LessThan(o.GetActivityStreamsEvent())
-	} else if this.IsActivityStreamsFlag() {
-		return this.GetActivityStreamsFlag().LessThan(o.GetActivityStreamsFlag())
-	} else if this.IsActivityStreamsFollow() {
-		return this.GetActivityStreamsFollow().LessThan(o.GetActivityStreamsFollow())
-	} else if this.IsActivityStreamsGroup() {
-		return this.GetActivityStreamsGroup().LessThan(o.GetActivityStreamsGroup())
-	} else if this.IsTootIdentityProof() {
-		return this.GetTootIdentityProof().LessThan(o.GetTootIdentityProof())
-	} else if this.IsActivityStreamsIgnore() {
-		return this.GetActivityStreamsIgnore().LessThan(o.GetActivityStreamsIgnore())
-	} else if this.IsActivityStreamsImage() {
-		return this.GetActivityStreamsImage().LessThan(o.GetActivityStreamsImage())
-	} else if this.IsActivityStreamsIntransitiveActivity() {
-		return this.GetActivityStreamsIntransitiveActivity().LessThan(o.GetActivityStreamsIntransitiveActivity())
-	} else if this.IsActivityStreamsInvite() {
-		return this.GetActivityStreamsInvite().LessThan(o.GetActivityStreamsInvite())
-	} else if this.IsActivityStreamsJoin() {
-		return this.GetActivityStreamsJoin().LessThan(o.GetActivityStreamsJoin())
-	} else if this.IsActivityStreamsLeave() {
-		return this.GetActivityStreamsLeave().LessThan(o.GetActivityStreamsLeave())
-	} else if this.IsActivityStreamsLike() {
-		return this.GetActivityStreamsLike().LessThan(o.GetActivityStreamsLike())
-	} else if this.IsActivityStreamsListen() {
-		return this.GetActivityStreamsListen().LessThan(o.GetActivityStreamsListen())
-	} else if this.IsActivityStreamsMention() {
-		return this.GetActivityStreamsMention().LessThan(o.GetActivityStreamsMention())
-	} else if this.IsActivityStreamsMove() {
-		return this.GetActivityStreamsMove().LessThan(o.GetActivityStreamsMove())
-	} else if this.IsActivityStreamsNote() {
-		return this.GetActivityStreamsNote().LessThan(o.GetActivityStreamsNote())
-	} else if this.IsActivityStreamsOffer() {
-		return this.GetActivityStreamsOffer().LessThan(o.GetActivityStreamsOffer())
-	} else if this.IsActivityStreamsOrderedCollection() {
-		return this.GetActivityStreamsOrderedCollection().LessThan(o.GetActivityStreamsOrderedCollection())
-	} else if this.IsActivityStreamsOrderedCollectionPage() {
-		return this.GetActivityStreamsOrderedCollectionPage().LessThan(o.GetActivityStreamsOrderedCollectionPage())
-	} else if this.IsActivityStreamsOrganization() {
-		return this.GetActivityStreamsOrganization().LessThan(o.GetActivityStreamsOrganization())
-	} else if this.IsActivityStreamsPage() {
-		return this.GetActivityStreamsPage().LessThan(o.GetActivityStreamsPage())
-	} else if this.IsActivityStreamsPerson() {
-		return this.GetActivityStreamsPerson().LessThan(o.GetActivityStreamsPerson())
-	} else if this.IsActivityStreamsPlace() {
-		return this.GetActivityStreamsPlace().LessThan(o.GetActivityStreamsPlace())
-	} else if this.IsActivityStreamsProfile() {
-		return this.GetActivityStreamsProfile().LessThan(o.GetActivityStreamsProfile())
-	} else if this.IsForgeFedPush() {
-		return this.GetForgeFedPush().LessThan(o.GetForgeFedPush())
-	} else if this.IsActivityStreamsQuestion() {
-		return this.GetActivityStreamsQuestion().LessThan(o.GetActivityStreamsQuestion())
-	} else if this.IsActivityStreamsRead() {
-		return this.GetActivityStreamsRead().LessThan(o.GetActivityStreamsRead())
-	} else if this.IsActivityStreamsReject() {
-		return this.GetActivityStreamsReject().LessThan(o.GetActivityStreamsReject())
-	} else if this.IsActivityStreamsRelationship() {
-		return this.GetActivityStreamsRelationship().LessThan(o.GetActivityStreamsRelationship())
-	} else if this.IsActivityStreamsRemove() {
-		return this.GetActivityStreamsRemove().LessThan(o.GetActivityStreamsRemove())
-	} else if this.IsForgeFedRepository() {
-		return this.GetForgeFedRepository().LessThan(o.GetForgeFedRepository())
-	} else if this.IsActivityStreamsService() {
-		return this.GetActivityStreamsService().LessThan(o.GetActivityStreamsService())
-	} else if this.IsActivityStreamsTentativeAccept() {
-		return this.GetActivityStreamsTentativeAccept().LessThan(o.GetActivityStreamsTentativeAccept())
-	} else if this.IsActivityStreamsTentativeReject() {
-		return this.GetActivityStreamsTentativeReject().LessThan(o.GetActivityStreamsTentativeReject())
-	} else if this.IsForgeFedTicket() {
-		return this.GetForgeFedTicket().LessThan(o.GetForgeFedTicket())
-	} else if this.IsForgeFedTicketDependency() {
-		return this.GetForgeFedTicketDependency().LessThan(o.GetForgeFedTicketDependency())
-	} else if this.IsActivityStreamsTombstone() {
-		return this.GetActivityStreamsTombstone().LessThan(o.GetActivityStreamsTombstone())
-	} else if this.IsActivityStreamsTravel() {
-		return this.GetActivityStreamsTravel().LessThan(o.GetActivityStreamsTravel())
-	} else if this.IsActivityStreamsUndo() {
-		return this.GetActivityStreamsUndo().LessThan(o.GetActivityStreamsUndo())
-	} else if this.IsActivityStreamsUpdate() {
-		return this.GetActivityStreamsUpdate().LessThan(o.GetActivityStreamsUpdate())
-	} else if this.IsActivityStreamsVideo() {
-		return this.GetActivityStreamsVideo().LessThan(o.GetActivityStreamsVideo())
-	} else if this.IsActivityStreamsView() {
-		return this.GetActivityStreamsView().LessThan(o.GetActivityStreamsView())
-	} else if this.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	}
-	return false
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "ActivityStreamsTo".
@@ -2116,22 +3349,358 @@ func (this ActivityStreamsToPropertyIterator) Name() string {
 	}
 }
 
-// Next returns the next iterator, or nil if there is no next iterator.
+// Next returns the next iterator, or nil if there is no next iterator. It remains
+// correct when values are added to or removed from the property, including
+// this one, without obtaining the iterator again.
 func (this ActivityStreamsToPropertyIterator) Next() vocab.ActivityStreamsToPropertyIterator {
-	if this.myIdx+1 >= this.parent.Len() {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx + 1)
+	if it := proplist.Next(&this); it != nil {
+		return it
 	}
+	return nil
 }
 
-// Prev returns the previous iterator, or nil if there is no previous iterator.
+// Prev returns the previous iterator, or nil if there is no previous iterator. It
+// remains correct when values are added to or removed from the property,
+// including this one, without obtaining the iterator again.
 func (this ActivityStreamsToPropertyIterator) Prev() vocab.ActivityStreamsToPropertyIterator {
-	if this.myIdx-1 < 0 {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx - 1)
+	if it := proplist.Prev(&this); it != nil {
+		return it
 	}
+	return nil
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsToPropertyIterator) Release() {
+	if r, ok := this.activitystreamsObjectMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsLinkMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAcceptMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsActivityMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAddMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAnnounceMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsApplicationMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsArriveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsArticleMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAudioMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsBlockMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedBranchMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsCollectionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsCollectionPageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedCommitMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsCreateMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsDeleteMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsDislikeMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsDocumentMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.tootEmojiMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.litepubEmojiReactMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsEventMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsFlagMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsFollowMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsGroupMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsHashtagMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.tootIdentityProofMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsIgnoreMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsImageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsIntransitiveActivityMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsInviteMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsJoinMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsLeaveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsLikeMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsListenMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsMentionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsMoveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsNoteMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOfferMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOrderedCollectionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOrderedCollectionPageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOrganizationMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsPageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsPersonMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsPlaceMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsProfileMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.schemaPropertyValueMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedPushMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsQuestionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsReadMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsRejectMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsRelationshipMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsRemoveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedRepositoryMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsServiceMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTentativeAcceptMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTentativeRejectMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedTicketMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedTicketDependencyMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTombstoneMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTravelMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsUndoMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsUpdateMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsVideoMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsViewMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	*this = ActivityStreamsToPropertyIterator{}
+	poolActivityStreamsToPropertyIterator.Put(this)
 }
 
 // SetActivityStreamsAccept sets the value of this property. Calling
@@ -2267,6 +3836,13 @@ func (this *ActivityStreamsToPropertyIterator) SetActivityStreamsGroup(v vocab.A
 	this.activitystreamsGroupMember = v
 }
 
+// SetActivityStreamsHashtag sets the value of this property. Calling
+// IsActivityStreamsHashtag afterwards returns true.
+func (this *ActivityStreamsToPropertyIterator) SetActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.clear()
+	this.activitystreamsHashtagMember = v
+}
+
 // SetActivityStreamsIgnore sets the value of this property. Calling
 // IsActivityStreamsIgnore afterwards returns true.
 func (this *ActivityStreamsToPropertyIterator) SetActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
@@ -2560,6 +4136,20 @@ func (this *ActivityStreamsToPropertyIterator) SetIRI(v *url.URL) {
 	this.iri = v
 }
 
+// SetLitepubEmojiReact sets the value of this property. Calling
+// IsLitepubEmojiReact afterwards returns true.
+func (this *ActivityStreamsToPropertyIterator) SetLitepubEmojiReact(v vocab.LitepubEmojiReact) {
+	this.clear()
+	this.litepubEmojiReactMember = v
+}
+
+// SetSchemaPropertyValue sets the value of this property. Calling
+// IsSchemaPropertyValue afterwards returns true.
+func (this *ActivityStreamsToPropertyIterator) SetSchemaPropertyValue(v vocab.SchemaPropertyValue) {
+	this.clear()
+	this.schemaPropertyValueMember = v
+}
+
 // SetTootEmoji sets the value of this property. Calling IsTootEmoji afterwards
 // returns true.
 func (this *ActivityStreamsToPropertyIterator) SetTootEmoji(v vocab.TootEmoji) {
@@ -2657,6 +4247,10 @@ func (this *ActivityStreamsToPropertyIterator) SetType(t vocab.Type) error {
 		this.SetTootEmoji(v)
 		return nil
 	}
+	if v, ok := t.(vocab.LitepubEmojiReact); ok {
+		this.SetLitepubEmojiReact(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsEvent); ok {
 		this.SetActivityStreamsEvent(v)
 		return nil
@@ -2673,6 +4267,10 @@ func (this *ActivityStreamsToPropertyIterator) SetType(t vocab.Type) error {
 		this.SetActivityStreamsGroup(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsHashtag); ok {
+		this.SetActivityStreamsHashtag(v)
+		return nil
+	}
 	if v, ok := t.(vocab.TootIdentityProof); ok {
 		this.SetTootIdentityProof(v)
 		return nil
@@ -2753,6 +4351,10 @@ func (this *ActivityStreamsToPropertyIterator) SetType(t vocab.Type) error {
 		this.SetActivityStreamsProfile(v)
 		return nil
 	}
+	if v, ok := t.(vocab.SchemaPropertyValue); ok {
+		this.SetSchemaPropertyValue(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ForgeFedPush); ok {
 		this.SetForgeFedPush(v)
 		return nil
@@ -2852,10 +4454,12 @@ func (this *ActivityStreamsToPropertyIterator) clear() {
 	this.activitystreamsDislikeMember = nil
 	this.activitystreamsDocumentMember = nil
 	this.tootEmojiMember = nil
+	this.litepubEmojiReactMember = nil
 	this.activitystreamsEventMember = nil
 	this.activitystreamsFlagMember = nil
 	this.activitystreamsFollowMember = nil
 	this.activitystreamsGroupMember = nil
+	this.activitystreamsHashtagMember = nil
 	this.tootIdentityProofMember = nil
 	this.activitystreamsIgnoreMember = nil
 	this.activitystreamsImageMember = nil
@@ -2876,6 +4480,7 @@ func (this *ActivityStreamsToPropertyIterator) clear() {
 	this.activitystreamsPersonMember = nil
 	this.activitystreamsPlaceMember = nil
 	this.activitystreamsProfileMember = nil
+	this.schemaPropertyValueMember = nil
 	this.forgefedPushMember = nil
 	this.activitystreamsQuestionMember = nil
 	this.activitystreamsReadMember = nil
@@ -2943,6 +4548,8 @@ func (this ActivityStreamsToPropertyIterator) serialize() (interface{}, error) {
 		return this.GetActivityStreamsDocument().Serialize()
 	} else if this.IsTootEmoji() {
 		return this.GetTootEmoji().Serialize()
+	} else if this.IsLitepubEmojiReact() {
+		return this.GetLitepubEmojiReact().Serialize()
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().Serialize()
 	} else if this.IsActivityStreamsFlag() {
@@ -2951,6 +4558,8 @@ func (this ActivityStreamsToPropertyIterator) serialize() (interface{}, error) {
 		return this.GetActivityStreamsFollow().Serialize()
 	} else if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup().Serialize()
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().Serialize()
 	} else if this.IsTootIdentityProof() {
 		return this.GetTootIdentityProof().Serialize()
 	} else if this.IsActivityStreamsIgnore() {
@@ -2991,6 +4600,8 @@ func (this ActivityStreamsToPropertyIterator) serialize() (interface{}, error) {
 		return this.GetActivityStreamsPlace().Serialize()
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().Serialize()
+	} else if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue().Serialize()
 	} else if this.IsForgeFedPush() {
 		return this.GetForgeFedPush().Serialize()
 	} else if this.IsActivityStreamsQuestion() {
@@ -3036,7 +4647,7 @@ func (this ActivityStreamsToPropertyIterator) serialize() (interface{}, error) {
 // ActivityStreamsToProperty is the non-functional property "to". It is permitted
 // to have one or more values, and of different value types.
 type ActivityStreamsToProperty struct {
-	properties []*ActivityStreamsToPropertyIterator
+	properties proplist.List[*ActivityStreamsToPropertyIterator]
 	alias      string
 }
 
@@ -3054,10 +4665,9 @@ func DeserializeToProperty(m map[string]interface{}, aliasMap map[string]string)
 	i, ok := m[propName]
 
 	if ok {
-		this := &ActivityStreamsToProperty{
-			alias:      alias,
-			properties: []*ActivityStreamsToPropertyIterator{},
-		}
+		// A released value keeps its properties for reuse.
+		this := poolActivityStreamsToProperty.Get().(*ActivityStreamsToProperty)
+		this.alias = alias
 		if list, ok := i.([]interface{}); ok {
 			for _, iterator := range list {
 				if p, err := deserializeActivityStreamsToPropertyIterator(iterator, aliasMap); err != nil {
@@ -3074,10 +4684,7 @@ func DeserializeToProperty(m map[string]interface{}, aliasMap map[string]string)
 			}
 		}
 		// Set up the properties for iteration.
-		for idx, ele := range this.properties {
-			ele.parent = this
-			ele.myIdx = idx
-		}
+		this.properties.Link()
 		return this, nil
 	}
 	return nil, nil
@@ -3088,722 +4695,619 @@ func NewActivityStreamsToProperty() *ActivityStreamsToProperty {
 	return &ActivityStreamsToProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and each of its values.
+func (this ActivityStreamsToProperty) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+	for _, elem := range this.properties {
+		elem.AddJSONLDContext(m)
+	}
+}
+
 // AppendActivityStreamsAccept appends a Accept value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsAccept(v vocab.ActivityStreamsAccept) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsAcceptMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsActivity appends a Activity value to the back of a list of
-// the property "to". Invalidates iterators that are traversing using Prev.
+// the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsActivity(v vocab.ActivityStreamsActivity) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsActivityMember: v,
 		alias:                         this.alias,
-		myIdx:                         this.Len(),
-		parent:                        this,
 	})
 }
 
 // AppendActivityStreamsAdd appends a Add value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsAdd(v vocab.ActivityStreamsAdd) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsAddMember: v,
 		alias:                    this.alias,
-		myIdx:                    this.Len(),
-		parent:                   this,
 	})
 }
 
 // AppendActivityStreamsAnnounce appends a Announce value to the back of a list of
-// the property "to". Invalidates iterators that are traversing using Prev.
+// the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsAnnounce(v vocab.ActivityStreamsAnnounce) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsAnnounceMember: v,
 		alias:                         this.alias,
-		myIdx:                         this.Len(),
-		parent:                        this,
 	})
 }
 
 // AppendActivityStreamsApplication appends a Application value to the back of a
-// list of the property "to". Invalidates iterators that are traversing using
-// Prev.
+// list of the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsApplication(v vocab.ActivityStreamsApplication) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsApplicationMember: v,
 		alias:                            this.alias,
-		myIdx:                            this.Len(),
-		parent:                           this,
 	})
 }
 
 // AppendActivityStreamsArrive appends a Arrive value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsArrive(v vocab.ActivityStreamsArrive) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsArriveMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsArticle appends a Article value to the back of a list of
-// the property "to". Invalidates iterators that are traversing using Prev.
+// the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsArticle(v vocab.ActivityStreamsArticle) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsArticleMember: v,
 		alias:                        this.alias,
-		myIdx:                        this.Len(),
-		parent:                       this,
 	})
 }
 
 // AppendActivityStreamsAudio appends a Audio value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsAudio(v vocab.ActivityStreamsAudio) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsAudioMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsBlock appends a Block value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsBlock(v vocab.ActivityStreamsBlock) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsBlockMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsCollection appends a Collection value to the back of a
-// list of the property "to". Invalidates iterators that are traversing using
-// Prev.
+// list of the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsCollection(v vocab.ActivityStreamsCollection) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsCollectionMember: v,
 		alias:                           this.alias,
-		myIdx:                           this.Len(),
-		parent:                          this,
 	})
 }
 
 // AppendActivityStreamsCollectionPage appends a CollectionPage value to the back
-// of a list of the property "to". Invalidates iterators that are traversing
-// using Prev.
+// of a list of the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsCollectionPage(v vocab.ActivityStreamsCollectionPage) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsCollectionPageMember: v,
 		alias:                               this.alias,
-		myIdx:                               this.Len(),
-		parent:                              this,
 	})
 }
 
 // AppendActivityStreamsCreate appends a Create value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsCreate(v vocab.ActivityStreamsCreate) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsCreateMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsDelete appends a Delete value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsDelete(v vocab.ActivityStreamsDelete) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsDeleteMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsDislike appends a Dislike value to the back of a list of
-// the property "to". Invalidates iterators that are traversing using Prev.
+// the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsDislike(v vocab.ActivityStreamsDislike) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsDislikeMember: v,
 		alias:                        this.alias,
-		myIdx:                        this.Len(),
-		parent:                       this,
 	})
 }
 
 // AppendActivityStreamsDocument appends a Document value to the back of a list of
-// the property "to". Invalidates iterators that are traversing using Prev.
+// the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsDocument(v vocab.ActivityStreamsDocument) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsDocumentMember: v,
 		alias:                         this.alias,
-		myIdx:                         this.Len(),
-		parent:                        this,
 	})
 }
 
 // AppendActivityStreamsEvent appends a Event value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsEventMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsFlag appends a Flag value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsFlag(v vocab.ActivityStreamsFlag) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsFlagMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsFollow appends a Follow value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsFollow(v vocab.ActivityStreamsFollow) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsFollowMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsGroup appends a Group value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsGroup(v vocab.ActivityStreamsGroup) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsGroupMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
+	})
+}
+
+// AppendActivityStreamsHashtag appends a Hashtag value to the back of a list of
+// the property "to".
+func (this *ActivityStreamsToProperty) AppendActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
 	})
 }
 
 // AppendActivityStreamsIgnore appends a Ignore value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsIgnoreMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsImage appends a Image value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsImage(v vocab.ActivityStreamsImage) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsImageMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsIntransitiveActivity appends a IntransitiveActivity value
-// to the back of a list of the property "to". Invalidates iterators that are
-// traversing using Prev.
+// to the back of a list of the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsIntransitiveActivity(v vocab.ActivityStreamsIntransitiveActivity) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsIntransitiveActivityMember: v,
-		alias:  this.alias,
-		myIdx:  this.Len(),
-		parent: this,
+		alias: this.alias,
 	})
 }
 
 // AppendActivityStreamsInvite appends a Invite value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsInvite(v vocab.ActivityStreamsInvite) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsInviteMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsJoin appends a Join value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsJoin(v vocab.ActivityStreamsJoin) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsJoinMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsLeave appends a Leave value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsLeave(v vocab.ActivityStreamsLeave) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsLeaveMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsLike appends a Like value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsLike(v vocab.ActivityStreamsLike) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsLikeMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsLink appends a Link value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsLink(v vocab.ActivityStreamsLink) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsLinkMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsListen appends a Listen value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsListen(v vocab.ActivityStreamsListen) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsListenMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsMention appends a Mention value to the back of a list of
-// the property "to". Invalidates iterators that are traversing using Prev.
+// the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsMention(v vocab.ActivityStreamsMention) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsMentionMember: v,
 		alias:                        this.alias,
-		myIdx:                        this.Len(),
-		parent:                       this,
 	})
 }
 
 // AppendActivityStreamsMove appends a Move value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsMove(v vocab.ActivityStreamsMove) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsMoveMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsNote appends a Note value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsNote(v vocab.ActivityStreamsNote) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsNoteMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsObject appends a Object value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsObject(v vocab.ActivityStreamsObject) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsObjectMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsOffer appends a Offer value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsOffer(v vocab.ActivityStreamsOffer) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsOfferMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsOrderedCollection appends a OrderedCollection value to the
-// back of a list of the property "to". Invalidates iterators that are
-// traversing using Prev.
+// back of a list of the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsOrderedCollection(v vocab.ActivityStreamsOrderedCollection) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsOrderedCollectionMember: v,
 		alias:                                  this.alias,
-		myIdx:                                  this.Len(),
-		parent:                                 this,
 	})
 }
 
 // AppendActivityStreamsOrderedCollectionPage appends a OrderedCollectionPage
-// value to the back of a list of the property "to". Invalidates iterators
-// that are traversing using Prev.
+// value to the back of a list of the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsOrderedCollectionPage(v vocab.ActivityStreamsOrderedCollectionPage) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsOrderedCollectionPageMember: v,
-		alias:  this.alias,
-		myIdx:  this.Len(),
-		parent: this,
+		alias: this.alias,
 	})
 }
 
 // AppendActivityStreamsOrganization appends a Organization value to the back of a
-// list of the property "to". Invalidates iterators that are traversing using
-// Prev.
+// list of the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsOrganization(v vocab.ActivityStreamsOrganization) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsOrganizationMember: v,
 		alias:                             this.alias,
-		myIdx:                             this.Len(),
-		parent:                            this,
 	})
 }
 
 // AppendActivityStreamsPage appends a Page value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsPage(v vocab.ActivityStreamsPage) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsPageMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsPerson appends a Person value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsPerson(v vocab.ActivityStreamsPerson) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsPersonMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsPlace appends a Place value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsPlace(v vocab.ActivityStreamsPlace) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsPlaceMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsProfile appends a Profile value to the back of a list of
-// the property "to". Invalidates iterators that are traversing using Prev.
+// the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsProfile(v vocab.ActivityStreamsProfile) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsProfileMember: v,
 		alias:                        this.alias,
-		myIdx:                        this.Len(),
-		parent:                       this,
 	})
 }
 
 // AppendActivityStreamsQuestion appends a Question value to the back of a list of
-// the property "to". Invalidates iterators that are traversing using Prev.
+// the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsQuestionMember: v,
 		alias:                         this.alias,
-		myIdx:                         this.Len(),
-		parent:                        this,
 	})
 }
 
 // AppendActivityStreamsRead appends a Read value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsRead(v vocab.ActivityStreamsRead) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsReadMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsReject appends a Reject value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsReject(v vocab.ActivityStreamsReject) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsRejectMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsRelationship appends a Relationship value to the back of a
-// list of the property "to". Invalidates iterators that are traversing using
-// Prev.
+// list of the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsRelationship(v vocab.ActivityStreamsRelationship) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsRelationshipMember: v,
 		alias:                             this.alias,
-		myIdx:                             this.Len(),
-		parent:                            this,
 	})
 }
 
 // AppendActivityStreamsRemove appends a Remove value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsRemove(v vocab.ActivityStreamsRemove) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsRemoveMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsService appends a Service value to the back of a list of
-// the property "to". Invalidates iterators that are traversing using Prev.
+// the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsService(v vocab.ActivityStreamsService) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsServiceMember: v,
 		alias:                        this.alias,
-		myIdx:                        this.Len(),
-		parent:                       this,
 	})
 }
 
 // AppendActivityStreamsTentativeAccept appends a TentativeAccept value to the
-// back of a list of the property "to". Invalidates iterators that are
-// traversing using Prev.
+// back of a list of the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsTentativeAccept(v vocab.ActivityStreamsTentativeAccept) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsTentativeAcceptMember: v,
 		alias:                                this.alias,
-		myIdx:                                this.Len(),
-		parent:                               this,
 	})
 }
 
 // AppendActivityStreamsTentativeReject appends a TentativeReject value to the
-// back of a list of the property "to". Invalidates iterators that are
-// traversing using Prev.
+// back of a list of the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsTentativeReject(v vocab.ActivityStreamsTentativeReject) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsTentativeRejectMember: v,
 		alias:                                this.alias,
-		myIdx:                                this.Len(),
-		parent:                               this,
 	})
 }
 
 // AppendActivityStreamsTombstone appends a Tombstone value to the back of a list
-// of the property "to". Invalidates iterators that are traversing using Prev.
+// of the property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsTombstone(v vocab.ActivityStreamsTombstone) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsTombstoneMember: v,
 		alias:                          this.alias,
-		myIdx:                          this.Len(),
-		parent:                         this,
 	})
 }
 
 // AppendActivityStreamsTravel appends a Travel value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsTravel(v vocab.ActivityStreamsTravel) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsTravelMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsUndo appends a Undo value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsUndo(v vocab.ActivityStreamsUndo) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsUndoMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsUpdate appends a Update value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsUpdate(v vocab.ActivityStreamsUpdate) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsUpdateMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsVideo appends a Video value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsVideo(v vocab.ActivityStreamsVideo) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsVideoMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsView appends a View value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendActivityStreamsView(v vocab.ActivityStreamsView) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		activitystreamsViewMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendForgeFedBranch appends a Branch value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendForgeFedBranch(v vocab.ForgeFedBranch) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedBranchMember: v,
-		myIdx:                this.Len(),
-		parent:               this,
 	})
 }
 
 // AppendForgeFedCommit appends a Commit value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendForgeFedCommit(v vocab.ForgeFedCommit) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedCommitMember: v,
-		myIdx:                this.Len(),
-		parent:               this,
 	})
 }
 
 // AppendForgeFedPush appends a Push value to the back of a list of the property
-// "to". Invalidates iterators that are traversing using Prev.
+// "to".
 func (this *ActivityStreamsToProperty) AppendForgeFedPush(v vocab.ForgeFedPush) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		alias:              this.alias,
 		forgefedPushMember: v,
-		myIdx:              this.Len(),
-		parent:             this,
 	})
 }
 
 // AppendForgeFedRepository appends a Repository value to the back of a list of
-// the property "to". Invalidates iterators that are traversing using Prev.
+// the property "to".
 func (this *ActivityStreamsToProperty) AppendForgeFedRepository(v vocab.ForgeFedRepository) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		alias:                    this.alias,
 		forgefedRepositoryMember: v,
-		myIdx:                    this.Len(),
-		parent:                   this,
 	})
 }
 
 // AppendForgeFedTicket appends a Ticket value to the back of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
+// property "to".
 func (this *ActivityStreamsToProperty) AppendForgeFedTicket(v vocab.ForgeFedTicket) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                this.Len(),
-		parent:               this,
 	})
 }
 
 // AppendForgeFedTicketDependency appends a TicketDependency value to the back of
-// a list of the property "to". Invalidates iterators that are traversing
-// using Prev.
+// a list of the property "to".
 func (this *ActivityStreamsToProperty) AppendForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		alias:                          this.alias,
 		forgefedTicketDependencyMember: v,
-		myIdx:                          this.Len(),
-		parent:                         this,
 	})
 }
 
 // AppendIRI appends an IRI value to the back of a list of the property "to"
 func (this *ActivityStreamsToProperty) AppendIRI(v *url.URL) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  this.Len(),
-		parent: this,
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// AppendLitepubEmojiReact appends a EmojiReact value to the back of a list of the
+// property "to".
+func (this *ActivityStreamsToProperty) AppendLitepubEmojiReact(v vocab.LitepubEmojiReact) {
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
+		alias:                   this.alias,
+		litepubEmojiReactMember: v,
+	})
+}
+
+// AppendSchemaPropertyValue appends a PropertyValue value to the back of a list
+// of the property "to".
+func (this *ActivityStreamsToProperty) AppendSchemaPropertyValue(v vocab.SchemaPropertyValue) {
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
+		alias:                     this.alias,
+		schemaPropertyValueMember: v,
 	})
 }
 
 // AppendTootEmoji appends a Emoji value to the back of a list of the property
-// "to". Invalidates iterators that are traversing using Prev.
+// "to".
 func (this *ActivityStreamsToProperty) AppendTootEmoji(v vocab.TootEmoji) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		alias:           this.alias,
-		myIdx:           this.Len(),
-		parent:          this,
 		tootEmojiMember: v,
 	})
 }
 
 // AppendTootIdentityProof appends a IdentityProof value to the back of a list of
-// the property "to". Invalidates iterators that are traversing using Prev.
+// the property "to".
 func (this *ActivityStreamsToProperty) AppendTootIdentityProof(v vocab.TootIdentityProof) {
-	this.properties = append(this.properties, &ActivityStreamsToPropertyIterator{
+	this.properties.Append(&ActivityStreamsToPropertyIterator{
 		alias:                   this.alias,
-		myIdx:                   this.Len(),
-		parent:                  this,
 		tootIdentityProofMember: v,
 	})
 }
 
-// PrependType prepends an arbitrary type value to the front of a list of the
-// property "to". Invalidates iterators that are traversing using Prev.
-// Returns an error if the type is not a valid one to set for this property.
+// AppendType appends an arbitrary type value to the back of a list of the
+// property "to". Returns an error if the type is not a valid one to set for
+// this property.
 func (this *ActivityStreamsToProperty) AppendType(t vocab.Type) error {
-	n := &ActivityStreamsToPropertyIterator{
-		alias:  this.alias,
-		myIdx:  this.Len(),
-		parent: this,
-	}
+	n := &ActivityStreamsToPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append(this.properties, n)
+	this.properties.Append(n)
 	return nil
 }
 
@@ -3824,6 +5328,31 @@ func (this ActivityStreamsToProperty) Begin() vocab.ActivityStreamsToPropertyIte
 	}
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsToProperty) Compare(o vocab.ActivityStreamsToProperty) int {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if c := this.properties[i].Compare(o.At(i)); c != 0 {
+			return c
+		}
+	}
+	if l1 < l2 {
+		return -1
+	} else if l1 > l2 {
+		return 1
+	}
+	return 0
+}
+
 // Empty returns returns true if there are no elements.
 func (this ActivityStreamsToProperty) Empty() bool {
 	return this.Len() == 0
@@ -3838,1093 +5367,671 @@ func (this ActivityStreamsToProperty) End() vocab.ActivityStreamsToPropertyItera
 
 // InsertActivityStreamsAccept inserts a Accept value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsAccept(idx int, v vocab.ActivityStreamsAccept) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsAcceptMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsActivity inserts a Activity value at the specified index
 // for a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsActivity(idx int, v vocab.ActivityStreamsActivity) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsActivityMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsAdd inserts a Add value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsAdd(idx int, v vocab.ActivityStreamsAdd) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsAddMember: v,
 		alias:                    this.alias,
-		myIdx:                    idx,
-		parent:                   this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsAnnounce inserts a Announce value at the specified index
 // for a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsAnnounce(idx int, v vocab.ActivityStreamsAnnounce) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsAnnounceMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsApplication inserts a Application value at the specified
 // index for a property "to". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsApplication(idx int, v vocab.ActivityStreamsApplication) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsApplicationMember: v,
 		alias:                            this.alias,
-		myIdx:                            idx,
-		parent:                           this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsArrive inserts a Arrive value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsArrive(idx int, v vocab.ActivityStreamsArrive) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsArriveMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsArticle inserts a Article value at the specified index for
 // a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsArticle(idx int, v vocab.ActivityStreamsArticle) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsArticleMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsAudio inserts a Audio value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsAudio(idx int, v vocab.ActivityStreamsAudio) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsAudioMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsBlock inserts a Block value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsBlock(idx int, v vocab.ActivityStreamsBlock) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsBlockMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsCollection inserts a Collection value at the specified
 // index for a property "to". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsCollection(idx int, v vocab.ActivityStreamsCollection) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsCollectionMember: v,
 		alias:                           this.alias,
-		myIdx:                           idx,
-		parent:                          this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsCollectionPage inserts a CollectionPage value at the
 // specified index for a property "to". Existing elements at that index and
-// higher are shifted back once. Invalidates all iterators.
+// higher are shifted back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsCollectionPage(idx int, v vocab.ActivityStreamsCollectionPage) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsCollectionPageMember: v,
 		alias:                               this.alias,
-		myIdx:                               idx,
-		parent:                              this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsCreate inserts a Create value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsCreate(idx int, v vocab.ActivityStreamsCreate) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsCreateMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsDelete inserts a Delete value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsDelete(idx int, v vocab.ActivityStreamsDelete) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsDeleteMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsDislike inserts a Dislike value at the specified index for
 // a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsDislike(idx int, v vocab.ActivityStreamsDislike) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsDislikeMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsDocument inserts a Document value at the specified index
 // for a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsDocument(idx int, v vocab.ActivityStreamsDocument) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsDocumentMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsEvent inserts a Event value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsEvent(idx int, v vocab.ActivityStreamsEvent) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsEventMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsFlag inserts a Flag value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsFlag(idx int, v vocab.ActivityStreamsFlag) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsFlagMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsFollow inserts a Follow value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsFollow(idx int, v vocab.ActivityStreamsFollow) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsFollowMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsGroup inserts a Group value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsGroup(idx int, v vocab.ActivityStreamsGroup) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsGroupMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
+}
+
+// InsertActivityStreamsHashtag inserts a Hashtag value at the specified index for
+// a property "to". Existing elements at that index and higher are shifted
+// back once.
+func (this *ActivityStreamsToProperty) InsertActivityStreamsHashtag(idx int, v vocab.ActivityStreamsHashtag) {
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+	})
 }
 
 // InsertActivityStreamsIgnore inserts a Ignore value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsIgnore(idx int, v vocab.ActivityStreamsIgnore) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsIgnoreMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsImage inserts a Image value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsImage(idx int, v vocab.ActivityStreamsImage) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsImageMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsIntransitiveActivity inserts a IntransitiveActivity value
 // at the specified index for a property "to". Existing elements at that index
-// and higher are shifted back once. Invalidates all iterators.
+// and higher are shifted back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsIntransitiveActivity(idx int, v vocab.ActivityStreamsIntransitiveActivity) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsIntransitiveActivityMember: v,
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+		alias: this.alias,
+	})
 }
 
 // InsertActivityStreamsInvite inserts a Invite value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsInvite(idx int, v vocab.ActivityStreamsInvite) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsInviteMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsJoin inserts a Join value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsJoin(idx int, v vocab.ActivityStreamsJoin) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsJoinMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsLeave inserts a Leave value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsLeave(idx int, v vocab.ActivityStreamsLeave) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsLeaveMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsLike inserts a Like value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsLike(idx int, v vocab.ActivityStreamsLike) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsLikeMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsLink inserts a Link value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsLink(idx int, v vocab.ActivityStreamsLink) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsLinkMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsListen inserts a Listen value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsListen(idx int, v vocab.ActivityStreamsListen) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsListenMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsMention inserts a Mention value at the specified index for
 // a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsMention(idx int, v vocab.ActivityStreamsMention) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsMentionMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsMove inserts a Move value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsMove(idx int, v vocab.ActivityStreamsMove) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsMoveMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsNote inserts a Note value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsNote(idx int, v vocab.ActivityStreamsNote) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsNoteMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsObject inserts a Object value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsObject(idx int, v vocab.ActivityStreamsObject) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsObjectMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsOffer inserts a Offer value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsOffer(idx int, v vocab.ActivityStreamsOffer) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsOfferMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsOrderedCollection inserts a OrderedCollection value at the
 // specified index for a property "to". Existing elements at that index and
-// higher are shifted back once. Invalidates all iterators.
+// higher are shifted back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsOrderedCollection(idx int, v vocab.ActivityStreamsOrderedCollection) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsOrderedCollectionMember: v,
 		alias:                                  this.alias,
-		myIdx:                                  idx,
-		parent:                                 this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsOrderedCollectionPage inserts a OrderedCollectionPage
 // value at the specified index for a property "to". Existing elements at that
-// index and higher are shifted back once. Invalidates all iterators.
+// index and higher are shifted back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsOrderedCollectionPage(idx int, v vocab.ActivityStreamsOrderedCollectionPage) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsOrderedCollectionPageMember: v,
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+		alias: this.alias,
+	})
 }
 
 // InsertActivityStreamsOrganization inserts a Organization value at the specified
 // index for a property "to". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsOrganization(idx int, v vocab.ActivityStreamsOrganization) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsOrganizationMember: v,
 		alias:                             this.alias,
-		myIdx:                             idx,
-		parent:                            this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsPage inserts a Page value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsPage(idx int, v vocab.ActivityStreamsPage) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsPageMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsPerson inserts a Person value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsPerson(idx int, v vocab.ActivityStreamsPerson) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsPersonMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsPlace inserts a Place value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsPlace(idx int, v vocab.ActivityStreamsPlace) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsPlaceMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsProfile inserts a Profile value at the specified index for
 // a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsProfile(idx int, v vocab.ActivityStreamsProfile) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsProfileMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsQuestion inserts a Question value at the specified index
 // for a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsQuestion(idx int, v vocab.ActivityStreamsQuestion) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsQuestionMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsRead inserts a Read value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsRead(idx int, v vocab.ActivityStreamsRead) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsReadMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsReject inserts a Reject value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsReject(idx int, v vocab.ActivityStreamsReject) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsRejectMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsRelationship inserts a Relationship value at the specified
 // index for a property "to". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsRelationship(idx int, v vocab.ActivityStreamsRelationship) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsRelationshipMember: v,
 		alias:                             this.alias,
-		myIdx:                             idx,
-		parent:                            this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsRemove inserts a Remove value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsRemove(idx int, v vocab.ActivityStreamsRemove) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsRemoveMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsService inserts a Service value at the specified index for
 // a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsService(idx int, v vocab.ActivityStreamsService) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsServiceMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsTentativeAccept inserts a TentativeAccept value at the
 // specified index for a property "to". Existing elements at that index and
-// higher are shifted back once. Invalidates all iterators.
+// higher are shifted back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsTentativeAccept(idx int, v vocab.ActivityStreamsTentativeAccept) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsTentativeAcceptMember: v,
 		alias:                                this.alias,
-		myIdx:                                idx,
-		parent:                               this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsTentativeReject inserts a TentativeReject value at the
 // specified index for a property "to". Existing elements at that index and
-// higher are shifted back once. Invalidates all iterators.
+// higher are shifted back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsTentativeReject(idx int, v vocab.ActivityStreamsTentativeReject) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsTentativeRejectMember: v,
 		alias:                                this.alias,
-		myIdx:                                idx,
-		parent:                               this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsTombstone inserts a Tombstone value at the specified index
 // for a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsTombstone(idx int, v vocab.ActivityStreamsTombstone) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsTombstoneMember: v,
 		alias:                          this.alias,
-		myIdx:                          idx,
-		parent:                         this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsTravel inserts a Travel value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsTravel(idx int, v vocab.ActivityStreamsTravel) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsTravelMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsUndo inserts a Undo value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsUndo(idx int, v vocab.ActivityStreamsUndo) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsUndoMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsUpdate inserts a Update value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsUpdate(idx int, v vocab.ActivityStreamsUpdate) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsUpdateMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsVideo inserts a Video value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsVideo(idx int, v vocab.ActivityStreamsVideo) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsVideoMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsView inserts a View value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertActivityStreamsView(idx int, v vocab.ActivityStreamsView) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsViewMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedBranch inserts a Branch value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertForgeFedBranch(idx int, v vocab.ForgeFedBranch) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedBranchMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedCommit inserts a Commit value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertForgeFedCommit(idx int, v vocab.ForgeFedCommit) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedCommitMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedPush inserts a Push value at the specified index for a property
 // "to". Existing elements at that index and higher are shifted back once.
-// Invalidates all iterators.
 func (this *ActivityStreamsToProperty) InsertForgeFedPush(idx int, v vocab.ForgeFedPush) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		alias:              this.alias,
 		forgefedPushMember: v,
-		myIdx:              idx,
-		parent:             this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedRepository inserts a Repository value at the specified index for
 // a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertForgeFedRepository(idx int, v vocab.ForgeFedRepository) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		alias:                    this.alias,
 		forgefedRepositoryMember: v,
-		myIdx:                    idx,
-		parent:                   this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedTicket inserts a Ticket value at the specified index for a
 // property "to". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsToProperty) InsertForgeFedTicket(idx int, v vocab.ForgeFedTicket) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedTicketDependency inserts a TicketDependency value at the
 // specified index for a property "to". Existing elements at that index and
-// higher are shifted back once. Invalidates all iterators.
+// higher are shifted back once.
 func (this *ActivityStreamsToProperty) InsertForgeFedTicketDependency(idx int, v vocab.ForgeFedTicketDependency) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		alias:                          this.alias,
 		forgefedTicketDependencyMember: v,
-		myIdx:                          idx,
-		parent:                         this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // Insert inserts an IRI value at the specified index for a property "to".
 // Existing elements at that index and higher are shifted back once.
-// Invalidates all iterators.
 func (this *ActivityStreamsToProperty) InsertIRI(idx int, v *url.URL) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// InsertLitepubEmojiReact inserts a EmojiReact value at the specified index for a
+// property "to". Existing elements at that index and higher are shifted back
+// once.
+func (this *ActivityStreamsToProperty) InsertLitepubEmojiReact(idx int, v vocab.LitepubEmojiReact) {
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
+		alias:                   this.alias,
+		litepubEmojiReactMember: v,
+	})
+}
+
+// InsertSchemaPropertyValue inserts a PropertyValue value at the specified index
+// for a property "to". Existing elements at that index and higher are shifted
+// back once.
+func (this *ActivityStreamsToProperty) InsertSchemaPropertyValue(idx int, v vocab.SchemaPropertyValue) {
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
+		alias:                     this.alias,
+		schemaPropertyValueMember: v,
+	})
 }
 
 // InsertTootEmoji inserts a Emoji value at the specified index for a property
 // "to". Existing elements at that index and higher are shifted back once.
-// Invalidates all iterators.
 func (this *ActivityStreamsToProperty) InsertTootEmoji(idx int, v vocab.TootEmoji) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		alias:           this.alias,
-		myIdx:           idx,
-		parent:          this,
 		tootEmojiMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertTootIdentityProof inserts a IdentityProof value at the specified index
 // for a property "to". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsToProperty) InsertTootIdentityProof(idx int, v vocab.TootIdentityProof) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsToPropertyIterator{
 		alias:                   this.alias,
-		myIdx:                   idx,
-		parent:                  this,
 		tootIdentityProofMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
-// PrependType prepends an arbitrary type value to the front of a list of the
-// property "to". Invalidates all iterators. Returns an error if the type is
-// not a valid one to set for this property.
+// InsertType inserts an arbitrary type value at the specified index for the
+// property "to". Existing elements at that index and higher are shifted back
+// once. Returns an error if the type is not a valid one to set for this
+// property.
 func (this *ActivityStreamsToProperty) InsertType(idx int, t vocab.Type) error {
-	n := &ActivityStreamsToPropertyIterator{
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+	n := &ActivityStreamsToPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = n
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, n)
 	return nil
 }
 
@@ -4932,18 +6039,8 @@ func (this *ActivityStreamsToProperty) InsertType(idx int, t vocab.Type) error {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsToProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	for _, elem := range this.properties {
-		child := elem.JSONLDContext()
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		for k, v := range child {
-			m[k] = v
-		}
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -4957,7 +6054,7 @@ func (this ActivityStreamsToProperty) KindIndex(idx int) int {
 
 // Len returns the number of values that exist for the "to" property.
 func (this ActivityStreamsToProperty) Len() (length int) {
-	return len(this.properties)
+	return this.properties.Len()
 }
 
 // Less computes whether another property is less than this one. Mixing types
@@ -5049,170 +6146,182 @@ func (this ActivityStreamsToProperty) Less(i, j int) bool {
 			rhs := this.properties[j].GetTootEmoji()
 			return lhs.LessThan(rhs)
 		} else if idx1 == 20 {
+			lhs := this.properties[i].GetLitepubEmojiReact()
+			rhs := this.properties[j].GetLitepubEmojiReact()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 21 {
 			lhs := this.properties[i].GetActivityStreamsEvent()
 			rhs := this.properties[j].GetActivityStreamsEvent()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 21 {
+		} else if idx1 == 22 {
 			lhs := this.properties[i].GetActivityStreamsFlag()
 			rhs := this.properties[j].GetActivityStreamsFlag()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 22 {
+		} else if idx1 == 23 {
 			lhs := this.properties[i].GetActivityStreamsFollow()
 			rhs := this.properties[j].GetActivityStreamsFollow()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 23 {
+		} else if idx1 == 24 {
 			lhs := this.properties[i].GetActivityStreamsGroup()
 			rhs := this.properties[j].GetActivityStreamsGroup()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 24 {
+		} else if idx1 == 25 {
+			lhs := this.properties[i].GetActivityStreamsHashtag()
+			rhs := this.properties[j].GetActivityStreamsHashtag()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 26 {
 			lhs := this.properties[i].GetTootIdentityProof()
 			rhs := this.properties[j].GetTootIdentityProof()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 25 {
+		} else if idx1 == 27 {
 			lhs := this.properties[i].GetActivityStreamsIgnore()
 			rhs := this.properties[j].GetActivityStreamsIgnore()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 26 {
+		} else if idx1 == 28 {
 			lhs := this.properties[i].GetActivityStreamsImage()
 			rhs := this.properties[j].GetActivityStreamsImage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 27 {
+		} else if idx1 == 29 {
 			lhs := this.properties[i].GetActivityStreamsIntransitiveActivity()
 			rhs := this.properties[j].GetActivityStreamsIntransitiveActivity()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 28 {
+		} else if idx1 == 30 {
 			lhs := this.properties[i].GetActivityStreamsInvite()
 			rhs := this.properties[j].GetActivityStreamsInvite()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 29 {
+		} else if idx1 == 31 {
 			lhs := this.properties[i].GetActivityStreamsJoin()
 			rhs := this.properties[j].GetActivityStreamsJoin()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 30 {
+		} else if idx1 == 32 {
 			lhs := this.properties[i].GetActivityStreamsLeave()
 			rhs := this.properties[j].GetActivityStreamsLeave()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 31 {
+		} else if idx1 == 33 {
 			lhs := this.properties[i].GetActivityStreamsLike()
 			rhs := this.properties[j].GetActivityStreamsLike()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 32 {
+		} else if idx1 == 34 {
 			lhs := this.properties[i].GetActivityStreamsListen()
 			rhs := this.properties[j].GetActivityStreamsListen()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 33 {
+		} else if idx1 == 35 {
 			lhs := this.properties[i].GetActivityStreamsMention()
 			rhs := this.properties[j].GetActivityStreamsMention()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 34 {
+		} else if idx1 == 36 {
 			lhs := this.properties[i].GetActivityStreamsMove()
 			rhs := this.properties[j].GetActivityStreamsMove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 35 {
+		} else if idx1 == 37 {
 			lhs := this.properties[i].GetActivityStreamsNote()
 			rhs := this.properties[j].GetActivityStreamsNote()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 36 {
+		} else if idx1 == 38 {
 			lhs := this.properties[i].GetActivityStreamsOffer()
 			rhs := this.properties[j].GetActivityStreamsOffer()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 37 {
+		} else if idx1 == 39 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollection()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollection()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 38 {
+		} else if idx1 == 40 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollectionPage()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollectionPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 39 {
+		} else if idx1 == 41 {
 			lhs := this.properties[i].GetActivityStreamsOrganization()
 			rhs := this.properties[j].GetActivityStreamsOrganization()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 40 {
+		} else if idx1 == 42 {
 			lhs := this.properties[i].GetActivityStreamsPage()
 			rhs := this.properties[j].GetActivityStreamsPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 41 {
+		} else if idx1 == 43 {
 			lhs := this.properties[i].GetActivityStreamsPerson()
 			rhs := this.properties[j].GetActivityStreamsPerson()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 42 {
+		} else if idx1 == 44 {
 			lhs := this.properties[i].GetActivityStreamsPlace()
 			rhs := this.properties[j].GetActivityStreamsPlace()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 43 {
+		} else if idx1 == 45 {
 			lhs := this.properties[i].GetActivityStreamsProfile()
 			rhs := this.properties[j].GetActivityStreamsProfile()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 44 {
+		} else if idx1 == 46 {
+			lhs := this.properties[i].GetSchemaPropertyValue()
+			rhs := this.properties[j].GetSchemaPropertyValue()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 47 {
 			lhs := this.properties[i].GetForgeFedPush()
 			rhs := this.properties[j].GetForgeFedPush()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 45 {
+		} else if idx1 == 48 {
 			lhs := this.properties[i].GetActivityStreamsQuestion()
 			rhs := this.properties[j].GetActivityStreamsQuestion()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 46 {
+		} else if idx1 == 49 {
 			lhs := this.properties[i].GetActivityStreamsRead()
 			rhs := this.properties[j].GetActivityStreamsRead()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 47 {
+		} else if idx1 == 50 {
 			lhs := this.properties[i].GetActivityStreamsReject()
 			rhs := this.properties[j].GetActivityStreamsReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 48 {
+		} else if idx1 == 51 {
 			lhs := this.properties[i].GetActivityStreamsRelationship()
 			rhs := this.properties[j].GetActivityStreamsRelationship()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 49 {
+		} else if idx1 == 52 {
 			lhs := this.properties[i].GetActivityStreamsRemove()
 			rhs := this.properties[j].GetActivityStreamsRemove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 50 {
+		} else if idx1 == 53 {
 			lhs := this.properties[i].GetForgeFedRepository()
 			rhs := this.properties[j].GetForgeFedRepository()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 51 {
+		} else if idx1 == 54 {
 			lhs := this.properties[i].GetActivityStreamsService()
 			rhs := this.properties[j].GetActivityStreamsService()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 52 {
+		} else if idx1 == 55 {
 			lhs := this.properties[i].GetActivityStreamsTentativeAccept()
 			rhs := this.properties[j].GetActivityStreamsTentativeAccept()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 53 {
+		} else if idx1 == 56 {
 			lhs := this.properties[i].GetActivityStreamsTentativeReject()
 			rhs := this.properties[j].GetActivityStreamsTentativeReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 54 {
+		} else if idx1 == 57 {
 			lhs := this.properties[i].GetForgeFedTicket()
 			rhs := this.properties[j].GetForgeFedTicket()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 55 {
+		} else if idx1 == 58 {
 			lhs := this.properties[i].GetForgeFedTicketDependency()
 			rhs := this.properties[j].GetForgeFedTicketDependency()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 56 {
+		} else if idx1 == 59 {
 			lhs := this.properties[i].GetActivityStreamsTombstone()
 			rhs := this.properties[j].GetActivityStreamsTombstone()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 57 {
+		} else if idx1 == 60 {
 			lhs := this.properties[i].GetActivityStreamsTravel()
 			rhs := this.properties[j].GetActivityStreamsTravel()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 58 {
+		} else if idx1 == 61 {
 			lhs := this.properties[i].GetActivityStreamsUndo()
 			rhs := this.properties[j].GetActivityStreamsUndo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 59 {
+		} else if idx1 == 62 {
 			lhs := this.properties[i].GetActivityStreamsUpdate()
 			rhs := this.properties[j].GetActivityStreamsUpdate()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 60 {
+		} else if idx1 == 63 {
 			lhs := this.properties[i].GetActivityStreamsVideo()
 			rhs := this.properties[j].GetActivityStreamsVideo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 61 {
+		} else if idx1 == 64 {
 			lhs := this.properties[i].GetActivityStreamsView()
 			rhs := this.properties[j].GetActivityStreamsView()
 			return lhs.LessThan(rhs)
@@ -5230,20 +6339,7 @@ func (this ActivityStreamsToProperty) Less(i, j int) bool {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsToProperty) LessThan(o vocab.ActivityStreamsToProperty) bool {
-	l1 := this.Len()
-	l2 := o.Len()
-	l := l1
-	if l2 < l1 {
-		l = l2
-	}
-	for i := 0; i < l; i++ {
-		if this.properties[i].LessThan(o.At(i)) {
-			return true
-		} else if o.At(i).LessThan(this.properties[i]) {
-			return false
-		}
-	}
-	return l1 < l2
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property ("to") with any alias.
@@ -5256,918 +6352,627 @@ func (this ActivityStreamsToProperty) Name() string {
 }
 
 // PrependActivityStreamsAccept prepends a Accept value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsAccept(v vocab.ActivityStreamsAccept) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsAcceptMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsActivity prepends a Activity value to the front of a list
-// of the property "to". Invalidates all iterators.
+// of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsActivity(v vocab.ActivityStreamsActivity) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsActivityMember: v,
 		alias:                         this.alias,
-		myIdx:                         0,
-		parent:                        this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsAdd prepends a Add value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsAdd(v vocab.ActivityStreamsAdd) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsAddMember: v,
 		alias:                    this.alias,
-		myIdx:                    0,
-		parent:                   this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsAnnounce prepends a Announce value to the front of a list
-// of the property "to". Invalidates all iterators.
+// of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsAnnounce(v vocab.ActivityStreamsAnnounce) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsAnnounceMember: v,
 		alias:                         this.alias,
-		myIdx:                         0,
-		parent:                        this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsApplication prepends a Application value to the front of
-// a list of the property "to". Invalidates all iterators.
+// a list of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsApplication(v vocab.ActivityStreamsApplication) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsApplicationMember: v,
 		alias:                            this.alias,
-		myIdx:                            0,
-		parent:                           this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsArrive prepends a Arrive value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsArrive(v vocab.ActivityStreamsArrive) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsArriveMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsArticle prepends a Article value to the front of a list
-// of the property "to". Invalidates all iterators.
+// of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsArticle(v vocab.ActivityStreamsArticle) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsArticleMember: v,
 		alias:                        this.alias,
-		myIdx:                        0,
-		parent:                       this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsAudio prepends a Audio value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsAudio(v vocab.ActivityStreamsAudio) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsAudioMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsBlock prepends a Block value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsBlock(v vocab.ActivityStreamsBlock) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsBlockMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsCollection prepends a Collection value to the front of a
-// list of the property "to". Invalidates all iterators.
+// list of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsCollection(v vocab.ActivityStreamsCollection) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsCollectionMember: v,
 		alias:                           this.alias,
-		myIdx:                           0,
-		parent:                          this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsCollectionPage prepends a CollectionPage value to the
-// front of a list of the property "to". Invalidates all iterators.
+// front of a list of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsCollectionPage(v vocab.ActivityStreamsCollectionPage) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsCollectionPageMember: v,
 		alias:                               this.alias,
-		myIdx:                               0,
-		parent:                              this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsCreate prepends a Create value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsCreate(v vocab.ActivityStreamsCreate) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsCreateMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsDelete prepends a Delete value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsDelete(v vocab.ActivityStreamsDelete) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsDeleteMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsDislike prepends a Dislike value to the front of a list
-// of the property "to". Invalidates all iterators.
+// of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsDislike(v vocab.ActivityStreamsDislike) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsDislikeMember: v,
 		alias:                        this.alias,
-		myIdx:                        0,
-		parent:                       this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsDocument prepends a Document value to the front of a list
-// of the property "to". Invalidates all iterators.
+// of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsDocument(v vocab.ActivityStreamsDocument) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsDocumentMember: v,
 		alias:                         this.alias,
-		myIdx:                         0,
-		parent:                        this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsEvent prepends a Event value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsEventMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsFlag prepends a Flag value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsFlag(v vocab.ActivityStreamsFlag) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsFlagMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsFollow prepends a Follow value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsFollow(v vocab.ActivityStreamsFollow) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsFollowMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsGroup prepends a Group value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsGroup(v vocab.ActivityStreamsGroup) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsGroupMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
+}
+
+// PrependActivityStreamsHashtag prepends a Hashtag value to the front of a list
+// of the property "to".
+func (this *ActivityStreamsToProperty) PrependActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+	})
 }
 
 // PrependActivityStreamsIgnore prepends a Ignore value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsIgnoreMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsImage prepends a Image value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsImage(v vocab.ActivityStreamsImage) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsImageMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsIntransitiveActivity prepends a IntransitiveActivity
-// value to the front of a list of the property "to". Invalidates all
-// iterators.
+// value to the front of a list of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsIntransitiveActivity(v vocab.ActivityStreamsIntransitiveActivity) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsIntransitiveActivityMember: v,
-		alias:  this.alias,
-		myIdx:  0,
-		parent: this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+		alias: this.alias,
+	})
 }
 
 // PrependActivityStreamsInvite prepends a Invite value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsInvite(v vocab.ActivityStreamsInvite) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsInviteMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsJoin prepends a Join value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsJoin(v vocab.ActivityStreamsJoin) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsJoinMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsLeave prepends a Leave value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsLeave(v vocab.ActivityStreamsLeave) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsLeaveMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsLike prepends a Like value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsLike(v vocab.ActivityStreamsLike) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsLikeMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsLink prepends a Link value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsLink(v vocab.ActivityStreamsLink) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsLinkMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsListen prepends a Listen value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsListen(v vocab.ActivityStreamsListen) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsListenMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsMention prepends a Mention value to the front of a list
-// of the property "to". Invalidates all iterators.
+// of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsMention(v vocab.ActivityStreamsMention) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsMentionMember: v,
 		alias:                        this.alias,
-		myIdx:                        0,
-		parent:                       this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsMove prepends a Move value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsMove(v vocab.ActivityStreamsMove) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsMoveMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsNote prepends a Note value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsNote(v vocab.ActivityStreamsNote) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsNoteMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsObject prepends a Object value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsObject(v vocab.ActivityStreamsObject) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsObjectMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsOffer prepends a Offer value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsOffer(v vocab.ActivityStreamsOffer) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsOfferMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsOrderedCollection prepends a OrderedCollection value to
-// the front of a list of the property "to". Invalidates all iterators.
+// the front of a list of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsOrderedCollection(v vocab.ActivityStreamsOrderedCollection) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsOrderedCollectionMember: v,
 		alias:                                  this.alias,
-		myIdx:                                  0,
-		parent:                                 this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsOrderedCollectionPage prepends a OrderedCollectionPage
-// value to the front of a list of the property "to". Invalidates all
-// iterators.
+// value to the front of a list of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsOrderedCollectionPage(v vocab.ActivityStreamsOrderedCollectionPage) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsOrderedCollectionPageMember: v,
-		alias:  this.alias,
-		myIdx:  0,
-		parent: this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+		alias: this.alias,
+	})
 }
 
 // PrependActivityStreamsOrganization prepends a Organization value to the front
-// of a list of the property "to". Invalidates all iterators.
+// of a list of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsOrganization(v vocab.ActivityStreamsOrganization) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsOrganizationMember: v,
 		alias:                             this.alias,
-		myIdx:                             0,
-		parent:                            this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsPage prepends a Page value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsPage(v vocab.ActivityStreamsPage) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsPageMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsPerson prepends a Person value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsPerson(v vocab.ActivityStreamsPerson) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsPersonMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsPlace prepends a Place value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsPlace(v vocab.ActivityStreamsPlace) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsPlaceMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsProfile prepends a Profile value to the front of a list
-// of the property "to". Invalidates all iterators.
+// of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsProfile(v vocab.ActivityStreamsProfile) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsProfileMember: v,
 		alias:                        this.alias,
-		myIdx:                        0,
-		parent:                       this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsQuestion prepends a Question value to the front of a list
-// of the property "to". Invalidates all iterators.
+// of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsQuestionMember: v,
 		alias:                         this.alias,
-		myIdx:                         0,
-		parent:                        this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsRead prepends a Read value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsRead(v vocab.ActivityStreamsRead) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsReadMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsReject prepends a Reject value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsReject(v vocab.ActivityStreamsReject) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsRejectMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsRelationship prepends a Relationship value to the front
-// of a list of the property "to". Invalidates all iterators.
+// of a list of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsRelationship(v vocab.ActivityStreamsRelationship) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsRelationshipMember: v,
 		alias:                             this.alias,
-		myIdx:                             0,
-		parent:                            this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsRemove prepends a Remove value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsRemove(v vocab.ActivityStreamsRemove) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsRemoveMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsService prepends a Service value to the front of a list
-// of the property "to". Invalidates all iterators.
+// of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsService(v vocab.ActivityStreamsService) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsServiceMember: v,
 		alias:                        this.alias,
-		myIdx:                        0,
-		parent:                       this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsTentativeAccept prepends a TentativeAccept value to the
-// front of a list of the property "to". Invalidates all iterators.
+// front of a list of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsTentativeAccept(v vocab.ActivityStreamsTentativeAccept) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsTentativeAcceptMember: v,
 		alias:                                this.alias,
-		myIdx:                                0,
-		parent:                               this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsTentativeReject prepends a TentativeReject value to the
-// front of a list of the property "to". Invalidates all iterators.
+// front of a list of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsTentativeReject(v vocab.ActivityStreamsTentativeReject) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsTentativeRejectMember: v,
 		alias:                                this.alias,
-		myIdx:                                0,
-		parent:                               this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsTombstone prepends a Tombstone value to the front of a
-// list of the property "to". Invalidates all iterators.
+// list of the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsTombstone(v vocab.ActivityStreamsTombstone) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsTombstoneMember: v,
 		alias:                          this.alias,
-		myIdx:                          0,
-		parent:                         this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsTravel prepends a Travel value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsTravel(v vocab.ActivityStreamsTravel) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsTravelMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsUndo prepends a Undo value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsUndo(v vocab.ActivityStreamsUndo) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsUndoMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsUpdate prepends a Update value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsUpdate(v vocab.ActivityStreamsUpdate) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsUpdateMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsVideo prepends a Video value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsVideo(v vocab.ActivityStreamsVideo) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsVideoMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsView prepends a View value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependActivityStreamsView(v vocab.ActivityStreamsView) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		activitystreamsViewMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedBranch prepends a Branch value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependForgeFedBranch(v vocab.ForgeFedBranch) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedBranchMember: v,
-		myIdx:                0,
-		parent:               this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedCommit prepends a Commit value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependForgeFedCommit(v vocab.ForgeFedCommit) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedCommitMember: v,
-		myIdx:                0,
-		parent:               this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedPush prepends a Push value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependForgeFedPush(v vocab.ForgeFedPush) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		alias:              this.alias,
 		forgefedPushMember: v,
-		myIdx:              0,
-		parent:             this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedRepository prepends a Repository value to the front of a list of
-// the property "to". Invalidates all iterators.
+// the property "to".
 func (this *ActivityStreamsToProperty) PrependForgeFedRepository(v vocab.ForgeFedRepository) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		alias:                    this.alias,
 		forgefedRepositoryMember: v,
-		myIdx:                    0,
-		parent:                   this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedTicket prepends a Ticket value to the front of a list of the
-// property "to". Invalidates all iterators.
+// property "to".
 func (this *ActivityStreamsToProperty) PrependForgeFedTicket(v vocab.ForgeFedTicket) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                0,
-		parent:               this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedTicketDependency prepends a TicketDependency value to the front
-// of a list of the property "to". Invalidates all iterators.
+// of a list of the property "to".
 func (this *ActivityStreamsToProperty) PrependForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		alias:                          this.alias,
 		forgefedTicketDependencyMember: v,
-		myIdx:                          0,
-		parent:                         this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependIRI prepends an IRI value to the front of a list of the property "to".
 func (this *ActivityStreamsToProperty) PrependIRI(v *url.URL) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  0,
-		parent: this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// PrependLitepubEmojiReact prepends a EmojiReact value to the front of a list of
+// the property "to".
+func (this *ActivityStreamsToProperty) PrependLitepubEmojiReact(v vocab.LitepubEmojiReact) {
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
+		alias:                   this.alias,
+		litepubEmojiReactMember: v,
+	})
+}
+
+// PrependSchemaPropertyValue prepends a PropertyValue value to the front of a
+// list of the property "to".
+func (this *ActivityStreamsToProperty) PrependSchemaPropertyValue(v vocab.SchemaPropertyValue) {
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
+		alias:                     this.alias,
+		schemaPropertyValueMember: v,
+	})
 }
 
 // PrependTootEmoji prepends a Emoji value to the front of a list of the property
-// "to". Invalidates all iterators.
+// "to".
 func (this *ActivityStreamsToProperty) PrependTootEmoji(v vocab.TootEmoji) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		alias:           this.alias,
-		myIdx:           0,
-		parent:          this,
 		tootEmojiMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependTootIdentityProof prepends a IdentityProof value to the front of a list
-// of the property "to". Invalidates all iterators.
+// of the property "to".
 func (this *ActivityStreamsToProperty) PrependTootIdentityProof(v vocab.TootIdentityProof) {
-	this.properties = append([]*ActivityStreamsToPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsToPropertyIterator{
 		alias:                   this.alias,
-		myIdx:                   0,
-		parent:                  this,
 		tootIdentityProofMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependType prepends an arbitrary type value to the front of a list of the
-// property "to". Invalidates all iterators. Returns an error if the type is
-// not a valid one to set for this property.
+// property "to". Returns an error if the type is not a valid one to set for
+// this property.
 func (this *ActivityStreamsToProperty) PrependType(t vocab.Type) error {
-	n := &ActivityStreamsToPropertyIterator{
-		alias:  this.alias,
-		myIdx:  0,
-		parent: this,
-	}
+	n := &ActivityStreamsToPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append([]*ActivityStreamsToPropertyIterator{n}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(n)
 	return nil
 }
 
+// Release returns this property and its values to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsToProperty) Release() {
+	for i, elem := range this.properties {
+		elem.Release()
+		this.properties[i] = nil
+	}
+	*this = ActivityStreamsToProperty{properties: this.properties[:0]}
+	poolActivityStreamsToProperty.Put(this)
+}
+
 // Remove deletes an element at the specified index from a list of the property
 // "to", regardless of its type. Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ActivityStreamsToProperty) Remove(idx int) {
-	(this.properties)[idx].parent = nil
-	copy((this.properties)[idx:], (this.properties)[idx+1:])
-	(this.properties)[len(this.properties)-1] = &ActivityStreamsToPropertyIterator{}
-	this.properties = (this.properties)[:len(this.properties)-1]
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Remove(idx)
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -6191,838 +6996,635 @@ func (this ActivityStreamsToProperty) Serialize() (interface{}, error) {
 }
 
 // SetActivityStreamsAccept sets a Accept value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsAccept(idx int, v vocab.ActivityStreamsAccept) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsAcceptMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsActivity sets a Activity value to be at the specified index
-// for the property "to". Panics if the index is out of bounds. Invalidates
-// all iterators.
+// for the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsActivity(idx int, v vocab.ActivityStreamsActivity) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsActivityMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
+	})
 }
 
 // SetActivityStreamsAdd sets a Add value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsAdd(idx int, v vocab.ActivityStreamsAdd) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsAddMember: v,
 		alias:                    this.alias,
-		myIdx:                    idx,
-		parent:                   this,
-	}
+	})
 }
 
 // SetActivityStreamsAnnounce sets a Announce value to be at the specified index
-// for the property "to". Panics if the index is out of bounds. Invalidates
-// all iterators.
+// for the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsAnnounce(idx int, v vocab.ActivityStreamsAnnounce) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsAnnounceMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
+	})
 }
 
 // SetActivityStreamsApplication sets a Application value to be at the specified
 // index for the property "to". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ActivityStreamsToProperty) SetActivityStreamsApplication(idx int, v vocab.ActivityStreamsApplication) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsApplicationMember: v,
 		alias:                            this.alias,
-		myIdx:                            idx,
-		parent:                           this,
-	}
+	})
 }
 
 // SetActivityStreamsArrive sets a Arrive value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsArrive(idx int, v vocab.ActivityStreamsArrive) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsArriveMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsArticle sets a Article value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsArticle(idx int, v vocab.ActivityStreamsArticle) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsArticleMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
+	})
 }
 
 // SetActivityStreamsAudio sets a Audio value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsAudio(idx int, v vocab.ActivityStreamsAudio) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsAudioMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsBlock sets a Block value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsBlock(idx int, v vocab.ActivityStreamsBlock) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsBlockMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsCollection sets a Collection value to be at the specified
 // index for the property "to". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ActivityStreamsToProperty) SetActivityStreamsCollection(idx int, v vocab.ActivityStreamsCollection) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsCollectionMember: v,
 		alias:                           this.alias,
-		myIdx:                           idx,
-		parent:                          this,
-	}
+	})
 }
 
 // SetActivityStreamsCollectionPage sets a CollectionPage value to be at the
-// specified index for the property "to". Panics if the index is out of
-// bounds. Invalidates all iterators.
+// specified index for the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsCollectionPage(idx int, v vocab.ActivityStreamsCollectionPage) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsCollectionPageMember: v,
 		alias:                               this.alias,
-		myIdx:                               idx,
-		parent:                              this,
-	}
+	})
 }
 
 // SetActivityStreamsCreate sets a Create value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsCreate(idx int, v vocab.ActivityStreamsCreate) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsCreateMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsDelete sets a Delete value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsDelete(idx int, v vocab.ActivityStreamsDelete) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsDeleteMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsDislike sets a Dislike value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsDislike(idx int, v vocab.ActivityStreamsDislike) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsDislikeMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
+	})
 }
 
 // SetActivityStreamsDocument sets a Document value to be at the specified index
-// for the property "to". Panics if the index is out of bounds. Invalidates
-// all iterators.
+// for the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsDocument(idx int, v vocab.ActivityStreamsDocument) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsDocumentMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
+	})
 }
 
 // SetActivityStreamsEvent sets a Event value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsEvent(idx int, v vocab.ActivityStreamsEvent) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsEventMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsFlag sets a Flag value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsFlag(idx int, v vocab.ActivityStreamsFlag) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsFlagMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsFollow sets a Follow value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsFollow(idx int, v vocab.ActivityStreamsFollow) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsFollowMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsGroup sets a Group value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsGroup(idx int, v vocab.ActivityStreamsGroup) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsGroupMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
+}
+
+// SetActivityStreamsHashtag sets a Hashtag value to be at the specified index for
+// the property "to". Panics if the index is out of bounds.
+func (this *ActivityStreamsToProperty) SetActivityStreamsHashtag(idx int, v vocab.ActivityStreamsHashtag) {
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+	})
 }
 
 // SetActivityStreamsIgnore sets a Ignore value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsIgnore(idx int, v vocab.ActivityStreamsIgnore) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsIgnoreMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsImage sets a Image value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsImage(idx int, v vocab.ActivityStreamsImage) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsImageMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsIntransitiveActivity sets a IntransitiveActivity value to be
 // at the specified index for the property "to". Panics if the index is out of
-// bounds. Invalidates all iterators.
+// bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsIntransitiveActivity(idx int, v vocab.ActivityStreamsIntransitiveActivity) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsIntransitiveActivityMember: v,
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+		alias: this.alias,
+	})
 }
 
 // SetActivityStreamsInvite sets a Invite value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsInvite(idx int, v vocab.ActivityStreamsInvite) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsInviteMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsJoin sets a Join value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsJoin(idx int, v vocab.ActivityStreamsJoin) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsJoinMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsLeave sets a Leave value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsLeave(idx int, v vocab.ActivityStreamsLeave) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsLeaveMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsLike sets a Like value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsLike(idx int, v vocab.ActivityStreamsLike) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsLikeMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsLink sets a Link value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsLink(idx int, v vocab.ActivityStreamsLink) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsLinkMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsListen sets a Listen value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsListen(idx int, v vocab.ActivityStreamsListen) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsListenMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsMention sets a Mention value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsMention(idx int, v vocab.ActivityStreamsMention) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsMentionMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
+	})
 }
 
 // SetActivityStreamsMove sets a Move value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsMove(idx int, v vocab.ActivityStreamsMove) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsMoveMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsNote sets a Note value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsNote(idx int, v vocab.ActivityStreamsNote) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsNoteMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsObject sets a Object value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsObject(idx int, v vocab.ActivityStreamsObject) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsObjectMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsOffer sets a Offer value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsOffer(idx int, v vocab.ActivityStreamsOffer) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsOfferMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsOrderedCollection sets a OrderedCollection value to be at the
-// specified index for the property "to". Panics if the index is out of
-// bounds. Invalidates all iterators.
+// specified index for the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsOrderedCollection(idx int, v vocab.ActivityStreamsOrderedCollection) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsOrderedCollectionMember: v,
 		alias:                                  this.alias,
-		myIdx:                                  idx,
-		parent:                                 this,
-	}
+	})
 }
 
 // SetActivityStreamsOrderedCollectionPage sets a OrderedCollectionPage value to
 // be at the specified index for the property "to". Panics if the index is out
-// of bounds. Invalidates all iterators.
+// of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsOrderedCollectionPage(idx int, v vocab.ActivityStreamsOrderedCollectionPage) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsOrderedCollectionPageMember: v,
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+		alias: this.alias,
+	})
 }
 
 // SetActivityStreamsOrganization sets a Organization value to be at the specified
 // index for the property "to". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ActivityStreamsToProperty) SetActivityStreamsOrganization(idx int, v vocab.ActivityStreamsOrganization) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsOrganizationMember: v,
 		alias:                             this.alias,
-		myIdx:                             idx,
-		parent:                            this,
-	}
+	})
 }
 
 // SetActivityStreamsPage sets a Page value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsPage(idx int, v vocab.ActivityStreamsPage) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsPageMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsPerson sets a Person value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsPerson(idx int, v vocab.ActivityStreamsPerson) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsPersonMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsPlace sets a Place value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsPlace(idx int, v vocab.ActivityStreamsPlace) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsPlaceMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsProfile sets a Profile value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsProfile(idx int, v vocab.ActivityStreamsProfile) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsProfileMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
+	})
 }
 
 // SetActivityStreamsQuestion sets a Question value to be at the specified index
-// for the property "to". Panics if the index is out of bounds. Invalidates
-// all iterators.
+// for the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsQuestion(idx int, v vocab.ActivityStreamsQuestion) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsQuestionMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
+	})
 }
 
 // SetActivityStreamsRead sets a Read value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsRead(idx int, v vocab.ActivityStreamsRead) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsReadMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsReject sets a Reject value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsReject(idx int, v vocab.ActivityStreamsReject) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsRejectMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsRelationship sets a Relationship value to be at the specified
 // index for the property "to". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ActivityStreamsToProperty) SetActivityStreamsRelationship(idx int, v vocab.ActivityStreamsRelationship) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsRelationshipMember: v,
 		alias:                             this.alias,
-		myIdx:                             idx,
-		parent:                            this,
-	}
+	})
 }
 
 // SetActivityStreamsRemove sets a Remove value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsRemove(idx int, v vocab.ActivityStreamsRemove) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsRemoveMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsService sets a Service value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsService(idx int, v vocab.ActivityStreamsService) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsServiceMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
+	})
 }
 
 // SetActivityStreamsTentativeAccept sets a TentativeAccept value to be at the
-// specified index for the property "to". Panics if the index is out of
-// bounds. Invalidates all iterators.
+// specified index for the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsTentativeAccept(idx int, v vocab.ActivityStreamsTentativeAccept) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsTentativeAcceptMember: v,
 		alias:                                this.alias,
-		myIdx:                                idx,
-		parent:                               this,
-	}
+	})
 }
 
 // SetActivityStreamsTentativeReject sets a TentativeReject value to be at the
-// specified index for the property "to". Panics if the index is out of
-// bounds. Invalidates all iterators.
+// specified index for the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsTentativeReject(idx int, v vocab.ActivityStreamsTentativeReject) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsTentativeRejectMember: v,
 		alias:                                this.alias,
-		myIdx:                                idx,
-		parent:                               this,
-	}
+	})
 }
 
 // SetActivityStreamsTombstone sets a Tombstone value to be at the specified index
-// for the property "to". Panics if the index is out of bounds. Invalidates
-// all iterators.
+// for the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsTombstone(idx int, v vocab.ActivityStreamsTombstone) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsTombstoneMember: v,
 		alias:                          this.alias,
-		myIdx:                          idx,
-		parent:                         this,
-	}
+	})
 }
 
 // SetActivityStreamsTravel sets a Travel value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsTravel(idx int, v vocab.ActivityStreamsTravel) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsTravelMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsUndo sets a Undo value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsUndo(idx int, v vocab.ActivityStreamsUndo) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsUndoMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsUpdate sets a Update value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsUpdate(idx int, v vocab.ActivityStreamsUpdate) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsUpdateMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsVideo sets a Video value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsVideo(idx int, v vocab.ActivityStreamsVideo) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsVideoMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsView sets a View value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetActivityStreamsView(idx int, v vocab.ActivityStreamsView) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		activitystreamsViewMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetForgeFedBranch sets a Branch value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetForgeFedBranch(idx int, v vocab.ForgeFedBranch) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedBranchMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
+	})
 }
 
 // SetForgeFedCommit sets a Commit value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetForgeFedCommit(idx int, v vocab.ForgeFedCommit) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedCommitMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
+	})
 }
 
 // SetForgeFedPush sets a Push value to be at the specified index for the property
-// "to". Panics if the index is out of bounds. Invalidates all iterators.
+// "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetForgeFedPush(idx int, v vocab.ForgeFedPush) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		alias:              this.alias,
 		forgefedPushMember: v,
-		myIdx:              idx,
-		parent:             this,
-	}
+	})
 }
 
 // SetForgeFedRepository sets a Repository value to be at the specified index for
-// the property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetForgeFedRepository(idx int, v vocab.ForgeFedRepository) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		alias:                    this.alias,
 		forgefedRepositoryMember: v,
-		myIdx:                    idx,
-		parent:                   this,
-	}
+	})
 }
 
 // SetForgeFedTicket sets a Ticket value to be at the specified index for the
-// property "to". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetForgeFedTicket(idx int, v vocab.ForgeFedTicket) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
+	})
 }
 
 // SetForgeFedTicketDependency sets a TicketDependency value to be at the
-// specified index for the property "to". Panics if the index is out of
-// bounds. Invalidates all iterators.
+// specified index for the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetForgeFedTicketDependency(idx int, v vocab.ForgeFedTicketDependency) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		alias:                          this.alias,
 		forgefedTicketDependencyMember: v,
-		myIdx:                          idx,
-		parent:                         this,
-	}
+	})
 }
 
 // SetIRI sets an IRI value to be at the specified index for the property "to".
 // Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetIRI(idx int, v *url.URL) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// SetLitepubEmojiReact sets a EmojiReact value to be at the specified index for
+// the property "to". Panics if the index is out of bounds.
+func (this *ActivityStreamsToProperty) SetLitepubEmojiReact(idx int, v vocab.LitepubEmojiReact) {
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
+		alias:                   this.alias,
+		litepubEmojiReactMember: v,
+	})
+}
+
+// SetSchemaPropertyValue sets a PropertyValue value to be at the specified index
+// for the property "to". Panics if the index is out of bounds.
+func (this *ActivityStreamsToProperty) SetSchemaPropertyValue(idx int, v vocab.SchemaPropertyValue) {
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
+		alias:                     this.alias,
+		schemaPropertyValueMember: v,
+	})
 }
 
 // SetTootEmoji sets a Emoji value to be at the specified index for the property
-// "to". Panics if the index is out of bounds. Invalidates all iterators.
+// "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetTootEmoji(idx int, v vocab.TootEmoji) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		alias:           this.alias,
-		myIdx:           idx,
-		parent:          this,
 		tootEmojiMember: v,
-	}
+	})
 }
 
 // SetTootIdentityProof sets a IdentityProof value to be at the specified index
-// for the property "to". Panics if the index is out of bounds. Invalidates
-// all iterators.
+// for the property "to". Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetTootIdentityProof(idx int, v vocab.TootIdentityProof) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsToPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsToPropertyIterator{
 		alias:                   this.alias,
-		myIdx:                   idx,
-		parent:                  this,
 		tootIdentityProofMember: v,
-	}
+	})
 }
 
 // SetType sets an arbitrary type value to the specified index of the property
-// "to". Invalidates all iterators. Returns an error if the type is not a
-// valid one to set for this property. Panics if the index is out of bounds.
+// "to". Returns an error if the type is not a valid one to set for this
+// property. Panics if the index is out of bounds.
 func (this *ActivityStreamsToProperty) SetType(idx int, t vocab.Type) error {
-	n := &ActivityStreamsToPropertyIterator{
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+	n := &ActivityStreamsToPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	(this.properties)[idx] = n
+	this.properties.Set(idx, n)
 	return nil
 }
 
 // Swap swaps the location of values at two indices for the "to" property.
 func (this ActivityStreamsToProperty) Swap(i, j int) {
-	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+	this.properties.Swap(i, j)
+}
+
+// Values returns the iterators of all values from front to back, so they can be
+// ranged over. Adding values to or removing them from this property
+// afterwards does not change the returned slice.
+func (this ActivityStreamsToProperty) Values() []vocab.ActivityStreamsToPropertyIterator {
+	values := make([]vocab.ActivityStreamsToPropertyIterator, len(this.properties))
+	for i, elem := range this.properties {
+		values[i] = elem
+	}
+	return values
 }
+
+// poolActivityStreamsToProperty holds the released values of ActivityStreamsToProperty, which are reused when deserializing.
+var poolActivityStreamsToProperty = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsToProperty{}
+}}
+
+// poolActivityStreamsToPropertyIterator holds the released values of ActivityStreamsToPropertyIterator, which are reused when deserializing.
+var poolActivityStreamsToPropertyIterator = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsToPropertyIterator{}
+}}
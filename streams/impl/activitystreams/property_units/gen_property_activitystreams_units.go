@@ -8,6 +8,7 @@ import (
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"sync"
 )
 
 // ActivityStreamsUnitsProperty is the functional property "units". It is
@@ -39,20 +40,23 @@ func DeserializeUnitsProperty(m map[string]interface{}, aliasMap map[string]stri
 
 	if ok {
 		if v, err := string1.DeserializeString(i); err == nil {
-			this := &ActivityStreamsUnitsProperty{
+			this := poolActivityStreamsUnitsProperty.Get().(*ActivityStreamsUnitsProperty)
+			*this = ActivityStreamsUnitsProperty{
 				alias:                 alias,
 				hasStringMember:       true,
 				xmlschemaStringMember: v,
 			}
 			return this, nil
 		} else if v, err := anyuri.DeserializeAnyURI(i); err == nil {
-			this := &ActivityStreamsUnitsProperty{
+			this := poolActivityStreamsUnitsProperty.Get().(*ActivityStreamsUnitsProperty)
+			*this = ActivityStreamsUnitsProperty{
 				alias:                 alias,
 				xmlschemaAnyURIMember: v,
 			}
 			return this, nil
 		}
-		this := &ActivityStreamsUnitsProperty{
+		this := poolActivityStreamsUnitsProperty.Get().(*ActivityStreamsUnitsProperty)
+		*this = ActivityStreamsUnitsProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -66,6 +70,14 @@ func NewActivityStreamsUnitsProperty() *ActivityStreamsUnitsProperty {
 	return &ActivityStreamsUnitsProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ActivityStreamsUnitsProperty) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+}
+
 // Clear ensures no value of this property is set. Calling HasAny or any of the
 // 'Is' methods afterwards will return false.
 func (this *ActivityStreamsUnitsProperty) Clear() {
@@ -74,6 +86,36 @@ func (this *ActivityStreamsUnitsProperty) Clear() {
 	this.unknown = nil
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsUnitsProperty) Compare(o vocab.ActivityStreamsUnitsProperty) int {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return -1
+	} else if idx1 > idx2 {
+		return 1
+	} else if this.IsXMLSchemaString() {
+		if lhs, rhs := this.GetXMLSchemaString(), o.GetXMLSchemaString(); string1.LessString(lhs, rhs) {
+			return -1
+		} else if string1.LessString(rhs, lhs) {
+			return 1
+		}
+		return 0
+	} else if this.IsXMLSchemaAnyURI() {
+		if lhs, rhs := this.GetXMLSchemaAnyURI(), o.GetXMLSchemaAnyURI(); anyuri.LessAnyURI(lhs, rhs) {
+			return -1
+		} else if anyuri.LessAnyURI(rhs, lhs) {
+			return 1
+		}
+		return 0
+	}
+	return 0
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this ActivityStreamsUnitsProperty) GetIRI() *url.URL {
@@ -122,17 +164,8 @@ func (this ActivityStreamsUnitsProperty) IsXMLSchemaString() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsUnitsProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -157,18 +190,7 @@ func (this ActivityStreamsUnitsProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsUnitsProperty) LessThan(o vocab.ActivityStreamsUnitsProperty) bool {
-	idx1 := this.KindIndex()
-	idx2 := o.KindIndex()
-	if idx1 < idx2 {
-		return true
-	} else if idx1 > idx2 {
-		return false
-	} else if this.IsXMLSchemaString() {
-		return string1.LessString(this.GetXMLSchemaString(), o.GetXMLSchemaString())
-	} else if this.IsXMLSchemaAnyURI() {
-		return anyuri.LessAnyURI(this.GetXMLSchemaAnyURI(), o.GetXMLSchemaAnyURI())
-	}
-	return false
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "units".
@@ -180,6 +202,15 @@ func (this ActivityStreamsUnitsProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsUnitsProperty) Release() {
+	*this = ActivityStreamsUnitsProperty{}
+	poolActivityStreamsUnitsProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -213,3 +244,8 @@ func (this *ActivityStreamsUnitsProperty) SetXMLSchemaString(v string) {
 	this.xmlschemaStringMember = v
 	this.hasStringMember = true
 }
+
+// poolActivityStreamsUnitsProperty holds the released values of ActivityStreamsUnitsProperty, which are reused when deserializing.
+var poolActivityStreamsUnitsProperty = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsUnitsProperty{}
+}}
@@ -7,6 +7,8 @@ import (
 	datetime "github.com/go-fed/activity/streams/values/dateTime"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -40,7 +42,8 @@ func DeserializeUpdatedProperty(m map[string]interface{}, aliasMap map[string]st
 			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 			if err == nil && len(u.Scheme) > 0 {
-				this := &ActivityStreamsUpdatedProperty{
+				this := poolActivityStreamsUpdatedProperty.Get().(*ActivityStreamsUpdatedProperty)
+				*this = ActivityStreamsUpdatedProperty{
 					alias: alias,
 					iri:   u,
 				}
@@ -48,14 +51,16 @@ func DeserializeUpdatedProperty(m map[string]interface{}, aliasMap map[string]st
 			}
 		}
 		if v, err := datetime.DeserializeDateTime(i); err == nil {
-			this := &ActivityStreamsUpdatedProperty{
+			this := poolActivityStreamsUpdatedProperty.Get().(*ActivityStreamsUpdatedProperty)
+			*this = ActivityStreamsUpdatedProperty{
 				alias:                   alias,
 				hasDateTimeMember:       true,
 				xmlschemaDateTimeMember: v,
 			}
 			return this, nil
 		}
-		this := &ActivityStreamsUpdatedProperty{
+		this := poolActivityStreamsUpdatedProperty.Get().(*ActivityStreamsUpdatedProperty)
+		*this = ActivityStreamsUpdatedProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -69,6 +74,14 @@ func NewActivityStreamsUpdatedProperty() *ActivityStreamsUpdatedProperty {
 	return &ActivityStreamsUpdatedProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ActivityStreamsUpdatedProperty) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+}
+
 // Clear ensures no value of this property is set. Calling IsXMLSchemaDateTime
 // afterwards will return false.
 func (this *ActivityStreamsUpdatedProperty) Clear() {
@@ -77,6 +90,42 @@ func (this *ActivityStreamsUpdatedProperty) Clear() {
 	this.hasDateTimeMember = false
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsUpdatedProperty) Compare(o vocab.ActivityStreamsUpdatedProperty) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsXMLSchemaDateTime() && !o.IsXMLSchemaDateTime() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsXMLSchemaDateTime() && !o.IsXMLSchemaDateTime() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsXMLSchemaDateTime() && o.IsXMLSchemaDateTime() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	if lhs, rhs := this.Get(), o.Get(); datetime.LessDateTime(lhs, rhs) {
+		return -1
+	} else if datetime.LessDateTime(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
 // Get returns the value of this property. When IsXMLSchemaDateTime returns false,
 // Get will return any arbitrary value.
 func (this ActivityStreamsUpdatedProperty) Get() time.Time {
@@ -108,17 +157,8 @@ func (this ActivityStreamsUpdatedProperty) IsXMLSchemaDateTime() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsUpdatedProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -140,30 +180,7 @@ func (this ActivityStreamsUpdatedProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsUpdatedProperty) LessThan(o vocab.ActivityStreamsUpdatedProperty) bool {
-	// LessThan comparison for if either or both are IRIs.
-	if this.IsIRI() && o.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	} else if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsXMLSchemaDateTime() && !o.IsXMLSchemaDateTime() {
-		// Both are unknowns.
-		return false
-	} else if this.IsXMLSchemaDateTime() && !o.IsXMLSchemaDateTime() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsXMLSchemaDateTime() && o.IsXMLSchemaDateTime() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return datetime.LessDateTime(this.Get(), o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "updated".
@@ -175,6 +192,15 @@ func (this ActivityStreamsUpdatedProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsUpdatedProperty) Release() {
+	*this = ActivityStreamsUpdatedProperty{}
+	poolActivityStreamsUpdatedProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -202,3 +228,8 @@ func (this *ActivityStreamsUpdatedProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.iri = v
 }
+
+// poolActivityStreamsUpdatedProperty holds the released values of ActivityStreamsUpdatedProperty, which are reused when deserializing.
+var poolActivityStreamsUpdatedProperty = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsUpdatedProperty{}
+}}
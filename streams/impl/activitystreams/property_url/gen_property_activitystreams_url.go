@@ -4,9 +4,12 @@ package propertyurl
 
 import (
 	"fmt"
+	proplist "github.com/go-fed/activity/streams/impl/proplist"
 	anyuri "github.com/go-fed/activity/streams/values/anyURI"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ActivityStreamsUrlPropertyIterator is an iterator for a property. It is
@@ -17,11 +20,11 @@ import (
 type ActivityStreamsUrlPropertyIterator struct {
 	xmlschemaAnyURIMember        *url.URL
 	activitystreamsLinkMember    vocab.ActivityStreamsLink
+	activitystreamsHashtagMember vocab.ActivityStreamsHashtag
 	activitystreamsMentionMember vocab.ActivityStreamsMention
 	unknown                      interface{}
 	alias                        string
-	myIdx                        int
-	parent                       vocab.ActivityStreamsUrlProperty
+	proplist.Links[*ActivityStreamsUrlPropertyIterator]
 }
 
 // NewActivityStreamsUrlPropertyIterator creates a new ActivityStreamsUrl property.
@@ -37,34 +40,163 @@ func deserializeActivityStreamsUrlPropertyIterator(i interface{}, aliasMap map[s
 		alias = a
 	}
 	if m, ok := i.(map[string]interface{}); ok {
-		if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsUrlPropertyIterator{
-				activitystreamsLinkMember: v,
-				alias:                     alias,
+		// Begin: Determine the types of the value, to deserialize it as them first
+		typeValue, hasType := m["type"]
+		isType := func(vocabURI, name string) bool {
+			aliasPrefix := ""
+			if a, ok := aliasMap[vocabURI]; ok {
+				aliasPrefix = a + ":"
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsUrlPropertyIterator{
-				activitystreamsMentionMember: v,
-				alias:                        alias,
+			switch t := typeValue.(type) {
+			case string:
+				return strings.TrimPrefix(t, aliasPrefix) == name
+			case []interface{}:
+				for _, elem := range t {
+					if s, ok := elem.(string); ok && strings.TrimPrefix(s, aliasPrefix) == name {
+						return true
+					}
+				}
+			}
+			return false
+		}
+		// End: Determine the types of the value, to deserialize it as them first
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Link") {
+			if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsUrlPropertyIterator.Get().(*ActivityStreamsUrlPropertyIterator)
+				*this = ActivityStreamsUrlPropertyIterator{
+					activitystreamsLinkMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Hashtag") {
+			if v, err := mgr.DeserializeHashtagActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsUrlPropertyIterator.Get().(*ActivityStreamsUrlPropertyIterator)
+				*this = ActivityStreamsUrlPropertyIterator{
+					activitystreamsHashtagMember: v,
+					alias:                        alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Mention") {
+			if v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap); err == nil {
+				this := poolActivityStreamsUrlPropertyIterator.Get().(*ActivityStreamsUrlPropertyIterator)
+				*this = ActivityStreamsUrlPropertyIterator{
+					activitystreamsMentionMember: v,
+					alias:                        alias,
+				}
+				return this, nil
 			}
-			return this, nil
 		}
 	}
 	if v, err := anyuri.DeserializeAnyURI(i); err == nil {
-		this := &ActivityStreamsUrlPropertyIterator{
+		this := poolActivityStreamsUrlPropertyIterator.Get().(*ActivityStreamsUrlPropertyIterator)
+		*this = ActivityStreamsUrlPropertyIterator{
 			alias:                 alias,
 			xmlschemaAnyURIMember: v,
 		}
 		return this, nil
 	}
-	this := &ActivityStreamsUrlPropertyIterator{
+	this := poolActivityStreamsUrlPropertyIterator.Get().(*ActivityStreamsUrlPropertyIterator)
+	*this = ActivityStreamsUrlPropertyIterator{
 		alias:   alias,
 		unknown: i,
 	}
 	return this, nil
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ActivityStreamsUrlPropertyIterator) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+	if this.IsActivityStreamsLink() {
+		if a, ok := this.activitystreamsLinkMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsLinkMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsHashtag() {
+		if a, ok := this.activitystreamsHashtagMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsHashtagMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsMention() {
+		if a, ok := this.activitystreamsMentionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsMentionMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	}
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsUrlPropertyIterator) Compare(o vocab.ActivityStreamsUrlPropertyIterator) int {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return -1
+	} else if idx1 > idx2 {
+		return 1
+	} else if this.IsXMLSchemaAnyURI() {
+		if lhs, rhs := this.GetXMLSchemaAnyURI(), o.GetXMLSchemaAnyURI(); anyuri.LessAnyURI(lhs, rhs) {
+			return -1
+		} else if anyuri.LessAnyURI(rhs, lhs) {
+			return 1
+		}
+		return 0
+	} else if this.IsActivityStreamsLink() {
+		return this.GetActivityStreamsLink().Compare(o.GetActivityStreamsLink())
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().Compare(o.GetActivityStreamsHashtag())
+	} else if this.IsActivityStreamsMention() {
+		return this.GetActivityStreamsMention().Compare(o.GetActivityStreamsMention())
+	}
+	return 0
+}
+
+// GetActivityStreamsHashtag returns the value of this property. When
+// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag will
+// return an arbitrary value.
+func (this ActivityStreamsUrlPropertyIterator) GetActivityStreamsHashtag() vocab.ActivityStreamsHashtag {
+	return this.activitystreamsHashtagMember
+}
+
 // GetActivityStreamsLink returns the value of this property. When
 // IsActivityStreamsLink returns false, GetActivityStreamsLink will return an
 // arbitrary value.
@@ -91,6 +223,9 @@ func (this ActivityStreamsUrlPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsLink() {
 		return this.GetActivityStreamsLink()
 	}
+	if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag()
+	}
 	if this.IsActivityStreamsMention() {
 		return this.GetActivityStreamsMention()
 	}
@@ -108,9 +243,17 @@ func (this ActivityStreamsUrlPropertyIterator) GetXMLSchemaAnyURI() *url.URL {
 func (this ActivityStreamsUrlPropertyIterator) HasAny() bool {
 	return this.IsXMLSchemaAnyURI() ||
 		this.IsActivityStreamsLink() ||
+		this.IsActivityStreamsHashtag() ||
 		this.IsActivityStreamsMention()
 }
 
+// IsActivityStreamsHashtag returns true if this property has a type of "Hashtag".
+// When true, use the GetActivityStreamsHashtag and SetActivityStreamsHashtag
+// methods to access and set this property.
+func (this ActivityStreamsUrlPropertyIterator) IsActivityStreamsHashtag() bool {
+	return this.activitystreamsHashtagMember != nil
+}
+
 // IsActivityStreamsLink returns true if this property has a type of "Link". When
 // true, use the GetActivityStreamsLink and SetActivityStreamsLink methods to
 // access and set this property.
@@ -142,21 +285,8 @@ func (this ActivityStreamsUrlPropertyIterator) IsXMLSchemaAnyURI() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsUrlPropertyIterator) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	var child map[string]string
-	if this.IsActivityStreamsLink() {
-		child = this.GetActivityStreamsLink().JSONLDContext()
-	} else if this.IsActivityStreamsMention() {
-		child = this.GetActivityStreamsMention().JSONLDContext()
-	}
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -170,9 +300,12 @@ func (this ActivityStreamsUrlPropertyIterator) KindIndex() int {
 	if this.IsActivityStreamsLink() {
 		return 1
 	}
-	if this.IsActivityStreamsMention() {
+	if this.IsActivityStreamsHashtag() {
 		return 2
 	}
+	if this.IsActivityStreamsMention() {
+		return 3
+	}
 	if this.IsIRI() {
 		return -2
 	}
@@ -184,20 +317,7 @@ func (this ActivityStreamsUrlPropertyIterator) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsUrlPropertyIterator) LessThan(o vocab.ActivityStreamsUrlPropertyIterator) bool {
-	idx1 := this.KindIndex()
-	idx2 := o.KindIndex()
-	if idx1 < idx2 {
-		return true
-	} else if idx1 > idx2 {
-		return false
-	} else if this.IsXMLSchemaAnyURI() {
-		return anyuri.LessAnyURI(this.GetXMLSchemaAnyURI(), o.GetXMLSchemaAnyURI())
-	} else if this.IsActivityStreamsLink() {
-		return this.GetActivityStreamsLink().LessThan(o.GetActivityStreamsLink())
-	} else if this.IsActivityStreamsMention() {
-		return this.GetActivityStreamsMention().LessThan(o.GetActivityStreamsMention())
-	}
-	return false
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "ActivityStreamsUrl".
@@ -209,22 +329,55 @@ func (this ActivityStreamsUrlPropertyIterator) Name() string {
 	}
 }
 
-// Next returns the next iterator, or nil if there is no next iterator.
+// Next returns the next iterator, or nil if there is no next iterator. It remains
+// correct when values are added to or removed from the property, including
+// this one, without obtaining the iterator again.
 func (this ActivityStreamsUrlPropertyIterator) Next() vocab.ActivityStreamsUrlPropertyIterator {
-	if this.myIdx+1 >= this.parent.Len() {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx + 1)
+	if it := proplist.Next(&this); it != nil {
+		return it
 	}
+	return nil
 }
 
-// Prev returns the previous iterator, or nil if there is no previous iterator.
+// Prev returns the previous iterator, or nil if there is no previous iterator. It
+// remains correct when values are added to or removed from the property,
+// including this one, without obtaining the iterator again.
 func (this ActivityStreamsUrlPropertyIterator) Prev() vocab.ActivityStreamsUrlPropertyIterator {
-	if this.myIdx-1 < 0 {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx - 1)
+	if it := proplist.Prev(&this); it != nil {
+		return it
+	}
+	return nil
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsUrlPropertyIterator) Release() {
+	if r, ok := this.activitystreamsLinkMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
 	}
+	if r, ok := this.activitystreamsHashtagMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsMentionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	*this = ActivityStreamsUrlPropertyIterator{}
+	poolActivityStreamsUrlPropertyIterator.Put(this)
+}
+
+// SetActivityStreamsHashtag sets the value of this property. Calling
+// IsActivityStreamsHashtag afterwards returns true.
+func (this *ActivityStreamsUrlPropertyIterator) SetActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.clear()
+	this.activitystreamsHashtagMember = v
 }
 
 // SetActivityStreamsLink sets the value of this property. Calling
@@ -254,6 +407,10 @@ func (this *ActivityStreamsUrlPropertyIterator) SetType(t vocab.Type) error {
 		this.SetActivityStreamsLink(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsHashtag); ok {
+		this.SetActivityStreamsHashtag(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsMention); ok {
 		this.SetActivityStreamsMention(v)
 		return nil
@@ -274,6 +431,7 @@ func (this *ActivityStreamsUrlPropertyIterator) SetXMLSchemaAnyURI(v *url.URL) {
 func (this *ActivityStreamsUrlPropertyIterator) clear() {
 	this.xmlschemaAnyURIMember = nil
 	this.activitystreamsLinkMember = nil
+	this.activitystreamsHashtagMember = nil
 	this.activitystreamsMentionMember = nil
 	this.unknown = nil
 }
@@ -287,6 +445,8 @@ func (this ActivityStreamsUrlPropertyIterator) serialize() (interface{}, error)
 		return anyuri.SerializeAnyURI(this.GetXMLSchemaAnyURI())
 	} else if this.IsActivityStreamsLink() {
 		return this.GetActivityStreamsLink().Serialize()
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().Serialize()
 	} else if this.IsActivityStreamsMention() {
 		return this.GetActivityStreamsMention().Serialize()
 	}
@@ -296,7 +456,7 @@ func (this ActivityStreamsUrlPropertyIterator) serialize() (interface{}, error)
 // ActivityStreamsUrlProperty is the non-functional property "url". It is
 // permitted to have one or more values, and of different value types.
 type ActivityStreamsUrlProperty struct {
-	properties []*ActivityStreamsUrlPropertyIterator
+	properties proplist.List[*ActivityStreamsUrlPropertyIterator]
 	alias      string
 }
 
@@ -314,10 +474,9 @@ func DeserializeUrlProperty(m map[string]interface{}, aliasMap map[string]string
 	i, ok := m[propName]
 
 	if ok {
-		this := &ActivityStreamsUrlProperty{
-			alias:      alias,
-			properties: []*ActivityStreamsUrlPropertyIterator{},
-		}
+		// A released value keeps its properties for reuse.
+		this := poolActivityStreamsUrlProperty.Get().(*ActivityStreamsUrlProperty)
+		this.alias = alias
 		if list, ok := i.([]interface{}); ok {
 			for _, iterator := range list {
 				if p, err := deserializeActivityStreamsUrlPropertyIterator(iterator, aliasMap); err != nil {
@@ -334,10 +493,7 @@ func DeserializeUrlProperty(m map[string]interface{}, aliasMap map[string]string
 			}
 		}
 		// Set up the properties for iteration.
-		for idx, ele := range this.properties {
-			ele.parent = this
-			ele.myIdx = idx
-		}
+		this.properties.Link()
 		return this, nil
 	}
 	return nil, nil
@@ -348,61 +504,69 @@ func NewActivityStreamsUrlProperty() *ActivityStreamsUrlProperty {
 	return &ActivityStreamsUrlProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and each of its values.
+func (this ActivityStreamsUrlProperty) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+	for _, elem := range this.properties {
+		elem.AddJSONLDContext(m)
+	}
+}
+
+// AppendActivityStreamsHashtag appends a Hashtag value to the back of a list of
+// the property "url".
+func (this *ActivityStreamsUrlProperty) AppendActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.properties.Append(&ActivityStreamsUrlPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+	})
+}
+
 // AppendActivityStreamsLink appends a Link value to the back of a list of the
-// property "url". Invalidates iterators that are traversing using Prev.
+// property "url".
 func (this *ActivityStreamsUrlProperty) AppendActivityStreamsLink(v vocab.ActivityStreamsLink) {
-	this.properties = append(this.properties, &ActivityStreamsUrlPropertyIterator{
+	this.properties.Append(&ActivityStreamsUrlPropertyIterator{
 		activitystreamsLinkMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsMention appends a Mention value to the back of a list of
-// the property "url". Invalidates iterators that are traversing using Prev.
+// the property "url".
 func (this *ActivityStreamsUrlProperty) AppendActivityStreamsMention(v vocab.ActivityStreamsMention) {
-	this.properties = append(this.properties, &ActivityStreamsUrlPropertyIterator{
+	this.properties.Append(&ActivityStreamsUrlPropertyIterator{
 		activitystreamsMentionMember: v,
 		alias:                        this.alias,
-		myIdx:                        this.Len(),
-		parent:                       this,
 	})
 }
 
 // AppendIRI appends an IRI value to the back of a list of the property "url"
 func (this *ActivityStreamsUrlProperty) AppendIRI(v *url.URL) {
-	this.properties = append(this.properties, &ActivityStreamsUrlPropertyIterator{
+	this.properties.Append(&ActivityStreamsUrlPropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 this.Len(),
-		parent:                this,
 		xmlschemaAnyURIMember: v,
 	})
 }
 
-// PrependType prepends an arbitrary type value to the front of a list of the
-// property "url". Invalidates iterators that are traversing using Prev.
-// Returns an error if the type is not a valid one to set for this property.
+// AppendType appends an arbitrary type value to the back of a list of the
+// property "url". Returns an error if the type is not a valid one to set for
+// this property.
 func (this *ActivityStreamsUrlProperty) AppendType(t vocab.Type) error {
-	n := &ActivityStreamsUrlPropertyIterator{
-		alias:  this.alias,
-		myIdx:  this.Len(),
-		parent: this,
-	}
+	n := &ActivityStreamsUrlPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append(this.properties, n)
+	this.properties.Append(n)
 	return nil
 }
 
 // AppendXMLSchemaAnyURI appends a anyURI value to the back of a list of the
-// property "url". Invalidates iterators that are traversing using Prev.
+// property "url".
 func (this *ActivityStreamsUrlProperty) AppendXMLSchemaAnyURI(v *url.URL) {
-	this.properties = append(this.properties, &ActivityStreamsUrlPropertyIterator{
+	this.properties.Append(&ActivityStreamsUrlPropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 this.Len(),
-		parent:                this,
 		xmlschemaAnyURIMember: v,
 	})
 }
@@ -424,6 +588,31 @@ func (this ActivityStreamsUrlProperty) Begin() vocab.ActivityStreamsUrlPropertyI
 	}
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ActivityStreamsUrlProperty) Compare(o vocab.ActivityStreamsUrlProperty) int {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if c := this.properties[i].Compare(o.At(i)); c != 0 {
+			return c
+		}
+	}
+	if l1 < l2 {
+		return -1
+	} else if l1 > l2 {
+		return 1
+	}
+	return 0
+}
+
 // Empty returns returns true if there are no elements.
 func (this ActivityStreamsUrlProperty) Empty() bool {
 	return this.Len() == 0
@@ -436,111 +625,74 @@ func (this ActivityStreamsUrlProperty) End() vocab.ActivityStreamsUrlPropertyIte
 	return nil
 }
 
+// InsertActivityStreamsHashtag inserts a Hashtag value at the specified index for
+// a property "url". Existing elements at that index and higher are shifted
+// back once.
+func (this *ActivityStreamsUrlProperty) InsertActivityStreamsHashtag(idx int, v vocab.ActivityStreamsHashtag) {
+	this.properties.Insert(idx, &ActivityStreamsUrlPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+	})
+}
+
 // InsertActivityStreamsLink inserts a Link value at the specified index for a
 // property "url". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsUrlProperty) InsertActivityStreamsLink(idx int, v vocab.ActivityStreamsLink) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsUrlPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsUrlPropertyIterator{
 		activitystreamsLinkMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsMention inserts a Mention value at the specified index for
 // a property "url". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ActivityStreamsUrlProperty) InsertActivityStreamsMention(idx int, v vocab.ActivityStreamsMention) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsUrlPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsUrlPropertyIterator{
 		activitystreamsMentionMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // Insert inserts an IRI value at the specified index for a property "url".
 // Existing elements at that index and higher are shifted back once.
-// Invalidates all iterators.
 func (this *ActivityStreamsUrlProperty) InsertIRI(idx int, v *url.URL) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsUrlPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsUrlPropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
-// PrependType prepends an arbitrary type value to the front of a list of the
-// property "url". Invalidates all iterators. Returns an error if the type is
-// not a valid one to set for this property.
+// InsertType inserts an arbitrary type value at the specified index for the
+// property "url". Existing elements at that index and higher are shifted back
+// once. Returns an error if the type is not a valid one to set for this
+// property.
 func (this *ActivityStreamsUrlProperty) InsertType(idx int, t vocab.Type) error {
-	n := &ActivityStreamsUrlPropertyIterator{
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+	n := &ActivityStreamsUrlPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = n
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, n)
 	return nil
 }
 
 // InsertXMLSchemaAnyURI inserts a anyURI value at the specified index for a
 // property "url". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ActivityStreamsUrlProperty) InsertXMLSchemaAnyURI(idx int, v *url.URL) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ActivityStreamsUrlPropertyIterator{
+	this.properties.Insert(idx, &ActivityStreamsUrlPropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ActivityStreamsUrlProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	for _, elem := range this.properties {
-		child := elem.JSONLDContext()
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		for k, v := range child {
-			m[k] = v
-		}
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -554,7 +706,7 @@ func (this ActivityStreamsUrlProperty) KindIndex(idx int) int {
 
 // Len returns the number of values that exist for the "url" property.
 func (this ActivityStreamsUrlProperty) Len() (length int) {
-	return len(this.properties)
+	return this.properties.Len()
 }
 
 // Less computes whether another property is less than this one. Mixing types
@@ -574,6 +726,10 @@ func (this ActivityStreamsUrlProperty) Less(i, j int) bool {
 			rhs := this.properties[j].GetActivityStreamsLink()
 			return lhs.LessThan(rhs)
 		} else if idx1 == 2 {
+			lhs := this.properties[i].GetActivityStreamsHashtag()
+			rhs := this.properties[j].GetActivityStreamsHashtag()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 3 {
 			lhs := this.properties[i].GetActivityStreamsMention()
 			rhs := this.properties[j].GetActivityStreamsMention()
 			return lhs.LessThan(rhs)
@@ -591,20 +747,7 @@ func (this ActivityStreamsUrlProperty) Less(i, j int) bool {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ActivityStreamsUrlProperty) LessThan(o vocab.ActivityStreamsUrlProperty) bool {
-	l1 := this.Len()
-	l2 := o.Len()
-	l := l1
-	if l2 < l1 {
-		l = l2
-	}
-	for i := 0; i < l; i++ {
-		if this.properties[i].LessThan(o.At(i)) {
-			return true
-		} else if o.At(i).LessThan(this.properties[i]) {
-			return false
-		}
-	}
-	return l1 < l2
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property ("url") with any alias.
@@ -616,91 +759,79 @@ func (this ActivityStreamsUrlProperty) Name() string {
 	}
 }
 
+// PrependActivityStreamsHashtag prepends a Hashtag value to the front of a list
+// of the property "url".
+func (this *ActivityStreamsUrlProperty) PrependActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.properties.Prepend(&ActivityStreamsUrlPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+	})
+}
+
 // PrependActivityStreamsLink prepends a Link value to the front of a list of the
-// property "url". Invalidates all iterators.
+// property "url".
 func (this *ActivityStreamsUrlProperty) PrependActivityStreamsLink(v vocab.ActivityStreamsLink) {
-	this.properties = append([]*ActivityStreamsUrlPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsUrlPropertyIterator{
 		activitystreamsLinkMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsMention prepends a Mention value to the front of a list
-// of the property "url". Invalidates all iterators.
+// of the property "url".
 func (this *ActivityStreamsUrlProperty) PrependActivityStreamsMention(v vocab.ActivityStreamsMention) {
-	this.properties = append([]*ActivityStreamsUrlPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsUrlPropertyIterator{
 		activitystreamsMentionMember: v,
 		alias:                        this.alias,
-		myIdx:                        0,
-		parent:                       this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependIRI prepends an IRI value to the front of a list of the property "url".
 func (this *ActivityStreamsUrlProperty) PrependIRI(v *url.URL) {
-	this.properties = append([]*ActivityStreamsUrlPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsUrlPropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 0,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependType prepends an arbitrary type value to the front of a list of the
-// property "url". Invalidates all iterators. Returns an error if the type is
-// not a valid one to set for this property.
+// property "url". Returns an error if the type is not a valid one to set for
+// this property.
 func (this *ActivityStreamsUrlProperty) PrependType(t vocab.Type) error {
-	n := &ActivityStreamsUrlPropertyIterator{
-		alias:  this.alias,
-		myIdx:  0,
-		parent: this,
-	}
+	n := &ActivityStreamsUrlPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append([]*ActivityStreamsUrlPropertyIterator{n}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(n)
 	return nil
 }
 
 // PrependXMLSchemaAnyURI prepends a anyURI value to the front of a list of the
-// property "url". Invalidates all iterators.
+// property "url".
 func (this *ActivityStreamsUrlProperty) PrependXMLSchemaAnyURI(v *url.URL) {
-	this.properties = append([]*ActivityStreamsUrlPropertyIterator{{
+	this.properties.Prepend(&ActivityStreamsUrlPropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 0,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
+	})
+}
+
+// Release returns this property and its values to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsUrlProperty) Release() {
+	for i, elem := range this.properties {
+		elem.Release()
+		this.properties[i] = nil
 	}
+	*this = ActivityStreamsUrlProperty{properties: this.properties[:0]}
+	poolActivityStreamsUrlProperty.Put(this)
 }
 
 // Remove deletes an element at the specified index from a list of the property
 // "url", regardless of its type. Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ActivityStreamsUrlProperty) Remove(idx int) {
-	(this.properties)[idx].parent = nil
-	copy((this.properties)[idx:], (this.properties)[idx+1:])
-	(this.properties)[len(this.properties)-1] = &ActivityStreamsUrlPropertyIterator{}
-	this.properties = (this.properties)[:len(this.properties)-1]
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Remove(idx)
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -723,74 +854,85 @@ func (this ActivityStreamsUrlProperty) Serialize() (interface{}, error) {
 	return s, nil
 }
 
+// SetActivityStreamsHashtag sets a Hashtag value to be at the specified index for
+// the property "url". Panics if the index is out of bounds.
+func (this *ActivityStreamsUrlProperty) SetActivityStreamsHashtag(idx int, v vocab.ActivityStreamsHashtag) {
+	this.properties.Set(idx, &ActivityStreamsUrlPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+	})
+}
+
 // SetActivityStreamsLink sets a Link value to be at the specified index for the
-// property "url". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "url". Panics if the index is out of bounds.
 func (this *ActivityStreamsUrlProperty) SetActivityStreamsLink(idx int, v vocab.ActivityStreamsLink) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsUrlPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsUrlPropertyIterator{
 		activitystreamsLinkMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsMention sets a Mention value to be at the specified index for
-// the property "url". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// the property "url". Panics if the index is out of bounds.
 func (this *ActivityStreamsUrlProperty) SetActivityStreamsMention(idx int, v vocab.ActivityStreamsMention) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsUrlPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsUrlPropertyIterator{
 		activitystreamsMentionMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
+	})
 }
 
 // SetIRI sets an IRI value to be at the specified index for the property "url".
 // Panics if the index is out of bounds.
 func (this *ActivityStreamsUrlProperty) SetIRI(idx int, v *url.URL) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsUrlPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsUrlPropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}
+	})
 }
 
 // SetType sets an arbitrary type value to the specified index of the property
-// "url". Invalidates all iterators. Returns an error if the type is not a
-// valid one to set for this property. Panics if the index is out of bounds.
+// "url". Returns an error if the type is not a valid one to set for this
+// property. Panics if the index is out of bounds.
 func (this *ActivityStreamsUrlProperty) SetType(idx int, t vocab.Type) error {
-	n := &ActivityStreamsUrlPropertyIterator{
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+	n := &ActivityStreamsUrlPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	(this.properties)[idx] = n
+	this.properties.Set(idx, n)
 	return nil
 }
 
 // SetXMLSchemaAnyURI sets a anyURI value to be at the specified index for the
-// property "url". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "url". Panics if the index is out of bounds.
 func (this *ActivityStreamsUrlProperty) SetXMLSchemaAnyURI(idx int, v *url.URL) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ActivityStreamsUrlPropertyIterator{
+	this.properties.Set(idx, &ActivityStreamsUrlPropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}
+	})
 }
 
 // Swap swaps the location of values at two indices for the "url" property.
 func (this ActivityStreamsUrlProperty) Swap(i, j int) {
-	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+	this.properties.Swap(i, j)
 }
+
+// Values returns the iterators of all values from front to back, so they can be
+// ranged over. Adding values to or removing them from this property
+// afterwards does not change the returned slice.
+func (this ActivityStreamsUrlProperty) Values() []vocab.ActivityStreamsUrlPropertyIterator {
+	values := make([]vocab.ActivityStreamsUrlPropertyIterator, len(this.properties))
+	for i, elem := range this.properties {
+		values[i] = elem
+	}
+	return values
+}
+
+// poolActivityStreamsUrlProperty holds the released values of ActivityStreamsUrlProperty, which are reused when deserializing.
+var poolActivityStreamsUrlProperty = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsUrlProperty{}
+}}
+
+// poolActivityStreamsUrlPropertyIterator holds the released values of ActivityStreamsUrlPropertyIterator, which are reused when deserializing.
+var poolActivityStreamsUrlPropertyIterator = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsUrlPropertyIterator{}
+}}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"strings"
+	"sync"
 )
 
 // Indicates that the actor has added the object to the target. If the target
@@ -76,6 +77,7 @@ type ActivityStreamsAdd struct {
 	ActivityStreamsPublished    vocab.ActivityStreamsPublishedProperty
 	ActivityStreamsReplies      vocab.ActivityStreamsRepliesProperty
 	ActivityStreamsResult       vocab.ActivityStreamsResultProperty
+	ActivityStreamsSensitive    vocab.ActivityStreamsSensitiveProperty
 	ActivityStreamsShares       vocab.ActivityStreamsSharesProperty
 	ActivityStreamsSource       vocab.ActivityStreamsSourceProperty
 	ActivityStreamsStartTime    vocab.ActivityStreamsStartTimeProperty
@@ -108,7 +110,7 @@ func ActivityStreamsAddExtends(other vocab.Type) bool {
 // AddIsDisjointWith returns true if the other provided type is disjoint with the
 // Add type.
 func AddIsDisjointWith(other vocab.Type) bool {
-	disjointWith := []string{"Link", "Mention"}
+	disjointWith := []string{"Hashtag", "Link", "Mention"}
 	for _, disjoint := range disjointWith {
 		if disjoint == other.GetTypeName() {
 			return true
@@ -134,16 +136,18 @@ func DeserializeAdd(m map[string]interface{}, aliasMap map[string]string) (*Acti
 		alias = a
 		aliasPrefix = a + ":"
 	}
-	this := &ActivityStreamsAdd{
-		alias:   alias,
-		unknown: make(map[string]interface{}),
-	}
 	if typeValue, ok := m["type"]; !ok {
-		return nil, fmt.Errorf("no \"type\" property in map")
+		return nil, vocab.DeserializeError{
+			Err:    vocab.ErrMissingType,
+			Reason: "no \"type\" property in map",
+		}
 	} else if typeString, ok := typeValue.(string); ok {
 		typeName := strings.TrimPrefix(typeString, aliasPrefix)
 		if typeName != "Add" {
-			return nil, fmt.Errorf("\"type\" property is not of %q type: %s", "Add", typeName)
+			return nil, vocab.DeserializeError{
+				Err:    vocab.ErrWrongType,
+				Reason: fmt.Sprintf("\"type\" property is not of %q type: %s", "Add", typeName),
+			}
 		}
 		// Fall through, success in finding a proper Type
 	} else if arrType, ok := typeValue.([]interface{}); ok {
@@ -155,11 +159,22 @@ func DeserializeAdd(m map[string]interface{}, aliasMap map[string]string) (*Acti
 			}
 		}
 		if !found {
-			return nil, fmt.Errorf("could not find a \"type\" property of value %q", "Add")
+			return nil, vocab.DeserializeError{
+				Err:    vocab.ErrWrongType,
+				Reason: fmt.Sprintf("could not find a \"type\" property of value %q", "Add"),
+			}
 		}
 		// Fall through, success in finding a proper Type
 	} else {
-		return nil, fmt.Errorf("\"type\" property is unrecognized type: %T", typeValue)
+		return nil, vocab.DeserializeError{
+			Err:    vocab.ErrWrongType,
+			Reason: fmt.Sprintf("\"type\" property is unrecognized type: %T", typeValue),
+		}
+	}
+	this := poolActivityStreamsAdd.Get().(*ActivityStreamsAdd)
+	this.alias = alias
+	if this.unknown == nil {
+		this.unknown = make(map[string]interface{})
 	}
 	// Begin: Known property deserialization
 	if p, err := mgr.DeserializeActorPropertyActivityStreams()(m, aliasMap); err != nil {
@@ -302,6 +317,11 @@ func DeserializeAdd(m map[string]interface{}, aliasMap map[string]string) (*Acti
 	} else if p != nil {
 		this.ActivityStreamsResult = p
 	}
+	if p, err := mgr.DeserializeSensitivePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsSensitive = p
+	}
 	if p, err := mgr.DeserializeSharesPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -372,93 +392,7 @@ func DeserializeAdd(m map[string]interface{}, aliasMap map[string]string) (*Acti
 	// Begin: Unknown deserialization
 	for k, v := range m {
 		// Begin: Code that ensures a property name is unknown
-		if k == "actor" {
-			continue
-		} else if k == "altitude" {
-			continue
-		} else if k == "attachment" {
-			continue
-		} else if k == "attributedTo" {
-			continue
-		} else if k == "audience" {
-			continue
-		} else if k == "bcc" {
-			continue
-		} else if k == "bto" {
-			continue
-		} else if k == "cc" {
-			continue
-		} else if k == "content" {
-			continue
-		} else if k == "contentMap" {
-			continue
-		} else if k == "context" {
-			continue
-		} else if k == "duration" {
-			continue
-		} else if k == "endTime" {
-			continue
-		} else if k == "generator" {
-			continue
-		} else if k == "icon" {
-			continue
-		} else if k == "id" {
-			continue
-		} else if k == "image" {
-			continue
-		} else if k == "inReplyTo" {
-			continue
-		} else if k == "instrument" {
-			continue
-		} else if k == "likes" {
-			continue
-		} else if k == "location" {
-			continue
-		} else if k == "mediaType" {
-			continue
-		} else if k == "name" {
-			continue
-		} else if k == "nameMap" {
-			continue
-		} else if k == "object" {
-			continue
-		} else if k == "origin" {
-			continue
-		} else if k == "preview" {
-			continue
-		} else if k == "published" {
-			continue
-		} else if k == "replies" {
-			continue
-		} else if k == "result" {
-			continue
-		} else if k == "shares" {
-			continue
-		} else if k == "source" {
-			continue
-		} else if k == "startTime" {
-			continue
-		} else if k == "summary" {
-			continue
-		} else if k == "summaryMap" {
-			continue
-		} else if k == "tag" {
-			continue
-		} else if k == "target" {
-			continue
-		} else if k == "team" {
-			continue
-		} else if k == "ticketsTrackedBy" {
-			continue
-		} else if k == "to" {
-			continue
-		} else if k == "tracksTicketsFor" {
-			continue
-		} else if k == "type" {
-			continue
-		} else if k == "updated" {
-			continue
-		} else if k == "url" {
+		if _, ok := knownActivityStreamsAddProperties[k]; ok {
 			continue
 		} // End: Code that ensures a property name is unknown
 
@@ -489,912 +423,1218 @@ func NewActivityStreamsAdd() *ActivityStreamsAdd {
 	}
 }
 
-// GetActivityStreamsActor returns the "actor" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsActor() vocab.ActivityStreamsActorProperty {
-	return this.ActivityStreamsActor
-}
-
-// GetActivityStreamsAltitude returns the "altitude" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsAltitude() vocab.ActivityStreamsAltitudeProperty {
-	return this.ActivityStreamsAltitude
-}
-
-// GetActivityStreamsAttachment returns the "attachment" property if it exists,
-// and nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsAttachment() vocab.ActivityStreamsAttachmentProperty {
-	return this.ActivityStreamsAttachment
-}
-
-// GetActivityStreamsAttributedTo returns the "attributedTo" property if it
-// exists, and nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsAttributedTo() vocab.ActivityStreamsAttributedToProperty {
-	return this.ActivityStreamsAttributedTo
-}
-
-// GetActivityStreamsAudience returns the "audience" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsAudience() vocab.ActivityStreamsAudienceProperty {
-	return this.ActivityStreamsAudience
-}
-
-// GetActivityStreamsBcc returns the "bcc" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsBcc() vocab.ActivityStreamsBccProperty {
-	return this.ActivityStreamsBcc
-}
-
-// GetActivityStreamsBto returns the "bto" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsBto() vocab.ActivityStreamsBtoProperty {
-	return this.ActivityStreamsBto
-}
-
-// GetActivityStreamsCc returns the "cc" property if it exists, and nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsCc() vocab.ActivityStreamsCcProperty {
-	return this.ActivityStreamsCc
-}
-
-// GetActivityStreamsContent returns the "content" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsContent() vocab.ActivityStreamsContentProperty {
-	return this.ActivityStreamsContent
-}
-
-// GetActivityStreamsContext returns the "context" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsContext() vocab.ActivityStreamsContextProperty {
-	return this.ActivityStreamsContext
-}
-
-// GetActivityStreamsDuration returns the "duration" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsDuration() vocab.ActivityStreamsDurationProperty {
-	return this.ActivityStreamsDuration
-}
-
-// GetActivityStreamsEndTime returns the "endTime" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsEndTime() vocab.ActivityStreamsEndTimeProperty {
-	return this.ActivityStreamsEndTime
-}
-
-// GetActivityStreamsGenerator returns the "generator" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsGenerator() vocab.ActivityStreamsGeneratorProperty {
-	return this.ActivityStreamsGenerator
-}
-
-// GetActivityStreamsIcon returns the "icon" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsIcon() vocab.ActivityStreamsIconProperty {
-	return this.ActivityStreamsIcon
-}
-
-// GetActivityStreamsImage returns the "image" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsImage() vocab.ActivityStreamsImageProperty {
-	return this.ActivityStreamsImage
-}
-
-// GetActivityStreamsInReplyTo returns the "inReplyTo" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsInReplyTo() vocab.ActivityStreamsInReplyToProperty {
-	return this.ActivityStreamsInReplyTo
-}
-
-// GetActivityStreamsInstrument returns the "instrument" property if it exists,
-// and nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsInstrument() vocab.ActivityStreamsInstrumentProperty {
-	return this.ActivityStreamsInstrument
-}
-
-// GetActivityStreamsLikes returns the "likes" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsLikes() vocab.ActivityStreamsLikesProperty {
-	return this.ActivityStreamsLikes
-}
-
-// GetActivityStreamsLocation returns the "location" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsLocation() vocab.ActivityStreamsLocationProperty {
-	return this.ActivityStreamsLocation
-}
-
-// GetActivityStreamsMediaType returns the "mediaType" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty {
-	return this.ActivityStreamsMediaType
-}
-
-// GetActivityStreamsName returns the "name" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsName() vocab.ActivityStreamsNameProperty {
-	return this.ActivityStreamsName
-}
-
-// GetActivityStreamsObject returns the "object" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsObject() vocab.ActivityStreamsObjectProperty {
-	return this.ActivityStreamsObject
-}
-
-// GetActivityStreamsOrigin returns the "origin" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsOrigin() vocab.ActivityStreamsOriginProperty {
-	return this.ActivityStreamsOrigin
-}
-
-// GetActivityStreamsPreview returns the "preview" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsPreview() vocab.ActivityStreamsPreviewProperty {
-	return this.ActivityStreamsPreview
-}
-
-// GetActivityStreamsPublished returns the "published" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsPublished() vocab.ActivityStreamsPublishedProperty {
-	return this.ActivityStreamsPublished
-}
-
-// GetActivityStreamsReplies returns the "replies" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsReplies() vocab.ActivityStreamsRepliesProperty {
-	return this.ActivityStreamsReplies
-}
-
-// GetActivityStreamsResult returns the "result" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsResult() vocab.ActivityStreamsResultProperty {
-	return this.ActivityStreamsResult
-}
-
-// GetActivityStreamsShares returns the "shares" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsShares() vocab.ActivityStreamsSharesProperty {
-	return this.ActivityStreamsShares
-}
-
-// GetActivityStreamsSource returns the "source" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsSource() vocab.ActivityStreamsSourceProperty {
-	return this.ActivityStreamsSource
-}
-
-// GetActivityStreamsStartTime returns the "startTime" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsStartTime() vocab.ActivityStreamsStartTimeProperty {
-	return this.ActivityStreamsStartTime
-}
-
-// GetActivityStreamsSummary returns the "summary" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsSummary() vocab.ActivityStreamsSummaryProperty {
-	return this.ActivityStreamsSummary
-}
-
-// GetActivityStreamsTag returns the "tag" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsTag() vocab.ActivityStreamsTagProperty {
-	return this.ActivityStreamsTag
-}
-
-// GetActivityStreamsTarget returns the "target" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsTarget() vocab.ActivityStreamsTargetProperty {
-	return this.ActivityStreamsTarget
-}
-
-// GetActivityStreamsTo returns the "to" property if it exists, and nil otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsTo() vocab.ActivityStreamsToProperty {
-	return this.ActivityStreamsTo
-}
-
-// GetActivityStreamsUpdated returns the "updated" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsUpdated() vocab.ActivityStreamsUpdatedProperty {
-	return this.ActivityStreamsUpdated
-}
-
-// GetActivityStreamsUrl returns the "url" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsAdd) GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty {
-	return this.ActivityStreamsUrl
-}
-
-// GetForgeFedTeam returns the "team" property if it exists, and nil otherwise.
-func (this ActivityStreamsAdd) GetForgeFedTeam() vocab.ForgeFedTeamProperty {
-	return this.ForgeFedTeam
-}
-
-// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if it
-// exists, and nil otherwise.
-func (this ActivityStreamsAdd) GetForgeFedTicketsTrackedBy() vocab.ForgeFedTicketsTrackedByProperty {
-	return this.ForgeFedTicketsTrackedBy
-}
-
-// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if it
-// exists, and nil otherwise.
-func (this ActivityStreamsAdd) GetForgeFedTracksTicketsFor() vocab.ForgeFedTracksTicketsForProperty {
-	return this.ForgeFedTracksTicketsFor
-}
-
-// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
-func (this ActivityStreamsAdd) GetJSONLDId() vocab.JSONLDIdProperty {
-	return this.JSONLDId
-}
-
-// GetJSONLDType returns the "type" property if it exists, and nil otherwise.
-func (this ActivityStreamsAdd) GetJSONLDType() vocab.JSONLDTypeProperty {
-	return this.JSONLDType
-}
-
-// GetTypeName returns the name of this type.
-func (this ActivityStreamsAdd) GetTypeName() string {
-	return "Add"
-}
-
-// GetUnknownProperties returns the unknown properties for the Add type. Note that
-// this should not be used by app developers. It is only used to help
-// determine which implementation is LessThan the other. Developers who are
-// creating a different implementation of this type's interface can use this
-// method in their LessThan implementation, but routine ActivityPub
-// applications should not use this to bypass the code generation tool.
-func (this ActivityStreamsAdd) GetUnknownProperties() map[string]interface{} {
-	return this.unknown
-}
-
-// IsExtending returns true if the Add type extends from the other type.
-func (this ActivityStreamsAdd) IsExtending(other vocab.Type) bool {
-	return ActivityStreamsAddExtends(other)
-}
-
-// JSONLDContext returns the JSONLD URIs required in the context string for this
-// type and the specific properties that are set. The value in the map is the
-// alias used to import the type and its properties.
-func (this ActivityStreamsAdd) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	m = this.helperJSONLDContext(this.ActivityStreamsActor, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAltitude, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAttachment, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAttributedTo, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAudience, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsBcc, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsBto, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsCc, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsContent, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsContext, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsDuration, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsEndTime, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsGenerator, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsIcon, m)
-	m = this.helperJSONLDContext(this.JSONLDId, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsImage, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsInReplyTo, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsInstrument, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsLikes, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsLocation, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsMediaType, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsName, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsObject, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsOrigin, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPreview, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPublished, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsReplies, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsResult, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsShares, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsSource, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsStartTime, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsTag, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsTarget, m)
-	m = this.helperJSONLDContext(this.ForgeFedTeam, m)
-	m = this.helperJSONLDContext(this.ForgeFedTicketsTrackedBy, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsTo, m)
-	m = this.helperJSONLDContext(this.ForgeFedTracksTicketsFor, m)
-	m = this.helperJSONLDContext(this.JSONLDType, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsUpdated, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsUrl, m)
-
-	return m
-}
-
-// LessThan computes if this Add is lesser, with an arbitrary but stable
-// determination.
-func (this ActivityStreamsAdd) LessThan(o vocab.ActivityStreamsAdd) bool {
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// type and the specific properties that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the type and each of its properties.
+func (this ActivityStreamsAdd) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+	this.helperAddJSONLDContext(this.ActivityStreamsActor, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsAltitude, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsAttachment, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsAttributedTo, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsAudience, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsBcc, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsBto, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsCc, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsContent, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsContext, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsDuration, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsEndTime, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsGenerator, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsIcon, m)
+	this.helperAddJSONLDContext(this.JSONLDId, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsImage, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsInReplyTo, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsInstrument, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsLikes, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsLocation, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsMediaType, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsName, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsObject, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsOrigin, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsPreview, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsPublished, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsReplies, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsResult, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsSensitive, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsShares, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsSource, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsStartTime, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsSummary, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsTag, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsTarget, m)
+	this.helperAddJSONLDContext(this.ForgeFedTeam, m)
+	this.helperAddJSONLDContext(this.ForgeFedTicketsTrackedBy, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsTo, m)
+	this.helperAddJSONLDContext(this.ForgeFedTracksTicketsFor, m)
+	this.helperAddJSONLDContext(this.JSONLDType, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsUpdated, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsUrl, m)
+}
+
+// Compare compares this Add with another, with an arbitrary but stable
+// determination. It returns a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Each property is compared
+// once, so comparing deeply nested values costs half as much as calling
+// LessThan both ways.
+func (this ActivityStreamsAdd) Compare(o vocab.ActivityStreamsAdd) int {
 	// Begin: Compare known properties
 	// Compare property "actor"
 	if lhs, rhs := this.ActivityStreamsActor, o.GetActivityStreamsActor(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "altitude"
 	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "attachment"
 	if lhs, rhs := this.ActivityStreamsAttachment, o.GetActivityStreamsAttachment(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "attributedTo"
 	if lhs, rhs := this.ActivityStreamsAttributedTo, o.GetActivityStreamsAttributedTo(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "audience"
 	if lhs, rhs := this.ActivityStreamsAudience, o.GetActivityStreamsAudience(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "bcc"
 	if lhs, rhs := this.ActivityStreamsBcc, o.GetActivityStreamsBcc(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "bto"
 	if lhs, rhs := this.ActivityStreamsBto, o.GetActivityStreamsBto(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "cc"
 	if lhs, rhs := this.ActivityStreamsCc, o.GetActivityStreamsCc(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "content"
 	if lhs, rhs := this.ActivityStreamsContent, o.GetActivityStreamsContent(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "context"
 	if lhs, rhs := this.ActivityStreamsContext, o.GetActivityStreamsContext(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "duration"
 	if lhs, rhs := this.ActivityStreamsDuration, o.GetActivityStreamsDuration(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "endTime"
 	if lhs, rhs := this.ActivityStreamsEndTime, o.GetActivityStreamsEndTime(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "generator"
 	if lhs, rhs := this.ActivityStreamsGenerator, o.GetActivityStreamsGenerator(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "icon"
 	if lhs, rhs := this.ActivityStreamsIcon, o.GetActivityStreamsIcon(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "id"
 	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "image"
 	if lhs, rhs := this.ActivityStreamsImage, o.GetActivityStreamsImage(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "inReplyTo"
 	if lhs, rhs := this.ActivityStreamsInReplyTo, o.GetActivityStreamsInReplyTo(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "instrument"
 	if lhs, rhs := this.ActivityStreamsInstrument, o.GetActivityStreamsInstrument(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "likes"
 	if lhs, rhs := this.ActivityStreamsLikes, o.GetActivityStreamsLikes(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "location"
 	if lhs, rhs := this.ActivityStreamsLocation, o.GetActivityStreamsLocation(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "mediaType"
 	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "name"
 	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "object"
 	if lhs, rhs := this.ActivityStreamsObject, o.GetActivityStreamsObject(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "origin"
 	if lhs, rhs := this.ActivityStreamsOrigin, o.GetActivityStreamsOrigin(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "preview"
 	if lhs, rhs := this.ActivityStreamsPreview, o.GetActivityStreamsPreview(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "published"
 	if lhs, rhs := this.ActivityStreamsPublished, o.GetActivityStreamsPublished(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "replies"
 	if lhs, rhs := this.ActivityStreamsReplies, o.GetActivityStreamsReplies(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "result"
 	if lhs, rhs := this.ActivityStreamsResult, o.GetActivityStreamsResult(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return 1
+	} // Else: Both are nil or equal
+	// Compare property "sensitive"
+	if lhs, rhs := this.ActivityStreamsSensitive, o.GetActivityStreamsSensitive(); lhs != nil && rhs != nil {
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "shares"
 	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "source"
 	if lhs, rhs := this.ActivityStreamsSource, o.GetActivityStreamsSource(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "startTime"
 	if lhs, rhs := this.ActivityStreamsStartTime, o.GetActivityStreamsStartTime(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "summary"
 	if lhs, rhs := this.ActivityStreamsSummary, o.GetActivityStreamsSummary(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "tag"
 	if lhs, rhs := this.ActivityStreamsTag, o.GetActivityStreamsTag(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "target"
 	if lhs, rhs := this.ActivityStreamsTarget, o.GetActivityStreamsTarget(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "team"
 	if lhs, rhs := this.ForgeFedTeam, o.GetForgeFedTeam(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "ticketsTrackedBy"
 	if lhs, rhs := this.ForgeFedTicketsTrackedBy, o.GetForgeFedTicketsTrackedBy(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "to"
 	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "tracksTicketsFor"
 	if lhs, rhs := this.ForgeFedTracksTicketsFor, o.GetForgeFedTracksTicketsFor(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "type"
 	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "updated"
 	if lhs, rhs := this.ActivityStreamsUpdated, o.GetActivityStreamsUpdated(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "url"
 	if lhs, rhs := this.ActivityStreamsUrl, o.GetActivityStreamsUrl(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// End: Compare known properties
 
-	// Begin: Compare unknown properties (only by number of them)
-	if len(this.unknown) < len(o.GetUnknownProperties()) {
-		return true
-	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
-		return false
-	} // End: Compare unknown properties (only by number of them)
+	// Begin: Compare unknown properties (only by number of them)
+	if len(this.unknown) < len(o.GetUnknownProperties()) {
+		return -1
+	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
+		return 1
+	} // End: Compare unknown properties (only by number of them)
+
+	// All properties are the same.
+	return 0
+}
+
+// GetActivityStreamsActor returns the "actor" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsActor() vocab.ActivityStreamsActorProperty {
+	return this.ActivityStreamsActor
+}
+
+// GetActivityStreamsAltitude returns the "altitude" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsAltitude() vocab.ActivityStreamsAltitudeProperty {
+	return this.ActivityStreamsAltitude
+}
+
+// GetActivityStreamsAttachment returns the "attachment" property if it exists,
+// and nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsAttachment() vocab.ActivityStreamsAttachmentProperty {
+	return this.ActivityStreamsAttachment
+}
+
+// GetActivityStreamsAttributedTo returns the "attributedTo" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsAttributedTo() vocab.ActivityStreamsAttributedToProperty {
+	return this.ActivityStreamsAttributedTo
+}
+
+// GetActivityStreamsAudience returns the "audience" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsAudience() vocab.ActivityStreamsAudienceProperty {
+	return this.ActivityStreamsAudience
+}
+
+// GetActivityStreamsBcc returns the "bcc" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsBcc() vocab.ActivityStreamsBccProperty {
+	return this.ActivityStreamsBcc
+}
+
+// GetActivityStreamsBto returns the "bto" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsBto() vocab.ActivityStreamsBtoProperty {
+	return this.ActivityStreamsBto
+}
+
+// GetActivityStreamsCc returns the "cc" property if it exists, and nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsCc() vocab.ActivityStreamsCcProperty {
+	return this.ActivityStreamsCc
+}
+
+// GetActivityStreamsContent returns the "content" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsContent() vocab.ActivityStreamsContentProperty {
+	return this.ActivityStreamsContent
+}
+
+// GetActivityStreamsContext returns the "context" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsContext() vocab.ActivityStreamsContextProperty {
+	return this.ActivityStreamsContext
+}
+
+// GetActivityStreamsDuration returns the "duration" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsDuration() vocab.ActivityStreamsDurationProperty {
+	return this.ActivityStreamsDuration
+}
+
+// GetActivityStreamsEndTime returns the "endTime" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsEndTime() vocab.ActivityStreamsEndTimeProperty {
+	return this.ActivityStreamsEndTime
+}
+
+// GetActivityStreamsGenerator returns the "generator" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsGenerator() vocab.ActivityStreamsGeneratorProperty {
+	return this.ActivityStreamsGenerator
+}
+
+// GetActivityStreamsIcon returns the "icon" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsIcon() vocab.ActivityStreamsIconProperty {
+	return this.ActivityStreamsIcon
+}
+
+// GetActivityStreamsImage returns the "image" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsImage() vocab.ActivityStreamsImageProperty {
+	return this.ActivityStreamsImage
+}
+
+// GetActivityStreamsInReplyTo returns the "inReplyTo" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsInReplyTo() vocab.ActivityStreamsInReplyToProperty {
+	return this.ActivityStreamsInReplyTo
+}
+
+// GetActivityStreamsInstrument returns the "instrument" property if it exists,
+// and nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsInstrument() vocab.ActivityStreamsInstrumentProperty {
+	return this.ActivityStreamsInstrument
+}
+
+// GetActivityStreamsLikes returns the "likes" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsLikes() vocab.ActivityStreamsLikesProperty {
+	return this.ActivityStreamsLikes
+}
+
+// GetActivityStreamsLocation returns the "location" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsLocation() vocab.ActivityStreamsLocationProperty {
+	return this.ActivityStreamsLocation
+}
+
+// GetActivityStreamsMediaType returns the "mediaType" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty {
+	return this.ActivityStreamsMediaType
+}
+
+// GetActivityStreamsName returns the "name" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsName() vocab.ActivityStreamsNameProperty {
+	return this.ActivityStreamsName
+}
 
-	// All properties are the same.
-	return false
+// GetActivityStreamsObject returns the "object" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsObject() vocab.ActivityStreamsObjectProperty {
+	return this.ActivityStreamsObject
+}
+
+// GetActivityStreamsOrigin returns the "origin" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsOrigin() vocab.ActivityStreamsOriginProperty {
+	return this.ActivityStreamsOrigin
+}
+
+// GetActivityStreamsPreview returns the "preview" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsPreview() vocab.ActivityStreamsPreviewProperty {
+	return this.ActivityStreamsPreview
+}
+
+// GetActivityStreamsPublished returns the "published" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsPublished() vocab.ActivityStreamsPublishedProperty {
+	return this.ActivityStreamsPublished
+}
+
+// GetActivityStreamsReplies returns the "replies" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsReplies() vocab.ActivityStreamsRepliesProperty {
+	return this.ActivityStreamsReplies
+}
+
+// GetActivityStreamsResult returns the "result" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsResult() vocab.ActivityStreamsResultProperty {
+	return this.ActivityStreamsResult
+}
+
+// GetActivityStreamsSensitive returns the "sensitive" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsSensitive() vocab.ActivityStreamsSensitiveProperty {
+	return this.ActivityStreamsSensitive
+}
+
+// GetActivityStreamsShares returns the "shares" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsShares() vocab.ActivityStreamsSharesProperty {
+	return this.ActivityStreamsShares
+}
+
+// GetActivityStreamsSource returns the "source" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsSource() vocab.ActivityStreamsSourceProperty {
+	return this.ActivityStreamsSource
+}
+
+// GetActivityStreamsStartTime returns the "startTime" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsStartTime() vocab.ActivityStreamsStartTimeProperty {
+	return this.ActivityStreamsStartTime
+}
+
+// GetActivityStreamsSummary returns the "summary" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsSummary() vocab.ActivityStreamsSummaryProperty {
+	return this.ActivityStreamsSummary
+}
+
+// GetActivityStreamsTag returns the "tag" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsTag() vocab.ActivityStreamsTagProperty {
+	return this.ActivityStreamsTag
+}
+
+// GetActivityStreamsTarget returns the "target" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsTarget() vocab.ActivityStreamsTargetProperty {
+	return this.ActivityStreamsTarget
+}
+
+// GetActivityStreamsTo returns the "to" property if it exists, and nil otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsTo() vocab.ActivityStreamsToProperty {
+	return this.ActivityStreamsTo
+}
+
+// GetActivityStreamsUpdated returns the "updated" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsUpdated() vocab.ActivityStreamsUpdatedProperty {
+	return this.ActivityStreamsUpdated
+}
+
+// GetActivityStreamsUrl returns the "url" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsAdd) GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty {
+	return this.ActivityStreamsUrl
+}
+
+// GetForgeFedTeam returns the "team" property if it exists, and nil otherwise.
+func (this ActivityStreamsAdd) GetForgeFedTeam() vocab.ForgeFedTeamProperty {
+	return this.ForgeFedTeam
+}
+
+// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsAdd) GetForgeFedTicketsTrackedBy() vocab.ForgeFedTicketsTrackedByProperty {
+	return this.ForgeFedTicketsTrackedBy
+}
+
+// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsAdd) GetForgeFedTracksTicketsFor() vocab.ForgeFedTracksTicketsForProperty {
+	return this.ForgeFedTracksTicketsFor
+}
+
+// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
+func (this ActivityStreamsAdd) GetJSONLDId() vocab.JSONLDIdProperty {
+	return this.JSONLDId
+}
+
+// GetJSONLDType returns the "type" property if it exists, and nil otherwise.
+func (this ActivityStreamsAdd) GetJSONLDType() vocab.JSONLDTypeProperty {
+	return this.JSONLDType
+}
+
+// GetTypeName returns the name of this type.
+func (this ActivityStreamsAdd) GetTypeName() string {
+	return "Add"
+}
+
+// GetUnknownProperties returns the unknown properties for the Add type. Note that
+// this should not be used by app developers. It is only used to help
+// determine which implementation is LessThan the other. Developers who are
+// creating a different implementation of this type's interface can use this
+// method in their LessThan implementation, but routine ActivityPub
+// applications should not use this to bypass the code generation tool.
+func (this ActivityStreamsAdd) GetUnknownProperties() map[string]interface{} {
+	return this.unknown
+}
+
+// IsExtending returns true if the Add type extends from the other type.
+func (this ActivityStreamsAdd) IsExtending(other vocab.Type) bool {
+	return ActivityStreamsAddExtends(other)
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// type and the specific properties that are set. The value in the map is the
+// alias used to import the type and its properties.
+func (this ActivityStreamsAdd) JSONLDContext() map[string]string {
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
+	return m
+}
+
+// LessThan computes if this Add is lesser, with an arbitrary but stable
+// determination.
+func (this ActivityStreamsAdd) LessThan(o vocab.ActivityStreamsAdd) bool {
+	return this.Compare(o) < 0
+}
+
+// Release returns this type and its properties to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsAdd) Release() {
+	if r, ok := this.ActivityStreamsActor.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsAltitude.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsAttachment.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsAttributedTo.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsAudience.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsBcc.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsBto.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsCc.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsContent.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsContext.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsDuration.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsEndTime.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsGenerator.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsIcon.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.JSONLDId.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsImage.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsInReplyTo.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsInstrument.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsLikes.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsLocation.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsMediaType.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsName.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsObject.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsOrigin.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsPreview.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsPublished.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsReplies.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsResult.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsSensitive.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsShares.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsSource.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsStartTime.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsSummary.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsTag.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsTarget.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ForgeFedTeam.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ForgeFedTicketsTrackedBy.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsTo.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ForgeFedTracksTicketsFor.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.JSONLDType.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsUpdated.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsUrl.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	unknown := this.unknown
+	for k := range unknown {
+		delete(unknown, k)
+	}
+	*this = ActivityStreamsAdd{unknown: unknown}
+	poolActivityStreamsAdd.Put(this)
 }
 
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format.
 func (this ActivityStreamsAdd) Serialize() (map[string]interface{}, error) {
-	m := make(map[string]interface{})
+	// Begin: Count the properties to serialize
+	n := 1 + len(this.unknown)
+	if this.ActivityStreamsActor != nil {
+		n++
+	}
+	if this.ActivityStreamsAltitude != nil {
+		n++
+	}
+	if this.ActivityStreamsAttachment != nil {
+		n++
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		n++
+	}
+	if this.ActivityStreamsAudience != nil {
+		n++
+	}
+	if this.ActivityStreamsBcc != nil {
+		n++
+	}
+	if this.ActivityStreamsBto != nil {
+		n++
+	}
+	if this.ActivityStreamsCc != nil {
+		n++
+	}
+	if this.ActivityStreamsContent != nil {
+		n++
+	}
+	if this.ActivityStreamsContext != nil {
+		n++
+	}
+	if this.ActivityStreamsDuration != nil {
+		n++
+	}
+	if this.ActivityStreamsEndTime != nil {
+		n++
+	}
+	if this.ActivityStreamsGenerator != nil {
+		n++
+	}
+	if this.ActivityStreamsIcon != nil {
+		n++
+	}
+	if this.JSONLDId != nil {
+		n++
+	}
+	if this.ActivityStreamsImage != nil {
+		n++
+	}
+	if this.ActivityStreamsInReplyTo != nil {
+		n++
+	}
+	if this.ActivityStreamsInstrument != nil {
+		n++
+	}
+	if this.ActivityStreamsLikes != nil {
+		n++
+	}
+	if this.ActivityStreamsLocation != nil {
+		n++
+	}
+	if this.ActivityStreamsMediaType != nil {
+		n++
+	}
+	if this.ActivityStreamsName != nil {
+		n++
+	}
+	if this.ActivityStreamsObject != nil {
+		n++
+	}
+	if this.ActivityStreamsOrigin != nil {
+		n++
+	}
+	if this.ActivityStreamsPreview != nil {
+		n++
+	}
+	if this.ActivityStreamsPublished != nil {
+		n++
+	}
+	if this.ActivityStreamsReplies != nil {
+		n++
+	}
+	if this.ActivityStreamsResult != nil {
+		n++
+	}
+	if this.ActivityStreamsSensitive != nil {
+		n++
+	}
+	if this.ActivityStreamsShares != nil {
+		n++
+	}
+	if this.ActivityStreamsSource != nil {
+		n++
+	}
+	if this.ActivityStreamsStartTime != nil {
+		n++
+	}
+	if this.ActivityStreamsSummary != nil {
+		n++
+	}
+	if this.ActivityStreamsTag != nil {
+		n++
+	}
+	if this.ActivityStreamsTarget != nil {
+		n++
+	}
+	if this.ForgeFedTeam != nil {
+		n++
+	}
+	if this.ForgeFedTicketsTrackedBy != nil {
+		n++
+	}
+	if this.ActivityStreamsTo != nil {
+		n++
+	}
+	if this.ForgeFedTracksTicketsFor != nil {
+		n++
+	}
+	if this.JSONLDType != nil {
+		n++
+	}
+	if this.ActivityStreamsUpdated != nil {
+		n++
+	}
+	if this.ActivityStreamsUrl != nil {
+		n++
+	}
+	// End: Count the properties to serialize
+	m := make(map[string]interface{}, n)
 	typeName := "Add"
 	if len(this.alias) > 0 {
 		typeName = this.alias + ":" + "Add"
@@ -1625,6 +1865,14 @@ func (this ActivityStreamsAdd) Serialize() (map[string]interface{}, error) {
 			m[this.ActivityStreamsResult.Name()] = i
 		}
 	}
+	// Maybe serialize property "sensitive"
+	if this.ActivityStreamsSensitive != nil {
+		if i, err := this.ActivityStreamsSensitive.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsSensitive.Name()] = i
+		}
+	}
 	// Maybe serialize property "shares"
 	if this.ActivityStreamsShares != nil {
 		if i, err := this.ActivityStreamsShares.Serialize(); err != nil {
@@ -1878,6 +2126,11 @@ func (this *ActivityStreamsAdd) SetActivityStreamsResult(i vocab.ActivityStreams
 	this.ActivityStreamsResult = i
 }
 
+// SetActivityStreamsSensitive sets the "sensitive" property.
+func (this *ActivityStreamsAdd) SetActivityStreamsSensitive(i vocab.ActivityStreamsSensitiveProperty) {
+	this.ActivityStreamsSensitive = i
+}
+
 // SetActivityStreamsShares sets the "shares" property.
 func (this *ActivityStreamsAdd) SetActivityStreamsShares(i vocab.ActivityStreamsSharesProperty) {
 	this.ActivityStreamsShares = i
@@ -1953,19 +2206,79 @@ func (this ActivityStreamsAdd) VocabularyURI() string {
 	return "https://www.w3.org/ns/activitystreams"
 }
 
-// helperJSONLDContext obtains the context uris and their aliases from a property,
-// if it is not nil.
-func (this ActivityStreamsAdd) helperJSONLDContext(i jsonldContexter, toMerge map[string]string) map[string]string {
+// helperAddJSONLDContext adds the context uris and their aliases from a property
+// to the map, if it is not nil.
+func (this ActivityStreamsAdd) helperAddJSONLDContext(i jsonldContexter, m map[string]string) {
 	if i == nil {
-		return toMerge
+		return
 	}
-	for k, v := range i.JSONLDContext() {
+	if a, ok := i.(interface {
+		AddJSONLDContext(map[string]string)
+	}); ok {
+		a.AddJSONLDContext(m)
+	} else {
 		/*
 		   Since the literal maps in this function are determined at
 		   code-generation time, this loop should not overwrite an existing key with a
 		   new value.
 		*/
-		toMerge[k] = v
+		for k, v := range i.JSONLDContext() {
+			m[k] = v
+		}
 	}
-	return toMerge
 }
+
+// knownActivityStreamsAddProperties are the names of the properties of the "Add"
+// type, which are not unknown properties.
+var knownActivityStreamsAddProperties = map[string]struct{}{
+	"actor":            {},
+	"altitude":         {},
+	"attachment":       {},
+	"attributedTo":     {},
+	"audience":         {},
+	"bcc":              {},
+	"bto":              {},
+	"cc":               {},
+	"content":          {},
+	"contentMap":       {},
+	"context":          {},
+	"duration":         {},
+	"endTime":          {},
+	"generator":        {},
+	"icon":             {},
+	"id":               {},
+	"image":            {},
+	"inReplyTo":        {},
+	"instrument":       {},
+	"likes":            {},
+	"location":         {},
+	"mediaType":        {},
+	"name":             {},
+	"nameMap":          {},
+	"object":           {},
+	"origin":           {},
+	"preview":          {},
+	"published":        {},
+	"replies":          {},
+	"result":           {},
+	"sensitive":        {},
+	"shares":           {},
+	"source":           {},
+	"startTime":        {},
+	"summary":          {},
+	"summaryMap":       {},
+	"tag":              {},
+	"target":           {},
+	"team":             {},
+	"ticketsTrackedBy": {},
+	"to":               {},
+	"tracksTicketsFor": {},
+	"type":             {},
+	"updated":          {},
+	"url":              {},
+}
+
+// poolActivityStreamsAdd holds the released values of ActivityStreamsAdd, which are reused when deserializing.
+var poolActivityStreamsAdd = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsAdd{}
+}}
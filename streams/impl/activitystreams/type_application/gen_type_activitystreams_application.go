@@ -6,6 +6,7 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"strings"
+	"sync"
 )
 
 // Describes a software application.
@@ -16,6 +17,7 @@ import (
 //     "type": "Application"
 //   }
 type ActivityStreamsApplication struct {
+	ActivityStreamsAlsoKnownAs       vocab.ActivityStreamsAlsoKnownAsProperty
 	ActivityStreamsAltitude          vocab.ActivityStreamsAltitudeProperty
 	ActivityStreamsAttachment        vocab.ActivityStreamsAttachmentProperty
 	ActivityStreamsAttributedTo      vocab.ActivityStreamsAttributedToProperty
@@ -29,6 +31,7 @@ type ActivityStreamsApplication struct {
 	ActivityStreamsDuration          vocab.ActivityStreamsDurationProperty
 	ActivityStreamsEndTime           vocab.ActivityStreamsEndTimeProperty
 	TootFeatured                     vocab.TootFeaturedProperty
+	TootFeaturedTags                 vocab.TootFeaturedTagsProperty
 	ActivityStreamsFollowers         vocab.ActivityStreamsFollowersProperty
 	ActivityStreamsFollowing         vocab.ActivityStreamsFollowingProperty
 	ActivityStreamsGenerator         vocab.ActivityStreamsGeneratorProperty
@@ -37,10 +40,12 @@ type ActivityStreamsApplication struct {
 	ActivityStreamsImage             vocab.ActivityStreamsImageProperty
 	ActivityStreamsInReplyTo         vocab.ActivityStreamsInReplyToProperty
 	ActivityStreamsInbox             vocab.ActivityStreamsInboxProperty
+	TootIndexable                    vocab.TootIndexableProperty
 	ActivityStreamsLiked             vocab.ActivityStreamsLikedProperty
 	ActivityStreamsLikes             vocab.ActivityStreamsLikesProperty
 	ActivityStreamsLocation          vocab.ActivityStreamsLocationProperty
 	ActivityStreamsMediaType         vocab.ActivityStreamsMediaTypeProperty
+	ActivityStreamsMovedTo           vocab.ActivityStreamsMovedToProperty
 	ActivityStreamsName              vocab.ActivityStreamsNameProperty
 	ActivityStreamsObject            vocab.ActivityStreamsObjectProperty
 	ActivityStreamsOutbox            vocab.ActivityStreamsOutboxProperty
@@ -49,6 +54,7 @@ type ActivityStreamsApplication struct {
 	W3IDSecurityV1PublicKey          vocab.W3IDSecurityV1PublicKeyProperty
 	ActivityStreamsPublished         vocab.ActivityStreamsPublishedProperty
 	ActivityStreamsReplies           vocab.ActivityStreamsRepliesProperty
+	ActivityStreamsSensitive         vocab.ActivityStreamsSensitiveProperty
 	ActivityStreamsShares            vocab.ActivityStreamsSharesProperty
 	ActivityStreamsSource            vocab.ActivityStreamsSourceProperty
 	ActivityStreamsStartTime         vocab.ActivityStreamsStartTimeProperty
@@ -81,7 +87,7 @@ func ActivityStreamsApplicationExtends(other vocab.Type) bool {
 // ApplicationIsDisjointWith returns true if the other provided type is disjoint
 // with the Application type.
 func ApplicationIsDisjointWith(other vocab.Type) bool {
-	disjointWith := []string{"Link", "Mention"}
+	disjointWith := []string{"Hashtag", "Link", "Mention"}
 	for _, disjoint := range disjointWith {
 		if disjoint == other.GetTypeName() {
 			return true
@@ -107,16 +113,18 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 		alias = a
 		aliasPrefix = a + ":"
 	}
-	this := &ActivityStreamsApplication{
-		alias:   alias,
-		unknown: make(map[string]interface{}),
-	}
 	if typeValue, ok := m["type"]; !ok {
-		return nil, fmt.Errorf("no \"type\" property in map")
+		return nil, vocab.DeserializeError{
+			Err:    vocab.ErrMissingType,
+			Reason: "no \"type\" property in map",
+		}
 	} else if typeString, ok := typeValue.(string); ok {
 		typeName := strings.TrimPrefix(typeString, aliasPrefix)
 		if typeName != "Application" {
-			return nil, fmt.Errorf("\"type\" property is not of %q type: %s", "Application", typeName)
+			return nil, vocab.DeserializeError{
+				Err:    vocab.ErrWrongType,
+				Reason: fmt.Sprintf("\"type\" property is not of %q type: %s", "Application", typeName),
+			}
 		}
 		// Fall through, success in finding a proper Type
 	} else if arrType, ok := typeValue.([]interface{}); ok {
@@ -128,13 +136,29 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 			}
 		}
 		if !found {
-			return nil, fmt.Errorf("could not find a \"type\" property of value %q", "Application")
+			return nil, vocab.DeserializeError{
+				Err:    vocab.ErrWrongType,
+				Reason: fmt.Sprintf("could not find a \"type\" property of value %q", "Application"),
+			}
 		}
 		// Fall through, success in finding a proper Type
 	} else {
-		return nil, fmt.Errorf("\"type\" property is unrecognized type: %T", typeValue)
+		return nil, vocab.DeserializeError{
+			Err:    vocab.ErrWrongType,
+			Reason: fmt.Sprintf("\"type\" property is unrecognized type: %T", typeValue),
+		}
+	}
+	this := poolActivityStreamsApplication.Get().(*ActivityStreamsApplication)
+	this.alias = alias
+	if this.unknown == nil {
+		this.unknown = make(map[string]interface{})
 	}
 	// Begin: Known property deserialization
+	if p, err := mgr.DeserializeAlsoKnownAsPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsAlsoKnownAs = p
+	}
 	if p, err := mgr.DeserializeAltitudePropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -200,6 +224,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.TootFeatured = p
 	}
+	if p, err := mgr.DeserializeFeaturedTagsPropertyToot()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.TootFeaturedTags = p
+	}
 	if p, err := mgr.DeserializeFollowersPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -240,6 +269,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsInbox = p
 	}
+	if p, err := mgr.DeserializeIndexablePropertyToot()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.TootIndexable = p
+	}
 	if p, err := mgr.DeserializeLikedPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -260,6 +294,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsMediaType = p
 	}
+	if p, err := mgr.DeserializeMovedToPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsMovedTo = p
+	}
 	if p, err := mgr.DeserializeNamePropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -300,6 +339,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsReplies = p
 	}
+	if p, err := mgr.DeserializeSensitivePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsSensitive = p
+	}
 	if p, err := mgr.DeserializeSharesPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -370,105 +414,7 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	// Begin: Unknown deserialization
 	for k, v := range m {
 		// Begin: Code that ensures a property name is unknown
-		if k == "altitude" {
-			continue
-		} else if k == "attachment" {
-			continue
-		} else if k == "attributedTo" {
-			continue
-		} else if k == "audience" {
-			continue
-		} else if k == "bcc" {
-			continue
-		} else if k == "bto" {
-			continue
-		} else if k == "cc" {
-			continue
-		} else if k == "content" {
-			continue
-		} else if k == "contentMap" {
-			continue
-		} else if k == "context" {
-			continue
-		} else if k == "discoverable" {
-			continue
-		} else if k == "duration" {
-			continue
-		} else if k == "endTime" {
-			continue
-		} else if k == "featured" {
-			continue
-		} else if k == "followers" {
-			continue
-		} else if k == "following" {
-			continue
-		} else if k == "generator" {
-			continue
-		} else if k == "icon" {
-			continue
-		} else if k == "id" {
-			continue
-		} else if k == "image" {
-			continue
-		} else if k == "inReplyTo" {
-			continue
-		} else if k == "inbox" {
-			continue
-		} else if k == "liked" {
-			continue
-		} else if k == "likes" {
-			continue
-		} else if k == "location" {
-			continue
-		} else if k == "mediaType" {
-			continue
-		} else if k == "name" {
-			continue
-		} else if k == "nameMap" {
-			continue
-		} else if k == "object" {
-			continue
-		} else if k == "outbox" {
-			continue
-		} else if k == "preferredUsername" {
-			continue
-		} else if k == "preferredUsernameMap" {
-			continue
-		} else if k == "preview" {
-			continue
-		} else if k == "publicKey" {
-			continue
-		} else if k == "published" {
-			continue
-		} else if k == "replies" {
-			continue
-		} else if k == "shares" {
-			continue
-		} else if k == "source" {
-			continue
-		} else if k == "startTime" {
-			continue
-		} else if k == "streams" {
-			continue
-		} else if k == "summary" {
-			continue
-		} else if k == "summaryMap" {
-			continue
-		} else if k == "tag" {
-			continue
-		} else if k == "team" {
-			continue
-		} else if k == "ticketsTrackedBy" {
-			continue
-		} else if k == "to" {
-			continue
-		} else if k == "tracksTicketsFor" {
-			continue
-		} else if k == "type" {
-			continue
-		} else if k == "updated" {
-			continue
-		} else if k == "url" {
+		if _, ok := knownActivityStreamsApplicationProperties[k]; ok {
 			continue
 		} // End: Code that ensures a property name is unknown
 
@@ -499,1022 +445,1474 @@ func NewActivityStreamsApplication() *ActivityStreamsApplication {
 	}
 }
 
-// GetActivityStreamsAltitude returns the "altitude" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsAltitude() vocab.ActivityStreamsAltitudeProperty {
-	return this.ActivityStreamsAltitude
-}
-
-// GetActivityStreamsAttachment returns the "attachment" property if it exists,
-// and nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsAttachment() vocab.ActivityStreamsAttachmentProperty {
-	return this.ActivityStreamsAttachment
-}
-
-// GetActivityStreamsAttributedTo returns the "attributedTo" property if it
-// exists, and nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsAttributedTo() vocab.ActivityStreamsAttributedToProperty {
-	return this.ActivityStreamsAttributedTo
-}
-
-// GetActivityStreamsAudience returns the "audience" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsAudience() vocab.ActivityStreamsAudienceProperty {
-	return this.ActivityStreamsAudience
-}
-
-// GetActivityStreamsBcc returns the "bcc" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsBcc() vocab.ActivityStreamsBccProperty {
-	return this.ActivityStreamsBcc
-}
-
-// GetActivityStreamsBto returns the "bto" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsBto() vocab.ActivityStreamsBtoProperty {
-	return this.ActivityStreamsBto
-}
-
-// GetActivityStreamsCc returns the "cc" property if it exists, and nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsCc() vocab.ActivityStreamsCcProperty {
-	return this.ActivityStreamsCc
-}
-
-// GetActivityStreamsContent returns the "content" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsContent() vocab.ActivityStreamsContentProperty {
-	return this.ActivityStreamsContent
-}
-
-// GetActivityStreamsContext returns the "context" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsContext() vocab.ActivityStreamsContextProperty {
-	return this.ActivityStreamsContext
-}
-
-// GetActivityStreamsDuration returns the "duration" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsDuration() vocab.ActivityStreamsDurationProperty {
-	return this.ActivityStreamsDuration
-}
-
-// GetActivityStreamsEndTime returns the "endTime" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsEndTime() vocab.ActivityStreamsEndTimeProperty {
-	return this.ActivityStreamsEndTime
-}
-
-// GetActivityStreamsFollowers returns the "followers" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsFollowers() vocab.ActivityStreamsFollowersProperty {
-	return this.ActivityStreamsFollowers
-}
-
-// GetActivityStreamsFollowing returns the "following" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsFollowing() vocab.ActivityStreamsFollowingProperty {
-	return this.ActivityStreamsFollowing
-}
-
-// GetActivityStreamsGenerator returns the "generator" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsGenerator() vocab.ActivityStreamsGeneratorProperty {
-	return this.ActivityStreamsGenerator
-}
-
-// GetActivityStreamsIcon returns the "icon" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsIcon() vocab.ActivityStreamsIconProperty {
-	return this.ActivityStreamsIcon
-}
-
-// GetActivityStreamsImage returns the "image" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsImage() vocab.ActivityStreamsImageProperty {
-	return this.ActivityStreamsImage
-}
-
-// GetActivityStreamsInReplyTo returns the "inReplyTo" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsInReplyTo() vocab.ActivityStreamsInReplyToProperty {
-	return this.ActivityStreamsInReplyTo
-}
-
-// GetActivityStreamsInbox returns the "inbox" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsInbox() vocab.ActivityStreamsInboxProperty {
-	return this.ActivityStreamsInbox
-}
-
-// GetActivityStreamsLiked returns the "liked" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsLiked() vocab.ActivityStreamsLikedProperty {
-	return this.ActivityStreamsLiked
-}
-
-// GetActivityStreamsLikes returns the "likes" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsLikes() vocab.ActivityStreamsLikesProperty {
-	return this.ActivityStreamsLikes
-}
-
-// GetActivityStreamsLocation returns the "location" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsLocation() vocab.ActivityStreamsLocationProperty {
-	return this.ActivityStreamsLocation
-}
-
-// GetActivityStreamsMediaType returns the "mediaType" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty {
-	return this.ActivityStreamsMediaType
-}
-
-// GetActivityStreamsName returns the "name" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsName() vocab.ActivityStreamsNameProperty {
-	return this.ActivityStreamsName
-}
-
-// GetActivityStreamsObject returns the "object" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsObject() vocab.ActivityStreamsObjectProperty {
-	return this.ActivityStreamsObject
-}
-
-// GetActivityStreamsOutbox returns the "outbox" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsOutbox() vocab.ActivityStreamsOutboxProperty {
-	return this.ActivityStreamsOutbox
-}
-
-// GetActivityStreamsPreferredUsername returns the "preferredUsername" property if
-// it exists, and nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsPreferredUsername() vocab.ActivityStreamsPreferredUsernameProperty {
-	return this.ActivityStreamsPreferredUsername
-}
-
-// GetActivityStreamsPreview returns the "preview" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsPreview() vocab.ActivityStreamsPreviewProperty {
-	return this.ActivityStreamsPreview
-}
-
-// GetActivityStreamsPublished returns the "published" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsPublished() vocab.ActivityStreamsPublishedProperty {
-	return this.ActivityStreamsPublished
-}
-
-// GetActivityStreamsReplies returns the "replies" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsReplies() vocab.ActivityStreamsRepliesProperty {
-	return this.ActivityStreamsReplies
-}
-
-// GetActivityStreamsShares returns the "shares" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsShares() vocab.ActivityStreamsSharesProperty {
-	return this.ActivityStreamsShares
-}
-
-// GetActivityStreamsSource returns the "source" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsSource() vocab.ActivityStreamsSourceProperty {
-	return this.ActivityStreamsSource
-}
-
-// GetActivityStreamsStartTime returns the "startTime" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsStartTime() vocab.ActivityStreamsStartTimeProperty {
-	return this.ActivityStreamsStartTime
-}
-
-// GetActivityStreamsStreams returns the "streams" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsStreams() vocab.ActivityStreamsStreamsProperty {
-	return this.ActivityStreamsStreams
-}
-
-// GetActivityStreamsSummary returns the "summary" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsSummary() vocab.ActivityStreamsSummaryProperty {
-	return this.ActivityStreamsSummary
-}
-
-// GetActivityStreamsTag returns the "tag" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsTag() vocab.ActivityStreamsTagProperty {
-	return this.ActivityStreamsTag
-}
-
-// GetActivityStreamsTo returns the "to" property if it exists, and nil otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsTo() vocab.ActivityStreamsToProperty {
-	return this.ActivityStreamsTo
-}
-
-// GetActivityStreamsUpdated returns the "updated" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsUpdated() vocab.ActivityStreamsUpdatedProperty {
-	return this.ActivityStreamsUpdated
-}
-
-// GetActivityStreamsUrl returns the "url" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty {
-	return this.ActivityStreamsUrl
-}
-
-// GetForgeFedTeam returns the "team" property if it exists, and nil otherwise.
-func (this ActivityStreamsApplication) GetForgeFedTeam() vocab.ForgeFedTeamProperty {
-	return this.ForgeFedTeam
-}
-
-// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if it
-// exists, and nil otherwise.
-func (this ActivityStreamsApplication) GetForgeFedTicketsTrackedBy() vocab.ForgeFedTicketsTrackedByProperty {
-	return this.ForgeFedTicketsTrackedBy
-}
-
-// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if it
-// exists, and nil otherwise.
-func (this ActivityStreamsApplication) GetForgeFedTracksTicketsFor() vocab.ForgeFedTracksTicketsForProperty {
-	return this.ForgeFedTracksTicketsFor
-}
-
-// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
-func (this ActivityStreamsApplication) GetJSONLDId() vocab.JSONLDIdProperty {
-	return this.JSONLDId
-}
-
-// GetJSONLDType returns the "type" property if it exists, and nil otherwise.
-func (this ActivityStreamsApplication) GetJSONLDType() vocab.JSONLDTypeProperty {
-	return this.JSONLDType
-}
-
-// GetTootDiscoverable returns the "discoverable" property if it exists, and nil
-// otherwise.
-func (this ActivityStreamsApplication) GetTootDiscoverable() vocab.TootDiscoverableProperty {
-	return this.TootDiscoverable
-}
-
-// GetTootFeatured returns the "featured" property if it exists, and nil otherwise.
-func (this ActivityStreamsApplication) GetTootFeatured() vocab.TootFeaturedProperty {
-	return this.TootFeatured
-}
-
-// GetTypeName returns the name of this type.
-func (this ActivityStreamsApplication) GetTypeName() string {
-	return "Application"
-}
-
-// GetUnknownProperties returns the unknown properties for the Application type.
-// Note that this should not be used by app developers. It is only used to
-// help determine which implementation is LessThan the other. Developers who
-// are creating a different implementation of this type's interface can use
-// this method in their LessThan implementation, but routine ActivityPub
-// applications should not use this to bypass the code generation tool.
-func (this ActivityStreamsApplication) GetUnknownProperties() map[string]interface{} {
-	return this.unknown
-}
-
-// GetW3IDSecurityV1PublicKey returns the "publicKey" property if it exists, and
-// nil otherwise.
-func (this ActivityStreamsApplication) GetW3IDSecurityV1PublicKey() vocab.W3IDSecurityV1PublicKeyProperty {
-	return this.W3IDSecurityV1PublicKey
-}
-
-// IsExtending returns true if the Application type extends from the other type.
-func (this ActivityStreamsApplication) IsExtending(other vocab.Type) bool {
-	return ActivityStreamsApplicationExtends(other)
-}
-
-// JSONLDContext returns the JSONLD URIs required in the context string for this
-// type and the specific properties that are set. The value in the map is the
-// alias used to import the type and its properties.
-func (this ActivityStreamsApplication) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	m = this.helperJSONLDContext(this.ActivityStreamsAltitude, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAttachment, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAttributedTo, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAudience, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsBcc, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsBto, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsCc, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsContent, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsContext, m)
-	m = this.helperJSONLDContext(this.TootDiscoverable, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsDuration, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsEndTime, m)
-	m = this.helperJSONLDContext(this.TootFeatured, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsFollowers, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsFollowing, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsGenerator, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsIcon, m)
-	m = this.helperJSONLDContext(this.JSONLDId, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsImage, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsInReplyTo, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsInbox, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsLiked, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsLikes, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsLocation, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsMediaType, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsName, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsObject, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsOutbox, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPreferredUsername, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPreview, m)
-	m = this.helperJSONLDContext(this.W3IDSecurityV1PublicKey, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPublished, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsReplies, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsShares, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsSource, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsStartTime, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsStreams, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsTag, m)
-	m = this.helperJSONLDContext(this.ForgeFedTeam, m)
-	m = this.helperJSONLDContext(this.ForgeFedTicketsTrackedBy, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsTo, m)
-	m = this.helperJSONLDContext(this.ForgeFedTracksTicketsFor, m)
-	m = this.helperJSONLDContext(this.JSONLDType, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsUpdated, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsUrl, m)
-
-	return m
-}
-
-// LessThan computes if this Application is lesser, with an arbitrary but stable
-// determination.
-func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplication) bool {
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// type and the specific properties that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the type and each of its properties.
+func (this ActivityStreamsApplication) AddJSONLDContext(m map[string]string) {
+	m["https://www.w3.org/ns/activitystreams"] = this.alias
+	this.helperAddJSONLDContext(this.ActivityStreamsAlsoKnownAs, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsAltitude, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsAttachment, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsAttributedTo, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsAudience, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsBcc, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsBto, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsCc, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsContent, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsContext, m)
+	this.helperAddJSONLDContext(this.TootDiscoverable, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsDuration, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsEndTime, m)
+	this.helperAddJSONLDContext(this.TootFeatured, m)
+	this.helperAddJSONLDContext(this.TootFeaturedTags, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsFollowers, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsFollowing, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsGenerator, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsIcon, m)
+	this.helperAddJSONLDContext(this.JSONLDId, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsImage, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsInReplyTo, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsInbox, m)
+	this.helperAddJSONLDContext(this.TootIndexable, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsLiked, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsLikes, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsLocation, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsMediaType, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsMovedTo, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsName, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsObject, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsOutbox, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsPreferredUsername, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsPreview, m)
+	this.helperAddJSONLDContext(this.W3IDSecurityV1PublicKey, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsPublished, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsReplies, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsSensitive, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsShares, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsSource, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsStartTime, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsStreams, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsSummary, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsTag, m)
+	this.helperAddJSONLDContext(this.ForgeFedTeam, m)
+	this.helperAddJSONLDContext(this.ForgeFedTicketsTrackedBy, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsTo, m)
+	this.helperAddJSONLDContext(this.ForgeFedTracksTicketsFor, m)
+	this.helperAddJSONLDContext(this.JSONLDType, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsUpdated, m)
+	this.helperAddJSONLDContext(this.ActivityStreamsUrl, m)
+}
+
+// Compare compares this Application with another, with an arbitrary but stable
+// determination. It returns a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Each property is compared
+// once, so comparing deeply nested values costs half as much as calling
+// LessThan both ways.
+func (this ActivityStreamsApplication) Compare(o vocab.ActivityStreamsApplication) int {
 	// Begin: Compare known properties
+	// Compare property "alsoKnownAs"
+	if lhs, rhs := this.ActivityStreamsAlsoKnownAs, o.GetActivityStreamsAlsoKnownAs(); lhs != nil && rhs != nil {
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return -1
+	} else if lhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "altitude"
 	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "attachment"
 	if lhs, rhs := this.ActivityStreamsAttachment, o.GetActivityStreamsAttachment(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "attributedTo"
 	if lhs, rhs := this.ActivityStreamsAttributedTo, o.GetActivityStreamsAttributedTo(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "audience"
 	if lhs, rhs := this.ActivityStreamsAudience, o.GetActivityStreamsAudience(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "bcc"
 	if lhs, rhs := this.ActivityStreamsBcc, o.GetActivityStreamsBcc(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "bto"
 	if lhs, rhs := this.ActivityStreamsBto, o.GetActivityStreamsBto(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "cc"
 	if lhs, rhs := this.ActivityStreamsCc, o.GetActivityStreamsCc(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "content"
 	if lhs, rhs := this.ActivityStreamsContent, o.GetActivityStreamsContent(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "context"
 	if lhs, rhs := this.ActivityStreamsContext, o.GetActivityStreamsContext(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "discoverable"
 	if lhs, rhs := this.TootDiscoverable, o.GetTootDiscoverable(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "duration"
 	if lhs, rhs := this.ActivityStreamsDuration, o.GetActivityStreamsDuration(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "endTime"
 	if lhs, rhs := this.ActivityStreamsEndTime, o.GetActivityStreamsEndTime(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "featured"
 	if lhs, rhs := this.TootFeatured, o.GetTootFeatured(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return 1
+	} // Else: Both are nil or equal
+	// Compare property "featuredTags"
+	if lhs, rhs := this.TootFeaturedTags, o.GetTootFeaturedTags(); lhs != nil && rhs != nil {
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "followers"
 	if lhs, rhs := this.ActivityStreamsFollowers, o.GetActivityStreamsFollowers(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "following"
 	if lhs, rhs := this.ActivityStreamsFollowing, o.GetActivityStreamsFollowing(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "generator"
 	if lhs, rhs := this.ActivityStreamsGenerator, o.GetActivityStreamsGenerator(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "icon"
 	if lhs, rhs := this.ActivityStreamsIcon, o.GetActivityStreamsIcon(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "id"
 	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "image"
 	if lhs, rhs := this.ActivityStreamsImage, o.GetActivityStreamsImage(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "inReplyTo"
 	if lhs, rhs := this.ActivityStreamsInReplyTo, o.GetActivityStreamsInReplyTo(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "inbox"
 	if lhs, rhs := this.ActivityStreamsInbox, o.GetActivityStreamsInbox(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return 1
+	} // Else: Both are nil or equal
+	// Compare property "indexable"
+	if lhs, rhs := this.TootIndexable, o.GetTootIndexable(); lhs != nil && rhs != nil {
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "liked"
 	if lhs, rhs := this.ActivityStreamsLiked, o.GetActivityStreamsLiked(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "likes"
 	if lhs, rhs := this.ActivityStreamsLikes, o.GetActivityStreamsLikes(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "location"
 	if lhs, rhs := this.ActivityStreamsLocation, o.GetActivityStreamsLocation(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "mediaType"
 	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
+	// Compare property "movedTo"
+	if lhs, rhs := this.ActivityStreamsMovedTo, o.GetActivityStreamsMovedTo(); lhs != nil && rhs != nil {
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return -1
+	} else if lhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "name"
 	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "object"
 	if lhs, rhs := this.ActivityStreamsObject, o.GetActivityStreamsObject(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "outbox"
 	if lhs, rhs := this.ActivityStreamsOutbox, o.GetActivityStreamsOutbox(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "preferredUsername"
 	if lhs, rhs := this.ActivityStreamsPreferredUsername, o.GetActivityStreamsPreferredUsername(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "preview"
 	if lhs, rhs := this.ActivityStreamsPreview, o.GetActivityStreamsPreview(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "publicKey"
 	if lhs, rhs := this.W3IDSecurityV1PublicKey, o.GetW3IDSecurityV1PublicKey(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "published"
 	if lhs, rhs := this.ActivityStreamsPublished, o.GetActivityStreamsPublished(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "replies"
 	if lhs, rhs := this.ActivityStreamsReplies, o.GetActivityStreamsReplies(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
+	// Compare property "sensitive"
+	if lhs, rhs := this.ActivityStreamsSensitive, o.GetActivityStreamsSensitive(); lhs != nil && rhs != nil {
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return -1
+	} else if lhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "shares"
 	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "source"
 	if lhs, rhs := this.ActivityStreamsSource, o.GetActivityStreamsSource(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "startTime"
 	if lhs, rhs := this.ActivityStreamsStartTime, o.GetActivityStreamsStartTime(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "streams"
 	if lhs, rhs := this.ActivityStreamsStreams, o.GetActivityStreamsStreams(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "summary"
 	if lhs, rhs := this.ActivityStreamsSummary, o.GetActivityStreamsSummary(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "tag"
 	if lhs, rhs := this.ActivityStreamsTag, o.GetActivityStreamsTag(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "team"
 	if lhs, rhs := this.ForgeFedTeam, o.GetForgeFedTeam(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "ticketsTrackedBy"
 	if lhs, rhs := this.ForgeFedTicketsTrackedBy, o.GetForgeFedTicketsTrackedBy(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "to"
 	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "tracksTicketsFor"
 	if lhs, rhs := this.ForgeFedTracksTicketsFor, o.GetForgeFedTracksTicketsFor(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "type"
 	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "updated"
 	if lhs, rhs := this.ActivityStreamsUpdated, o.GetActivityStreamsUpdated(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// Compare property "url"
 	if lhs, rhs := this.ActivityStreamsUrl, o.GetActivityStreamsUrl(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
 		}
 	} else if lhs == nil && rhs != nil {
 		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
+		return -1
+	} else if lhs != nil && rhs == nil {
 		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
+		return 1
+	} // Else: Both are nil or equal
 	// End: Compare known properties
 
-	// Begin: Compare unknown properties (only by number of them)
-	if len(this.unknown) < len(o.GetUnknownProperties()) {
-		return true
-	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
-		return false
-	} // End: Compare unknown properties (only by number of them)
+	// Begin: Compare unknown properties (only by number of them)
+	if len(this.unknown) < len(o.GetUnknownProperties()) {
+		return -1
+	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
+		return 1
+	} // End: Compare unknown properties (only by number of them)
+
+	// All properties are the same.
+	return 0
+}
+
+// GetActivityStreamsAlsoKnownAs returns the "alsoKnownAs" property if it exists,
+// and nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsAlsoKnownAs() vocab.ActivityStreamsAlsoKnownAsProperty {
+	return this.ActivityStreamsAlsoKnownAs
+}
+
+// GetActivityStreamsAltitude returns the "altitude" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsAltitude() vocab.ActivityStreamsAltitudeProperty {
+	return this.ActivityStreamsAltitude
+}
+
+// GetActivityStreamsAttachment returns the "attachment" property if it exists,
+// and nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsAttachment() vocab.ActivityStreamsAttachmentProperty {
+	return this.ActivityStreamsAttachment
+}
+
+// GetActivityStreamsAttributedTo returns the "attributedTo" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsAttributedTo() vocab.ActivityStreamsAttributedToProperty {
+	return this.ActivityStreamsAttributedTo
+}
+
+// GetActivityStreamsAudience returns the "audience" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsAudience() vocab.ActivityStreamsAudienceProperty {
+	return this.ActivityStreamsAudience
+}
+
+// GetActivityStreamsBcc returns the "bcc" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsBcc() vocab.ActivityStreamsBccProperty {
+	return this.ActivityStreamsBcc
+}
+
+// GetActivityStreamsBto returns the "bto" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsBto() vocab.ActivityStreamsBtoProperty {
+	return this.ActivityStreamsBto
+}
+
+// GetActivityStreamsCc returns the "cc" property if it exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsCc() vocab.ActivityStreamsCcProperty {
+	return this.ActivityStreamsCc
+}
+
+// GetActivityStreamsContent returns the "content" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsContent() vocab.ActivityStreamsContentProperty {
+	return this.ActivityStreamsContent
+}
+
+// GetActivityStreamsContext returns the "context" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsContext() vocab.ActivityStreamsContextProperty {
+	return this.ActivityStreamsContext
+}
+
+// GetActivityStreamsDuration returns the "duration" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsDuration() vocab.ActivityStreamsDurationProperty {
+	return this.ActivityStreamsDuration
+}
+
+// GetActivityStreamsEndTime returns the "endTime" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsEndTime() vocab.ActivityStreamsEndTimeProperty {
+	return this.ActivityStreamsEndTime
+}
+
+// GetActivityStreamsFollowers returns the "followers" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsFollowers() vocab.ActivityStreamsFollowersProperty {
+	return this.ActivityStreamsFollowers
+}
+
+// GetActivityStreamsFollowing returns the "following" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsFollowing() vocab.ActivityStreamsFollowingProperty {
+	return this.ActivityStreamsFollowing
+}
+
+// GetActivityStreamsGenerator returns the "generator" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsGenerator() vocab.ActivityStreamsGeneratorProperty {
+	return this.ActivityStreamsGenerator
+}
+
+// GetActivityStreamsIcon returns the "icon" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsIcon() vocab.ActivityStreamsIconProperty {
+	return this.ActivityStreamsIcon
+}
+
+// GetActivityStreamsImage returns the "image" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsImage() vocab.ActivityStreamsImageProperty {
+	return this.ActivityStreamsImage
+}
+
+// GetActivityStreamsInReplyTo returns the "inReplyTo" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsInReplyTo() vocab.ActivityStreamsInReplyToProperty {
+	return this.ActivityStreamsInReplyTo
+}
+
+// GetActivityStreamsInbox returns the "inbox" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsInbox() vocab.ActivityStreamsInboxProperty {
+	return this.ActivityStreamsInbox
+}
+
+// GetActivityStreamsLiked returns the "liked" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsLiked() vocab.ActivityStreamsLikedProperty {
+	return this.ActivityStreamsLiked
+}
+
+// GetActivityStreamsLikes returns the "likes" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsLikes() vocab.ActivityStreamsLikesProperty {
+	return this.ActivityStreamsLikes
+}
+
+// GetActivityStreamsLocation returns the "location" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsLocation() vocab.ActivityStreamsLocationProperty {
+	return this.ActivityStreamsLocation
+}
+
+// GetActivityStreamsMediaType returns the "mediaType" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty {
+	return this.ActivityStreamsMediaType
+}
+
+// GetActivityStreamsMovedTo returns the "movedTo" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsMovedTo() vocab.ActivityStreamsMovedToProperty {
+	return this.ActivityStreamsMovedTo
+}
+
+// GetActivityStreamsName returns the "name" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsName() vocab.ActivityStreamsNameProperty {
+	return this.ActivityStreamsName
+}
+
+// GetActivityStreamsObject returns the "object" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsObject() vocab.ActivityStreamsObjectProperty {
+	return this.ActivityStreamsObject
+}
+
+// GetActivityStreamsOutbox returns the "outbox" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsOutbox() vocab.ActivityStreamsOutboxProperty {
+	return this.ActivityStreamsOutbox
+}
+
+// GetActivityStreamsPreferredUsername returns the "preferredUsername" property if
+// it exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsPreferredUsername() vocab.ActivityStreamsPreferredUsernameProperty {
+	return this.ActivityStreamsPreferredUsername
+}
+
+// GetActivityStreamsPreview returns the "preview" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsPreview() vocab.ActivityStreamsPreviewProperty {
+	return this.ActivityStreamsPreview
+}
+
+// GetActivityStreamsPublished returns the "published" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsPublished() vocab.ActivityStreamsPublishedProperty {
+	return this.ActivityStreamsPublished
+}
+
+// GetActivityStreamsReplies returns the "replies" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsReplies() vocab.ActivityStreamsRepliesProperty {
+	return this.ActivityStreamsReplies
+}
+
+// GetActivityStreamsSensitive returns the "sensitive" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsSensitive() vocab.ActivityStreamsSensitiveProperty {
+	return this.ActivityStreamsSensitive
+}
+
+// GetActivityStreamsShares returns the "shares" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsShares() vocab.ActivityStreamsSharesProperty {
+	return this.ActivityStreamsShares
+}
+
+// GetActivityStreamsSource returns the "source" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsSource() vocab.ActivityStreamsSourceProperty {
+	return this.ActivityStreamsSource
+}
+
+// GetActivityStreamsStartTime returns the "startTime" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsStartTime() vocab.ActivityStreamsStartTimeProperty {
+	return this.ActivityStreamsStartTime
+}
+
+// GetActivityStreamsStreams returns the "streams" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsStreams() vocab.ActivityStreamsStreamsProperty {
+	return this.ActivityStreamsStreams
+}
 
-	// All properties are the same.
-	return false
+// GetActivityStreamsSummary returns the "summary" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsSummary() vocab.ActivityStreamsSummaryProperty {
+	return this.ActivityStreamsSummary
+}
+
+// GetActivityStreamsTag returns the "tag" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsTag() vocab.ActivityStreamsTagProperty {
+	return this.ActivityStreamsTag
+}
+
+// GetActivityStreamsTo returns the "to" property if it exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsTo() vocab.ActivityStreamsToProperty {
+	return this.ActivityStreamsTo
+}
+
+// GetActivityStreamsUpdated returns the "updated" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsUpdated() vocab.ActivityStreamsUpdatedProperty {
+	return this.ActivityStreamsUpdated
+}
+
+// GetActivityStreamsUrl returns the "url" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty {
+	return this.ActivityStreamsUrl
+}
+
+// GetForgeFedTeam returns the "team" property if it exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetForgeFedTeam() vocab.ForgeFedTeamProperty {
+	return this.ForgeFedTeam
+}
+
+// GetForgeFedTicketsTrackedBy returns the "ticketsTrackedBy" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetForgeFedTicketsTrackedBy() vocab.ForgeFedTicketsTrackedByProperty {
+	return this.ForgeFedTicketsTrackedBy
+}
+
+// GetForgeFedTracksTicketsFor returns the "tracksTicketsFor" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetForgeFedTracksTicketsFor() vocab.ForgeFedTracksTicketsForProperty {
+	return this.ForgeFedTracksTicketsFor
+}
+
+// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetJSONLDId() vocab.JSONLDIdProperty {
+	return this.JSONLDId
+}
+
+// GetJSONLDType returns the "type" property if it exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetJSONLDType() vocab.JSONLDTypeProperty {
+	return this.JSONLDType
+}
+
+// GetTootDiscoverable returns the "discoverable" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetTootDiscoverable() vocab.TootDiscoverableProperty {
+	return this.TootDiscoverable
+}
+
+// GetTootFeatured returns the "featured" property if it exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetTootFeatured() vocab.TootFeaturedProperty {
+	return this.TootFeatured
+}
+
+// GetTootFeaturedTags returns the "featuredTags" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetTootFeaturedTags() vocab.TootFeaturedTagsProperty {
+	return this.TootFeaturedTags
+}
+
+// GetTootIndexable returns the "indexable" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetTootIndexable() vocab.TootIndexableProperty {
+	return this.TootIndexable
+}
+
+// GetTypeName returns the name of this type.
+func (this ActivityStreamsApplication) GetTypeName() string {
+	return "Application"
+}
+
+// GetUnknownProperties returns the unknown properties for the Application type.
+// Note that this should not be used by app developers. It is only used to
+// help determine which implementation is LessThan the other. Developers who
+// are creating a different implementation of this type's interface can use
+// this method in their LessThan implementation, but routine ActivityPub
+// applications should not use this to bypass the code generation tool.
+func (this ActivityStreamsApplication) GetUnknownProperties() map[string]interface{} {
+	return this.unknown
+}
+
+// GetW3IDSecurityV1PublicKey returns the "publicKey" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetW3IDSecurityV1PublicKey() vocab.W3IDSecurityV1PublicKeyProperty {
+	return this.W3IDSecurityV1PublicKey
+}
+
+// IsExtending returns true if the Application type extends from the other type.
+func (this ActivityStreamsApplication) IsExtending(other vocab.Type) bool {
+	return ActivityStreamsApplicationExtends(other)
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// type and the specific properties that are set. The value in the map is the
+// alias used to import the type and its properties.
+func (this ActivityStreamsApplication) JSONLDContext() map[string]string {
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
+	return m
+}
+
+// LessThan computes if this Application is lesser, with an arbitrary but stable
+// determination.
+func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplication) bool {
+	return this.Compare(o) < 0
+}
+
+// Release returns this type and its properties to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ActivityStreamsApplication) Release() {
+	if r, ok := this.ActivityStreamsAlsoKnownAs.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsAltitude.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsAttachment.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsAttributedTo.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsAudience.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsBcc.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsBto.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsCc.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsContent.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsContext.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.TootDiscoverable.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsDuration.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsEndTime.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.TootFeatured.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.TootFeaturedTags.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsFollowers.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsFollowing.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsGenerator.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsIcon.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.JSONLDId.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsImage.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsInReplyTo.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsInbox.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.TootIndexable.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsLiked.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsLikes.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsLocation.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsMediaType.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsMovedTo.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsName.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsObject.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsOutbox.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsPreferredUsername.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsPreview.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.W3IDSecurityV1PublicKey.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsPublished.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsReplies.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsSensitive.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsShares.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsSource.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsStartTime.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsStreams.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsSummary.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsTag.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ForgeFedTeam.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ForgeFedTicketsTrackedBy.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsTo.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ForgeFedTracksTicketsFor.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.JSONLDType.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsUpdated.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.ActivityStreamsUrl.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	unknown := this.unknown
+	for k := range unknown {
+		delete(unknown, k)
+	}
+	*this = ActivityStreamsApplication{unknown: unknown}
+	poolActivityStreamsApplication.Put(this)
 }
 
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format.
 func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, error) {
-	m := make(map[string]interface{})
+	// Begin: Count the properties to serialize
+	n := 1 + len(this.unknown)
+	if this.ActivityStreamsAlsoKnownAs != nil {
+		n++
+	}
+	if this.ActivityStreamsAltitude != nil {
+		n++
+	}
+	if this.ActivityStreamsAttachment != nil {
+		n++
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		n++
+	}
+	if this.ActivityStreamsAudience != nil {
+		n++
+	}
+	if this.ActivityStreamsBcc != nil {
+		n++
+	}
+	if this.ActivityStreamsBto != nil {
+		n++
+	}
+	if this.ActivityStreamsCc != nil {
+		n++
+	}
+	if this.ActivityStreamsContent != nil {
+		n++
+	}
+	if this.ActivityStreamsContext != nil {
+		n++
+	}
+	if this.TootDiscoverable != nil {
+		n++
+	}
+	if this.ActivityStreamsDuration != nil {
+		n++
+	}
+	if this.ActivityStreamsEndTime != nil {
+		n++
+	}
+	if this.TootFeatured != nil {
+		n++
+	}
+	if this.TootFeaturedTags != nil {
+		n++
+	}
+	if this.ActivityStreamsFollowers != nil {
+		n++
+	}
+	if this.ActivityStreamsFollowing != nil {
+		n++
+	}
+	if this.ActivityStreamsGenerator != nil {
+		n++
+	}
+	if this.ActivityStreamsIcon != nil {
+		n++
+	}
+	if this.JSONLDId != nil {
+		n++
+	}
+	if this.ActivityStreamsImage != nil {
+		n++
+	}
+	if this.ActivityStreamsInReplyTo != nil {
+		n++
+	}
+	if this.ActivityStreamsInbox != nil {
+		n++
+	}
+	if this.TootIndexable != nil {
+		n++
+	}
+	if this.ActivityStreamsLiked != nil {
+		n++
+	}
+	if this.ActivityStreamsLikes != nil {
+		n++
+	}
+	if this.ActivityStreamsLocation != nil {
+		n++
+	}
+	if this.ActivityStreamsMediaType != nil {
+		n++
+	}
+	if this.ActivityStreamsMovedTo != nil {
+		n++
+	}
+	if this.ActivityStreamsName != nil {
+		n++
+	}
+	if this.ActivityStreamsObject != nil {
+		n++
+	}
+	if this.ActivityStreamsOutbox != nil {
+		n++
+	}
+	if this.ActivityStreamsPreferredUsername != nil {
+		n++
+	}
+	if this.ActivityStreamsPreview != nil {
+		n++
+	}
+	if this.W3IDSecurityV1PublicKey != nil {
+		n++
+	}
+	if this.ActivityStreamsPublished != nil {
+		n++
+	}
+	if this.ActivityStreamsReplies != nil {
+		n++
+	}
+	if this.ActivityStreamsSensitive != nil {
+		n++
+	}
+	if this.ActivityStreamsShares != nil {
+		n++
+	}
+	if this.ActivityStreamsSource != nil {
+		n++
+	}
+	if this.ActivityStreamsStartTime != nil {
+		n++
+	}
+	if this.ActivityStreamsStreams != nil {
+		n++
+	}
+	if this.ActivityStreamsSummary != nil {
+		n++
+	}
+	if this.ActivityStreamsTag != nil {
+		n++
+	}
+	if this.ForgeFedTeam != nil {
+		n++
+	}
+	if this.ForgeFedTicketsTrackedBy != nil {
+		n++
+	}
+	if this.ActivityStreamsTo != nil {
+		n++
+	}
+	if this.ForgeFedTracksTicketsFor != nil {
+		n++
+	}
+	if this.JSONLDType != nil {
+		n++
+	}
+	if this.ActivityStreamsUpdated != nil {
+		n++
+	}
+	if this.ActivityStreamsUrl != nil {
+		n++
+	}
+	// End: Count the properties to serialize
+	m := make(map[string]interface{}, n)
 	typeName := "Application"
 	if len(this.alias) > 0 {
 		typeName = this.alias + ":" + "Application"
 	}
 	m["type"] = typeName
 	// Begin: Serialize known properties
+	// Maybe serialize property "alsoKnownAs"
+	if this.ActivityStreamsAlsoKnownAs != nil {
+		if i, err := this.ActivityStreamsAlsoKnownAs.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAlsoKnownAs.Name()] = i
+		}
+	}
 	// Maybe serialize property "altitude"
 	if this.ActivityStreamsAltitude != nil {
 		if i, err := this.ActivityStreamsAltitude.Serialize(); err != nil {
@@ -1619,6 +2017,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.TootFeatured.Name()] = i
 		}
 	}
+	// Maybe serialize property "featuredTags"
+	if this.TootFeaturedTags != nil {
+		if i, err := this.TootFeaturedTags.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.TootFeaturedTags.Name()] = i
+		}
+	}
 	// Maybe serialize property "followers"
 	if this.ActivityStreamsFollowers != nil {
 		if i, err := this.ActivityStreamsFollowers.Serialize(); err != nil {
@@ -1683,6 +2089,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsInbox.Name()] = i
 		}
 	}
+	// Maybe serialize property "indexable"
+	if this.TootIndexable != nil {
+		if i, err := this.TootIndexable.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.TootIndexable.Name()] = i
+		}
+	}
 	// Maybe serialize property "liked"
 	if this.ActivityStreamsLiked != nil {
 		if i, err := this.ActivityStreamsLiked.Serialize(); err != nil {
@@ -1715,6 +2129,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsMediaType.Name()] = i
 		}
 	}
+	// Maybe serialize property "movedTo"
+	if this.ActivityStreamsMovedTo != nil {
+		if i, err := this.ActivityStreamsMovedTo.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsMovedTo.Name()] = i
+		}
+	}
 	// Maybe serialize property "name"
 	if this.ActivityStreamsName != nil {
 		if i, err := this.ActivityStreamsName.Serialize(); err != nil {
@@ -1779,6 +2201,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsReplies.Name()] = i
 		}
 	}
+	// Maybe serialize property "sensitive"
+	if this.ActivityStreamsSensitive != nil {
+		if i, err := this.ActivityStreamsSensitive.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsSensitive.Name()] = i
+		}
+	}
 	// Maybe serialize property "shares"
 	if this.ActivityStreamsShares != nil {
 		if i, err := this.ActivityStreamsShares.Serialize(); err != nil {
@@ -1897,6 +2327,11 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 	return m, nil
 }
 
+// SetActivityStreamsAlsoKnownAs sets the "alsoKnownAs" property.
+func (this *ActivityStreamsApplication) SetActivityStreamsAlsoKnownAs(i vocab.ActivityStreamsAlsoKnownAsProperty) {
+	this.ActivityStreamsAlsoKnownAs = i
+}
+
 // SetActivityStreamsAltitude sets the "altitude" property.
 func (this *ActivityStreamsApplication) SetActivityStreamsAltitude(i vocab.ActivityStreamsAltitudeProperty) {
 	this.ActivityStreamsAltitude = i
@@ -2007,6 +2442,11 @@ func (this *ActivityStreamsApplication) SetActivityStreamsMediaType(i vocab.Acti
 	this.ActivityStreamsMediaType = i
 }
 
+// SetActivityStreamsMovedTo sets the "movedTo" property.
+func (this *ActivityStreamsApplication) SetActivityStreamsMovedTo(i vocab.ActivityStreamsMovedToProperty) {
+	this.ActivityStreamsMovedTo = i
+}
+
 // SetActivityStreamsName sets the "name" property.
 func (this *ActivityStreamsApplication) SetActivityStreamsName(i vocab.ActivityStreamsNameProperty) {
 	this.ActivityStreamsName = i
@@ -2042,6 +2482,11 @@ func (this *ActivityStreamsApplication) SetActivityStreamsReplies(i vocab.Activi
 	this.ActivityStreamsReplies = i
 }
 
+// SetActivityStreamsSensitive sets the "sensitive" property.
+func (this *ActivityStreamsApplication) SetActivityStreamsSensitive(i vocab.ActivityStreamsSensitiveProperty) {
+	this.ActivityStreamsSensitive = i
+}
+
 // SetActivityStreamsShares sets the "shares" property.
 func (this *ActivityStreamsApplication) SetActivityStreamsShares(i vocab.ActivityStreamsSharesProperty) {
 	this.ActivityStreamsShares = i
@@ -2122,6 +2567,16 @@ func (this *ActivityStreamsApplication) SetTootFeatured(i vocab.TootFeaturedProp
 	this.TootFeatured = i
 }
 
+// SetTootFeaturedTags sets the "featuredTags" property.
+func (this *ActivityStreamsApplication) SetTootFeaturedTags(i vocab.TootFeaturedTagsProperty) {
+	this.TootFeaturedTags = i
+}
+
+// SetTootIndexable sets the "indexable" property.
+func (this *ActivityStreamsApplication) SetTootIndexable(i vocab.TootIndexableProperty) {
+	this.TootIndexable = i
+}
+
 // SetW3IDSecurityV1PublicKey sets the "publicKey" property.
 func (this *ActivityStreamsApplication) SetW3IDSecurityV1PublicKey(i vocab.W3IDSecurityV1PublicKeyProperty) {
 	this.W3IDSecurityV1PublicKey = i
@@ -2132,19 +2587,89 @@ func (this ActivityStreamsApplication) VocabularyURI() string {
 	return "https://www.w3.org/ns/activitystreams"
 }
 
-// helperJSONLDContext obtains the context uris and their aliases from a property,
-// if it is not nil.
-func (this ActivityStreamsApplication) helperJSONLDContext(i jsonldContexter, toMerge map[string]string) map[string]string {
+// helperAddJSONLDContext adds the context uris and their aliases from a property
+// to the map, if it is not nil.
+func (this ActivityStreamsApplication) helperAddJSONLDContext(i jsonldContexter, m map[string]string) {
 	if i == nil {
-		return toMerge
+		return
 	}
-	for k, v := range i.JSONLDContext() {
+	if a, ok := i.(interface {
+		AddJSONLDContext(map[string]string)
+	}); ok {
+		a.AddJSONLDContext(m)
+	} else {
 		/*
 		   Since the literal maps in this function are determined at
 		   code-generation time, this loop should not overwrite an existing key with a
 		   new value.
 		*/
-		toMerge[k] = v
+		for k, v := range i.JSONLDContext() {
+			m[k] = v
+		}
 	}
-	return toMerge
 }
+
+// knownActivityStreamsApplicationProperties are the names of the properties of
+// the "Application" type, which are not unknown properties.
+var knownActivityStreamsApplicationProperties = map[string]struct{}{
+	"alsoKnownAs":          {},
+	"altitude":             {},
+	"attachment":           {},
+	"attributedTo":         {},
+	"audience":             {},
+	"bcc":                  {},
+	"bto":                  {},
+	"cc":                   {},
+	"content":              {},
+	"contentMap":           {},
+	"context":              {},
+	"discoverable":         {},
+	"duration":             {},
+	"endTime":              {},
+	"featured":             {},
+	"featuredTags":         {},
+	"followers":            {},
+	"following":            {},
+	"generator":            {},
+	"icon":                 {},
+	"id":                   {},
+	"image":                {},
+	"inReplyTo":            {},
+	"inbox":                {},
+	"indexable":            {},
+	"liked":                {},
+	"likes":                {},
+	"location":             {},
+	"mediaType":            {},
+	"movedTo":              {},
+	"name":                 {},
+	"nameMap":              {},
+	"object":               {},
+	"outbox":               {},
+	"preferredUsername":    {},
+	"preferredUsernameMap": {},
+	"preview":              {},
+	"publicKey":            {},
+	"published":            {},
+	"replies":              {},
+	"sensitive":            {},
+	"shares":               {},
+	"source":               {},
+	"startTime":            {},
+	"streams":              {},
+	"summary":              {},
+	"summaryMap":           {},
+	"tag":                  {},
+	"team":                 {},
+	"ticketsTrackedBy":     {},
+	"to":                   {},
+	"tracksTicketsFor":     {},
+	"type":                 {},
+	"updated":              {},
+	"url":                  {},
+}
+
+// poolActivityStreamsApplication holds the released values of ActivityStreamsApplication, which are reused when deserializing.
+var poolActivityStreamsApplication = sync.Pool{New: func() interface{} {
+	return &ActivityStreamsApplication{}
+}}
@@ -16,6 +16,10 @@ type privateManager interface {
 	// "ActivityStreamsAttributedToProperty" non-functional property in
 	// the vocabulary "ActivityStreams"
 	DeserializeAttributedToPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAttributedToProperty, error)
+	// DeserializeFpsPropertyPeerTube returns the deserialization method for
+	// the "PeerTubeFpsProperty" non-functional property in the vocabulary
+	// "PeerTube"
+	DeserializeFpsPropertyPeerTube() func(map[string]interface{}, map[string]string) (vocab.PeerTubeFpsProperty, error)
 	// DeserializeHeightPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsHeightProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
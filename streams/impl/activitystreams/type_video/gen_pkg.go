@@ -44,6 +44,10 @@ type privateManager interface {
 	// for the "ActivityStreamsCcProperty" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeCcPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCcProperty, error)
+	// DeserializeCommentsEnabledPropertyPeerTube returns the deserialization
+	// method for the "PeerTubeCommentsEnabledProperty" non-functional
+	// property in the vocabulary "PeerTube"
+	DeserializeCommentsEnabledPropertyPeerTube() func(map[string]interface{}, map[string]string) (vocab.PeerTubeCommentsEnabledProperty, error)
 	// DeserializeContentPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsContentProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
@@ -60,6 +64,10 @@ type privateManager interface {
 	// method for the "ActivityStreamsEndTimeProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializeEndTimePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEndTimeProperty, error)
+	// DeserializeFpsPropertyPeerTube returns the deserialization method for
+	// the "PeerTubeFpsProperty" non-functional property in the vocabulary
+	// "PeerTube"
+	DeserializeFpsPropertyPeerTube() func(map[string]interface{}, map[string]string) (vocab.PeerTubeFpsProperty, error)
 	// DeserializeGeneratorPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsGeneratorProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
@@ -80,6 +88,10 @@ type privateManager interface {
 	// method for the "ActivityStreamsInReplyToProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializeInReplyToPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsInReplyToProperty, error)
+	// DeserializeLicencePropertyPeerTube returns the deserialization method
+	// for the "PeerTubeLicenceProperty" non-functional property in the
+	// vocabulary "PeerTube"
+	DeserializeLicencePropertyPeerTube() func(map[string]interface{}, map[string]string) (vocab.PeerTubeLicenceProperty, error)
 	// DeserializeLikesPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsLikesProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
@@ -112,6 +124,10 @@ type privateManager interface {
 	// method for the "ActivityStreamsRepliesProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializeRepliesPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRepliesProperty, error)
+	// DeserializeSensitivePropertyActivityStreams returns the deserialization
+	// method for the "ActivityStreamsSensitiveProperty" non-functional
+	// property in the vocabulary "ActivityStreams"
+	DeserializeSensitivePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsSensitiveProperty, error)
 	// DeserializeSharesPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsSharesProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
@@ -160,6 +176,10 @@ type privateManager interface {
 	// method for the "ActivityStreamsUrlProperty" non-functional property
 	// in the vocabulary "ActivityStreams"
 	DeserializeUrlPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsUrlProperty, error)
+	// DeserializeViewsPropertyPeerTube returns the deserialization method for
+	// the "PeerTubeViewsProperty" non-functional property in the
+	// vocabulary "PeerTube"
+	DeserializeViewsPropertyPeerTube() func(map[string]interface{}, map[string]string) (vocab.PeerTubeViewsProperty, error)
 }
 
 // jsonldContexter is a private interface to determine the JSON-LD contexts and
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ForgeFedAssignedToProperty is the functional property "assignedTo". It is
@@ -37,7 +39,8 @@ func DeserializeAssignedToProperty(m map[string]interface{}, aliasMap map[string
 			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 			if err == nil && len(u.Scheme) > 0 {
-				this := &ForgeFedAssignedToProperty{
+				this := poolForgeFedAssignedToProperty.Get().(*ForgeFedAssignedToProperty)
+				*this = ForgeFedAssignedToProperty{
 					alias: alias,
 					iri:   u,
 				}
@@ -45,15 +48,39 @@ func DeserializeAssignedToProperty(m map[string]interface{}, aliasMap map[string
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
-			if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedAssignedToProperty{
-					activitystreamsPersonMember: v,
-					alias:                       alias,
+			// Begin: Determine the types of the value, to deserialize it as them first
+			typeValue, hasType := m["type"]
+			isType := func(vocabURI, name string) bool {
+				aliasPrefix := ""
+				if a, ok := aliasMap[vocabURI]; ok {
+					aliasPrefix = a + ":"
+				}
+				switch t := typeValue.(type) {
+				case string:
+					return strings.TrimPrefix(t, aliasPrefix) == name
+				case []interface{}:
+					for _, elem := range t {
+						if s, ok := elem.(string); ok && strings.TrimPrefix(s, aliasPrefix) == name {
+							return true
+						}
+					}
+				}
+				return false
+			}
+			// End: Determine the types of the value, to deserialize it as them first
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Person") {
+				if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedAssignedToProperty.Get().(*ForgeFedAssignedToProperty)
+					*this = ForgeFedAssignedToProperty{
+						activitystreamsPersonMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
 			}
 		}
-		this := &ForgeFedAssignedToProperty{
+		this := poolForgeFedAssignedToProperty.Get().(*ForgeFedAssignedToProperty)
+		*this = ForgeFedAssignedToProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -67,6 +94,30 @@ func NewForgeFedAssignedToProperty() *ForgeFedAssignedToProperty {
 	return &ForgeFedAssignedToProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ForgeFedAssignedToProperty) AddJSONLDContext(m map[string]string) {
+	m["https://forgefed.peers.community/ns"] = this.alias
+	if this.IsActivityStreamsPerson() {
+		if a, ok := this.activitystreamsPersonMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsPersonMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	}
+}
+
 // Clear ensures no value of this property is set. Calling IsActivityStreamsPerson
 // afterwards will return false.
 func (this *ForgeFedAssignedToProperty) Clear() {
@@ -75,6 +126,37 @@ func (this *ForgeFedAssignedToProperty) Clear() {
 	this.activitystreamsPersonMember = nil
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ForgeFedAssignedToProperty) Compare(o vocab.ForgeFedAssignedToProperty) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsActivityStreamsPerson() && !o.IsActivityStreamsPerson() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsActivityStreamsPerson() && !o.IsActivityStreamsPerson() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsActivityStreamsPerson() && o.IsActivityStreamsPerson() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	return this.Get().Compare(o.Get())
+}
+
 // Get returns the value of this property. When IsActivityStreamsPerson returns
 // false, Get will return any arbitrary value.
 func (this ForgeFedAssignedToProperty) Get() vocab.ActivityStreamsPerson {
@@ -116,19 +198,8 @@ func (this ForgeFedAssignedToProperty) IsIRI() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ForgeFedAssignedToProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://forgefed.peers.community/ns": this.alias}
-	var child map[string]string
-	if this.IsActivityStreamsPerson() {
-		child = this.Get().JSONLDContext()
-	}
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -150,30 +221,7 @@ func (this ForgeFedAssignedToProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ForgeFedAssignedToProperty) LessThan(o vocab.ForgeFedAssignedToProperty) bool {
-	// LessThan comparison for if either or both are IRIs.
-	if this.IsIRI() && o.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	} else if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsActivityStreamsPerson() && !o.IsActivityStreamsPerson() {
-		// Both are unknowns.
-		return false
-	} else if this.IsActivityStreamsPerson() && !o.IsActivityStreamsPerson() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsActivityStreamsPerson() && o.IsActivityStreamsPerson() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return this.Get().LessThan(o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "assignedTo".
@@ -185,6 +233,20 @@ func (this ForgeFedAssignedToProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ForgeFedAssignedToProperty) Release() {
+	if r, ok := this.activitystreamsPersonMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	*this = ForgeFedAssignedToProperty{}
+	poolForgeFedAssignedToProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -222,3 +284,8 @@ func (this *ForgeFedAssignedToProperty) SetType(t vocab.Type) error {
 
 	return fmt.Errorf("illegal type to set on assignedTo property: %T", t)
 }
+
+// poolForgeFedAssignedToProperty holds the released values of ForgeFedAssignedToProperty, which are reused when deserializing.
+var poolForgeFedAssignedToProperty = sync.Pool{New: func() interface{} {
+	return &ForgeFedAssignedToProperty{}
+}}
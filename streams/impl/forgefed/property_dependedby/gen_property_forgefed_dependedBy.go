@@ -4,8 +4,11 @@ package propertydependedby
 
 import (
 	"fmt"
+	proplist "github.com/go-fed/activity/streams/impl/proplist"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ForgeFedDependedByPropertyIterator is an iterator for a property. It is
@@ -15,8 +18,7 @@ type ForgeFedDependedByPropertyIterator struct {
 	unknown              interface{}
 	iri                  *url.URL
 	alias                string
-	myIdx                int
-	parent               vocab.ForgeFedDependedByProperty
+	proplist.Links[*ForgeFedDependedByPropertyIterator]
 }
 
 // NewForgeFedDependedByPropertyIterator creates a new ForgeFedDependedBy property.
@@ -36,7 +38,8 @@ func deserializeForgeFedDependedByPropertyIterator(i interface{}, aliasMap map[s
 		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 		if err == nil && len(u.Scheme) > 0 {
-			this := &ForgeFedDependedByPropertyIterator{
+			this := poolForgeFedDependedByPropertyIterator.Get().(*ForgeFedDependedByPropertyIterator)
+			*this = ForgeFedDependedByPropertyIterator{
 				alias: alias,
 				iri:   u,
 			}
@@ -44,21 +47,100 @@ func deserializeForgeFedDependedByPropertyIterator(i interface{}, aliasMap map[s
 		}
 	}
 	if m, ok := i.(map[string]interface{}); ok {
-		if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
-			this := &ForgeFedDependedByPropertyIterator{
-				alias:                alias,
-				forgefedTicketMember: v,
+		// Begin: Determine the types of the value, to deserialize it as them first
+		typeValue, hasType := m["type"]
+		isType := func(vocabURI, name string) bool {
+			aliasPrefix := ""
+			if a, ok := aliasMap[vocabURI]; ok {
+				aliasPrefix = a + ":"
+			}
+			switch t := typeValue.(type) {
+			case string:
+				return strings.TrimPrefix(t, aliasPrefix) == name
+			case []interface{}:
+				for _, elem := range t {
+					if s, ok := elem.(string); ok && strings.TrimPrefix(s, aliasPrefix) == name {
+						return true
+					}
+				}
+			}
+			return false
+		}
+		// End: Determine the types of the value, to deserialize it as them first
+		if !hasType || isType("https://forgefed.peers.community/ns", "Ticket") {
+			if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
+				this := poolForgeFedDependedByPropertyIterator.Get().(*ForgeFedDependedByPropertyIterator)
+				*this = ForgeFedDependedByPropertyIterator{
+					alias:                alias,
+					forgefedTicketMember: v,
+				}
+				return this, nil
 			}
-			return this, nil
 		}
 	}
-	this := &ForgeFedDependedByPropertyIterator{
+	this := poolForgeFedDependedByPropertyIterator.Get().(*ForgeFedDependedByPropertyIterator)
+	*this = ForgeFedDependedByPropertyIterator{
 		alias:   alias,
 		unknown: i,
 	}
 	return this, nil
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ForgeFedDependedByPropertyIterator) AddJSONLDContext(m map[string]string) {
+	m["https://forgefed.peers.community/ns"] = this.alias
+	if this.IsForgeFedTicket() {
+		if a, ok := this.forgefedTicketMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedTicketMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	}
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ForgeFedDependedByPropertyIterator) Compare(o vocab.ForgeFedDependedByPropertyIterator) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsForgeFedTicket() && !o.IsForgeFedTicket() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsForgeFedTicket() && !o.IsForgeFedTicket() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsForgeFedTicket() && o.IsForgeFedTicket() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	return this.Get().Compare(o.Get())
+}
+
 // Get returns the value of this property. When IsForgeFedTicket returns false,
 // Get will return any arbitrary value.
 func (this ForgeFedDependedByPropertyIterator) Get() vocab.ForgeFedTicket {
@@ -100,19 +182,8 @@ func (this ForgeFedDependedByPropertyIterator) IsIRI() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ForgeFedDependedByPropertyIterator) JSONLDContext() map[string]string {
-	m := map[string]string{"https://forgefed.peers.community/ns": this.alias}
-	var child map[string]string
-	if this.IsForgeFedTicket() {
-		child = this.Get().JSONLDContext()
-	}
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -134,30 +205,7 @@ func (this ForgeFedDependedByPropertyIterator) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ForgeFedDependedByPropertyIterator) LessThan(o vocab.ForgeFedDependedByPropertyIterator) bool {
-	// LessThan comparison for if either or both are IRIs.
-	if this.IsIRI() && o.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	} else if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsForgeFedTicket() && !o.IsForgeFedTicket() {
-		// Both are unknowns.
-		return false
-	} else if this.IsForgeFedTicket() && !o.IsForgeFedTicket() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsForgeFedTicket() && o.IsForgeFedTicket() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return this.Get().LessThan(o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "ForgeFedDependedBy".
@@ -169,22 +217,38 @@ func (this ForgeFedDependedByPropertyIterator) Name() string {
 	}
 }
 
-// Next returns the next iterator, or nil if there is no next iterator.
+// Next returns the next iterator, or nil if there is no next iterator. It remains
+// correct when values are added to or removed from the property, including
+// this one, without obtaining the iterator again.
 func (this ForgeFedDependedByPropertyIterator) Next() vocab.ForgeFedDependedByPropertyIterator {
-	if this.myIdx+1 >= this.parent.Len() {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx + 1)
+	if it := proplist.Next(&this); it != nil {
+		return it
 	}
+	return nil
 }
 
-// Prev returns the previous iterator, or nil if there is no previous iterator.
+// Prev returns the previous iterator, or nil if there is no previous iterator. It
+// remains correct when values are added to or removed from the property,
+// including this one, without obtaining the iterator again.
 func (this ForgeFedDependedByPropertyIterator) Prev() vocab.ForgeFedDependedByPropertyIterator {
-	if this.myIdx-1 < 0 {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx - 1)
+	if it := proplist.Prev(&this); it != nil {
+		return it
 	}
+	return nil
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ForgeFedDependedByPropertyIterator) Release() {
+	if r, ok := this.forgefedTicketMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	*this = ForgeFedDependedByPropertyIterator{}
+	poolForgeFedDependedByPropertyIterator.Put(this)
 }
 
 // Set sets the value of this property. Calling IsForgeFedTicket afterwards will
@@ -236,7 +300,7 @@ func (this ForgeFedDependedByPropertyIterator) serialize() (interface{}, error)
 // ForgeFedDependedByProperty is the non-functional property "dependedBy". It is
 // permitted to have one or more values, and of different value types.
 type ForgeFedDependedByProperty struct {
-	properties []*ForgeFedDependedByPropertyIterator
+	properties proplist.List[*ForgeFedDependedByPropertyIterator]
 	alias      string
 }
 
@@ -254,10 +318,9 @@ func DeserializeDependedByProperty(m map[string]interface{}, aliasMap map[string
 	i, ok := m[propName]
 
 	if ok {
-		this := &ForgeFedDependedByProperty{
-			alias:      alias,
-			properties: []*ForgeFedDependedByPropertyIterator{},
-		}
+		// A released value keeps its properties for reuse.
+		this := poolForgeFedDependedByProperty.Get().(*ForgeFedDependedByProperty)
+		this.alias = alias
 		if list, ok := i.([]interface{}); ok {
 			for _, iterator := range list {
 				if p, err := deserializeForgeFedDependedByPropertyIterator(iterator, aliasMap); err != nil {
@@ -274,10 +337,7 @@ func DeserializeDependedByProperty(m map[string]interface{}, aliasMap map[string
 			}
 		}
 		// Set up the properties for iteration.
-		for idx, ele := range this.properties {
-			ele.parent = this
-			ele.myIdx = idx
-		}
+		this.properties.Link()
 		return this, nil
 	}
 	return nil, nil
@@ -288,42 +348,44 @@ func NewForgeFedDependedByProperty() *ForgeFedDependedByProperty {
 	return &ForgeFedDependedByProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and each of its values.
+func (this ForgeFedDependedByProperty) AddJSONLDContext(m map[string]string) {
+	m["https://forgefed.peers.community/ns"] = this.alias
+	for _, elem := range this.properties {
+		elem.AddJSONLDContext(m)
+	}
+}
+
 // AppendForgeFedTicket appends a Ticket value to the back of a list of the
-// property "dependedBy". Invalidates iterators that are traversing using Prev.
+// property "dependedBy".
 func (this *ForgeFedDependedByProperty) AppendForgeFedTicket(v vocab.ForgeFedTicket) {
-	this.properties = append(this.properties, &ForgeFedDependedByPropertyIterator{
+	this.properties.Append(&ForgeFedDependedByPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                this.Len(),
-		parent:               this,
 	})
 }
 
 // AppendIRI appends an IRI value to the back of a list of the property
 // "dependedBy"
 func (this *ForgeFedDependedByProperty) AppendIRI(v *url.URL) {
-	this.properties = append(this.properties, &ForgeFedDependedByPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  this.Len(),
-		parent: this,
+	this.properties.Append(&ForgeFedDependedByPropertyIterator{
+		alias: this.alias,
+		iri:   v,
 	})
 }
 
-// PrependType prepends an arbitrary type value to the front of a list of the
-// property "dependedBy". Invalidates iterators that are traversing using
-// Prev. Returns an error if the type is not a valid one to set for this
-// property.
+// AppendType appends an arbitrary type value to the back of a list of the
+// property "dependedBy". Returns an error if the type is not a valid one to
+// set for this property.
 func (this *ForgeFedDependedByProperty) AppendType(t vocab.Type) error {
-	n := &ForgeFedDependedByPropertyIterator{
-		alias:  this.alias,
-		myIdx:  this.Len(),
-		parent: this,
-	}
+	n := &ForgeFedDependedByPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append(this.properties, n)
+	this.properties.Append(n)
 	return nil
 }
 
@@ -344,6 +406,31 @@ func (this ForgeFedDependedByProperty) Begin() vocab.ForgeFedDependedByPropertyI
 	}
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ForgeFedDependedByProperty) Compare(o vocab.ForgeFedDependedByProperty) int {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if c := this.properties[i].Compare(o.At(i)); c != 0 {
+			return c
+		}
+	}
+	if l1 < l2 {
+		return -1
+	} else if l1 > l2 {
+		return 1
+	}
+	return 0
+}
+
 // Empty returns returns true if there are no elements.
 func (this ForgeFedDependedByProperty) Empty() bool {
 	return this.Len() == 0
@@ -358,56 +445,33 @@ func (this ForgeFedDependedByProperty) End() vocab.ForgeFedDependedByPropertyIte
 
 // InsertForgeFedTicket inserts a Ticket value at the specified index for a
 // property "dependedBy". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedDependedByProperty) InsertForgeFedTicket(idx int, v vocab.ForgeFedTicket) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedDependedByPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedDependedByPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // Insert inserts an IRI value at the specified index for a property "dependedBy".
 // Existing elements at that index and higher are shifted back once.
-// Invalidates all iterators.
 func (this *ForgeFedDependedByProperty) InsertIRI(idx int, v *url.URL) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedDependedByPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, &ForgeFedDependedByPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
-// PrependType prepends an arbitrary type value to the front of a list of the
-// property "dependedBy". Invalidates all iterators. Returns an error if the
-// type is not a valid one to set for this property.
+// InsertType inserts an arbitrary type value at the specified index for the
+// property "dependedBy". Existing elements at that index and higher are
+// shifted back once. Returns an error if the type is not a valid one to set
+// for this property.
 func (this *ForgeFedDependedByProperty) InsertType(idx int, t vocab.Type) error {
-	n := &ForgeFedDependedByPropertyIterator{
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+	n := &ForgeFedDependedByPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = n
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, n)
 	return nil
 }
 
@@ -415,18 +479,8 @@ func (this *ForgeFedDependedByProperty) InsertType(idx int, t vocab.Type) error
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ForgeFedDependedByProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://forgefed.peers.community/ns": this.alias}
-	for _, elem := range this.properties {
-		child := elem.JSONLDContext()
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		for k, v := range child {
-			m[k] = v
-		}
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -440,7 +494,7 @@ func (this ForgeFedDependedByProperty) KindIndex(idx int) int {
 
 // Len returns the number of values that exist for the "dependedBy" property.
 func (this ForgeFedDependedByProperty) Len() (length int) {
-	return len(this.properties)
+	return this.properties.Len()
 }
 
 // Less computes whether another property is less than this one. Mixing types
@@ -469,20 +523,7 @@ func (this ForgeFedDependedByProperty) Less(i, j int) bool {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ForgeFedDependedByProperty) LessThan(o vocab.ForgeFedDependedByProperty) bool {
-	l1 := this.Len()
-	l2 := o.Len()
-	l := l1
-	if l2 < l1 {
-		l = l2
-	}
-	for i := 0; i < l; i++ {
-		if this.properties[i].LessThan(o.At(i)) {
-			return true
-		} else if o.At(i).LessThan(this.properties[i]) {
-			return false
-		}
-	}
-	return l1 < l2
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property ("dependedBy") with any alias.
@@ -495,63 +536,52 @@ func (this ForgeFedDependedByProperty) Name() string {
 }
 
 // PrependForgeFedTicket prepends a Ticket value to the front of a list of the
-// property "dependedBy". Invalidates all iterators.
+// property "dependedBy".
 func (this *ForgeFedDependedByProperty) PrependForgeFedTicket(v vocab.ForgeFedTicket) {
-	this.properties = append([]*ForgeFedDependedByPropertyIterator{{
+	this.properties.Prepend(&ForgeFedDependedByPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                0,
-		parent:               this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependIRI prepends an IRI value to the front of a list of the property
 // "dependedBy".
 func (this *ForgeFedDependedByProperty) PrependIRI(v *url.URL) {
-	this.properties = append([]*ForgeFedDependedByPropertyIterator{{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  0,
-		parent: this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(&ForgeFedDependedByPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // PrependType prepends an arbitrary type value to the front of a list of the
-// property "dependedBy". Invalidates all iterators. Returns an error if the
-// type is not a valid one to set for this property.
+// property "dependedBy". Returns an error if the type is not a valid one to
+// set for this property.
 func (this *ForgeFedDependedByProperty) PrependType(t vocab.Type) error {
-	n := &ForgeFedDependedByPropertyIterator{
-		alias:  this.alias,
-		myIdx:  0,
-		parent: this,
-	}
+	n := &ForgeFedDependedByPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append([]*ForgeFedDependedByPropertyIterator{n}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(n)
 	return nil
 }
 
+// Release returns this property and its values to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ForgeFedDependedByProperty) Release() {
+	for i, elem := range this.properties {
+		elem.Release()
+		this.properties[i] = nil
+	}
+	*this = ForgeFedDependedByProperty{properties: this.properties[:0]}
+	poolForgeFedDependedByProperty.Put(this)
+}
+
 // Remove deletes an element at the specified index from a list of the property
 // "dependedBy", regardless of its type. Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedDependedByProperty) Remove(idx int) {
-	(this.properties)[idx].parent = nil
-	copy((this.properties)[idx:], (this.properties)[idx+1:])
-	(this.properties)[len(this.properties)-1] = &ForgeFedDependedByPropertyIterator{}
-	this.properties = (this.properties)[:len(this.properties)-1]
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Remove(idx)
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -575,48 +605,57 @@ func (this ForgeFedDependedByProperty) Serialize() (interface{}, error) {
 }
 
 // Set sets a Ticket value to be at the specified index for the property
-// "dependedBy". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// "dependedBy". Panics if the index is out of bounds.
 func (this *ForgeFedDependedByProperty) Set(idx int, v vocab.ForgeFedTicket) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedDependedByPropertyIterator{
+	this.properties.Set(idx, &ForgeFedDependedByPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
+	})
 }
 
 // SetIRI sets an IRI value to be at the specified index for the property
 // "dependedBy". Panics if the index is out of bounds.
 func (this *ForgeFedDependedByProperty) SetIRI(idx int, v *url.URL) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedDependedByPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
+	this.properties.Set(idx, &ForgeFedDependedByPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // SetType sets an arbitrary type value to the specified index of the property
-// "dependedBy". Invalidates all iterators. Returns an error if the type is
-// not a valid one to set for this property. Panics if the index is out of
-// bounds.
+// "dependedBy". Returns an error if the type is not a valid one to set for
+// this property. Panics if the index is out of bounds.
 func (this *ForgeFedDependedByProperty) SetType(idx int, t vocab.Type) error {
-	n := &ForgeFedDependedByPropertyIterator{
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+	n := &ForgeFedDependedByPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	(this.properties)[idx] = n
+	this.properties.Set(idx, n)
 	return nil
 }
 
 // Swap swaps the location of values at two indices for the "dependedBy" property.
 func (this ForgeFedDependedByProperty) Swap(i, j int) {
-	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+	this.properties.Swap(i, j)
 }
+
+// Values returns the iterators of all values from front to back, so they can be
+// ranged over. Adding values to or removing them from this property
+// afterwards does not change the returned slice.
+func (this ForgeFedDependedByProperty) Values() []vocab.ForgeFedDependedByPropertyIterator {
+	values := make([]vocab.ForgeFedDependedByPropertyIterator, len(this.properties))
+	for i, elem := range this.properties {
+		values[i] = elem
+	}
+	return values
+}
+
+// poolForgeFedDependedByProperty holds the released values of ForgeFedDependedByProperty, which are reused when deserializing.
+var poolForgeFedDependedByProperty = sync.Pool{New: func() interface{} {
+	return &ForgeFedDependedByProperty{}
+}}
+
+// poolForgeFedDependedByPropertyIterator holds the released values of ForgeFedDependedByPropertyIterator, which are reused when deserializing.
+var poolForgeFedDependedByPropertyIterator = sync.Pool{New: func() interface{} {
+	return &ForgeFedDependedByPropertyIterator{}
+}}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ForgeFedDependenciesProperty is the functional property "dependencies". It is
@@ -42,7 +44,8 @@ func DeserializeDependenciesProperty(m map[string]interface{}, aliasMap map[stri
 			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 			if err == nil && len(u.Scheme) > 0 {
-				this := &ForgeFedDependenciesProperty{
+				this := poolForgeFedDependenciesProperty.Get().(*ForgeFedDependenciesProperty)
+				*this = ForgeFedDependenciesProperty{
 					alias: alias,
 					iri:   u,
 				}
@@ -50,21 +53,49 @@ func DeserializeDependenciesProperty(m map[string]interface{}, aliasMap map[stri
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
-			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedDependenciesProperty{
-					activitystreamsOrderedCollectionMember: v,
-					alias:                                  alias,
+			// Begin: Determine the types of the value, to deserialize it as them first
+			typeValue, hasType := m["type"]
+			isType := func(vocabURI, name string) bool {
+				aliasPrefix := ""
+				if a, ok := aliasMap[vocabURI]; ok {
+					aliasPrefix = a + ":"
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedDependenciesProperty{
-					activitystreamsOrderedCollectionPageMember: v,
-					alias: alias,
+				switch t := typeValue.(type) {
+				case string:
+					return strings.TrimPrefix(t, aliasPrefix) == name
+				case []interface{}:
+					for _, elem := range t {
+						if s, ok := elem.(string); ok && strings.TrimPrefix(s, aliasPrefix) == name {
+							return true
+						}
+					}
+				}
+				return false
+			}
+			// End: Determine the types of the value, to deserialize it as them first
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "OrderedCollection") {
+				if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedDependenciesProperty.Get().(*ForgeFedDependenciesProperty)
+					*this = ForgeFedDependenciesProperty{
+						activitystreamsOrderedCollectionMember: v,
+						alias:                                  alias,
+					}
+					return this, nil
+				}
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "OrderedCollectionPage") {
+				if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedDependenciesProperty.Get().(*ForgeFedDependenciesProperty)
+					*this = ForgeFedDependenciesProperty{
+						activitystreamsOrderedCollectionPageMember: v,
+						alias: alias,
+					}
+					return this, nil
 				}
-				return this, nil
 			}
 		}
-		this := &ForgeFedDependenciesProperty{
+		this := poolForgeFedDependenciesProperty.Get().(*ForgeFedDependenciesProperty)
+		*this = ForgeFedDependenciesProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -78,6 +109,45 @@ func NewForgeFedDependenciesProperty() *ForgeFedDependenciesProperty {
 	return &ForgeFedDependenciesProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ForgeFedDependenciesProperty) AddJSONLDContext(m map[string]string) {
+	m["https://forgefed.peers.community/ns"] = this.alias
+	if this.IsActivityStreamsOrderedCollection() {
+		if a, ok := this.activitystreamsOrderedCollectionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrderedCollectionMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		if a, ok := this.activitystreamsOrderedCollectionPageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrderedCollectionPageMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	}
+}
+
 // Clear ensures no value of this property is set. Calling HasAny or any of the
 // 'Is' methods afterwards will return false.
 func (this *ForgeFedDependenciesProperty) Clear() {
@@ -87,6 +157,28 @@ func (this *ForgeFedDependenciesProperty) Clear() {
 	this.iri = nil
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ForgeFedDependenciesProperty) Compare(o vocab.ForgeFedDependenciesProperty) int {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return -1
+	} else if idx1 > idx2 {
+		return 1
+	} else if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection().Compare(o.GetActivityStreamsOrderedCollection())
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage().Compare(o.GetActivityStreamsOrderedCollectionPage())
+	} else if this.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	}
+	return 0
+}
+
 // GetActivityStreamsOrderedCollection returns the value of this property. When
 // IsActivityStreamsOrderedCollection returns false,
 // GetActivityStreamsOrderedCollection will return an arbitrary value.
@@ -154,21 +246,8 @@ func (this ForgeFedDependenciesProperty) IsIRI() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ForgeFedDependenciesProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://forgefed.peers.community/ns": this.alias}
-	var child map[string]string
-	if this.IsActivityStreamsOrderedCollection() {
-		child = this.GetActivityStreamsOrderedCollection().JSONLDContext()
-	} else if this.IsActivityStreamsOrderedCollectionPage() {
-		child = this.GetActivityStreamsOrderedCollectionPage().JSONLDContext()
-	}
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -193,20 +272,7 @@ func (this ForgeFedDependenciesProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ForgeFedDependenciesProperty) LessThan(o vocab.ForgeFedDependenciesProperty) bool {
-	idx1 := this.KindIndex()
-	idx2 := o.KindIndex()
-	if idx1 < idx2 {
-		return true
-	} else if idx1 > idx2 {
-		return false
-	} else if this.IsActivityStreamsOrderedCollection() {
-		return this.GetActivityStreamsOrderedCollection().LessThan(o.GetActivityStreamsOrderedCollection())
-	} else if this.IsActivityStreamsOrderedCollectionPage() {
-		return this.GetActivityStreamsOrderedCollectionPage().LessThan(o.GetActivityStreamsOrderedCollectionPage())
-	} else if this.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	}
-	return false
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "dependencies".
@@ -218,6 +284,25 @@ func (this ForgeFedDependenciesProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ForgeFedDependenciesProperty) Release() {
+	if r, ok := this.activitystreamsOrderedCollectionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOrderedCollectionPageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	*this = ForgeFedDependenciesProperty{}
+	poolForgeFedDependenciesProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -267,3 +352,8 @@ func (this *ForgeFedDependenciesProperty) SetType(t vocab.Type) error {
 
 	return fmt.Errorf("illegal type to set on dependencies property: %T", t)
 }
+
+// poolForgeFedDependenciesProperty holds the released values of ForgeFedDependenciesProperty, which are reused when deserializing.
+var poolForgeFedDependenciesProperty = sync.Pool{New: func() interface{} {
+	return &ForgeFedDependenciesProperty{}
+}}
@@ -4,9 +4,12 @@ package propertyfilesremoved
 
 import (
 	"fmt"
+	proplist "github.com/go-fed/activity/streams/impl/proplist"
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ForgeFedFilesRemovedPropertyIterator is an iterator for a property. It is
@@ -17,8 +20,7 @@ type ForgeFedFilesRemovedPropertyIterator struct {
 	unknown               interface{}
 	iri                   *url.URL
 	alias                 string
-	myIdx                 int
-	parent                vocab.ForgeFedFilesRemovedProperty
+	proplist.Links[*ForgeFedFilesRemovedPropertyIterator]
 }
 
 // NewForgeFedFilesRemovedPropertyIterator creates a new ForgeFedFilesRemoved
@@ -39,7 +41,8 @@ func deserializeForgeFedFilesRemovedPropertyIterator(i interface{}, aliasMap map
 		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 		if err == nil && len(u.Scheme) > 0 {
-			this := &ForgeFedFilesRemovedPropertyIterator{
+			this := poolForgeFedFilesRemovedPropertyIterator.Get().(*ForgeFedFilesRemovedPropertyIterator)
+			*this = ForgeFedFilesRemovedPropertyIterator{
 				alias: alias,
 				iri:   u,
 			}
@@ -47,20 +50,66 @@ func deserializeForgeFedFilesRemovedPropertyIterator(i interface{}, aliasMap map
 		}
 	}
 	if v, err := string1.DeserializeString(i); err == nil {
-		this := &ForgeFedFilesRemovedPropertyIterator{
+		this := poolForgeFedFilesRemovedPropertyIterator.Get().(*ForgeFedFilesRemovedPropertyIterator)
+		*this = ForgeFedFilesRemovedPropertyIterator{
 			alias:                 alias,
 			hasStringMember:       true,
 			xmlschemaStringMember: v,
 		}
 		return this, nil
 	}
-	this := &ForgeFedFilesRemovedPropertyIterator{
+	this := poolForgeFedFilesRemovedPropertyIterator.Get().(*ForgeFedFilesRemovedPropertyIterator)
+	*this = ForgeFedFilesRemovedPropertyIterator{
 		alias:   alias,
 		unknown: i,
 	}
 	return this, nil
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ForgeFedFilesRemovedPropertyIterator) AddJSONLDContext(m map[string]string) {
+	m["https://forgefed.peers.community/ns"] = this.alias
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ForgeFedFilesRemovedPropertyIterator) Compare(o vocab.ForgeFedFilesRemovedPropertyIterator) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsXMLSchemaString() && !o.IsXMLSchemaString() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsXMLSchemaString() && !o.IsXMLSchemaString() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsXMLSchemaString() && o.IsXMLSchemaString() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	if lhs, rhs := this.Get(), o.Get(); string1.LessString(lhs, rhs) {
+		return -1
+	} else if string1.LessString(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
 // Get returns the value of this property. When IsXMLSchemaString returns false,
 // Get will return any arbitrary value.
 func (this ForgeFedFilesRemovedPropertyIterator) Get() string {
@@ -92,17 +141,8 @@ func (this ForgeFedFilesRemovedPropertyIterator) IsXMLSchemaString() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ForgeFedFilesRemovedPropertyIterator) JSONLDContext() map[string]string {
-	m := map[string]string{"https://forgefed.peers.community/ns": this.alias}
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -124,30 +164,7 @@ func (this ForgeFedFilesRemovedPropertyIterator) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ForgeFedFilesRemovedPropertyIterator) LessThan(o vocab.ForgeFedFilesRemovedPropertyIterator) bool {
-	// LessThan comparison for if either or both are IRIs.
-	if this.IsIRI() && o.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	} else if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsXMLSchemaString() && !o.IsXMLSchemaString() {
-		// Both are unknowns.
-		return false
-	} else if this.IsXMLSchemaString() && !o.IsXMLSchemaString() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsXMLSchemaString() && o.IsXMLSchemaString() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return string1.LessString(this.Get(), o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "ForgeFedFilesRemoved".
@@ -159,22 +176,33 @@ func (this ForgeFedFilesRemovedPropertyIterator) Name() string {
 	}
 }
 
-// Next returns the next iterator, or nil if there is no next iterator.
+// Next returns the next iterator, or nil if there is no next iterator. It remains
+// correct when values are added to or removed from the property, including
+// this one, without obtaining the iterator again.
 func (this ForgeFedFilesRemovedPropertyIterator) Next() vocab.ForgeFedFilesRemovedPropertyIterator {
-	if this.myIdx+1 >= this.parent.Len() {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx + 1)
+	if it := proplist.Next(&this); it != nil {
+		return it
 	}
+	return nil
 }
 
-// Prev returns the previous iterator, or nil if there is no previous iterator.
+// Prev returns the previous iterator, or nil if there is no previous iterator. It
+// remains correct when values are added to or removed from the property,
+// including this one, without obtaining the iterator again.
 func (this ForgeFedFilesRemovedPropertyIterator) Prev() vocab.ForgeFedFilesRemovedPropertyIterator {
-	if this.myIdx-1 < 0 {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx - 1)
+	if it := proplist.Prev(&this); it != nil {
+		return it
 	}
+	return nil
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ForgeFedFilesRemovedPropertyIterator) Release() {
+	*this = ForgeFedFilesRemovedPropertyIterator{}
+	poolForgeFedFilesRemovedPropertyIterator.Put(this)
 }
 
 // Set sets the value of this property. Calling IsXMLSchemaString afterwards will
@@ -216,7 +244,7 @@ func (this ForgeFedFilesRemovedPropertyIterator) serialize() (interface{}, error
 // ForgeFedFilesRemovedProperty is the non-functional property "filesRemoved". It
 // is permitted to have one or more values, and of different value types.
 type ForgeFedFilesRemovedProperty struct {
-	properties []*ForgeFedFilesRemovedPropertyIterator
+	properties proplist.List[*ForgeFedFilesRemovedPropertyIterator]
 	alias      string
 }
 
@@ -235,10 +263,9 @@ func DeserializeFilesRemovedProperty(m map[string]interface{}, aliasMap map[stri
 	i, ok := m[propName]
 
 	if ok {
-		this := &ForgeFedFilesRemovedProperty{
-			alias:      alias,
-			properties: []*ForgeFedFilesRemovedPropertyIterator{},
-		}
+		// A released value keeps its properties for reuse.
+		this := poolForgeFedFilesRemovedProperty.Get().(*ForgeFedFilesRemovedProperty)
+		this.alias = alias
 		if list, ok := i.([]interface{}); ok {
 			for _, iterator := range list {
 				if p, err := deserializeForgeFedFilesRemovedPropertyIterator(iterator, aliasMap); err != nil {
@@ -255,10 +282,7 @@ func DeserializeFilesRemovedProperty(m map[string]interface{}, aliasMap map[stri
 			}
 		}
 		// Set up the properties for iteration.
-		for idx, ele := range this.properties {
-			ele.parent = this
-			ele.myIdx = idx
-		}
+		this.properties.Link()
 		return this, nil
 	}
 	return nil, nil
@@ -269,26 +293,32 @@ func NewForgeFedFilesRemovedProperty() *ForgeFedFilesRemovedProperty {
 	return &ForgeFedFilesRemovedProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and each of its values.
+func (this ForgeFedFilesRemovedProperty) AddJSONLDContext(m map[string]string) {
+	m["https://forgefed.peers.community/ns"] = this.alias
+	for _, elem := range this.properties {
+		elem.AddJSONLDContext(m)
+	}
+}
+
 // AppendIRI appends an IRI value to the back of a list of the property
 // "filesRemoved"
 func (this *ForgeFedFilesRemovedProperty) AppendIRI(v *url.URL) {
-	this.properties = append(this.properties, &ForgeFedFilesRemovedPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  this.Len(),
-		parent: this,
+	this.properties.Append(&ForgeFedFilesRemovedPropertyIterator{
+		alias: this.alias,
+		iri:   v,
 	})
 }
 
 // AppendXMLSchemaString appends a string value to the back of a list of the
-// property "filesRemoved". Invalidates iterators that are traversing using
-// Prev.
+// property "filesRemoved".
 func (this *ForgeFedFilesRemovedProperty) AppendXMLSchemaString(v string) {
-	this.properties = append(this.properties, &ForgeFedFilesRemovedPropertyIterator{
+	this.properties.Append(&ForgeFedFilesRemovedPropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 this.Len(),
-		parent:                this,
 		xmlschemaStringMember: v,
 	})
 }
@@ -310,6 +340,31 @@ func (this ForgeFedFilesRemovedProperty) Begin() vocab.ForgeFedFilesRemovedPrope
 	}
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ForgeFedFilesRemovedProperty) Compare(o vocab.ForgeFedFilesRemovedProperty) int {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if c := this.properties[i].Compare(o.At(i)); c != 0 {
+			return c
+		}
+	}
+	if l1 < l2 {
+		return -1
+	} else if l1 > l2 {
+		return 1
+	}
+	return 0
+}
+
 // Empty returns returns true if there are no elements.
 func (this ForgeFedFilesRemovedProperty) Empty() bool {
 	return this.Len() == 0
@@ -324,55 +379,31 @@ func (this ForgeFedFilesRemovedProperty) End() vocab.ForgeFedFilesRemovedPropert
 
 // Insert inserts an IRI value at the specified index for a property
 // "filesRemoved". Existing elements at that index and higher are shifted back
-// once. Invalidates all iterators.
+// once.
 func (this *ForgeFedFilesRemovedProperty) InsertIRI(idx int, v *url.URL) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedFilesRemovedPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, &ForgeFedFilesRemovedPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // InsertXMLSchemaString inserts a string value at the specified index for a
 // property "filesRemoved". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedFilesRemovedProperty) InsertXMLSchemaString(idx int, v string) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedFilesRemovedPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedFilesRemovedPropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaStringMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ForgeFedFilesRemovedProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://forgefed.peers.community/ns": this.alias}
-	for _, elem := range this.properties {
-		child := elem.JSONLDContext()
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		for k, v := range child {
-			m[k] = v
-		}
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -386,7 +417,7 @@ func (this ForgeFedFilesRemovedProperty) KindIndex(idx int) int {
 
 // Len returns the number of values that exist for the "filesRemoved" property.
 func (this ForgeFedFilesRemovedProperty) Len() (length int) {
-	return len(this.properties)
+	return this.properties.Len()
 }
 
 // Less computes whether another property is less than this one. Mixing types
@@ -415,20 +446,7 @@ func (this ForgeFedFilesRemovedProperty) Less(i, j int) bool {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ForgeFedFilesRemovedProperty) LessThan(o vocab.ForgeFedFilesRemovedProperty) bool {
-	l1 := this.Len()
-	l2 := o.Len()
-	l := l1
-	if l2 < l1 {
-		l = l2
-	}
-	for i := 0; i < l; i++ {
-		if this.properties[i].LessThan(o.At(i)) {
-			return true
-		} else if o.At(i).LessThan(this.properties[i]) {
-			return false
-		}
-	}
-	return l1 < l2
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property ("filesRemoved") with any alias.
@@ -443,43 +461,40 @@ func (this ForgeFedFilesRemovedProperty) Name() string {
 // PrependIRI prepends an IRI value to the front of a list of the property
 // "filesRemoved".
 func (this *ForgeFedFilesRemovedProperty) PrependIRI(v *url.URL) {
-	this.properties = append([]*ForgeFedFilesRemovedPropertyIterator{{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  0,
-		parent: this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(&ForgeFedFilesRemovedPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // PrependXMLSchemaString prepends a string value to the front of a list of the
-// property "filesRemoved". Invalidates all iterators.
+// property "filesRemoved".
 func (this *ForgeFedFilesRemovedProperty) PrependXMLSchemaString(v string) {
-	this.properties = append([]*ForgeFedFilesRemovedPropertyIterator{{
+	this.properties.Prepend(&ForgeFedFilesRemovedPropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 0,
-		parent:                this,
 		xmlschemaStringMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
+	})
+}
+
+// Release returns this property and its values to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ForgeFedFilesRemovedProperty) Release() {
+	for i, elem := range this.properties {
+		elem.Release()
+		this.properties[i] = nil
 	}
+	*this = ForgeFedFilesRemovedProperty{properties: this.properties[:0]}
+	poolForgeFedFilesRemovedProperty.Put(this)
 }
 
 // Remove deletes an element at the specified index from a list of the property
 // "filesRemoved", regardless of its type. Panics if the index is out of
-// bounds. Invalidates all iterators.
+// bounds.
 func (this *ForgeFedFilesRemovedProperty) Remove(idx int) {
-	(this.properties)[idx].parent = nil
-	copy((this.properties)[idx:], (this.properties)[idx+1:])
-	(this.properties)[len(this.properties)-1] = &ForgeFedFilesRemovedPropertyIterator{}
-	this.properties = (this.properties)[:len(this.properties)-1]
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Remove(idx)
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -503,33 +518,47 @@ func (this ForgeFedFilesRemovedProperty) Serialize() (interface{}, error) {
 }
 
 // Set sets a string value to be at the specified index for the property
-// "filesRemoved". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// "filesRemoved". Panics if the index is out of bounds.
 func (this *ForgeFedFilesRemovedProperty) Set(idx int, v string) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedFilesRemovedPropertyIterator{
+	this.properties.Set(idx, &ForgeFedFilesRemovedPropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaStringMember: v,
-	}
+	})
 }
 
 // SetIRI sets an IRI value to be at the specified index for the property
 // "filesRemoved". Panics if the index is out of bounds.
 func (this *ForgeFedFilesRemovedProperty) SetIRI(idx int, v *url.URL) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedFilesRemovedPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
+	this.properties.Set(idx, &ForgeFedFilesRemovedPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // Swap swaps the location of values at two indices for the "filesRemoved"
 // property.
 func (this ForgeFedFilesRemovedProperty) Swap(i, j int) {
-	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+	this.properties.Swap(i, j)
 }
+
+// Values returns the iterators of all values from front to back, so they can be
+// ranged over. Adding values to or removing them from this property
+// afterwards does not change the returned slice.
+func (this ForgeFedFilesRemovedProperty) Values() []vocab.ForgeFedFilesRemovedPropertyIterator {
+	values := make([]vocab.ForgeFedFilesRemovedPropertyIterator, len(this.properties))
+	for i, elem := range this.properties {
+		values[i] = elem
+	}
+	return values
+}
+
+// poolForgeFedFilesRemovedProperty holds the released values of ForgeFedFilesRemovedProperty, which are reused when deserializing.
+var poolForgeFedFilesRemovedProperty = sync.Pool{New: func() interface{} {
+	return &ForgeFedFilesRemovedProperty{}
+}}
+
+// poolForgeFedFilesRemovedPropertyIterator holds the released values of ForgeFedFilesRemovedPropertyIterator, which are reused when deserializing.
+var poolForgeFedFilesRemovedPropertyIterator = sync.Pool{New: func() interface{} {
+	return &ForgeFedFilesRemovedPropertyIterator{}
+}}
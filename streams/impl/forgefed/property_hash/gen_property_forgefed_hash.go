@@ -7,6 +7,8 @@ import (
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ForgeFedHashProperty is the functional property "hash". It is permitted to be a
@@ -39,7 +41,8 @@ func DeserializeHashProperty(m map[string]interface{}, aliasMap map[string]strin
 			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 			if err == nil && len(u.Scheme) > 0 {
-				this := &ForgeFedHashProperty{
+				this := poolForgeFedHashProperty.Get().(*ForgeFedHashProperty)
+				*this = ForgeFedHashProperty{
 					alias: alias,
 					iri:   u,
 				}
@@ -47,14 +50,16 @@ func DeserializeHashProperty(m map[string]interface{}, aliasMap map[string]strin
 			}
 		}
 		if v, err := string1.DeserializeString(i); err == nil {
-			this := &ForgeFedHashProperty{
+			this := poolForgeFedHashProperty.Get().(*ForgeFedHashProperty)
+			*this = ForgeFedHashProperty{
 				alias:                 alias,
 				hasStringMember:       true,
 				xmlschemaStringMember: v,
 			}
 			return this, nil
 		}
-		this := &ForgeFedHashProperty{
+		this := poolForgeFedHashProperty.Get().(*ForgeFedHashProperty)
+		*this = ForgeFedHashProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -68,6 +73,14 @@ func NewForgeFedHashProperty() *ForgeFedHashProperty {
 	return &ForgeFedHashProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ForgeFedHashProperty) AddJSONLDContext(m map[string]string) {
+	m["https://forgefed.peers.community/ns"] = this.alias
+}
+
 // Clear ensures no value of this property is set. Calling IsXMLSchemaString
 // afterwards will return false.
 func (this *ForgeFedHashProperty) Clear() {
@@ -76,6 +89,42 @@ func (this *ForgeFedHashProperty) Clear() {
 	this.hasStringMember = false
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ForgeFedHashProperty) Compare(o vocab.ForgeFedHashProperty) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsXMLSchemaString() && !o.IsXMLSchemaString() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsXMLSchemaString() && !o.IsXMLSchemaString() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsXMLSchemaString() && o.IsXMLSchemaString() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	if lhs, rhs := this.Get(), o.Get(); string1.LessString(lhs, rhs) {
+		return -1
+	} else if string1.LessString(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
 // Get returns the value of this property. When IsXMLSchemaString returns false,
 // Get will return any arbitrary value.
 func (this ForgeFedHashProperty) Get() string {
@@ -107,17 +156,8 @@ func (this ForgeFedHashProperty) IsXMLSchemaString() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ForgeFedHashProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://forgefed.peers.community/ns": this.alias}
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -139,30 +179,7 @@ func (this ForgeFedHashProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ForgeFedHashProperty) LessThan(o vocab.ForgeFedHashProperty) bool {
-	// LessThan comparison for if either or both are IRIs.
-	if this.IsIRI() && o.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	} else if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsXMLSchemaString() && !o.IsXMLSchemaString() {
-		// Both are unknowns.
-		return false
-	} else if this.IsXMLSchemaString() && !o.IsXMLSchemaString() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsXMLSchemaString() && o.IsXMLSchemaString() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return string1.LessString(this.Get(), o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "hash".
@@ -174,6 +191,15 @@ func (this ForgeFedHashProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ForgeFedHashProperty) Release() {
+	*this = ForgeFedHashProperty{}
+	poolForgeFedHashProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -201,3 +227,8 @@ func (this *ForgeFedHashProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.iri = v
 }
+
+// poolForgeFedHashProperty holds the released values of ForgeFedHashProperty, which are reused when deserializing.
+var poolForgeFedHashProperty = sync.Pool{New: func() interface{} {
+	return &ForgeFedHashProperty{}
+}}
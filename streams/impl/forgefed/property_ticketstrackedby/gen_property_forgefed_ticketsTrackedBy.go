@@ -6,6 +6,8 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ForgeFedTicketsTrackedByProperty is the functional property "ticketsTrackedBy".
@@ -33,6 +35,7 @@ type ForgeFedTicketsTrackedByProperty struct {
 	activitystreamsDislikeMember               vocab.ActivityStreamsDislike
 	activitystreamsDocumentMember              vocab.ActivityStreamsDocument
 	tootEmojiMember                            vocab.TootEmoji
+	litepubEmojiReactMember                    vocab.LitepubEmojiReact
 	activitystreamsEventMember                 vocab.ActivityStreamsEvent
 	activitystreamsFlagMember                  vocab.ActivityStreamsFlag
 	activitystreamsFollowMember                vocab.ActivityStreamsFollow
@@ -56,6 +59,7 @@ type ForgeFedTicketsTrackedByProperty struct {
 	activitystreamsPersonMember                vocab.ActivityStreamsPerson
 	activitystreamsPlaceMember                 vocab.ActivityStreamsPlace
 	activitystreamsProfileMember               vocab.ActivityStreamsProfile
+	schemaPropertyValueMember                  vocab.SchemaPropertyValue
 	forgefedPushMember                         vocab.ForgeFedPush
 	activitystreamsQuestionMember              vocab.ActivityStreamsQuestion
 	activitystreamsReadMember                  vocab.ActivityStreamsRead
@@ -100,7 +104,8 @@ func DeserializeTicketsTrackedByProperty(m map[string]interface{}, aliasMap map[
 			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 			if err == nil && len(u.Scheme) > 0 {
-				this := &ForgeFedTicketsTrackedByProperty{
+				this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+				*this = ForgeFedTicketsTrackedByProperty{
 					alias: alias,
 					iri:   u,
 				}
@@ -108,369 +113,649 @@ func DeserializeTicketsTrackedByProperty(m map[string]interface{}, aliasMap map[
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
-			if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsObjectMember: v,
-					alias:                       alias,
+			// Begin: Determine the types of the value, to deserialize it as them first
+			typeValue, hasType := m["type"]
+			isType := func(vocabURI, name string) bool {
+				aliasPrefix := ""
+				if a, ok := aliasMap[vocabURI]; ok {
+					aliasPrefix = a + ":"
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsAcceptMember: v,
-					alias:                       alias,
+				switch t := typeValue.(type) {
+				case string:
+					return strings.TrimPrefix(t, aliasPrefix) == name
+				case []interface{}:
+					for _, elem := range t {
+						if s, ok := elem.(string); ok && strings.TrimPrefix(s, aliasPrefix) == name {
+							return true
+						}
+					}
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsActivityMember: v,
-					alias:                         alias,
+				return false
+			}
+			// End: Determine the types of the value, to deserialize it as them first
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Object") {
+				if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsObjectMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsAddMember: v,
-					alias:                    alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Accept") {
+				if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsAcceptMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsAnnounceMember: v,
-					alias:                         alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Activity") {
+				if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsActivityMember: v,
+						alias:                         alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsApplicationMember: v,
-					alias:                            alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Add") {
+				if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsAddMember: v,
+						alias:                    alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsArriveMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Announce") {
+				if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsAnnounceMember: v,
+						alias:                         alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsArticleMember: v,
-					alias:                        alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Application") {
+				if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsApplicationMember: v,
+						alias:                            alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsAudioMember: v,
-					alias:                      alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Arrive") {
+				if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsArriveMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsBlockMember: v,
-					alias:                      alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Article") {
+				if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsArticleMember: v,
+						alias:                        alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeBranchForgeFed()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					alias:                alias,
-					forgefedBranchMember: v,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Audio") {
+				if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsAudioMember: v,
+						alias:                      alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsCollectionMember: v,
-					alias:                           alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Block") {
+				if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsBlockMember: v,
+						alias:                      alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsCollectionPageMember: v,
-					alias:                               alias,
+			}
+			if !hasType || isType("https://forgefed.peers.community/ns", "Branch") {
+				if v, err := mgr.DeserializeBranchForgeFed()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						alias:                alias,
+						forgefedBranchMember: v,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					alias:                alias,
-					forgefedCommitMember: v,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Collection") {
+				if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsCollectionMember: v,
+						alias:                           alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsCreateMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "CollectionPage") {
+				if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsCollectionPageMember: v,
+						alias:                               alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsDeleteMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("https://forgefed.peers.community/ns", "Commit") {
+				if v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						alias:                alias,
+						forgefedCommitMember: v,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsDislikeMember: v,
-					alias:                        alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Create") {
+				if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsCreateMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsDocumentMember: v,
-					alias:                         alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Delete") {
+				if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsDeleteMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeEmojiToot()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					alias:           alias,
-					tootEmojiMember: v,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Dislike") {
+				if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsDislikeMember: v,
+						alias:                        alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsEventMember: v,
-					alias:                      alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Document") {
+				if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsDocumentMember: v,
+						alias:                         alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsFlagMember: v,
-					alias:                     alias,
+			}
+			if !hasType || isType("http://joinmastodon.org/ns", "Emoji") {
+				if v, err := mgr.DeserializeEmojiToot()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						alias:           alias,
+						tootEmojiMember: v,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsFollowMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("http://litepub.social/ns", "EmojiReact") {
+				if v, err := mgr.DeserializeEmojiReactLitepub()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						alias:                   alias,
+						litepubEmojiReactMember: v,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsGroupMember: v,
-					alias:                      alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Event") {
+				if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsEventMember: v,
+						alias:                      alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeIdentityProofToot()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					alias:                   alias,
-					tootIdentityProofMember: v,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Flag") {
+				if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsFlagMember: v,
+						alias:                     alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsIgnoreMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Follow") {
+				if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsFollowMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsImageMember: v,
-					alias:                      alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Group") {
+				if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsGroupMember: v,
+						alias:                      alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsIntransitiveActivityMember: v,
-					alias: alias,
+			}
+			if !hasType || isType("http://joinmastodon.org/ns", "IdentityProof") {
+				if v, err := mgr.DeserializeIdentityProofToot()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						alias:                   alias,
+						tootIdentityProofMember: v,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsInviteMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Ignore") {
+				if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsIgnoreMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsJoinMember: v,
-					alias:                     alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Image") {
+				if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsImageMember: v,
+						alias:                      alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsLeaveMember: v,
-					alias:                      alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "IntransitiveActivity") {
+				if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsIntransitiveActivityMember: v,
+						alias: alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsLikeMember: v,
-					alias:                     alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Invite") {
+				if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsInviteMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsListenMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Join") {
+				if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsJoinMember: v,
+						alias:                     alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsMoveMember: v,
-					alias:                     alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Leave") {
+				if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsLeaveMember: v,
+						alias:                      alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsNoteMember: v,
-					alias:                     alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Like") {
+				if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsLikeMember: v,
+						alias:                     alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsOfferMember: v,
-					alias:                      alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Listen") {
+				if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsListenMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsOrderedCollectionMember: v,
-					alias:                                  alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Move") {
+				if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsMoveMember: v,
+						alias:                     alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsOrderedCollectionPageMember: v,
-					alias: alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Note") {
+				if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsNoteMember: v,
+						alias:                     alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsOrganizationMember: v,
-					alias:                             alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Offer") {
+				if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsOfferMember: v,
+						alias:                      alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsPageMember: v,
-					alias:                     alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "OrderedCollection") {
+				if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsOrderedCollectionMember: v,
+						alias:                                  alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsPersonMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "OrderedCollectionPage") {
+				if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsOrderedCollectionPageMember: v,
+						alias: alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsPlaceMember: v,
-					alias:                      alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Organization") {
+				if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsOrganizationMember: v,
+						alias:                             alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsProfileMember: v,
-					alias:                        alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Page") {
+				if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsPageMember: v,
+						alias:                     alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializePushForgeFed()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					alias:              alias,
-					forgefedPushMember: v,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Person") {
+				if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsPersonMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsQuestionMember: v,
-					alias:                         alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Place") {
+				if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsPlaceMember: v,
+						alias:                      alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsReadMember: v,
-					alias:                     alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Profile") {
+				if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsProfileMember: v,
+						alias:                        alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsRejectMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("http://schema.org", "PropertyValue") {
+				if v, err := mgr.DeserializePropertyValueSchema()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						alias:                     alias,
+						schemaPropertyValueMember: v,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsRelationshipMember: v,
-					alias:                             alias,
+			}
+			if !hasType || isType("https://forgefed.peers.community/ns", "Push") {
+				if v, err := mgr.DeserializePushForgeFed()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						alias:              alias,
+						forgefedPushMember: v,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsRemoveMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Question") {
+				if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsQuestionMember: v,
+						alias:                         alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					alias:                    alias,
-					forgefedRepositoryMember: v,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Read") {
+				if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsReadMember: v,
+						alias:                     alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsServiceMember: v,
-					alias:                        alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Reject") {
+				if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsRejectMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsTentativeAcceptMember: v,
-					alias:                                alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Relationship") {
+				if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsRelationshipMember: v,
+						alias:                             alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsTentativeRejectMember: v,
-					alias:                                alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Remove") {
+				if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsRemoveMember: v,
+						alias:                       alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					alias:                alias,
-					forgefedTicketMember: v,
+			}
+			if !hasType || isType("https://forgefed.peers.community/ns", "Repository") {
+				if v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						alias:                    alias,
+						forgefedRepositoryMember: v,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					alias:                          alias,
-					forgefedTicketDependencyMember: v,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Service") {
+				if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsServiceMember: v,
+						alias:                        alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsTombstoneMember: v,
-					alias:                          alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "TentativeAccept") {
+				if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsTentativeAcceptMember: v,
+						alias:                                alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsTravelMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "TentativeReject") {
+				if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsTentativeRejectMember: v,
+						alias:                                alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsUndoMember: v,
-					alias:                     alias,
+			}
+			if !hasType || isType("https://forgefed.peers.community/ns", "Ticket") {
+				if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						alias:                alias,
+						forgefedTicketMember: v,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsUpdateMember: v,
-					alias:                       alias,
+			}
+			if !hasType || isType("https://forgefed.peers.community/ns", "TicketDependency") {
+				if v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						alias:                          alias,
+						forgefedTicketDependencyMember: v,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsVideoMember: v,
-					alias:                      alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Tombstone") {
+				if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsTombstoneMember: v,
+						alias:                          alias,
+					}
+					return this, nil
 				}
-				return this, nil
-			} else if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
-				this := &ForgeFedTicketsTrackedByProperty{
-					activitystreamsViewMember: v,
-					alias:                     alias,
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Travel") {
+				if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsTravelMember: v,
+						alias:                       alias,
+					}
+					return this, nil
+				}
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Undo") {
+				if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsUndoMember: v,
+						alias:                     alias,
+					}
+					return this, nil
+				}
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Update") {
+				if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsUpdateMember: v,
+						alias:                       alias,
+					}
+					return this, nil
+				}
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "Video") {
+				if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsVideoMember: v,
+						alias:                      alias,
+					}
+					return this, nil
+				}
+			}
+			if !hasType || isType("https://www.w3.org/ns/activitystreams", "View") {
+				if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
+					this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+					*this = ForgeFedTicketsTrackedByProperty{
+						activitystreamsViewMember: v,
+						alias:                     alias,
+					}
+					return this, nil
 				}
-				return this, nil
 			}
 		}
-		this := &ForgeFedTicketsTrackedByProperty{
+		this := poolForgeFedTicketsTrackedByProperty.Get().(*ForgeFedTicketsTrackedByProperty)
+		*this = ForgeFedTicketsTrackedByProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -484,6 +769,945 @@ func NewForgeFedTicketsTrackedByProperty() *ForgeFedTicketsTrackedByProperty {
 	return &ForgeFedTicketsTrackedByProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ForgeFedTicketsTrackedByProperty) AddJSONLDContext(m map[string]string) {
+	m["https://forgefed.peers.community/ns"] = this.alias
+	if this.IsActivityStreamsObject() {
+		if a, ok := this.activitystreamsObjectMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsObjectMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAccept() {
+		if a, ok := this.activitystreamsAcceptMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAcceptMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsActivity() {
+		if a, ok := this.activitystreamsActivityMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsActivityMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAdd() {
+		if a, ok := this.activitystreamsAddMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAddMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAnnounce() {
+		if a, ok := this.activitystreamsAnnounceMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAnnounceMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsApplication() {
+		if a, ok := this.activitystreamsApplicationMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsApplicationMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsArrive() {
+		if a, ok := this.activitystreamsArriveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsArriveMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsArticle() {
+		if a, ok := this.activitystreamsArticleMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsArticleMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAudio() {
+		if a, ok := this.activitystreamsAudioMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAudioMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsBlock() {
+		if a, ok := this.activitystreamsBlockMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsBlockMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsForgeFedBranch() {
+		if a, ok := this.forgefedBranchMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedBranchMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsCollection() {
+		if a, ok := this.activitystreamsCollectionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsCollectionMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsCollectionPage() {
+		if a, ok := this.activitystreamsCollectionPageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsCollectionPageMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsForgeFedCommit() {
+		if a, ok := this.forgefedCommitMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedCommitMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsCreate() {
+		if a, ok := this.activitystreamsCreateMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsCreateMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsDelete() {
+		if a, ok := this.activitystreamsDeleteMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsDeleteMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsDislike() {
+		if a, ok := this.activitystreamsDislikeMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsDislikeMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsDocument() {
+		if a, ok := this.activitystreamsDocumentMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsDocumentMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsTootEmoji() {
+		if a, ok := this.tootEmojiMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.tootEmojiMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsLitepubEmojiReact() {
+		if a, ok := this.litepubEmojiReactMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.litepubEmojiReactMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsEvent() {
+		if a, ok := this.activitystreamsEventMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsEventMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsFlag() {
+		if a, ok := this.activitystreamsFlagMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsFlagMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsFollow() {
+		if a, ok := this.activitystreamsFollowMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsFollowMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsGroup() {
+		if a, ok := this.activitystreamsGroupMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsGroupMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsTootIdentityProof() {
+		if a, ok := this.tootIdentityProofMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.tootIdentityProofMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsIgnore() {
+		if a, ok := this.activitystreamsIgnoreMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsIgnoreMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsImage() {
+		if a, ok := this.activitystreamsImageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsImageMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		if a, ok := this.activitystreamsIntransitiveActivityMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsIntransitiveActivityMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsInvite() {
+		if a, ok := this.activitystreamsInviteMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsInviteMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsJoin() {
+		if a, ok := this.activitystreamsJoinMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsJoinMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsLeave() {
+		if a, ok := this.activitystreamsLeaveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsLeaveMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsLike() {
+		if a, ok := this.activitystreamsLikeMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsLikeMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsListen() {
+		if a, ok := this.activitystreamsListenMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsListenMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsMove() {
+		if a, ok := this.activitystreamsMoveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsMoveMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsNote() {
+		if a, ok := this.activitystreamsNoteMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsNoteMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOffer() {
+		if a, ok := this.activitystreamsOfferMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOfferMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOrderedCollection() {
+		if a, ok := this.activitystreamsOrderedCollectionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrderedCollectionMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		if a, ok := this.activitystreamsOrderedCollectionPageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrderedCollectionPageMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOrganization() {
+		if a, ok := this.activitystreamsOrganizationMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrganizationMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsPage() {
+		if a, ok := this.activitystreamsPageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsPageMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsPerson() {
+		if a, ok := this.activitystreamsPersonMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsPersonMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsPlace() {
+		if a, ok := this.activitystreamsPlaceMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsPlaceMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsProfile() {
+		if a, ok := this.activitystreamsProfileMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsProfileMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsSchemaPropertyValue() {
+		if a, ok := this.schemaPropertyValueMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.schemaPropertyValueMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsForgeFedPush() {
+		if a, ok := this.forgefedPushMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedPushMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsQuestion() {
+		if a, ok := this.activitystreamsQuestionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsQuestionMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsRead() {
+		if a, ok := this.activitystreamsReadMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsReadMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsReject() {
+		if a, ok := this.activitystreamsRejectMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsRejectMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsRelationship() {
+		if a, ok := this.activitystreamsRelationshipMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsRelationshipMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsRemove() {
+		if a, ok := this.activitystreamsRemoveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsRemoveMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsForgeFedRepository() {
+		if a, ok := this.forgefedRepositoryMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedRepositoryMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsService() {
+		if a, ok := this.activitystreamsServiceMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsServiceMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsTentativeAccept() {
+		if a, ok := this.activitystreamsTentativeAcceptMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTentativeAcceptMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsTentativeReject() {
+		if a, ok := this.activitystreamsTentativeRejectMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTentativeRejectMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsForgeFedTicket() {
+		if a, ok := this.forgefedTicketMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedTicketMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsForgeFedTicketDependency() {
+		if a, ok := this.forgefedTicketDependencyMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedTicketDependencyMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsTombstone() {
+		if a, ok := this.activitystreamsTombstoneMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTombstoneMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsTravel() {
+		if a, ok := this.activitystreamsTravelMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTravelMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsUndo() {
+		if a, ok := this.activitystreamsUndoMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsUndoMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsUpdate() {
+		if a, ok := this.activitystreamsUpdateMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsUpdateMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsVideo() {
+		if a, ok := this.activitystreamsVideoMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsVideoMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsView() {
+		if a, ok := this.activitystreamsViewMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsViewMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	}
+}
+
 // Clear ensures no value of this property is set. Calling HasAny or any of the
 // 'Is' methods afterwards will return false.
 func (this *ForgeFedTicketsTrackedByProperty) Clear() {
@@ -506,6 +1730,7 @@ func (this *ForgeFedTicketsTrackedByProperty) Clear() {
 	this.activitystreamsDislikeMember = nil
 	this.activitystreamsDocumentMember = nil
 	this.tootEmojiMember = nil
+	this.litepubEmojiReactMember = nil
 	this.activitystreamsEventMember = nil
 	this.activitystreamsFlagMember = nil
 	this.activitystreamsFollowMember = nil
@@ -529,6 +1754,7 @@ func (this *ForgeFedTicketsTrackedByProperty) Clear() {
 	this.activitystreamsPersonMember = nil
 	this.activitystreamsPlaceMember = nil
 	this.activitystreamsProfileMember = nil
+	this.schemaPropertyValueMember = nil
 	this.forgefedPushMember = nil
 	this.activitystreamsQuestionMember = nil
 	this.activitystreamsReadMember = nil
@@ -551,6 +1777,148 @@ func (this *ForgeFedTicketsTrackedByProperty) Clear() {
 	this.iri = nil
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ForgeFedTicketsTrackedByProperty) Compare(o vocab.ForgeFedTicketsTrackedByProperty) int {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return -1
+	} else if idx1 > idx2 {
+		return 1
+	} else if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject().Compare(o.GetActivityStreamsObject())
+	} else if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept().Compare(o.GetActivityStreamsAccept())
+	} else if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity().Compare(o.GetActivityStreamsActivity())
+	} else if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd().Compare(o.GetActivityStreamsAdd())
+	} else if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce().Compare(o.GetActivityStreamsAnnounce())
+	} else if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().Compare(o.GetActivityStreamsApplication())
+	} else if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive().Compare(o.GetActivityStreamsArrive())
+	} else if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle().Compare(o.GetActivityStreamsArticle())
+	} else if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio().Compare(o.GetActivityStreamsAudio())
+	} else if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock().Compare(o.GetActivityStreamsBlock())
+	} else if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch().Compare(o.GetForgeFedBranch())
+	} else if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection().Compare(o.GetActivityStreamsCollection())
+	} else if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage().Compare(o.GetActivityStreamsCollectionPage())
+	} else if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().Compare(o.GetForgeFedCommit())
+	} else if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate().Compare(o.GetActivityStreamsCreate())
+	} else if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete().Compare(o.GetActivityStreamsDelete())
+	} else if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike().Compare(o.GetActivityStreamsDislike())
+	} else if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument().Compare(o.GetActivityStreamsDocument())
+	} else if this.IsTootEmoji() {
+		return this.GetTootEmoji().Compare(o.GetTootEmoji())
+	} else if this.IsLitepubEmojiReact() {
+		return this.GetLitepubEmojiReact().Compare(o.GetLitepubEmojiReact())
+	} else if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent().Compare(o.GetActivityStreamsEvent())
+	} else if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag().Compare(o.GetActivityStreamsFlag())
+	} else if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow().Compare(o.GetActivityStreamsFollow())
+	} else if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().Compare(o.GetActivityStreamsGroup())
+	} else if this.IsTootIdentityProof() {
+		return this.GetTootIdentityProof().Compare(o.GetTootIdentityProof())
+	} else if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore().Compare(o.GetActivityStreamsIgnore())
+	} else if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage().Compare(o.GetActivityStreamsImage())
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity().Compare(o.GetActivityStreamsIntransitiveActivity())
+	} else if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite().Compare(o.GetActivityStreamsInvite())
+	} else if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin().Compare(o.GetActivityStreamsJoin())
+	} else if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave().Compare(o.GetActivityStreamsLeave())
+	} else if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike().Compare(o.GetActivityStreamsLike())
+	} else if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen().Compare(o.GetActivityStreamsListen())
+	} else if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove().Compare(o.GetActivityStreamsMove())
+	} else if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote().Compare(o.GetActivityStreamsNote())
+	} else if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer().Compare(o.GetActivityStreamsOffer())
+	} else if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection().Compare(o.GetActivityStreamsOrderedCollection())
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage().Compare(o.GetActivityStreamsOrderedCollectionPage())
+	} else if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().Compare(o.GetActivityStreamsOrganization())
+	} else if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage().Compare(o.GetActivityStreamsPage())
+	} else if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().Compare(o.GetActivityStreamsPerson())
+	} else if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace().Compare(o.GetActivityStreamsPlace())
+	} else if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile().Compare(o.GetActivityStreamsProfile())
+	} else if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue().Compare(o.GetSchemaPropertyValue())
+	} else if this.IsForgeFedPush() {
+		return this.GetForgeFedPush().Compare(o.GetForgeFedPush())
+	} else if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion().Compare(o.GetActivityStreamsQuestion())
+	} else if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead().Compare(o.GetActivityStreamsRead())
+	} else if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject().Compare(o.GetActivityStreamsReject())
+	} else if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship().Compare(o.GetActivityStreamsRelationship())
+	} else if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove().Compare(o.GetActivityStreamsRemove())
+	} else if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().Compare(o.GetForgeFedRepository())
+	} else if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().Compare(o.GetActivityStreamsService())
+	} else if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept().Compare(o.GetActivityStreamsTentativeAccept())
+	} else if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject().Compare(o.GetActivityStreamsTentativeReject())
+	} else if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().Compare(o.GetForgeFedTicket())
+	} else if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().Compare(o.GetForgeFedTicketDependency())
+	} else if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone().Compare(o.GetActivityStreamsTombstone())
+	} else if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel().Compare(o.GetActivityStreamsTravel())
+	} else if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo().Compare(o.GetActivityStreamsUndo())
+	} else if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate().Compare(o.GetActivityStreamsUpdate())
+	} else if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo().Compare(o.GetActivityStreamsVideo())
+	} else if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView().Compare(o.GetActivityStreamsView())
+	} else if this.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	}
+	return 0
+}
+
 // GetActivityStreamsAccept returns the value of this property. When
 // IsActivityStreamsAccept returns false, GetActivityStreamsAccept will return
 // an arbitrary value.
@@ -959,6 +2327,20 @@ func (this ForgeFedTicketsTrackedByProperty) GetIRI() *url.URL {
 	return this.iri
 }
 
+// GetLitepubEmojiReact returns the value of this property. When
+// IsLitepubEmojiReact returns false, GetLitepubEmojiReact will return an
+// arbitrary value.
+func (this ForgeFedTicketsTrackedByProperty) GetLitepubEmojiReact() vocab.LitepubEmojiReact {
+	return this.litepubEmojiReactMember
+}
+
+// GetSchemaPropertyValue returns the value of this property. When
+// IsSchemaPropertyValue returns false, GetSchemaPropertyValue will return an
+// arbitrary value.
+func (this ForgeFedTicketsTrackedByProperty) GetSchemaPropertyValue() vocab.SchemaPropertyValue {
+	return this.schemaPropertyValueMember
+}
+
 // GetTootEmoji returns the value of this property. When IsTootEmoji returns
 // false, GetTootEmoji will return an arbitrary value.
 func (this ForgeFedTicketsTrackedByProperty) GetTootEmoji() vocab.TootEmoji {
@@ -1032,6 +2414,9 @@ func (this ForgeFedTicketsTrackedByProperty) GetType() vocab.Type {
 	if this.IsTootEmoji() {
 		return this.GetTootEmoji()
 	}
+	if this.IsLitepubEmojiReact() {
+		return this.GetLitepubEmojiReact()
+	}
 	if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent()
 	}
@@ -1101,6 +2486,9 @@ func (this ForgeFedTicketsTrackedByProperty) GetType() vocab.Type {
 	if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile()
 	}
+	if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue()
+	}
 	if this.IsForgeFedPush() {
 		return this.GetForgeFedPush()
 	}
@@ -1180,6 +2568,7 @@ func (this ForgeFedTicketsTrackedByProperty) HasAny() bool {
 		this.IsActivityStreamsDislike() ||
 		this.IsActivityStreamsDocument() ||
 		this.IsTootEmoji() ||
+		this.IsLitepubEmojiReact() ||
 		this.IsActivityStreamsEvent() ||
 		this.IsActivityStreamsFlag() ||
 		this.IsActivityStreamsFollow() ||
@@ -1203,6 +2592,7 @@ func (this ForgeFedTicketsTrackedByProperty) HasAny() bool {
 		this.IsActivityStreamsPerson() ||
 		this.IsActivityStreamsPlace() ||
 		this.IsActivityStreamsProfile() ||
+		this.IsSchemaPropertyValue() ||
 		this.IsForgeFedPush() ||
 		this.IsActivityStreamsQuestion() ||
 		this.IsActivityStreamsRead() ||
@@ -1641,6 +3031,20 @@ func (this ForgeFedTicketsTrackedByProperty) IsIRI() bool {
 	return this.iri != nil
 }
 
+// IsLitepubEmojiReact returns true if this property has a type of "EmojiReact".
+// When true, use the GetLitepubEmojiReact and SetLitepubEmojiReact methods to
+// access and set this property.
+func (this ForgeFedTicketsTrackedByProperty) IsLitepubEmojiReact() bool {
+	return this.litepubEmojiReactMember != nil
+}
+
+// IsSchemaPropertyValue returns true if this property has a type of
+// "PropertyValue". When true, use the GetSchemaPropertyValue and
+// SetSchemaPropertyValue methods to access and set this property.
+func (this ForgeFedTicketsTrackedByProperty) IsSchemaPropertyValue() bool {
+	return this.schemaPropertyValueMember != nil
+}
+
 // IsTootEmoji returns true if this property has a type of "Emoji". When true, use
 // the GetTootEmoji and SetTootEmoji methods to access and set this property.
 func (this ForgeFedTicketsTrackedByProperty) IsTootEmoji() bool {
@@ -1658,137 +3062,8 @@ func (this ForgeFedTicketsTrackedByProperty) IsTootIdentityProof() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ForgeFedTicketsTrackedByProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://forgefed.peers.community/ns": this.alias}
-	var child map[string]string
-	if this.IsActivityStreamsObject() {
-		child = this.GetActivityStreamsObject().JSONLDContext()
-	} else if this.IsActivityStreamsAccept() {
-		child = this.GetActivityStreamsAccept().JSONLDContext()
-	} else if this.IsActivityStreamsActivity() {
-		child = this.GetActivityStreamsActivity().JSONLDContext()
-	} else if this.IsActivityStreamsAdd() {
-		child = this.GetActivityStreamsAdd().JSONLDContext()
-	} else if this.IsActivityStreamsAnnounce() {
-		child = this.GetActivityStreamsAnnounce().JSONLDContext()
-	} else if this.IsActivityStreamsApplication() {
-		child = this.GetActivityStreamsApplication().JSONLDContext()
-	} else if this.IsActivityStreamsArrive() {
-		child = this.GetActivityStreamsArrive().JSONLDContext()
-	} else if this.IsActivityStreamsArticle() {
-		child = this.GetActivityStreamsArticle().JSONLDContext()
-	} else if this.IsActivityStreamsAudio() {
-		child = this.GetActivityStreamsAudio().JSONLDContext()
-	} else if this.IsActivityStreamsBlock() {
-		child = this.GetActivityStreamsBlock().JSONLDContext()
-	} else if this.IsForgeFedBranch() {
-		child = this.GetForgeFedBranch().JSONLDContext()
-	} else if this.IsActivityStreamsCollection() {
-		child = this.GetActivityStreamsCollection().JSONLDContext()
-	} else if this.IsActivityStreamsCollectionPage() {
-		child = this.GetActivityStreamsCollectionPage().JSONLDContext()
-	} else if this.IsForgeFedCommit() {
-		child = this.GetForgeFedCommit().JSONLDContext()
-	} else if this.IsActivityStreamsCreate() {
-		child = this.GetActivityStreamsCreate().JSONLDContext()
-	} else if this.IsActivityStreamsDelete() {
-		child = this.GetActivityStreamsDelete().JSONLDContext()
-	} else if this.IsActivityStreamsDislike() {
-		child = this.GetActivityStreamsDislike().JSONLDContext()
-	} else if this.IsActivityStreamsDocument() {
-		child = this.GetActivityStreamsDocument().JSONLDContext()
-	} else if this.IsTootEmoji() {
-		child = this.GetTootEmoji().JSONLDContext()
-	} else if this.IsActivityStreamsEvent() {
-		child = this.GetActivityStreamsEvent().JSONLDContext()
-	} else if this.IsActivityStreamsFlag() {
-		child = this.GetActivityStreamsFlag().JSONLDContext()
-	} else if this.IsActivityStreamsFollow() {
-		child = this.GetActivityStreamsFollow().JSONLDContext()
-	} else if this.IsActivityStreamsGroup() {
-		child = this.GetActivityStreamsGroup().JSONLDContext()
-	} else if this.IsTootIdentityProof() {
-		child = this.GetTootIdentityProof().JSONLDContext()
-	} else if this.IsActivityStreamsIgnore() {
-		child = this.GetActivityStreamsIgnore().JSONLDContext()
-	} else if this.IsActivityStreamsImage() {
-		child = this.GetActivityStreamsImage().JSONLDContext()
-	} else if this.IsActivityStreamsIntransitiveActivity() {
-		child = this.GetActivityStreamsIntransitiveActivity().JSONLDContext()
-	} else if this.IsActivityStreamsInvite() {
-		child = this.GetActivityStreamsInvite().JSONLDContext()
-	} else if this.IsActivityStreamsJoin() {
-		child = this.GetActivityStreamsJoin().JSONLDContext()
-	} else if this.IsActivityStreamsLeave() {
-		child = this.GetActivityStreamsLeave().JSONLDContext()
-	} else if this.IsActivityStreamsLike() {
-		child = this.GetActivityStreamsLike().JSONLDContext()
-	} else if this.IsActivityStreamsListen() {
-		child = this.GetActivityStreamsListen().JSONLDContext()
-	} else if this.IsActivityStreamsMove() {
-		child = this.GetActivityStreamsMove().JSONLDContext()
-	} else if this.IsActivityStreamsNote() {
-		child = this.GetActivityStreamsNote().JSONLDContext()
-	} else if this.IsActivityStreamsOffer() {
-		child = this.GetActivityStreamsOffer().JSONLDContext()
-	} else if this.IsActivityStreamsOrderedCollection() {
-		child = this.GetActivityStreamsOrderedCollection().JSONLDContext()
-	} else if this.IsActivityStreamsOrderedCollectionPage() {
-		child = this.GetActivityStreamsOrderedCollectionPage().JSONLDContext()
-	} else if this.IsActivityStreamsOrganization() {
-		child = this.GetActivityStreamsOrganization().JSONLDContext()
-	} else if this.IsActivityStreamsPage() {
-		child = this.GetActivityStreamsPage().JSONLDContext()
-	} else if this.IsActivityStreamsPerson() {
-		child = this.GetActivityStreamsPerson().JSONLDContext()
-	} else if this.IsActivityStreamsPlace() {
-		child = this.GetActivityStreamsPlace().JSONLDContext()
-	} else if this.IsActivityStreamsProfile() {
-		child = this.GetActivityStreamsProfile().JSONLDContext()
-	} else if this.IsForgeFedPush() {
-		child = this.GetForgeFedPush().JSONLDContext()
-	} else if this.IsActivityStreamsQuestion() {
-		child = this.GetActivityStreamsQuestion().JSONLDContext()
-	} else if this.IsActivityStreamsRead() {
-		child = this.GetActivityStreamsRead().JSONLDContext()
-	} else if this.IsActivityStreamsReject() {
-		child = this.GetActivityStreamsReject().JSONLDContext()
-	} else if this.IsActivityStreamsRelationship() {
-		child = this.GetActivityStreamsRelationship().JSONLDContext()
-	} else if this.IsActivityStreamsRemove() {
-		child = this.GetActivityStreamsRemove().JSONLDContext()
-	} else if this.IsForgeFedRepository() {
-		child = this.GetForgeFedRepository().JSONLDContext()
-	} else if this.IsActivityStreamsService() {
-		child = this.GetActivityStreamsService().JSONLDContext()
-	} else if this.IsActivityStreamsTentativeAccept() {
-		child = this.GetActivityStreamsTentativeAccept().JSONLDContext()
-	} else if this.IsActivityStreamsTentativeReject() {
-		child = this.GetActivityStreamsTentativeReject().JSONLDContext()
-	} else if this.IsForgeFedTicket() {
-		child = this.GetForgeFedTicket().JSONLDContext()
-	} else if this.IsForgeFedTicketDependency() {
-		child = this.GetForgeFedTicketDependency().JSONLDContext()
-	} else if this.IsActivityStreamsTombstone() {
-		child = this.GetActivityStreamsTombstone().JSONLDContext()
-	} else if this.IsActivityStreamsTravel() {
-		child = this.GetActivityStreamsTravel().JSONLDContext()
-	} else if this.IsActivityStreamsUndo() {
-		child = this.GetActivityStreamsUndo().JSONLDContext()
-	} else if this.IsActivityStreamsUpdate() {
-		child = this.GetActivityStreamsUpdate().JSONLDContext()
-	} else if this.IsActivityStreamsVideo() {
-		child = this.GetActivityStreamsVideo().JSONLDContext()
-	} else if this.IsActivityStreamsView() {
-		child = this.GetActivityStreamsView().JSONLDContext()
-	}
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -1853,129 +3128,135 @@ func (this ForgeFedTicketsTrackedByProperty) KindIndex() int {
 	if this.IsTootEmoji() {
 		return 18
 	}
-	if this.IsActivityStreamsEvent() {
+	if this.IsLitepubEmojiReact() {
 		return 19
 	}
-	if this.IsActivityStreamsFlag() {
+	if this.IsActivityStreamsEvent() {
 		return 20
 	}
-	if this.IsActivityStreamsFollow() {
+	if this.IsActivityStreamsFlag() {
 		return 21
 	}
-	if this.IsActivityStreamsGroup() {
+	if this.IsActivityStreamsFollow() {
 		return 22
 	}
-	if this.IsTootIdentityProof() {
+	if this.IsActivityStreamsGroup() {
 		return 23
 	}
-	if this.IsActivityStreamsIgnore() {
+	if this.IsTootIdentityProof() {
 		return 24
 	}
-	if this.IsActivityStreamsImage() {
+	if this.IsActivityStreamsIgnore() {
 		return 25
 	}
-	if this.IsActivityStreamsIntransitiveActivity() {
+	if this.IsActivityStreamsImage() {
 		return 26
 	}
-	if this.IsActivityStreamsInvite() {
+	if this.IsActivityStreamsIntransitiveActivity() {
 		return 27
 	}
-	if this.IsActivityStreamsJoin() {
+	if this.IsActivityStreamsInvite() {
 		return 28
 	}
-	if this.IsActivityStreamsLeave() {
+	if this.IsActivityStreamsJoin() {
 		return 29
 	}
-	if this.IsActivityStreamsLike() {
+	if this.IsActivityStreamsLeave() {
 		return 30
 	}
-	if this.IsActivityStreamsListen() {
+	if this.IsActivityStreamsLike() {
 		return 31
 	}
-	if this.IsActivityStreamsMove() {
+	if this.IsActivityStreamsListen() {
 		return 32
 	}
-	if this.IsActivityStreamsNote() {
+	if this.IsActivityStreamsMove() {
 		return 33
 	}
-	if this.IsActivityStreamsOffer() {
+	if this.IsActivityStreamsNote() {
 		return 34
 	}
-	if this.IsActivityStreamsOrderedCollection() {
+	if this.IsActivityStreamsOffer() {
 		return 35
 	}
-	if this.IsActivityStreamsOrderedCollectionPage() {
+	if this.IsActivityStreamsOrderedCollection() {
 		return 36
 	}
-	if this.IsActivityStreamsOrganization() {
+	if this.IsActivityStreamsOrderedCollectionPage() {
 		return 37
 	}
-	if this.IsActivityStreamsPage() {
+	if this.IsActivityStreamsOrganization() {
 		return 38
 	}
-	if this.IsActivityStreamsPerson() {
+	if this.IsActivityStreamsPage() {
 		return 39
 	}
-	if this.IsActivityStreamsPlace() {
+	if this.IsActivityStreamsPerson() {
 		return 40
 	}
-	if this.IsActivityStreamsProfile() {
+	if this.IsActivityStreamsPlace() {
 		return 41
 	}
-	if this.IsForgeFedPush() {
+	if this.IsActivityStreamsProfile() {
 		return 42
 	}
-	if this.IsActivityStreamsQuestion() {
+	if this.IsSchemaPropertyValue() {
 		return 43
 	}
-	if this.IsActivityStreamsRead() {
+	if this.IsForgeFedPush() {
 		return 44
 	}
-	if this.IsActivityStreamsReject() {
+	if this.IsActivityStreamsQuestion() {
 		return 45
 	}
-	if this.IsActivityStreamsRelationship() {
+	if this.IsActivityStreamsRead() {
 		return 46
 	}
-	if this.IsActivityStreamsRemove() {
+	if this.IsActivityStreamsReject() {
 		return 47
 	}
-	if this.IsForgeFedRepository() {
+	if this.IsActivityStreamsRelationship() {
 		return 48
 	}
-	if this.IsActivityStreamsService() {
+	if this.IsActivityStreamsRemove() {
 		return 49
 	}
-	if this.IsActivityStreamsTentativeAccept() {
+	if this.IsForgeFedRepository() {
 		return 50
 	}
-	if this.IsActivityStreamsTentativeReject() {
+	if this.IsActivityStreamsService() {
 		return 51
 	}
-	if this.IsForgeFedTicket() {
+	if this.IsActivityStreamsTentativeAccept() {
 		return 52
 	}
-	if this.IsForgeFedTicketDependency() {
+	if this.IsActivityStreamsTentativeReject() {
 		return 53
 	}
-	if this.IsActivityStreamsTombstone() {
+	if this.IsForgeFedTicket() {
 		return 54
 	}
-	if this.IsActivityStreamsTravel() {
+	if this.IsForgeFedTicketDependency() {
 		return 55
 	}
-	if this.IsActivityStreamsUndo() {
+	if this.IsActivityStreamsTombstone() {
 		return 56
 	}
-	if this.IsActivityStreamsUpdate() {
+	if this.IsActivityStreamsTravel() {
 		return 57
 	}
-	if this.IsActivityStreamsVideo() {
+	if this.IsActivityStreamsUndo() {
 		return 58
 	}
-	if this.IsActivityStreamsView() {
+	if this.IsActivityStreamsUpdate() {
 		return 59
 	}
+	if this.IsActivityStreamsVideo() {
+		return 60
+	}
+	if this.IsActivityStreamsView() {
+		return 61
+	}
 	if this.IsIRI() {
 		return -2
 	}
@@ -1987,136 +3268,7 @@ func (this ForgeFedTicketsTrackedByProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ForgeFedTicketsTrackedByProperty) LessThan(o vocab.ForgeFedTicketsTrackedByProperty) bool {
-	idx1 := this.KindIndex()
-	idx2 := o.KindIndex()
-	if idx1 < idx2 {
-		return true
-	} else if idx1 > idx2 {
-		return false
-	} else if this.IsActivityStreamsObject() {
-		return this.GetActivityStreamsObject().LessThan(o.GetActivityStreamsObject())
-	} else if this.IsActivityStreamsAccept() {
-		return this.GetActivityStreamsAccept().LessThan(o.GetActivityStreamsAccept())
-	} else if this.IsActivityStreamsActivity() {
-		return this.GetActivityStreamsActivity().LessThan(o.GetActivityStreamsActivity())
-	} else if this.IsActivityStreamsAdd() {
-		return this.GetActivityStreamsAdd().LessThan(o.GetActivityStreamsAdd())
-	} else if this.IsActivityStreamsAnnounce() {
-		return this.GetActivityStreamsAnnounce().LessThan(o.GetActivityStreamsAnnounce())
-	} else if this.IsActivityStreamsApplication() {
-		return this.GetActivityStreamsApplication().LessThan(o.GetActivityStreamsApplication())
-	} else if this.IsActivityStreamsArrive() {
-		return this.GetActivityStreamsArrive().LessThan(o.GetActivityStreamsArrive())
-	} else if this.IsActivityStreamsArticle() {
-		return this.GetActivityStreamsArticle().LessThan(o.GetActivityStreamsArticle())
-	} else if this.IsActivityStreamsAudio() {
-		return this.GetActivityStreamsAudio().LessThan(o.GetActivityStreamsAudio())
-	} else if this.IsActivityStreamsBlock() {
-		return this.GetActivityStreamsBlock().LessThan(o.GetActivityStreamsBlock())
-	} else if this.IsForgeFedBranch() {
-		return this.GetForgeFedBranch().LessThan(o.GetForgeFedBranch())
-	} else if this.IsActivityStreamsCollection() {
-		return this.GetActivityStreamsCollection().LessThan(o.GetActivityStreamsCollection())
-	} else if this.IsActivityStreamsCollectionPage() {
-		return this.GetActivityStreamsCollectionPage().LessThan(o.GetActivityStreamsCollectionPage())
-	} else if this.IsForgeFedCommit() {
-		return this.GetForgeFedCommit().LessThan(o.GetForgeFedCommit())
-	} else if this.IsActivityStreamsCreate() {
-		return this.GetActivityStreamsCreate().LessThan(o.GetActivityStreamsCreate())
-	} else if this.IsActivityStreamsDelete() {
-		return this.GetActivityStreamsDelete().LessThan(o.GetActivityStreamsDelete())
-	} else if this.IsActivityStreamsDislike() {
-		return this.GetActivityStreamsDislike().LessThan(o.GetActivityStreamsDislike())
-	} else if this.IsActivityStreamsDocument() {
-		return this.GetActivityStreamsDocument().LessThan(o.GetActivityStreamsDocument())
-	} else if this.IsTootEmoji() {
-		return this.GetTootEmoji().LessThan(o.GetTootEmoji())
-	} else if this.IsActivityStreamsEvent() {
-		return this.GetActivityStreamsEvent().LessThan(o.GetActivityStreamsEvent())
-	} else if this.IsActivityStreamsFlag() {
-		return this.GetActivityStreamsFlag().LessThan(o.GetActivityStreamsFlag())
-	} else if this.IsActivityStreamsFollow() {
-		return this.GetActivityStreamsFollow().LessThan(o.GetActivityStreamsFollow())
-	} else if this.IsActivityStreamsGroup() {
-		return this.GetActivityStreamsGroup().LessThan(o.GetActivityStreamsGroup())
-	} else if this.IsTootIdentityProof() {
-		return this.GetTootIdentityProof().LessThan(o.GetTootIdentityProof())
-	} else if this.IsActivityStreamsIgnore() {
-		return this.GetActivityStreamsIgnore().LessThan(o.GetActivityStreamsIgnore())
-	} else if this.IsActivityStreamsImage() {
-		return this.GetActivityStreamsImage().LessThan(o.GetActivityStreamsImage())
-	} else if this.IsActivityStreamsIntransitiveActivity() {
-		return this.GetActivityStreamsIntransitiveActivity().LessThan(o.GetActivityStreamsIntransitiveActivity())
-	} else if this.IsActivityStreamsInvite() {
-		return this.GetActivityStreamsInvite().LessThan(o.GetActivityStreamsInvite())
-	} else if this.IsActivityStreamsJoin() {
-		return this.GetActivityStreamsJoin().LessThan(o.GetActivityStreamsJoin())
-	} else if this.IsActivityStreamsLeave() {
-		return this.GetActivityStreamsLeave().LessThan(o.GetActivityStreamsLeave())
-	} else if this.IsActivityStreamsLike() {
-		return this.GetActivityStreamsLike().LessThan(o.GetActivityStreamsLike())
-	} else if this.IsActivityStreamsListen() {
-		return this.GetActivityStreamsListen().LessThan(o.GetActivityStreamsListen())
-	} else if this.IsActivityStreamsMove() {
-		return this.GetActivityStreamsMove().LessThan(o.GetActivityStreamsMove())
-	} else if this.IsActivityStreamsNote() {
-		return this.GetActivityStreamsNote().LessThan(o.GetActivityStreamsNote())
-	} else if this.IsActivityStreamsOffer() {
-		return this.GetActivityStreamsOffer().LessThan(o.GetActivityStreamsOffer())
-	} else if this.IsActivityStreamsOrderedCollection() {
-		return this.GetActivityStreamsOrderedCollection().LessThan(o.GetActivityStreamsOrderedCollection())
-	} else if this.IsActivityStreamsOrderedCollectionPage() {
-		return this.GetActivityStreamsOrderedCollectionPage().LessThan(o.GetActivityStreamsOrderedCollectionPage())
-	} else if this.IsActivityStreamsOrganization() {
-		return this.GetActivityStreamsOrganization().LessThan(o.GetActivityStreamsOrganization())
-	} else if this.IsActivityStreamsPage() {
-		return this.GetActivityStreamsPage().LessThan(o.GetActivityStreamsPage())
-	} else if this.IsActivityStreamsPerson() {
-		return this.GetActivityStreamsPerson().LessThan(o.GetActivityStreamsPerson())
-	} else if this.IsActivityStreamsPlace() {
-		return this.GetActivityStreamsPlace().LessThan(o.GetActivityStreamsPlace())
-	} else if this.IsActivityStreamsProfile() {
-		return this.GetActivityStreamsProfile().LessThan(o.GetActivityStreamsProfile())
-	} else if this.IsForgeFedPush() {
-		return this.GetForgeFedPush().LessThan(o.GetForgeFedPush())
-	} else if this.IsActivityStreamsQuestion() {
-		return this.GetActivityStreamsQuestion().LessThan(o.GetActivityStreamsQuestion())
-	} else if this.IsActivityStreamsRead() {
-		return this.GetActivityStreamsRead().LessThan(o.GetActivityStreamsRead())
-	} else if this.IsActivityStreamsReject() {
-		return this.GetActivityStreamsReject().LessThan(o.GetActivityStreamsReject())
-	} else if this.IsActivityStreamsRelationship() {
-		return this.GetActivityStreamsRelationship().LessThan(o.GetActivityStreamsRelationship())
-	} else if this.IsActivityStreamsRemove() {
-		return this.GetActivityStreamsRemove().LessThan(o.GetActivityStreamsRemove())
-	} else if this.IsForgeFedRepository() {
-		return this.GetForgeFedRepository().LessThan(o.GetForgeFedRepository())
-	} else if this.IsActivityStreamsService() {
-		return this.GetActivityStreamsService().LessThan(o.GetActivityStreamsService())
-	} else if this.IsActivityStreamsTentativeAccept() {
-		return this.GetActivityStreamsTentativeAccept().LessThan(o.GetActivityStreamsTentativeAccept())
-	} else if this.IsActivityStreamsTentativeReject() {
-		return this.GetActivityStreamsTentativeReject().LessThan(o.GetActivityStreamsTentativeReject())
-	} else if this.IsForgeFedTicket() {
-		return this.GetForgeFedTicket().LessThan(o.GetForgeFedTicket())
-	} else if this.IsForgeFedTicketDependency() {
-		return this.GetForgeFedTicketDependency().LessThan(o.GetForgeFedTicketDependency())
-	} else if this.IsActivityStreamsTombstone() {
-		return this.GetActivityStreamsTombstone().LessThan(o.GetActivityStreamsTombstone())
-	} else if this.IsActivityStreamsTravel() {
-		return this.GetActivityStreamsTravel().LessThan(o.GetActivityStreamsTravel())
-	} else if this.IsActivityStreamsUndo() {
-		return this.GetActivityStreamsUndo().LessThan(o.GetActivityStreamsUndo())
-	} else if this.IsActivityStreamsUpdate() {
-		return this.GetActivityStreamsUpdate().LessThan(o.GetActivityStreamsUpdate())
-	} else if this.IsActivityStreamsVideo() {
-		return this.GetActivityStreamsVideo().LessThan(o.GetActivityStreamsVideo())
-	} else if this.IsActivityStreamsView() {
-		return this.GetActivityStreamsView().LessThan(o.GetActivityStreamsView())
-	} else if this.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	}
-	return false
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "ticketsTrackedBy".
@@ -2128,6 +3280,325 @@ func (this ForgeFedTicketsTrackedByProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ForgeFedTicketsTrackedByProperty) Release() {
+	if r, ok := this.activitystreamsObjectMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAcceptMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsActivityMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAddMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAnnounceMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsApplicationMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsArriveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsArticleMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAudioMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsBlockMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedBranchMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsCollectionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsCollectionPageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedCommitMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsCreateMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsDeleteMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsDislikeMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsDocumentMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.tootEmojiMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.litepubEmojiReactMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsEventMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsFlagMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsFollowMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsGroupMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.tootIdentityProofMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsIgnoreMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsImageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsIntransitiveActivityMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsInviteMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsJoinMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsLeaveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsLikeMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsListenMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsMoveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsNoteMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOfferMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOrderedCollectionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOrderedCollectionPageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOrganizationMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsPageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsPersonMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsPlaceMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsProfileMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.schemaPropertyValueMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedPushMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsQuestionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsReadMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsRejectMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsRelationshipMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsRemoveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedRepositoryMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsServiceMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTentativeAcceptMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTentativeRejectMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedTicketMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedTicketDependencyMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTombstoneMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTravelMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsUndoMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsUpdateMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsVideoMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsViewMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	*this = ForgeFedTicketsTrackedByProperty{}
+	poolForgeFedTicketsTrackedByProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -2171,6 +3642,8 @@ func (this ForgeFedTicketsTrackedByProperty) Serialize() (interface{}, error) {
 		return this.GetActivityStreamsDocument().Serialize()
 	} else if this.IsTootEmoji() {
 		return this.GetTootEmoji().Serialize()
+	} else if this.IsLitepubEmojiReact() {
+		return this.GetLitepubEmojiReact().Serialize()
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().Serialize()
 	} else if this.IsActivityStreamsFlag() {
@@ -2217,6 +3690,8 @@ func (this ForgeFedTicketsTrackedByProperty) Serialize() (interface{}, error) {
 		return this.GetActivityStreamsPlace().Serialize()
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().Serialize()
+	} else if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue().Serialize()
 	} else if this.IsForgeFedPush() {
 		return this.GetForgeFedPush().Serialize()
 	} else if this.IsActivityStreamsQuestion() {
@@ -2671,6 +4146,20 @@ func (this *ForgeFedTicketsTrackedByProperty) SetIRI(v *url.URL) {
 	this.iri = v
 }
 
+// SetLitepubEmojiReact sets the value of this property. Calling
+// IsLitepubEmojiReact afterwards returns true.
+func (this *ForgeFedTicketsTrackedByProperty) SetLitepubEmojiReact(v vocab.LitepubEmojiReact) {
+	this.Clear()
+	this.litepubEmojiReactMember = v
+}
+
+// SetSchemaPropertyValue sets the value of this property. Calling
+// IsSchemaPropertyValue afterwards returns true.
+func (this *ForgeFedTicketsTrackedByProperty) SetSchemaPropertyValue(v vocab.SchemaPropertyValue) {
+	this.Clear()
+	this.schemaPropertyValueMember = v
+}
+
 // SetTootEmoji sets the value of this property. Calling IsTootEmoji afterwards
 // returns true.
 func (this *ForgeFedTicketsTrackedByProperty) SetTootEmoji(v vocab.TootEmoji) {
@@ -2764,6 +4253,10 @@ func (this *ForgeFedTicketsTrackedByProperty) SetType(t vocab.Type) error {
 		this.SetTootEmoji(v)
 		return nil
 	}
+	if v, ok := t.(vocab.LitepubEmojiReact); ok {
+		this.SetLitepubEmojiReact(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsEvent); ok {
 		this.SetActivityStreamsEvent(v)
 		return nil
@@ -2856,6 +4349,10 @@ func (this *ForgeFedTicketsTrackedByProperty) SetType(t vocab.Type) error {
 		this.SetActivityStreamsProfile(v)
 		return nil
 	}
+	if v, ok := t.(vocab.SchemaPropertyValue); ok {
+		this.SetSchemaPropertyValue(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ForgeFedPush); ok {
 		this.SetForgeFedPush(v)
 		return nil
@@ -2931,3 +4428,8 @@ func (this *ForgeFedTicketsTrackedByProperty) SetType(t vocab.Type) error {
 
 	return fmt.Errorf("illegal type to set on ticketsTrackedBy property: %T", t)
 }
+
+// poolForgeFedTicketsTrackedByProperty holds the released values of ForgeFedTicketsTrackedByProperty, which are reused when deserializing.
+var poolForgeFedTicketsTrackedByProperty = sync.Pool{New: func() interface{} {
+	return &ForgeFedTicketsTrackedByProperty{}
+}}
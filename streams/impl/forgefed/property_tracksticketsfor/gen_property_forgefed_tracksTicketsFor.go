@@ -4,8 +4,11 @@ package propertytracksticketsfor
 
 import (
 	"fmt"
+	proplist "github.com/go-fed/activity/streams/impl/proplist"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // ForgeFedTracksTicketsForPropertyIterator is an iterator for a property. It is
@@ -33,6 +36,7 @@ type ForgeFedTracksTicketsForPropertyIterator struct {
 	activitystreamsDislikeMember               vocab.ActivityStreamsDislike
 	activitystreamsDocumentMember              vocab.ActivityStreamsDocument
 	tootEmojiMember                            vocab.TootEmoji
+	litepubEmojiReactMember                    vocab.LitepubEmojiReact
 	activitystreamsEventMember                 vocab.ActivityStreamsEvent
 	activitystreamsFlagMember                  vocab.ActivityStreamsFlag
 	activitystreamsFollowMember                vocab.ActivityStreamsFollow
@@ -56,6 +60,7 @@ type ForgeFedTracksTicketsForPropertyIterator struct {
 	activitystreamsPersonMember                vocab.ActivityStreamsPerson
 	activitystreamsPlaceMember                 vocab.ActivityStreamsPlace
 	activitystreamsProfileMember               vocab.ActivityStreamsProfile
+	schemaPropertyValueMember                  vocab.SchemaPropertyValue
 	forgefedPushMember                         vocab.ForgeFedPush
 	activitystreamsQuestionMember              vocab.ActivityStreamsQuestion
 	activitystreamsReadMember                  vocab.ActivityStreamsRead
@@ -77,8 +82,7 @@ type ForgeFedTracksTicketsForPropertyIterator struct {
 	unknown                                    interface{}
 	iri                                        *url.URL
 	alias                                      string
-	myIdx                                      int
-	parent                                     vocab.ForgeFedTracksTicketsForProperty
+	proplist.Links[*ForgeFedTracksTicketsForPropertyIterator]
 }
 
 // NewForgeFedTracksTicketsForPropertyIterator creates a new
@@ -99,7 +103,8 @@ func deserializeForgeFedTracksTicketsForPropertyIterator(i interface{}, aliasMap
 		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 		if err == nil && len(u.Scheme) > 0 {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
+			this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+			*this = ForgeFedTracksTicketsForPropertyIterator{
 				alias: alias,
 				iri:   u,
 			}
@@ -107,373 +112,1734 @@ func deserializeForgeFedTracksTicketsForPropertyIterator(i interface{}, aliasMap
 		}
 	}
 	if m, ok := i.(map[string]interface{}); ok {
-		if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsObjectMember: v,
-				alias:                       alias,
+		// Begin: Determine the types of the value, to deserialize it as them first
+		typeValue, hasType := m["type"]
+		isType := func(vocabURI, name string) bool {
+			aliasPrefix := ""
+			if a, ok := aliasMap[vocabURI]; ok {
+				aliasPrefix = a + ":"
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsAcceptMember: v,
-				alias:                       alias,
+			switch t := typeValue.(type) {
+			case string:
+				return strings.TrimPrefix(t, aliasPrefix) == name
+			case []interface{}:
+				for _, elem := range t {
+					if s, ok := elem.(string); ok && strings.TrimPrefix(s, aliasPrefix) == name {
+						return true
+					}
+				}
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsActivityMember: v,
-				alias:                         alias,
+			return false
+		}
+		// End: Determine the types of the value, to deserialize it as them first
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Object") {
+			if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsObjectMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsAddMember: v,
-				alias:                    alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Accept") {
+			if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsAcceptMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsAnnounceMember: v,
-				alias:                         alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Activity") {
+			if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsActivityMember: v,
+					alias:                         alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsApplicationMember: v,
-				alias:                            alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Add") {
+			if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsAddMember: v,
+					alias:                    alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsArriveMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Announce") {
+			if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsAnnounceMember: v,
+					alias:                         alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsArticleMember: v,
-				alias:                        alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Application") {
+			if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsApplicationMember: v,
+					alias:                            alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsAudioMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Arrive") {
+			if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsArriveMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsBlockMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Article") {
+			if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsArticleMember: v,
+					alias:                        alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeBranchForgeFed()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				alias:                alias,
-				forgefedBranchMember: v,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Audio") {
+			if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsAudioMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsCollectionMember: v,
-				alias:                           alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Block") {
+			if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsBlockMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsCollectionPageMember: v,
-				alias:                               alias,
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "Branch") {
+			if v, err := mgr.DeserializeBranchForgeFed()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					alias:                alias,
+					forgefedBranchMember: v,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				alias:                alias,
-				forgefedCommitMember: v,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Collection") {
+			if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsCollectionMember: v,
+					alias:                           alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsCreateMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "CollectionPage") {
+			if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsCollectionPageMember: v,
+					alias:                               alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsDeleteMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "Commit") {
+			if v, err := mgr.DeserializeCommitForgeFed()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					alias:                alias,
+					forgefedCommitMember: v,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsDislikeMember: v,
-				alias:                        alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Create") {
+			if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsCreateMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsDocumentMember: v,
-				alias:                         alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Delete") {
+			if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsDeleteMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeEmojiToot()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				alias:           alias,
-				tootEmojiMember: v,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Dislike") {
+			if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsDislikeMember: v,
+					alias:                        alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsEventMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Document") {
+			if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsDocumentMember: v,
+					alias:                         alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsFlagMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("http://joinmastodon.org/ns", "Emoji") {
+			if v, err := mgr.DeserializeEmojiToot()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					alias:           alias,
+					tootEmojiMember: v,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsFollowMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("http://litepub.social/ns", "EmojiReact") {
+			if v, err := mgr.DeserializeEmojiReactLitepub()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					alias:                   alias,
+					litepubEmojiReactMember: v,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsGroupMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Event") {
+			if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsEventMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeIdentityProofToot()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				alias:                   alias,
-				tootIdentityProofMember: v,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Flag") {
+			if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsFlagMember: v,
+					alias:                     alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsIgnoreMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Follow") {
+			if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsFollowMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsImageMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Group") {
+			if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsGroupMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsIntransitiveActivityMember: v,
-				alias: alias,
+		}
+		if !hasType || isType("http://joinmastodon.org/ns", "IdentityProof") {
+			if v, err := mgr.DeserializeIdentityProofToot()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					alias:                   alias,
+					tootIdentityProofMember: v,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsInviteMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Ignore") {
+			if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsIgnoreMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsJoinMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Image") {
+			if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsImageMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsLeaveMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "IntransitiveActivity") {
+			if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsIntransitiveActivityMember: v,
+					alias: alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsLikeMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Invite") {
+			if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsInviteMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsListenMember: v,
-				alias:                       alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Join") {
+			if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsJoinMember: v,
+					alias:                     alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsMoveMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Leave") {
+			if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsLeaveMember: v,
+					alias:                      alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsNoteMember: v,
-				alias:                     alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Like") {
+			if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsLikeMember: v,
+					alias:                     alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsOfferMember: v,
-				alias:                      alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Listen") {
+			if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsListenMember: v,
+					alias:                       alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsOrderedCollectionMember: v,
-				alias:                                  alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Move") {
+			if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsMoveMember: v,
+					alias:                     alias,
+				}
+				return this, nil
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsOrderedCollectionPageMember: v,
-				alias: alias,
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Note") {
+			if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsNoteMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Offer") {
+			if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsOfferMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "OrderedCollection") {
+			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsOrderedCollectionMember: v,
+					alias:                                  alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "OrderedCollectionPage") {
+			if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsOrderedCollectionPageMember: v,
+					alias: alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Organization") {
+			if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsOrganizationMember: v,
+					alias:                             alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Page") {
+			if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsPageMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Person") {
+			if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsPersonMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Place") {
+			if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsPlaceMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Profile") {
+			if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsProfileMember: v,
+					alias:                        alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("http://schema.org", "PropertyValue") {
+			if v, err := mgr.DeserializePropertyValueSchema()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					alias:                     alias,
+					schemaPropertyValueMember: v,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "Push") {
+			if v, err := mgr.DeserializePushForgeFed()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					alias:              alias,
+					forgefedPushMember: v,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Question") {
+			if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsQuestionMember: v,
+					alias:                         alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Read") {
+			if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsReadMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Reject") {
+			if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsRejectMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Relationship") {
+			if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsRelationshipMember: v,
+					alias:                             alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Remove") {
+			if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsRemoveMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "Repository") {
+			if v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					alias:                    alias,
+					forgefedRepositoryMember: v,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Service") {
+			if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsServiceMember: v,
+					alias:                        alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "TentativeAccept") {
+			if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsTentativeAcceptMember: v,
+					alias:                                alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "TentativeReject") {
+			if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsTentativeRejectMember: v,
+					alias:                                alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "Ticket") {
+			if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					alias:                alias,
+					forgefedTicketMember: v,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://forgefed.peers.community/ns", "TicketDependency") {
+			if v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					alias:                          alias,
+					forgefedTicketDependencyMember: v,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Tombstone") {
+			if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsTombstoneMember: v,
+					alias:                          alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Travel") {
+			if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsTravelMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Undo") {
+			if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsUndoMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Update") {
+			if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsUpdateMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "Video") {
+			if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsVideoMember: v,
+					alias:                      alias,
+				}
+				return this, nil
+			}
+		}
+		if !hasType || isType("https://www.w3.org/ns/activitystreams", "View") {
+			if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
+				this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+				*this = ForgeFedTracksTicketsForPropertyIterator{
+					activitystreamsViewMember: v,
+					alias:                     alias,
+				}
+				return this, nil
+			}
+		}
+	}
+	this := poolForgeFedTracksTicketsForPropertyIterator.Get().(*ForgeFedTracksTicketsForPropertyIterator)
+	*this = ForgeFedTracksTicketsForPropertyIterator{
+		alias:   alias,
+		unknown: i,
+	}
+	return this, nil
+}
+
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this ForgeFedTracksTicketsForPropertyIterator) AddJSONLDContext(m map[string]string) {
+	m["https://forgefed.peers.community/ns"] = this.alias
+	if this.IsActivityStreamsObject() {
+		if a, ok := this.activitystreamsObjectMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsObjectMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAccept() {
+		if a, ok := this.activitystreamsAcceptMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAcceptMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsActivity() {
+		if a, ok := this.activitystreamsActivityMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsActivityMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAdd() {
+		if a, ok := this.activitystreamsAddMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAddMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAnnounce() {
+		if a, ok := this.activitystreamsAnnounceMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAnnounceMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsApplication() {
+		if a, ok := this.activitystreamsApplicationMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsApplicationMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsArrive() {
+		if a, ok := this.activitystreamsArriveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsArriveMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsArticle() {
+		if a, ok := this.activitystreamsArticleMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsArticleMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsAudio() {
+		if a, ok := this.activitystreamsAudioMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsAudioMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsBlock() {
+		if a, ok := this.activitystreamsBlockMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsBlockMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsForgeFedBranch() {
+		if a, ok := this.forgefedBranchMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedBranchMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsCollection() {
+		if a, ok := this.activitystreamsCollectionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsCollectionMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsCollectionPage() {
+		if a, ok := this.activitystreamsCollectionPageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsCollectionPageMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsForgeFedCommit() {
+		if a, ok := this.forgefedCommitMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedCommitMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsCreate() {
+		if a, ok := this.activitystreamsCreateMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsCreateMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsDelete() {
+		if a, ok := this.activitystreamsDeleteMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsDeleteMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsDislike() {
+		if a, ok := this.activitystreamsDislikeMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsDislikeMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsDocument() {
+		if a, ok := this.activitystreamsDocumentMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsDocumentMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsTootEmoji() {
+		if a, ok := this.tootEmojiMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.tootEmojiMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsLitepubEmojiReact() {
+		if a, ok := this.litepubEmojiReactMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.litepubEmojiReactMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsEvent() {
+		if a, ok := this.activitystreamsEventMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsEventMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsFlag() {
+		if a, ok := this.activitystreamsFlagMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsFlagMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsFollow() {
+		if a, ok := this.activitystreamsFollowMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsFollowMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsGroup() {
+		if a, ok := this.activitystreamsGroupMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsGroupMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsTootIdentityProof() {
+		if a, ok := this.tootIdentityProofMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.tootIdentityProofMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsIgnore() {
+		if a, ok := this.activitystreamsIgnoreMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsIgnoreMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsImage() {
+		if a, ok := this.activitystreamsImageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsImageMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		if a, ok := this.activitystreamsIntransitiveActivityMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsIntransitiveActivityMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsInvite() {
+		if a, ok := this.activitystreamsInviteMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsInviteMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsJoin() {
+		if a, ok := this.activitystreamsJoinMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsJoinMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsLeave() {
+		if a, ok := this.activitystreamsLeaveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsLeaveMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsLike() {
+		if a, ok := this.activitystreamsLikeMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsLikeMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsListen() {
+		if a, ok := this.activitystreamsListenMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsListenMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsMove() {
+		if a, ok := this.activitystreamsMoveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsMoveMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsNote() {
+		if a, ok := this.activitystreamsNoteMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsNoteMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOffer() {
+		if a, ok := this.activitystreamsOfferMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOfferMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOrderedCollection() {
+		if a, ok := this.activitystreamsOrderedCollectionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrderedCollectionMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		if a, ok := this.activitystreamsOrderedCollectionPageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrderedCollectionPageMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsOrganizationMember: v,
-				alias:                             alias,
+		}
+	} else if this.IsActivityStreamsOrganization() {
+		if a, ok := this.activitystreamsOrganizationMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsOrganizationMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsPageMember: v,
-				alias:                     alias,
+		}
+	} else if this.IsActivityStreamsPage() {
+		if a, ok := this.activitystreamsPageMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsPageMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsPersonMember: v,
-				alias:                       alias,
+		}
+	} else if this.IsActivityStreamsPerson() {
+		if a, ok := this.activitystreamsPersonMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsPersonMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsPlaceMember: v,
-				alias:                      alias,
+		}
+	} else if this.IsActivityStreamsPlace() {
+		if a, ok := this.activitystreamsPlaceMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsPlaceMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsProfileMember: v,
-				alias:                        alias,
+		}
+	} else if this.IsActivityStreamsProfile() {
+		if a, ok := this.activitystreamsProfileMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsProfileMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializePushForgeFed()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				alias:              alias,
-				forgefedPushMember: v,
+		}
+	} else if this.IsSchemaPropertyValue() {
+		if a, ok := this.schemaPropertyValueMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.schemaPropertyValueMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsQuestionMember: v,
-				alias:                         alias,
+		}
+	} else if this.IsForgeFedPush() {
+		if a, ok := this.forgefedPushMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedPushMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsReadMember: v,
-				alias:                     alias,
+		}
+	} else if this.IsActivityStreamsQuestion() {
+		if a, ok := this.activitystreamsQuestionMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsQuestionMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsRejectMember: v,
-				alias:                       alias,
+		}
+	} else if this.IsActivityStreamsRead() {
+		if a, ok := this.activitystreamsReadMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsReadMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsRelationshipMember: v,
-				alias:                             alias,
+		}
+	} else if this.IsActivityStreamsReject() {
+		if a, ok := this.activitystreamsRejectMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsRejectMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsRemoveMember: v,
-				alias:                       alias,
+		}
+	} else if this.IsActivityStreamsRelationship() {
+		if a, ok := this.activitystreamsRelationshipMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsRelationshipMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRepositoryForgeFed()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				alias:                    alias,
-				forgefedRepositoryMember: v,
+		}
+	} else if this.IsActivityStreamsRemove() {
+		if a, ok := this.activitystreamsRemoveMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsRemoveMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsServiceMember: v,
-				alias:                        alias,
+		}
+	} else if this.IsForgeFedRepository() {
+		if a, ok := this.forgefedRepositoryMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedRepositoryMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsTentativeAcceptMember: v,
-				alias:                                alias,
+		}
+	} else if this.IsActivityStreamsService() {
+		if a, ok := this.activitystreamsServiceMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsServiceMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsTentativeRejectMember: v,
-				alias:                                alias,
+		}
+	} else if this.IsActivityStreamsTentativeAccept() {
+		if a, ok := this.activitystreamsTentativeAcceptMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTentativeAcceptMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTicketForgeFed()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				alias:                alias,
-				forgefedTicketMember: v,
+		}
+	} else if this.IsActivityStreamsTentativeReject() {
+		if a, ok := this.activitystreamsTentativeRejectMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTentativeRejectMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTicketDependencyForgeFed()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				alias:                          alias,
-				forgefedTicketDependencyMember: v,
+		}
+	} else if this.IsForgeFedTicket() {
+		if a, ok := this.forgefedTicketMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedTicketMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsTombstoneMember: v,
-				alias:                          alias,
+		}
+	} else if this.IsForgeFedTicketDependency() {
+		if a, ok := this.forgefedTicketDependencyMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.forgefedTicketDependencyMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsTravelMember: v,
-				alias:                       alias,
+		}
+	} else if this.IsActivityStreamsTombstone() {
+		if a, ok := this.activitystreamsTombstoneMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTombstoneMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsUndoMember: v,
-				alias:                     alias,
+		}
+	} else if this.IsActivityStreamsTravel() {
+		if a, ok := this.activitystreamsTravelMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsTravelMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsUpdateMember: v,
-				alias:                       alias,
+		}
+	} else if this.IsActivityStreamsUndo() {
+		if a, ok := this.activitystreamsUndoMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsUndoMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsVideoMember: v,
-				alias:                      alias,
+		}
+	} else if this.IsActivityStreamsUpdate() {
+		if a, ok := this.activitystreamsUpdateMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsUpdateMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
-		} else if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
-			this := &ForgeFedTracksTicketsForPropertyIterator{
-				activitystreamsViewMember: v,
-				alias:                     alias,
+		}
+	} else if this.IsActivityStreamsVideo() {
+		if a, ok := this.activitystreamsVideoMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsVideoMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	} else if this.IsActivityStreamsView() {
+		if a, ok := this.activitystreamsViewMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.activitystreamsViewMember.JSONLDContext() {
+				m[k] = v
 			}
-			return this, nil
 		}
 	}
-	this := &ForgeFedTracksTicketsForPropertyIterator{
-		alias:   alias,
-		unknown: i,
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ForgeFedTracksTicketsForPropertyIterator) Compare(o vocab.ForgeFedTracksTicketsForPropertyIterator) int {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return -1
+	} else if idx1 > idx2 {
+		return 1
+	} else if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject().Compare(o.GetActivityStreamsObject())
+	} else if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept().Compare(o.GetActivityStreamsAccept())
+	} else if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity().Compare(o.GetActivityStreamsActivity())
+	} else if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd().Compare(o.GetActivityStreamsAdd())
+	} else if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce().Compare(o.GetActivityStreamsAnnounce())
+	} else if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().Compare(o.GetActivityStreamsApplication())
+	} else if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive().Compare(o.GetActivityStreamsArrive())
+	} else if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle().Compare(o.GetActivityStreamsArticle())
+	} else if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio().Compare(o.GetActivityStreamsAudio())
+	} else if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock().Compare(o.GetActivityStreamsBlock())
+	} else if this.IsForgeFedBranch() {
+		return this.GetForgeFedBranch().Compare(o.GetForgeFedBranch())
+	} else if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection().Compare(o.GetActivityStreamsCollection())
+	} else if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage().Compare(o.GetActivityStreamsCollectionPage())
+	} else if this.IsForgeFedCommit() {
+		return this.GetForgeFedCommit().Compare(o.GetForgeFedCommit())
+	} else if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate().Compare(o.GetActivityStreamsCreate())
+	} else if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete().Compare(o.GetActivityStreamsDelete())
+	} else if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike().Compare(o.GetActivityStreamsDislike())
+	} else if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument().Compare(o.GetActivityStreamsDocument())
+	} else if this.IsTootEmoji() {
+		return this.GetTootEmoji().Compare(o.GetTootEmoji())
+	} else if this.IsLitepubEmojiReact() {
+		return this.GetLitepubEmojiReact().Compare(o.GetLitepubEmojiReact())
+	} else if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent().Compare(o.GetActivityStreamsEvent())
+	} else if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag().Compare(o.GetActivityStreamsFlag())
+	} else if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow().Compare(o.GetActivityStreamsFollow())
+	} else if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().Compare(o.GetActivityStreamsGroup())
+	} else if this.IsTootIdentityProof() {
+		return this.GetTootIdentityProof().Compare(o.GetTootIdentityProof())
+	} else if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore().Compare(o.GetActivityStreamsIgnore())
+	} else if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage().Compare(o.GetActivityStreamsImage())
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity().Compare(o.GetActivityStreamsIntransitiveActivity())
+	} else if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite().Compare(o.GetActivityStreamsInvite())
+	} else if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin().Compare(o.GetActivityStreamsJoin())
+	} else if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave().Compare(o.GetActivityStreamsLeave())
+	} else if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike().Compare(o.GetActivityStreamsLike())
+	} else if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen().Compare(o.GetActivityStreamsListen())
+	} else if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove().Compare(o.GetActivityStreamsMove())
+	} else if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote().Compare(o.GetActivityStreamsNote())
+	} else if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer().Compare(o.GetActivityStreamsOffer())
+	} else if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection().Compare(o.GetActivityStreamsOrderedCollection())
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage().Compare(o.GetActivityStreamsOrderedCollectionPage())
+	} else if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().Compare(o.GetActivityStreamsOrganization())
+	} else if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage().Compare(o.GetActivityStreamsPage())
+	} else if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().Compare(o.GetActivityStreamsPerson())
+	} else if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace().Compare(o.GetActivityStreamsPlace())
+	} else if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile().Compare(o.GetActivityStreamsProfile())
+	} else if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue().Compare(o.GetSchemaPropertyValue())
+	} else if this.IsForgeFedPush() {
+		return this.GetForgeFedPush().Compare(o.GetForgeFedPush())
+	} else if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion().Compare(o.GetActivityStreamsQuestion())
+	} else if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead().Compare(o.GetActivityStreamsRead())
+	} else if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject().Compare(o.GetActivityStreamsReject())
+	} else if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship().Compare(o.GetActivityStreamsRelationship())
+	} else if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove().Compare(o.GetActivityStreamsRemove())
+	} else if this.IsForgeFedRepository() {
+		return this.GetForgeFedRepository().Compare(o.GetForgeFedRepository())
+	} else if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().Compare(o.GetActivityStreamsService())
+	} else if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept().Compare(o.GetActivityStreamsTentativeAccept())
+	} else if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject().Compare(o.GetActivityStreamsTentativeReject())
+	} else if this.IsForgeFedTicket() {
+		return this.GetForgeFedTicket().Compare(o.GetForgeFedTicket())
+	} else if this.IsForgeFedTicketDependency() {
+		return this.GetForgeFedTicketDependency().Compare(o.GetForgeFedTicketDependency())
+	} else if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone().Compare(o.GetActivityStreamsTombstone())
+	} else if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel().Compare(o.GetActivityStreamsTravel())
+	} else if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo().Compare(o.GetActivityStreamsUndo())
+	} else if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate().Compare(o.GetActivityStreamsUpdate())
+	} else if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo().Compare(o.GetActivityStreamsVideo())
+	} else if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView().Compare(o.GetActivityStreamsView())
+	} else if this.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
 	}
-	return this, nil
+	return 0
 }
 
 // GetActivityStreamsAccept returns the value of this property. When
@@ -884,6 +2250,20 @@ func (this ForgeFedTracksTicketsForPropertyIterator) GetIRI() *url.URL {
 	return this.iri
 }
 
+// GetLitepubEmojiReact returns the value of this property. When
+// IsLitepubEmojiReact returns false, GetLitepubEmojiReact will return an
+// arbitrary value.
+func (this ForgeFedTracksTicketsForPropertyIterator) GetLitepubEmojiReact() vocab.LitepubEmojiReact {
+	return this.litepubEmojiReactMember
+}
+
+// GetSchemaPropertyValue returns the value of this property. When
+// IsSchemaPropertyValue returns false, GetSchemaPropertyValue will return an
+// arbitrary value.
+func (this ForgeFedTracksTicketsForPropertyIterator) GetSchemaPropertyValue() vocab.SchemaPropertyValue {
+	return this.schemaPropertyValueMember
+}
+
 // GetTootEmoji returns the value of this property. When IsTootEmoji returns
 // false, GetTootEmoji will return an arbitrary value.
 func (this ForgeFedTracksTicketsForPropertyIterator) GetTootEmoji() vocab.TootEmoji {
@@ -957,6 +2337,9 @@ func (this ForgeFedTracksTicketsForPropertyIterator) GetType() vocab.Type {
 	if this.IsTootEmoji() {
 		return this.GetTootEmoji()
 	}
+	if this.IsLitepubEmojiReact() {
+		return this.GetLitepubEmojiReact()
+	}
 	if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent()
 	}
@@ -1026,6 +2409,9 @@ func (this ForgeFedTracksTicketsForPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile()
 	}
+	if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue()
+	}
 	if this.IsForgeFedPush() {
 		return this.GetForgeFedPush()
 	}
@@ -1105,6 +2491,7 @@ func (this ForgeFedTracksTicketsForPropertyIterator) HasAny() bool {
 		this.IsActivityStreamsDislike() ||
 		this.IsActivityStreamsDocument() ||
 		this.IsTootEmoji() ||
+		this.IsLitepubEmojiReact() ||
 		this.IsActivityStreamsEvent() ||
 		this.IsActivityStreamsFlag() ||
 		this.IsActivityStreamsFollow() ||
@@ -1128,6 +2515,7 @@ func (this ForgeFedTracksTicketsForPropertyIterator) HasAny() bool {
 		this.IsActivityStreamsPerson() ||
 		this.IsActivityStreamsPlace() ||
 		this.IsActivityStreamsProfile() ||
+		this.IsSchemaPropertyValue() ||
 		this.IsForgeFedPush() ||
 		this.IsActivityStreamsQuestion() ||
 		this.IsActivityStreamsRead() ||
@@ -1566,6 +2954,20 @@ func (this ForgeFedTracksTicketsForPropertyIterator) IsIRI() bool {
 	return this.iri != nil
 }
 
+// IsLitepubEmojiReact returns true if this property has a type of "EmojiReact".
+// When true, use the GetLitepubEmojiReact and SetLitepubEmojiReact methods to
+// access and set this property.
+func (this ForgeFedTracksTicketsForPropertyIterator) IsLitepubEmojiReact() bool {
+	return this.litepubEmojiReactMember != nil
+}
+
+// IsSchemaPropertyValue returns true if this property has a type of
+// "PropertyValue". When true, use the GetSchemaPropertyValue and
+// SetSchemaPropertyValue methods to access and set this property.
+func (this ForgeFedTracksTicketsForPropertyIterator) IsSchemaPropertyValue() bool {
+	return this.schemaPropertyValueMember != nil
+}
+
 // IsTootEmoji returns true if this property has a type of "Emoji". When true, use
 // the GetTootEmoji and SetTootEmoji methods to access and set this property.
 func (this ForgeFedTracksTicketsForPropertyIterator) IsTootEmoji() bool {
@@ -1583,137 +2985,8 @@ func (this ForgeFedTracksTicketsForPropertyIterator) IsTootIdentityProof() bool
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ForgeFedTracksTicketsForPropertyIterator) JSONLDContext() map[string]string {
-	m := map[string]string{"https://forgefed.peers.community/ns": this.alias}
-	var child map[string]string
-	if this.IsActivityStreamsObject() {
-		child = this.GetActivityStreamsObject().JSONLDContext()
-	} else if this.IsActivityStreamsAccept() {
-		child = this.GetActivityStreamsAccept().JSONLDContext()
-	} else if this.IsActivityStreamsActivity() {
-		child = this.GetActivityStreamsActivity().JSONLDContext()
-	} else if this.IsActivityStreamsAdd() {
-		child = this.GetActivityStreamsAdd().JSONLDContext()
-	} else if this.IsActivityStreamsAnnounce() {
-		child = this.GetActivityStreamsAnnounce().JSONLDContext()
-	} else if this.IsActivityStreamsApplication() {
-		child = this.GetActivityStreamsApplication().JSONLDContext()
-	} else if this.IsActivityStreamsArrive() {
-		child = this.GetActivityStreamsArrive().JSONLDContext()
-	} else if this.IsActivityStreamsArticle() {
-		child = this.GetActivityStreamsArticle().JSONLDContext()
-	} else if this.IsActivityStreamsAudio() {
-		child = this.GetActivityStreamsAudio().JSONLDContext()
-	} else if this.IsActivityStreamsBlock() {
-		child = this.GetActivityStreamsBlock().JSONLDContext()
-	} else if this.IsForgeFedBranch() {
-		child = this.GetForgeFedBranch().JSONLDContext()
-	} else if this.IsActivityStreamsCollection() {
-		child = this.GetActivityStreamsCollection().JSONLDContext()
-	} else if this.IsActivityStreamsCollectionPage() {
-		child = this.GetActivityStreamsCollectionPage().JSONLDContext()
-	} else if this.IsForgeFedCommit() {
-		child = this.GetForgeFedCommit().JSONLDContext()
-	} else if this.IsActivityStreamsCreate() {
-		child = this.GetActivityStreamsCreate().JSONLDContext()
-	} else if this.IsActivityStreamsDelete() {
-		child = this.GetActivityStreamsDelete().JSONLDContext()
-	} else if this.IsActivityStreamsDislike() {
-		child = this.GetActivityStreamsDislike().JSONLDContext()
-	} else if this.IsActivityStreamsDocument() {
-		child = this.GetActivityStreamsDocument().JSONLDContext()
-	} else if this.IsTootEmoji() {
-		child = this.GetTootEmoji().JSONLDContext()
-	} else if this.IsActivityStreamsEvent() {
-		child = this.GetActivityStreamsEvent().JSONLDContext()
-	} else if this.IsActivityStreamsFlag() {
-		child = this.GetActivityStreamsFlag().JSONLDContext()
-	} else if this.IsActivityStreamsFollow() {
-		child = this.GetActivityStreamsFollow().JSONLDContext()
-	} else if this.IsActivityStreamsGroup() {
-		child = this.GetActivityStreamsGroup().JSONLDContext()
-	} else if this.IsTootIdentityProof() {
-		child = this.GetTootIdentityProof().JSONLDContext()
-	} else if this.IsActivityStreamsIgnore() {
-		child = this.GetActivityStreamsIgnore().JSONLDContext()
-	} else if this.IsActivityStreamsImage() {
-		child = this.GetActivityStreamsImage().JSONLDContext()
-	} else if this.IsActivityStreamsIntransitiveActivity() {
-		child = this.GetActivityStreamsIntransitiveActivity().JSONLDContext()
-	} else if this.IsActivityStreamsInvite() {
-		child = this.GetActivityStreamsInvite().JSONLDContext()
-	} else if this.IsActivityStreamsJoin() {
-		child = this.GetActivityStreamsJoin().JSONLDContext()
-	} else if this.IsActivityStreamsLeave() {
-		child = this.GetActivityStreamsLeave().JSONLDContext()
-	} else if this.IsActivityStreamsLike() {
-		child = this.GetActivityStreamsLike().JSONLDContext()
-	} else if this.IsActivityStreamsListen() {
-		child = this.GetActivityStreamsListen().JSONLDContext()
-	} else if this.IsActivityStreamsMove() {
-		child = this.GetActivityStreamsMove().JSONLDContext()
-	} else if this.IsActivityStreamsNote() {
-		child = this.GetActivityStreamsNote().JSONLDContext()
-	} else if this.IsActivityStreamsOffer() {
-		child = this.GetActivityStreamsOffer().JSONLDContext()
-	} else if this.IsActivityStreamsOrderedCollection() {
-		child = this.GetActivityStreamsOrderedCollection().JSONLDContext()
-	} else if this.IsActivityStreamsOrderedCollectionPage() {
-		child = this.GetActivityStreamsOrderedCollectionPage().JSONLDContext()
-	} else if this.IsActivityStreamsOrganization() {
-		child = this.GetActivityStreamsOrganization().JSONLDContext()
-	} else if this.IsActivityStreamsPage() {
-		child = this.GetActivityStreamsPage().JSONLDContext()
-	} else if this.IsActivityStreamsPerson() {
-		child = this.GetActivityStreamsPerson().JSONLDContext()
-	} else if this.IsActivityStreamsPlace() {
-		child = this.GetActivityStreamsPlace().JSONLDContext()
-	} else if this.IsActivityStreamsProfile() {
-		child = this.GetActivityStreamsProfile().JSONLDContext()
-	} else if this.IsForgeFedPush() {
-		child = this.GetForgeFedPush().JSONLDContext()
-	} else if this.IsActivityStreamsQuestion() {
-		child = this.GetActivityStreamsQuestion().JSONLDContext()
-	} else if this.IsActivityStreamsRead() {
-		child = this.GetActivityStreamsRead().JSONLDContext()
-	} else if this.IsActivityStreamsReject() {
-		child = this.GetActivityStreamsReject().JSONLDContext()
-	} else if this.IsActivityStreamsRelationship() {
-		child = this.GetActivityStreamsRelationship().JSONLDContext()
-	} else if this.IsActivityStreamsRemove() {
-		child = this.GetActivityStreamsRemove().JSONLDContext()
-	} else if this.IsForgeFedRepository() {
-		child = this.GetForgeFedRepository().JSONLDContext()
-	} else if this.IsActivityStreamsService() {
-		child = this.GetActivityStreamsService().JSONLDContext()
-	} else if this.IsActivityStreamsTentativeAccept() {
-		child = this.GetActivityStreamsTentativeAccept().JSONLDContext()
-	} else if this.IsActivityStreamsTentativeReject() {
-		child = this.GetActivityStreamsTentativeReject().JSONLDContext()
-	} else if this.IsForgeFedTicket() {
-		child = this.GetForgeFedTicket().JSONLDContext()
-	} else if this.IsForgeFedTicketDependency() {
-		child = this.GetForgeFedTicketDependency().JSONLDContext()
-	} else if this.IsActivityStreamsTombstone() {
-		child = this.GetActivityStreamsTombstone().JSONLDContext()
-	} else if this.IsActivityStreamsTravel() {
-		child = this.GetActivityStreamsTravel().JSONLDContext()
-	} else if this.IsActivityStreamsUndo() {
-		child = this.GetActivityStreamsUndo().JSONLDContext()
-	} else if this.IsActivityStreamsUpdate() {
-		child = this.GetActivityStreamsUpdate().JSONLDContext()
-	} else if this.IsActivityStreamsVideo() {
-		child = this.GetActivityStreamsVideo().JSONLDContext()
-	} else if this.IsActivityStreamsView() {
-		child = this.GetActivityStreamsView().JSONLDContext()
-	}
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -1778,128 +3051,134 @@ func (this ForgeFedTracksTicketsForPropertyIterator) KindIndex() int {
 	if this.IsTootEmoji() {
 		return 18
 	}
-	if this.IsActivityStreamsEvent() {
+	if this.IsLitepubEmojiReact() {
 		return 19
 	}
-	if this.IsActivityStreamsFlag() {
+	if this.IsActivityStreamsEvent() {
 		return 20
 	}
-	if this.IsActivityStreamsFollow() {
+	if this.IsActivityStreamsFlag() {
 		return 21
 	}
-	if this.IsActivityStreamsGroup() {
+	if this.IsActivityStreamsFollow() {
 		return 22
 	}
-	if this.IsTootIdentityProof() {
+	if this.IsActivityStreamsGroup() {
 		return 23
 	}
-	if this.IsActivityStreamsIgnore() {
+	if this.IsTootIdentityProof() {
 		return 24
 	}
+	if this.IsActivityStreamsIgnore() {
+		return 25
+	}
 	if this.IsActivityStreamsImage() {
-		return 25
+		return 26
 	}
 	if this.IsActivityStreamsIntransitiveActivity() {
-		return 26
+		return 27
 	}
 	if this.IsActivityStreamsInvite() {
-		return 27
+		return 28
 	}
 	if this.IsActivityStreamsJoin() {
-		return 28
+		return 29
 	}
 	if this.IsActivityStreamsLeave() {
-		return 29
+		return 30
 	}
 	if this.IsActivityStreamsLike() {
-		return 30
+		return 31
 	}
 	if this.IsActivityStreamsListen() {
-		return 31
+		return 32
 	}
 	if this.IsActivityStreamsMove() {
-		return 32
+		return 33
 	}
 	if this.IsActivityStreamsNote() {
-		return 33
+		return 34
 	}
 	if this.IsActivityStreamsOffer() {
-		return 34
+		return 35
 	}
 	if this.IsActivityStreamsOrderedCollection() {
-		return 35
+		return 36
 	}
 	if this.IsActivityStreamsOrderedCollectionPage() {
-		return 36
+		return 37
 	}
 	if this.IsActivityStreamsOrganization() {
-		return 37
+		return 38
 	}
 	if this.IsActivityStreamsPage() {
-		return 38
+		return 39
 	}
 	if this.IsActivityStreamsPerson() {
-		return 39
+		return 40
 	}
 	if this.IsActivityStreamsPlace() {
-		return 40
+		return 41
 	}
 	if this.IsActivityStreamsProfile() {
-		return 41
+		return 42
+	}
+	if this.IsSchemaPropertyValue() {
+		return 43
 	}
 	if this.IsForgeFedPush() {
-		return 42
+		return 44
 	}
 	if this.IsActivityStreamsQuestion() {
-		return 43
+		return 45
 	}
 	if this.IsActivityStreamsRead() {
-		return 44
+		return 46
 	}
 	if this.IsActivityStreamsReject() {
-		return 45
+		return 47
 	}
 	if this.IsActivityStreamsRelationship() {
-		return 46
+		return 48
 	}
 	if this.IsActivityStreamsRemove() {
-		return 47
+		return 49
 	}
 	if this.IsForgeFedRepository() {
-		return 48
+		return 50
 	}
 	if this.IsActivityStreamsService() {
-		return 49
+		return 51
 	}
 	if this.IsActivityStreamsTentativeAccept() {
-		return 50
+		return 52
 	}
 	if this.IsActivityStreamsTentativeReject() {
-		return 51
+		return 53
 	}
 	if this.IsForgeFedTicket() {
-		return 52
+		return 54
 	}
 	if this.IsForgeFedTicketDependency() {
-		return 53
+		return 55
 	}
 	if this.IsActivityStreamsTombstone() {
-		return 54
+		return 56
 	}
 	if this.IsActivityStreamsTravel() {
-		return 55
+		return 57
 	}
 	if this.IsActivityStreamsUndo() {
-		return 56
+		return 58
 	}
 	if this.IsActivityStreamsUpdate() {
-		return 57
+		return 59
 	}
 	if this.IsActivityStreamsVideo() {
-		return 58
+		return 60
 	}
 	if this.IsActivityStreamsView() {
-		return 59
+		return 61
 	}
 	if this.IsIRI() {
 		return -2
@@ -1912,136 +3191,7 @@ func (this ForgeFedTracksTicketsForPropertyIterator) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ForgeFedTracksTicketsForPropertyIterator) LessThan(o vocab.ForgeFedTracksTicketsForPropertyIterator) bool {
-	idx1 := this.KindIndex()
-	idx2 := o.KindIndex()
-	if idx1 < idx2 {
-		return true
-	} else if idx1 > idx2 {
-		return false
-	} else if this.IsActivityStreamsObject() {
-		return this.GetActivityStreamsObject().LessThan(o.GetActivityStreamsObject())
-	} else if this.IsActivityStreamsAccept() {
-		return this.GetActivityStreamsAccept().LessThan(o.GetActivityStreamsAccept())
-	} else if this.IsActivityStreamsActivity() {
-		return this.GetActivityStreamsActivity().LessThan(o.GetActivityStreamsActivity())
-	} else if this.IsActivityStreamsAdd() {
-		return this.GetActivityStreamsAdd().LessThan(o.GetActivityStreamsAdd())
-	} else if this.IsActivityStreamsAnnounce() {
-		return this.GetActivityStreamsAnnounce().LessThan(o.GetActivityStreamsAnnounce())
-	} else if this.IsActivityStreamsApplication() {
-		return this.GetActivityStreamsApplication().LessThan(o.GetActivityStreamsApplication())
-	} else if this.IsActivityStreamsArrive() {
-		return this.GetActivityStreamsArrive().LessThan(o.GetActivityStreamsArrive())
-	} else if this.IsActivityStreamsArticle() {
-		return this.GetActivityStreamsArticle().LessThan(o.GetActivityStreamsArticle())
-	} else if this.IsActivityStreamsAudio() {
-		return this.GetActivityStreamsAudio().LessThan(o.GetActivityStreamsAudio())
-	} else if this.IsActivityStreamsBlock() {
-		return this.GetActivityStreamsBlock().LessThan(o.GetActivityStreamsBlock())
-	} else if this.IsForgeFedBranch() {
-		return this.GetForgeFedBranch().LessThan(o.GetForgeFedBranch())
-	} else if this.IsActivityStreamsCollection() {
-		return this.GetActivityStreamsCollection().LessThan(o.GetActivityStreamsCollection())
-	} else if this.IsActivityStreamsCollectionPage() {
-		return this.GetActivityStreamsCollectionPage().LessThan(o.GetActivityStreamsCollectionPage())
-	} else if this.IsForgeFedCommit() {
-		return this.GetForgeFedCommit().LessThan(o.GetForgeFedCommit())
-	} else if this.IsActivityStreamsCreate() {
-		return this.GetActivityStreamsCreate().LessThan(o.GetActivityStreamsCreate())
-	} else if this.IsActivityStreamsDelete() {
-		return this.GetActivityStreamsDelete().LessThan(o.GetActivityStreamsDelete())
-	} else if this.IsActivityStreamsDislike() {
-		return this.GetActivityStreamsDislike().LessThan(o.GetActivityStreamsDislike())
-	} else if this.IsActivityStreamsDocument() {
-		return this.GetActivityStreamsDocument().LessThan(o.GetActivityStreamsDocument())
-	} else if this.IsTootEmoji() {
-		return this.GetTootEmoji().LessThan(o.GetTootEmoji())
-	} else if this.IsActivityStreamsEvent() {
-		return this.GetActivityStreamsEvent().LessThan(o.GetActivityStreamsEvent())
-	} else if this.IsActivityStreamsFlag() {
-		return this.GetActivityStreamsFlag().LessThan(o.GetActivityStreamsFlag())
-	} else if this.IsActivityStreamsFollow() {
-		return this.GetActivityStreamsFollow().LessThan(o.GetActivityStreamsFollow())
-	} else if this.IsActivityStreamsGroup() {
-		return this.GetActivityStreamsGroup().LessThan(o.GetActivityStreamsGroup())
-	} else if this.IsTootIdentityProof() {
-		return this.GetTootIdentityProof().LessThan(o.GetTootIdentityProof())
-	} else if this.IsActivityStreamsIgnore() {
-		return this.GetActivityStreamsIgnore().LessThan(o.GetActivityStreamsIgnore())
-	} else if this.IsActivityStreamsImage() {
-		return this.GetActivityStreamsImage().LessThan(o.GetActivityStreamsImage())
-	} else if this.IsActivityStreamsIntransitiveActivity() {
-		return this.GetActivityStreamsIntransitiveActivity().LessThan(o.GetActivityStreamsIntransitiveActivity())
-	} else if this.IsActivityStreamsInvite() {
-		return this.GetActivityStreamsInvite().LessThan(o.GetActivityStreamsInvite())
-	} else if this.IsActivityStreamsJoin() {
-		return this.GetActivityStreamsJoin().LessThan(o.GetActivityStreamsJoin())
-	} else if this.IsActivityStreamsLeave() {
-		return this.GetActivityStreamsLeave().LessThan(o.GetActivityStreamsLeave())
-	} else if this.IsActivityStreamsLike() {
-		return this.GetActivityStreamsLike().LessThan(o.GetActivityStreamsLike())
-	} else if this.IsActivityStreamsListen() {
-		return this.GetActivityStreamsListen().LessThan(o.GetActivityStreamsListen())
-	} else if this.IsActivityStreamsMove() {
-		return this.GetActivityStreamsMove().LessThan(o.GetActivityStreamsMove())
-	} else if this.IsActivityStreamsNote() {
-		return this.GetActivityStreamsNote().LessThan(o.GetActivityStreamsNote())
-	} else if this.IsActivityStreamsOffer() {
-		return this.GetActivityStreamsOffer().LessThan(o.GetActivityStreamsOffer())
-	} else if this.IsActivityStreamsOrderedCollection() {
-		return this.GetActivityStreamsOrderedCollection().LessThan(o.GetActivityStreamsOrderedCollection())
-	} else if this.IsActivityStreamsOrderedCollectionPage() {
-		return this.GetActivityStreamsOrderedCollectionPage().LessThan(o.GetActivityStreamsOrderedCollectionPage())
-	} else if this.IsActivityStreamsOrganization() {
-		return this.GetActivityStreamsOrganization().LessThan(o.GetActivityStreamsOrganization())
-	} else if this.IsActivityStreamsPage() {
-		return this.GetActivityStreamsPage().LessThan(o.GetActivityStreamsPage())
-	} else if this.IsActivityStreamsPerson() {
-		return this.GetActivityStreamsPerson().LessThan(o.GetActivityStreamsPerson())
-	} else if this.IsActivityStreamsPlace() {
-		return this.GetActivityStreamsPlace().LessThan(o.GetActivityStreamsPlace())
-	} else if this.IsActivityStreamsProfile() {
-		return this.GetActivityStreamsProfile().LessThan(o.GetActivityStreamsProfile())
-	} else if this.IsForgeFedPush() {
-		return this.GetForgeFedPush().LessThan(o.GetForgeFedPush())
-	} else if this.IsActivityStreamsQuestion() {
-		return this.GetActivityStreamsQuestion().LessThan(o.GetActivityStreamsQuestion())
-	} else if this.IsActivityStreamsRead() {
-		return this.GetActivityStreamsRead().LessThan(o.GetActivityStreamsRead())
-	} else if this.IsActivityStreamsReject() {
-		return this.GetActivityStreamsReject().LessThan(o.GetActivityStreamsReject())
-	} else if this.IsActivityStreamsRelationship() {
-		return this.GetActivityStreamsRelationship().LessThan(o.GetActivityStreamsRelationship())
-	} else if this.IsActivityStreamsRemove() {
-		return this.GetActivityStreamsRemove().LessThan(o.GetActivityStreamsRemove())
-	} else if this.IsForgeFedRepository() {
-		return this.GetForgeFedRepository().LessThan(o.GetForgeFedRepository())
-	} else if this.IsActivityStreamsService() {
-		return this.GetActivityStreamsService().LessThan(o.GetActivityStreamsService())
-	} else if this.IsActivityStreamsTentativeAccept() {
-		return this.GetActivityStreamsTentativeAccept().LessThan(o.GetActivityStreamsTentativeAccept())
-	} else if this.IsActivityStreamsTentativeReject() {
-		return this.GetActivityStreamsTentativeReject().LessThan(o.GetActivityStreamsTentativeReject())
-	} else if this.IsForgeFedTicket() {
-		return this.GetForgeFedTicket().LessThan(o.GetForgeFedTicket())
-	} else if this.IsForgeFedTicketDependency() {
-		return this.GetForgeFedTicketDependency().LessThan(o.GetForgeFedTicketDependency())
-	} else if this.IsActivityStreamsTombstone() {
-		return this.GetActivityStreamsTombstone().LessThan(o.GetActivityStreamsTombstone())
-	} else if this.IsActivityStreamsTravel() {
-		return this.GetActivityStreamsTravel().LessThan(o.GetActivityStreamsTravel())
-	} else if this.IsActivityStreamsUndo() {
-		return this.GetActivityStreamsUndo().LessThan(o.GetActivityStreamsUndo())
-	} else if this.IsActivityStreamsUpdate() {
-		return this.GetActivityStreamsUpdate().LessThan(o.GetActivityStreamsUpdate())
-	} else if this.IsActivityStreamsVideo() {
-		return this.GetActivityStreamsVideo().LessThan(o.GetActivityStreamsVideo())
-	} else if this.IsActivityStreamsView() {
-		return this.GetActivityStreamsView().LessThan(o.GetActivityStreamsView())
-	} else if this.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	}
-	return false
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "ForgeFedTracksTicketsFor".
@@ -2053,22 +3203,343 @@ func (this ForgeFedTracksTicketsForPropertyIterator) Name() string {
 	}
 }
 
-// Next returns the next iterator, or nil if there is no next iterator.
+// Next returns the next iterator, or nil if there is no next iterator. It remains
+// correct when values are added to or removed from the property, including
+// this one, without obtaining the iterator again.
 func (this ForgeFedTracksTicketsForPropertyIterator) Next() vocab.ForgeFedTracksTicketsForPropertyIterator {
-	if this.myIdx+1 >= this.parent.Len() {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx + 1)
+	if it := proplist.Next(&this); it != nil {
+		return it
 	}
+	return nil
 }
 
-// Prev returns the previous iterator, or nil if there is no previous iterator.
+// Prev returns the previous iterator, or nil if there is no previous iterator. It
+// remains correct when values are added to or removed from the property,
+// including this one, without obtaining the iterator again.
 func (this ForgeFedTracksTicketsForPropertyIterator) Prev() vocab.ForgeFedTracksTicketsForPropertyIterator {
-	if this.myIdx-1 < 0 {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx - 1)
+	if it := proplist.Prev(&this); it != nil {
+		return it
 	}
+	return nil
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ForgeFedTracksTicketsForPropertyIterator) Release() {
+	if r, ok := this.activitystreamsObjectMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAcceptMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsActivityMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAddMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAnnounceMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsApplicationMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsArriveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsArticleMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsAudioMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsBlockMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedBranchMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsCollectionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsCollectionPageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedCommitMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsCreateMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsDeleteMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsDislikeMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsDocumentMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.tootEmojiMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.litepubEmojiReactMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsEventMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsFlagMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsFollowMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsGroupMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.tootIdentityProofMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsIgnoreMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsImageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsIntransitiveActivityMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsInviteMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsJoinMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsLeaveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsLikeMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsListenMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsMoveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsNoteMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOfferMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOrderedCollectionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOrderedCollectionPageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsOrganizationMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsPageMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsPersonMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsPlaceMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsProfileMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.schemaPropertyValueMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedPushMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsQuestionMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsReadMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsRejectMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsRelationshipMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsRemoveMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedRepositoryMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsServiceMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTentativeAcceptMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTentativeRejectMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedTicketMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.forgefedTicketDependencyMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTombstoneMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsTravelMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsUndoMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsUpdateMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsVideoMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.activitystreamsViewMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	*this = ForgeFedTracksTicketsForPropertyIterator{}
+	poolForgeFedTracksTicketsForPropertyIterator.Put(this)
 }
 
 // SetActivityStreamsAccept sets the value of this property. Calling
@@ -2483,6 +3954,20 @@ func (this *ForgeFedTracksTicketsForPropertyIterator) SetIRI(v *url.URL) {
 	this.iri = v
 }
 
+// SetLitepubEmojiReact sets the value of this property. Calling
+// IsLitepubEmojiReact afterwards returns true.
+func (this *ForgeFedTracksTicketsForPropertyIterator) SetLitepubEmojiReact(v vocab.LitepubEmojiReact) {
+	this.clear()
+	this.litepubEmojiReactMember = v
+}
+
+// SetSchemaPropertyValue sets the value of this property. Calling
+// IsSchemaPropertyValue afterwards returns true.
+func (this *ForgeFedTracksTicketsForPropertyIterator) SetSchemaPropertyValue(v vocab.SchemaPropertyValue) {
+	this.clear()
+	this.schemaPropertyValueMember = v
+}
+
 // SetTootEmoji sets the value of this property. Calling IsTootEmoji afterwards
 // returns true.
 func (this *ForgeFedTracksTicketsForPropertyIterator) SetTootEmoji(v vocab.TootEmoji) {
@@ -2576,6 +4061,10 @@ func (this *ForgeFedTracksTicketsForPropertyIterator) SetType(t vocab.Type) erro
 		this.SetTootEmoji(v)
 		return nil
 	}
+	if v, ok := t.(vocab.LitepubEmojiReact); ok {
+		this.SetLitepubEmojiReact(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsEvent); ok {
 		this.SetActivityStreamsEvent(v)
 		return nil
@@ -2668,6 +4157,10 @@ func (this *ForgeFedTracksTicketsForPropertyIterator) SetType(t vocab.Type) erro
 		this.SetActivityStreamsProfile(v)
 		return nil
 	}
+	if v, ok := t.(vocab.SchemaPropertyValue); ok {
+		this.SetSchemaPropertyValue(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ForgeFedPush); ok {
 		this.SetForgeFedPush(v)
 		return nil
@@ -2766,6 +4259,7 @@ func (this *ForgeFedTracksTicketsForPropertyIterator) clear() {
 	this.activitystreamsDislikeMember = nil
 	this.activitystreamsDocumentMember = nil
 	this.tootEmojiMember = nil
+	this.litepubEmojiReactMember = nil
 	this.activitystreamsEventMember = nil
 	this.activitystreamsFlagMember = nil
 	this.activitystreamsFollowMember = nil
@@ -2789,6 +4283,7 @@ func (this *ForgeFedTracksTicketsForPropertyIterator) clear() {
 	this.activitystreamsPersonMember = nil
 	this.activitystreamsPlaceMember = nil
 	this.activitystreamsProfileMember = nil
+	this.schemaPropertyValueMember = nil
 	this.forgefedPushMember = nil
 	this.activitystreamsQuestionMember = nil
 	this.activitystreamsReadMember = nil
@@ -2854,6 +4349,8 @@ func (this ForgeFedTracksTicketsForPropertyIterator) serialize() (interface{}, e
 		return this.GetActivityStreamsDocument().Serialize()
 	} else if this.IsTootEmoji() {
 		return this.GetTootEmoji().Serialize()
+	} else if this.IsLitepubEmojiReact() {
+		return this.GetLitepubEmojiReact().Serialize()
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().Serialize()
 	} else if this.IsActivityStreamsFlag() {
@@ -2900,6 +4397,8 @@ func (this ForgeFedTracksTicketsForPropertyIterator) serialize() (interface{}, e
 		return this.GetActivityStreamsPlace().Serialize()
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().Serialize()
+	} else if this.IsSchemaPropertyValue() {
+		return this.GetSchemaPropertyValue().Serialize()
 	} else if this.IsForgeFedPush() {
 		return this.GetForgeFedPush().Serialize()
 	} else if this.IsActivityStreamsQuestion() {
@@ -2946,7 +4445,7 @@ func (this ForgeFedTracksTicketsForPropertyIterator) serialize() (interface{}, e
 // "tracksTicketsFor". It is permitted to have one or more values, and of
 // different value types.
 type ForgeFedTracksTicketsForProperty struct {
-	properties []*ForgeFedTracksTicketsForPropertyIterator
+	properties proplist.List[*ForgeFedTracksTicketsForPropertyIterator]
 	alias      string
 }
 
@@ -2965,10 +4464,9 @@ func DeserializeTracksTicketsForProperty(m map[string]interface{}, aliasMap map[
 	i, ok := m[propName]
 
 	if ok {
-		this := &ForgeFedTracksTicketsForProperty{
-			alias:      alias,
-			properties: []*ForgeFedTracksTicketsForPropertyIterator{},
-		}
+		// A released value keeps its properties for reuse.
+		this := poolForgeFedTracksTicketsForProperty.Get().(*ForgeFedTracksTicketsForProperty)
+		this.alias = alias
 		if list, ok := i.([]interface{}); ok {
 			for _, iterator := range list {
 				if p, err := deserializeForgeFedTracksTicketsForPropertyIterator(iterator, aliasMap); err != nil {
@@ -2985,10 +4483,7 @@ func DeserializeTracksTicketsForProperty(m map[string]interface{}, aliasMap map[
 			}
 		}
 		// Set up the properties for iteration.
-		for idx, ele := range this.properties {
-			ele.parent = this
-			ele.myIdx = idx
-		}
+		this.properties.Link()
 		return this, nil
 	}
 	return nil, nil
@@ -2999,749 +4494,593 @@ func NewForgeFedTracksTicketsForProperty() *ForgeFedTracksTicketsForProperty {
 	return &ForgeFedTracksTicketsForProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and each of its values.
+func (this ForgeFedTracksTicketsForProperty) AddJSONLDContext(m map[string]string) {
+	m["https://forgefed.peers.community/ns"] = this.alias
+	for _, elem := range this.properties {
+		elem.AddJSONLDContext(m)
+	}
+}
+
 // AppendActivityStreamsAccept appends a Accept value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsAccept(v vocab.ActivityStreamsAccept) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAcceptMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsActivity appends a Activity value to the back of a list of
-// the property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsActivity(v vocab.ActivityStreamsActivity) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsActivityMember: v,
 		alias:                         this.alias,
-		myIdx:                         this.Len(),
-		parent:                        this,
 	})
 }
 
 // AppendActivityStreamsAdd appends a Add value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsAdd(v vocab.ActivityStreamsAdd) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAddMember: v,
 		alias:                    this.alias,
-		myIdx:                    this.Len(),
-		parent:                   this,
 	})
 }
 
 // AppendActivityStreamsAnnounce appends a Announce value to the back of a list of
-// the property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsAnnounce(v vocab.ActivityStreamsAnnounce) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAnnounceMember: v,
 		alias:                         this.alias,
-		myIdx:                         this.Len(),
-		parent:                        this,
 	})
 }
 
 // AppendActivityStreamsApplication appends a Application value to the back of a
-// list of the property "tracksTicketsFor". Invalidates iterators that are
-// traversing using Prev.
+// list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsApplication(v vocab.ActivityStreamsApplication) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsApplicationMember: v,
 		alias:                            this.alias,
-		myIdx:                            this.Len(),
-		parent:                           this,
 	})
 }
 
 // AppendActivityStreamsArrive appends a Arrive value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsArrive(v vocab.ActivityStreamsArrive) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsArriveMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsArticle appends a Article value to the back of a list of
-// the property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsArticle(v vocab.ActivityStreamsArticle) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsArticleMember: v,
 		alias:                        this.alias,
-		myIdx:                        this.Len(),
-		parent:                       this,
 	})
 }
 
 // AppendActivityStreamsAudio appends a Audio value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsAudio(v vocab.ActivityStreamsAudio) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAudioMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsBlock appends a Block value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsBlock(v vocab.ActivityStreamsBlock) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsBlockMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsCollection appends a Collection value to the back of a
-// list of the property "tracksTicketsFor". Invalidates iterators that are
-// traversing using Prev.
+// list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsCollection(v vocab.ActivityStreamsCollection) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCollectionMember: v,
 		alias:                           this.alias,
-		myIdx:                           this.Len(),
-		parent:                          this,
 	})
 }
 
 // AppendActivityStreamsCollectionPage appends a CollectionPage value to the back
-// of a list of the property "tracksTicketsFor". Invalidates iterators that
-// are traversing using Prev.
+// of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsCollectionPage(v vocab.ActivityStreamsCollectionPage) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCollectionPageMember: v,
 		alias:                               this.alias,
-		myIdx:                               this.Len(),
-		parent:                              this,
 	})
 }
 
 // AppendActivityStreamsCreate appends a Create value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsCreate(v vocab.ActivityStreamsCreate) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCreateMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsDelete appends a Delete value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsDelete(v vocab.ActivityStreamsDelete) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDeleteMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsDislike appends a Dislike value to the back of a list of
-// the property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsDislike(v vocab.ActivityStreamsDislike) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDislikeMember: v,
 		alias:                        this.alias,
-		myIdx:                        this.Len(),
-		parent:                       this,
 	})
 }
 
 // AppendActivityStreamsDocument appends a Document value to the back of a list of
-// the property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsDocument(v vocab.ActivityStreamsDocument) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDocumentMember: v,
 		alias:                         this.alias,
-		myIdx:                         this.Len(),
-		parent:                        this,
 	})
 }
 
 // AppendActivityStreamsEvent appends a Event value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsEventMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsFlag appends a Flag value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsFlag(v vocab.ActivityStreamsFlag) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsFlagMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsFollow appends a Follow value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsFollow(v vocab.ActivityStreamsFollow) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsFollowMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsGroup appends a Group value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsGroup(v vocab.ActivityStreamsGroup) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsGroupMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsIgnore appends a Ignore value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsIgnoreMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsImage appends a Image value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsImage(v vocab.ActivityStreamsImage) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsImageMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsIntransitiveActivity appends a IntransitiveActivity value
-// to the back of a list of the property "tracksTicketsFor". Invalidates
-// iterators that are traversing using Prev.
+// to the back of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsIntransitiveActivity(v vocab.ActivityStreamsIntransitiveActivity) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsIntransitiveActivityMember: v,
-		alias:  this.alias,
-		myIdx:  this.Len(),
-		parent: this,
+		alias: this.alias,
 	})
 }
 
 // AppendActivityStreamsInvite appends a Invite value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsInvite(v vocab.ActivityStreamsInvite) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsInviteMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsJoin appends a Join value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsJoin(v vocab.ActivityStreamsJoin) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsJoinMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsLeave appends a Leave value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsLeave(v vocab.ActivityStreamsLeave) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsLeaveMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsLike appends a Like value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsLike(v vocab.ActivityStreamsLike) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsLikeMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsListen appends a Listen value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsListen(v vocab.ActivityStreamsListen) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsListenMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsMove appends a Move value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsMove(v vocab.ActivityStreamsMove) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsMoveMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsNote appends a Note value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsNote(v vocab.ActivityStreamsNote) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsNoteMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsObject appends a Object value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsObject(v vocab.ActivityStreamsObject) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsObjectMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsOffer appends a Offer value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsOffer(v vocab.ActivityStreamsOffer) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOfferMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsOrderedCollection appends a OrderedCollection value to the
-// back of a list of the property "tracksTicketsFor". Invalidates iterators
-// that are traversing using Prev.
+// back of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsOrderedCollection(v vocab.ActivityStreamsOrderedCollection) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrderedCollectionMember: v,
 		alias:                                  this.alias,
-		myIdx:                                  this.Len(),
-		parent:                                 this,
 	})
 }
 
 // AppendActivityStreamsOrderedCollectionPage appends a OrderedCollectionPage
-// value to the back of a list of the property "tracksTicketsFor". Invalidates
-// iterators that are traversing using Prev.
+// value to the back of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsOrderedCollectionPage(v vocab.ActivityStreamsOrderedCollectionPage) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrderedCollectionPageMember: v,
-		alias:  this.alias,
-		myIdx:  this.Len(),
-		parent: this,
+		alias: this.alias,
 	})
 }
 
 // AppendActivityStreamsOrganization appends a Organization value to the back of a
-// list of the property "tracksTicketsFor". Invalidates iterators that are
-// traversing using Prev.
+// list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsOrganization(v vocab.ActivityStreamsOrganization) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrganizationMember: v,
 		alias:                             this.alias,
-		myIdx:                             this.Len(),
-		parent:                            this,
 	})
 }
 
 // AppendActivityStreamsPage appends a Page value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsPage(v vocab.ActivityStreamsPage) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPageMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsPerson appends a Person value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsPerson(v vocab.ActivityStreamsPerson) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPersonMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsPlace appends a Place value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsPlace(v vocab.ActivityStreamsPlace) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPlaceMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsProfile appends a Profile value to the back of a list of
-// the property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsProfile(v vocab.ActivityStreamsProfile) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsProfileMember: v,
 		alias:                        this.alias,
-		myIdx:                        this.Len(),
-		parent:                       this,
 	})
 }
 
 // AppendActivityStreamsQuestion appends a Question value to the back of a list of
-// the property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsQuestionMember: v,
 		alias:                         this.alias,
-		myIdx:                         this.Len(),
-		parent:                        this,
 	})
 }
 
 // AppendActivityStreamsRead appends a Read value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsRead(v vocab.ActivityStreamsRead) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsReadMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsReject appends a Reject value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsReject(v vocab.ActivityStreamsReject) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRejectMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsRelationship appends a Relationship value to the back of a
-// list of the property "tracksTicketsFor". Invalidates iterators that are
-// traversing using Prev.
+// list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsRelationship(v vocab.ActivityStreamsRelationship) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRelationshipMember: v,
 		alias:                             this.alias,
-		myIdx:                             this.Len(),
-		parent:                            this,
 	})
 }
 
 // AppendActivityStreamsRemove appends a Remove value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsRemove(v vocab.ActivityStreamsRemove) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRemoveMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsService appends a Service value to the back of a list of
-// the property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsService(v vocab.ActivityStreamsService) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsServiceMember: v,
 		alias:                        this.alias,
-		myIdx:                        this.Len(),
-		parent:                       this,
 	})
 }
 
 // AppendActivityStreamsTentativeAccept appends a TentativeAccept value to the
-// back of a list of the property "tracksTicketsFor". Invalidates iterators
-// that are traversing using Prev.
+// back of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsTentativeAccept(v vocab.ActivityStreamsTentativeAccept) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTentativeAcceptMember: v,
 		alias:                                this.alias,
-		myIdx:                                this.Len(),
-		parent:                               this,
 	})
 }
 
 // AppendActivityStreamsTentativeReject appends a TentativeReject value to the
-// back of a list of the property "tracksTicketsFor". Invalidates iterators
-// that are traversing using Prev.
+// back of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsTentativeReject(v vocab.ActivityStreamsTentativeReject) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTentativeRejectMember: v,
 		alias:                                this.alias,
-		myIdx:                                this.Len(),
-		parent:                               this,
 	})
 }
 
 // AppendActivityStreamsTombstone appends a Tombstone value to the back of a list
-// of the property "tracksTicketsFor". Invalidates iterators that are
-// traversing using Prev.
+// of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsTombstone(v vocab.ActivityStreamsTombstone) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTombstoneMember: v,
 		alias:                          this.alias,
-		myIdx:                          this.Len(),
-		parent:                         this,
 	})
 }
 
 // AppendActivityStreamsTravel appends a Travel value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsTravel(v vocab.ActivityStreamsTravel) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTravelMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsUndo appends a Undo value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsUndo(v vocab.ActivityStreamsUndo) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsUndoMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendActivityStreamsUpdate appends a Update value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsUpdate(v vocab.ActivityStreamsUpdate) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsUpdateMember: v,
 		alias:                       this.alias,
-		myIdx:                       this.Len(),
-		parent:                      this,
 	})
 }
 
 // AppendActivityStreamsVideo appends a Video value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsVideo(v vocab.ActivityStreamsVideo) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsVideoMember: v,
 		alias:                      this.alias,
-		myIdx:                      this.Len(),
-		parent:                     this,
 	})
 }
 
 // AppendActivityStreamsView appends a View value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendActivityStreamsView(v vocab.ActivityStreamsView) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsViewMember: v,
 		alias:                     this.alias,
-		myIdx:                     this.Len(),
-		parent:                    this,
 	})
 }
 
 // AppendForgeFedBranch appends a Branch value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendForgeFedBranch(v vocab.ForgeFedBranch) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedBranchMember: v,
-		myIdx:                this.Len(),
-		parent:               this,
 	})
 }
 
 // AppendForgeFedCommit appends a Commit value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendForgeFedCommit(v vocab.ForgeFedCommit) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedCommitMember: v,
-		myIdx:                this.Len(),
-		parent:               this,
 	})
 }
 
 // AppendForgeFedPush appends a Push value to the back of a list of the property
-// "tracksTicketsFor". Invalidates iterators that are traversing using Prev.
+// "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendForgeFedPush(v vocab.ForgeFedPush) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:              this.alias,
 		forgefedPushMember: v,
-		myIdx:              this.Len(),
-		parent:             this,
 	})
 }
 
 // AppendForgeFedRepository appends a Repository value to the back of a list of
-// the property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendForgeFedRepository(v vocab.ForgeFedRepository) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                    this.alias,
 		forgefedRepositoryMember: v,
-		myIdx:                    this.Len(),
-		parent:                   this,
 	})
 }
 
 // AppendForgeFedTicket appends a Ticket value to the back of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendForgeFedTicket(v vocab.ForgeFedTicket) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                this.Len(),
-		parent:               this,
 	})
 }
 
 // AppendForgeFedTicketDependency appends a TicketDependency value to the back of
-// a list of the property "tracksTicketsFor". Invalidates iterators that are
-// traversing using Prev.
+// a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                          this.alias,
 		forgefedTicketDependencyMember: v,
-		myIdx:                          this.Len(),
-		parent:                         this,
 	})
 }
 
 // AppendIRI appends an IRI value to the back of a list of the property
 // "tracksTicketsFor"
 func (this *ForgeFedTracksTicketsForProperty) AppendIRI(v *url.URL) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  this.Len(),
-		parent: this,
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// AppendLitepubEmojiReact appends a EmojiReact value to the back of a list of the
+// property "tracksTicketsFor".
+func (this *ForgeFedTracksTicketsForProperty) AppendLitepubEmojiReact(v vocab.LitepubEmojiReact) {
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
+		alias:                   this.alias,
+		litepubEmojiReactMember: v,
+	})
+}
+
+// AppendSchemaPropertyValue appends a PropertyValue value to the back of a list
+// of the property "tracksTicketsFor".
+func (this *ForgeFedTracksTicketsForProperty) AppendSchemaPropertyValue(v vocab.SchemaPropertyValue) {
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
+		alias:                     this.alias,
+		schemaPropertyValueMember: v,
 	})
 }
 
 // AppendTootEmoji appends a Emoji value to the back of a list of the property
-// "tracksTicketsFor". Invalidates iterators that are traversing using Prev.
+// "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendTootEmoji(v vocab.TootEmoji) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:           this.alias,
-		myIdx:           this.Len(),
-		parent:          this,
 		tootEmojiMember: v,
 	})
 }
 
 // AppendTootIdentityProof appends a IdentityProof value to the back of a list of
-// the property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) AppendTootIdentityProof(v vocab.TootIdentityProof) {
-	this.properties = append(this.properties, &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Append(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                   this.alias,
-		myIdx:                   this.Len(),
-		parent:                  this,
 		tootIdentityProofMember: v,
 	})
 }
 
-// PrependType prepends an arbitrary type value to the front of a list of the
-// property "tracksTicketsFor". Invalidates iterators that are traversing
-// using Prev. Returns an error if the type is not a valid one to set for this
-// property.
+// AppendType appends an arbitrary type value to the back of a list of the
+// property "tracksTicketsFor". Returns an error if the type is not a valid
+// one to set for this property.
 func (this *ForgeFedTracksTicketsForProperty) AppendType(t vocab.Type) error {
-	n := &ForgeFedTracksTicketsForPropertyIterator{
-		alias:  this.alias,
-		myIdx:  this.Len(),
-		parent: this,
-	}
+	n := &ForgeFedTracksTicketsForPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append(this.properties, n)
+	this.properties.Append(n)
 	return nil
 }
 
@@ -3762,6 +5101,31 @@ func (this ForgeFedTracksTicketsForProperty) Begin() vocab.ForgeFedTracksTickets
 	}
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this ForgeFedTracksTicketsForProperty) Compare(o vocab.ForgeFedTracksTicketsForProperty) int {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if c := this.properties[i].Compare(o.At(i)); c != 0 {
+			return c
+		}
+	}
+	if l1 < l2 {
+		return -1
+	} else if l1 > l2 {
+		return 1
+	}
+	return 0
+}
+
 // Empty returns returns true if there are no elements.
 func (this ForgeFedTracksTicketsForProperty) Empty() bool {
 	return this.Len() == 0
@@ -3776,1060 +5140,644 @@ func (this ForgeFedTracksTicketsForProperty) End() vocab.ForgeFedTracksTicketsFo
 
 // InsertActivityStreamsAccept inserts a Accept value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsAccept(idx int, v vocab.ActivityStreamsAccept) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAcceptMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsActivity inserts a Activity value at the specified index
 // for a property "tracksTicketsFor". Existing elements at that index and
-// higher are shifted back once. Invalidates all iterators.
+// higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsActivity(idx int, v vocab.ActivityStreamsActivity) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsActivityMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsAdd inserts a Add value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsAdd(idx int, v vocab.ActivityStreamsAdd) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAddMember: v,
 		alias:                    this.alias,
-		myIdx:                    idx,
-		parent:                   this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsAnnounce inserts a Announce value at the specified index
 // for a property "tracksTicketsFor". Existing elements at that index and
-// higher are shifted back once. Invalidates all iterators.
+// higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsAnnounce(idx int, v vocab.ActivityStreamsAnnounce) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAnnounceMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsApplication inserts a Application value at the specified
 // index for a property "tracksTicketsFor". Existing elements at that index
-// and higher are shifted back once. Invalidates all iterators.
+// and higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsApplication(idx int, v vocab.ActivityStreamsApplication) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsApplicationMember: v,
 		alias:                            this.alias,
-		myIdx:                            idx,
-		parent:                           this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsArrive inserts a Arrive value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsArrive(idx int, v vocab.ActivityStreamsArrive) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsArriveMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsArticle inserts a Article value at the specified index for
 // a property "tracksTicketsFor". Existing elements at that index and higher
-// are shifted back once. Invalidates all iterators.
+// are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsArticle(idx int, v vocab.ActivityStreamsArticle) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsArticleMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsAudio inserts a Audio value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsAudio(idx int, v vocab.ActivityStreamsAudio) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAudioMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsBlock inserts a Block value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsBlock(idx int, v vocab.ActivityStreamsBlock) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsBlockMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsCollection inserts a Collection value at the specified
 // index for a property "tracksTicketsFor". Existing elements at that index
-// and higher are shifted back once. Invalidates all iterators.
+// and higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsCollection(idx int, v vocab.ActivityStreamsCollection) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCollectionMember: v,
 		alias:                           this.alias,
-		myIdx:                           idx,
-		parent:                          this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsCollectionPage inserts a CollectionPage value at the
 // specified index for a property "tracksTicketsFor". Existing elements at
-// that index and higher are shifted back once. Invalidates all iterators.
+// that index and higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsCollectionPage(idx int, v vocab.ActivityStreamsCollectionPage) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCollectionPageMember: v,
 		alias:                               this.alias,
-		myIdx:                               idx,
-		parent:                              this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsCreate inserts a Create value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsCreate(idx int, v vocab.ActivityStreamsCreate) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCreateMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsDelete inserts a Delete value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsDelete(idx int, v vocab.ActivityStreamsDelete) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDeleteMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsDislike inserts a Dislike value at the specified index for
 // a property "tracksTicketsFor". Existing elements at that index and higher
-// are shifted back once. Invalidates all iterators.
+// are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsDislike(idx int, v vocab.ActivityStreamsDislike) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDislikeMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsDocument inserts a Document value at the specified index
 // for a property "tracksTicketsFor". Existing elements at that index and
-// higher are shifted back once. Invalidates all iterators.
+// higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsDocument(idx int, v vocab.ActivityStreamsDocument) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDocumentMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsEvent inserts a Event value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsEvent(idx int, v vocab.ActivityStreamsEvent) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsEventMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsFlag inserts a Flag value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsFlag(idx int, v vocab.ActivityStreamsFlag) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsFlagMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsFollow inserts a Follow value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsFollow(idx int, v vocab.ActivityStreamsFollow) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsFollowMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsGroup inserts a Group value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsGroup(idx int, v vocab.ActivityStreamsGroup) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsGroupMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsIgnore inserts a Ignore value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsIgnore(idx int, v vocab.ActivityStreamsIgnore) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsIgnoreMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsImage inserts a Image value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsImage(idx int, v vocab.ActivityStreamsImage) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsImageMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsIntransitiveActivity inserts a IntransitiveActivity value
 // at the specified index for a property "tracksTicketsFor". Existing elements
-// at that index and higher are shifted back once. Invalidates all iterators.
+// at that index and higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsIntransitiveActivity(idx int, v vocab.ActivityStreamsIntransitiveActivity) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsIntransitiveActivityMember: v,
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+		alias: this.alias,
+	})
 }
 
 // InsertActivityStreamsInvite inserts a Invite value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsInvite(idx int, v vocab.ActivityStreamsInvite) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsInviteMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsJoin inserts a Join value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsJoin(idx int, v vocab.ActivityStreamsJoin) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsJoinMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsLeave inserts a Leave value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsLeave(idx int, v vocab.ActivityStreamsLeave) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsLeaveMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsLike inserts a Like value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsLike(idx int, v vocab.ActivityStreamsLike) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsLikeMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsListen inserts a Listen value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsListen(idx int, v vocab.ActivityStreamsListen) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsListenMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsMove inserts a Move value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsMove(idx int, v vocab.ActivityStreamsMove) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsMoveMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsNote inserts a Note value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsNote(idx int, v vocab.ActivityStreamsNote) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsNoteMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsObject inserts a Object value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsObject(idx int, v vocab.ActivityStreamsObject) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsObjectMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsOffer inserts a Offer value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsOffer(idx int, v vocab.ActivityStreamsOffer) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOfferMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsOrderedCollection inserts a OrderedCollection value at the
 // specified index for a property "tracksTicketsFor". Existing elements at
-// that index and higher are shifted back once. Invalidates all iterators.
+// that index and higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsOrderedCollection(idx int, v vocab.ActivityStreamsOrderedCollection) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrderedCollectionMember: v,
 		alias:                                  this.alias,
-		myIdx:                                  idx,
-		parent:                                 this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsOrderedCollectionPage inserts a OrderedCollectionPage
 // value at the specified index for a property "tracksTicketsFor". Existing
-// elements at that index and higher are shifted back once. Invalidates all
-// iterators.
+// elements at that index and higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsOrderedCollectionPage(idx int, v vocab.ActivityStreamsOrderedCollectionPage) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrderedCollectionPageMember: v,
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+		alias: this.alias,
+	})
 }
 
 // InsertActivityStreamsOrganization inserts a Organization value at the specified
 // index for a property "tracksTicketsFor". Existing elements at that index
-// and higher are shifted back once. Invalidates all iterators.
+// and higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsOrganization(idx int, v vocab.ActivityStreamsOrganization) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrganizationMember: v,
 		alias:                             this.alias,
-		myIdx:                             idx,
-		parent:                            this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsPage inserts a Page value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsPage(idx int, v vocab.ActivityStreamsPage) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPageMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsPerson inserts a Person value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsPerson(idx int, v vocab.ActivityStreamsPerson) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPersonMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsPlace inserts a Place value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsPlace(idx int, v vocab.ActivityStreamsPlace) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPlaceMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsProfile inserts a Profile value at the specified index for
 // a property "tracksTicketsFor". Existing elements at that index and higher
-// are shifted back once. Invalidates all iterators.
+// are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsProfile(idx int, v vocab.ActivityStreamsProfile) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsProfileMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsQuestion inserts a Question value at the specified index
 // for a property "tracksTicketsFor". Existing elements at that index and
-// higher are shifted back once. Invalidates all iterators.
+// higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsQuestion(idx int, v vocab.ActivityStreamsQuestion) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsQuestionMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsRead inserts a Read value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsRead(idx int, v vocab.ActivityStreamsRead) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsReadMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsReject inserts a Reject value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsReject(idx int, v vocab.ActivityStreamsReject) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRejectMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsRelationship inserts a Relationship value at the specified
 // index for a property "tracksTicketsFor". Existing elements at that index
-// and higher are shifted back once. Invalidates all iterators.
+// and higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsRelationship(idx int, v vocab.ActivityStreamsRelationship) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRelationshipMember: v,
 		alias:                             this.alias,
-		myIdx:                             idx,
-		parent:                            this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsRemove inserts a Remove value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsRemove(idx int, v vocab.ActivityStreamsRemove) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRemoveMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsService inserts a Service value at the specified index for
 // a property "tracksTicketsFor". Existing elements at that index and higher
-// are shifted back once. Invalidates all iterators.
+// are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsService(idx int, v vocab.ActivityStreamsService) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsServiceMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsTentativeAccept inserts a TentativeAccept value at the
 // specified index for a property "tracksTicketsFor". Existing elements at
-// that index and higher are shifted back once. Invalidates all iterators.
+// that index and higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsTentativeAccept(idx int, v vocab.ActivityStreamsTentativeAccept) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTentativeAcceptMember: v,
 		alias:                                this.alias,
-		myIdx:                                idx,
-		parent:                               this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsTentativeReject inserts a TentativeReject value at the
 // specified index for a property "tracksTicketsFor". Existing elements at
-// that index and higher are shifted back once. Invalidates all iterators.
+// that index and higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsTentativeReject(idx int, v vocab.ActivityStreamsTentativeReject) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTentativeRejectMember: v,
 		alias:                                this.alias,
-		myIdx:                                idx,
-		parent:                               this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsTombstone inserts a Tombstone value at the specified index
 // for a property "tracksTicketsFor". Existing elements at that index and
-// higher are shifted back once. Invalidates all iterators.
+// higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsTombstone(idx int, v vocab.ActivityStreamsTombstone) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTombstoneMember: v,
 		alias:                          this.alias,
-		myIdx:                          idx,
-		parent:                         this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsTravel inserts a Travel value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsTravel(idx int, v vocab.ActivityStreamsTravel) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTravelMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsUndo inserts a Undo value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsUndo(idx int, v vocab.ActivityStreamsUndo) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsUndoMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsUpdate inserts a Update value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsUpdate(idx int, v vocab.ActivityStreamsUpdate) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsUpdateMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsVideo inserts a Video value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsVideo(idx int, v vocab.ActivityStreamsVideo) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsVideoMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertActivityStreamsView inserts a View value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertActivityStreamsView(idx int, v vocab.ActivityStreamsView) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsViewMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedBranch inserts a Branch value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertForgeFedBranch(idx int, v vocab.ForgeFedBranch) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedBranchMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedCommit inserts a Commit value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertForgeFedCommit(idx int, v vocab.ForgeFedCommit) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedCommitMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedPush inserts a Push value at the specified index for a property
 // "tracksTicketsFor". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertForgeFedPush(idx int, v vocab.ForgeFedPush) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:              this.alias,
 		forgefedPushMember: v,
-		myIdx:              idx,
-		parent:             this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedRepository inserts a Repository value at the specified index for
 // a property "tracksTicketsFor". Existing elements at that index and higher
-// are shifted back once. Invalidates all iterators.
+// are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertForgeFedRepository(idx int, v vocab.ForgeFedRepository) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                    this.alias,
 		forgefedRepositoryMember: v,
-		myIdx:                    idx,
-		parent:                   this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedTicket inserts a Ticket value at the specified index for a
 // property "tracksTicketsFor". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertForgeFedTicket(idx int, v vocab.ForgeFedTicket) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertForgeFedTicketDependency inserts a TicketDependency value at the
 // specified index for a property "tracksTicketsFor". Existing elements at
-// that index and higher are shifted back once. Invalidates all iterators.
+// that index and higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertForgeFedTicketDependency(idx int, v vocab.ForgeFedTicketDependency) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                          this.alias,
 		forgefedTicketDependencyMember: v,
-		myIdx:                          idx,
-		parent:                         this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // Insert inserts an IRI value at the specified index for a property
 // "tracksTicketsFor". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertIRI(idx int, v *url.URL) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// InsertLitepubEmojiReact inserts a EmojiReact value at the specified index for a
+// property "tracksTicketsFor". Existing elements at that index and higher are
+// shifted back once.
+func (this *ForgeFedTracksTicketsForProperty) InsertLitepubEmojiReact(idx int, v vocab.LitepubEmojiReact) {
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
+		alias:                   this.alias,
+		litepubEmojiReactMember: v,
+	})
+}
+
+// InsertSchemaPropertyValue inserts a PropertyValue value at the specified index
+// for a property "tracksTicketsFor". Existing elements at that index and
+// higher are shifted back once.
+func (this *ForgeFedTracksTicketsForProperty) InsertSchemaPropertyValue(idx int, v vocab.SchemaPropertyValue) {
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
+		alias:                     this.alias,
+		schemaPropertyValueMember: v,
+	})
 }
 
 // InsertTootEmoji inserts a Emoji value at the specified index for a property
 // "tracksTicketsFor". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertTootEmoji(idx int, v vocab.TootEmoji) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:           this.alias,
-		myIdx:           idx,
-		parent:          this,
 		tootEmojiMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertTootIdentityProof inserts a IdentityProof value at the specified index
 // for a property "tracksTicketsFor". Existing elements at that index and
-// higher are shifted back once. Invalidates all iterators.
+// higher are shifted back once.
 func (this *ForgeFedTracksTicketsForProperty) InsertTootIdentityProof(idx int, v vocab.TootIdentityProof) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Insert(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                   this.alias,
-		myIdx:                   idx,
-		parent:                  this,
 		tootIdentityProofMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
-// PrependType prepends an arbitrary type value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators. Returns an error if
-// the type is not a valid one to set for this property.
+// InsertType inserts an arbitrary type value at the specified index for the
+// property "tracksTicketsFor". Existing elements at that index and higher are
+// shifted back once. Returns an error if the type is not a valid one to set
+// for this property.
 func (this *ForgeFedTracksTicketsForProperty) InsertType(idx int, t vocab.Type) error {
-	n := &ForgeFedTracksTicketsForPropertyIterator{
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+	n := &ForgeFedTracksTicketsForPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = n
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, n)
 	return nil
 }
 
@@ -4837,18 +5785,8 @@ func (this *ForgeFedTracksTicketsForProperty) InsertType(idx int, t vocab.Type)
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this ForgeFedTracksTicketsForProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://forgefed.peers.community/ns": this.alias}
-	for _, elem := range this.properties {
-		child := elem.JSONLDContext()
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		for k, v := range child {
-			m[k] = v
-		}
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -4862,7 +5800,7 @@ func (this ForgeFedTracksTicketsForProperty) KindIndex(idx int) int {
 
 // Len returns the number of values that exist for the "tracksTicketsFor" property.
 func (this ForgeFedTracksTicketsForProperty) Len() (length int) {
-	return len(this.properties)
+	return this.properties.Len()
 }
 
 // Less computes whether another property is less than this one. Mixing types
@@ -4950,166 +5888,174 @@ func (this ForgeFedTracksTicketsForProperty) Less(i, j int) bool {
 			rhs := this.properties[j].GetTootEmoji()
 			return lhs.LessThan(rhs)
 		} else if idx1 == 19 {
+			lhs := this.properties[i].GetLitepubEmojiReact()
+			rhs := this.properties[j].GetLitepubEmojiReact()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 20 {
 			lhs := this.properties[i].GetActivityStreamsEvent()
 			rhs := this.properties[j].GetActivityStreamsEvent()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 20 {
+		} else if idx1 == 21 {
 			lhs := this.properties[i].GetActivityStreamsFlag()
 			rhs := this.properties[j].GetActivityStreamsFlag()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 21 {
+		} else if idx1 == 22 {
 			lhs := this.properties[i].GetActivityStreamsFollow()
 			rhs := this.properties[j].GetActivityStreamsFollow()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 22 {
+		} else if idx1 == 23 {
 			lhs := this.properties[i].GetActivityStreamsGroup()
 			rhs := this.properties[j].GetActivityStreamsGroup()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 23 {
+		} else if idx1 == 24 {
 			lhs := this.properties[i].GetTootIdentityProof()
 			rhs := this.properties[j].GetTootIdentityProof()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 24 {
+		} else if idx1 == 25 {
 			lhs := this.properties[i].GetActivityStreamsIgnore()
 			rhs := this.properties[j].GetActivityStreamsIgnore()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 25 {
+		} else if idx1 == 26 {
 			lhs := this.properties[i].GetActivityStreamsImage()
 			rhs := this.properties[j].GetActivityStreamsImage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 26 {
+		} else if idx1 == 27 {
 			lhs := this.properties[i].GetActivityStreamsIntransitiveActivity()
 			rhs := this.properties[j].GetActivityStreamsIntransitiveActivity()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 27 {
+		} else if idx1 == 28 {
 			lhs := this.properties[i].GetActivityStreamsInvite()
 			rhs := this.properties[j].GetActivityStreamsInvite()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 28 {
+		} else if idx1 == 29 {
 			lhs := this.properties[i].GetActivityStreamsJoin()
 			rhs := this.properties[j].GetActivityStreamsJoin()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 29 {
+		} else if idx1 == 30 {
 			lhs := this.properties[i].GetActivityStreamsLeave()
 			rhs := this.properties[j].GetActivityStreamsLeave()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 30 {
+		} else if idx1 == 31 {
 			lhs := this.properties[i].GetActivityStreamsLike()
 			rhs := this.properties[j].GetActivityStreamsLike()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 31 {
+		} else if idx1 == 32 {
 			lhs := this.properties[i].GetActivityStreamsListen()
 			rhs := this.properties[j].GetActivityStreamsListen()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 32 {
+		} else if idx1 == 33 {
 			lhs := this.properties[i].GetActivityStreamsMove()
 			rhs := this.properties[j].GetActivityStreamsMove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 33 {
+		} else if idx1 == 34 {
 			lhs := this.properties[i].GetActivityStreamsNote()
 			rhs := this.properties[j].GetActivityStreamsNote()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 34 {
+		} else if idx1 == 35 {
 			lhs := this.properties[i].GetActivityStreamsOffer()
 			rhs := this.properties[j].GetActivityStreamsOffer()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 35 {
+		} else if idx1 == 36 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollection()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollection()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 36 {
+		} else if idx1 == 37 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollectionPage()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollectionPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 37 {
+		} else if idx1 == 38 {
 			lhs := this.properties[i].GetActivityStreamsOrganization()
 			rhs := this.properties[j].GetActivityStreamsOrganization()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 38 {
+		} else if idx1 == 39 {
 			lhs := this.properties[i].GetActivityStreamsPage()
 			rhs := this.properties[j].GetActivityStreamsPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 39 {
+		} else if idx1 == 40 {
 			lhs := this.properties[i].GetActivityStreamsPerson()
 			rhs := this.properties[j].GetActivityStreamsPerson()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 40 {
+		} else if idx1 == 41 {
 			lhs := this.properties[i].GetActivityStreamsPlace()
 			rhs := this.properties[j].GetActivityStreamsPlace()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 41 {
+		} else if idx1 == 42 {
 			lhs := this.properties[i].GetActivityStreamsProfile()
 			rhs := this.properties[j].GetActivityStreamsProfile()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 42 {
+		} else if idx1 == 43 {
+			lhs := this.properties[i].GetSchemaPropertyValue()
+			rhs := this.properties[j].GetSchemaPropertyValue()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 44 {
 			lhs := this.properties[i].GetForgeFedPush()
 			rhs := this.properties[j].GetForgeFedPush()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 43 {
+		} else if idx1 == 45 {
 			lhs := this.properties[i].GetActivityStreamsQuestion()
 			rhs := this.properties[j].GetActivityStreamsQuestion()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 44 {
+		} else if idx1 == 46 {
 			lhs := this.properties[i].GetActivityStreamsRead()
 			rhs := this.properties[j].GetActivityStreamsRead()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 45 {
+		} else if idx1 == 47 {
 			lhs := this.properties[i].GetActivityStreamsReject()
 			rhs := this.properties[j].GetActivityStreamsReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 46 {
+		} else if idx1 == 48 {
 			lhs := this.properties[i].GetActivityStreamsRelationship()
 			rhs := this.properties[j].GetActivityStreamsRelationship()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 47 {
+		} else if idx1 == 49 {
 			lhs := this.properties[i].GetActivityStreamsRemove()
 			rhs := this.properties[j].GetActivityStreamsRemove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 48 {
+		} else if idx1 == 50 {
 			lhs := this.properties[i].GetForgeFedRepository()
 			rhs := this.properties[j].GetForgeFedRepository()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 49 {
+		} else if idx1 == 51 {
 			lhs := this.properties[i].GetActivityStreamsService()
 			rhs := this.properties[j].GetActivityStreamsService()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 50 {
+		} else if idx1 == 52 {
 			lhs := this.properties[i].GetActivityStreamsTentativeAccept()
 			rhs := this.properties[j].GetActivityStreamsTentativeAccept()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 51 {
+		} else if idx1 == 53 {
 			lhs := this.properties[i].GetActivityStreamsTentativeReject()
 			rhs := this.properties[j].GetActivityStreamsTentativeReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 52 {
+		} else if idx1 == 54 {
 			lhs := this.properties[i].GetForgeFedTicket()
 			rhs := this.properties[j].GetForgeFedTicket()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 53 {
+		} else if idx1 == 55 {
 			lhs := this.properties[i].GetForgeFedTicketDependency()
 			rhs := this.properties[j].GetForgeFedTicketDependency()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 54 {
+		} else if idx1 == 56 {
 			lhs := this.properties[i].GetActivityStreamsTombstone()
 			rhs := this.properties[j].GetActivityStreamsTombstone()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 55 {
+		} else if idx1 == 57 {
 			lhs := this.properties[i].GetActivityStreamsTravel()
 			rhs := this.properties[j].GetActivityStreamsTravel()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 56 {
+		} else if idx1 == 58 {
 			lhs := this.properties[i].GetActivityStreamsUndo()
 			rhs := this.properties[j].GetActivityStreamsUndo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 57 {
+		} else if idx1 == 59 {
 			lhs := this.properties[i].GetActivityStreamsUpdate()
 			rhs := this.properties[j].GetActivityStreamsUpdate()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 58 {
+		} else if idx1 == 60 {
 			lhs := this.properties[i].GetActivityStreamsVideo()
 			rhs := this.properties[j].GetActivityStreamsVideo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 59 {
+		} else if idx1 == 61 {
 			lhs := this.properties[i].GetActivityStreamsView()
 			rhs := this.properties[j].GetActivityStreamsView()
 			return lhs.LessThan(rhs)
@@ -5127,20 +6073,7 @@ func (this ForgeFedTracksTicketsForProperty) Less(i, j int) bool {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this ForgeFedTracksTicketsForProperty) LessThan(o vocab.ForgeFedTracksTicketsForProperty) bool {
-	l1 := this.Len()
-	l2 := o.Len()
-	l := l1
-	if l2 < l1 {
-		l = l2
-	}
-	for i := 0; i < l; i++ {
-		if this.properties[i].LessThan(o.At(i)) {
-			return true
-		} else if o.At(i).LessThan(this.properties[i]) {
-			return false
-		}
-	}
-	return l1 < l2
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property ("tracksTicketsFor") with any alias.
@@ -5153,895 +6086,602 @@ func (this ForgeFedTracksTicketsForProperty) Name() string {
 }
 
 // PrependActivityStreamsAccept prepends a Accept value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsAccept(v vocab.ActivityStreamsAccept) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAcceptMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsActivity prepends a Activity value to the front of a list
-// of the property "tracksTicketsFor". Invalidates all iterators.
+// of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsActivity(v vocab.ActivityStreamsActivity) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsActivityMember: v,
 		alias:                         this.alias,
-		myIdx:                         0,
-		parent:                        this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsAdd prepends a Add value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsAdd(v vocab.ActivityStreamsAdd) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAddMember: v,
 		alias:                    this.alias,
-		myIdx:                    0,
-		parent:                   this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsAnnounce prepends a Announce value to the front of a list
-// of the property "tracksTicketsFor". Invalidates all iterators.
+// of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsAnnounce(v vocab.ActivityStreamsAnnounce) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAnnounceMember: v,
 		alias:                         this.alias,
-		myIdx:                         0,
-		parent:                        this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsApplication prepends a Application value to the front of
-// a list of the property "tracksTicketsFor". Invalidates all iterators.
+// a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsApplication(v vocab.ActivityStreamsApplication) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsApplicationMember: v,
 		alias:                            this.alias,
-		myIdx:                            0,
-		parent:                           this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsArrive prepends a Arrive value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsArrive(v vocab.ActivityStreamsArrive) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsArriveMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsArticle prepends a Article value to the front of a list
-// of the property "tracksTicketsFor". Invalidates all iterators.
+// of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsArticle(v vocab.ActivityStreamsArticle) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsArticleMember: v,
 		alias:                        this.alias,
-		myIdx:                        0,
-		parent:                       this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsAudio prepends a Audio value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsAudio(v vocab.ActivityStreamsAudio) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAudioMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsBlock prepends a Block value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsBlock(v vocab.ActivityStreamsBlock) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsBlockMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsCollection prepends a Collection value to the front of a
-// list of the property "tracksTicketsFor". Invalidates all iterators.
+// list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsCollection(v vocab.ActivityStreamsCollection) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCollectionMember: v,
 		alias:                           this.alias,
-		myIdx:                           0,
-		parent:                          this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsCollectionPage prepends a CollectionPage value to the
-// front of a list of the property "tracksTicketsFor". Invalidates all
-// iterators.
+// front of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsCollectionPage(v vocab.ActivityStreamsCollectionPage) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCollectionPageMember: v,
 		alias:                               this.alias,
-		myIdx:                               0,
-		parent:                              this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsCreate prepends a Create value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsCreate(v vocab.ActivityStreamsCreate) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCreateMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsDelete prepends a Delete value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsDelete(v vocab.ActivityStreamsDelete) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDeleteMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsDislike prepends a Dislike value to the front of a list
-// of the property "tracksTicketsFor". Invalidates all iterators.
+// of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsDislike(v vocab.ActivityStreamsDislike) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDislikeMember: v,
 		alias:                        this.alias,
-		myIdx:                        0,
-		parent:                       this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsDocument prepends a Document value to the front of a list
-// of the property "tracksTicketsFor". Invalidates all iterators.
+// of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsDocument(v vocab.ActivityStreamsDocument) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDocumentMember: v,
 		alias:                         this.alias,
-		myIdx:                         0,
-		parent:                        this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsEvent prepends a Event value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsEventMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsFlag prepends a Flag value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsFlag(v vocab.ActivityStreamsFlag) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsFlagMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsFollow prepends a Follow value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsFollow(v vocab.ActivityStreamsFollow) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsFollowMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsGroup prepends a Group value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsGroup(v vocab.ActivityStreamsGroup) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsGroupMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsIgnore prepends a Ignore value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsIgnoreMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsImage prepends a Image value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsImage(v vocab.ActivityStreamsImage) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsImageMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsIntransitiveActivity prepends a IntransitiveActivity
 // value to the front of a list of the property "tracksTicketsFor".
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsIntransitiveActivity(v vocab.ActivityStreamsIntransitiveActivity) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsIntransitiveActivityMember: v,
-		alias:  this.alias,
-		myIdx:  0,
-		parent: this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+		alias: this.alias,
+	})
 }
 
 // PrependActivityStreamsInvite prepends a Invite value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsInvite(v vocab.ActivityStreamsInvite) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsInviteMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsJoin prepends a Join value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsJoin(v vocab.ActivityStreamsJoin) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsJoinMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsLeave prepends a Leave value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsLeave(v vocab.ActivityStreamsLeave) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsLeaveMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsLike prepends a Like value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsLike(v vocab.ActivityStreamsLike) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsLikeMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsListen prepends a Listen value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsListen(v vocab.ActivityStreamsListen) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsListenMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsMove prepends a Move value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsMove(v vocab.ActivityStreamsMove) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsMoveMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsNote prepends a Note value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsNote(v vocab.ActivityStreamsNote) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsNoteMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsObject prepends a Object value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsObject(v vocab.ActivityStreamsObject) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsObjectMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsOffer prepends a Offer value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsOffer(v vocab.ActivityStreamsOffer) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOfferMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsOrderedCollection prepends a OrderedCollection value to
-// the front of a list of the property "tracksTicketsFor". Invalidates all
-// iterators.
+// the front of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsOrderedCollection(v vocab.ActivityStreamsOrderedCollection) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrderedCollectionMember: v,
 		alias:                                  this.alias,
-		myIdx:                                  0,
-		parent:                                 this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsOrderedCollectionPage prepends a OrderedCollectionPage
 // value to the front of a list of the property "tracksTicketsFor".
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsOrderedCollectionPage(v vocab.ActivityStreamsOrderedCollectionPage) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrderedCollectionPageMember: v,
-		alias:  this.alias,
-		myIdx:  0,
-		parent: this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+		alias: this.alias,
+	})
 }
 
 // PrependActivityStreamsOrganization prepends a Organization value to the front
-// of a list of the property "tracksTicketsFor". Invalidates all iterators.
+// of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsOrganization(v vocab.ActivityStreamsOrganization) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrganizationMember: v,
 		alias:                             this.alias,
-		myIdx:                             0,
-		parent:                            this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsPage prepends a Page value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsPage(v vocab.ActivityStreamsPage) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPageMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsPerson prepends a Person value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsPerson(v vocab.ActivityStreamsPerson) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPersonMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsPlace prepends a Place value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsPlace(v vocab.ActivityStreamsPlace) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPlaceMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsProfile prepends a Profile value to the front of a list
-// of the property "tracksTicketsFor". Invalidates all iterators.
+// of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsProfile(v vocab.ActivityStreamsProfile) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsProfileMember: v,
 		alias:                        this.alias,
-		myIdx:                        0,
-		parent:                       this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsQuestion prepends a Question value to the front of a list
-// of the property "tracksTicketsFor". Invalidates all iterators.
+// of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsQuestionMember: v,
 		alias:                         this.alias,
-		myIdx:                         0,
-		parent:                        this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsRead prepends a Read value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsRead(v vocab.ActivityStreamsRead) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsReadMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsReject prepends a Reject value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsReject(v vocab.ActivityStreamsReject) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRejectMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsRelationship prepends a Relationship value to the front
-// of a list of the property "tracksTicketsFor". Invalidates all iterators.
+// of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsRelationship(v vocab.ActivityStreamsRelationship) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRelationshipMember: v,
 		alias:                             this.alias,
-		myIdx:                             0,
-		parent:                            this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsRemove prepends a Remove value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsRemove(v vocab.ActivityStreamsRemove) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRemoveMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsService prepends a Service value to the front of a list
-// of the property "tracksTicketsFor". Invalidates all iterators.
+// of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsService(v vocab.ActivityStreamsService) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsServiceMember: v,
 		alias:                        this.alias,
-		myIdx:                        0,
-		parent:                       this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsTentativeAccept prepends a TentativeAccept value to the
-// front of a list of the property "tracksTicketsFor". Invalidates all
-// iterators.
+// front of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsTentativeAccept(v vocab.ActivityStreamsTentativeAccept) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTentativeAcceptMember: v,
 		alias:                                this.alias,
-		myIdx:                                0,
-		parent:                               this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsTentativeReject prepends a TentativeReject value to the
-// front of a list of the property "tracksTicketsFor". Invalidates all
-// iterators.
+// front of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsTentativeReject(v vocab.ActivityStreamsTentativeReject) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTentativeRejectMember: v,
 		alias:                                this.alias,
-		myIdx:                                0,
-		parent:                               this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsTombstone prepends a Tombstone value to the front of a
-// list of the property "tracksTicketsFor". Invalidates all iterators.
+// list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsTombstone(v vocab.ActivityStreamsTombstone) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTombstoneMember: v,
 		alias:                          this.alias,
-		myIdx:                          0,
-		parent:                         this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsTravel prepends a Travel value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsTravel(v vocab.ActivityStreamsTravel) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTravelMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsUndo prepends a Undo value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsUndo(v vocab.ActivityStreamsUndo) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsUndoMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsUpdate prepends a Update value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsUpdate(v vocab.ActivityStreamsUpdate) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsUpdateMember: v,
 		alias:                       this.alias,
-		myIdx:                       0,
-		parent:                      this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsVideo prepends a Video value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsVideo(v vocab.ActivityStreamsVideo) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsVideoMember: v,
 		alias:                      this.alias,
-		myIdx:                      0,
-		parent:                     this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependActivityStreamsView prepends a View value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependActivityStreamsView(v vocab.ActivityStreamsView) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsViewMember: v,
 		alias:                     this.alias,
-		myIdx:                     0,
-		parent:                    this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedBranch prepends a Branch value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependForgeFedBranch(v vocab.ForgeFedBranch) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedBranchMember: v,
-		myIdx:                0,
-		parent:               this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedCommit prepends a Commit value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependForgeFedCommit(v vocab.ForgeFedCommit) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedCommitMember: v,
-		myIdx:                0,
-		parent:               this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedPush prepends a Push value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependForgeFedPush(v vocab.ForgeFedPush) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:              this.alias,
 		forgefedPushMember: v,
-		myIdx:              0,
-		parent:             this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedRepository prepends a Repository value to the front of a list of
-// the property "tracksTicketsFor". Invalidates all iterators.
+// the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependForgeFedRepository(v vocab.ForgeFedRepository) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                    this.alias,
 		forgefedRepositoryMember: v,
-		myIdx:                    0,
-		parent:                   this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedTicket prepends a Ticket value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators.
+// property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependForgeFedTicket(v vocab.ForgeFedTicket) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                0,
-		parent:               this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependForgeFedTicketDependency prepends a TicketDependency value to the front
-// of a list of the property "tracksTicketsFor". Invalidates all iterators.
+// of a list of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependForgeFedTicketDependency(v vocab.ForgeFedTicketDependency) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                          this.alias,
 		forgefedTicketDependencyMember: v,
-		myIdx:                          0,
-		parent:                         this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependIRI prepends an IRI value to the front of a list of the property
 // "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependIRI(v *url.URL) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  0,
-		parent: this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// PrependLitepubEmojiReact prepends a EmojiReact value to the front of a list of
+// the property "tracksTicketsFor".
+func (this *ForgeFedTracksTicketsForProperty) PrependLitepubEmojiReact(v vocab.LitepubEmojiReact) {
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
+		alias:                   this.alias,
+		litepubEmojiReactMember: v,
+	})
+}
+
+// PrependSchemaPropertyValue prepends a PropertyValue value to the front of a
+// list of the property "tracksTicketsFor".
+func (this *ForgeFedTracksTicketsForProperty) PrependSchemaPropertyValue(v vocab.SchemaPropertyValue) {
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
+		alias:                     this.alias,
+		schemaPropertyValueMember: v,
+	})
 }
 
 // PrependTootEmoji prepends a Emoji value to the front of a list of the property
-// "tracksTicketsFor". Invalidates all iterators.
+// "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependTootEmoji(v vocab.TootEmoji) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:           this.alias,
-		myIdx:           0,
-		parent:          this,
 		tootEmojiMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependTootIdentityProof prepends a IdentityProof value to the front of a list
-// of the property "tracksTicketsFor". Invalidates all iterators.
+// of the property "tracksTicketsFor".
 func (this *ForgeFedTracksTicketsForProperty) PrependTootIdentityProof(v vocab.TootIdentityProof) {
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{{
+	this.properties.Prepend(&ForgeFedTracksTicketsForPropertyIterator{
 		alias:                   this.alias,
-		myIdx:                   0,
-		parent:                  this,
 		tootIdentityProofMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependType prepends an arbitrary type value to the front of a list of the
-// property "tracksTicketsFor". Invalidates all iterators. Returns an error if
-// the type is not a valid one to set for this property.
+// property "tracksTicketsFor". Returns an error if the type is not a valid
+// one to set for this property.
 func (this *ForgeFedTracksTicketsForProperty) PrependType(t vocab.Type) error {
-	n := &ForgeFedTracksTicketsForPropertyIterator{
-		alias:  this.alias,
-		myIdx:  0,
-		parent: this,
-	}
+	n := &ForgeFedTracksTicketsForPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append([]*ForgeFedTracksTicketsForPropertyIterator{n}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(n)
 	return nil
 }
 
+// Release returns this property and its values to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *ForgeFedTracksTicketsForProperty) Release() {
+	for i, elem := range this.properties {
+		elem.Release()
+		this.properties[i] = nil
+	}
+	*this = ForgeFedTracksTicketsForProperty{properties: this.properties[:0]}
+	poolForgeFedTracksTicketsForProperty.Put(this)
+}
+
 // Remove deletes an element at the specified index from a list of the property
 // "tracksTicketsFor", regardless of its type. Panics if the index is out of
-// bounds. Invalidates all iterators.
+// bounds.
 func (this *ForgeFedTracksTicketsForProperty) Remove(idx int) {
-	(this.properties)[idx].parent = nil
-	copy((this.properties)[idx:], (this.properties)[idx+1:])
-	(this.properties)[len(this.properties)-1] = &ForgeFedTracksTicketsForPropertyIterator{}
-	this.properties = (this.properties)[:len(this.properties)-1]
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Remove(idx)
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -6066,815 +6706,617 @@ func (this ForgeFedTracksTicketsForProperty) Serialize() (interface{}, error) {
 
 // SetActivityStreamsAccept sets a Accept value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsAccept(idx int, v vocab.ActivityStreamsAccept) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAcceptMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsActivity sets a Activity value to be at the specified index
 // for the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsActivity(idx int, v vocab.ActivityStreamsActivity) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsActivityMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
+	})
 }
 
 // SetActivityStreamsAdd sets a Add value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsAdd(idx int, v vocab.ActivityStreamsAdd) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAddMember: v,
 		alias:                    this.alias,
-		myIdx:                    idx,
-		parent:                   this,
-	}
+	})
 }
 
 // SetActivityStreamsAnnounce sets a Announce value to be at the specified index
 // for the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsAnnounce(idx int, v vocab.ActivityStreamsAnnounce) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAnnounceMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
+	})
 }
 
 // SetActivityStreamsApplication sets a Application value to be at the specified
 // index for the property "tracksTicketsFor". Panics if the index is out of
-// bounds. Invalidates all iterators.
+// bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsApplication(idx int, v vocab.ActivityStreamsApplication) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsApplicationMember: v,
 		alias:                            this.alias,
-		myIdx:                            idx,
-		parent:                           this,
-	}
+	})
 }
 
 // SetActivityStreamsArrive sets a Arrive value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsArrive(idx int, v vocab.ActivityStreamsArrive) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsArriveMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsArticle sets a Article value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsArticle(idx int, v vocab.ActivityStreamsArticle) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsArticleMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
+	})
 }
 
 // SetActivityStreamsAudio sets a Audio value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsAudio(idx int, v vocab.ActivityStreamsAudio) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsAudioMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsBlock sets a Block value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsBlock(idx int, v vocab.ActivityStreamsBlock) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsBlockMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsCollection sets a Collection value to be at the specified
 // index for the property "tracksTicketsFor". Panics if the index is out of
-// bounds. Invalidates all iterators.
+// bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsCollection(idx int, v vocab.ActivityStreamsCollection) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCollectionMember: v,
 		alias:                           this.alias,
-		myIdx:                           idx,
-		parent:                          this,
-	}
+	})
 }
 
 // SetActivityStreamsCollectionPage sets a CollectionPage value to be at the
 // specified index for the property "tracksTicketsFor". Panics if the index is
-// out of bounds. Invalidates all iterators.
+// out of bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsCollectionPage(idx int, v vocab.ActivityStreamsCollectionPage) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCollectionPageMember: v,
 		alias:                               this.alias,
-		myIdx:                               idx,
-		parent:                              this,
-	}
+	})
 }
 
 // SetActivityStreamsCreate sets a Create value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsCreate(idx int, v vocab.ActivityStreamsCreate) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsCreateMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsDelete sets a Delete value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsDelete(idx int, v vocab.ActivityStreamsDelete) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDeleteMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsDislike sets a Dislike value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsDislike(idx int, v vocab.ActivityStreamsDislike) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDislikeMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
+	})
 }
 
 // SetActivityStreamsDocument sets a Document value to be at the specified index
 // for the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsDocument(idx int, v vocab.ActivityStreamsDocument) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsDocumentMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
+	})
 }
 
 // SetActivityStreamsEvent sets a Event value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsEvent(idx int, v vocab.ActivityStreamsEvent) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsEventMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsFlag sets a Flag value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsFlag(idx int, v vocab.ActivityStreamsFlag) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsFlagMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsFollow sets a Follow value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsFollow(idx int, v vocab.ActivityStreamsFollow) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsFollowMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsGroup sets a Group value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsGroup(idx int, v vocab.ActivityStreamsGroup) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsGroupMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsIgnore sets a Ignore value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsIgnore(idx int, v vocab.ActivityStreamsIgnore) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsIgnoreMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsImage sets a Image value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsImage(idx int, v vocab.ActivityStreamsImage) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsImageMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsIntransitiveActivity sets a IntransitiveActivity value to be
 // at the specified index for the property "tracksTicketsFor". Panics if the
-// index is out of bounds. Invalidates all iterators.
+// index is out of bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsIntransitiveActivity(idx int, v vocab.ActivityStreamsIntransitiveActivity) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsIntransitiveActivityMember: v,
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+		alias: this.alias,
+	})
 }
 
 // SetActivityStreamsInvite sets a Invite value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsInvite(idx int, v vocab.ActivityStreamsInvite) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsInviteMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsJoin sets a Join value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsJoin(idx int, v vocab.ActivityStreamsJoin) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsJoinMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsLeave sets a Leave value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsLeave(idx int, v vocab.ActivityStreamsLeave) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsLeaveMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsLike sets a Like value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsLike(idx int, v vocab.ActivityStreamsLike) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsLikeMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsListen sets a Listen value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsListen(idx int, v vocab.ActivityStreamsListen) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsListenMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsMove sets a Move value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsMove(idx int, v vocab.ActivityStreamsMove) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsMoveMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsNote sets a Note value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsNote(idx int, v vocab.ActivityStreamsNote) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsNoteMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsObject sets a Object value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsObject(idx int, v vocab.ActivityStreamsObject) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsObjectMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsOffer sets a Offer value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsOffer(idx int, v vocab.ActivityStreamsOffer) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOfferMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsOrderedCollection sets a OrderedCollection value to be at the
 // specified index for the property "tracksTicketsFor". Panics if the index is
-// out of bounds. Invalidates all iterators.
+// out of bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsOrderedCollection(idx int, v vocab.ActivityStreamsOrderedCollection) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrderedCollectionMember: v,
 		alias:                                  this.alias,
-		myIdx:                                  idx,
-		parent:                                 this,
-	}
+	})
 }
 
 // SetActivityStreamsOrderedCollectionPage sets a OrderedCollectionPage value to
 // be at the specified index for the property "tracksTicketsFor". Panics if
-// the index is out of bounds. Invalidates all iterators.
+// the index is out of bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsOrderedCollectionPage(idx int, v vocab.ActivityStreamsOrderedCollectionPage) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrderedCollectionPageMember: v,
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+		alias: this.alias,
+	})
 }
 
 // SetActivityStreamsOrganization sets a Organization value to be at the specified
 // index for the property "tracksTicketsFor". Panics if the index is out of
-// bounds. Invalidates all iterators.
+// bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsOrganization(idx int, v vocab.ActivityStreamsOrganization) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsOrganizationMember: v,
 		alias:                             this.alias,
-		myIdx:                             idx,
-		parent:                            this,
-	}
+	})
 }
 
 // SetActivityStreamsPage sets a Page value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsPage(idx int, v vocab.ActivityStreamsPage) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPageMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsPerson sets a Person value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsPerson(idx int, v vocab.ActivityStreamsPerson) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPersonMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsPlace sets a Place value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsPlace(idx int, v vocab.ActivityStreamsPlace) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsPlaceMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsProfile sets a Profile value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsProfile(idx int, v vocab.ActivityStreamsProfile) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsProfileMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
+	})
 }
 
 // SetActivityStreamsQuestion sets a Question value to be at the specified index
 // for the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsQuestion(idx int, v vocab.ActivityStreamsQuestion) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsQuestionMember: v,
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
-	}
+	})
 }
 
 // SetActivityStreamsRead sets a Read value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsRead(idx int, v vocab.ActivityStreamsRead) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsReadMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsReject sets a Reject value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsReject(idx int, v vocab.ActivityStreamsReject) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRejectMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsRelationship sets a Relationship value to be at the specified
 // index for the property "tracksTicketsFor". Panics if the index is out of
-// bounds. Invalidates all iterators.
+// bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsRelationship(idx int, v vocab.ActivityStreamsRelationship) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRelationshipMember: v,
 		alias:                             this.alias,
-		myIdx:                             idx,
-		parent:                            this,
-	}
+	})
 }
 
 // SetActivityStreamsRemove sets a Remove value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsRemove(idx int, v vocab.ActivityStreamsRemove) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsRemoveMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsService sets a Service value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsService(idx int, v vocab.ActivityStreamsService) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsServiceMember: v,
 		alias:                        this.alias,
-		myIdx:                        idx,
-		parent:                       this,
-	}
+	})
 }
 
 // SetActivityStreamsTentativeAccept sets a TentativeAccept value to be at the
 // specified index for the property "tracksTicketsFor". Panics if the index is
-// out of bounds. Invalidates all iterators.
+// out of bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsTentativeAccept(idx int, v vocab.ActivityStreamsTentativeAccept) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTentativeAcceptMember: v,
 		alias:                                this.alias,
-		myIdx:                                idx,
-		parent:                               this,
-	}
+	})
 }
 
 // SetActivityStreamsTentativeReject sets a TentativeReject value to be at the
 // specified index for the property "tracksTicketsFor". Panics if the index is
-// out of bounds. Invalidates all iterators.
+// out of bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsTentativeReject(idx int, v vocab.ActivityStreamsTentativeReject) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTentativeRejectMember: v,
 		alias:                                this.alias,
-		myIdx:                                idx,
-		parent:                               this,
-	}
+	})
 }
 
 // SetActivityStreamsTombstone sets a Tombstone value to be at the specified index
 // for the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsTombstone(idx int, v vocab.ActivityStreamsTombstone) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTombstoneMember: v,
 		alias:                          this.alias,
-		myIdx:                          idx,
-		parent:                         this,
-	}
+	})
 }
 
 // SetActivityStreamsTravel sets a Travel value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsTravel(idx int, v vocab.ActivityStreamsTravel) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsTravelMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsUndo sets a Undo value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsUndo(idx int, v vocab.ActivityStreamsUndo) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsUndoMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetActivityStreamsUpdate sets a Update value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsUpdate(idx int, v vocab.ActivityStreamsUpdate) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsUpdateMember: v,
 		alias:                       this.alias,
-		myIdx:                       idx,
-		parent:                      this,
-	}
+	})
 }
 
 // SetActivityStreamsVideo sets a Video value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsVideo(idx int, v vocab.ActivityStreamsVideo) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsVideoMember: v,
 		alias:                      this.alias,
-		myIdx:                      idx,
-		parent:                     this,
-	}
+	})
 }
 
 // SetActivityStreamsView sets a View value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetActivityStreamsView(idx int, v vocab.ActivityStreamsView) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		activitystreamsViewMember: v,
 		alias:                     this.alias,
-		myIdx:                     idx,
-		parent:                    this,
-	}
+	})
 }
 
 // SetForgeFedBranch sets a Branch value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetForgeFedBranch(idx int, v vocab.ForgeFedBranch) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedBranchMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
+	})
 }
 
 // SetForgeFedCommit sets a Commit value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetForgeFedCommit(idx int, v vocab.ForgeFedCommit) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedCommitMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
+	})
 }
 
 // SetForgeFedPush sets a Push value to be at the specified index for the property
-// "tracksTicketsFor". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// "tracksTicketsFor". Panics if the index is out of bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetForgeFedPush(idx int, v vocab.ForgeFedPush) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:              this.alias,
 		forgefedPushMember: v,
-		myIdx:              idx,
-		parent:             this,
-	}
+	})
 }
 
 // SetForgeFedRepository sets a Repository value to be at the specified index for
 // the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetForgeFedRepository(idx int, v vocab.ForgeFedRepository) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                    this.alias,
 		forgefedRepositoryMember: v,
-		myIdx:                    idx,
-		parent:                   this,
-	}
+	})
 }
 
 // SetForgeFedTicket sets a Ticket value to be at the specified index for the
 // property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetForgeFedTicket(idx int, v vocab.ForgeFedTicket) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                this.alias,
 		forgefedTicketMember: v,
-		myIdx:                idx,
-		parent:               this,
-	}
+	})
 }
 
 // SetForgeFedTicketDependency sets a TicketDependency value to be at the
 // specified index for the property "tracksTicketsFor". Panics if the index is
-// out of bounds. Invalidates all iterators.
+// out of bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetForgeFedTicketDependency(idx int, v vocab.ForgeFedTicketDependency) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                          this.alias,
 		forgefedTicketDependencyMember: v,
-		myIdx:                          idx,
-		parent:                         this,
-	}
+	})
 }
 
 // SetIRI sets an IRI value to be at the specified index for the property
 // "tracksTicketsFor". Panics if the index is out of bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetIRI(idx int, v *url.URL) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
+}
+
+// SetLitepubEmojiReact sets a EmojiReact value to be at the specified index for
+// the property "tracksTicketsFor". Panics if the index is out of bounds.
+func (this *ForgeFedTracksTicketsForProperty) SetLitepubEmojiReact(idx int, v vocab.LitepubEmojiReact) {
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
+		alias:                   this.alias,
+		litepubEmojiReactMember: v,
+	})
+}
+
+// SetSchemaPropertyValue sets a PropertyValue value to be at the specified index
+// for the property "tracksTicketsFor". Panics if the index is out of bounds.
+func (this *ForgeFedTracksTicketsForProperty) SetSchemaPropertyValue(idx int, v vocab.SchemaPropertyValue) {
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
+		alias:                     this.alias,
+		schemaPropertyValueMember: v,
+	})
 }
 
 // SetTootEmoji sets a Emoji value to be at the specified index for the property
-// "tracksTicketsFor". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// "tracksTicketsFor". Panics if the index is out of bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetTootEmoji(idx int, v vocab.TootEmoji) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:           this.alias,
-		myIdx:           idx,
-		parent:          this,
 		tootEmojiMember: v,
-	}
+	})
 }
 
 // SetTootIdentityProof sets a IdentityProof value to be at the specified index
 // for the property "tracksTicketsFor". Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *ForgeFedTracksTicketsForProperty) SetTootIdentityProof(idx int, v vocab.TootIdentityProof) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &ForgeFedTracksTicketsForPropertyIterator{
+	this.properties.Set(idx, &ForgeFedTracksTicketsForPropertyIterator{
 		alias:                   this.alias,
-		myIdx:                   idx,
-		parent:                  this,
 		tootIdentityProofMember: v,
-	}
+	})
 }
 
 // SetType sets an arbitrary type value to the specified index of the property
-// "tracksTicketsFor". Invalidates all iterators. Returns an error if the type
-// is not a valid one to set for this property. Panics if the index is out of
-// bounds.
+// "tracksTicketsFor". Returns an error if the type is not a valid one to set
+// for this property. Panics if the index is out of bounds.
 func (this *ForgeFedTracksTicketsForProperty) SetType(idx int, t vocab.Type) error {
-	n := &ForgeFedTracksTicketsForPropertyIterator{
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+	n := &ForgeFedTracksTicketsForPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	(this.properties)[idx] = n
+	this.properties.Set(idx, n)
 	return nil
 }
 
 // Swap swaps the location of values at two indices for the "tracksTicketsFor"
 // property.
 func (this ForgeFedTracksTicketsForProperty) Swap(i, j int) {
-	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+	this.properties.Swap(i, j)
+}
+
+// Values returns the iterators of all values from front to back, so they can be
+// ranged over. Adding values to or removing them from this property
+// afterwards does not change the returned slice.
+func (this ForgeFedTracksTicketsForProperty) Values() []vocab.ForgeFedTracksTicketsForPropertyIterator {
+	values := make([]vocab.ForgeFedTracksTicketsForPropertyIterator, len(this.properties))
+	for i, elem := range this.properties {
+		values[i] = elem
+	}
+	return values
 }
+
+// poolForgeFedTracksTicketsForProperty holds the released values of ForgeFedTracksTicketsForProperty, which are reused when deserializing.
+var poolForgeFedTracksTicketsForProperty = sync.Pool{New: func() interface{} {
+	return &ForgeFedTracksTicketsForProperty{}
+}}
+
+// poolForgeFedTracksTicketsForPropertyIterator holds the released values of ForgeFedTracksTicketsForPropertyIterator, which are reused when deserializing.
+var poolForgeFedTracksTicketsForPropertyIterator = sync.Pool{New: func() interface{} {
+	return &ForgeFedTracksTicketsForPropertyIterator{}
+}}
@@ -7,6 +7,7 @@ import (
 	anyuri "github.com/go-fed/activity/streams/values/anyURI"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"sync"
 )
 
 // JSONLDIdProperty is the functional property "id". It is permitted to be a
@@ -31,13 +32,15 @@ func DeserializeIdProperty(m map[string]interface{}, aliasMap map[string]string)
 
 	if ok {
 		if v, err := anyuri.DeserializeAnyURI(i); err == nil {
-			this := &JSONLDIdProperty{
+			this := poolJSONLDIdProperty.Get().(*JSONLDIdProperty)
+			*this = JSONLDIdProperty{
 				alias:                 alias,
 				xmlschemaAnyURIMember: v,
 			}
 			return this, nil
 		}
-		this := &JSONLDIdProperty{
+		this := poolJSONLDIdProperty.Get().(*JSONLDIdProperty)
+		*this = JSONLDIdProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -51,6 +54,12 @@ func NewJSONLDIdProperty() *JSONLDIdProperty {
 	return &JSONLDIdProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this JSONLDIdProperty) AddJSONLDContext(m map[string]string) {}
+
 // Clear ensures no value of this property is set. Calling IsXMLSchemaAnyURI
 // afterwards will return false.
 func (this *JSONLDIdProperty) Clear() {
@@ -58,6 +67,39 @@ func (this *JSONLDIdProperty) Clear() {
 	this.xmlschemaAnyURIMember = nil
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this JSONLDIdProperty) Compare(o vocab.JSONLDIdProperty) int {
+	if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsXMLSchemaAnyURI() && o.IsXMLSchemaAnyURI() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	if lhs, rhs := this.Get(), o.Get(); anyuri.LessAnyURI(lhs, rhs) {
+		return -1
+	} else if anyuri.LessAnyURI(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
 // Get returns the value of this property. When IsXMLSchemaAnyURI returns false,
 // Get will return any arbitrary value.
 func (this JSONLDIdProperty) Get() *url.URL {
@@ -89,17 +131,8 @@ func (this JSONLDIdProperty) IsXMLSchemaAnyURI() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this JSONLDIdProperty) JSONLDContext() map[string]string {
-	var m map[string]string
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -121,27 +154,7 @@ func (this JSONLDIdProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this JSONLDIdProperty) LessThan(o vocab.JSONLDIdProperty) bool {
-	if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
-		// Both are unknowns.
-		return false
-	} else if this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsXMLSchemaAnyURI() && o.IsXMLSchemaAnyURI() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return anyuri.LessAnyURI(this.Get(), o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "id".
@@ -153,6 +166,15 @@ func (this JSONLDIdProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *JSONLDIdProperty) Release() {
+	*this = JSONLDIdProperty{}
+	poolJSONLDIdProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -177,3 +199,8 @@ func (this *JSONLDIdProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.Set(v)
 }
+
+// poolJSONLDIdProperty holds the released values of JSONLDIdProperty, which are reused when deserializing.
+var poolJSONLDIdProperty = sync.Pool{New: func() interface{} {
+	return &JSONLDIdProperty{}
+}}
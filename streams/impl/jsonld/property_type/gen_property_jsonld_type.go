@@ -4,10 +4,12 @@ package propertytype
 
 import (
 	"fmt"
+	proplist "github.com/go-fed/activity/streams/impl/proplist"
 	anyuri "github.com/go-fed/activity/streams/values/anyURI"
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"sync"
 )
 
 // JSONLDTypePropertyIterator is an iterator for a property. It is permitted to be
@@ -21,8 +23,7 @@ type JSONLDTypePropertyIterator struct {
 	hasStringMember       bool
 	unknown               interface{}
 	alias                 string
-	myIdx                 int
-	parent                vocab.JSONLDTypeProperty
+	proplist.Links[*JSONLDTypePropertyIterator]
 }
 
 // NewJSONLDTypePropertyIterator creates a new JSONLDType property.
@@ -36,26 +37,65 @@ func deserializeJSONLDTypePropertyIterator(i interface{}, aliasMap map[string]st
 	alias := ""
 
 	if v, err := anyuri.DeserializeAnyURI(i); err == nil {
-		this := &JSONLDTypePropertyIterator{
+		this := poolJSONLDTypePropertyIterator.Get().(*JSONLDTypePropertyIterator)
+		*this = JSONLDTypePropertyIterator{
 			alias:                 alias,
 			xmlschemaAnyURIMember: v,
 		}
 		return this, nil
 	} else if v, err := string1.DeserializeString(i); err == nil {
-		this := &JSONLDTypePropertyIterator{
+		this := poolJSONLDTypePropertyIterator.Get().(*JSONLDTypePropertyIterator)
+		*this = JSONLDTypePropertyIterator{
 			alias:                 alias,
 			hasStringMember:       true,
 			xmlschemaStringMember: v,
 		}
 		return this, nil
 	}
-	this := &JSONLDTypePropertyIterator{
+	this := poolJSONLDTypePropertyIterator.Get().(*JSONLDTypePropertyIterator)
+	*this = JSONLDTypePropertyIterator{
 		alias:   alias,
 		unknown: i,
 	}
 	return this, nil
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this JSONLDTypePropertyIterator) AddJSONLDContext(m map[string]string) {}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this JSONLDTypePropertyIterator) Compare(o vocab.JSONLDTypePropertyIterator) int {
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
+		return -1
+	} else if idx1 > idx2 {
+		return 1
+	} else if this.IsXMLSchemaAnyURI() {
+		if lhs, rhs := this.GetXMLSchemaAnyURI(), o.GetXMLSchemaAnyURI(); anyuri.LessAnyURI(lhs, rhs) {
+			return -1
+		} else if anyuri.LessAnyURI(rhs, lhs) {
+			return 1
+		}
+		return 0
+	} else if this.IsXMLSchemaString() {
+		if lhs, rhs := this.GetXMLSchemaString(), o.GetXMLSchemaString(); string1.LessString(lhs, rhs) {
+			return -1
+		} else if string1.LessString(rhs, lhs) {
+			return 1
+		}
+		return 0
+	}
+	return 0
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this JSONLDTypePropertyIterator) GetIRI() *url.URL {
@@ -104,17 +144,8 @@ func (this JSONLDTypePropertyIterator) IsXMLSchemaString() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this JSONLDTypePropertyIterator) JSONLDContext() map[string]string {
-	var m map[string]string
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -139,18 +170,7 @@ func (this JSONLDTypePropertyIterator) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this JSONLDTypePropertyIterator) LessThan(o vocab.JSONLDTypePropertyIterator) bool {
-	idx1 := this.KindIndex()
-	idx2 := o.KindIndex()
-	if idx1 < idx2 {
-		return true
-	} else if idx1 > idx2 {
-		return false
-	} else if this.IsXMLSchemaAnyURI() {
-		return anyuri.LessAnyURI(this.GetXMLSchemaAnyURI(), o.GetXMLSchemaAnyURI())
-	} else if this.IsXMLSchemaString() {
-		return string1.LessString(this.GetXMLSchemaString(), o.GetXMLSchemaString())
-	}
-	return false
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "JSONLDType".
@@ -162,22 +182,33 @@ func (this JSONLDTypePropertyIterator) Name() string {
 	}
 }
 
-// Next returns the next iterator, or nil if there is no next iterator.
+// Next returns the next iterator, or nil if there is no next iterator. It remains
+// correct when values are added to or removed from the property, including
+// this one, without obtaining the iterator again.
 func (this JSONLDTypePropertyIterator) Next() vocab.JSONLDTypePropertyIterator {
-	if this.myIdx+1 >= this.parent.Len() {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx + 1)
+	if it := proplist.Next(&this); it != nil {
+		return it
 	}
+	return nil
 }
 
-// Prev returns the previous iterator, or nil if there is no previous iterator.
+// Prev returns the previous iterator, or nil if there is no previous iterator. It
+// remains correct when values are added to or removed from the property,
+// including this one, without obtaining the iterator again.
 func (this JSONLDTypePropertyIterator) Prev() vocab.JSONLDTypePropertyIterator {
-	if this.myIdx-1 < 0 {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx - 1)
+	if it := proplist.Prev(&this); it != nil {
+		return it
 	}
+	return nil
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *JSONLDTypePropertyIterator) Release() {
+	*this = JSONLDTypePropertyIterator{}
+	poolJSONLDTypePropertyIterator.Put(this)
 }
 
 // SetIRI sets the value of this property. Calling IsIRI afterwards returns true.
@@ -225,7 +256,7 @@ func (this JSONLDTypePropertyIterator) serialize() (interface{}, error) {
 // JSONLDTypeProperty is the non-functional property "type". It is permitted to
 // have one or more values, and of different value types.
 type JSONLDTypeProperty struct {
-	properties []*JSONLDTypePropertyIterator
+	properties proplist.List[*JSONLDTypePropertyIterator]
 	alias      string
 }
 
@@ -241,10 +272,9 @@ func DeserializeTypeProperty(m map[string]interface{}, aliasMap map[string]strin
 	i, ok := m[propName]
 
 	if ok {
-		this := &JSONLDTypeProperty{
-			alias:      alias,
-			properties: []*JSONLDTypePropertyIterator{},
-		}
+		// A released value keeps its properties for reuse.
+		this := poolJSONLDTypeProperty.Get().(*JSONLDTypeProperty)
+		this.alias = alias
 		if list, ok := i.([]interface{}); ok {
 			for _, iterator := range list {
 				if p, err := deserializeJSONLDTypePropertyIterator(iterator, aliasMap); err != nil {
@@ -261,10 +291,7 @@ func DeserializeTypeProperty(m map[string]interface{}, aliasMap map[string]strin
 			}
 		}
 		// Set up the properties for iteration.
-		for idx, ele := range this.properties {
-			ele.parent = this
-			ele.myIdx = idx
-		}
+		this.properties.Link()
 		return this, nil
 	}
 	return nil, nil
@@ -275,35 +302,39 @@ func NewJSONLDTypeProperty() *JSONLDTypeProperty {
 	return &JSONLDTypeProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and each of its values.
+func (this JSONLDTypeProperty) AddJSONLDContext(m map[string]string) {
+	for _, elem := range this.properties {
+		elem.AddJSONLDContext(m)
+	}
+}
+
 // AppendIRI appends an IRI value to the back of a list of the property "type"
 func (this *JSONLDTypeProperty) AppendIRI(v *url.URL) {
-	this.properties = append(this.properties, &JSONLDTypePropertyIterator{
+	this.properties.Append(&JSONLDTypePropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 this.Len(),
-		parent:                this,
 		xmlschemaAnyURIMember: v,
 	})
 }
 
 // AppendXMLSchemaAnyURI appends a anyURI value to the back of a list of the
-// property "type". Invalidates iterators that are traversing using Prev.
+// property "type".
 func (this *JSONLDTypeProperty) AppendXMLSchemaAnyURI(v *url.URL) {
-	this.properties = append(this.properties, &JSONLDTypePropertyIterator{
+	this.properties.Append(&JSONLDTypePropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 this.Len(),
-		parent:                this,
 		xmlschemaAnyURIMember: v,
 	})
 }
 
 // AppendXMLSchemaString appends a string value to the back of a list of the
-// property "type". Invalidates iterators that are traversing using Prev.
+// property "type".
 func (this *JSONLDTypeProperty) AppendXMLSchemaString(v string) {
-	this.properties = append(this.properties, &JSONLDTypePropertyIterator{
+	this.properties.Append(&JSONLDTypePropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 this.Len(),
-		parent:                this,
 		xmlschemaStringMember: v,
 	})
 }
@@ -325,6 +356,31 @@ func (this JSONLDTypeProperty) Begin() vocab.JSONLDTypePropertyIterator {
 	}
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this JSONLDTypeProperty) Compare(o vocab.JSONLDTypeProperty) int {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if c := this.properties[i].Compare(o.At(i)); c != 0 {
+			return c
+		}
+	}
+	if l1 < l2 {
+		return -1
+	} else if l1 > l2 {
+		return 1
+	}
+	return 0
+}
+
 // Empty returns returns true if there are no elements.
 func (this JSONLDTypeProperty) Empty() bool {
 	return this.Len() == 0
@@ -339,72 +395,40 @@ func (this JSONLDTypeProperty) End() vocab.JSONLDTypePropertyIterator {
 
 // Insert inserts an IRI value at the specified index for a property "type".
 // Existing elements at that index and higher are shifted back once.
-// Invalidates all iterators.
 func (this *JSONLDTypeProperty) InsertIRI(idx int, v *url.URL) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &JSONLDTypePropertyIterator{
+	this.properties.Insert(idx, &JSONLDTypePropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertXMLSchemaAnyURI inserts a anyURI value at the specified index for a
 // property "type". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *JSONLDTypeProperty) InsertXMLSchemaAnyURI(idx int, v *url.URL) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &JSONLDTypePropertyIterator{
+	this.properties.Insert(idx, &JSONLDTypePropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // InsertXMLSchemaString inserts a string value at the specified index for a
 // property "type". Existing elements at that index and higher are shifted
-// back once. Invalidates all iterators.
+// back once.
 func (this *JSONLDTypeProperty) InsertXMLSchemaString(idx int, v string) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &JSONLDTypePropertyIterator{
+	this.properties.Insert(idx, &JSONLDTypePropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaStringMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this JSONLDTypeProperty) JSONLDContext() map[string]string {
-	var m map[string]string
-	for _, elem := range this.properties {
-		child := elem.JSONLDContext()
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		for k, v := range child {
-			m[k] = v
-		}
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -418,7 +442,7 @@ func (this JSONLDTypeProperty) KindIndex(idx int) int {
 
 // Len returns the number of values that exist for the "type" property.
 func (this JSONLDTypeProperty) Len() (length int) {
-	return len(this.properties)
+	return this.properties.Len()
 }
 
 // Less computes whether another property is less than this one. Mixing types
@@ -451,20 +475,7 @@ func (this JSONLDTypeProperty) Less(i, j int) bool {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this JSONLDTypeProperty) LessThan(o vocab.JSONLDTypeProperty) bool {
-	l1 := this.Len()
-	l2 := o.Len()
-	l := l1
-	if l2 < l1 {
-		l = l2
-	}
-	for i := 0; i < l; i++ {
-		if this.properties[i].LessThan(o.At(i)) {
-			return true
-		} else if o.At(i).LessThan(this.properties[i]) {
-			return false
-		}
-	}
-	return l1 < l2
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property ("type") with any alias.
@@ -478,57 +489,48 @@ func (this JSONLDTypeProperty) Name() string {
 
 // PrependIRI prepends an IRI value to the front of a list of the property "type".
 func (this *JSONLDTypeProperty) PrependIRI(v *url.URL) {
-	this.properties = append([]*JSONLDTypePropertyIterator{{
+	this.properties.Prepend(&JSONLDTypePropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 0,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependXMLSchemaAnyURI prepends a anyURI value to the front of a list of the
-// property "type". Invalidates all iterators.
+// property "type".
 func (this *JSONLDTypeProperty) PrependXMLSchemaAnyURI(v *url.URL) {
-	this.properties = append([]*JSONLDTypePropertyIterator{{
+	this.properties.Prepend(&JSONLDTypePropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 0,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // PrependXMLSchemaString prepends a string value to the front of a list of the
-// property "type". Invalidates all iterators.
+// property "type".
 func (this *JSONLDTypeProperty) PrependXMLSchemaString(v string) {
-	this.properties = append([]*JSONLDTypePropertyIterator{{
+	this.properties.Prepend(&JSONLDTypePropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 0,
-		parent:                this,
 		xmlschemaStringMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
+	})
+}
+
+// Release returns this property and its values to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *JSONLDTypeProperty) Release() {
+	for i, elem := range this.properties {
+		elem.Release()
+		this.properties[i] = nil
 	}
+	*this = JSONLDTypeProperty{properties: this.properties[:0]}
+	poolJSONLDTypeProperty.Put(this)
 }
 
 // Remove deletes an element at the specified index from a list of the property
 // "type", regardless of its type. Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *JSONLDTypeProperty) Remove(idx int) {
-	(this.properties)[idx].parent = nil
-	copy((this.properties)[idx:], (this.properties)[idx+1:])
-	(this.properties)[len(this.properties)-1] = &JSONLDTypePropertyIterator{}
-	this.properties = (this.properties)[:len(this.properties)-1]
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Remove(idx)
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -554,43 +556,53 @@ func (this JSONLDTypeProperty) Serialize() (interface{}, error) {
 // SetIRI sets an IRI value to be at the specified index for the property "type".
 // Panics if the index is out of bounds.
 func (this *JSONLDTypeProperty) SetIRI(idx int, v *url.URL) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &JSONLDTypePropertyIterator{
+	this.properties.Set(idx, &JSONLDTypePropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}
+	})
 }
 
 // SetXMLSchemaAnyURI sets a anyURI value to be at the specified index for the
-// property "type". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "type". Panics if the index is out of bounds.
 func (this *JSONLDTypeProperty) SetXMLSchemaAnyURI(idx int, v *url.URL) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &JSONLDTypePropertyIterator{
+	this.properties.Set(idx, &JSONLDTypePropertyIterator{
 		alias:                 this.alias,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaAnyURIMember: v,
-	}
+	})
 }
 
 // SetXMLSchemaString sets a string value to be at the specified index for the
-// property "type". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// property "type". Panics if the index is out of bounds.
 func (this *JSONLDTypeProperty) SetXMLSchemaString(idx int, v string) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &JSONLDTypePropertyIterator{
+	this.properties.Set(idx, &JSONLDTypePropertyIterator{
 		alias:                 this.alias,
 		hasStringMember:       true,
-		myIdx:                 idx,
-		parent:                this,
 		xmlschemaStringMember: v,
-	}
+	})
 }
 
 // Swap swaps the location of values at two indices for the "type" property.
 func (this JSONLDTypeProperty) Swap(i, j int) {
-	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+	this.properties.Swap(i, j)
 }
+
+// Values returns the iterators of all values from front to back, so they can be
+// ranged over. Adding values to or removing them from this property
+// afterwards does not change the returned slice.
+func (this JSONLDTypeProperty) Values() []vocab.JSONLDTypePropertyIterator {
+	values := make([]vocab.JSONLDTypePropertyIterator, len(this.properties))
+	for i, elem := range this.properties {
+		values[i] = elem
+	}
+	return values
+}
+
+// poolJSONLDTypeProperty holds the released values of JSONLDTypeProperty, which are reused when deserializing.
+var poolJSONLDTypeProperty = sync.Pool{New: func() interface{} {
+	return &JSONLDTypeProperty{}
+}}
+
+// poolJSONLDTypePropertyIterator holds the released values of JSONLDTypePropertyIterator, which are reused when deserializing.
+var poolJSONLDTypePropertyIterator = sync.Pool{New: func() interface{} {
+	return &JSONLDTypePropertyIterator{}
+}}
@@ -0,0 +1,235 @@
+// Code generated by astool. DO NOT EDIT.
+
+package propertycommentsenabled
+
+import (
+	"fmt"
+	boolean "github.com/go-fed/activity/streams/values/boolean"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// PeerTubeCommentsEnabledProperty is the functional property "commentsEnabled".
+// It is permitted to be a single default-valued value type.
+type PeerTubeCommentsEnabledProperty struct {
+	xmlschemaBooleanMember bool
+	hasBooleanMember       bool
+	unknown                interface{}
+	iri                    *url.URL
+	alias                  string
+}
+
+// DeserializeCommentsEnabledProperty creates a "commentsEnabled" property from an
+// interface representation that has been unmarshalled from a text or binary
+// format.
+func DeserializeCommentsEnabledProperty(m map[string]interface{}, aliasMap map[string]string) (*PeerTubeCommentsEnabledProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://joinpeertube.org/ns"]; ok {
+		alias = a
+	}
+	propName := "commentsEnabled"
+	if len(alias) > 0 {
+		// Use alias both to find the property, and set within the property.
+		propName = fmt.Sprintf("%s:%s", alias, "commentsEnabled")
+	}
+	i, ok := m[propName]
+
+	if ok {
+		if s, ok := i.(string); ok {
+			u, err := url.Parse(s)
+			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
+			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
+			if err == nil && len(u.Scheme) > 0 {
+				this := poolPeerTubeCommentsEnabledProperty.Get().(*PeerTubeCommentsEnabledProperty)
+				*this = PeerTubeCommentsEnabledProperty{
+					alias: alias,
+					iri:   u,
+				}
+				return this, nil
+			}
+		}
+		if v, err := boolean.DeserializeBoolean(i); err == nil {
+			this := poolPeerTubeCommentsEnabledProperty.Get().(*PeerTubeCommentsEnabledProperty)
+			*this = PeerTubeCommentsEnabledProperty{
+				alias:                  alias,
+				hasBooleanMember:       true,
+				xmlschemaBooleanMember: v,
+			}
+			return this, nil
+		}
+		this := poolPeerTubeCommentsEnabledProperty.Get().(*PeerTubeCommentsEnabledProperty)
+		*this = PeerTubeCommentsEnabledProperty{
+			alias:   alias,
+			unknown: i,
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewPeerTubeCommentsEnabledProperty creates a new commentsEnabled property.
+func NewPeerTubeCommentsEnabledProperty() *PeerTubeCommentsEnabledProperty {
+	return &PeerTubeCommentsEnabledProperty{alias: ""}
+}
+
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this PeerTubeCommentsEnabledProperty) AddJSONLDContext(m map[string]string) {
+	m["https://joinpeertube.org/ns"] = this.alias
+}
+
+// Clear ensures no value of this property is set. Calling IsXMLSchemaBoolean
+// afterwards will return false.
+func (this *PeerTubeCommentsEnabledProperty) Clear() {
+	this.unknown = nil
+	this.iri = nil
+	this.hasBooleanMember = false
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this PeerTubeCommentsEnabledProperty) Compare(o vocab.PeerTubeCommentsEnabledProperty) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsXMLSchemaBoolean() && !o.IsXMLSchemaBoolean() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsXMLSchemaBoolean() && !o.IsXMLSchemaBoolean() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsXMLSchemaBoolean() && o.IsXMLSchemaBoolean() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	if lhs, rhs := this.Get(), o.Get(); boolean.LessBoolean(lhs, rhs) {
+		return -1
+	} else if boolean.LessBoolean(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
+// Get returns the value of this property. When IsXMLSchemaBoolean returns false,
+// Get will return any arbitrary value.
+func (this PeerTubeCommentsEnabledProperty) Get() bool {
+	return this.xmlschemaBooleanMember
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return any arbitrary value.
+func (this PeerTubeCommentsEnabledProperty) GetIRI() *url.URL {
+	return this.iri
+}
+
+// HasAny returns true if the value or IRI is set.
+func (this PeerTubeCommentsEnabledProperty) HasAny() bool {
+	return this.IsXMLSchemaBoolean() || this.iri != nil
+}
+
+// IsIRI returns true if this property is an IRI.
+func (this PeerTubeCommentsEnabledProperty) IsIRI() bool {
+	return this.iri != nil
+}
+
+// IsXMLSchemaBoolean returns true if this property is set and not an IRI.
+func (this PeerTubeCommentsEnabledProperty) IsXMLSchemaBoolean() bool {
+	return this.hasBooleanMember
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this PeerTubeCommentsEnabledProperty) JSONLDContext() map[string]string {
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this PeerTubeCommentsEnabledProperty) KindIndex() int {
+	if this.IsXMLSchemaBoolean() {
+		return 0
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this PeerTubeCommentsEnabledProperty) LessThan(o vocab.PeerTubeCommentsEnabledProperty) bool {
+	return this.Compare(o) < 0
+}
+
+// Name returns the name of this property: "commentsEnabled".
+func (this PeerTubeCommentsEnabledProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":" + "commentsEnabled"
+	} else {
+		return "commentsEnabled"
+	}
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *PeerTubeCommentsEnabledProperty) Release() {
+	*this = PeerTubeCommentsEnabledProperty{}
+	poolPeerTubeCommentsEnabledProperty.Put(this)
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this PeerTubeCommentsEnabledProperty) Serialize() (interface{}, error) {
+	if this.IsXMLSchemaBoolean() {
+		return boolean.SerializeBoolean(this.Get())
+	} else if this.IsIRI() {
+		return this.iri.String(), nil
+	}
+	return this.unknown, nil
+}
+
+// Set sets the value of this property. Calling IsXMLSchemaBoolean afterwards will
+// return true.
+func (this *PeerTubeCommentsEnabledProperty) Set(v bool) {
+	this.Clear()
+	this.xmlschemaBooleanMember = v
+	this.hasBooleanMember = true
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards will return
+// true.
+func (this *PeerTubeCommentsEnabledProperty) SetIRI(v *url.URL) {
+	this.Clear()
+	this.iri = v
+}
+
+// poolPeerTubeCommentsEnabledProperty holds the released values of PeerTubeCommentsEnabledProperty, which are reused when deserializing.
+var poolPeerTubeCommentsEnabledProperty = sync.Pool{New: func() interface{} {
+	return &PeerTubeCommentsEnabledProperty{}
+}}
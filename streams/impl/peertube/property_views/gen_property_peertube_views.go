@@ -0,0 +1,235 @@
+// Code generated by astool. DO NOT EDIT.
+
+package propertyviews
+
+import (
+	"fmt"
+	nonnegativeinteger "github.com/go-fed/activity/streams/values/nonNegativeInteger"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// PeerTubeViewsProperty is the functional property "views". It is permitted to be
+// a single default-valued value type.
+type PeerTubeViewsProperty struct {
+	xmlschemaNonNegativeIntegerMember int
+	hasNonNegativeIntegerMember       bool
+	unknown                           interface{}
+	iri                               *url.URL
+	alias                             string
+}
+
+// DeserializeViewsProperty creates a "views" property from an interface
+// representation that has been unmarshalled from a text or binary format.
+func DeserializeViewsProperty(m map[string]interface{}, aliasMap map[string]string) (*PeerTubeViewsProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://joinpeertube.org/ns"]; ok {
+		alias = a
+	}
+	propName := "views"
+	if len(alias) > 0 {
+		// Use alias both to find the property, and set within the property.
+		propName = fmt.Sprintf("%s:%s", alias, "views")
+	}
+	i, ok := m[propName]
+
+	if ok {
+		if s, ok := i.(string); ok {
+			u, err := url.Parse(s)
+			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
+			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
+			if err == nil && len(u.Scheme) > 0 {
+				this := poolPeerTubeViewsProperty.Get().(*PeerTubeViewsProperty)
+				*this = PeerTubeViewsProperty{
+					alias: alias,
+					iri:   u,
+				}
+				return this, nil
+			}
+		}
+		if v, err := nonnegativeinteger.DeserializeNonNegativeInteger(i); err == nil {
+			this := poolPeerTubeViewsProperty.Get().(*PeerTubeViewsProperty)
+			*this = PeerTubeViewsProperty{
+				alias:                             alias,
+				hasNonNegativeIntegerMember:       true,
+				xmlschemaNonNegativeIntegerMember: v,
+			}
+			return this, nil
+		}
+		this := poolPeerTubeViewsProperty.Get().(*PeerTubeViewsProperty)
+		*this = PeerTubeViewsProperty{
+			alias:   alias,
+			unknown: i,
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewPeerTubeViewsProperty creates a new views property.
+func NewPeerTubeViewsProperty() *PeerTubeViewsProperty {
+	return &PeerTubeViewsProperty{alias: ""}
+}
+
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this PeerTubeViewsProperty) AddJSONLDContext(m map[string]string) {
+	m["https://joinpeertube.org/ns"] = this.alias
+}
+
+// Clear ensures no value of this property is set. Calling
+// IsXMLSchemaNonNegativeInteger afterwards will return false.
+func (this *PeerTubeViewsProperty) Clear() {
+	this.unknown = nil
+	this.iri = nil
+	this.hasNonNegativeIntegerMember = false
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this PeerTubeViewsProperty) Compare(o vocab.PeerTubeViewsProperty) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsXMLSchemaNonNegativeInteger() && !o.IsXMLSchemaNonNegativeInteger() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsXMLSchemaNonNegativeInteger() && !o.IsXMLSchemaNonNegativeInteger() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsXMLSchemaNonNegativeInteger() && o.IsXMLSchemaNonNegativeInteger() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	if lhs, rhs := this.Get(), o.Get(); nonnegativeinteger.LessNonNegativeInteger(lhs, rhs) {
+		return -1
+	} else if nonnegativeinteger.LessNonNegativeInteger(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
+// Get returns the value of this property. When IsXMLSchemaNonNegativeInteger
+// returns false, Get will return any arbitrary value.
+func (this PeerTubeViewsProperty) Get() int {
+	return this.xmlschemaNonNegativeIntegerMember
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return any arbitrary value.
+func (this PeerTubeViewsProperty) GetIRI() *url.URL {
+	return this.iri
+}
+
+// HasAny returns true if the value or IRI is set.
+func (this PeerTubeViewsProperty) HasAny() bool {
+	return this.IsXMLSchemaNonNegativeInteger() || this.iri != nil
+}
+
+// IsIRI returns true if this property is an IRI.
+func (this PeerTubeViewsProperty) IsIRI() bool {
+	return this.iri != nil
+}
+
+// IsXMLSchemaNonNegativeInteger returns true if this property is set and not an
+// IRI.
+func (this PeerTubeViewsProperty) IsXMLSchemaNonNegativeInteger() bool {
+	return this.hasNonNegativeIntegerMember
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this PeerTubeViewsProperty) JSONLDContext() map[string]string {
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this PeerTubeViewsProperty) KindIndex() int {
+	if this.IsXMLSchemaNonNegativeInteger() {
+		return 0
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this PeerTubeViewsProperty) LessThan(o vocab.PeerTubeViewsProperty) bool {
+	return this.Compare(o) < 0
+}
+
+// Name returns the name of this property: "views".
+func (this PeerTubeViewsProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":" + "views"
+	} else {
+		return "views"
+	}
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *PeerTubeViewsProperty) Release() {
+	*this = PeerTubeViewsProperty{}
+	poolPeerTubeViewsProperty.Put(this)
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this PeerTubeViewsProperty) Serialize() (interface{}, error) {
+	if this.IsXMLSchemaNonNegativeInteger() {
+		return nonnegativeinteger.SerializeNonNegativeInteger(this.Get())
+	} else if this.IsIRI() {
+		return this.iri.String(), nil
+	}
+	return this.unknown, nil
+}
+
+// Set sets the value of this property. Calling IsXMLSchemaNonNegativeInteger
+// afterwards will return true.
+func (this *PeerTubeViewsProperty) Set(v int) {
+	this.Clear()
+	this.xmlschemaNonNegativeIntegerMember = v
+	this.hasNonNegativeIntegerMember = true
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards will return
+// true.
+func (this *PeerTubeViewsProperty) SetIRI(v *url.URL) {
+	this.Clear()
+	this.iri = v
+}
+
+// poolPeerTubeViewsProperty holds the released values of PeerTubeViewsProperty, which are reused when deserializing.
+var poolPeerTubeViewsProperty = sync.Pool{New: func() interface{} {
+	return &PeerTubeViewsProperty{}
+}}
@@ -7,6 +7,8 @@ import (
 	boolean "github.com/go-fed/activity/streams/values/boolean"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // TootDiscoverableProperty is the functional property "discoverable". It is
@@ -40,7 +42,8 @@ func DeserializeDiscoverableProperty(m map[string]interface{}, aliasMap map[stri
 			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 			if err == nil && len(u.Scheme) > 0 {
-				this := &TootDiscoverableProperty{
+				this := poolTootDiscoverableProperty.Get().(*TootDiscoverableProperty)
+				*this = TootDiscoverableProperty{
 					alias: alias,
 					iri:   u,
 				}
@@ -48,14 +51,16 @@ func DeserializeDiscoverableProperty(m map[string]interface{}, aliasMap map[stri
 			}
 		}
 		if v, err := boolean.DeserializeBoolean(i); err == nil {
-			this := &TootDiscoverableProperty{
+			this := poolTootDiscoverableProperty.Get().(*TootDiscoverableProperty)
+			*this = TootDiscoverableProperty{
 				alias:                  alias,
 				hasBooleanMember:       true,
 				xmlschemaBooleanMember: v,
 			}
 			return this, nil
 		}
-		this := &TootDiscoverableProperty{
+		this := poolTootDiscoverableProperty.Get().(*TootDiscoverableProperty)
+		*this = TootDiscoverableProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -69,6 +74,14 @@ func NewTootDiscoverableProperty() *TootDiscoverableProperty {
 	return &TootDiscoverableProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this TootDiscoverableProperty) AddJSONLDContext(m map[string]string) {
+	m["http://joinmastodon.org/ns"] = this.alias
+}
+
 // Clear ensures no value of this property is set. Calling IsXMLSchemaBoolean
 // afterwards will return false.
 func (this *TootDiscoverableProperty) Clear() {
@@ -77,6 +90,42 @@ func (this *TootDiscoverableProperty) Clear() {
 	this.hasBooleanMember = false
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this TootDiscoverableProperty) Compare(o vocab.TootDiscoverableProperty) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsXMLSchemaBoolean() && !o.IsXMLSchemaBoolean() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsXMLSchemaBoolean() && !o.IsXMLSchemaBoolean() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsXMLSchemaBoolean() && o.IsXMLSchemaBoolean() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	if lhs, rhs := this.Get(), o.Get(); boolean.LessBoolean(lhs, rhs) {
+		return -1
+	} else if boolean.LessBoolean(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
 // Get returns the value of this property. When IsXMLSchemaBoolean returns false,
 // Get will return any arbitrary value.
 func (this TootDiscoverableProperty) Get() bool {
@@ -108,17 +157,8 @@ func (this TootDiscoverableProperty) IsXMLSchemaBoolean() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this TootDiscoverableProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"http://joinmastodon.org/ns": this.alias}
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -140,30 +180,7 @@ func (this TootDiscoverableProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this TootDiscoverableProperty) LessThan(o vocab.TootDiscoverableProperty) bool {
-	// LessThan comparison for if either or both are IRIs.
-	if this.IsIRI() && o.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	} else if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsXMLSchemaBoolean() && !o.IsXMLSchemaBoolean() {
-		// Both are unknowns.
-		return false
-	} else if this.IsXMLSchemaBoolean() && !o.IsXMLSchemaBoolean() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsXMLSchemaBoolean() && o.IsXMLSchemaBoolean() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return boolean.LessBoolean(this.Get(), o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "discoverable".
@@ -175,6 +192,15 @@ func (this TootDiscoverableProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *TootDiscoverableProperty) Release() {
+	*this = TootDiscoverableProperty{}
+	poolTootDiscoverableProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -202,3 +228,8 @@ func (this *TootDiscoverableProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.iri = v
 }
+
+// poolTootDiscoverableProperty holds the released values of TootDiscoverableProperty, which are reused when deserializing.
+var poolTootDiscoverableProperty = sync.Pool{New: func() interface{} {
+	return &TootDiscoverableProperty{}
+}}
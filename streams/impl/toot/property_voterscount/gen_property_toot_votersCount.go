@@ -7,6 +7,8 @@ import (
 	nonnegativeinteger "github.com/go-fed/activity/streams/values/nonNegativeInteger"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // TootVotersCountProperty is the functional property "votersCount". It is
@@ -40,7 +42,8 @@ func DeserializeVotersCountProperty(m map[string]interface{}, aliasMap map[strin
 			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 			if err == nil && len(u.Scheme) > 0 {
-				this := &TootVotersCountProperty{
+				this := poolTootVotersCountProperty.Get().(*TootVotersCountProperty)
+				*this = TootVotersCountProperty{
 					alias: alias,
 					iri:   u,
 				}
@@ -48,14 +51,16 @@ func DeserializeVotersCountProperty(m map[string]interface{}, aliasMap map[strin
 			}
 		}
 		if v, err := nonnegativeinteger.DeserializeNonNegativeInteger(i); err == nil {
-			this := &TootVotersCountProperty{
+			this := poolTootVotersCountProperty.Get().(*TootVotersCountProperty)
+			*this = TootVotersCountProperty{
 				alias:                             alias,
 				hasNonNegativeIntegerMember:       true,
 				xmlschemaNonNegativeIntegerMember: v,
 			}
 			return this, nil
 		}
-		this := &TootVotersCountProperty{
+		this := poolTootVotersCountProperty.Get().(*TootVotersCountProperty)
+		*this = TootVotersCountProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -69,6 +74,14 @@ func NewTootVotersCountProperty() *TootVotersCountProperty {
 	return &TootVotersCountProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this TootVotersCountProperty) AddJSONLDContext(m map[string]string) {
+	m["http://joinmastodon.org/ns"] = this.alias
+}
+
 // Clear ensures no value of this property is set. Calling
 // IsXMLSchemaNonNegativeInteger afterwards will return false.
 func (this *TootVotersCountProperty) Clear() {
@@ -77,6 +90,42 @@ func (this *TootVotersCountProperty) Clear() {
 	this.hasNonNegativeIntegerMember = false
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this TootVotersCountProperty) Compare(o vocab.TootVotersCountProperty) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsXMLSchemaNonNegativeInteger() && !o.IsXMLSchemaNonNegativeInteger() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsXMLSchemaNonNegativeInteger() && !o.IsXMLSchemaNonNegativeInteger() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsXMLSchemaNonNegativeInteger() && o.IsXMLSchemaNonNegativeInteger() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	if lhs, rhs := this.Get(), o.Get(); nonnegativeinteger.LessNonNegativeInteger(lhs, rhs) {
+		return -1
+	} else if nonnegativeinteger.LessNonNegativeInteger(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
 // Get returns the value of this property. When IsXMLSchemaNonNegativeInteger
 // returns false, Get will return any arbitrary value.
 func (this TootVotersCountProperty) Get() int {
@@ -109,17 +158,8 @@ func (this TootVotersCountProperty) IsXMLSchemaNonNegativeInteger() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this TootVotersCountProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"http://joinmastodon.org/ns": this.alias}
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -141,30 +181,7 @@ func (this TootVotersCountProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this TootVotersCountProperty) LessThan(o vocab.TootVotersCountProperty) bool {
-	// LessThan comparison for if either or both are IRIs.
-	if this.IsIRI() && o.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	} else if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsXMLSchemaNonNegativeInteger() && !o.IsXMLSchemaNonNegativeInteger() {
-		// Both are unknowns.
-		return false
-	} else if this.IsXMLSchemaNonNegativeInteger() && !o.IsXMLSchemaNonNegativeInteger() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsXMLSchemaNonNegativeInteger() && o.IsXMLSchemaNonNegativeInteger() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return nonnegativeinteger.LessNonNegativeInteger(this.Get(), o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "votersCount".
@@ -176,6 +193,15 @@ func (this TootVotersCountProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *TootVotersCountProperty) Release() {
+	*this = TootVotersCountProperty{}
+	poolTootVotersCountProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -203,3 +229,8 @@ func (this *TootVotersCountProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.iri = v
 }
+
+// poolTootVotersCountProperty holds the released values of TootVotersCountProperty, which are reused when deserializing.
+var poolTootVotersCountProperty = sync.Pool{New: func() interface{} {
+	return &TootVotersCountProperty{}
+}}
@@ -7,6 +7,7 @@ import (
 	anyuri "github.com/go-fed/activity/streams/values/anyURI"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"sync"
 )
 
 // W3IDSecurityV1OwnerProperty is the functional property "owner". It is permitted
@@ -33,13 +34,15 @@ func DeserializeOwnerProperty(m map[string]interface{}, aliasMap map[string]stri
 
 	if ok {
 		if v, err := anyuri.DeserializeAnyURI(i); err == nil {
-			this := &W3IDSecurityV1OwnerProperty{
+			this := poolW3IDSecurityV1OwnerProperty.Get().(*W3IDSecurityV1OwnerProperty)
+			*this = W3IDSecurityV1OwnerProperty{
 				alias:                 alias,
 				xmlschemaAnyURIMember: v,
 			}
 			return this, nil
 		}
-		this := &W3IDSecurityV1OwnerProperty{
+		this := poolW3IDSecurityV1OwnerProperty.Get().(*W3IDSecurityV1OwnerProperty)
+		*this = W3IDSecurityV1OwnerProperty{
 			alias:   alias,
 			unknown: i,
 		}
@@ -53,6 +56,14 @@ func NewW3IDSecurityV1OwnerProperty() *W3IDSecurityV1OwnerProperty {
 	return &W3IDSecurityV1OwnerProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this W3IDSecurityV1OwnerProperty) AddJSONLDContext(m map[string]string) {
+	m["https://w3id.org/security/v1"] = this.alias
+}
+
 // Clear ensures no value of this property is set. Calling IsXMLSchemaAnyURI
 // afterwards will return false.
 func (this *W3IDSecurityV1OwnerProperty) Clear() {
@@ -60,6 +71,39 @@ func (this *W3IDSecurityV1OwnerProperty) Clear() {
 	this.xmlschemaAnyURIMember = nil
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this W3IDSecurityV1OwnerProperty) Compare(o vocab.W3IDSecurityV1OwnerProperty) int {
+	if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsXMLSchemaAnyURI() && o.IsXMLSchemaAnyURI() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	if lhs, rhs := this.Get(), o.Get(); anyuri.LessAnyURI(lhs, rhs) {
+		return -1
+	} else if anyuri.LessAnyURI(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
 // Get returns the value of this property. When IsXMLSchemaAnyURI returns false,
 // Get will return any arbitrary value.
 func (this W3IDSecurityV1OwnerProperty) Get() *url.URL {
@@ -91,17 +135,8 @@ func (this W3IDSecurityV1OwnerProperty) IsXMLSchemaAnyURI() bool {
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this W3IDSecurityV1OwnerProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://w3id.org/security/v1": this.alias}
-	var child map[string]string
-
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -123,27 +158,7 @@ func (this W3IDSecurityV1OwnerProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this W3IDSecurityV1OwnerProperty) LessThan(o vocab.W3IDSecurityV1OwnerProperty) bool {
-	if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
-		// Both are unknowns.
-		return false
-	} else if this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsXMLSchemaAnyURI() && o.IsXMLSchemaAnyURI() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return anyuri.LessAnyURI(this.Get(), o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "owner".
@@ -155,6 +170,15 @@ func (this W3IDSecurityV1OwnerProperty) Name() string {
 	}
 }
 
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *W3IDSecurityV1OwnerProperty) Release() {
+	*this = W3IDSecurityV1OwnerProperty{}
+	poolW3IDSecurityV1OwnerProperty.Put(this)
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -179,3 +203,8 @@ func (this *W3IDSecurityV1OwnerProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.Set(v)
 }
+
+// poolW3IDSecurityV1OwnerProperty holds the released values of W3IDSecurityV1OwnerProperty, which are reused when deserializing.
+var poolW3IDSecurityV1OwnerProperty = sync.Pool{New: func() interface{} {
+	return &W3IDSecurityV1OwnerProperty{}
+}}
@@ -4,8 +4,11 @@ package propertypublickey
 
 import (
 	"fmt"
+	proplist "github.com/go-fed/activity/streams/impl/proplist"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 // W3IDSecurityV1PublicKeyPropertyIterator is an iterator for a property. It is
@@ -15,8 +18,7 @@ type W3IDSecurityV1PublicKeyPropertyIterator struct {
 	unknown                       interface{}
 	iri                           *url.URL
 	alias                         string
-	myIdx                         int
-	parent                        vocab.W3IDSecurityV1PublicKeyProperty
+	proplist.Links[*W3IDSecurityV1PublicKeyPropertyIterator]
 }
 
 // NewW3IDSecurityV1PublicKeyPropertyIterator creates a new
@@ -37,7 +39,8 @@ func deserializeW3IDSecurityV1PublicKeyPropertyIterator(i interface{}, aliasMap
 		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
 		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
 		if err == nil && len(u.Scheme) > 0 {
-			this := &W3IDSecurityV1PublicKeyPropertyIterator{
+			this := poolW3IDSecurityV1PublicKeyPropertyIterator.Get().(*W3IDSecurityV1PublicKeyPropertyIterator)
+			*this = W3IDSecurityV1PublicKeyPropertyIterator{
 				alias: alias,
 				iri:   u,
 			}
@@ -46,20 +49,77 @@ func deserializeW3IDSecurityV1PublicKeyPropertyIterator(i interface{}, aliasMap
 	}
 	if m, ok := i.(map[string]interface{}); ok {
 		if v, err := mgr.DeserializePublicKeyW3IDSecurityV1()(m, aliasMap); err == nil {
-			this := &W3IDSecurityV1PublicKeyPropertyIterator{
+			this := poolW3IDSecurityV1PublicKeyPropertyIterator.Get().(*W3IDSecurityV1PublicKeyPropertyIterator)
+			*this = W3IDSecurityV1PublicKeyPropertyIterator{
 				alias:                         alias,
 				w3idsecurityv1PublicKeyMember: v,
 			}
 			return this, nil
 		}
 	}
-	this := &W3IDSecurityV1PublicKeyPropertyIterator{
+	this := poolW3IDSecurityV1PublicKeyPropertyIterator.Get().(*W3IDSecurityV1PublicKeyPropertyIterator)
+	*this = W3IDSecurityV1PublicKeyPropertyIterator{
 		alias:   alias,
 		unknown: i,
 	}
 	return this, nil
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and its value.
+func (this W3IDSecurityV1PublicKeyPropertyIterator) AddJSONLDContext(m map[string]string) {
+	m["https://w3id.org/security/v1"] = this.alias
+	if this.IsW3IDSecurityV1PublicKey() {
+		if a, ok := this.w3idsecurityv1PublicKeyMember.(interface {
+			AddJSONLDContext(map[string]string)
+		}); ok {
+			a.AddJSONLDContext(m)
+		} else {
+			/*
+			   Since the literal maps in this function are determined at
+			   code-generation time, this loop should not overwrite an existing key with a
+			   new value.
+			*/
+			for k, v := range this.w3idsecurityv1PublicKeyMember.JSONLDContext() {
+				m[k] = v
+			}
+		}
+	}
+}
+
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this W3IDSecurityV1PublicKeyPropertyIterator) Compare(o vocab.W3IDSecurityV1PublicKeyPropertyIterator) int {
+	// Compare comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return strings.Compare(this.iri.String(), o.GetIRI().String())
+	} else if this.IsIRI() && !o.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return -1
+	} else if !this.IsIRI() && o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return 1
+	}
+	// Compare comparison for the single value or unknown value.
+	if !this.IsW3IDSecurityV1PublicKey() && !o.IsW3IDSecurityV1PublicKey() {
+		// Both are unknowns.
+		return 0
+	} else if this.IsW3IDSecurityV1PublicKey() && !o.IsW3IDSecurityV1PublicKey() {
+		// Values are always greater than unknown values.
+		return 1
+	} else if !this.IsW3IDSecurityV1PublicKey() && o.IsW3IDSecurityV1PublicKey() {
+		// Unknowns are always less than known values.
+		return -1
+	}
+	// Actual comparison.
+	return this.Get().Compare(o.Get())
+}
+
 // Get returns the value of this property. When IsW3IDSecurityV1PublicKey returns
 // false, Get will return any arbitrary value.
 func (this W3IDSecurityV1PublicKeyPropertyIterator) Get() vocab.W3IDSecurityV1PublicKey {
@@ -101,19 +161,8 @@ func (this W3IDSecurityV1PublicKeyPropertyIterator) IsW3IDSecurityV1PublicKey()
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this W3IDSecurityV1PublicKeyPropertyIterator) JSONLDContext() map[string]string {
-	m := map[string]string{"https://w3id.org/security/v1": this.alias}
-	var child map[string]string
-	if this.IsW3IDSecurityV1PublicKey() {
-		child = this.Get().JSONLDContext()
-	}
-	/*
-	   Since the literal maps in this function are determined at
-	   code-generation time, this loop should not overwrite an existing key with a
-	   new value.
-	*/
-	for k, v := range child {
-		m[k] = v
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -135,30 +184,7 @@ func (this W3IDSecurityV1PublicKeyPropertyIterator) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this W3IDSecurityV1PublicKeyPropertyIterator) LessThan(o vocab.W3IDSecurityV1PublicKeyPropertyIterator) bool {
-	// LessThan comparison for if either or both are IRIs.
-	if this.IsIRI() && o.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	} else if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
-		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
-		return false
-	}
-	// LessThan comparison for the single value or unknown value.
-	if !this.IsW3IDSecurityV1PublicKey() && !o.IsW3IDSecurityV1PublicKey() {
-		// Both are unknowns.
-		return false
-	} else if this.IsW3IDSecurityV1PublicKey() && !o.IsW3IDSecurityV1PublicKey() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsW3IDSecurityV1PublicKey() && o.IsW3IDSecurityV1PublicKey() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
-		return this.Get().LessThan(o.Get())
-	}
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property: "W3IDSecurityV1PublicKey".
@@ -170,22 +196,38 @@ func (this W3IDSecurityV1PublicKeyPropertyIterator) Name() string {
 	}
 }
 
-// Next returns the next iterator, or nil if there is no next iterator.
+// Next returns the next iterator, or nil if there is no next iterator. It remains
+// correct when values are added to or removed from the property, including
+// this one, without obtaining the iterator again.
 func (this W3IDSecurityV1PublicKeyPropertyIterator) Next() vocab.W3IDSecurityV1PublicKeyPropertyIterator {
-	if this.myIdx+1 >= this.parent.Len() {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx + 1)
+	if it := proplist.Next(&this); it != nil {
+		return it
 	}
+	return nil
 }
 
-// Prev returns the previous iterator, or nil if there is no previous iterator.
+// Prev returns the previous iterator, or nil if there is no previous iterator. It
+// remains correct when values are added to or removed from the property,
+// including this one, without obtaining the iterator again.
 func (this W3IDSecurityV1PublicKeyPropertyIterator) Prev() vocab.W3IDSecurityV1PublicKeyPropertyIterator {
-	if this.myIdx-1 < 0 {
-		return nil
-	} else {
-		return this.parent.At(this.myIdx - 1)
+	if it := proplist.Prev(&this); it != nil {
+		return it
+	}
+	return nil
+}
+
+// Release returns this property and any type it holds to the pools from which
+// they are reused when deserializing. Neither may be used afterwards, so it
+// is only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *W3IDSecurityV1PublicKeyPropertyIterator) Release() {
+	if r, ok := this.w3idsecurityv1PublicKeyMember.(interface {
+		Release()
+	}); ok {
+		r.Release()
 	}
+	*this = W3IDSecurityV1PublicKeyPropertyIterator{}
+	poolW3IDSecurityV1PublicKeyPropertyIterator.Put(this)
 }
 
 // Set sets the value of this property. Calling IsW3IDSecurityV1PublicKey
@@ -237,7 +279,7 @@ func (this W3IDSecurityV1PublicKeyPropertyIterator) serialize() (interface{}, er
 // W3IDSecurityV1PublicKeyProperty is the non-functional property "publicKey". It
 // is permitted to have one or more values, and of different value types.
 type W3IDSecurityV1PublicKeyProperty struct {
-	properties []*W3IDSecurityV1PublicKeyPropertyIterator
+	properties proplist.List[*W3IDSecurityV1PublicKeyPropertyIterator]
 	alias      string
 }
 
@@ -255,10 +297,9 @@ func DeserializePublicKeyProperty(m map[string]interface{}, aliasMap map[string]
 	i, ok := m[propName]
 
 	if ok {
-		this := &W3IDSecurityV1PublicKeyProperty{
-			alias:      alias,
-			properties: []*W3IDSecurityV1PublicKeyPropertyIterator{},
-		}
+		// A released value keeps its properties for reuse.
+		this := poolW3IDSecurityV1PublicKeyProperty.Get().(*W3IDSecurityV1PublicKeyProperty)
+		this.alias = alias
 		if list, ok := i.([]interface{}); ok {
 			for _, iterator := range list {
 				if p, err := deserializeW3IDSecurityV1PublicKeyPropertyIterator(iterator, aliasMap); err != nil {
@@ -275,10 +316,7 @@ func DeserializePublicKeyProperty(m map[string]interface{}, aliasMap map[string]
 			}
 		}
 		// Set up the properties for iteration.
-		for idx, ele := range this.properties {
-			ele.parent = this
-			ele.myIdx = idx
-		}
+		this.properties.Link()
 		return this, nil
 	}
 	return nil, nil
@@ -289,40 +327,42 @@ func NewW3IDSecurityV1PublicKeyProperty() *W3IDSecurityV1PublicKeyProperty {
 	return &W3IDSecurityV1PublicKeyProperty{alias: ""}
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// property and the specific values that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the property and each of its values.
+func (this W3IDSecurityV1PublicKeyProperty) AddJSONLDContext(m map[string]string) {
+	m["https://w3id.org/security/v1"] = this.alias
+	for _, elem := range this.properties {
+		elem.AddJSONLDContext(m)
+	}
+}
+
 // AppendIRI appends an IRI value to the back of a list of the property "publicKey"
 func (this *W3IDSecurityV1PublicKeyProperty) AppendIRI(v *url.URL) {
-	this.properties = append(this.properties, &W3IDSecurityV1PublicKeyPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  this.Len(),
-		parent: this,
+	this.properties.Append(&W3IDSecurityV1PublicKeyPropertyIterator{
+		alias: this.alias,
+		iri:   v,
 	})
 }
 
-// PrependType prepends an arbitrary type value to the front of a list of the
-// property "publicKey". Invalidates iterators that are traversing using Prev.
-// Returns an error if the type is not a valid one to set for this property.
+// AppendType appends an arbitrary type value to the back of a list of the
+// property "publicKey". Returns an error if the type is not a valid one to
+// set for this property.
 func (this *W3IDSecurityV1PublicKeyProperty) AppendType(t vocab.Type) error {
-	n := &W3IDSecurityV1PublicKeyPropertyIterator{
-		alias:  this.alias,
-		myIdx:  this.Len(),
-		parent: this,
-	}
+	n := &W3IDSecurityV1PublicKeyPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append(this.properties, n)
+	this.properties.Append(n)
 	return nil
 }
 
 // AppendW3IDSecurityV1PublicKey appends a PublicKey value to the back of a list
-// of the property "publicKey". Invalidates iterators that are traversing
-// using Prev.
+// of the property "publicKey".
 func (this *W3IDSecurityV1PublicKeyProperty) AppendW3IDSecurityV1PublicKey(v vocab.W3IDSecurityV1PublicKey) {
-	this.properties = append(this.properties, &W3IDSecurityV1PublicKeyPropertyIterator{
+	this.properties.Append(&W3IDSecurityV1PublicKeyPropertyIterator{
 		alias:                         this.alias,
-		myIdx:                         this.Len(),
-		parent:                        this,
 		w3idsecurityv1PublicKeyMember: v,
 	})
 }
@@ -344,6 +384,31 @@ func (this W3IDSecurityV1PublicKeyProperty) Begin() vocab.W3IDSecurityV1PublicKe
 	}
 }
 
+// Compare compares two instances of this property with an arbitrary but stable
+// comparison, returning a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Applications should not use
+// this because it is only meant to help alternative implementations to go-fed
+// to be able to normalize nonfunctional properties.
+func (this W3IDSecurityV1PublicKeyProperty) Compare(o vocab.W3IDSecurityV1PublicKeyProperty) int {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if c := this.properties[i].Compare(o.At(i)); c != 0 {
+			return c
+		}
+	}
+	if l1 < l2 {
+		return -1
+	} else if l1 > l2 {
+		return 1
+	}
+	return 0
+}
+
 // Empty returns returns true if there are no elements.
 func (this W3IDSecurityV1PublicKeyProperty) Empty() bool {
 	return this.Len() == 0
@@ -358,75 +423,42 @@ func (this W3IDSecurityV1PublicKeyProperty) End() vocab.W3IDSecurityV1PublicKeyP
 
 // Insert inserts an IRI value at the specified index for a property "publicKey".
 // Existing elements at that index and higher are shifted back once.
-// Invalidates all iterators.
 func (this *W3IDSecurityV1PublicKeyProperty) InsertIRI(idx int, v *url.URL) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &W3IDSecurityV1PublicKeyPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, &W3IDSecurityV1PublicKeyPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
-// PrependType prepends an arbitrary type value to the front of a list of the
-// property "publicKey". Invalidates all iterators. Returns an error if the
-// type is not a valid one to set for this property.
+// InsertType inserts an arbitrary type value at the specified index for the
+// property "publicKey". Existing elements at that index and higher are
+// shifted back once. Returns an error if the type is not a valid one to set
+// for this property.
 func (this *W3IDSecurityV1PublicKeyProperty) InsertType(idx int, t vocab.Type) error {
-	n := &W3IDSecurityV1PublicKeyPropertyIterator{
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+	n := &W3IDSecurityV1PublicKeyPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = n
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Insert(idx, n)
 	return nil
 }
 
 // InsertW3IDSecurityV1PublicKey inserts a PublicKey value at the specified index
 // for a property "publicKey". Existing elements at that index and higher are
-// shifted back once. Invalidates all iterators.
+// shifted back once.
 func (this *W3IDSecurityV1PublicKeyProperty) InsertW3IDSecurityV1PublicKey(idx int, v vocab.W3IDSecurityV1PublicKey) {
-	this.properties = append(this.properties, nil)
-	copy(this.properties[idx+1:], this.properties[idx:])
-	this.properties[idx] = &W3IDSecurityV1PublicKeyPropertyIterator{
+	this.properties.Insert(idx, &W3IDSecurityV1PublicKeyPropertyIterator{
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
 		w3idsecurityv1PublicKeyMember: v,
-	}
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	})
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this W3IDSecurityV1PublicKeyProperty) JSONLDContext() map[string]string {
-	m := map[string]string{"https://w3id.org/security/v1": this.alias}
-	for _, elem := range this.properties {
-		child := elem.JSONLDContext()
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		for k, v := range child {
-			m[k] = v
-		}
-	}
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
@@ -440,7 +472,7 @@ func (this W3IDSecurityV1PublicKeyProperty) KindIndex(idx int) int {
 
 // Len returns the number of values that exist for the "publicKey" property.
 func (this W3IDSecurityV1PublicKeyProperty) Len() (length int) {
-	return len(this.properties)
+	return this.properties.Len()
 }
 
 // Less computes whether another property is less than this one. Mixing types
@@ -469,20 +501,7 @@ func (this W3IDSecurityV1PublicKeyProperty) Less(i, j int) bool {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this W3IDSecurityV1PublicKeyProperty) LessThan(o vocab.W3IDSecurityV1PublicKeyProperty) bool {
-	l1 := this.Len()
-	l2 := o.Len()
-	l := l1
-	if l2 < l1 {
-		l = l2
-	}
-	for i := 0; i < l; i++ {
-		if this.properties[i].LessThan(o.At(i)) {
-			return true
-		} else if o.At(i).LessThan(this.properties[i]) {
-			return false
-		}
-	}
-	return l1 < l2
+	return this.Compare(o) < 0
 }
 
 // Name returns the name of this property ("publicKey") with any alias.
@@ -497,61 +516,50 @@ func (this W3IDSecurityV1PublicKeyProperty) Name() string {
 // PrependIRI prepends an IRI value to the front of a list of the property
 // "publicKey".
 func (this *W3IDSecurityV1PublicKeyProperty) PrependIRI(v *url.URL) {
-	this.properties = append([]*W3IDSecurityV1PublicKeyPropertyIterator{{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  0,
-		parent: this,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(&W3IDSecurityV1PublicKeyPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // PrependType prepends an arbitrary type value to the front of a list of the
-// property "publicKey". Invalidates all iterators. Returns an error if the
-// type is not a valid one to set for this property.
+// property "publicKey". Returns an error if the type is not a valid one to
+// set for this property.
 func (this *W3IDSecurityV1PublicKeyProperty) PrependType(t vocab.Type) error {
-	n := &W3IDSecurityV1PublicKeyPropertyIterator{
-		alias:  this.alias,
-		myIdx:  0,
-		parent: this,
-	}
+	n := &W3IDSecurityV1PublicKeyPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	this.properties = append([]*W3IDSecurityV1PublicKeyPropertyIterator{n}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Prepend(n)
 	return nil
 }
 
 // PrependW3IDSecurityV1PublicKey prepends a PublicKey value to the front of a
-// list of the property "publicKey". Invalidates all iterators.
+// list of the property "publicKey".
 func (this *W3IDSecurityV1PublicKeyProperty) PrependW3IDSecurityV1PublicKey(v vocab.W3IDSecurityV1PublicKey) {
-	this.properties = append([]*W3IDSecurityV1PublicKeyPropertyIterator{{
+	this.properties.Prepend(&W3IDSecurityV1PublicKeyPropertyIterator{
 		alias:                         this.alias,
-		myIdx:                         0,
-		parent:                        this,
 		w3idsecurityv1PublicKeyMember: v,
-	}}, this.properties...)
-	for i := 1; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
+	})
+}
+
+// Release returns this property and its values to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *W3IDSecurityV1PublicKeyProperty) Release() {
+	for i, elem := range this.properties {
+		elem.Release()
+		this.properties[i] = nil
 	}
+	*this = W3IDSecurityV1PublicKeyProperty{properties: this.properties[:0]}
+	poolW3IDSecurityV1PublicKeyProperty.Put(this)
 }
 
 // Remove deletes an element at the specified index from a list of the property
 // "publicKey", regardless of its type. Panics if the index is out of bounds.
-// Invalidates all iterators.
 func (this *W3IDSecurityV1PublicKeyProperty) Remove(idx int) {
-	(this.properties)[idx].parent = nil
-	copy((this.properties)[idx:], (this.properties)[idx+1:])
-	(this.properties)[len(this.properties)-1] = &W3IDSecurityV1PublicKeyPropertyIterator{}
-	this.properties = (this.properties)[:len(this.properties)-1]
-	for i := idx; i < this.Len(); i++ {
-		(this.properties)[i].myIdx = i
-	}
+	this.properties.Remove(idx)
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -575,47 +583,57 @@ func (this W3IDSecurityV1PublicKeyProperty) Serialize() (interface{}, error) {
 }
 
 // Set sets a PublicKey value to be at the specified index for the property
-// "publicKey". Panics if the index is out of bounds. Invalidates all
-// iterators.
+// "publicKey". Panics if the index is out of bounds.
 func (this *W3IDSecurityV1PublicKeyProperty) Set(idx int, v vocab.W3IDSecurityV1PublicKey) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &W3IDSecurityV1PublicKeyPropertyIterator{
+	this.properties.Set(idx, &W3IDSecurityV1PublicKeyPropertyIterator{
 		alias:                         this.alias,
-		myIdx:                         idx,
-		parent:                        this,
 		w3idsecurityv1PublicKeyMember: v,
-	}
+	})
 }
 
 // SetIRI sets an IRI value to be at the specified index for the property
 // "publicKey". Panics if the index is out of bounds.
 func (this *W3IDSecurityV1PublicKeyProperty) SetIRI(idx int, v *url.URL) {
-	(this.properties)[idx].parent = nil
-	(this.properties)[idx] = &W3IDSecurityV1PublicKeyPropertyIterator{
-		alias:  this.alias,
-		iri:    v,
-		myIdx:  idx,
-		parent: this,
-	}
+	this.properties.Set(idx, &W3IDSecurityV1PublicKeyPropertyIterator{
+		alias: this.alias,
+		iri:   v,
+	})
 }
 
 // SetType sets an arbitrary type value to the specified index of the property
-// "publicKey". Invalidates all iterators. Returns an error if the type is not
-// a valid one to set for this property. Panics if the index is out of bounds.
+// "publicKey". Returns an error if the type is not a valid one to set for
+// this property. Panics if the index is out of bounds.
 func (this *W3IDSecurityV1PublicKeyProperty) SetType(idx int, t vocab.Type) error {
-	n := &W3IDSecurityV1PublicKeyPropertyIterator{
-		alias:  this.alias,
-		myIdx:  idx,
-		parent: this,
-	}
+	n := &W3IDSecurityV1PublicKeyPropertyIterator{alias: this.alias}
 	if err := n.SetType(t); err != nil {
 		return err
 	}
-	(this.properties)[idx] = n
+	this.properties.Set(idx, n)
 	return nil
 }
 
 // Swap swaps the location of values at two indices for the "publicKey" property.
 func (this W3IDSecurityV1PublicKeyProperty) Swap(i, j int) {
-	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
+	this.properties.Swap(i, j)
+}
+
+// Values returns the iterators of all values from front to back, so they can be
+// ranged over. Adding values to or removing them from this property
+// afterwards does not change the returned slice.
+func (this W3IDSecurityV1PublicKeyProperty) Values() []vocab.W3IDSecurityV1PublicKeyPropertyIterator {
+	values := make([]vocab.W3IDSecurityV1PublicKeyPropertyIterator, len(this.properties))
+	for i, elem := range this.properties {
+		values[i] = elem
+	}
+	return values
 }
+
+// poolW3IDSecurityV1PublicKeyProperty holds the released values of W3IDSecurityV1PublicKeyProperty, which are reused when deserializing.
+var poolW3IDSecurityV1PublicKeyProperty = sync.Pool{New: func() interface{} {
+	return &W3IDSecurityV1PublicKeyProperty{}
+}}
+
+// poolW3IDSecurityV1PublicKeyPropertyIterator holds the released values of W3IDSecurityV1PublicKeyPropertyIterator, which are reused when deserializing.
+var poolW3IDSecurityV1PublicKeyPropertyIterator = sync.Pool{New: func() interface{} {
+	return &W3IDSecurityV1PublicKeyPropertyIterator{}
+}}
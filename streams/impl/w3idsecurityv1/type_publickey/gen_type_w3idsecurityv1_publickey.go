@@ -2,7 +2,10 @@
 
 package typepublickey
 
-import vocab "github.com/go-fed/activity/streams/vocab"
+import (
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"sync"
+)
 
 // A public key represents a public cryptographical key for a user
 type W3IDSecurityV1PublicKey struct {
@@ -20,11 +23,12 @@ func DeserializePublicKey(m map[string]interface{}, aliasMap map[string]string)
 	if a, ok := aliasMap["https://w3id.org/security/v1"]; ok {
 		alias = a
 	}
-	this := &W3IDSecurityV1PublicKey{
-		alias:   alias,
-		unknown: make(map[string]interface{}),
-	}
 
+	this := poolW3IDSecurityV1PublicKey.Get().(*W3IDSecurityV1PublicKey)
+	this.alias = alias
+	if this.unknown == nil {
+		this.unknown = make(map[string]interface{})
+	}
 	// Begin: Known property deserialization
 	if p, err := mgr.DeserializeIdPropertyJSONLD()(m, aliasMap); err != nil {
 		return nil, err
@@ -46,11 +50,7 @@ func DeserializePublicKey(m map[string]interface{}, aliasMap map[string]string)
 	// Begin: Unknown deserialization
 	for k, v := range m {
 		// Begin: Code that ensures a property name is unknown
-		if k == "id" {
-			continue
-		} else if k == "owner" {
-			continue
-		} else if k == "publicKeyPem" {
+		if _, ok := knownW3IDSecurityV1PublicKeyProperties[k]; ok {
 			continue
 		} // End: Code that ensures a property name is unknown
 
@@ -100,6 +100,73 @@ func W3IDSecurityV1PublicKeyExtends(other vocab.Type) bool {
 	return false
 }
 
+// AddJSONLDContext adds the JSONLD URIs required in the context string for this
+// type and the specific properties that are set to the map, along with the
+// aliases used to import them. Unlike JSONLDContext, it does not create a map
+// for the type and each of its properties.
+func (this W3IDSecurityV1PublicKey) AddJSONLDContext(m map[string]string) {
+	m["https://w3id.org/security/v1"] = this.alias
+	this.helperAddJSONLDContext(this.JSONLDId, m)
+	this.helperAddJSONLDContext(this.W3IDSecurityV1Owner, m)
+	this.helperAddJSONLDContext(this.W3IDSecurityV1PublicKeyPem, m)
+}
+
+// Compare compares this PublicKey with another, with an arbitrary but stable
+// determination. It returns a negative number if this is lesser, a positive
+// number if this is greater, and 0 if neither is. Each property is compared
+// once, so comparing deeply nested values costs half as much as calling
+// LessThan both ways.
+func (this W3IDSecurityV1PublicKey) Compare(o vocab.W3IDSecurityV1PublicKey) int {
+	// Begin: Compare known properties
+	// Compare property "id"
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return -1
+	} else if lhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return 1
+	} // Else: Both are nil or equal
+	// Compare property "owner"
+	if lhs, rhs := this.W3IDSecurityV1Owner, o.GetW3IDSecurityV1Owner(); lhs != nil && rhs != nil {
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return -1
+	} else if lhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return 1
+	} // Else: Both are nil or equal
+	// Compare property "publicKeyPem"
+	if lhs, rhs := this.W3IDSecurityV1PublicKeyPem, o.GetW3IDSecurityV1PublicKeyPem(); lhs != nil && rhs != nil {
+		if c := lhs.Compare(rhs); c != 0 {
+			return c
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return -1
+	} else if lhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return 1
+	} // Else: Both are nil or equal
+	// End: Compare known properties
+
+	// Begin: Compare unknown properties (only by number of them)
+	if len(this.unknown) < len(o.GetUnknownProperties()) {
+		return -1
+	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
+		return 1
+	} // End: Compare unknown properties (only by number of them)
+
+	// All properties are the same.
+	return 0
+}
+
 // GetJSONLDId returns the "id" property if it exists, and nil otherwise.
 func (this W3IDSecurityV1PublicKey) GetJSONLDId() vocab.JSONLDIdProperty {
 	return this.JSONLDId
@@ -141,77 +208,61 @@ func (this W3IDSecurityV1PublicKey) IsExtending(other vocab.Type) bool {
 // type and the specific properties that are set. The value in the map is the
 // alias used to import the type and its properties.
 func (this W3IDSecurityV1PublicKey) JSONLDContext() map[string]string {
-	m := map[string]string{"https://w3id.org/security/v1": this.alias}
-	m = this.helperJSONLDContext(this.JSONLDId, m)
-	m = this.helperJSONLDContext(this.W3IDSecurityV1Owner, m)
-	m = this.helperJSONLDContext(this.W3IDSecurityV1PublicKeyPem, m)
-
+	m := make(map[string]string)
+	this.AddJSONLDContext(m)
 	return m
 }
 
 // LessThan computes if this PublicKey is lesser, with an arbitrary but stable
 // determination.
 func (this W3IDSecurityV1PublicKey) LessThan(o vocab.W3IDSecurityV1PublicKey) bool {
-	// Begin: Compare known properties
-	// Compare property "id"
-	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
-		}
-	} else if lhs == nil && rhs != nil {
-		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
-		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
-	// Compare property "owner"
-	if lhs, rhs := this.W3IDSecurityV1Owner, o.GetW3IDSecurityV1Owner(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
-		}
-	} else if lhs == nil && rhs != nil {
-		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
-		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
-	// Compare property "publicKeyPem"
-	if lhs, rhs := this.W3IDSecurityV1PublicKeyPem, o.GetW3IDSecurityV1PublicKeyPem(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
-		}
-	} else if lhs == nil && rhs != nil {
-		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
-		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
-	// End: Compare known properties
-
-	// Begin: Compare unknown properties (only by number of them)
-	if len(this.unknown) < len(o.GetUnknownProperties()) {
-		return true
-	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
-		return false
-	} // End: Compare unknown properties (only by number of them)
+	return this.Compare(o) < 0
+}
 
-	// All properties are the same.
-	return false
+// Release returns this type and its properties to the pools from which they are
+// reused when deserializing. None of them may be used afterwards, so it is
+// only called by applications that are done with a deserialized value, to
+// reduce the allocations of deserializing the next one.
+func (this *W3IDSecurityV1PublicKey) Release() {
+	if r, ok := this.JSONLDId.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.W3IDSecurityV1Owner.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	if r, ok := this.W3IDSecurityV1PublicKeyPem.(interface {
+		Release()
+	}); ok {
+		r.Release()
+	}
+	unknown := this.unknown
+	for k := range unknown {
+		delete(unknown, k)
+	}
+	*this = W3IDSecurityV1PublicKey{unknown: unknown}
+	poolW3IDSecurityV1PublicKey.Put(this)
 }
 
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format.
 func (this W3IDSecurityV1PublicKey) Serialize() (map[string]interface{}, error) {
-	m := make(map[string]interface{})
+	// Begin: Count the properties to serialize
+	n := len(this.unknown)
+	if this.JSONLDId != nil {
+		n++
+	}
+	if this.W3IDSecurityV1Owner != nil {
+		n++
+	}
+	if this.W3IDSecurityV1PublicKeyPem != nil {
+		n++
+	}
+	// End: Count the properties to serialize
+	m := make(map[string]interface{}, n)
 	// Begin: Serialize known properties
 	// Maybe serialize property "id"
 	if this.JSONLDId != nil {
@@ -271,19 +322,37 @@ func (this W3IDSecurityV1PublicKey) VocabularyURI() string {
 	return "https://w3id.org/security/v1"
 }
 
-// helperJSONLDContext obtains the context uris and their aliases from a property,
-// if it is not nil.
-func (this W3IDSecurityV1PublicKey) helperJSONLDContext(i jsonldContexter, toMerge map[string]string) map[string]string {
+// helperAddJSONLDContext adds the context uris and their aliases from a property
+// to the map, if it is not nil.
+func (this W3IDSecurityV1PublicKey) helperAddJSONLDContext(i jsonldContexter, m map[string]string) {
 	if i == nil {
-		return toMerge
+		return
 	}
-	for k, v := range i.JSONLDContext() {
+	if a, ok := i.(interface {
+		AddJSONLDContext(map[string]string)
+	}); ok {
+		a.AddJSONLDContext(m)
+	} else {
 		/*
 		   Since the literal maps in this function are determined at
 		   code-generation time, this loop should not overwrite an existing key with a
 		   new value.
 		*/
-		toMerge[k] = v
+		for k, v := range i.JSONLDContext() {
+			m[k] = v
+		}
 	}
-	return toMerge
 }
+
+// knownW3IDSecurityV1PublicKeyProperties are the names of the properties of the
+// "PublicKey" type, which are not unknown properties.
+var knownW3IDSecurityV1PublicKeyProperties = map[string]struct{}{
+	"id":           {},
+	"owner":        {},
+	"publicKeyPem": {},
+}
+
+// poolW3IDSecurityV1PublicKey holds the released values of W3IDSecurityV1PublicKey, which are reused when deserializing.
+var poolW3IDSecurityV1PublicKey = sync.Pool{New: func() interface{} {
+	return &W3IDSecurityV1PublicKey{}
+}}
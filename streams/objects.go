@@ -0,0 +1,138 @@
+package streams
+
+import (
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// NewNote creates a Note with its 'content', attributed to an actor and
+// addressed to the recipients. The 'attributedTo' property is not set if the
+// actor is nil, nor is the 'to' property if there are no recipients.
+func NewNote(content string, attributedTo *url.URL, to []*url.URL) vocab.ActivityStreamsNote {
+	note := NewActivityStreamsNote()
+	contentProp := NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString(content)
+	note.SetActivityStreamsContent(contentProp)
+	if attributedTo != nil {
+		attributedToProp := NewActivityStreamsAttributedToProperty()
+		attributedToProp.AppendIRI(attributedTo)
+		note.SetActivityStreamsAttributedTo(attributedToProp)
+	}
+	if len(to) > 0 {
+		toProp := NewActivityStreamsToProperty()
+		for _, iri := range to {
+			toProp.AppendIRI(iri)
+		}
+		note.SetActivityStreamsTo(toProp)
+	}
+	return note
+}
+
+// NewCreateFromObject creates a Create of an object by an actor.
+//
+// The Create is addressed to the same recipients as the object, and published
+// at the same time, if the object has them. The recipients are copied as IRIs,
+// so the object's recipients that are types without an 'id' are left out.
+func NewCreateFromObject(obj vocab.Type, actor *url.URL) vocab.ActivityStreamsCreate {
+	create := NewActivityStreamsCreate()
+	objectProp := NewActivityStreamsObjectProperty()
+	objectProp.AppendType(obj)
+	create.SetActivityStreamsObject(objectProp)
+	actorProp := NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(actor)
+	create.SetActivityStreamsActor(actorProp)
+	if v, ok := obj.(interface {
+		GetActivityStreamsPublished() vocab.ActivityStreamsPublishedProperty
+	}); ok {
+		if published := v.GetActivityStreamsPublished(); published != nil && published.IsXMLSchemaDateTime() {
+			publishedProp := NewActivityStreamsPublishedProperty()
+			publishedProp.Set(published.Get())
+			create.SetActivityStreamsPublished(publishedProp)
+		}
+	}
+	if v, ok := obj.(interface {
+		GetActivityStreamsTo() vocab.ActivityStreamsToProperty
+	}); ok && v.GetActivityStreamsTo() != nil {
+		to := NewActivityStreamsToProperty()
+		for iter := v.GetActivityStreamsTo().Begin(); iter != nil; iter = iter.Next() {
+			if iri := recipientIRI(iter.GetIRI(), iter.GetType()); iri != nil {
+				to.AppendIRI(iri)
+			}
+		}
+		create.SetActivityStreamsTo(to)
+	}
+	if v, ok := obj.(interface {
+		GetActivityStreamsBto() vocab.ActivityStreamsBtoProperty
+	}); ok && v.GetActivityStreamsBto() != nil {
+		bto := NewActivityStreamsBtoProperty()
+		for iter := v.GetActivityStreamsBto().Begin(); iter != nil; iter = iter.Next() {
+			if iri := recipientIRI(iter.GetIRI(), iter.GetType()); iri != nil {
+				bto.AppendIRI(iri)
+			}
+		}
+		create.SetActivityStreamsBto(bto)
+	}
+	if v, ok := obj.(interface {
+		GetActivityStreamsCc() vocab.ActivityStreamsCcProperty
+	}); ok && v.GetActivityStreamsCc() != nil {
+		cc := NewActivityStreamsCcProperty()
+		for iter := v.GetActivityStreamsCc().Begin(); iter != nil; iter = iter.Next() {
+			if iri := recipientIRI(iter.GetIRI(), iter.GetType()); iri != nil {
+				cc.AppendIRI(iri)
+			}
+		}
+		create.SetActivityStreamsCc(cc)
+	}
+	if v, ok := obj.(interface {
+		GetActivityStreamsBcc() vocab.ActivityStreamsBccProperty
+	}); ok && v.GetActivityStreamsBcc() != nil {
+		bcc := NewActivityStreamsBccProperty()
+		for iter := v.GetActivityStreamsBcc().Begin(); iter != nil; iter = iter.Next() {
+			if iri := recipientIRI(iter.GetIRI(), iter.GetType()); iri != nil {
+				bcc.AppendIRI(iri)
+			}
+		}
+		create.SetActivityStreamsBcc(bcc)
+	}
+	if v, ok := obj.(interface {
+		GetActivityStreamsAudience() vocab.ActivityStreamsAudienceProperty
+	}); ok && v.GetActivityStreamsAudience() != nil {
+		audience := NewActivityStreamsAudienceProperty()
+		for iter := v.GetActivityStreamsAudience().Begin(); iter != nil; iter = iter.Next() {
+			if iri := recipientIRI(iter.GetIRI(), iter.GetType()); iri != nil {
+				audience.AppendIRI(iri)
+			}
+		}
+		create.SetActivityStreamsAudience(audience)
+	}
+	return create
+}
+
+// NewFollow creates a Follow of an object, such as another actor, by an actor.
+//
+// The Follow is addressed to the object, so that it is delivered to the actor
+// being followed.
+func NewFollow(actor, object *url.URL) vocab.ActivityStreamsFollow {
+	follow := NewActivityStreamsFollow()
+	actorProp := NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(actor)
+	follow.SetActivityStreamsActor(actorProp)
+	objectProp := NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(object)
+	follow.SetActivityStreamsObject(objectProp)
+	to := NewActivityStreamsToProperty()
+	to.AppendIRI(object)
+	follow.SetActivityStreamsTo(to)
+	return follow
+}
+
+// recipientIRI returns the IRI of a value of an addressing property, which is
+// either an IRI or a type with an 'id'. It returns nil if the value is neither.
+func recipientIRI(iri *url.URL, t vocab.Type) *url.URL {
+	if iri != nil {
+		return iri
+	} else if t != nil {
+		return GetId(t)
+	}
+	return nil
+}
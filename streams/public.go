@@ -0,0 +1,91 @@
+package streams
+
+import (
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+const (
+	// PublicCollection is the IRI of the special Public collection, which
+	// addresses a value to everyone.
+	PublicCollection = "https://www.w3.org/ns/activitystreams#Public"
+	// PublicCollectionCompact is the Public collection as a JSON-LD compact
+	// IRI.
+	PublicCollectionCompact = "as:Public"
+	// PublicCollectionTerm is the Public collection as a term of the
+	// ActivityStreams JSON-LD context.
+	PublicCollectionTerm = "Public"
+)
+
+// addressingProperty is any addressing property, such as 'to' or 'cc'.
+type addressingProperty interface {
+	Serialize() (interface{}, error)
+}
+
+// IsPublic returns true if the IRI is the Public collection, in any of the
+// spellings peers use for it.
+func IsPublic(iri *url.URL) bool {
+	return iri != nil && IsPublicString(iri.String())
+}
+
+// IsPublicString returns true if the string is the Public collection, in any of
+// the spellings peers use for it: PublicCollection, PublicCollectionCompact, or
+// PublicCollectionTerm.
+func IsPublicString(s string) bool {
+	return s == PublicCollection || s == PublicCollectionCompact || s == PublicCollectionTerm
+}
+
+// IsPubliclyAddressed returns true if the Public collection is in any of the
+// 'to', 'bto', 'cc', 'bcc', or 'audience' of a value.
+//
+// Unlike the other spellings, PublicCollectionTerm is not an IRI, so it is not
+// deserialized as one and is kept as an unknown value instead. It is still
+// recognized here.
+func IsPubliclyAddressed(t vocab.Type) bool {
+	var props []addressingProperty
+	if v, ok := t.(interface {
+		GetActivityStreamsTo() vocab.ActivityStreamsToProperty
+	}); ok && v.GetActivityStreamsTo() != nil {
+		props = append(props, v.GetActivityStreamsTo())
+	}
+	if v, ok := t.(interface {
+		GetActivityStreamsBto() vocab.ActivityStreamsBtoProperty
+	}); ok && v.GetActivityStreamsBto() != nil {
+		props = append(props, v.GetActivityStreamsBto())
+	}
+	if v, ok := t.(interface {
+		GetActivityStreamsCc() vocab.ActivityStreamsCcProperty
+	}); ok && v.GetActivityStreamsCc() != nil {
+		props = append(props, v.GetActivityStreamsCc())
+	}
+	if v, ok := t.(interface {
+		GetActivityStreamsBcc() vocab.ActivityStreamsBccProperty
+	}); ok && v.GetActivityStreamsBcc() != nil {
+		props = append(props, v.GetActivityStreamsBcc())
+	}
+	if v, ok := t.(interface {
+		GetActivityStreamsAudience() vocab.ActivityStreamsAudienceProperty
+	}); ok && v.GetActivityStreamsAudience() != nil {
+		props = append(props, v.GetActivityStreamsAudience())
+	}
+	for _, p := range props {
+		// Serializing the property includes its unknown values.
+		s, err := p.Serialize()
+		if err != nil {
+			continue
+		}
+		values, ok := s.([]interface{})
+		if !ok {
+			values = []interface{}{s}
+		}
+		for _, value := range values {
+			if m, ok := value.(map[string]interface{}); ok {
+				value = m["id"]
+			}
+			if str, ok := value.(string); ok && IsPublicString(str) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -5906,6 +5906,384 @@ func personExampleWithPublicKeyType() vocab.ActivityStreamsPerson {
 	return personExampleType
 }
 
+const serviceWithPublicKeys = `{
+  "@context": [
+    "https://w3id.org/security/v1",
+    "https://www.w3.org/ns/activitystreams"
+  ],
+  "id": "https://example.com/relay",
+  "type": "Service",
+  "inbox": "https://example.com/relay/inbox",
+  "publicKey": [
+    {
+      "id": "https://example.com/relay#main-key",
+      "owner": "https://example.com/relay",
+      "publicKeyPem": "-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEArYzMmldblHfnAPbwfVIo\n-----END PUBLIC KEY-----\n"
+    },
+    "https://example.com/relay#rotated-key"
+  ]
+}`
+
+func serviceWithPublicKeysType() vocab.ActivityStreamsService {
+	service := NewActivityStreamsService()
+	idProp := NewJSONLDIdProperty()
+	idProp.Set(MustParseURL("https://example.com/relay"))
+	service.SetJSONLDId(idProp)
+	inboxProp := NewActivityStreamsInboxProperty()
+	inboxProp.SetIRI(MustParseURL("https://example.com/relay/inbox"))
+	service.SetActivityStreamsInbox(inboxProp)
+	publicKey := NewW3IDSecurityV1PublicKey()
+	pubKeyIdProp := NewJSONLDIdProperty()
+	pubKeyIdProp.Set(MustParseURL("https://example.com/relay#main-key"))
+	publicKey.SetJSONLDId(pubKeyIdProp)
+	ownerProp := NewW3IDSecurityV1OwnerProperty()
+	ownerProp.SetIRI(MustParseURL("https://example.com/relay"))
+	publicKey.SetW3IDSecurityV1Owner(ownerProp)
+	publicKeyPemProp := NewW3IDSecurityV1PublicKeyPemProperty()
+	publicKeyPemProp.Set("-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEArYzMmldblHfnAPbwfVIo\n-----END PUBLIC KEY-----\n")
+	publicKey.SetW3IDSecurityV1PublicKeyPem(publicKeyPemProp)
+	publicKeyProp := NewW3IDSecurityV1PublicKeyProperty()
+	publicKeyProp.AppendW3IDSecurityV1PublicKey(publicKey)
+	publicKeyProp.AppendIRI(MustParseURL("https://example.com/relay#rotated-key"))
+	service.SetW3IDSecurityV1PublicKey(publicKeyProp)
+	return service
+}
+
+const peerTubeVideo = `{
+  "@context": [
+    "https://joinpeertube.org/ns",
+    "https://www.w3.org/ns/activitystreams"
+  ],
+  "id": "https://peertube.example.com/videos/watch/1",
+  "type": "Video",
+  "name": "A Short Film",
+  "views": 42,
+  "commentsEnabled": true,
+  "licence": "https://creativecommons.org/licenses/by/4.0/",
+  "url": {
+    "type": "Link",
+    "mediaType": "video/mp4",
+    "href": "https://peertube.example.com/static/webseed/1-720.mp4",
+    "height": 720,
+    "fps": 30
+  }
+}`
+
+func peerTubeVideoType() vocab.ActivityStreamsVideo {
+	video := NewActivityStreamsVideo()
+	idProp := NewJSONLDIdProperty()
+	idProp.Set(MustParseURL("https://peertube.example.com/videos/watch/1"))
+	video.SetJSONLDId(idProp)
+	nameProp := NewActivityStreamsNameProperty()
+	nameProp.AppendXMLSchemaString("A Short Film")
+	video.SetActivityStreamsName(nameProp)
+	viewsProp := NewPeerTubeViewsProperty()
+	viewsProp.Set(42)
+	video.SetPeerTubeViews(viewsProp)
+	commentsEnabledProp := NewPeerTubeCommentsEnabledProperty()
+	commentsEnabledProp.Set(true)
+	video.SetPeerTubeCommentsEnabled(commentsEnabledProp)
+	licenceProp := NewPeerTubeLicenceProperty()
+	licenceProp.SetIRI(MustParseURL("https://creativecommons.org/licenses/by/4.0/"))
+	video.SetPeerTubeLicence(licenceProp)
+	link := NewActivityStreamsLink()
+	mediaTypeProp := NewActivityStreamsMediaTypeProperty()
+	mediaTypeProp.Set("video/mp4")
+	link.SetActivityStreamsMediaType(mediaTypeProp)
+	hrefProp := NewActivityStreamsHrefProperty()
+	hrefProp.Set(MustParseURL("https://peertube.example.com/static/webseed/1-720.mp4"))
+	link.SetActivityStreamsHref(hrefProp)
+	heightProp := NewActivityStreamsHeightProperty()
+	heightProp.Set(720)
+	link.SetActivityStreamsHeight(heightProp)
+	fpsProp := NewPeerTubeFpsProperty()
+	fpsProp.Set(30)
+	link.SetPeerTubeFps(fpsProp)
+	urlProp := NewActivityStreamsUrlProperty()
+	urlProp.AppendActivityStreamsLink(link)
+	video.SetActivityStreamsUrl(urlProp)
+	return video
+}
+
+const emojiReact = `{
+  "@context": [
+    "http://litepub.social/ns",
+    "https://www.w3.org/ns/activitystreams"
+  ],
+  "id": "https://example.com/activities/1",
+  "type": "EmojiReact",
+  "actor": "https://example.com/users/alice",
+  "object": "https://other.example.com/notes/1",
+  "content": "👍"
+}`
+
+func emojiReactType() vocab.LitepubEmojiReact {
+	react := NewLitepubEmojiReact()
+	idProp := NewJSONLDIdProperty()
+	idProp.Set(MustParseURL("https://example.com/activities/1"))
+	react.SetJSONLDId(idProp)
+	actorProp := NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(MustParseURL("https://example.com/users/alice"))
+	react.SetActivityStreamsActor(actorProp)
+	objectProp := NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(MustParseURL("https://other.example.com/notes/1"))
+	react.SetActivityStreamsObject(objectProp)
+	contentProp := NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString("👍")
+	react.SetActivityStreamsContent(contentProp)
+	return react
+}
+
+const emojiReactWithCustomEmoji = `{
+  "@context": [
+    "http://joinmastodon.org/ns",
+    "http://litepub.social/ns",
+    "https://www.w3.org/ns/activitystreams"
+  ],
+  "id": "https://example.com/activities/2",
+  "type": "EmojiReact",
+  "actor": "https://example.com/users/alice",
+  "object": "https://other.example.com/notes/1",
+  "content": ":blobcat:",
+  "tag": {
+    "id": "https://example.com/emoji/blobcat",
+    "type": "Emoji",
+    "name": ":blobcat:",
+    "icon": {
+      "type": "Image",
+      "mediaType": "image/png",
+      "url": "https://example.com/files/blobcat.png"
+    }
+  }
+}`
+
+func emojiReactWithCustomEmojiType() vocab.LitepubEmojiReact {
+	react := NewLitepubEmojiReact()
+	idProp := NewJSONLDIdProperty()
+	idProp.Set(MustParseURL("https://example.com/activities/2"))
+	react.SetJSONLDId(idProp)
+	actorProp := NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(MustParseURL("https://example.com/users/alice"))
+	react.SetActivityStreamsActor(actorProp)
+	objectProp := NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(MustParseURL("https://other.example.com/notes/1"))
+	react.SetActivityStreamsObject(objectProp)
+	contentProp := NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString(":blobcat:")
+	react.SetActivityStreamsContent(contentProp)
+	emoji := NewTootEmoji()
+	emojiIdProp := NewJSONLDIdProperty()
+	emojiIdProp.Set(MustParseURL("https://example.com/emoji/blobcat"))
+	emoji.SetJSONLDId(emojiIdProp)
+	nameProp := NewActivityStreamsNameProperty()
+	nameProp.AppendXMLSchemaString(":blobcat:")
+	emoji.SetActivityStreamsName(nameProp)
+	image := NewActivityStreamsImage()
+	mediaTypeProp := NewActivityStreamsMediaTypeProperty()
+	mediaTypeProp.Set("image/png")
+	image.SetActivityStreamsMediaType(mediaTypeProp)
+	urlProp := NewActivityStreamsUrlProperty()
+	urlProp.AppendIRI(MustParseURL("https://example.com/files/blobcat.png"))
+	image.SetActivityStreamsUrl(urlProp)
+	iconProp := NewActivityStreamsIconProperty()
+	iconProp.AppendActivityStreamsImage(image)
+	emoji.SetActivityStreamsIcon(iconProp)
+	tagProp := NewActivityStreamsTagProperty()
+	tagProp.AppendTootEmoji(emoji)
+	react.SetActivityStreamsTag(tagProp)
+	return react
+}
+
+const sensitiveNote = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/notes/1",
+  "type": "Note",
+  "summary": "Spoilers for the finale",
+  "sensitive": true,
+  "content": "The butler did it."
+}`
+
+func sensitiveNoteType() vocab.ActivityStreamsNote {
+	note := NewActivityStreamsNote()
+	idProp := NewJSONLDIdProperty()
+	idProp.Set(MustParseURL("https://example.com/notes/1"))
+	note.SetJSONLDId(idProp)
+	summaryProp := NewActivityStreamsSummaryProperty()
+	summaryProp.AppendXMLSchemaString("Spoilers for the finale")
+	note.SetActivityStreamsSummary(summaryProp)
+	sensitiveProp := NewActivityStreamsSensitiveProperty()
+	sensitiveProp.Set(true)
+	note.SetActivityStreamsSensitive(sensitiveProp)
+	contentProp := NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString("The butler did it.")
+	note.SetActivityStreamsContent(contentProp)
+	return note
+}
+
+const personMovedTo = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/users/alice",
+  "type": "Person",
+  "movedTo": "https://other.example.com/users/alice",
+  "alsoKnownAs": [
+    "https://other.example.com/users/alice",
+    "https://third.example.com/users/alice"
+  ]
+}`
+
+func personMovedToType() vocab.ActivityStreamsPerson {
+	person := NewActivityStreamsPerson()
+	idProp := NewJSONLDIdProperty()
+	idProp.Set(MustParseURL("https://example.com/users/alice"))
+	person.SetJSONLDId(idProp)
+	movedToProp := NewActivityStreamsMovedToProperty()
+	movedToProp.SetIRI(MustParseURL("https://other.example.com/users/alice"))
+	person.SetActivityStreamsMovedTo(movedToProp)
+	alsoKnownAsProp := NewActivityStreamsAlsoKnownAsProperty()
+	alsoKnownAsProp.AppendIRI(MustParseURL("https://other.example.com/users/alice"))
+	alsoKnownAsProp.AppendIRI(MustParseURL("https://third.example.com/users/alice"))
+	person.SetActivityStreamsAlsoKnownAs(alsoKnownAsProp)
+	return person
+}
+
+const personDiscoverableNotIndexable = `{
+  "@context": [
+    "http://joinmastodon.org/ns",
+    "https://www.w3.org/ns/activitystreams"
+  ],
+  "id": "https://example.com/users/alice",
+  "type": "Person",
+  "discoverable": true,
+  "indexable": false
+}`
+
+func personDiscoverableNotIndexableType() vocab.ActivityStreamsPerson {
+	person := NewActivityStreamsPerson()
+	idProp := NewJSONLDIdProperty()
+	idProp.Set(MustParseURL("https://example.com/users/alice"))
+	person.SetJSONLDId(idProp)
+	discoverableProp := NewTootDiscoverableProperty()
+	discoverableProp.Set(true)
+	person.SetTootDiscoverable(discoverableProp)
+	indexableProp := NewTootIndexableProperty()
+	indexableProp.Set(false)
+	person.SetTootIndexable(indexableProp)
+	return person
+}
+
+const personWithFeaturedCollections = `{
+  "@context": [
+    "http://joinmastodon.org/ns",
+    "https://www.w3.org/ns/activitystreams"
+  ],
+  "id": "https://example.com/users/alice",
+  "type": "Person",
+  "featured": "https://example.com/users/alice/collections/featured",
+  "featuredTags": {
+    "id": "https://example.com/users/alice/collections/tags",
+    "type": "Collection",
+    "totalItems": 1,
+    "items": {
+      "type": "Hashtag",
+      "href": "https://example.com/tags/cats",
+      "name": "#cats"
+    }
+  }
+}`
+
+func personWithFeaturedCollectionsType() vocab.ActivityStreamsPerson {
+	person := NewActivityStreamsPerson()
+	idProp := NewJSONLDIdProperty()
+	idProp.Set(MustParseURL("https://example.com/users/alice"))
+	person.SetJSONLDId(idProp)
+	featuredProp := NewTootFeaturedProperty()
+	featuredProp.SetIRI(MustParseURL("https://example.com/users/alice/collections/featured"))
+	person.SetTootFeatured(featuredProp)
+	hashtag := NewActivityStreamsHashtag()
+	hrefProp := NewActivityStreamsHrefProperty()
+	hrefProp.Set(MustParseURL("https://example.com/tags/cats"))
+	hashtag.SetActivityStreamsHref(hrefProp)
+	nameProp := NewActivityStreamsNameProperty()
+	nameProp.AppendXMLSchemaString("#cats")
+	hashtag.SetActivityStreamsName(nameProp)
+	itemsProp := NewActivityStreamsItemsProperty()
+	itemsProp.AppendActivityStreamsHashtag(hashtag)
+	collection := NewActivityStreamsCollection()
+	collectionIdProp := NewJSONLDIdProperty()
+	collectionIdProp.Set(MustParseURL("https://example.com/users/alice/collections/tags"))
+	collection.SetJSONLDId(collectionIdProp)
+	totalItemsProp := NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(1)
+	collection.SetActivityStreamsTotalItems(totalItemsProp)
+	collection.SetActivityStreamsItems(itemsProp)
+	featuredTagsProp := NewTootFeaturedTagsProperty()
+	featuredTagsProp.SetActivityStreamsCollection(collection)
+	person.SetTootFeaturedTags(featuredTagsProp)
+	return person
+}
+
+const questionWithVotersCount = `{
+  "@context": [
+    "http://joinmastodon.org/ns",
+    "https://www.w3.org/ns/activitystreams"
+  ],
+  "id": "https://example.com/users/alice/statuses/1",
+  "type": "Question",
+  "content": "Cats or dogs?",
+  "oneOf": [
+    {
+      "type": "Note",
+      "name": "Cats",
+      "replies": {
+        "type": "Collection",
+        "totalItems": 3
+      }
+    },
+    {
+      "type": "Note",
+      "name": "Dogs",
+      "replies": {
+        "type": "Collection",
+        "totalItems": 2
+      }
+    }
+  ],
+  "votersCount": 5
+}`
+
+func questionWithVotersCountType() vocab.ActivityStreamsQuestion {
+	question := NewActivityStreamsQuestion()
+	idProp := NewJSONLDIdProperty()
+	idProp.Set(MustParseURL("https://example.com/users/alice/statuses/1"))
+	question.SetJSONLDId(idProp)
+	contentProp := NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString("Cats or dogs?")
+	question.SetActivityStreamsContent(contentProp)
+	oneOfProp := NewActivityStreamsOneOfProperty()
+	for _, option := range []struct {
+		name  string
+		votes int
+	}{{"Cats", 3}, {"Dogs", 2}} {
+		note := NewActivityStreamsNote()
+		nameProp := NewActivityStreamsNameProperty()
+		nameProp.AppendXMLSchemaString(option.name)
+		note.SetActivityStreamsName(nameProp)
+		replies := NewActivityStreamsCollection()
+		totalItemsProp := NewActivityStreamsTotalItemsProperty()
+		totalItemsProp.Set(option.votes)
+		replies.SetActivityStreamsTotalItems(totalItemsProp)
+		repliesProp := NewActivityStreamsRepliesProperty()
+		repliesProp.SetActivityStreamsCollection(replies)
+		note.SetActivityStreamsReplies(repliesProp)
+		oneOfProp.AppendActivityStreamsNote(note)
+	}
+	question.SetActivityStreamsOneOf(oneOfProp)
+	votersCountProp := NewTootVotersCountProperty()
+	votersCountProp.Set(5)
+	question.SetTootVotersCount(votersCountProp)
+	return question
+}
+
 type testContextWrapper struct {
 	vocab.ActivityStreamsObject
 }
@@ -5971,3 +6349,103 @@ func serviceHasAttachmentWithUnknownType() vocab.ActivityStreamsService {
 	serviceType.SetActivityStreamsAttachment(attachmentProp)
 	return serviceType
 }
+
+const noteWithRelTags = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/notes/1",
+  "type": "Note",
+  "content": "Hello @sally, see my profile",
+  "tag": [
+    {
+      "type": "Mention",
+      "href": "https://example.org/users/sally",
+      "name": "@sally@example.org",
+      "rel": "tag"
+    },
+    {
+      "type": "Link",
+      "href": "https://example.com/@cj",
+      "rel": ["me", "nofollow"]
+    }
+  ]
+}`
+
+func noteWithRelTagsType() vocab.ActivityStreamsNote {
+	noteType := NewActivityStreamsNote()
+	idProp := NewJSONLDIdProperty()
+	idProp.Set(MustParseURL("https://example.com/notes/1"))
+	noteType.SetJSONLDId(idProp)
+	contentProp := NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString("Hello @sally, see my profile")
+	noteType.SetActivityStreamsContent(contentProp)
+	mention := NewActivityStreamsMention()
+	mentionHref := NewActivityStreamsHrefProperty()
+	mentionHref.Set(MustParseURL("https://example.org/users/sally"))
+	mention.SetActivityStreamsHref(mentionHref)
+	mentionName := NewActivityStreamsNameProperty()
+	mentionName.AppendXMLSchemaString("@sally@example.org")
+	mention.SetActivityStreamsName(mentionName)
+	mentionRel := NewActivityStreamsRelProperty()
+	mentionRel.AppendRFCRfc5988("tag")
+	mention.SetActivityStreamsRel(mentionRel)
+	link := NewActivityStreamsLink()
+	linkHref := NewActivityStreamsHrefProperty()
+	linkHref.Set(MustParseURL("https://example.com/@cj"))
+	link.SetActivityStreamsHref(linkHref)
+	linkRel := NewActivityStreamsRelProperty()
+	linkRel.AppendRFCRfc5988("me")
+	linkRel.AppendRFCRfc5988("nofollow")
+	link.SetActivityStreamsRel(linkRel)
+	tagProp := NewActivityStreamsTagProperty()
+	tagProp.AppendActivityStreamsMention(mention)
+	tagProp.AppendActivityStreamsLink(link)
+	noteType.SetActivityStreamsTag(tagProp)
+	return noteType
+}
+
+const personWithPropertyValues = `{
+  "@context": [
+    "http://schema.org",
+    "https://www.w3.org/ns/activitystreams"
+  ],
+  "id": "https://example.com/users/alice",
+  "type": "Person",
+  "attachment": [
+    {
+      "type": "PropertyValue",
+      "name": "Website",
+      "value": "<a href=\"https://example.com\" rel=\"me\">https://example.com</a>"
+    },
+    {
+      "type": "PropertyValue",
+      "name": "Pronouns",
+      "value": "they/them"
+    }
+  ]
+}`
+
+func personWithPropertyValuesType() vocab.ActivityStreamsPerson {
+	personType := NewActivityStreamsPerson()
+	idProp := NewJSONLDIdProperty()
+	idProp.Set(MustParseURL("https://example.com/users/alice"))
+	personType.SetJSONLDId(idProp)
+	attachmentProp := NewActivityStreamsAttachmentProperty()
+	website := NewSchemaPropertyValue()
+	websiteName := NewActivityStreamsNameProperty()
+	websiteName.AppendXMLSchemaString("Website")
+	website.SetActivityStreamsName(websiteName)
+	websiteValue := NewSchemaValueProperty()
+	websiteValue.Set("<a href=\"https://example.com\" rel=\"me\">https://example.com</a>")
+	website.SetSchemaValue(websiteValue)
+	attachmentProp.AppendSchemaPropertyValue(website)
+	pronouns := NewSchemaPropertyValue()
+	pronounsName := NewActivityStreamsNameProperty()
+	pronounsName.AppendXMLSchemaString("Pronouns")
+	pronouns.SetActivityStreamsName(pronounsName)
+	pronounsValue := NewSchemaValueProperty()
+	pronounsValue.Set("they/them")
+	pronouns.SetSchemaValue(pronounsValue)
+	attachmentProp.AppendSchemaPropertyValue(pronouns)
+	personType.SetActivityStreamsAttachment(attachmentProp)
+	return personType
+}
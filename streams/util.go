@@ -64,3 +64,16 @@ func Serialize(a vocab.Type) (m map[string]interface{}, e error) {
 	cleanFnRecur(m)
 	return
 }
+
+// Release returns a deserialized type and its properties to pools, from which
+// they are reused by later deserializations instead of being allocated again.
+//
+// Releasing is optional, and only worthwhile for applications deserializing
+// many values, such as servers processing the activities in their inboxes. The
+// type, its properties, and any of their values must not be used after being
+// released, including by other goroutines or by other types holding them.
+func Release(t vocab.Type) {
+	if r, ok := t.(interface{ Release() }); ok {
+		r.Release()
+	}
+}
@@ -2,12 +2,60 @@
 
 package float
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// floatFormatting is the format and precision passed to strconv.FormatFloat when
+// serializing float values.
+type floatFormatting struct {
+	format    byte
+	precision int
+}
+
+// floatFormat holds the *floatFormatting set with SetFormat, or a nil one if
+// float values are left to the encoding/json package to format. It is only
+// accessed atomically, so that it may be changed while values are serialized.
+var floatFormat atomic.Value
+
+// SetFormat configures float values to be serialized as JSON numbers formatted by
+// strconv.FormatFloat with the given format and precision, such as 'f' and 6
+// to always serialize coordinates with six decimal places. An error is
+// returned if the format is not one of "eEfgG". It is safe to call
+// concurrently with serializing values.
+func SetFormat(format byte, precision int) error {
+	if !strings.ContainsRune("eEfgG", rune(format)) {
+		return fmt.Errorf("%q is not a JSON number format of strconv.FormatFloat", format)
+	}
+	floatFormat.Store(&floatFormatting{
+		format:    format,
+		precision: precision,
+	})
+	return nil
+}
+
+// ResetFormat restores the default serialization of float values, which leaves
+// their formatting to the encoding/json package. It is safe to call
+// concurrently with serializing values.
+func ResetFormat() {
+	floatFormat.Store((*floatFormatting)(nil))
+}
 
 // SerializeFloat converts a float value to an interface representation suitable
 // for marshalling into a text or binary format.
 func SerializeFloat(this float64) (interface{}, error) {
-	return this, nil
+	f, _ := floatFormat.Load().(*floatFormatting)
+	if f == nil {
+		return this, nil
+	} else if math.IsNaN(this) || math.IsInf(this, 0) {
+		return nil, fmt.Errorf("%v cannot be serialized as a number for xsd:float", this)
+	}
+	return json.Number(strconv.FormatFloat(this, f.format, f.precision, 64)), nil
 }
 
 // DeserializeFloat creates float value from an interface representation that has
@@ -15,6 +63,8 @@ func SerializeFloat(this float64) (interface{}, error) {
 func DeserializeFloat(this interface{}) (float64, error) {
 	if f, ok := this.(float64); ok {
 		return f, nil
+	} else if n, ok := this.(json.Number); ok {
+		return n.Float64()
 	} else {
 		return 0, fmt.Errorf("%v cannot be interpreted as a float64 for xsd:float", this)
 	}
@@ -43,9 +43,11 @@
 // indices. Note that a non-functional property satisfies the sort interface,
 // but it results in an arbitrary but stable ordering best used as a
 // normalized form. A non-functional property's iterator looks like a
-// functional property with "Next" and "Previous" methods. Applications should
-// not use the "KindIndex" methods as it is a comparison mechanism only for
-// those looking to write an alternate implementation of this library.
+// functional property with "Next" and "Previous" methods. The "Values" method
+// returns all of the iterators in a slice, to range over them instead.
+// Applications should not use the "KindIndex" methods as it is a comparison
+// mechanism only for those looking to write an alternate implementation of
+// this library.
 //
 // Types and properties have a "JSONLDContext" method that returns a mapping
 // of vocabulary URIs to aliases that are required in the JSON-LD @context
@@ -53,9 +55,16 @@
 // serializing objects is done at code-generation time, unless a different
 // alias was used to deserialize the type or property.
 //
+// The implementations of types and properties also have a "Release" method,
+// which is not part of their interfaces. Applications deserializing many
+// values, such as servers processing the activities delivered to their
+// inboxes, may release a deserialized type once done with it, so that it and
+// its properties are reused by later deserializations instead of being
+// allocated again.
+//
 // Types, functional properties, and non-functional properties are not
-// designed for concurrent usage by two or more goroutines. Also, certain
-// methods on a non-functional property will invalidate iterators and possibly
-// cause unexpected behaviors. To avoid this, re-obtain an iterator after
-// modifying a non-functional property.
+// designed for concurrent usage by two or more goroutines. Iterators of a
+// non-functional property remain valid when values are added to or removed
+// from it, so it is not necessary to re-obtain an iterator after modifying a
+// non-functional property.
 package vocab
@@ -2,6 +2,8 @@
 
 package vocab
 
+import "errors"
+
 // Type represents an ActivityStreams type.
 type Type interface {
 	// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
@@ -21,3 +23,27 @@ type Type interface {
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
 }
+
+// ErrMissingType indicates that a value cannot be deserialized as a type because it has no "type" property.
+var ErrMissingType error = errors.New("no \"type\" property in map")
+
+// ErrWrongType indicates that a value cannot be deserialized as a type because its "type" property is another type.
+var ErrWrongType error = errors.New("\"type\" property is of another type")
+
+// DeserializeError is the error of deserializing a value as a type. Its Unwrap method returns the category of the error, ErrMissingType or ErrWrongType, so that errors.Is matches it as well.
+type DeserializeError struct {
+	// Err is the category of the error.
+	Err error
+	// Reason describes the error.
+	Reason string
+}
+
+// Error returns the reason of the error.
+func (this DeserializeError) Error() string {
+	return this.Reason
+}
+
+// Unwrap returns the category of the error.
+func (this DeserializeError) Unwrap() error {
+	return this.Err
+}
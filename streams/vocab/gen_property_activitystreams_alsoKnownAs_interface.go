@@ -0,0 +1,291 @@
+// Code generated by astool. DO NOT EDIT.
+
+package vocab
+
+import "net/url"
+
+// ActivityStreamsAlsoKnownAsPropertyIterator represents a single value for the
+// "alsoKnownAs" property.
+type ActivityStreamsAlsoKnownAsPropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsAlsoKnownAsPropertyIterator) int
+	// GetActivityStreamsApplication returns the value of this property. When
+	// IsActivityStreamsApplication returns false,
+	// GetActivityStreamsApplication will return an arbitrary value.
+	GetActivityStreamsApplication() ActivityStreamsApplication
+	// GetActivityStreamsGroup returns the value of this property. When
+	// IsActivityStreamsGroup returns false, GetActivityStreamsGroup will
+	// return an arbitrary value.
+	GetActivityStreamsGroup() ActivityStreamsGroup
+	// GetActivityStreamsOrganization returns the value of this property. When
+	// IsActivityStreamsOrganization returns false,
+	// GetActivityStreamsOrganization will return an arbitrary value.
+	GetActivityStreamsOrganization() ActivityStreamsOrganization
+	// GetActivityStreamsPerson returns the value of this property. When
+	// IsActivityStreamsPerson returns false, GetActivityStreamsPerson
+	// will return an arbitrary value.
+	GetActivityStreamsPerson() ActivityStreamsPerson
+	// GetActivityStreamsService returns the value of this property. When
+	// IsActivityStreamsService returns false, GetActivityStreamsService
+	// will return an arbitrary value.
+	GetActivityStreamsService() ActivityStreamsService
+	// GetIRI returns the IRI of this property. When IsIRI returns false,
+	// GetIRI will return an arbitrary value.
+	GetIRI() *url.URL
+	// GetType returns the value in this property as a Type. Returns nil if
+	// the value is not an ActivityStreams type, such as an IRI or another
+	// value.
+	GetType() Type
+	// HasAny returns true if any of the different values is set.
+	HasAny() bool
+	// IsActivityStreamsApplication returns true if this property has a type
+	// of "Application". When true, use the GetActivityStreamsApplication
+	// and SetActivityStreamsApplication methods to access and set this
+	// property.
+	IsActivityStreamsApplication() bool
+	// IsActivityStreamsGroup returns true if this property has a type of
+	// "Group". When true, use the GetActivityStreamsGroup and
+	// SetActivityStreamsGroup methods to access and set this property.
+	IsActivityStreamsGroup() bool
+	// IsActivityStreamsOrganization returns true if this property has a type
+	// of "Organization". When true, use the
+	// GetActivityStreamsOrganization and SetActivityStreamsOrganization
+	// methods to access and set this property.
+	IsActivityStreamsOrganization() bool
+	// IsActivityStreamsPerson returns true if this property has a type of
+	// "Person". When true, use the GetActivityStreamsPerson and
+	// SetActivityStreamsPerson methods to access and set this property.
+	IsActivityStreamsPerson() bool
+	// IsActivityStreamsService returns true if this property has a type of
+	// "Service". When true, use the GetActivityStreamsService and
+	// SetActivityStreamsService methods to access and set this property.
+	IsActivityStreamsService() bool
+	// IsIRI returns true if this property is an IRI. When true, use GetIRI
+	// and SetIRI to access and set this property
+	IsIRI() bool
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this property and the specific values that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// KindIndex computes an arbitrary value for indexing this kind of value.
+	// This is a leaky API detail only for folks looking to replace the
+	// go-fed implementation. Applications should not use this method.
+	KindIndex() int
+	// LessThan compares two instances of this property with an arbitrary but
+	// stable comparison. Applications should not use this because it is
+	// only meant to help alternative implementations to go-fed to be able
+	// to normalize nonfunctional properties.
+	LessThan(o ActivityStreamsAlsoKnownAsPropertyIterator) bool
+	// Name returns the name of this property: "ActivityStreamsAlsoKnownAs".
+	Name() string
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
+	Next() ActivityStreamsAlsoKnownAsPropertyIterator
+	// Prev returns the previous iterator, or nil if there is no previous
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
+	Prev() ActivityStreamsAlsoKnownAsPropertyIterator
+	// SetActivityStreamsApplication sets the value of this property. Calling
+	// IsActivityStreamsApplication afterwards returns true.
+	SetActivityStreamsApplication(v ActivityStreamsApplication)
+	// SetActivityStreamsGroup sets the value of this property. Calling
+	// IsActivityStreamsGroup afterwards returns true.
+	SetActivityStreamsGroup(v ActivityStreamsGroup)
+	// SetActivityStreamsOrganization sets the value of this property. Calling
+	// IsActivityStreamsOrganization afterwards returns true.
+	SetActivityStreamsOrganization(v ActivityStreamsOrganization)
+	// SetActivityStreamsPerson sets the value of this property. Calling
+	// IsActivityStreamsPerson afterwards returns true.
+	SetActivityStreamsPerson(v ActivityStreamsPerson)
+	// SetActivityStreamsService sets the value of this property. Calling
+	// IsActivityStreamsService afterwards returns true.
+	SetActivityStreamsService(v ActivityStreamsService)
+	// SetIRI sets the value of this property. Calling IsIRI afterwards
+	// returns true.
+	SetIRI(v *url.URL)
+	// SetType attempts to set the property for the arbitrary type. Returns an
+	// error if it is not a valid type to set on this property.
+	SetType(t Type) error
+}
+
+// Other actors that are the same entity as this actor, such as accounts it has
+// moved from.
+//
+// https://docs.joinmastodon.org/spec/activitypub/#as:
+//   {
+//     "alsoKnownAs": [
+//       "https://example.com/users/alice"
+//     ],
+//     "id": "https://other.example.com/users/alice",
+//     "type": "Person"
+//   }
+type ActivityStreamsAlsoKnownAsProperty interface {
+	// AppendActivityStreamsApplication appends a Application value to the
+	// back of a list of the property "alsoKnownAs".
+	AppendActivityStreamsApplication(v ActivityStreamsApplication)
+	// AppendActivityStreamsGroup appends a Group value to the back of a list
+	// of the property "alsoKnownAs".
+	AppendActivityStreamsGroup(v ActivityStreamsGroup)
+	// AppendActivityStreamsOrganization appends a Organization value to the
+	// back of a list of the property "alsoKnownAs".
+	AppendActivityStreamsOrganization(v ActivityStreamsOrganization)
+	// AppendActivityStreamsPerson appends a Person value to the back of a
+	// list of the property "alsoKnownAs".
+	AppendActivityStreamsPerson(v ActivityStreamsPerson)
+	// AppendActivityStreamsService appends a Service value to the back of a
+	// list of the property "alsoKnownAs".
+	AppendActivityStreamsService(v ActivityStreamsService)
+	// AppendIRI appends an IRI value to the back of a list of the property
+	// "alsoKnownAs"
+	AppendIRI(v *url.URL)
+	// AppendType appends an arbitrary type value to the back of a list of the
+	// property "alsoKnownAs". Returns an error if the type is not a valid
+	// one to set for this property.
+	AppendType(t Type) error
+	// At returns the property value for the specified index. Panics if the
+	// index is out of bounds.
+	At(index int) ActivityStreamsAlsoKnownAsPropertyIterator
+	// Begin returns the first iterator, or nil if empty. Can be used with the
+	// iterator's Next method and this property's End method to iterate
+	// from front to back through all values.
+	Begin() ActivityStreamsAlsoKnownAsPropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsAlsoKnownAsProperty) int
+	// Empty returns returns true if there are no elements.
+	Empty() bool
+	// End returns beyond-the-last iterator, which is nil. Can be used with
+	// the iterator's Next method and this property's Begin method to
+	// iterate from front to back through all values.
+	End() ActivityStreamsAlsoKnownAsPropertyIterator
+	// InsertActivityStreamsApplication inserts a Application value at the
+	// specified index for a property "alsoKnownAs". Existing elements at
+	// that index and higher are shifted back once.
+	InsertActivityStreamsApplication(idx int, v ActivityStreamsApplication)
+	// InsertActivityStreamsGroup inserts a Group value at the specified index
+	// for a property "alsoKnownAs". Existing elements at that index and
+	// higher are shifted back once.
+	InsertActivityStreamsGroup(idx int, v ActivityStreamsGroup)
+	// InsertActivityStreamsOrganization inserts a Organization value at the
+	// specified index for a property "alsoKnownAs". Existing elements at
+	// that index and higher are shifted back once.
+	InsertActivityStreamsOrganization(idx int, v ActivityStreamsOrganization)
+	// InsertActivityStreamsPerson inserts a Person value at the specified
+	// index for a property "alsoKnownAs". Existing elements at that index
+	// and higher are shifted back once.
+	InsertActivityStreamsPerson(idx int, v ActivityStreamsPerson)
+	// InsertActivityStreamsService inserts a Service value at the specified
+	// index for a property "alsoKnownAs". Existing elements at that index
+	// and higher are shifted back once.
+	InsertActivityStreamsService(idx int, v ActivityStreamsService)
+	// Insert inserts an IRI value at the specified index for a property
+	// "alsoKnownAs". Existing elements at that index and higher are
+	// shifted back once.
+	InsertIRI(idx int, v *url.URL)
+	// InsertType inserts an arbitrary type value at the specified index for
+	// the property "alsoKnownAs". Existing elements at that index and
+	// higher are shifted back once. Returns an error if the type is not a
+	// valid one to set for this property.
+	InsertType(idx int, t Type) error
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this property and the specific values that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// KindIndex computes an arbitrary value for indexing this kind of value.
+	// This is a leaky API method specifically needed only for alternate
+	// implementations for go-fed. Applications should not use this
+	// method. Panics if the index is out of bounds.
+	KindIndex(idx int) int
+	// Len returns the number of values that exist for the "alsoKnownAs"
+	// property.
+	Len() (length int)
+	// Less computes whether another property is less than this one. Mixing
+	// types results in a consistent but arbitrary ordering
+	Less(i, j int) bool
+	// LessThan compares two instances of this property with an arbitrary but
+	// stable comparison. Applications should not use this because it is
+	// only meant to help alternative implementations to go-fed to be able
+	// to normalize nonfunctional properties.
+	LessThan(o ActivityStreamsAlsoKnownAsProperty) bool
+	// Name returns the name of this property ("alsoKnownAs") with any alias.
+	Name() string
+	// PrependActivityStreamsApplication prepends a Application value to the
+	// front of a list of the property "alsoKnownAs".
+	PrependActivityStreamsApplication(v ActivityStreamsApplication)
+	// PrependActivityStreamsGroup prepends a Group value to the front of a
+	// list of the property "alsoKnownAs".
+	PrependActivityStreamsGroup(v ActivityStreamsGroup)
+	// PrependActivityStreamsOrganization prepends a Organization value to the
+	// front of a list of the property "alsoKnownAs".
+	PrependActivityStreamsOrganization(v ActivityStreamsOrganization)
+	// PrependActivityStreamsPerson prepends a Person value to the front of a
+	// list of the property "alsoKnownAs".
+	PrependActivityStreamsPerson(v ActivityStreamsPerson)
+	// PrependActivityStreamsService prepends a Service value to the front of
+	// a list of the property "alsoKnownAs".
+	PrependActivityStreamsService(v ActivityStreamsService)
+	// PrependIRI prepends an IRI value to the front of a list of the property
+	// "alsoKnownAs".
+	PrependIRI(v *url.URL)
+	// PrependType prepends an arbitrary type value to the front of a list of
+	// the property "alsoKnownAs". Returns an error if the type is not a
+	// valid one to set for this property.
+	PrependType(t Type) error
+	// Remove deletes an element at the specified index from a list of the
+	// property "alsoKnownAs", regardless of its type. Panics if the index
+	// is out of bounds.
+	Remove(idx int)
+	// Serialize converts this into an interface representation suitable for
+	// marshalling into a text or binary format. Applications should not
+	// need this function as most typical use cases serialize types
+	// instead of individual properties. It is exposed for alternatives to
+	// go-fed implementations to use.
+	Serialize() (interface{}, error)
+	// SetActivityStreamsApplication sets a Application value to be at the
+	// specified index for the property "alsoKnownAs". Panics if the index
+	// is out of bounds.
+	SetActivityStreamsApplication(idx int, v ActivityStreamsApplication)
+	// SetActivityStreamsGroup sets a Group value to be at the specified index
+	// for the property "alsoKnownAs". Panics if the index is out of
+	// bounds.
+	SetActivityStreamsGroup(idx int, v ActivityStreamsGroup)
+	// SetActivityStreamsOrganization sets a Organization value to be at the
+	// specified index for the property "alsoKnownAs". Panics if the index
+	// is out of bounds.
+	SetActivityStreamsOrganization(idx int, v ActivityStreamsOrganization)
+	// SetActivityStreamsPerson sets a Person value to be at the specified
+	// index for the property "alsoKnownAs". Panics if the index is out of
+	// bounds.
+	SetActivityStreamsPerson(idx int, v ActivityStreamsPerson)
+	// SetActivityStreamsService sets a Service value to be at the specified
+	// index for the property "alsoKnownAs". Panics if the index is out of
+	// bounds.
+	SetActivityStreamsService(idx int, v ActivityStreamsService)
+	// SetIRI sets an IRI value to be at the specified index for the property
+	// "alsoKnownAs". Panics if the index is out of bounds.
+	SetIRI(idx int, v *url.URL)
+	// SetType sets an arbitrary type value to the specified index of the
+	// property "alsoKnownAs". Returns an error if the type is not a valid
+	// one to set for this property. Panics if the index is out of bounds.
+	SetType(idx int, t Type) error
+	// Swap swaps the location of values at two indices for the "alsoKnownAs"
+	// property.
+	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []ActivityStreamsAlsoKnownAsPropertyIterator
+}
@@ -21,6 +21,13 @@ type ActivityStreamsAltitudeProperty interface {
 	// Clear ensures no value of this property is set. Calling
 	// IsXMLSchemaFloat afterwards will return false.
 	Clear()
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsAltitudeProperty) int
 	// Get returns the value of this property. When IsXMLSchemaFloat returns
 	// false, Get will return any arbitrary value.
 	Get() float64
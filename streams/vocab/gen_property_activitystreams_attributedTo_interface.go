@@ -7,6 +7,13 @@ import "net/url"
 // ActivityStreamsAttributedToPropertyIterator represents a single value for the
 // "attributedTo" property.
 type ActivityStreamsAttributedToPropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsAttributedToPropertyIterator) int
 	// GetActivityStreamsAccept returns the value of this property. When
 	// IsActivityStreamsAccept returns false, GetActivityStreamsAccept
 	// will return an arbitrary value.
@@ -83,6 +90,10 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// IsActivityStreamsGroup returns false, GetActivityStreamsGroup will
 	// return an arbitrary value.
 	GetActivityStreamsGroup() ActivityStreamsGroup
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsIgnore returns the value of this property. When
 	// IsActivityStreamsIgnore returns false, GetActivityStreamsIgnore
 	// will return an arbitrary value.
@@ -251,6 +262,14 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
+	// GetLitepubEmojiReact returns the value of this property. When
+	// IsLitepubEmojiReact returns false, GetLitepubEmojiReact will return
+	// an arbitrary value.
+	GetLitepubEmojiReact() LitepubEmojiReact
+	// GetSchemaPropertyValue returns the value of this property. When
+	// IsSchemaPropertyValue returns false, GetSchemaPropertyValue will
+	// return an arbitrary value.
+	GetSchemaPropertyValue() SchemaPropertyValue
 	// GetTootEmoji returns the value of this property. When IsTootEmoji
 	// returns false, GetTootEmoji will return an arbitrary value.
 	GetTootEmoji() TootEmoji
@@ -344,6 +363,10 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// "Group". When true, use the GetActivityStreamsGroup and
 	// SetActivityStreamsGroup methods to access and set this property.
 	IsActivityStreamsGroup() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsIgnore returns true if this property has a type of
 	// "Ignore". When true, use the GetActivityStreamsIgnore and
 	// SetActivityStreamsIgnore methods to access and set this property.
@@ -524,6 +547,14 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// IsIRI returns true if this property is an IRI. When true, use GetIRI
 	// and SetIRI to access and set this property
 	IsIRI() bool
+	// IsLitepubEmojiReact returns true if this property has a type of
+	// "EmojiReact". When true, use the GetLitepubEmojiReact and
+	// SetLitepubEmojiReact methods to access and set this property.
+	IsLitepubEmojiReact() bool
+	// IsSchemaPropertyValue returns true if this property has a type of
+	// "PropertyValue". When true, use the GetSchemaPropertyValue and
+	// SetSchemaPropertyValue methods to access and set this property.
+	IsSchemaPropertyValue() bool
 	// IsTootEmoji returns true if this property has a type of "Emoji". When
 	// true, use the GetTootEmoji and SetTootEmoji methods to access and
 	// set this property.
@@ -548,10 +579,14 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	LessThan(o ActivityStreamsAttributedToPropertyIterator) bool
 	// Name returns the name of this property: "ActivityStreamsAttributedTo".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() ActivityStreamsAttributedToPropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() ActivityStreamsAttributedToPropertyIterator
 	// SetActivityStreamsAccept sets the value of this property. Calling
 	// IsActivityStreamsAccept afterwards returns true.
@@ -610,6 +645,9 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// SetActivityStreamsGroup sets the value of this property. Calling
 	// IsActivityStreamsGroup afterwards returns true.
 	SetActivityStreamsGroup(v ActivityStreamsGroup)
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsIgnore sets the value of this property. Calling
 	// IsActivityStreamsIgnore afterwards returns true.
 	SetActivityStreamsIgnore(v ActivityStreamsIgnore)
@@ -738,6 +776,12 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 	// SetIRI sets the value of this property. Calling IsIRI afterwards
 	// returns true.
 	SetIRI(v *url.URL)
+	// SetLitepubEmojiReact sets the value of this property. Calling
+	// IsLitepubEmojiReact afterwards returns true.
+	SetLitepubEmojiReact(v LitepubEmojiReact)
+	// SetSchemaPropertyValue sets the value of this property. Calling
+	// IsSchemaPropertyValue afterwards returns true.
+	SetSchemaPropertyValue(v SchemaPropertyValue)
 	// SetTootEmoji sets the value of this property. Calling IsTootEmoji
 	// afterwards returns true.
 	SetTootEmoji(v TootEmoji)
@@ -779,262 +823,208 @@ type ActivityStreamsAttributedToPropertyIterator interface {
 //   }
 type ActivityStreamsAttributedToProperty interface {
 	// AppendActivityStreamsAccept appends a Accept value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsAccept(v ActivityStreamsAccept)
 	// AppendActivityStreamsActivity appends a Activity value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsActivity(v ActivityStreamsActivity)
 	// AppendActivityStreamsAdd appends a Add value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendActivityStreamsAdd(v ActivityStreamsAdd)
 	// AppendActivityStreamsAnnounce appends a Announce value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsAnnounce(v ActivityStreamsAnnounce)
 	// AppendActivityStreamsApplication appends a Application value to the
-	// back of a list of the property "attributedTo". Invalidates
-	// iterators that are traversing using Prev.
+	// back of a list of the property "attributedTo".
 	AppendActivityStreamsApplication(v ActivityStreamsApplication)
 	// AppendActivityStreamsArrive appends a Arrive value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsArrive(v ActivityStreamsArrive)
 	// AppendActivityStreamsArticle appends a Article value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsArticle(v ActivityStreamsArticle)
 	// AppendActivityStreamsAudio appends a Audio value to the back of a list
-	// of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "attributedTo".
 	AppendActivityStreamsAudio(v ActivityStreamsAudio)
 	// AppendActivityStreamsBlock appends a Block value to the back of a list
-	// of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "attributedTo".
 	AppendActivityStreamsBlock(v ActivityStreamsBlock)
 	// AppendActivityStreamsCollection appends a Collection value to the back
-	// of a list of the property "attributedTo". Invalidates iterators
-	// that are traversing using Prev.
+	// of a list of the property "attributedTo".
 	AppendActivityStreamsCollection(v ActivityStreamsCollection)
 	// AppendActivityStreamsCollectionPage appends a CollectionPage value to
-	// the back of a list of the property "attributedTo". Invalidates
-	// iterators that are traversing using Prev.
+	// the back of a list of the property "attributedTo".
 	AppendActivityStreamsCollectionPage(v ActivityStreamsCollectionPage)
 	// AppendActivityStreamsCreate appends a Create value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsCreate(v ActivityStreamsCreate)
 	// AppendActivityStreamsDelete appends a Delete value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsDelete(v ActivityStreamsDelete)
 	// AppendActivityStreamsDislike appends a Dislike value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsDislike(v ActivityStreamsDislike)
 	// AppendActivityStreamsDocument appends a Document value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsDocument(v ActivityStreamsDocument)
 	// AppendActivityStreamsEvent appends a Event value to the back of a list
-	// of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "attributedTo".
 	AppendActivityStreamsEvent(v ActivityStreamsEvent)
 	// AppendActivityStreamsFlag appends a Flag value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendActivityStreamsFlag(v ActivityStreamsFlag)
 	// AppendActivityStreamsFollow appends a Follow value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsFollow(v ActivityStreamsFollow)
 	// AppendActivityStreamsGroup appends a Group value to the back of a list
-	// of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "attributedTo".
 	AppendActivityStreamsGroup(v ActivityStreamsGroup)
+	// AppendActivityStreamsHashtag appends a Hashtag value to the back of a
+	// list of the property "attributedTo".
+	AppendActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// AppendActivityStreamsIgnore appends a Ignore value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsIgnore(v ActivityStreamsIgnore)
 	// AppendActivityStreamsImage appends a Image value to the back of a list
-	// of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "attributedTo".
 	AppendActivityStreamsImage(v ActivityStreamsImage)
 	// AppendActivityStreamsIntransitiveActivity appends a
 	// IntransitiveActivity value to the back of a list of the property
-	// "attributedTo". Invalidates iterators that are traversing using
-	// Prev.
+	// "attributedTo".
 	AppendActivityStreamsIntransitiveActivity(v ActivityStreamsIntransitiveActivity)
 	// AppendActivityStreamsInvite appends a Invite value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsInvite(v ActivityStreamsInvite)
 	// AppendActivityStreamsJoin appends a Join value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendActivityStreamsJoin(v ActivityStreamsJoin)
 	// AppendActivityStreamsLeave appends a Leave value to the back of a list
-	// of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "attributedTo".
 	AppendActivityStreamsLeave(v ActivityStreamsLeave)
 	// AppendActivityStreamsLike appends a Like value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendActivityStreamsLike(v ActivityStreamsLike)
 	// AppendActivityStreamsLink appends a Link value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendActivityStreamsLink(v ActivityStreamsLink)
 	// AppendActivityStreamsListen appends a Listen value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsListen(v ActivityStreamsListen)
 	// AppendActivityStreamsMention appends a Mention value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsMention(v ActivityStreamsMention)
 	// AppendActivityStreamsMove appends a Move value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendActivityStreamsMove(v ActivityStreamsMove)
 	// AppendActivityStreamsNote appends a Note value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendActivityStreamsNote(v ActivityStreamsNote)
 	// AppendActivityStreamsObject appends a Object value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsObject(v ActivityStreamsObject)
 	// AppendActivityStreamsOffer appends a Offer value to the back of a list
-	// of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "attributedTo".
 	AppendActivityStreamsOffer(v ActivityStreamsOffer)
 	// AppendActivityStreamsOrderedCollection appends a OrderedCollection
 	// value to the back of a list of the property "attributedTo".
-	// Invalidates iterators that are traversing using Prev.
 	AppendActivityStreamsOrderedCollection(v ActivityStreamsOrderedCollection)
 	// AppendActivityStreamsOrderedCollectionPage appends a
 	// OrderedCollectionPage value to the back of a list of the property
-	// "attributedTo". Invalidates iterators that are traversing using
-	// Prev.
+	// "attributedTo".
 	AppendActivityStreamsOrderedCollectionPage(v ActivityStreamsOrderedCollectionPage)
 	// AppendActivityStreamsOrganization appends a Organization value to the
-	// back of a list of the property "attributedTo". Invalidates
-	// iterators that are traversing using Prev.
+	// back of a list of the property "attributedTo".
 	AppendActivityStreamsOrganization(v ActivityStreamsOrganization)
 	// AppendActivityStreamsPage appends a Page value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendActivityStreamsPage(v ActivityStreamsPage)
 	// AppendActivityStreamsPerson appends a Person value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsPerson(v ActivityStreamsPerson)
 	// AppendActivityStreamsPlace appends a Place value to the back of a list
-	// of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "attributedTo".
 	AppendActivityStreamsPlace(v ActivityStreamsPlace)
 	// AppendActivityStreamsProfile appends a Profile value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsProfile(v ActivityStreamsProfile)
 	// AppendActivityStreamsQuestion appends a Question value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsQuestion(v ActivityStreamsQuestion)
 	// AppendActivityStreamsRead appends a Read value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendActivityStreamsRead(v ActivityStreamsRead)
 	// AppendActivityStreamsReject appends a Reject value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsReject(v ActivityStreamsReject)
 	// AppendActivityStreamsRelationship appends a Relationship value to the
-	// back of a list of the property "attributedTo". Invalidates
-	// iterators that are traversing using Prev.
+	// back of a list of the property "attributedTo".
 	AppendActivityStreamsRelationship(v ActivityStreamsRelationship)
 	// AppendActivityStreamsRemove appends a Remove value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsRemove(v ActivityStreamsRemove)
 	// AppendActivityStreamsService appends a Service value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsService(v ActivityStreamsService)
 	// AppendActivityStreamsTentativeAccept appends a TentativeAccept value to
-	// the back of a list of the property "attributedTo". Invalidates
-	// iterators that are traversing using Prev.
+	// the back of a list of the property "attributedTo".
 	AppendActivityStreamsTentativeAccept(v ActivityStreamsTentativeAccept)
 	// AppendActivityStreamsTentativeReject appends a TentativeReject value to
-	// the back of a list of the property "attributedTo". Invalidates
-	// iterators that are traversing using Prev.
+	// the back of a list of the property "attributedTo".
 	AppendActivityStreamsTentativeReject(v ActivityStreamsTentativeReject)
 	// AppendActivityStreamsTombstone appends a Tombstone value to the back of
-	// a list of the property "attributedTo". Invalidates iterators that
-	// are traversing using Prev.
+	// a list of the property "attributedTo".
 	AppendActivityStreamsTombstone(v ActivityStreamsTombstone)
 	// AppendActivityStreamsTravel appends a Travel value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsTravel(v ActivityStreamsTravel)
 	// AppendActivityStreamsUndo appends a Undo value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendActivityStreamsUndo(v ActivityStreamsUndo)
 	// AppendActivityStreamsUpdate appends a Update value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendActivityStreamsUpdate(v ActivityStreamsUpdate)
 	// AppendActivityStreamsVideo appends a Video value to the back of a list
-	// of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "attributedTo".
 	AppendActivityStreamsVideo(v ActivityStreamsVideo)
 	// AppendActivityStreamsView appends a View value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendActivityStreamsView(v ActivityStreamsView)
 	// AppendForgeFedBranch appends a Branch value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendForgeFedBranch(v ForgeFedBranch)
 	// AppendForgeFedCommit appends a Commit value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendForgeFedCommit(v ForgeFedCommit)
 	// AppendForgeFedPush appends a Push value to the back of a list of the
-	// property "attributedTo". Invalidates iterators that are traversing
-	// using Prev.
+	// property "attributedTo".
 	AppendForgeFedPush(v ForgeFedPush)
 	// AppendForgeFedRepository appends a Repository value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendForgeFedRepository(v ForgeFedRepository)
 	// AppendForgeFedTicket appends a Ticket value to the back of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "attributedTo".
 	AppendForgeFedTicket(v ForgeFedTicket)
 	// AppendForgeFedTicketDependency appends a TicketDependency value to the
-	// back of a list of the property "attributedTo". Invalidates
-	// iterators that are traversing using Prev.
+	// back of a list of the property "attributedTo".
 	AppendForgeFedTicketDependency(v ForgeFedTicketDependency)
 	// AppendIRI appends an IRI value to the back of a list of the property
 	// "attributedTo"
 	AppendIRI(v *url.URL)
+	// AppendLitepubEmojiReact appends a EmojiReact value to the back of a
+	// list of the property "attributedTo".
+	AppendLitepubEmojiReact(v LitepubEmojiReact)
+	// AppendSchemaPropertyValue appends a PropertyValue value to the back of
+	// a list of the property "attributedTo".
+	AppendSchemaPropertyValue(v SchemaPropertyValue)
 	// AppendTootEmoji appends a Emoji value to the back of a list of the
-	// property "attributedTo". Invalidates iterators that are traversing
-	// using Prev.
+	// property "attributedTo".
 	AppendTootEmoji(v TootEmoji)
 	// AppendTootIdentityProof appends a IdentityProof value to the back of a
-	// list of the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "attributedTo".
 	AppendTootIdentityProof(v TootIdentityProof)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "attributedTo". Invalidates iterators that are
-	// traversing using Prev. Returns an error if the type is not a valid
-	// one to set for this property.
+	// AppendType appends an arbitrary type value to the back of a list of the
+	// property "attributedTo". Returns an error if the type is not a
+	// valid one to set for this property.
 	AppendType(t Type) error
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
@@ -1043,6 +1033,13 @@ type ActivityStreamsAttributedToProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsAttributedToPropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsAttributedToProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
@@ -1051,271 +1048,274 @@ type ActivityStreamsAttributedToProperty interface {
 	End() ActivityStreamsAttributedToPropertyIterator
 	// InsertActivityStreamsAccept inserts a Accept value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsAccept(idx int, v ActivityStreamsAccept)
 	// InsertActivityStreamsActivity inserts a Activity value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsActivity(idx int, v ActivityStreamsActivity)
 	// InsertActivityStreamsAdd inserts a Add value at the specified index for
 	// a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsAdd(idx int, v ActivityStreamsAdd)
 	// InsertActivityStreamsAnnounce inserts a Announce value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsAnnounce(idx int, v ActivityStreamsAnnounce)
 	// InsertActivityStreamsApplication inserts a Application value at the
 	// specified index for a property "attributedTo". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsApplication(idx int, v ActivityStreamsApplication)
 	// InsertActivityStreamsArrive inserts a Arrive value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsArrive(idx int, v ActivityStreamsArrive)
 	// InsertActivityStreamsArticle inserts a Article value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsArticle(idx int, v ActivityStreamsArticle)
 	// InsertActivityStreamsAudio inserts a Audio value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsAudio(idx int, v ActivityStreamsAudio)
 	// InsertActivityStreamsBlock inserts a Block value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsBlock(idx int, v ActivityStreamsBlock)
 	// InsertActivityStreamsCollection inserts a Collection value at the
 	// specified index for a property "attributedTo". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsCollection(idx int, v ActivityStreamsCollection)
 	// InsertActivityStreamsCollectionPage inserts a CollectionPage value at
 	// the specified index for a property "attributedTo". Existing
 	// elements at that index and higher are shifted back once.
-	// Invalidates all iterators.
 	InsertActivityStreamsCollectionPage(idx int, v ActivityStreamsCollectionPage)
 	// InsertActivityStreamsCreate inserts a Create value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsCreate(idx int, v ActivityStreamsCreate)
 	// InsertActivityStreamsDelete inserts a Delete value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsDelete(idx int, v ActivityStreamsDelete)
 	// InsertActivityStreamsDislike inserts a Dislike value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsDislike(idx int, v ActivityStreamsDislike)
 	// InsertActivityStreamsDocument inserts a Document value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsDocument(idx int, v ActivityStreamsDocument)
 	// InsertActivityStreamsEvent inserts a Event value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsEvent(idx int, v ActivityStreamsEvent)
 	// InsertActivityStreamsFlag inserts a Flag value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsFlag(idx int, v ActivityStreamsFlag)
 	// InsertActivityStreamsFollow inserts a Follow value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsFollow(idx int, v ActivityStreamsFollow)
 	// InsertActivityStreamsGroup inserts a Group value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsGroup(idx int, v ActivityStreamsGroup)
+	// InsertActivityStreamsHashtag inserts a Hashtag value at the specified
+	// index for a property "attributedTo". Existing elements at that
+	// index and higher are shifted back once.
+	InsertActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// InsertActivityStreamsIgnore inserts a Ignore value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsIgnore(idx int, v ActivityStreamsIgnore)
 	// InsertActivityStreamsImage inserts a Image value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsImage(idx int, v ActivityStreamsImage)
 	// InsertActivityStreamsIntransitiveActivity inserts a
 	// IntransitiveActivity value at the specified index for a property
 	// "attributedTo". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertActivityStreamsIntransitiveActivity(idx int, v ActivityStreamsIntransitiveActivity)
 	// InsertActivityStreamsInvite inserts a Invite value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsInvite(idx int, v ActivityStreamsInvite)
 	// InsertActivityStreamsJoin inserts a Join value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsJoin(idx int, v ActivityStreamsJoin)
 	// InsertActivityStreamsLeave inserts a Leave value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsLeave(idx int, v ActivityStreamsLeave)
 	// InsertActivityStreamsLike inserts a Like value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsLike(idx int, v ActivityStreamsLike)
 	// InsertActivityStreamsLink inserts a Link value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsLink(idx int, v ActivityStreamsLink)
 	// InsertActivityStreamsListen inserts a Listen value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsListen(idx int, v ActivityStreamsListen)
 	// InsertActivityStreamsMention inserts a Mention value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsMention(idx int, v ActivityStreamsMention)
 	// InsertActivityStreamsMove inserts a Move value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsMove(idx int, v ActivityStreamsMove)
 	// InsertActivityStreamsNote inserts a Note value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsNote(idx int, v ActivityStreamsNote)
 	// InsertActivityStreamsObject inserts a Object value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsObject(idx int, v ActivityStreamsObject)
 	// InsertActivityStreamsOffer inserts a Offer value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsOffer(idx int, v ActivityStreamsOffer)
 	// InsertActivityStreamsOrderedCollection inserts a OrderedCollection
 	// value at the specified index for a property "attributedTo".
 	// Existing elements at that index and higher are shifted back once.
-	// Invalidates all iterators.
 	InsertActivityStreamsOrderedCollection(idx int, v ActivityStreamsOrderedCollection)
 	// InsertActivityStreamsOrderedCollectionPage inserts a
 	// OrderedCollectionPage value at the specified index for a property
 	// "attributedTo". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertActivityStreamsOrderedCollectionPage(idx int, v ActivityStreamsOrderedCollectionPage)
 	// InsertActivityStreamsOrganization inserts a Organization value at the
 	// specified index for a property "attributedTo". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsOrganization(idx int, v ActivityStreamsOrganization)
 	// InsertActivityStreamsPage inserts a Page value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsPage(idx int, v ActivityStreamsPage)
 	// InsertActivityStreamsPerson inserts a Person value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsPerson(idx int, v ActivityStreamsPerson)
 	// InsertActivityStreamsPlace inserts a Place value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsPlace(idx int, v ActivityStreamsPlace)
 	// InsertActivityStreamsProfile inserts a Profile value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsProfile(idx int, v ActivityStreamsProfile)
 	// InsertActivityStreamsQuestion inserts a Question value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsQuestion(idx int, v ActivityStreamsQuestion)
 	// InsertActivityStreamsRead inserts a Read value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsRead(idx int, v ActivityStreamsRead)
 	// InsertActivityStreamsReject inserts a Reject value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsReject(idx int, v ActivityStreamsReject)
 	// InsertActivityStreamsRelationship inserts a Relationship value at the
 	// specified index for a property "attributedTo". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsRelationship(idx int, v ActivityStreamsRelationship)
 	// InsertActivityStreamsRemove inserts a Remove value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsRemove(idx int, v ActivityStreamsRemove)
 	// InsertActivityStreamsService inserts a Service value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsService(idx int, v ActivityStreamsService)
 	// InsertActivityStreamsTentativeAccept inserts a TentativeAccept value at
 	// the specified index for a property "attributedTo". Existing
 	// elements at that index and higher are shifted back once.
-	// Invalidates all iterators.
 	InsertActivityStreamsTentativeAccept(idx int, v ActivityStreamsTentativeAccept)
 	// InsertActivityStreamsTentativeReject inserts a TentativeReject value at
 	// the specified index for a property "attributedTo". Existing
 	// elements at that index and higher are shifted back once.
-	// Invalidates all iterators.
 	InsertActivityStreamsTentativeReject(idx int, v ActivityStreamsTentativeReject)
 	// InsertActivityStreamsTombstone inserts a Tombstone value at the
 	// specified index for a property "attributedTo". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsTombstone(idx int, v ActivityStreamsTombstone)
 	// InsertActivityStreamsTravel inserts a Travel value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsTravel(idx int, v ActivityStreamsTravel)
 	// InsertActivityStreamsUndo inserts a Undo value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsUndo(idx int, v ActivityStreamsUndo)
 	// InsertActivityStreamsUpdate inserts a Update value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsUpdate(idx int, v ActivityStreamsUpdate)
 	// InsertActivityStreamsVideo inserts a Video value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsVideo(idx int, v ActivityStreamsVideo)
 	// InsertActivityStreamsView inserts a View value at the specified index
 	// for a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsView(idx int, v ActivityStreamsView)
 	// InsertForgeFedBranch inserts a Branch value at the specified index for
 	// a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertForgeFedBranch(idx int, v ForgeFedBranch)
 	// InsertForgeFedCommit inserts a Commit value at the specified index for
 	// a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertForgeFedCommit(idx int, v ForgeFedCommit)
 	// InsertForgeFedPush inserts a Push value at the specified index for a
 	// property "attributedTo". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertForgeFedPush(idx int, v ForgeFedPush)
 	// InsertForgeFedRepository inserts a Repository value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertForgeFedRepository(idx int, v ForgeFedRepository)
 	// InsertForgeFedTicket inserts a Ticket value at the specified index for
 	// a property "attributedTo". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertForgeFedTicket(idx int, v ForgeFedTicket)
 	// InsertForgeFedTicketDependency inserts a TicketDependency value at the
 	// specified index for a property "attributedTo". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertForgeFedTicketDependency(idx int, v ForgeFedTicketDependency)
 	// Insert inserts an IRI value at the specified index for a property
 	// "attributedTo". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertIRI(idx int, v *url.URL)
+	// InsertLitepubEmojiReact inserts a EmojiReact value at the specified
+	// index for a property "attributedTo". Existing elements at that
+	// index and higher are shifted back once.
+	InsertLitepubEmojiReact(idx int, v LitepubEmojiReact)
+	// InsertSchemaPropertyValue inserts a PropertyValue value at the
+	// specified index for a property "attributedTo". Existing elements at
+	// that index and higher are shifted back once.
+	InsertSchemaPropertyValue(idx int, v SchemaPropertyValue)
 	// InsertTootEmoji inserts a Emoji value at the specified index for a
 	// property "attributedTo". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertTootEmoji(idx int, v TootEmoji)
 	// InsertTootIdentityProof inserts a IdentityProof value at the specified
 	// index for a property "attributedTo". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertTootIdentityProof(idx int, v TootIdentityProof)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "attributedTo". Invalidates all iterators. Returns an
-	// error if the type is not a valid one to set for this property.
+	// InsertType inserts an arbitrary type value at the specified index for
+	// the property "attributedTo". Existing elements at that index and
+	// higher are shifted back once. Returns an error if the type is not a
+	// valid one to set for this property.
 	InsertType(idx int, t Type) error
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -1341,212 +1341,212 @@ type ActivityStreamsAttributedToProperty interface {
 	// Name returns the name of this property ("attributedTo") with any alias.
 	Name() string
 	// PrependActivityStreamsAccept prepends a Accept value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsAccept(v ActivityStreamsAccept)
 	// PrependActivityStreamsActivity prepends a Activity value to the front
-	// of a list of the property "attributedTo". Invalidates all iterators.
+	// of a list of the property "attributedTo".
 	PrependActivityStreamsActivity(v ActivityStreamsActivity)
 	// PrependActivityStreamsAdd prepends a Add value to the front of a list
-	// of the property "attributedTo". Invalidates all iterators.
+	// of the property "attributedTo".
 	PrependActivityStreamsAdd(v ActivityStreamsAdd)
 	// PrependActivityStreamsAnnounce prepends a Announce value to the front
-	// of a list of the property "attributedTo". Invalidates all iterators.
+	// of a list of the property "attributedTo".
 	PrependActivityStreamsAnnounce(v ActivityStreamsAnnounce)
 	// PrependActivityStreamsApplication prepends a Application value to the
-	// front of a list of the property "attributedTo". Invalidates all
-	// iterators.
+	// front of a list of the property "attributedTo".
 	PrependActivityStreamsApplication(v ActivityStreamsApplication)
 	// PrependActivityStreamsArrive prepends a Arrive value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsArrive(v ActivityStreamsArrive)
 	// PrependActivityStreamsArticle prepends a Article value to the front of
-	// a list of the property "attributedTo". Invalidates all iterators.
+	// a list of the property "attributedTo".
 	PrependActivityStreamsArticle(v ActivityStreamsArticle)
 	// PrependActivityStreamsAudio prepends a Audio value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsAudio(v ActivityStreamsAudio)
 	// PrependActivityStreamsBlock prepends a Block value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsBlock(v ActivityStreamsBlock)
 	// PrependActivityStreamsCollection prepends a Collection value to the
-	// front of a list of the property "attributedTo". Invalidates all
-	// iterators.
+	// front of a list of the property "attributedTo".
 	PrependActivityStreamsCollection(v ActivityStreamsCollection)
 	// PrependActivityStreamsCollectionPage prepends a CollectionPage value to
-	// the front of a list of the property "attributedTo". Invalidates all
-	// iterators.
+	// the front of a list of the property "attributedTo".
 	PrependActivityStreamsCollectionPage(v ActivityStreamsCollectionPage)
 	// PrependActivityStreamsCreate prepends a Create value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsCreate(v ActivityStreamsCreate)
 	// PrependActivityStreamsDelete prepends a Delete value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsDelete(v ActivityStreamsDelete)
 	// PrependActivityStreamsDislike prepends a Dislike value to the front of
-	// a list of the property "attributedTo". Invalidates all iterators.
+	// a list of the property "attributedTo".
 	PrependActivityStreamsDislike(v ActivityStreamsDislike)
 	// PrependActivityStreamsDocument prepends a Document value to the front
-	// of a list of the property "attributedTo". Invalidates all iterators.
+	// of a list of the property "attributedTo".
 	PrependActivityStreamsDocument(v ActivityStreamsDocument)
 	// PrependActivityStreamsEvent prepends a Event value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsEvent(v ActivityStreamsEvent)
 	// PrependActivityStreamsFlag prepends a Flag value to the front of a list
-	// of the property "attributedTo". Invalidates all iterators.
+	// of the property "attributedTo".
 	PrependActivityStreamsFlag(v ActivityStreamsFlag)
 	// PrependActivityStreamsFollow prepends a Follow value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsFollow(v ActivityStreamsFollow)
 	// PrependActivityStreamsGroup prepends a Group value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsGroup(v ActivityStreamsGroup)
+	// PrependActivityStreamsHashtag prepends a Hashtag value to the front of
+	// a list of the property "attributedTo".
+	PrependActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// PrependActivityStreamsIgnore prepends a Ignore value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsIgnore(v ActivityStreamsIgnore)
 	// PrependActivityStreamsImage prepends a Image value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsImage(v ActivityStreamsImage)
 	// PrependActivityStreamsIntransitiveActivity prepends a
 	// IntransitiveActivity value to the front of a list of the property
-	// "attributedTo". Invalidates all iterators.
+	// "attributedTo".
 	PrependActivityStreamsIntransitiveActivity(v ActivityStreamsIntransitiveActivity)
 	// PrependActivityStreamsInvite prepends a Invite value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsInvite(v ActivityStreamsInvite)
 	// PrependActivityStreamsJoin prepends a Join value to the front of a list
-	// of the property "attributedTo". Invalidates all iterators.
+	// of the property "attributedTo".
 	PrependActivityStreamsJoin(v ActivityStreamsJoin)
 	// PrependActivityStreamsLeave prepends a Leave value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsLeave(v ActivityStreamsLeave)
 	// PrependActivityStreamsLike prepends a Like value to the front of a list
-	// of the property "attributedTo". Invalidates all iterators.
+	// of the property "attributedTo".
 	PrependActivityStreamsLike(v ActivityStreamsLike)
 	// PrependActivityStreamsLink prepends a Link value to the front of a list
-	// of the property "attributedTo". Invalidates all iterators.
+	// of the property "attributedTo".
 	PrependActivityStreamsLink(v ActivityStreamsLink)
 	// PrependActivityStreamsListen prepends a Listen value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsListen(v ActivityStreamsListen)
 	// PrependActivityStreamsMention prepends a Mention value to the front of
-	// a list of the property "attributedTo". Invalidates all iterators.
+	// a list of the property "attributedTo".
 	PrependActivityStreamsMention(v ActivityStreamsMention)
 	// PrependActivityStreamsMove prepends a Move value to the front of a list
-	// of the property "attributedTo". Invalidates all iterators.
+	// of the property "attributedTo".
 	PrependActivityStreamsMove(v ActivityStreamsMove)
 	// PrependActivityStreamsNote prepends a Note value to the front of a list
-	// of the property "attributedTo". Invalidates all iterators.
+	// of the property "attributedTo".
 	PrependActivityStreamsNote(v ActivityStreamsNote)
 	// PrependActivityStreamsObject prepends a Object value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsObject(v ActivityStreamsObject)
 	// PrependActivityStreamsOffer prepends a Offer value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsOffer(v ActivityStreamsOffer)
 	// PrependActivityStreamsOrderedCollection prepends a OrderedCollection
 	// value to the front of a list of the property "attributedTo".
-	// Invalidates all iterators.
 	PrependActivityStreamsOrderedCollection(v ActivityStreamsOrderedCollection)
 	// PrependActivityStreamsOrderedCollectionPage prepends a
 	// OrderedCollectionPage value to the front of a list of the property
-	// "attributedTo". Invalidates all iterators.
+	// "attributedTo".
 	PrependActivityStreamsOrderedCollectionPage(v ActivityStreamsOrderedCollectionPage)
 	// PrependActivityStreamsOrganization prepends a Organization value to the
-	// front of a list of the property "attributedTo". Invalidates all
-	// iterators.
+	// front of a list of the property "attributedTo".
 	PrependActivityStreamsOrganization(v ActivityStreamsOrganization)
 	// PrependActivityStreamsPage prepends a Page value to the front of a list
-	// of the property "attributedTo". Invalidates all iterators.
+	// of the property "attributedTo".
 	PrependActivityStreamsPage(v ActivityStreamsPage)
 	// PrependActivityStreamsPerson prepends a Person value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsPerson(v ActivityStreamsPerson)
 	// PrependActivityStreamsPlace prepends a Place value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsPlace(v ActivityStreamsPlace)
 	// PrependActivityStreamsProfile prepends a Profile value to the front of
-	// a list of the property "attributedTo". Invalidates all iterators.
+	// a list of the property "attributedTo".
 	PrependActivityStreamsProfile(v ActivityStreamsProfile)
 	// PrependActivityStreamsQuestion prepends a Question value to the front
-	// of a list of the property "attributedTo". Invalidates all iterators.
+	// of a list of the property "attributedTo".
 	PrependActivityStreamsQuestion(v ActivityStreamsQuestion)
 	// PrependActivityStreamsRead prepends a Read value to the front of a list
-	// of the property "attributedTo". Invalidates all iterators.
+	// of the property "attributedTo".
 	PrependActivityStreamsRead(v ActivityStreamsRead)
 	// PrependActivityStreamsReject prepends a Reject value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsReject(v ActivityStreamsReject)
 	// PrependActivityStreamsRelationship prepends a Relationship value to the
-	// front of a list of the property "attributedTo". Invalidates all
-	// iterators.
+	// front of a list of the property "attributedTo".
 	PrependActivityStreamsRelationship(v ActivityStreamsRelationship)
 	// PrependActivityStreamsRemove prepends a Remove value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsRemove(v ActivityStreamsRemove)
 	// PrependActivityStreamsService prepends a Service value to the front of
-	// a list of the property "attributedTo". Invalidates all iterators.
+	// a list of the property "attributedTo".
 	PrependActivityStreamsService(v ActivityStreamsService)
 	// PrependActivityStreamsTentativeAccept prepends a TentativeAccept value
-	// to the front of a list of the property "attributedTo". Invalidates
-	// all iterators.
+	// to the front of a list of the property "attributedTo".
 	PrependActivityStreamsTentativeAccept(v ActivityStreamsTentativeAccept)
 	// PrependActivityStreamsTentativeReject prepends a TentativeReject value
-	// to the front of a list of the property "attributedTo". Invalidates
-	// all iterators.
+	// to the front of a list of the property "attributedTo".
 	PrependActivityStreamsTentativeReject(v ActivityStreamsTentativeReject)
 	// PrependActivityStreamsTombstone prepends a Tombstone value to the front
-	// of a list of the property "attributedTo". Invalidates all iterators.
+	// of a list of the property "attributedTo".
 	PrependActivityStreamsTombstone(v ActivityStreamsTombstone)
 	// PrependActivityStreamsTravel prepends a Travel value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsTravel(v ActivityStreamsTravel)
 	// PrependActivityStreamsUndo prepends a Undo value to the front of a list
-	// of the property "attributedTo". Invalidates all iterators.
+	// of the property "attributedTo".
 	PrependActivityStreamsUndo(v ActivityStreamsUndo)
 	// PrependActivityStreamsUpdate prepends a Update value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsUpdate(v ActivityStreamsUpdate)
 	// PrependActivityStreamsVideo prepends a Video value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependActivityStreamsVideo(v ActivityStreamsVideo)
 	// PrependActivityStreamsView prepends a View value to the front of a list
-	// of the property "attributedTo". Invalidates all iterators.
+	// of the property "attributedTo".
 	PrependActivityStreamsView(v ActivityStreamsView)
 	// PrependForgeFedBranch prepends a Branch value to the front of a list of
-	// the property "attributedTo". Invalidates all iterators.
+	// the property "attributedTo".
 	PrependForgeFedBranch(v ForgeFedBranch)
 	// PrependForgeFedCommit prepends a Commit value to the front of a list of
-	// the property "attributedTo". Invalidates all iterators.
+	// the property "attributedTo".
 	PrependForgeFedCommit(v ForgeFedCommit)
 	// PrependForgeFedPush prepends a Push value to the front of a list of the
-	// property "attributedTo". Invalidates all iterators.
+	// property "attributedTo".
 	PrependForgeFedPush(v ForgeFedPush)
 	// PrependForgeFedRepository prepends a Repository value to the front of a
-	// list of the property "attributedTo". Invalidates all iterators.
+	// list of the property "attributedTo".
 	PrependForgeFedRepository(v ForgeFedRepository)
 	// PrependForgeFedTicket prepends a Ticket value to the front of a list of
-	// the property "attributedTo". Invalidates all iterators.
+	// the property "attributedTo".
 	PrependForgeFedTicket(v ForgeFedTicket)
 	// PrependForgeFedTicketDependency prepends a TicketDependency value to
-	// the front of a list of the property "attributedTo". Invalidates all
-	// iterators.
+	// the front of a list of the property "attributedTo".
 	PrependForgeFedTicketDependency(v ForgeFedTicketDependency)
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "attributedTo".
 	PrependIRI(v *url.URL)
+	// PrependLitepubEmojiReact prepends a EmojiReact value to the front of a
+	// list of the property "attributedTo".
+	PrependLitepubEmojiReact(v LitepubEmojiReact)
+	// PrependSchemaPropertyValue prepends a PropertyValue value to the front
+	// of a list of the property "attributedTo".
+	PrependSchemaPropertyValue(v SchemaPropertyValue)
 	// PrependTootEmoji prepends a Emoji value to the front of a list of the
-	// property "attributedTo". Invalidates all iterators.
+	// property "attributedTo".
 	PrependTootEmoji(v TootEmoji)
 	// PrependTootIdentityProof prepends a IdentityProof value to the front of
-	// a list of the property "attributedTo". Invalidates all iterators.
+	// a list of the property "attributedTo".
 	PrependTootIdentityProof(v TootIdentityProof)
 	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "attributedTo". Invalidates all iterators. Returns an
-	// error if the type is not a valid one to set for this property.
+	// the property "attributedTo". Returns an error if the type is not a
+	// valid one to set for this property.
 	PrependType(t Type) error
 	// Remove deletes an element at the specified index from a list of the
 	// property "attributedTo", regardless of its type. Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -1556,261 +1556,271 @@ type ActivityStreamsAttributedToProperty interface {
 	Serialize() (interface{}, error)
 	// SetActivityStreamsAccept sets a Accept value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsAccept(idx int, v ActivityStreamsAccept)
 	// SetActivityStreamsActivity sets a Activity value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsActivity(idx int, v ActivityStreamsActivity)
 	// SetActivityStreamsAdd sets a Add value to be at the specified index for
 	// the property "attributedTo". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsAdd(idx int, v ActivityStreamsAdd)
 	// SetActivityStreamsAnnounce sets a Announce value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsAnnounce(idx int, v ActivityStreamsAnnounce)
 	// SetActivityStreamsApplication sets a Application value to be at the
 	// specified index for the property "attributedTo". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsApplication(idx int, v ActivityStreamsApplication)
 	// SetActivityStreamsArrive sets a Arrive value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsArrive(idx int, v ActivityStreamsArrive)
 	// SetActivityStreamsArticle sets a Article value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsArticle(idx int, v ActivityStreamsArticle)
 	// SetActivityStreamsAudio sets a Audio value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsAudio(idx int, v ActivityStreamsAudio)
 	// SetActivityStreamsBlock sets a Block value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsBlock(idx int, v ActivityStreamsBlock)
 	// SetActivityStreamsCollection sets a Collection value to be at the
 	// specified index for the property "attributedTo". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsCollection(idx int, v ActivityStreamsCollection)
 	// SetActivityStreamsCollectionPage sets a CollectionPage value to be at
 	// the specified index for the property "attributedTo". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsCollectionPage(idx int, v ActivityStreamsCollectionPage)
 	// SetActivityStreamsCreate sets a Create value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsCreate(idx int, v ActivityStreamsCreate)
 	// SetActivityStreamsDelete sets a Delete value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsDelete(idx int, v ActivityStreamsDelete)
 	// SetActivityStreamsDislike sets a Dislike value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsDislike(idx int, v ActivityStreamsDislike)
 	// SetActivityStreamsDocument sets a Document value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsDocument(idx int, v ActivityStreamsDocument)
 	// SetActivityStreamsEvent sets a Event value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsEvent(idx int, v ActivityStreamsEvent)
 	// SetActivityStreamsFlag sets a Flag value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsFlag(idx int, v ActivityStreamsFlag)
 	// SetActivityStreamsFollow sets a Follow value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsFollow(idx int, v ActivityStreamsFollow)
 	// SetActivityStreamsGroup sets a Group value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsGroup(idx int, v ActivityStreamsGroup)
+	// SetActivityStreamsHashtag sets a Hashtag value to be at the specified
+	// index for the property "attributedTo". Panics if the index is out
+	// of bounds.
+	SetActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// SetActivityStreamsIgnore sets a Ignore value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsIgnore(idx int, v ActivityStreamsIgnore)
 	// SetActivityStreamsImage sets a Image value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsImage(idx int, v ActivityStreamsImage)
 	// SetActivityStreamsIntransitiveActivity sets a IntransitiveActivity
 	// value to be at the specified index for the property "attributedTo".
-	// Panics if the index is out of bounds. Invalidates all iterators.
+	// Panics if the index is out of bounds.
 	SetActivityStreamsIntransitiveActivity(idx int, v ActivityStreamsIntransitiveActivity)
 	// SetActivityStreamsInvite sets a Invite value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsInvite(idx int, v ActivityStreamsInvite)
 	// SetActivityStreamsJoin sets a Join value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsJoin(idx int, v ActivityStreamsJoin)
 	// SetActivityStreamsLeave sets a Leave value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsLeave(idx int, v ActivityStreamsLeave)
 	// SetActivityStreamsLike sets a Like value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsLike(idx int, v ActivityStreamsLike)
 	// SetActivityStreamsLink sets a Link value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsLink(idx int, v ActivityStreamsLink)
 	// SetActivityStreamsListen sets a Listen value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsListen(idx int, v ActivityStreamsListen)
 	// SetActivityStreamsMention sets a Mention value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsMention(idx int, v ActivityStreamsMention)
 	// SetActivityStreamsMove sets a Move value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsMove(idx int, v ActivityStreamsMove)
 	// SetActivityStreamsNote sets a Note value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsNote(idx int, v ActivityStreamsNote)
 	// SetActivityStreamsObject sets a Object value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsObject(idx int, v ActivityStreamsObject)
 	// SetActivityStreamsOffer sets a Offer value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsOffer(idx int, v ActivityStreamsOffer)
 	// SetActivityStreamsOrderedCollection sets a OrderedCollection value to
 	// be at the specified index for the property "attributedTo". Panics
-	// if the index is out of bounds. Invalidates all iterators.
+	// if the index is out of bounds.
 	SetActivityStreamsOrderedCollection(idx int, v ActivityStreamsOrderedCollection)
 	// SetActivityStreamsOrderedCollectionPage sets a OrderedCollectionPage
 	// value to be at the specified index for the property "attributedTo".
-	// Panics if the index is out of bounds. Invalidates all iterators.
+	// Panics if the index is out of bounds.
 	SetActivityStreamsOrderedCollectionPage(idx int, v ActivityStreamsOrderedCollectionPage)
 	// SetActivityStreamsOrganization sets a Organization value to be at the
 	// specified index for the property "attributedTo". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsOrganization(idx int, v ActivityStreamsOrganization)
 	// SetActivityStreamsPage sets a Page value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsPage(idx int, v ActivityStreamsPage)
 	// SetActivityStreamsPerson sets a Person value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsPerson(idx int, v ActivityStreamsPerson)
 	// SetActivityStreamsPlace sets a Place value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsPlace(idx int, v ActivityStreamsPlace)
 	// SetActivityStreamsProfile sets a Profile value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsProfile(idx int, v ActivityStreamsProfile)
 	// SetActivityStreamsQuestion sets a Question value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsQuestion(idx int, v ActivityStreamsQuestion)
 	// SetActivityStreamsRead sets a Read value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsRead(idx int, v ActivityStreamsRead)
 	// SetActivityStreamsReject sets a Reject value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsReject(idx int, v ActivityStreamsReject)
 	// SetActivityStreamsRelationship sets a Relationship value to be at the
 	// specified index for the property "attributedTo". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsRelationship(idx int, v ActivityStreamsRelationship)
 	// SetActivityStreamsRemove sets a Remove value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsRemove(idx int, v ActivityStreamsRemove)
 	// SetActivityStreamsService sets a Service value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsService(idx int, v ActivityStreamsService)
 	// SetActivityStreamsTentativeAccept sets a TentativeAccept value to be at
 	// the specified index for the property "attributedTo". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsTentativeAccept(idx int, v ActivityStreamsTentativeAccept)
 	// SetActivityStreamsTentativeReject sets a TentativeReject value to be at
 	// the specified index for the property "attributedTo". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsTentativeReject(idx int, v ActivityStreamsTentativeReject)
 	// SetActivityStreamsTombstone sets a Tombstone value to be at the
 	// specified index for the property "attributedTo". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsTombstone(idx int, v ActivityStreamsTombstone)
 	// SetActivityStreamsTravel sets a Travel value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsTravel(idx int, v ActivityStreamsTravel)
 	// SetActivityStreamsUndo sets a Undo value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsUndo(idx int, v ActivityStreamsUndo)
 	// SetActivityStreamsUpdate sets a Update value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetActivityStreamsUpdate(idx int, v ActivityStreamsUpdate)
 	// SetActivityStreamsVideo sets a Video value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsVideo(idx int, v ActivityStreamsVideo)
 	// SetActivityStreamsView sets a View value to be at the specified index
 	// for the property "attributedTo". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsView(idx int, v ActivityStreamsView)
 	// SetForgeFedBranch sets a Branch value to be at the specified index for
 	// the property "attributedTo". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetForgeFedBranch(idx int, v ForgeFedBranch)
 	// SetForgeFedCommit sets a Commit value to be at the specified index for
 	// the property "attributedTo". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetForgeFedCommit(idx int, v ForgeFedCommit)
 	// SetForgeFedPush sets a Push value to be at the specified index for the
 	// property "attributedTo". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetForgeFedPush(idx int, v ForgeFedPush)
 	// SetForgeFedRepository sets a Repository value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetForgeFedRepository(idx int, v ForgeFedRepository)
 	// SetForgeFedTicket sets a Ticket value to be at the specified index for
 	// the property "attributedTo". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetForgeFedTicket(idx int, v ForgeFedTicket)
 	// SetForgeFedTicketDependency sets a TicketDependency value to be at the
 	// specified index for the property "attributedTo". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetForgeFedTicketDependency(idx int, v ForgeFedTicketDependency)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "attributedTo". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
+	// SetLitepubEmojiReact sets a EmojiReact value to be at the specified
+	// index for the property "attributedTo". Panics if the index is out
+	// of bounds.
+	SetLitepubEmojiReact(idx int, v LitepubEmojiReact)
+	// SetSchemaPropertyValue sets a PropertyValue value to be at the
+	// specified index for the property "attributedTo". Panics if the
+	// index is out of bounds.
+	SetSchemaPropertyValue(idx int, v SchemaPropertyValue)
 	// SetTootEmoji sets a Emoji value to be at the specified index for the
 	// property "attributedTo". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetTootEmoji(idx int, v TootEmoji)
 	// SetTootIdentityProof sets a IdentityProof value to be at the specified
 	// index for the property "attributedTo". Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	SetTootIdentityProof(idx int, v TootIdentityProof)
 	// SetType sets an arbitrary type value to the specified index of the
-	// property "attributedTo". Invalidates all iterators. Returns an
-	// error if the type is not a valid one to set for this property.
-	// Panics if the index is out of bounds.
+	// property "attributedTo". Returns an error if the type is not a
+	// valid one to set for this property. Panics if the index is out of
+	// bounds.
 	SetType(idx int, t Type) error
 	// Swap swaps the location of values at two indices for the "attributedTo"
 	// property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []ActivityStreamsAttributedToPropertyIterator
 }
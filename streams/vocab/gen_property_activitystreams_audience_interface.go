@@ -7,6 +7,13 @@ import "net/url"
 // ActivityStreamsAudiencePropertyIterator represents a single value for the
 // "audience" property.
 type ActivityStreamsAudiencePropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsAudiencePropertyIterator) int
 	// GetActivityStreamsAccept returns the value of this property. When
 	// IsActivityStreamsAccept returns false, GetActivityStreamsAccept
 	// will return an arbitrary value.
@@ -83,6 +90,10 @@ type ActivityStreamsAudiencePropertyIterator interface {
 	// IsActivityStreamsGroup returns false, GetActivityStreamsGroup will
 	// return an arbitrary value.
 	GetActivityStreamsGroup() ActivityStreamsGroup
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsIgnore returns the value of this property. When
 	// IsActivityStreamsIgnore returns false, GetActivityStreamsIgnore
 	// will return an arbitrary value.
@@ -251,6 +262,14 @@ type ActivityStreamsAudiencePropertyIterator interface {
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
+	// GetLitepubEmojiReact returns the value of this property. When
+	// IsLitepubEmojiReact returns false, GetLitepubEmojiReact will return
+	// an arbitrary value.
+	GetLitepubEmojiReact() LitepubEmojiReact
+	// GetSchemaPropertyValue returns the value of this property. When
+	// IsSchemaPropertyValue returns false, GetSchemaPropertyValue will
+	// return an arbitrary value.
+	GetSchemaPropertyValue() SchemaPropertyValue
 	// GetTootEmoji returns the value of this property. When IsTootEmoji
 	// returns false, GetTootEmoji will return an arbitrary value.
 	GetTootEmoji() TootEmoji
@@ -344,6 +363,10 @@ type ActivityStreamsAudiencePropertyIterator interface {
 	// "Group". When true, use the GetActivityStreamsGroup and
 	// SetActivityStreamsGroup methods to access and set this property.
 	IsActivityStreamsGroup() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsIgnore returns true if this property has a type of
 	// "Ignore". When true, use the GetActivityStreamsIgnore and
 	// SetActivityStreamsIgnore methods to access and set this property.
@@ -524,6 +547,14 @@ type ActivityStreamsAudiencePropertyIterator interface {
 	// IsIRI returns true if this property is an IRI. When true, use GetIRI
 	// and SetIRI to access and set this property
 	IsIRI() bool
+	// IsLitepubEmojiReact returns true if this property has a type of
+	// "EmojiReact". When true, use the GetLitepubEmojiReact and
+	// SetLitepubEmojiReact methods to access and set this property.
+	IsLitepubEmojiReact() bool
+	// IsSchemaPropertyValue returns true if this property has a type of
+	// "PropertyValue". When true, use the GetSchemaPropertyValue and
+	// SetSchemaPropertyValue methods to access and set this property.
+	IsSchemaPropertyValue() bool
 	// IsTootEmoji returns true if this property has a type of "Emoji". When
 	// true, use the GetTootEmoji and SetTootEmoji methods to access and
 	// set this property.
@@ -548,10 +579,14 @@ type ActivityStreamsAudiencePropertyIterator interface {
 	LessThan(o ActivityStreamsAudiencePropertyIterator) bool
 	// Name returns the name of this property: "ActivityStreamsAudience".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() ActivityStreamsAudiencePropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() ActivityStreamsAudiencePropertyIterator
 	// SetActivityStreamsAccept sets the value of this property. Calling
 	// IsActivityStreamsAccept afterwards returns true.
@@ -610,6 +645,9 @@ type ActivityStreamsAudiencePropertyIterator interface {
 	// SetActivityStreamsGroup sets the value of this property. Calling
 	// IsActivityStreamsGroup afterwards returns true.
 	SetActivityStreamsGroup(v ActivityStreamsGroup)
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsIgnore sets the value of this property. Calling
 	// IsActivityStreamsIgnore afterwards returns true.
 	SetActivityStreamsIgnore(v ActivityStreamsIgnore)
@@ -738,6 +776,12 @@ type ActivityStreamsAudiencePropertyIterator interface {
 	// SetIRI sets the value of this property. Calling IsIRI afterwards
 	// returns true.
 	SetIRI(v *url.URL)
+	// SetLitepubEmojiReact sets the value of this property. Calling
+	// IsLitepubEmojiReact afterwards returns true.
+	SetLitepubEmojiReact(v LitepubEmojiReact)
+	// SetSchemaPropertyValue sets the value of this property. Calling
+	// IsSchemaPropertyValue afterwards returns true.
+	SetSchemaPropertyValue(v SchemaPropertyValue)
 	// SetTootEmoji sets the value of this property. Calling IsTootEmoji
 	// afterwards returns true.
 	SetTootEmoji(v TootEmoji)
@@ -765,260 +809,208 @@ type ActivityStreamsAudiencePropertyIterator interface {
 //   }
 type ActivityStreamsAudienceProperty interface {
 	// AppendActivityStreamsAccept appends a Accept value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsAccept(v ActivityStreamsAccept)
 	// AppendActivityStreamsActivity appends a Activity value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsActivity(v ActivityStreamsActivity)
 	// AppendActivityStreamsAdd appends a Add value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendActivityStreamsAdd(v ActivityStreamsAdd)
 	// AppendActivityStreamsAnnounce appends a Announce value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsAnnounce(v ActivityStreamsAnnounce)
 	// AppendActivityStreamsApplication appends a Application value to the
-	// back of a list of the property "audience". Invalidates iterators
-	// that are traversing using Prev.
+	// back of a list of the property "audience".
 	AppendActivityStreamsApplication(v ActivityStreamsApplication)
 	// AppendActivityStreamsArrive appends a Arrive value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsArrive(v ActivityStreamsArrive)
 	// AppendActivityStreamsArticle appends a Article value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsArticle(v ActivityStreamsArticle)
 	// AppendActivityStreamsAudio appends a Audio value to the back of a list
-	// of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "audience".
 	AppendActivityStreamsAudio(v ActivityStreamsAudio)
 	// AppendActivityStreamsBlock appends a Block value to the back of a list
-	// of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "audience".
 	AppendActivityStreamsBlock(v ActivityStreamsBlock)
 	// AppendActivityStreamsCollection appends a Collection value to the back
-	// of a list of the property "audience". Invalidates iterators that
-	// are traversing using Prev.
+	// of a list of the property "audience".
 	AppendActivityStreamsCollection(v ActivityStreamsCollection)
 	// AppendActivityStreamsCollectionPage appends a CollectionPage value to
-	// the back of a list of the property "audience". Invalidates
-	// iterators that are traversing using Prev.
+	// the back of a list of the property "audience".
 	AppendActivityStreamsCollectionPage(v ActivityStreamsCollectionPage)
 	// AppendActivityStreamsCreate appends a Create value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsCreate(v ActivityStreamsCreate)
 	// AppendActivityStreamsDelete appends a Delete value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsDelete(v ActivityStreamsDelete)
 	// AppendActivityStreamsDislike appends a Dislike value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsDislike(v ActivityStreamsDislike)
 	// AppendActivityStreamsDocument appends a Document value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsDocument(v ActivityStreamsDocument)
 	// AppendActivityStreamsEvent appends a Event value to the back of a list
-	// of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "audience".
 	AppendActivityStreamsEvent(v ActivityStreamsEvent)
 	// AppendActivityStreamsFlag appends a Flag value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendActivityStreamsFlag(v ActivityStreamsFlag)
 	// AppendActivityStreamsFollow appends a Follow value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsFollow(v ActivityStreamsFollow)
 	// AppendActivityStreamsGroup appends a Group value to the back of a list
-	// of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "audience".
 	AppendActivityStreamsGroup(v ActivityStreamsGroup)
+	// AppendActivityStreamsHashtag appends a Hashtag value to the back of a
+	// list of the property "audience".
+	AppendActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// AppendActivityStreamsIgnore appends a Ignore value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsIgnore(v ActivityStreamsIgnore)
 	// AppendActivityStreamsImage appends a Image value to the back of a list
-	// of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "audience".
 	AppendActivityStreamsImage(v ActivityStreamsImage)
 	// AppendActivityStreamsIntransitiveActivity appends a
 	// IntransitiveActivity value to the back of a list of the property
-	// "audience". Invalidates iterators that are traversing using Prev.
+	// "audience".
 	AppendActivityStreamsIntransitiveActivity(v ActivityStreamsIntransitiveActivity)
 	// AppendActivityStreamsInvite appends a Invite value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsInvite(v ActivityStreamsInvite)
 	// AppendActivityStreamsJoin appends a Join value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendActivityStreamsJoin(v ActivityStreamsJoin)
 	// AppendActivityStreamsLeave appends a Leave value to the back of a list
-	// of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "audience".
 	AppendActivityStreamsLeave(v ActivityStreamsLeave)
 	// AppendActivityStreamsLike appends a Like value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendActivityStreamsLike(v ActivityStreamsLike)
 	// AppendActivityStreamsLink appends a Link value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendActivityStreamsLink(v ActivityStreamsLink)
 	// AppendActivityStreamsListen appends a Listen value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsListen(v ActivityStreamsListen)
 	// AppendActivityStreamsMention appends a Mention value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsMention(v ActivityStreamsMention)
 	// AppendActivityStreamsMove appends a Move value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendActivityStreamsMove(v ActivityStreamsMove)
 	// AppendActivityStreamsNote appends a Note value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendActivityStreamsNote(v ActivityStreamsNote)
 	// AppendActivityStreamsObject appends a Object value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsObject(v ActivityStreamsObject)
 	// AppendActivityStreamsOffer appends a Offer value to the back of a list
-	// of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "audience".
 	AppendActivityStreamsOffer(v ActivityStreamsOffer)
 	// AppendActivityStreamsOrderedCollection appends a OrderedCollection
-	// value to the back of a list of the property "audience". Invalidates
-	// iterators that are traversing using Prev.
+	// value to the back of a list of the property "audience".
 	AppendActivityStreamsOrderedCollection(v ActivityStreamsOrderedCollection)
 	// AppendActivityStreamsOrderedCollectionPage appends a
 	// OrderedCollectionPage value to the back of a list of the property
-	// "audience". Invalidates iterators that are traversing using Prev.
+	// "audience".
 	AppendActivityStreamsOrderedCollectionPage(v ActivityStreamsOrderedCollectionPage)
 	// AppendActivityStreamsOrganization appends a Organization value to the
-	// back of a list of the property "audience". Invalidates iterators
-	// that are traversing using Prev.
+	// back of a list of the property "audience".
 	AppendActivityStreamsOrganization(v ActivityStreamsOrganization)
 	// AppendActivityStreamsPage appends a Page value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendActivityStreamsPage(v ActivityStreamsPage)
 	// AppendActivityStreamsPerson appends a Person value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsPerson(v ActivityStreamsPerson)
 	// AppendActivityStreamsPlace appends a Place value to the back of a list
-	// of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "audience".
 	AppendActivityStreamsPlace(v ActivityStreamsPlace)
 	// AppendActivityStreamsProfile appends a Profile value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsProfile(v ActivityStreamsProfile)
 	// AppendActivityStreamsQuestion appends a Question value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsQuestion(v ActivityStreamsQuestion)
 	// AppendActivityStreamsRead appends a Read value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendActivityStreamsRead(v ActivityStreamsRead)
 	// AppendActivityStreamsReject appends a Reject value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsReject(v ActivityStreamsReject)
 	// AppendActivityStreamsRelationship appends a Relationship value to the
-	// back of a list of the property "audience". Invalidates iterators
-	// that are traversing using Prev.
+	// back of a list of the property "audience".
 	AppendActivityStreamsRelationship(v ActivityStreamsRelationship)
 	// AppendActivityStreamsRemove appends a Remove value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsRemove(v ActivityStreamsRemove)
 	// AppendActivityStreamsService appends a Service value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsService(v ActivityStreamsService)
 	// AppendActivityStreamsTentativeAccept appends a TentativeAccept value to
-	// the back of a list of the property "audience". Invalidates
-	// iterators that are traversing using Prev.
+	// the back of a list of the property "audience".
 	AppendActivityStreamsTentativeAccept(v ActivityStreamsTentativeAccept)
 	// AppendActivityStreamsTentativeReject appends a TentativeReject value to
-	// the back of a list of the property "audience". Invalidates
-	// iterators that are traversing using Prev.
+	// the back of a list of the property "audience".
 	AppendActivityStreamsTentativeReject(v ActivityStreamsTentativeReject)
 	// AppendActivityStreamsTombstone appends a Tombstone value to the back of
-	// a list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// a list of the property "audience".
 	AppendActivityStreamsTombstone(v ActivityStreamsTombstone)
 	// AppendActivityStreamsTravel appends a Travel value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsTravel(v ActivityStreamsTravel)
 	// AppendActivityStreamsUndo appends a Undo value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendActivityStreamsUndo(v ActivityStreamsUndo)
 	// AppendActivityStreamsUpdate appends a Update value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendActivityStreamsUpdate(v ActivityStreamsUpdate)
 	// AppendActivityStreamsVideo appends a Video value to the back of a list
-	// of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// of the property "audience".
 	AppendActivityStreamsVideo(v ActivityStreamsVideo)
 	// AppendActivityStreamsView appends a View value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendActivityStreamsView(v ActivityStreamsView)
 	// AppendForgeFedBranch appends a Branch value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendForgeFedBranch(v ForgeFedBranch)
 	// AppendForgeFedCommit appends a Commit value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendForgeFedCommit(v ForgeFedCommit)
 	// AppendForgeFedPush appends a Push value to the back of a list of the
-	// property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// property "audience".
 	AppendForgeFedPush(v ForgeFedPush)
 	// AppendForgeFedRepository appends a Repository value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendForgeFedRepository(v ForgeFedRepository)
 	// AppendForgeFedTicket appends a Ticket value to the back of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "audience".
 	AppendForgeFedTicket(v ForgeFedTicket)
 	// AppendForgeFedTicketDependency appends a TicketDependency value to the
-	// back of a list of the property "audience". Invalidates iterators
-	// that are traversing using Prev.
+	// back of a list of the property "audience".
 	AppendForgeFedTicketDependency(v ForgeFedTicketDependency)
 	// AppendIRI appends an IRI value to the back of a list of the property
 	// "audience"
 	AppendIRI(v *url.URL)
+	// AppendLitepubEmojiReact appends a EmojiReact value to the back of a
+	// list of the property "audience".
+	AppendLitepubEmojiReact(v LitepubEmojiReact)
+	// AppendSchemaPropertyValue appends a PropertyValue value to the back of
+	// a list of the property "audience".
+	AppendSchemaPropertyValue(v SchemaPropertyValue)
 	// AppendTootEmoji appends a Emoji value to the back of a list of the
-	// property "audience". Invalidates iterators that are traversing
-	// using Prev.
+	// property "audience".
 	AppendTootEmoji(v TootEmoji)
 	// AppendTootIdentityProof appends a IdentityProof value to the back of a
-	// list of the property "audience". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "audience".
 	AppendTootIdentityProof(v TootIdentityProof)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "audience". Invalidates iterators that are traversing
-	// using Prev. Returns an error if the type is not a valid one to set
-	// for this property.
+	// AppendType appends an arbitrary type value to the back of a list of the
+	// property "audience". Returns an error if the type is not a valid
+	// one to set for this property.
 	AppendType(t Type) error
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
@@ -1027,6 +1019,13 @@ type ActivityStreamsAudienceProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsAudiencePropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsAudienceProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
@@ -1035,271 +1034,274 @@ type ActivityStreamsAudienceProperty interface {
 	End() ActivityStreamsAudiencePropertyIterator
 	// InsertActivityStreamsAccept inserts a Accept value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsAccept(idx int, v ActivityStreamsAccept)
 	// InsertActivityStreamsActivity inserts a Activity value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsActivity(idx int, v ActivityStreamsActivity)
 	// InsertActivityStreamsAdd inserts a Add value at the specified index for
 	// a property "audience". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertActivityStreamsAdd(idx int, v ActivityStreamsAdd)
 	// InsertActivityStreamsAnnounce inserts a Announce value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsAnnounce(idx int, v ActivityStreamsAnnounce)
 	// InsertActivityStreamsApplication inserts a Application value at the
 	// specified index for a property "audience". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsApplication(idx int, v ActivityStreamsApplication)
 	// InsertActivityStreamsArrive inserts a Arrive value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsArrive(idx int, v ActivityStreamsArrive)
 	// InsertActivityStreamsArticle inserts a Article value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsArticle(idx int, v ActivityStreamsArticle)
 	// InsertActivityStreamsAudio inserts a Audio value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsAudio(idx int, v ActivityStreamsAudio)
 	// InsertActivityStreamsBlock inserts a Block value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsBlock(idx int, v ActivityStreamsBlock)
 	// InsertActivityStreamsCollection inserts a Collection value at the
 	// specified index for a property "audience". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsCollection(idx int, v ActivityStreamsCollection)
 	// InsertActivityStreamsCollectionPage inserts a CollectionPage value at
 	// the specified index for a property "audience". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsCollectionPage(idx int, v ActivityStreamsCollectionPage)
 	// InsertActivityStreamsCreate inserts a Create value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsCreate(idx int, v ActivityStreamsCreate)
 	// InsertActivityStreamsDelete inserts a Delete value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsDelete(idx int, v ActivityStreamsDelete)
 	// InsertActivityStreamsDislike inserts a Dislike value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsDislike(idx int, v ActivityStreamsDislike)
 	// InsertActivityStreamsDocument inserts a Document value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsDocument(idx int, v ActivityStreamsDocument)
 	// InsertActivityStreamsEvent inserts a Event value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsEvent(idx int, v ActivityStreamsEvent)
 	// InsertActivityStreamsFlag inserts a Flag value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsFlag(idx int, v ActivityStreamsFlag)
 	// InsertActivityStreamsFollow inserts a Follow value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsFollow(idx int, v ActivityStreamsFollow)
 	// InsertActivityStreamsGroup inserts a Group value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsGroup(idx int, v ActivityStreamsGroup)
+	// InsertActivityStreamsHashtag inserts a Hashtag value at the specified
+	// index for a property "audience". Existing elements at that index
+	// and higher are shifted back once.
+	InsertActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// InsertActivityStreamsIgnore inserts a Ignore value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsIgnore(idx int, v ActivityStreamsIgnore)
 	// InsertActivityStreamsImage inserts a Image value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsImage(idx int, v ActivityStreamsImage)
 	// InsertActivityStreamsIntransitiveActivity inserts a
 	// IntransitiveActivity value at the specified index for a property
 	// "audience". Existing elements at that index and higher are shifted
-	// back once. Invalidates all iterators.
+	// back once.
 	InsertActivityStreamsIntransitiveActivity(idx int, v ActivityStreamsIntransitiveActivity)
 	// InsertActivityStreamsInvite inserts a Invite value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsInvite(idx int, v ActivityStreamsInvite)
 	// InsertActivityStreamsJoin inserts a Join value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsJoin(idx int, v ActivityStreamsJoin)
 	// InsertActivityStreamsLeave inserts a Leave value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsLeave(idx int, v ActivityStreamsLeave)
 	// InsertActivityStreamsLike inserts a Like value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsLike(idx int, v ActivityStreamsLike)
 	// InsertActivityStreamsLink inserts a Link value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsLink(idx int, v ActivityStreamsLink)
 	// InsertActivityStreamsListen inserts a Listen value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsListen(idx int, v ActivityStreamsListen)
 	// InsertActivityStreamsMention inserts a Mention value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsMention(idx int, v ActivityStreamsMention)
 	// InsertActivityStreamsMove inserts a Move value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsMove(idx int, v ActivityStreamsMove)
 	// InsertActivityStreamsNote inserts a Note value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsNote(idx int, v ActivityStreamsNote)
 	// InsertActivityStreamsObject inserts a Object value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsObject(idx int, v ActivityStreamsObject)
 	// InsertActivityStreamsOffer inserts a Offer value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsOffer(idx int, v ActivityStreamsOffer)
 	// InsertActivityStreamsOrderedCollection inserts a OrderedCollection
 	// value at the specified index for a property "audience". Existing
 	// elements at that index and higher are shifted back once.
-	// Invalidates all iterators.
 	InsertActivityStreamsOrderedCollection(idx int, v ActivityStreamsOrderedCollection)
 	// InsertActivityStreamsOrderedCollectionPage inserts a
 	// OrderedCollectionPage value at the specified index for a property
 	// "audience". Existing elements at that index and higher are shifted
-	// back once. Invalidates all iterators.
+	// back once.
 	InsertActivityStreamsOrderedCollectionPage(idx int, v ActivityStreamsOrderedCollectionPage)
 	// InsertActivityStreamsOrganization inserts a Organization value at the
 	// specified index for a property "audience". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsOrganization(idx int, v ActivityStreamsOrganization)
 	// InsertActivityStreamsPage inserts a Page value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsPage(idx int, v ActivityStreamsPage)
 	// InsertActivityStreamsPerson inserts a Person value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsPerson(idx int, v ActivityStreamsPerson)
 	// InsertActivityStreamsPlace inserts a Place value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsPlace(idx int, v ActivityStreamsPlace)
 	// InsertActivityStreamsProfile inserts a Profile value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsProfile(idx int, v ActivityStreamsProfile)
 	// InsertActivityStreamsQuestion inserts a Question value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsQuestion(idx int, v ActivityStreamsQuestion)
 	// InsertActivityStreamsRead inserts a Read value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsRead(idx int, v ActivityStreamsRead)
 	// InsertActivityStreamsReject inserts a Reject value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsReject(idx int, v ActivityStreamsReject)
 	// InsertActivityStreamsRelationship inserts a Relationship value at the
 	// specified index for a property "audience". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsRelationship(idx int, v ActivityStreamsRelationship)
 	// InsertActivityStreamsRemove inserts a Remove value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsRemove(idx int, v ActivityStreamsRemove)
 	// InsertActivityStreamsService inserts a Service value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsService(idx int, v ActivityStreamsService)
 	// InsertActivityStreamsTentativeAccept inserts a TentativeAccept value at
 	// the specified index for a property "audience". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsTentativeAccept(idx int, v ActivityStreamsTentativeAccept)
 	// InsertActivityStreamsTentativeReject inserts a TentativeReject value at
 	// the specified index for a property "audience". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsTentativeReject(idx int, v ActivityStreamsTentativeReject)
 	// InsertActivityStreamsTombstone inserts a Tombstone value at the
 	// specified index for a property "audience". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsTombstone(idx int, v ActivityStreamsTombstone)
 	// InsertActivityStreamsTravel inserts a Travel value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsTravel(idx int, v ActivityStreamsTravel)
 	// InsertActivityStreamsUndo inserts a Undo value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsUndo(idx int, v ActivityStreamsUndo)
 	// InsertActivityStreamsUpdate inserts a Update value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertActivityStreamsUpdate(idx int, v ActivityStreamsUpdate)
 	// InsertActivityStreamsVideo inserts a Video value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsVideo(idx int, v ActivityStreamsVideo)
 	// InsertActivityStreamsView inserts a View value at the specified index
 	// for a property "audience". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsView(idx int, v ActivityStreamsView)
 	// InsertForgeFedBranch inserts a Branch value at the specified index for
 	// a property "audience". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertForgeFedBranch(idx int, v ForgeFedBranch)
 	// InsertForgeFedCommit inserts a Commit value at the specified index for
 	// a property "audience". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertForgeFedCommit(idx int, v ForgeFedCommit)
 	// InsertForgeFedPush inserts a Push value at the specified index for a
 	// property "audience". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertForgeFedPush(idx int, v ForgeFedPush)
 	// InsertForgeFedRepository inserts a Repository value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertForgeFedRepository(idx int, v ForgeFedRepository)
 	// InsertForgeFedTicket inserts a Ticket value at the specified index for
 	// a property "audience". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertForgeFedTicket(idx int, v ForgeFedTicket)
 	// InsertForgeFedTicketDependency inserts a TicketDependency value at the
 	// specified index for a property "audience". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertForgeFedTicketDependency(idx int, v ForgeFedTicketDependency)
 	// Insert inserts an IRI value at the specified index for a property
 	// "audience". Existing elements at that index and higher are shifted
-	// back once. Invalidates all iterators.
+	// back once.
 	InsertIRI(idx int, v *url.URL)
+	// InsertLitepubEmojiReact inserts a EmojiReact value at the specified
+	// index for a property "audience". Existing elements at that index
+	// and higher are shifted back once.
+	InsertLitepubEmojiReact(idx int, v LitepubEmojiReact)
+	// InsertSchemaPropertyValue inserts a PropertyValue value at the
+	// specified index for a property "audience". Existing elements at
+	// that index and higher are shifted back once.
+	InsertSchemaPropertyValue(idx int, v SchemaPropertyValue)
 	// InsertTootEmoji inserts a Emoji value at the specified index for a
 	// property "audience". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertTootEmoji(idx int, v TootEmoji)
 	// InsertTootIdentityProof inserts a IdentityProof value at the specified
 	// index for a property "audience". Existing elements at that index
-	// and higher are shifted back once. Invalidates all iterators.
+	// and higher are shifted back once.
 	InsertTootIdentityProof(idx int, v TootIdentityProof)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "audience". Invalidates all iterators. Returns an
-	// error if the type is not a valid one to set for this property.
+	// InsertType inserts an arbitrary type value at the specified index for
+	// the property "audience". Existing elements at that index and higher
+	// are shifted back once. Returns an error if the type is not a valid
+	// one to set for this property.
 	InsertType(idx int, t Type) error
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -1324,212 +1326,212 @@ type ActivityStreamsAudienceProperty interface {
 	// Name returns the name of this property ("audience") with any alias.
 	Name() string
 	// PrependActivityStreamsAccept prepends a Accept value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsAccept(v ActivityStreamsAccept)
 	// PrependActivityStreamsActivity prepends a Activity value to the front
-	// of a list of the property "audience". Invalidates all iterators.
+	// of a list of the property "audience".
 	PrependActivityStreamsActivity(v ActivityStreamsActivity)
 	// PrependActivityStreamsAdd prepends a Add value to the front of a list
-	// of the property "audience". Invalidates all iterators.
+	// of the property "audience".
 	PrependActivityStreamsAdd(v ActivityStreamsAdd)
 	// PrependActivityStreamsAnnounce prepends a Announce value to the front
-	// of a list of the property "audience". Invalidates all iterators.
+	// of a list of the property "audience".
 	PrependActivityStreamsAnnounce(v ActivityStreamsAnnounce)
 	// PrependActivityStreamsApplication prepends a Application value to the
-	// front of a list of the property "audience". Invalidates all
-	// iterators.
+	// front of a list of the property "audience".
 	PrependActivityStreamsApplication(v ActivityStreamsApplication)
 	// PrependActivityStreamsArrive prepends a Arrive value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsArrive(v ActivityStreamsArrive)
 	// PrependActivityStreamsArticle prepends a Article value to the front of
-	// a list of the property "audience". Invalidates all iterators.
+	// a list of the property "audience".
 	PrependActivityStreamsArticle(v ActivityStreamsArticle)
 	// PrependActivityStreamsAudio prepends a Audio value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsAudio(v ActivityStreamsAudio)
 	// PrependActivityStreamsBlock prepends a Block value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsBlock(v ActivityStreamsBlock)
 	// PrependActivityStreamsCollection prepends a Collection value to the
-	// front of a list of the property "audience". Invalidates all
-	// iterators.
+	// front of a list of the property "audience".
 	PrependActivityStreamsCollection(v ActivityStreamsCollection)
 	// PrependActivityStreamsCollectionPage prepends a CollectionPage value to
-	// the front of a list of the property "audience". Invalidates all
-	// iterators.
+	// the front of a list of the property "audience".
 	PrependActivityStreamsCollectionPage(v ActivityStreamsCollectionPage)
 	// PrependActivityStreamsCreate prepends a Create value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsCreate(v ActivityStreamsCreate)
 	// PrependActivityStreamsDelete prepends a Delete value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsDelete(v ActivityStreamsDelete)
 	// PrependActivityStreamsDislike prepends a Dislike value to the front of
-	// a list of the property "audience". Invalidates all iterators.
+	// a list of the property "audience".
 	PrependActivityStreamsDislike(v ActivityStreamsDislike)
 	// PrependActivityStreamsDocument prepends a Document value to the front
-	// of a list of the property "audience". Invalidates all iterators.
+	// of a list of the property "audience".
 	PrependActivityStreamsDocument(v ActivityStreamsDocument)
 	// PrependActivityStreamsEvent prepends a Event value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsEvent(v ActivityStreamsEvent)
 	// PrependActivityStreamsFlag prepends a Flag value to the front of a list
-	// of the property "audience". Invalidates all iterators.
+	// of the property "audience".
 	PrependActivityStreamsFlag(v ActivityStreamsFlag)
 	// PrependActivityStreamsFollow prepends a Follow value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsFollow(v ActivityStreamsFollow)
 	// PrependActivityStreamsGroup prepends a Group value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsGroup(v ActivityStreamsGroup)
+	// PrependActivityStreamsHashtag prepends a Hashtag value to the front of
+	// a list of the property "audience".
+	PrependActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// PrependActivityStreamsIgnore prepends a Ignore value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsIgnore(v ActivityStreamsIgnore)
 	// PrependActivityStreamsImage prepends a Image value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsImage(v ActivityStreamsImage)
 	// PrependActivityStreamsIntransitiveActivity prepends a
 	// IntransitiveActivity value to the front of a list of the property
-	// "audience". Invalidates all iterators.
+	// "audience".
 	PrependActivityStreamsIntransitiveActivity(v ActivityStreamsIntransitiveActivity)
 	// PrependActivityStreamsInvite prepends a Invite value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsInvite(v ActivityStreamsInvite)
 	// PrependActivityStreamsJoin prepends a Join value to the front of a list
-	// of the property "audience". Invalidates all iterators.
+	// of the property "audience".
 	PrependActivityStreamsJoin(v ActivityStreamsJoin)
 	// PrependActivityStreamsLeave prepends a Leave value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsLeave(v ActivityStreamsLeave)
 	// PrependActivityStreamsLike prepends a Like value to the front of a list
-	// of the property "audience". Invalidates all iterators.
+	// of the property "audience".
 	PrependActivityStreamsLike(v ActivityStreamsLike)
 	// PrependActivityStreamsLink prepends a Link value to the front of a list
-	// of the property "audience". Invalidates all iterators.
+	// of the property "audience".
 	PrependActivityStreamsLink(v ActivityStreamsLink)
 	// PrependActivityStreamsListen prepends a Listen value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsListen(v ActivityStreamsListen)
 	// PrependActivityStreamsMention prepends a Mention value to the front of
-	// a list of the property "audience". Invalidates all iterators.
+	// a list of the property "audience".
 	PrependActivityStreamsMention(v ActivityStreamsMention)
 	// PrependActivityStreamsMove prepends a Move value to the front of a list
-	// of the property "audience". Invalidates all iterators.
+	// of the property "audience".
 	PrependActivityStreamsMove(v ActivityStreamsMove)
 	// PrependActivityStreamsNote prepends a Note value to the front of a list
-	// of the property "audience". Invalidates all iterators.
+	// of the property "audience".
 	PrependActivityStreamsNote(v ActivityStreamsNote)
 	// PrependActivityStreamsObject prepends a Object value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsObject(v ActivityStreamsObject)
 	// PrependActivityStreamsOffer prepends a Offer value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsOffer(v ActivityStreamsOffer)
 	// PrependActivityStreamsOrderedCollection prepends a OrderedCollection
 	// value to the front of a list of the property "audience".
-	// Invalidates all iterators.
 	PrependActivityStreamsOrderedCollection(v ActivityStreamsOrderedCollection)
 	// PrependActivityStreamsOrderedCollectionPage prepends a
 	// OrderedCollectionPage value to the front of a list of the property
-	// "audience". Invalidates all iterators.
+	// "audience".
 	PrependActivityStreamsOrderedCollectionPage(v ActivityStreamsOrderedCollectionPage)
 	// PrependActivityStreamsOrganization prepends a Organization value to the
-	// front of a list of the property "audience". Invalidates all
-	// iterators.
+	// front of a list of the property "audience".
 	PrependActivityStreamsOrganization(v ActivityStreamsOrganization)
 	// PrependActivityStreamsPage prepends a Page value to the front of a list
-	// of the property "audience". Invalidates all iterators.
+	// of the property "audience".
 	PrependActivityStreamsPage(v ActivityStreamsPage)
 	// PrependActivityStreamsPerson prepends a Person value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsPerson(v ActivityStreamsPerson)
 	// PrependActivityStreamsPlace prepends a Place value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsPlace(v ActivityStreamsPlace)
 	// PrependActivityStreamsProfile prepends a Profile value to the front of
-	// a list of the property "audience". Invalidates all iterators.
+	// a list of the property "audience".
 	PrependActivityStreamsProfile(v ActivityStreamsProfile)
 	// PrependActivityStreamsQuestion prepends a Question value to the front
-	// of a list of the property "audience". Invalidates all iterators.
+	// of a list of the property "audience".
 	PrependActivityStreamsQuestion(v ActivityStreamsQuestion)
 	// PrependActivityStreamsRead prepends a Read value to the front of a list
-	// of the property "audience". Invalidates all iterators.
+	// of the property "audience".
 	PrependActivityStreamsRead(v ActivityStreamsRead)
 	// PrependActivityStreamsReject prepends a Reject value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsReject(v ActivityStreamsReject)
 	// PrependActivityStreamsRelationship prepends a Relationship value to the
-	// front of a list of the property "audience". Invalidates all
-	// iterators.
+	// front of a list of the property "audience".
 	PrependActivityStreamsRelationship(v ActivityStreamsRelationship)
 	// PrependActivityStreamsRemove prepends a Remove value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsRemove(v ActivityStreamsRemove)
 	// PrependActivityStreamsService prepends a Service value to the front of
-	// a list of the property "audience". Invalidates all iterators.
+	// a list of the property "audience".
 	PrependActivityStreamsService(v ActivityStreamsService)
 	// PrependActivityStreamsTentativeAccept prepends a TentativeAccept value
-	// to the front of a list of the property "audience". Invalidates all
-	// iterators.
+	// to the front of a list of the property "audience".
 	PrependActivityStreamsTentativeAccept(v ActivityStreamsTentativeAccept)
 	// PrependActivityStreamsTentativeReject prepends a TentativeReject value
-	// to the front of a list of the property "audience". Invalidates all
-	// iterators.
+	// to the front of a list of the property "audience".
 	PrependActivityStreamsTentativeReject(v ActivityStreamsTentativeReject)
 	// PrependActivityStreamsTombstone prepends a Tombstone value to the front
-	// of a list of the property "audience". Invalidates all iterators.
+	// of a list of the property "audience".
 	PrependActivityStreamsTombstone(v ActivityStreamsTombstone)
 	// PrependActivityStreamsTravel prepends a Travel value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsTravel(v ActivityStreamsTravel)
 	// PrependActivityStreamsUndo prepends a Undo value to the front of a list
-	// of the property "audience". Invalidates all iterators.
+	// of the property "audience".
 	PrependActivityStreamsUndo(v ActivityStreamsUndo)
 	// PrependActivityStreamsUpdate prepends a Update value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsUpdate(v ActivityStreamsUpdate)
 	// PrependActivityStreamsVideo prepends a Video value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependActivityStreamsVideo(v ActivityStreamsVideo)
 	// PrependActivityStreamsView prepends a View value to the front of a list
-	// of the property "audience". Invalidates all iterators.
+	// of the property "audience".
 	PrependActivityStreamsView(v ActivityStreamsView)
 	// PrependForgeFedBranch prepends a Branch value to the front of a list of
-	// the property "audience". Invalidates all iterators.
+	// the property "audience".
 	PrependForgeFedBranch(v ForgeFedBranch)
 	// PrependForgeFedCommit prepends a Commit value to the front of a list of
-	// the property "audience". Invalidates all iterators.
+	// the property "audience".
 	PrependForgeFedCommit(v ForgeFedCommit)
 	// PrependForgeFedPush prepends a Push value to the front of a list of the
-	// property "audience". Invalidates all iterators.
+	// property "audience".
 	PrependForgeFedPush(v ForgeFedPush)
 	// PrependForgeFedRepository prepends a Repository value to the front of a
-	// list of the property "audience". Invalidates all iterators.
+	// list of the property "audience".
 	PrependForgeFedRepository(v ForgeFedRepository)
 	// PrependForgeFedTicket prepends a Ticket value to the front of a list of
-	// the property "audience". Invalidates all iterators.
+	// the property "audience".
 	PrependForgeFedTicket(v ForgeFedTicket)
 	// PrependForgeFedTicketDependency prepends a TicketDependency value to
-	// the front of a list of the property "audience". Invalidates all
-	// iterators.
+	// the front of a list of the property "audience".
 	PrependForgeFedTicketDependency(v ForgeFedTicketDependency)
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "audience".
 	PrependIRI(v *url.URL)
+	// PrependLitepubEmojiReact prepends a EmojiReact value to the front of a
+	// list of the property "audience".
+	PrependLitepubEmojiReact(v LitepubEmojiReact)
+	// PrependSchemaPropertyValue prepends a PropertyValue value to the front
+	// of a list of the property "audience".
+	PrependSchemaPropertyValue(v SchemaPropertyValue)
 	// PrependTootEmoji prepends a Emoji value to the front of a list of the
-	// property "audience". Invalidates all iterators.
+	// property "audience".
 	PrependTootEmoji(v TootEmoji)
 	// PrependTootIdentityProof prepends a IdentityProof value to the front of
-	// a list of the property "audience". Invalidates all iterators.
+	// a list of the property "audience".
 	PrependTootIdentityProof(v TootIdentityProof)
 	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "audience". Invalidates all iterators. Returns an
-	// error if the type is not a valid one to set for this property.
+	// the property "audience". Returns an error if the type is not a
+	// valid one to set for this property.
 	PrependType(t Type) error
 	// Remove deletes an element at the specified index from a list of the
 	// property "audience", regardless of its type. Panics if the index is
-	// out of bounds. Invalidates all iterators.
+	// out of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -1539,261 +1541,251 @@ type ActivityStreamsAudienceProperty interface {
 	Serialize() (interface{}, error)
 	// SetActivityStreamsAccept sets a Accept value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsAccept(idx int, v ActivityStreamsAccept)
 	// SetActivityStreamsActivity sets a Activity value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsActivity(idx int, v ActivityStreamsActivity)
 	// SetActivityStreamsAdd sets a Add value to be at the specified index for
 	// the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsAdd(idx int, v ActivityStreamsAdd)
 	// SetActivityStreamsAnnounce sets a Announce value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsAnnounce(idx int, v ActivityStreamsAnnounce)
 	// SetActivityStreamsApplication sets a Application value to be at the
 	// specified index for the property "audience". Panics if the index is
-	// out of bounds. Invalidates all iterators.
+	// out of bounds.
 	SetActivityStreamsApplication(idx int, v ActivityStreamsApplication)
 	// SetActivityStreamsArrive sets a Arrive value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsArrive(idx int, v ActivityStreamsArrive)
 	// SetActivityStreamsArticle sets a Article value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsArticle(idx int, v ActivityStreamsArticle)
 	// SetActivityStreamsAudio sets a Audio value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsAudio(idx int, v ActivityStreamsAudio)
 	// SetActivityStreamsBlock sets a Block value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsBlock(idx int, v ActivityStreamsBlock)
 	// SetActivityStreamsCollection sets a Collection value to be at the
 	// specified index for the property "audience". Panics if the index is
-	// out of bounds. Invalidates all iterators.
+	// out of bounds.
 	SetActivityStreamsCollection(idx int, v ActivityStreamsCollection)
 	// SetActivityStreamsCollectionPage sets a CollectionPage value to be at
 	// the specified index for the property "audience". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsCollectionPage(idx int, v ActivityStreamsCollectionPage)
 	// SetActivityStreamsCreate sets a Create value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsCreate(idx int, v ActivityStreamsCreate)
 	// SetActivityStreamsDelete sets a Delete value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsDelete(idx int, v ActivityStreamsDelete)
 	// SetActivityStreamsDislike sets a Dislike value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsDislike(idx int, v ActivityStreamsDislike)
 	// SetActivityStreamsDocument sets a Document value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsDocument(idx int, v ActivityStreamsDocument)
 	// SetActivityStreamsEvent sets a Event value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsEvent(idx int, v ActivityStreamsEvent)
 	// SetActivityStreamsFlag sets a Flag value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsFlag(idx int, v ActivityStreamsFlag)
 	// SetActivityStreamsFollow sets a Follow value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsFollow(idx int, v ActivityStreamsFollow)
 	// SetActivityStreamsGroup sets a Group value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsGroup(idx int, v ActivityStreamsGroup)
+	// SetActivityStreamsHashtag sets a Hashtag value to be at the specified
+	// index for the property "audience". Panics if the index is out of
+	// bounds.
+	SetActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// SetActivityStreamsIgnore sets a Ignore value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsIgnore(idx int, v ActivityStreamsIgnore)
 	// SetActivityStreamsImage sets a Image value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsImage(idx int, v ActivityStreamsImage)
 	// SetActivityStreamsIntransitiveActivity sets a IntransitiveActivity
 	// value to be at the specified index for the property "audience".
-	// Panics if the index is out of bounds. Invalidates all iterators.
+	// Panics if the index is out of bounds.
 	SetActivityStreamsIntransitiveActivity(idx int, v ActivityStreamsIntransitiveActivity)
 	// SetActivityStreamsInvite sets a Invite value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsInvite(idx int, v ActivityStreamsInvite)
 	// SetActivityStreamsJoin sets a Join value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsJoin(idx int, v ActivityStreamsJoin)
 	// SetActivityStreamsLeave sets a Leave value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsLeave(idx int, v ActivityStreamsLeave)
 	// SetActivityStreamsLike sets a Like value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsLike(idx int, v ActivityStreamsLike)
 	// SetActivityStreamsLink sets a Link value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsLink(idx int, v ActivityStreamsLink)
 	// SetActivityStreamsListen sets a Listen value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsListen(idx int, v ActivityStreamsListen)
 	// SetActivityStreamsMention sets a Mention value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsMention(idx int, v ActivityStreamsMention)
 	// SetActivityStreamsMove sets a Move value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsMove(idx int, v ActivityStreamsMove)
 	// SetActivityStreamsNote sets a Note value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsNote(idx int, v ActivityStreamsNote)
 	// SetActivityStreamsObject sets a Object value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsObject(idx int, v ActivityStreamsObject)
 	// SetActivityStreamsOffer sets a Offer value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsOffer(idx int, v ActivityStreamsOffer)
 	// SetActivityStreamsOrderedCollection sets a OrderedCollection value to
 	// be at the specified index for the property "audience". Panics if
-	// the index is out of bounds. Invalidates all iterators.
+	// the index is out of bounds.
 	SetActivityStreamsOrderedCollection(idx int, v ActivityStreamsOrderedCollection)
 	// SetActivityStreamsOrderedCollectionPage sets a OrderedCollectionPage
 	// value to be at the specified index for the property "audience".
-	// Panics if the index is out of bounds. Invalidates all iterators.
+	// Panics if the index is out of bounds.
 	SetActivityStreamsOrderedCollectionPage(idx int, v ActivityStreamsOrderedCollectionPage)
 	// SetActivityStreamsOrganization sets a Organization value to be at the
 	// specified index for the property "audience". Panics if the index is
-	// out of bounds. Invalidates all iterators.
+	// out of bounds.
 	SetActivityStreamsOrganization(idx int, v ActivityStreamsOrganization)
 	// SetActivityStreamsPage sets a Page value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsPage(idx int, v ActivityStreamsPage)
 	// SetActivityStreamsPerson sets a Person value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsPerson(idx int, v ActivityStreamsPerson)
 	// SetActivityStreamsPlace sets a Place value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsPlace(idx int, v ActivityStreamsPlace)
 	// SetActivityStreamsProfile sets a Profile value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsProfile(idx int, v ActivityStreamsProfile)
 	// SetActivityStreamsQuestion sets a Question value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsQuestion(idx int, v ActivityStreamsQuestion)
 	// SetActivityStreamsRead sets a Read value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsRead(idx int, v ActivityStreamsRead)
 	// SetActivityStreamsReject sets a Reject value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsReject(idx int, v ActivityStreamsReject)
 	// SetActivityStreamsRelationship sets a Relationship value to be at the
 	// specified index for the property "audience". Panics if the index is
-	// out of bounds. Invalidates all iterators.
+	// out of bounds.
 	SetActivityStreamsRelationship(idx int, v ActivityStreamsRelationship)
 	// SetActivityStreamsRemove sets a Remove value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsRemove(idx int, v ActivityStreamsRemove)
 	// SetActivityStreamsService sets a Service value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsService(idx int, v ActivityStreamsService)
 	// SetActivityStreamsTentativeAccept sets a TentativeAccept value to be at
 	// the specified index for the property "audience". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsTentativeAccept(idx int, v ActivityStreamsTentativeAccept)
 	// SetActivityStreamsTentativeReject sets a TentativeReject value to be at
 	// the specified index for the property "audience". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsTentativeReject(idx int, v ActivityStreamsTentativeReject)
 	// SetActivityStreamsTombstone sets a Tombstone value to be at the
 	// specified index for the property "audience". Panics if the index is
-	// out of bounds. Invalidates all iterators.
+	// out of bounds.
 	SetActivityStreamsTombstone(idx int, v ActivityStreamsTombstone)
 	// SetActivityStreamsTravel sets a Travel value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsTravel(idx int, v ActivityStreamsTravel)
 	// SetActivityStreamsUndo sets a Undo value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsUndo(idx int, v ActivityStreamsUndo)
 	// SetActivityStreamsUpdate sets a Update value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetActivityStreamsUpdate(idx int, v ActivityStreamsUpdate)
 	// SetActivityStreamsVideo sets a Video value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsVideo(idx int, v ActivityStreamsVideo)
 	// SetActivityStreamsView sets a View value to be at the specified index
 	// for the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsView(idx int, v ActivityStreamsView)
 	// SetForgeFedBranch sets a Branch value to be at the specified index for
 	// the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetForgeFedBranch(idx int, v ForgeFedBranch)
 	// SetForgeFedCommit sets a Commit value to be at the specified index for
 	// the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetForgeFedCommit(idx int, v ForgeFedCommit)
 	// SetForgeFedPush sets a Push value to be at the specified index for the
 	// property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetForgeFedPush(idx int, v ForgeFedPush)
 	// SetForgeFedRepository sets a Repository value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetForgeFedRepository(idx int, v ForgeFedRepository)
 	// SetForgeFedTicket sets a Ticket value to be at the specified index for
 	// the property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetForgeFedTicket(idx int, v ForgeFedTicket)
 	// SetForgeFedTicketDependency sets a TicketDependency value to be at the
 	// specified index for the property "audience". Panics if the index is
-	// out of bounds. Invalidates all iterators.
+	// out of bounds.
 	SetForgeFedTicketDependency(idx int, v ForgeFedTicketDependency)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "audience". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
+	// SetLitepubEmojiReact sets a EmojiReact value to be at the specified
+	// index for the property "audience". Panics if the index is out of
+	// bounds.
+	SetLitepubEmojiReact(idx int, v LitepubEmojiReact)
+	// SetSchemaPropertyValue sets a PropertyValue value to be at the
+	// specified index for the property "audience". Panics if the index is
+	// out of bounds.
+	SetSchemaPropertyValue(idx int, v SchemaPropertyValue)
 	// SetTootEmoji sets a Emoji value to be at the specified index for the
 	// property "audience". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetTootEmoji(idx int, v TootEmoji)
 	// SetTootIdentityProof sets a IdentityProof value to be at the specified
 	// index for the property "audience". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// bounds.
 	SetTootIdentityProof(idx int, v TootIdentityProof)
 	// SetType sets an arbitrary type value to the specified index of the
-	// property "audience". Invalidates all iterators. Returns an error if
-	// the type is not a valid one to set for this property. Panics if the
-	// index is out of bounds.
+	// property "audience". Returns an error if the type is not a valid
+	// one to set for this property. Panics if the index is out of bounds.
 	SetType(idx int, t Type) error
 	// Swap swaps the location of values at two indices for the "audience"
 	// property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []ActivityStreamsAudiencePropertyIterator
 }
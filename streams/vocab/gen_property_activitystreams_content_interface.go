@@ -7,6 +7,13 @@ import "net/url"
 // ActivityStreamsContentPropertyIterator represents a single value for the
 // "content" property.
 type ActivityStreamsContentPropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsContentPropertyIterator) int
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
@@ -63,10 +70,14 @@ type ActivityStreamsContentPropertyIterator interface {
 	LessThan(o ActivityStreamsContentPropertyIterator) bool
 	// Name returns the name of this property: "ActivityStreamsContent".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() ActivityStreamsContentPropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() ActivityStreamsContentPropertyIterator
 	// SetIRI sets the value of this property. Calling IsIRI afterwards
 	// returns true.
@@ -118,12 +129,10 @@ type ActivityStreamsContentProperty interface {
 	// "content"
 	AppendIRI(v *url.URL)
 	// AppendRDFLangString appends a langString value to the back of a list of
-	// the property "content". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "content".
 	AppendRDFLangString(v map[string]string)
 	// AppendXMLSchemaString appends a string value to the back of a list of
-	// the property "content". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "content".
 	AppendXMLSchemaString(v string)
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
@@ -132,6 +141,13 @@ type ActivityStreamsContentProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsContentPropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsContentProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
@@ -140,15 +156,15 @@ type ActivityStreamsContentProperty interface {
 	End() ActivityStreamsContentPropertyIterator
 	// Insert inserts an IRI value at the specified index for a property
 	// "content". Existing elements at that index and higher are shifted
-	// back once. Invalidates all iterators.
+	// back once.
 	InsertIRI(idx int, v *url.URL)
 	// InsertRDFLangString inserts a langString value at the specified index
 	// for a property "content". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertRDFLangString(idx int, v map[string]string)
 	// InsertXMLSchemaString inserts a string value at the specified index for
 	// a property "content". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertXMLSchemaString(idx int, v string)
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -176,14 +192,14 @@ type ActivityStreamsContentProperty interface {
 	// "content".
 	PrependIRI(v *url.URL)
 	// PrependRDFLangString prepends a langString value to the front of a list
-	// of the property "content". Invalidates all iterators.
+	// of the property "content".
 	PrependRDFLangString(v map[string]string)
 	// PrependXMLSchemaString prepends a string value to the front of a list
-	// of the property "content". Invalidates all iterators.
+	// of the property "content".
 	PrependXMLSchemaString(v string)
 	// Remove deletes an element at the specified index from a list of the
 	// property "content", regardless of its type. Panics if the index is
-	// out of bounds. Invalidates all iterators.
+	// out of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -196,13 +212,15 @@ type ActivityStreamsContentProperty interface {
 	SetIRI(idx int, v *url.URL)
 	// SetRDFLangString sets a langString value to be at the specified index
 	// for the property "content". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetRDFLangString(idx int, v map[string]string)
 	// SetXMLSchemaString sets a string value to be at the specified index for
 	// the property "content". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetXMLSchemaString(idx int, v string)
 	// Swap swaps the location of values at two indices for the "content"
 	// property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []ActivityStreamsContentPropertyIterator
 }
@@ -19,6 +19,13 @@ type ActivityStreamsHreflangProperty interface {
 	// Clear ensures no value of this property is set. Calling IsRFCBcp47
 	// afterwards will return false.
 	Clear()
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsHreflangProperty) int
 	// Get returns the value of this property. When IsRFCBcp47 returns false,
 	// Get will return any arbitrary value.
 	Get() string
@@ -7,6 +7,17 @@ import "net/url"
 // ActivityStreamsIconPropertyIterator represents a single value for the "icon"
 // property.
 type ActivityStreamsIconPropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsIconPropertyIterator) int
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsImage returns the value of this property. When
 	// IsActivityStreamsImage returns false, GetActivityStreamsImage will
 	// return an arbitrary value.
@@ -28,6 +39,10 @@ type ActivityStreamsIconPropertyIterator interface {
 	GetType() Type
 	// HasAny returns true if any of the different values is set.
 	HasAny() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsImage returns true if this property has a type of
 	// "Image". When true, use the GetActivityStreamsImage and
 	// SetActivityStreamsImage methods to access and set this property.
@@ -59,11 +74,18 @@ type ActivityStreamsIconPropertyIterator interface {
 	LessThan(o ActivityStreamsIconPropertyIterator) bool
 	// Name returns the name of this property: "ActivityStreamsIcon".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() ActivityStreamsIconPropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() ActivityStreamsIconPropertyIterator
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsImage sets the value of this property. Calling
 	// IsActivityStreamsImage afterwards returns true.
 	SetActivityStreamsImage(v ActivityStreamsImage)
@@ -122,25 +144,24 @@ type ActivityStreamsIconPropertyIterator interface {
 //     "type": "Note"
 //   }
 type ActivityStreamsIconProperty interface {
+	// AppendActivityStreamsHashtag appends a Hashtag value to the back of a
+	// list of the property "icon".
+	AppendActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// AppendActivityStreamsImage appends a Image value to the back of a list
-	// of the property "icon". Invalidates iterators that are traversing
-	// using Prev.
+	// of the property "icon".
 	AppendActivityStreamsImage(v ActivityStreamsImage)
 	// AppendActivityStreamsLink appends a Link value to the back of a list of
-	// the property "icon". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "icon".
 	AppendActivityStreamsLink(v ActivityStreamsLink)
 	// AppendActivityStreamsMention appends a Mention value to the back of a
-	// list of the property "icon". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "icon".
 	AppendActivityStreamsMention(v ActivityStreamsMention)
 	// AppendIRI appends an IRI value to the back of a list of the property
 	// "icon"
 	AppendIRI(v *url.URL)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "icon". Invalidates iterators that are traversing
-	// using Prev. Returns an error if the type is not a valid one to set
-	// for this property.
+	// AppendType appends an arbitrary type value to the back of a list of the
+	// property "icon". Returns an error if the type is not a valid one to
+	// set for this property.
 	AppendType(t Type) error
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
@@ -149,31 +170,43 @@ type ActivityStreamsIconProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsIconPropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsIconProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsIconPropertyIterator
+	// InsertActivityStreamsHashtag inserts a Hashtag value at the specified
+	// index for a property "icon". Existing elements at that index and
+	// higher are shifted back once.
+	InsertActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// InsertActivityStreamsImage inserts a Image value at the specified index
 	// for a property "icon". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertActivityStreamsImage(idx int, v ActivityStreamsImage)
 	// InsertActivityStreamsLink inserts a Link value at the specified index
 	// for a property "icon". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertActivityStreamsLink(idx int, v ActivityStreamsLink)
 	// InsertActivityStreamsMention inserts a Mention value at the specified
 	// index for a property "icon". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsMention(idx int, v ActivityStreamsMention)
 	// Insert inserts an IRI value at the specified index for a property
 	// "icon". Existing elements at that index and higher are shifted back
-	// once. Invalidates all iterators.
+	// once.
 	InsertIRI(idx int, v *url.URL)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "icon". Invalidates all iterators. Returns an error if
-	// the type is not a valid one to set for this property.
+	// InsertType inserts an arbitrary type value at the specified index for
+	// the property "icon". Existing elements at that index and higher are
+	// shifted back once. Returns an error if the type is not a valid one
+	// to set for this property.
 	InsertType(idx int, t Type) error
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -197,25 +230,28 @@ type ActivityStreamsIconProperty interface {
 	LessThan(o ActivityStreamsIconProperty) bool
 	// Name returns the name of this property ("icon") with any alias.
 	Name() string
+	// PrependActivityStreamsHashtag prepends a Hashtag value to the front of
+	// a list of the property "icon".
+	PrependActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// PrependActivityStreamsImage prepends a Image value to the front of a
-	// list of the property "icon". Invalidates all iterators.
+	// list of the property "icon".
 	PrependActivityStreamsImage(v ActivityStreamsImage)
 	// PrependActivityStreamsLink prepends a Link value to the front of a list
-	// of the property "icon". Invalidates all iterators.
+	// of the property "icon".
 	PrependActivityStreamsLink(v ActivityStreamsLink)
 	// PrependActivityStreamsMention prepends a Mention value to the front of
-	// a list of the property "icon". Invalidates all iterators.
+	// a list of the property "icon".
 	PrependActivityStreamsMention(v ActivityStreamsMention)
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "icon".
 	PrependIRI(v *url.URL)
 	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "icon". Invalidates all iterators. Returns an error if
-	// the type is not a valid one to set for this property.
+	// the property "icon". Returns an error if the type is not a valid
+	// one to set for this property.
 	PrependType(t Type) error
 	// Remove deletes an element at the specified index from a list of the
 	// property "icon", regardless of its type. Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -223,27 +259,30 @@ type ActivityStreamsIconProperty interface {
 	// instead of individual properties. It is exposed for alternatives to
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
+	// SetActivityStreamsHashtag sets a Hashtag value to be at the specified
+	// index for the property "icon". Panics if the index is out of bounds.
+	SetActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// SetActivityStreamsImage sets a Image value to be at the specified index
 	// for the property "icon". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsImage(idx int, v ActivityStreamsImage)
 	// SetActivityStreamsLink sets a Link value to be at the specified index
 	// for the property "icon". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsLink(idx int, v ActivityStreamsLink)
 	// SetActivityStreamsMention sets a Mention value to be at the specified
-	// index for the property "icon". Panics if the index is out of
-	// bounds. Invalidates all iterators.
+	// index for the property "icon". Panics if the index is out of bounds.
 	SetActivityStreamsMention(idx int, v ActivityStreamsMention)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "icon". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
 	// SetType sets an arbitrary type value to the specified index of the
-	// property "icon". Invalidates all iterators. Returns an error if the
-	// type is not a valid one to set for this property. Panics if the
-	// index is out of bounds.
+	// property "icon". Returns an error if the type is not a valid one to
+	// set for this property. Panics if the index is out of bounds.
 	SetType(idx int, t Type) error
 	// Swap swaps the location of values at two indices for the "icon"
 	// property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []ActivityStreamsIconPropertyIterator
 }
@@ -7,6 +7,13 @@ import "net/url"
 // ActivityStreamsNamePropertyIterator represents a single value for the "name"
 // property.
 type ActivityStreamsNamePropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsNamePropertyIterator) int
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
@@ -63,10 +70,14 @@ type ActivityStreamsNamePropertyIterator interface {
 	LessThan(o ActivityStreamsNamePropertyIterator) bool
 	// Name returns the name of this property: "ActivityStreamsName".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() ActivityStreamsNamePropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() ActivityStreamsNamePropertyIterator
 	// SetIRI sets the value of this property. Calling IsIRI afterwards
 	// returns true.
@@ -107,12 +118,10 @@ type ActivityStreamsNameProperty interface {
 	// "name"
 	AppendIRI(v *url.URL)
 	// AppendRDFLangString appends a langString value to the back of a list of
-	// the property "name". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "name".
 	AppendRDFLangString(v map[string]string)
 	// AppendXMLSchemaString appends a string value to the back of a list of
-	// the property "name". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "name".
 	AppendXMLSchemaString(v string)
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
@@ -121,6 +130,13 @@ type ActivityStreamsNameProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsNamePropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsNameProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
@@ -129,15 +145,15 @@ type ActivityStreamsNameProperty interface {
 	End() ActivityStreamsNamePropertyIterator
 	// Insert inserts an IRI value at the specified index for a property
 	// "name". Existing elements at that index and higher are shifted back
-	// once. Invalidates all iterators.
+	// once.
 	InsertIRI(idx int, v *url.URL)
 	// InsertRDFLangString inserts a langString value at the specified index
 	// for a property "name". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertRDFLangString(idx int, v map[string]string)
 	// InsertXMLSchemaString inserts a string value at the specified index for
 	// a property "name". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertXMLSchemaString(idx int, v string)
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -165,14 +181,14 @@ type ActivityStreamsNameProperty interface {
 	// "name".
 	PrependIRI(v *url.URL)
 	// PrependRDFLangString prepends a langString value to the front of a list
-	// of the property "name". Invalidates all iterators.
+	// of the property "name".
 	PrependRDFLangString(v map[string]string)
 	// PrependXMLSchemaString prepends a string value to the front of a list
-	// of the property "name". Invalidates all iterators.
+	// of the property "name".
 	PrependXMLSchemaString(v string)
 	// Remove deletes an element at the specified index from a list of the
 	// property "name", regardless of its type. Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -185,13 +201,15 @@ type ActivityStreamsNameProperty interface {
 	SetIRI(idx int, v *url.URL)
 	// SetRDFLangString sets a langString value to be at the specified index
 	// for the property "name". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetRDFLangString(idx int, v map[string]string)
 	// SetXMLSchemaString sets a string value to be at the specified index for
 	// the property "name". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetXMLSchemaString(idx int, v string)
 	// Swap swaps the location of values at two indices for the "name"
 	// property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []ActivityStreamsNamePropertyIterator
 }
@@ -7,6 +7,13 @@ import "net/url"
 // ActivityStreamsRelPropertyIterator represents a single value for the "rel"
 // property.
 type ActivityStreamsRelPropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsRelPropertyIterator) int
 	// Get returns the value of this property. When IsRFCRfc5988 returns
 	// false, Get will return any arbitrary value.
 	Get() string
@@ -35,10 +42,14 @@ type ActivityStreamsRelPropertyIterator interface {
 	LessThan(o ActivityStreamsRelPropertyIterator) bool
 	// Name returns the name of this property: "ActivityStreamsRel".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() ActivityStreamsRelPropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() ActivityStreamsRelPropertyIterator
 	// Set sets the value of this property. Calling IsRFCRfc5988 afterwards
 	// will return true.
@@ -71,8 +82,7 @@ type ActivityStreamsRelProperty interface {
 	// "rel"
 	AppendIRI(v *url.URL)
 	// AppendRFCRfc5988 appends a rfc5988 value to the back of a list of the
-	// property "rel". Invalidates iterators that are traversing using
-	// Prev.
+	// property "rel".
 	AppendRFCRfc5988(v string)
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
@@ -81,6 +91,13 @@ type ActivityStreamsRelProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsRelPropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsRelProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
@@ -89,11 +106,11 @@ type ActivityStreamsRelProperty interface {
 	End() ActivityStreamsRelPropertyIterator
 	// Insert inserts an IRI value at the specified index for a property
 	// "rel". Existing elements at that index and higher are shifted back
-	// once. Invalidates all iterators.
+	// once.
 	InsertIRI(idx int, v *url.URL)
 	// InsertRFCRfc5988 inserts a rfc5988 value at the specified index for a
 	// property "rel". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertRFCRfc5988(idx int, v string)
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -121,11 +138,11 @@ type ActivityStreamsRelProperty interface {
 	// "rel".
 	PrependIRI(v *url.URL)
 	// PrependRFCRfc5988 prepends a rfc5988 value to the front of a list of
-	// the property "rel". Invalidates all iterators.
+	// the property "rel".
 	PrependRFCRfc5988(v string)
 	// Remove deletes an element at the specified index from a list of the
 	// property "rel", regardless of its type. Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -134,12 +151,15 @@ type ActivityStreamsRelProperty interface {
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
 	// Set sets a rfc5988 value to be at the specified index for the property
-	// "rel". Panics if the index is out of bounds. Invalidates all
-	// iterators.
+	// "rel". Panics if the index is out of bounds.
 	Set(idx int, v string)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "rel". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
 	// Swap swaps the location of values at two indices for the "rel" property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []ActivityStreamsRelPropertyIterator
 }
@@ -7,6 +7,13 @@ import "net/url"
 // ActivityStreamsStreamsPropertyIterator represents a single value for the
 // "streams" property.
 type ActivityStreamsStreamsPropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsStreamsPropertyIterator) int
 	// GetActivityStreamsCollection returns the value of this property. When
 	// IsActivityStreamsCollection returns false,
 	// GetActivityStreamsCollection will return an arbitrary value.
@@ -75,10 +82,14 @@ type ActivityStreamsStreamsPropertyIterator interface {
 	LessThan(o ActivityStreamsStreamsPropertyIterator) bool
 	// Name returns the name of this property: "ActivityStreamsStreams".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() ActivityStreamsStreamsPropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() ActivityStreamsStreamsPropertyIterator
 	// SetActivityStreamsCollection sets the value of this property. Calling
 	// IsActivityStreamsCollection afterwards returns true.
@@ -104,28 +115,24 @@ type ActivityStreamsStreamsPropertyIterator interface {
 // A list of supplementary Collections which may be of interest
 type ActivityStreamsStreamsProperty interface {
 	// AppendActivityStreamsCollection appends a Collection value to the back
-	// of a list of the property "streams". Invalidates iterators that are
-	// traversing using Prev.
+	// of a list of the property "streams".
 	AppendActivityStreamsCollection(v ActivityStreamsCollection)
 	// AppendActivityStreamsCollectionPage appends a CollectionPage value to
-	// the back of a list of the property "streams". Invalidates iterators
-	// that are traversing using Prev.
+	// the back of a list of the property "streams".
 	AppendActivityStreamsCollectionPage(v ActivityStreamsCollectionPage)
 	// AppendActivityStreamsOrderedCollection appends a OrderedCollection
-	// value to the back of a list of the property "streams". Invalidates
-	// iterators that are traversing using Prev.
+	// value to the back of a list of the property "streams".
 	AppendActivityStreamsOrderedCollection(v ActivityStreamsOrderedCollection)
 	// AppendActivityStreamsOrderedCollectionPage appends a
 	// OrderedCollectionPage value to the back of a list of the property
-	// "streams". Invalidates iterators that are traversing using Prev.
+	// "streams".
 	AppendActivityStreamsOrderedCollectionPage(v ActivityStreamsOrderedCollectionPage)
 	// AppendIRI appends an IRI value to the back of a list of the property
 	// "streams"
 	AppendIRI(v *url.URL)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "streams". Invalidates iterators that are traversing
-	// using Prev. Returns an error if the type is not a valid one to set
-	// for this property.
+	// AppendType appends an arbitrary type value to the back of a list of the
+	// property "streams". Returns an error if the type is not a valid one
+	// to set for this property.
 	AppendType(t Type) error
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
@@ -134,6 +141,13 @@ type ActivityStreamsStreamsProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsStreamsPropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsStreamsProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
@@ -142,30 +156,29 @@ type ActivityStreamsStreamsProperty interface {
 	End() ActivityStreamsStreamsPropertyIterator
 	// InsertActivityStreamsCollection inserts a Collection value at the
 	// specified index for a property "streams". Existing elements at that
-	// index and higher are shifted back once. Invalidates all iterators.
+	// index and higher are shifted back once.
 	InsertActivityStreamsCollection(idx int, v ActivityStreamsCollection)
 	// InsertActivityStreamsCollectionPage inserts a CollectionPage value at
 	// the specified index for a property "streams". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertActivityStreamsCollectionPage(idx int, v ActivityStreamsCollectionPage)
 	// InsertActivityStreamsOrderedCollection inserts a OrderedCollection
 	// value at the specified index for a property "streams". Existing
 	// elements at that index and higher are shifted back once.
-	// Invalidates all iterators.
 	InsertActivityStreamsOrderedCollection(idx int, v ActivityStreamsOrderedCollection)
 	// InsertActivityStreamsOrderedCollectionPage inserts a
 	// OrderedCollectionPage value at the specified index for a property
 	// "streams". Existing elements at that index and higher are shifted
-	// back once. Invalidates all iterators.
+	// back once.
 	InsertActivityStreamsOrderedCollectionPage(idx int, v ActivityStreamsOrderedCollectionPage)
 	// Insert inserts an IRI value at the specified index for a property
 	// "streams". Existing elements at that index and higher are shifted
-	// back once. Invalidates all iterators.
+	// back once.
 	InsertIRI(idx int, v *url.URL)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "streams". Invalidates all iterators. Returns an error
-	// if the type is not a valid one to set for this property.
+	// InsertType inserts an arbitrary type value at the specified index for
+	// the property "streams". Existing elements at that index and higher
+	// are shifted back once. Returns an error if the type is not a valid
+	// one to set for this property.
 	InsertType(idx int, t Type) error
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -190,31 +203,28 @@ type ActivityStreamsStreamsProperty interface {
 	// Name returns the name of this property ("streams") with any alias.
 	Name() string
 	// PrependActivityStreamsCollection prepends a Collection value to the
-	// front of a list of the property "streams". Invalidates all
-	// iterators.
+	// front of a list of the property "streams".
 	PrependActivityStreamsCollection(v ActivityStreamsCollection)
 	// PrependActivityStreamsCollectionPage prepends a CollectionPage value to
-	// the front of a list of the property "streams". Invalidates all
-	// iterators.
+	// the front of a list of the property "streams".
 	PrependActivityStreamsCollectionPage(v ActivityStreamsCollectionPage)
 	// PrependActivityStreamsOrderedCollection prepends a OrderedCollection
-	// value to the front of a list of the property "streams". Invalidates
-	// all iterators.
+	// value to the front of a list of the property "streams".
 	PrependActivityStreamsOrderedCollection(v ActivityStreamsOrderedCollection)
 	// PrependActivityStreamsOrderedCollectionPage prepends a
 	// OrderedCollectionPage value to the front of a list of the property
-	// "streams". Invalidates all iterators.
+	// "streams".
 	PrependActivityStreamsOrderedCollectionPage(v ActivityStreamsOrderedCollectionPage)
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "streams".
 	PrependIRI(v *url.URL)
 	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "streams". Invalidates all iterators. Returns an error
-	// if the type is not a valid one to set for this property.
+	// the property "streams". Returns an error if the type is not a valid
+	// one to set for this property.
 	PrependType(t Type) error
 	// Remove deletes an element at the specified index from a list of the
 	// property "streams", regardless of its type. Panics if the index is
-	// out of bounds. Invalidates all iterators.
+	// out of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -224,29 +234,32 @@ type ActivityStreamsStreamsProperty interface {
 	Serialize() (interface{}, error)
 	// SetActivityStreamsCollection sets a Collection value to be at the
 	// specified index for the property "streams". Panics if the index is
-	// out of bounds. Invalidates all iterators.
+	// out of bounds.
 	SetActivityStreamsCollection(idx int, v ActivityStreamsCollection)
 	// SetActivityStreamsCollectionPage sets a CollectionPage value to be at
 	// the specified index for the property "streams". Panics if the index
-	// is out of bounds. Invalidates all iterators.
+	// is out of bounds.
 	SetActivityStreamsCollectionPage(idx int, v ActivityStreamsCollectionPage)
 	// SetActivityStreamsOrderedCollection sets a OrderedCollection value to
 	// be at the specified index for the property "streams". Panics if the
-	// index is out of bounds. Invalidates all iterators.
+	// index is out of bounds.
 	SetActivityStreamsOrderedCollection(idx int, v ActivityStreamsOrderedCollection)
 	// SetActivityStreamsOrderedCollectionPage sets a OrderedCollectionPage
 	// value to be at the specified index for the property "streams".
-	// Panics if the index is out of bounds. Invalidates all iterators.
+	// Panics if the index is out of bounds.
 	SetActivityStreamsOrderedCollectionPage(idx int, v ActivityStreamsOrderedCollectionPage)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "streams". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
 	// SetType sets an arbitrary type value to the specified index of the
-	// property "streams". Invalidates all iterators. Returns an error if
-	// the type is not a valid one to set for this property. Panics if the
-	// index is out of bounds.
+	// property "streams". Returns an error if the type is not a valid one
+	// to set for this property. Panics if the index is out of bounds.
 	SetType(idx int, t Type) error
 	// Swap swaps the location of values at two indices for the "streams"
 	// property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []ActivityStreamsStreamsPropertyIterator
 }
@@ -7,6 +7,17 @@ import "net/url"
 // ActivityStreamsUrlPropertyIterator represents a single value for the "url"
 // property.
 type ActivityStreamsUrlPropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsUrlPropertyIterator) int
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsLink returns the value of this property. When
 	// IsActivityStreamsLink returns false, GetActivityStreamsLink will
 	// return an arbitrary value.
@@ -28,6 +39,10 @@ type ActivityStreamsUrlPropertyIterator interface {
 	GetXMLSchemaAnyURI() *url.URL
 	// HasAny returns true if any of the different values is set.
 	HasAny() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsLink returns true if this property has a type of
 	// "Link". When true, use the GetActivityStreamsLink and
 	// SetActivityStreamsLink methods to access and set this property.
@@ -59,11 +74,18 @@ type ActivityStreamsUrlPropertyIterator interface {
 	LessThan(o ActivityStreamsUrlPropertyIterator) bool
 	// Name returns the name of this property: "ActivityStreamsUrl".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() ActivityStreamsUrlPropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() ActivityStreamsUrlPropertyIterator
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsLink sets the value of this property. Calling
 	// IsActivityStreamsLink afterwards returns true.
 	SetActivityStreamsLink(v ActivityStreamsLink)
@@ -118,25 +140,24 @@ type ActivityStreamsUrlPropertyIterator interface {
 //     ]
 //   }
 type ActivityStreamsUrlProperty interface {
+	// AppendActivityStreamsHashtag appends a Hashtag value to the back of a
+	// list of the property "url".
+	AppendActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// AppendActivityStreamsLink appends a Link value to the back of a list of
-	// the property "url". Invalidates iterators that are traversing using
-	// Prev.
+	// the property "url".
 	AppendActivityStreamsLink(v ActivityStreamsLink)
 	// AppendActivityStreamsMention appends a Mention value to the back of a
-	// list of the property "url". Invalidates iterators that are
-	// traversing using Prev.
+	// list of the property "url".
 	AppendActivityStreamsMention(v ActivityStreamsMention)
 	// AppendIRI appends an IRI value to the back of a list of the property
 	// "url"
 	AppendIRI(v *url.URL)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "url". Invalidates iterators that are traversing using
-	// Prev. Returns an error if the type is not a valid one to set for
-	// this property.
+	// AppendType appends an arbitrary type value to the back of a list of the
+	// property "url". Returns an error if the type is not a valid one to
+	// set for this property.
 	AppendType(t Type) error
 	// AppendXMLSchemaAnyURI appends a anyURI value to the back of a list of
-	// the property "url". Invalidates iterators that are traversing using
-	// Prev.
+	// the property "url".
 	AppendXMLSchemaAnyURI(v *url.URL)
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
@@ -145,31 +166,43 @@ type ActivityStreamsUrlProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsUrlPropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ActivityStreamsUrlProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsUrlPropertyIterator
+	// InsertActivityStreamsHashtag inserts a Hashtag value at the specified
+	// index for a property "url". Existing elements at that index and
+	// higher are shifted back once.
+	InsertActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// InsertActivityStreamsLink inserts a Link value at the specified index
 	// for a property "url". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertActivityStreamsLink(idx int, v ActivityStreamsLink)
 	// InsertActivityStreamsMention inserts a Mention value at the specified
 	// index for a property "url". Existing elements at that index and
-	// higher are shifted back once. Invalidates all iterators.
+	// higher are shifted back once.
 	InsertActivityStreamsMention(idx int, v ActivityStreamsMention)
 	// Insert inserts an IRI value at the specified index for a property
 	// "url". Existing elements at that index and higher are shifted back
-	// once. Invalidates all iterators.
+	// once.
 	InsertIRI(idx int, v *url.URL)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "url". Invalidates all iterators. Returns an error if
-	// the type is not a valid one to set for this property.
+	// InsertType inserts an arbitrary type value at the specified index for
+	// the property "url". Existing elements at that index and higher are
+	// shifted back once. Returns an error if the type is not a valid one
+	// to set for this property.
 	InsertType(idx int, t Type) error
 	// InsertXMLSchemaAnyURI inserts a anyURI value at the specified index for
 	// a property "url". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertXMLSchemaAnyURI(idx int, v *url.URL)
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -193,25 +226,28 @@ type ActivityStreamsUrlProperty interface {
 	LessThan(o ActivityStreamsUrlProperty) bool
 	// Name returns the name of this property ("url") with any alias.
 	Name() string
+	// PrependActivityStreamsHashtag prepends a Hashtag value to the front of
+	// a list of the property "url".
+	PrependActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// PrependActivityStreamsLink prepends a Link value to the front of a list
-	// of the property "url". Invalidates all iterators.
+	// of the property "url".
 	PrependActivityStreamsLink(v ActivityStreamsLink)
 	// PrependActivityStreamsMention prepends a Mention value to the front of
-	// a list of the property "url". Invalidates all iterators.
+	// a list of the property "url".
 	PrependActivityStreamsMention(v ActivityStreamsMention)
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "url".
 	PrependIRI(v *url.URL)
 	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "url". Invalidates all iterators. Returns an error if
-	// the type is not a valid one to set for this property.
+	// the property "url". Returns an error if the type is not a valid one
+	// to set for this property.
 	PrependType(t Type) error
 	// PrependXMLSchemaAnyURI prepends a anyURI value to the front of a list
-	// of the property "url". Invalidates all iterators.
+	// of the property "url".
 	PrependXMLSchemaAnyURI(v *url.URL)
 	// Remove deletes an element at the specified index from a list of the
 	// property "url", regardless of its type. Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -219,26 +255,29 @@ type ActivityStreamsUrlProperty interface {
 	// instead of individual properties. It is exposed for alternatives to
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
+	// SetActivityStreamsHashtag sets a Hashtag value to be at the specified
+	// index for the property "url". Panics if the index is out of bounds.
+	SetActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// SetActivityStreamsLink sets a Link value to be at the specified index
 	// for the property "url". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsLink(idx int, v ActivityStreamsLink)
 	// SetActivityStreamsMention sets a Mention value to be at the specified
 	// index for the property "url". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetActivityStreamsMention(idx int, v ActivityStreamsMention)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "url". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
 	// SetType sets an arbitrary type value to the specified index of the
-	// property "url". Invalidates all iterators. Returns an error if the
-	// type is not a valid one to set for this property. Panics if the
-	// index is out of bounds.
+	// property "url". Returns an error if the type is not a valid one to
+	// set for this property. Panics if the index is out of bounds.
 	SetType(idx int, t Type) error
 	// SetXMLSchemaAnyURI sets a anyURI value to be at the specified index for
 	// the property "url". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetXMLSchemaAnyURI(idx int, v *url.URL)
 	// Swap swaps the location of values at two indices for the "url" property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []ActivityStreamsUrlPropertyIterator
 }
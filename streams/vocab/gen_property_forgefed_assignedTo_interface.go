@@ -9,6 +9,13 @@ type ForgeFedAssignedToProperty interface {
 	// Clear ensures no value of this property is set. Calling
 	// IsActivityStreamsPerson afterwards will return false.
 	Clear()
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ForgeFedAssignedToProperty) int
 	// Get returns the value of this property. When IsActivityStreamsPerson
 	// returns false, Get will return any arbitrary value.
 	Get() ActivityStreamsPerson
@@ -7,6 +7,13 @@ import "net/url"
 // ForgeFedDependedByPropertyIterator represents a single value for the
 // "dependedBy" property.
 type ForgeFedDependedByPropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ForgeFedDependedByPropertyIterator) int
 	// Get returns the value of this property. When IsForgeFedTicket returns
 	// false, Get will return any arbitrary value.
 	Get() ForgeFedTicket
@@ -39,10 +46,14 @@ type ForgeFedDependedByPropertyIterator interface {
 	LessThan(o ForgeFedDependedByPropertyIterator) bool
 	// Name returns the name of this property: "ForgeFedDependedBy".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() ForgeFedDependedByPropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() ForgeFedDependedByPropertyIterator
 	// Set sets the value of this property. Calling IsForgeFedTicket
 	// afterwards will return true.
@@ -59,15 +70,13 @@ type ForgeFedDependedByPropertyIterator interface {
 // be resolved without this tickets being resolved too.
 type ForgeFedDependedByProperty interface {
 	// AppendForgeFedTicket appends a Ticket value to the back of a list of
-	// the property "dependedBy". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "dependedBy".
 	AppendForgeFedTicket(v ForgeFedTicket)
 	// AppendIRI appends an IRI value to the back of a list of the property
 	// "dependedBy"
 	AppendIRI(v *url.URL)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "dependedBy". Invalidates iterators that are
-	// traversing using Prev. Returns an error if the type is not a valid
+	// AppendType appends an arbitrary type value to the back of a list of the
+	// property "dependedBy". Returns an error if the type is not a valid
 	// one to set for this property.
 	AppendType(t Type) error
 	// At returns the property value for the specified index. Panics if the
@@ -77,6 +86,13 @@ type ForgeFedDependedByProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ForgeFedDependedByPropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ForgeFedDependedByProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
@@ -85,15 +101,16 @@ type ForgeFedDependedByProperty interface {
 	End() ForgeFedDependedByPropertyIterator
 	// InsertForgeFedTicket inserts a Ticket value at the specified index for
 	// a property "dependedBy". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertForgeFedTicket(idx int, v ForgeFedTicket)
 	// Insert inserts an IRI value at the specified index for a property
 	// "dependedBy". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertIRI(idx int, v *url.URL)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "dependedBy". Invalidates all iterators. Returns an
-	// error if the type is not a valid one to set for this property.
+	// InsertType inserts an arbitrary type value at the specified index for
+	// the property "dependedBy". Existing elements at that index and
+	// higher are shifted back once. Returns an error if the type is not a
+	// valid one to set for this property.
 	InsertType(idx int, t Type) error
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -119,18 +136,18 @@ type ForgeFedDependedByProperty interface {
 	// Name returns the name of this property ("dependedBy") with any alias.
 	Name() string
 	// PrependForgeFedTicket prepends a Ticket value to the front of a list of
-	// the property "dependedBy". Invalidates all iterators.
+	// the property "dependedBy".
 	PrependForgeFedTicket(v ForgeFedTicket)
 	// PrependIRI prepends an IRI value to the front of a list of the property
 	// "dependedBy".
 	PrependIRI(v *url.URL)
 	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "dependedBy". Invalidates all iterators. Returns an
-	// error if the type is not a valid one to set for this property.
+	// the property "dependedBy". Returns an error if the type is not a
+	// valid one to set for this property.
 	PrependType(t Type) error
 	// Remove deletes an element at the specified index from a list of the
 	// property "dependedBy", regardless of its type. Panics if the index
-	// is out of bounds. Invalidates all iterators.
+	// is out of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -139,18 +156,20 @@ type ForgeFedDependedByProperty interface {
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
 	// Set sets a Ticket value to be at the specified index for the property
-	// "dependedBy". Panics if the index is out of bounds. Invalidates all
-	// iterators.
+	// "dependedBy". Panics if the index is out of bounds.
 	Set(idx int, v ForgeFedTicket)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "dependedBy". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
 	// SetType sets an arbitrary type value to the specified index of the
-	// property "dependedBy". Invalidates all iterators. Returns an error
-	// if the type is not a valid one to set for this property. Panics if
-	// the index is out of bounds.
+	// property "dependedBy". Returns an error if the type is not a valid
+	// one to set for this property. Panics if the index is out of bounds.
 	SetType(idx int, t Type) error
 	// Swap swaps the location of values at two indices for the "dependedBy"
 	// property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []ForgeFedDependedByPropertyIterator
 }
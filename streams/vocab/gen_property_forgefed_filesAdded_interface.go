@@ -7,6 +7,13 @@ import "net/url"
 // ForgeFedFilesAddedPropertyIterator represents a single value for the
 // "filesAdded" property.
 type ForgeFedFilesAddedPropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ForgeFedFilesAddedPropertyIterator) int
 	// Get returns the value of this property. When IsXMLSchemaString returns
 	// false, Get will return any arbitrary value.
 	Get() string
@@ -35,10 +42,14 @@ type ForgeFedFilesAddedPropertyIterator interface {
 	LessThan(o ForgeFedFilesAddedPropertyIterator) bool
 	// Name returns the name of this property: "ForgeFedFilesAdded".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() ForgeFedFilesAddedPropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() ForgeFedFilesAddedPropertyIterator
 	// Set sets the value of this property. Calling IsXMLSchemaString
 	// afterwards will return true.
@@ -56,8 +67,7 @@ type ForgeFedFilesAddedProperty interface {
 	// "filesAdded"
 	AppendIRI(v *url.URL)
 	// AppendXMLSchemaString appends a string value to the back of a list of
-	// the property "filesAdded". Invalidates iterators that are
-	// traversing using Prev.
+	// the property "filesAdded".
 	AppendXMLSchemaString(v string)
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
@@ -66,6 +76,13 @@ type ForgeFedFilesAddedProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ForgeFedFilesAddedPropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ForgeFedFilesAddedProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
@@ -74,11 +91,11 @@ type ForgeFedFilesAddedProperty interface {
 	End() ForgeFedFilesAddedPropertyIterator
 	// Insert inserts an IRI value at the specified index for a property
 	// "filesAdded". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertIRI(idx int, v *url.URL)
 	// InsertXMLSchemaString inserts a string value at the specified index for
 	// a property "filesAdded". Existing elements at that index and higher
-	// are shifted back once. Invalidates all iterators.
+	// are shifted back once.
 	InsertXMLSchemaString(idx int, v string)
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -107,11 +124,11 @@ type ForgeFedFilesAddedProperty interface {
 	// "filesAdded".
 	PrependIRI(v *url.URL)
 	// PrependXMLSchemaString prepends a string value to the front of a list
-	// of the property "filesAdded". Invalidates all iterators.
+	// of the property "filesAdded".
 	PrependXMLSchemaString(v string)
 	// Remove deletes an element at the specified index from a list of the
 	// property "filesAdded", regardless of its type. Panics if the index
-	// is out of bounds. Invalidates all iterators.
+	// is out of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -120,8 +137,7 @@ type ForgeFedFilesAddedProperty interface {
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
 	// Set sets a string value to be at the specified index for the property
-	// "filesAdded". Panics if the index is out of bounds. Invalidates all
-	// iterators.
+	// "filesAdded". Panics if the index is out of bounds.
 	Set(idx int, v string)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "filesAdded". Panics if the index is out of bounds.
@@ -129,4 +145,8 @@ type ForgeFedFilesAddedProperty interface {
 	// Swap swaps the location of values at two indices for the "filesAdded"
 	// property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []ForgeFedFilesAddedPropertyIterator
 }
@@ -10,6 +10,13 @@ type ForgeFedIsResolvedProperty interface {
 	// Clear ensures no value of this property is set. Calling
 	// IsXMLSchemaBoolean afterwards will return false.
 	Clear()
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o ForgeFedIsResolvedProperty) int
 	// Get returns the value of this property. When IsXMLSchemaBoolean returns
 	// false, Get will return any arbitrary value.
 	Get() bool
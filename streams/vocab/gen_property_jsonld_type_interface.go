@@ -6,6 +6,13 @@ import "net/url"
 
 // JSONLDTypePropertyIterator represents a single value for the "type" property.
 type JSONLDTypePropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o JSONLDTypePropertyIterator) int
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
@@ -46,10 +53,14 @@ type JSONLDTypePropertyIterator interface {
 	LessThan(o JSONLDTypePropertyIterator) bool
 	// Name returns the name of this property: "JSONLDType".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() JSONLDTypePropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() JSONLDTypePropertyIterator
 	// SetIRI sets the value of this property. Calling IsIRI afterwards
 	// returns true.
@@ -68,12 +79,10 @@ type JSONLDTypeProperty interface {
 	// "type"
 	AppendIRI(v *url.URL)
 	// AppendXMLSchemaAnyURI appends a anyURI value to the back of a list of
-	// the property "type". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "type".
 	AppendXMLSchemaAnyURI(v *url.URL)
 	// AppendXMLSchemaString appends a string value to the back of a list of
-	// the property "type". Invalidates iterators that are traversing
-	// using Prev.
+	// the property "type".
 	AppendXMLSchemaString(v string)
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
@@ -82,6 +91,13 @@ type JSONLDTypeProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() JSONLDTypePropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o JSONLDTypeProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
@@ -90,15 +106,15 @@ type JSONLDTypeProperty interface {
 	End() JSONLDTypePropertyIterator
 	// Insert inserts an IRI value at the specified index for a property
 	// "type". Existing elements at that index and higher are shifted back
-	// once. Invalidates all iterators.
+	// once.
 	InsertIRI(idx int, v *url.URL)
 	// InsertXMLSchemaAnyURI inserts a anyURI value at the specified index for
 	// a property "type". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertXMLSchemaAnyURI(idx int, v *url.URL)
 	// InsertXMLSchemaString inserts a string value at the specified index for
 	// a property "type". Existing elements at that index and higher are
-	// shifted back once. Invalidates all iterators.
+	// shifted back once.
 	InsertXMLSchemaString(idx int, v string)
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -126,14 +142,14 @@ type JSONLDTypeProperty interface {
 	// "type".
 	PrependIRI(v *url.URL)
 	// PrependXMLSchemaAnyURI prepends a anyURI value to the front of a list
-	// of the property "type". Invalidates all iterators.
+	// of the property "type".
 	PrependXMLSchemaAnyURI(v *url.URL)
 	// PrependXMLSchemaString prepends a string value to the front of a list
-	// of the property "type". Invalidates all iterators.
+	// of the property "type".
 	PrependXMLSchemaString(v string)
 	// Remove deletes an element at the specified index from a list of the
 	// property "type", regardless of its type. Panics if the index is out
-	// of bounds. Invalidates all iterators.
+	// of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -146,13 +162,15 @@ type JSONLDTypeProperty interface {
 	SetIRI(idx int, v *url.URL)
 	// SetXMLSchemaAnyURI sets a anyURI value to be at the specified index for
 	// the property "type". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetXMLSchemaAnyURI(idx int, v *url.URL)
 	// SetXMLSchemaString sets a string value to be at the specified index for
 	// the property "type". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	SetXMLSchemaString(idx int, v string)
 	// Swap swaps the location of values at two indices for the "type"
 	// property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []JSONLDTypePropertyIterator
 }
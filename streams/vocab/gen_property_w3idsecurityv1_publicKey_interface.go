@@ -7,6 +7,13 @@ import "net/url"
 // W3IDSecurityV1PublicKeyPropertyIterator represents a single value for the
 // "publicKey" property.
 type W3IDSecurityV1PublicKeyPropertyIterator interface {
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o W3IDSecurityV1PublicKeyPropertyIterator) int
 	// Get returns the value of this property. When IsW3IDSecurityV1PublicKey
 	// returns false, Get will return any arbitrary value.
 	Get() W3IDSecurityV1PublicKey
@@ -40,10 +47,14 @@ type W3IDSecurityV1PublicKeyPropertyIterator interface {
 	LessThan(o W3IDSecurityV1PublicKeyPropertyIterator) bool
 	// Name returns the name of this property: "W3IDSecurityV1PublicKey".
 	Name() string
-	// Next returns the next iterator, or nil if there is no next iterator.
+	// Next returns the next iterator, or nil if there is no next iterator. It
+	// remains correct when values are added to or removed from the
+	// property, including this one, without obtaining the iterator again.
 	Next() W3IDSecurityV1PublicKeyPropertyIterator
 	// Prev returns the previous iterator, or nil if there is no previous
-	// iterator.
+	// iterator. It remains correct when values are added to or removed
+	// from the property, including this one, without obtaining the
+	// iterator again.
 	Prev() W3IDSecurityV1PublicKeyPropertyIterator
 	// Set sets the value of this property. Calling IsW3IDSecurityV1PublicKey
 	// afterwards will return true.
@@ -63,14 +74,12 @@ type W3IDSecurityV1PublicKeyProperty interface {
 	// AppendIRI appends an IRI value to the back of a list of the property
 	// "publicKey"
 	AppendIRI(v *url.URL)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "publicKey". Invalidates iterators that are traversing
-	// using Prev. Returns an error if the type is not a valid one to set
-	// for this property.
+	// AppendType appends an arbitrary type value to the back of a list of the
+	// property "publicKey". Returns an error if the type is not a valid
+	// one to set for this property.
 	AppendType(t Type) error
 	// AppendW3IDSecurityV1PublicKey appends a PublicKey value to the back of
-	// a list of the property "publicKey". Invalidates iterators that are
-	// traversing using Prev.
+	// a list of the property "publicKey".
 	AppendW3IDSecurityV1PublicKey(v W3IDSecurityV1PublicKey)
 	// At returns the property value for the specified index. Panics if the
 	// index is out of bounds.
@@ -79,6 +88,13 @@ type W3IDSecurityV1PublicKeyProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() W3IDSecurityV1PublicKeyPropertyIterator
+	// Compare compares two instances of this property with an arbitrary but
+	// stable comparison, returning a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is.
+	// Applications should not use this because it is only meant to help
+	// alternative implementations to go-fed to be able to normalize
+	// nonfunctional properties.
+	Compare(o W3IDSecurityV1PublicKeyProperty) int
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
@@ -87,16 +103,16 @@ type W3IDSecurityV1PublicKeyProperty interface {
 	End() W3IDSecurityV1PublicKeyPropertyIterator
 	// Insert inserts an IRI value at the specified index for a property
 	// "publicKey". Existing elements at that index and higher are shifted
-	// back once. Invalidates all iterators.
+	// back once.
 	InsertIRI(idx int, v *url.URL)
-	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "publicKey". Invalidates all iterators. Returns an
-	// error if the type is not a valid one to set for this property.
+	// InsertType inserts an arbitrary type value at the specified index for
+	// the property "publicKey". Existing elements at that index and
+	// higher are shifted back once. Returns an error if the type is not a
+	// valid one to set for this property.
 	InsertType(idx int, t Type) error
 	// InsertW3IDSecurityV1PublicKey inserts a PublicKey value at the
 	// specified index for a property "publicKey". Existing elements at
-	// that index and higher are shifted back once. Invalidates all
-	// iterators.
+	// that index and higher are shifted back once.
 	InsertW3IDSecurityV1PublicKey(idx int, v W3IDSecurityV1PublicKey)
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
@@ -125,15 +141,15 @@ type W3IDSecurityV1PublicKeyProperty interface {
 	// "publicKey".
 	PrependIRI(v *url.URL)
 	// PrependType prepends an arbitrary type value to the front of a list of
-	// the property "publicKey". Invalidates all iterators. Returns an
-	// error if the type is not a valid one to set for this property.
+	// the property "publicKey". Returns an error if the type is not a
+	// valid one to set for this property.
 	PrependType(t Type) error
 	// PrependW3IDSecurityV1PublicKey prepends a PublicKey value to the front
-	// of a list of the property "publicKey". Invalidates all iterators.
+	// of a list of the property "publicKey".
 	PrependW3IDSecurityV1PublicKey(v W3IDSecurityV1PublicKey)
 	// Remove deletes an element at the specified index from a list of the
 	// property "publicKey", regardless of its type. Panics if the index
-	// is out of bounds. Invalidates all iterators.
+	// is out of bounds.
 	Remove(idx int)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format. Applications should not
@@ -143,17 +159,19 @@ type W3IDSecurityV1PublicKeyProperty interface {
 	Serialize() (interface{}, error)
 	// Set sets a PublicKey value to be at the specified index for the
 	// property "publicKey". Panics if the index is out of bounds.
-	// Invalidates all iterators.
 	Set(idx int, v W3IDSecurityV1PublicKey)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "publicKey". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
 	// SetType sets an arbitrary type value to the specified index of the
-	// property "publicKey". Invalidates all iterators. Returns an error
-	// if the type is not a valid one to set for this property. Panics if
-	// the index is out of bounds.
+	// property "publicKey". Returns an error if the type is not a valid
+	// one to set for this property. Panics if the index is out of bounds.
 	SetType(idx int, t Type) error
 	// Swap swaps the location of values at two indices for the "publicKey"
 	// property.
 	Swap(i, j int)
+	// Values returns the iterators of all values from front to back, so they
+	// can be ranged over. Adding values to or removing them from this
+	// property afterwards does not change the returned slice.
+	Values() []W3IDSecurityV1PublicKeyPropertyIterator
 }
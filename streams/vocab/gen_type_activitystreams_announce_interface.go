@@ -24,6 +24,12 @@ package vocab
 //     "type": "Announce"
 //   }
 type ActivityStreamsAnnounce interface {
+	// Compare compares this Announce with another, with an arbitrary but
+	// stable determination. It returns a negative number if this is
+	// lesser, a positive number if this is greater, and 0 if neither is.
+	// Each property is compared once, so comparing deeply nested values
+	// costs half as much as calling LessThan both ways.
+	Compare(o ActivityStreamsAnnounce) int
 	// GetActivityStreamsActor returns the "actor" property if it exists, and
 	// nil otherwise.
 	GetActivityStreamsActor() ActivityStreamsActorProperty
@@ -105,6 +111,9 @@ type ActivityStreamsAnnounce interface {
 	// GetActivityStreamsResult returns the "result" property if it exists,
 	// and nil otherwise.
 	GetActivityStreamsResult() ActivityStreamsResultProperty
+	// GetActivityStreamsSensitive returns the "sensitive" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsSensitive() ActivityStreamsSensitiveProperty
 	// GetActivityStreamsShares returns the "shares" property if it exists,
 	// and nil otherwise.
 	GetActivityStreamsShares() ActivityStreamsSharesProperty
@@ -223,6 +232,8 @@ type ActivityStreamsAnnounce interface {
 	SetActivityStreamsReplies(i ActivityStreamsRepliesProperty)
 	// SetActivityStreamsResult sets the "result" property.
 	SetActivityStreamsResult(i ActivityStreamsResultProperty)
+	// SetActivityStreamsSensitive sets the "sensitive" property.
+	SetActivityStreamsSensitive(i ActivityStreamsSensitiveProperty)
 	// SetActivityStreamsShares sets the "shares" property.
 	SetActivityStreamsShares(i ActivityStreamsSharesProperty)
 	// SetActivityStreamsSource sets the "source" property.
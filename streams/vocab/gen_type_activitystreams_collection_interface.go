@@ -23,6 +23,12 @@ package vocab
 //     "type": "Collection"
 //   }
 type ActivityStreamsCollection interface {
+	// Compare compares this Collection with another, with an arbitrary but
+	// stable determination. It returns a negative number if this is
+	// lesser, a positive number if this is greater, and 0 if neither is.
+	// Each property is compared once, so comparing deeply nested values
+	// costs half as much as calling LessThan both ways.
+	Compare(o ActivityStreamsCollection) int
 	// GetActivityStreamsAltitude returns the "altitude" property if it
 	// exists, and nil otherwise.
 	GetActivityStreamsAltitude() ActivityStreamsAltitudeProperty
@@ -104,6 +110,9 @@ type ActivityStreamsCollection interface {
 	// GetActivityStreamsReplies returns the "replies" property if it exists,
 	// and nil otherwise.
 	GetActivityStreamsReplies() ActivityStreamsRepliesProperty
+	// GetActivityStreamsSensitive returns the "sensitive" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsSensitive() ActivityStreamsSensitiveProperty
 	// GetActivityStreamsShares returns the "shares" property if it exists,
 	// and nil otherwise.
 	GetActivityStreamsShares() ActivityStreamsSharesProperty
@@ -222,6 +231,8 @@ type ActivityStreamsCollection interface {
 	SetActivityStreamsPublished(i ActivityStreamsPublishedProperty)
 	// SetActivityStreamsReplies sets the "replies" property.
 	SetActivityStreamsReplies(i ActivityStreamsRepliesProperty)
+	// SetActivityStreamsSensitive sets the "sensitive" property.
+	SetActivityStreamsSensitive(i ActivityStreamsSensitiveProperty)
 	// SetActivityStreamsShares sets the "shares" property.
 	SetActivityStreamsShares(i ActivityStreamsSharesProperty)
 	// SetActivityStreamsSource sets the "source" property.
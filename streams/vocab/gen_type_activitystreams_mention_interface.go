@@ -12,6 +12,12 @@ package vocab
 //     "url": "http://example.org/joe"
 //   }
 type ActivityStreamsMention interface {
+	// Compare compares this Mention with another, with an arbitrary but
+	// stable determination. It returns a negative number if this is
+	// lesser, a positive number if this is greater, and 0 if neither is.
+	// Each property is compared once, so comparing deeply nested values
+	// costs half as much as calling LessThan both ways.
+	Compare(o ActivityStreamsMention) int
 	// GetActivityStreamsAttributedTo returns the "attributedTo" property if
 	// it exists, and nil otherwise.
 	GetActivityStreamsAttributedTo() ActivityStreamsAttributedToProperty
@@ -47,6 +53,9 @@ type ActivityStreamsMention interface {
 	// GetJSONLDType returns the "type" property if it exists, and nil
 	// otherwise.
 	GetJSONLDType() JSONLDTypeProperty
+	// GetPeerTubeFps returns the "fps" property if it exists, and nil
+	// otherwise.
+	GetPeerTubeFps() PeerTubeFpsProperty
 	// GetTypeName returns the name of this type.
 	GetTypeName() string
 	// GetUnknownProperties returns the unknown properties for the Mention
@@ -94,6 +103,8 @@ type ActivityStreamsMention interface {
 	SetJSONLDId(i JSONLDIdProperty)
 	// SetJSONLDType sets the "type" property.
 	SetJSONLDType(i JSONLDTypeProperty)
+	// SetPeerTubeFps sets the "fps" property.
+	SetPeerTubeFps(i PeerTubeFpsProperty)
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
 }
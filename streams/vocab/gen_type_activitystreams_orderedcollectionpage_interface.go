@@ -24,6 +24,12 @@ package vocab
 //     "type": "OrderedCollectionPage"
 //   }
 type ActivityStreamsOrderedCollectionPage interface {
+	// Compare compares this OrderedCollectionPage with another, with an
+	// arbitrary but stable determination. It returns a negative number if
+	// this is lesser, a positive number if this is greater, and 0 if
+	// neither is. Each property is compared once, so comparing deeply
+	// nested values costs half as much as calling LessThan both ways.
+	Compare(o ActivityStreamsOrderedCollectionPage) int
 	// GetActivityStreamsAltitude returns the "altitude" property if it
 	// exists, and nil otherwise.
 	GetActivityStreamsAltitude() ActivityStreamsAltitudeProperty
@@ -114,6 +120,9 @@ type ActivityStreamsOrderedCollectionPage interface {
 	// GetActivityStreamsReplies returns the "replies" property if it exists,
 	// and nil otherwise.
 	GetActivityStreamsReplies() ActivityStreamsRepliesProperty
+	// GetActivityStreamsSensitive returns the "sensitive" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsSensitive() ActivityStreamsSensitiveProperty
 	// GetActivityStreamsShares returns the "shares" property if it exists,
 	// and nil otherwise.
 	GetActivityStreamsShares() ActivityStreamsSharesProperty
@@ -244,6 +253,8 @@ type ActivityStreamsOrderedCollectionPage interface {
 	SetActivityStreamsPublished(i ActivityStreamsPublishedProperty)
 	// SetActivityStreamsReplies sets the "replies" property.
 	SetActivityStreamsReplies(i ActivityStreamsRepliesProperty)
+	// SetActivityStreamsSensitive sets the "sensitive" property.
+	SetActivityStreamsSensitive(i ActivityStreamsSensitiveProperty)
 	// SetActivityStreamsShares sets the "shares" property.
 	SetActivityStreamsShares(i ActivityStreamsSharesProperty)
 	// SetActivityStreamsSource sets the "source" property.
@@ -21,6 +21,12 @@ package vocab
 //     "units": "miles"
 //   }
 type ActivityStreamsPlace interface {
+	// Compare compares this Place with another, with an arbitrary but stable
+	// determination. It returns a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is. Each
+	// property is compared once, so comparing deeply nested values costs
+	// half as much as calling LessThan both ways.
+	Compare(o ActivityStreamsPlace) int
 	// GetActivityStreamsAccuracy returns the "accuracy" property if it
 	// exists, and nil otherwise.
 	GetActivityStreamsAccuracy() ActivityStreamsAccuracyProperty
@@ -102,6 +108,9 @@ type ActivityStreamsPlace interface {
 	// GetActivityStreamsReplies returns the "replies" property if it exists,
 	// and nil otherwise.
 	GetActivityStreamsReplies() ActivityStreamsRepliesProperty
+	// GetActivityStreamsSensitive returns the "sensitive" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsSensitive() ActivityStreamsSensitiveProperty
 	// GetActivityStreamsShares returns the "shares" property if it exists,
 	// and nil otherwise.
 	GetActivityStreamsShares() ActivityStreamsSharesProperty
@@ -219,6 +228,8 @@ type ActivityStreamsPlace interface {
 	SetActivityStreamsRadius(i ActivityStreamsRadiusProperty)
 	// SetActivityStreamsReplies sets the "replies" property.
 	SetActivityStreamsReplies(i ActivityStreamsRepliesProperty)
+	// SetActivityStreamsSensitive sets the "sensitive" property.
+	SetActivityStreamsSensitive(i ActivityStreamsSensitiveProperty)
 	// SetActivityStreamsShares sets the "shares" property.
 	SetActivityStreamsShares(i ActivityStreamsSharesProperty)
 	// SetActivityStreamsSource sets the "source" property.
@@ -12,6 +12,12 @@ package vocab
 //     "url": "http://example.org/video.mkv"
 //   }
 type ActivityStreamsVideo interface {
+	// Compare compares this Video with another, with an arbitrary but stable
+	// determination. It returns a negative number if this is lesser, a
+	// positive number if this is greater, and 0 if neither is. Each
+	// property is compared once, so comparing deeply nested values costs
+	// half as much as calling LessThan both ways.
+	Compare(o ActivityStreamsVideo) int
 	// GetActivityStreamsAltitude returns the "altitude" property if it
 	// exists, and nil otherwise.
 	GetActivityStreamsAltitude() ActivityStreamsAltitudeProperty
@@ -81,6 +87,9 @@ type ActivityStreamsVideo interface {
 	// GetActivityStreamsReplies returns the "replies" property if it exists,
 	// and nil otherwise.
 	GetActivityStreamsReplies() ActivityStreamsRepliesProperty
+	// GetActivityStreamsSensitive returns the "sensitive" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsSensitive() ActivityStreamsSensitiveProperty
 	// GetActivityStreamsShares returns the "shares" property if it exists,
 	// and nil otherwise.
 	GetActivityStreamsShares() ActivityStreamsSharesProperty
@@ -119,6 +128,18 @@ type ActivityStreamsVideo interface {
 	// GetJSONLDType returns the "type" property if it exists, and nil
 	// otherwise.
 	GetJSONLDType() JSONLDTypeProperty
+	// GetPeerTubeCommentsEnabled returns the "commentsEnabled" property if it
+	// exists, and nil otherwise.
+	GetPeerTubeCommentsEnabled() PeerTubeCommentsEnabledProperty
+	// GetPeerTubeFps returns the "fps" property if it exists, and nil
+	// otherwise.
+	GetPeerTubeFps() PeerTubeFpsProperty
+	// GetPeerTubeLicence returns the "licence" property if it exists, and nil
+	// otherwise.
+	GetPeerTubeLicence() PeerTubeLicenceProperty
+	// GetPeerTubeViews returns the "views" property if it exists, and nil
+	// otherwise.
+	GetPeerTubeViews() PeerTubeViewsProperty
 	// GetTootBlurhash returns the "blurhash" property if it exists, and nil
 	// otherwise.
 	GetTootBlurhash() TootBlurhashProperty
@@ -190,6 +211,8 @@ type ActivityStreamsVideo interface {
 	SetActivityStreamsPublished(i ActivityStreamsPublishedProperty)
 	// SetActivityStreamsReplies sets the "replies" property.
 	SetActivityStreamsReplies(i ActivityStreamsRepliesProperty)
+	// SetActivityStreamsSensitive sets the "sensitive" property.
+	SetActivityStreamsSensitive(i ActivityStreamsSensitiveProperty)
 	// SetActivityStreamsShares sets the "shares" property.
 	SetActivityStreamsShares(i ActivityStreamsSharesProperty)
 	// SetActivityStreamsSource sets the "source" property.
@@ -216,6 +239,14 @@ type ActivityStreamsVideo interface {
 	SetJSONLDId(i JSONLDIdProperty)
 	// SetJSONLDType sets the "type" property.
 	SetJSONLDType(i JSONLDTypeProperty)
+	// SetPeerTubeCommentsEnabled sets the "commentsEnabled" property.
+	SetPeerTubeCommentsEnabled(i PeerTubeCommentsEnabledProperty)
+	// SetPeerTubeFps sets the "fps" property.
+	SetPeerTubeFps(i PeerTubeFpsProperty)
+	// SetPeerTubeLicence sets the "licence" property.
+	SetPeerTubeLicence(i PeerTubeLicenceProperty)
+	// SetPeerTubeViews sets the "views" property.
+	SetPeerTubeViews(i PeerTubeViewsProperty)
 	// SetTootBlurhash sets the "blurhash" property.
 	SetTootBlurhash(i TootBlurhashProperty)
 	// VocabularyURI returns the vocabulary's URI as a string.
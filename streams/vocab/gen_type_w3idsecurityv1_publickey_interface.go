@@ -4,6 +4,12 @@ package vocab
 
 // A public key represents a public cryptographical key for a user
 type W3IDSecurityV1PublicKey interface {
+	// Compare compares this PublicKey with another, with an arbitrary but
+	// stable determination. It returns a negative number if this is
+	// lesser, a positive number if this is greater, and 0 if neither is.
+	// Each property is compared once, so comparing deeply nested values
+	// costs half as much as calling LessThan both ways.
+	Compare(o W3IDSecurityV1PublicKey) int
 	// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
 	GetJSONLDId() JSONLDIdProperty
 	// GetTypeName returns the name of this type.
@@ -1305,6 +1305,78 @@ func GetTestTable() []TestTable {
 				return mgr.DeserializePersonActivityStreams()(m, map[string]string{})
 			},
 		},
+		{
+			name:           "Person With movedTo And alsoKnownAs",
+			expectedJSON:   personMovedTo,
+			expectedStruct: personMovedToType(),
+			deserializer: func(m map[string]interface{}) (vocab.Type, error) {
+				return mgr.DeserializePersonActivityStreams()(m, map[string]string{})
+			},
+		},
+		{
+			name:           "Person Discoverable But Not Indexable",
+			expectedJSON:   personDiscoverableNotIndexable,
+			expectedStruct: personDiscoverableNotIndexableType(),
+			deserializer: func(m map[string]interface{}) (vocab.Type, error) {
+				return mgr.DeserializePersonActivityStreams()(m, map[string]string{})
+			},
+		},
+		{
+			name:           "Person With featured And featuredTags",
+			expectedJSON:   personWithFeaturedCollections,
+			expectedStruct: personWithFeaturedCollectionsType(),
+			deserializer: func(m map[string]interface{}) (vocab.Type, error) {
+				return mgr.DeserializePersonActivityStreams()(m, map[string]string{})
+			},
+		},
+		{
+			name:           "Question With votersCount",
+			expectedJSON:   questionWithVotersCount,
+			expectedStruct: questionWithVotersCountType(),
+			deserializer: func(m map[string]interface{}) (vocab.Type, error) {
+				return mgr.DeserializeQuestionActivityStreams()(m, map[string]string{})
+			},
+		},
+		{
+			name:           "Sensitive Note",
+			expectedJSON:   sensitiveNote,
+			expectedStruct: sensitiveNoteType(),
+			deserializer: func(m map[string]interface{}) (vocab.Type, error) {
+				return mgr.DeserializeNoteActivityStreams()(m, map[string]string{})
+			},
+		},
+		{
+			name:           "Litepub EmojiReact",
+			expectedJSON:   emojiReact,
+			expectedStruct: emojiReactType(),
+			deserializer: func(m map[string]interface{}) (vocab.Type, error) {
+				return mgr.DeserializeEmojiReactLitepub()(m, map[string]string{})
+			},
+		},
+		{
+			name:           "Litepub EmojiReact With Custom Emoji",
+			expectedJSON:   emojiReactWithCustomEmoji,
+			expectedStruct: emojiReactWithCustomEmojiType(),
+			deserializer: func(m map[string]interface{}) (vocab.Type, error) {
+				return mgr.DeserializeEmojiReactLitepub()(m, map[string]string{})
+			},
+		},
+		{
+			name:           "PeerTube Video",
+			expectedJSON:   peerTubeVideo,
+			expectedStruct: peerTubeVideoType(),
+			deserializer: func(m map[string]interface{}) (vocab.Type, error) {
+				return mgr.DeserializeVideoActivityStreams()(m, map[string]string{})
+			},
+		},
+		{
+			name:           "Service With Embedded And Referenced Public Keys",
+			expectedJSON:   serviceWithPublicKeys,
+			expectedStruct: serviceWithPublicKeysType(),
+			deserializer: func(m map[string]interface{}) (vocab.Type, error) {
+				return mgr.DeserializeServiceActivityStreams()(m, map[string]string{})
+			},
+		},
 		{
 			name:           "Service w/ Multiple schema:PropertyValue Attachments",
 			expectedJSON:   serviceHasAttachmentWithUnknown,
@@ -1315,6 +1387,22 @@ func GetTestTable() []TestTable {
 			skipDeserializationTest:       true,
 			skipDeserializationTestReason: "If go-fed gets the JSON, it won't match the form of the constructed type.",
 		},
+		{
+			name:           "Person With schema:PropertyValue Attachments",
+			expectedJSON:   personWithPropertyValues,
+			expectedStruct: personWithPropertyValuesType(),
+			deserializer: func(m map[string]interface{}) (vocab.Type, error) {
+				return mgr.DeserializePersonActivityStreams()(m, map[string]string{})
+			},
+		},
+		{
+			name:           "Note w/ Mention and Link With Rel",
+			expectedJSON:   noteWithRelTags,
+			expectedStruct: noteWithRelTagsType(),
+			deserializer: func(m map[string]interface{}) (vocab.Type, error) {
+				return mgr.DeserializeNoteActivityStreams()(m, map[string]string{})
+			},
+		},
 	}
 }
 